@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+// Package cdr ingests CDR records of settlement contracts in bulk. Records are kept off the ledger in the
+// node's DB and sealed into batches periodically, only the merkle root and the counters of a batch are
+// committed to the settlement contract, and every record can be proved by its merkle branch to the root.
+package cdr
+
+import (
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+// Record is a CDR record kept off the ledger, Seq and Position locate the leaf of record in the batch,
+// Seq is zero if the record is pending
+//
+//go:generate msgp
+type Record struct {
+	Param    cabi.CDRParam `msg:"p" json:"param"`
+	Seq      uint64        `msg:"s" json:"seq"`
+	Position uint32        `msg:"i" json:"position"`
+}
+
+func (z *Record) String() string {
+	return util.ToIndentString(z)
+}
+
+// Batch is a sealed batch of records, Leaves are the leaf hashes of records in the order of position
+//
+//go:generate msgp
+type Batch struct {
+	Seq       uint64                  `msg:"s" json:"seq"`
+	Root      types.Hash              `msg:"r,extension" json:"root"`
+	StartDate int64                   `msg:"t1" json:"startDate"`
+	EndDate   int64                   `msg:"t2" json:"endDate"`
+	Counters  []*cabi.CDRBatchCounter `msg:"c" json:"counters"`
+	Leaves    []types.Hash            `msg:"l" json:"-"`
+	Timestamp int64                   `msg:"ts" json:"timestamp"`
+}
+
+func (z *Batch) String() string {
+	return util.ToIndentString(z)
+}
+
+// ToParam builds the param to commit the batch to contract
+func (z *Batch) ToParam(contract types.Address) *cabi.CDRBatchParam {
+	return &cabi.CDRBatchParam{
+		ContractAddress: contract,
+		Seq:             z.Seq,
+		Root:            z.Root,
+		StartDate:       z.StartDate,
+		EndDate:         z.EndDate,
+		Counters:        z.Counters,
+	}
+}
+
+// LeafHash hashes the whole record, so any field of the record changed is detected by the proof,
+// while CDRParam.ToHash only identifies the record
+func LeafHash(param *cabi.CDRParam) (types.Hash, error) {
+	data, err := param.MarshalMsg(nil)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+	return types.HashBytes(data)
+}
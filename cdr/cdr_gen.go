@@ -0,0 +1,549 @@
+package cdr
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/qlcchain/go-qlc/common/types"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *Batch) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "s":
+			z.Seq, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Seq")
+				return
+			}
+		case "r":
+			err = dc.ReadExtension(&z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "t1":
+			z.StartDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "StartDate")
+				return
+			}
+		case "t2":
+			z.EndDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "EndDate")
+				return
+			}
+		case "c":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Counters")
+				return
+			}
+			if cap(z.Counters) >= int(zb0002) {
+				z.Counters = (z.Counters)[:zb0002]
+			} else {
+				z.Counters = make([]*cabi.CDRBatchCounter, zb0002)
+			}
+			for za0001 := range z.Counters {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Counters", za0001)
+						return
+					}
+					z.Counters[za0001] = nil
+				} else {
+					if z.Counters[za0001] == nil {
+						z.Counters[za0001] = new(cabi.CDRBatchCounter)
+					}
+					err = z.Counters[za0001].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "Counters", za0001)
+						return
+					}
+				}
+			}
+		case "l":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Leaves")
+				return
+			}
+			if cap(z.Leaves) >= int(zb0003) {
+				z.Leaves = (z.Leaves)[:zb0003]
+			} else {
+				z.Leaves = make([]types.Hash, zb0003)
+			}
+			for za0002 := range z.Leaves {
+				err = z.Leaves[za0002].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaves", za0002)
+					return
+				}
+			}
+		case "ts":
+			z.Timestamp, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Batch) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "s"
+	err = en.Append(0x87, 0xa1, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Seq)
+	if err != nil {
+		err = msgp.WrapError(err, "Seq")
+		return
+	}
+	// write "r"
+	err = en.Append(0xa1, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// write "t1"
+	err = en.Append(0xa2, 0x74, 0x31)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.StartDate)
+	if err != nil {
+		err = msgp.WrapError(err, "StartDate")
+		return
+	}
+	// write "t2"
+	err = en.Append(0xa2, 0x74, 0x32)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.EndDate)
+	if err != nil {
+		err = msgp.WrapError(err, "EndDate")
+		return
+	}
+	// write "c"
+	err = en.Append(0xa1, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Counters)))
+	if err != nil {
+		err = msgp.WrapError(err, "Counters")
+		return
+	}
+	for za0001 := range z.Counters {
+		if z.Counters[za0001] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.Counters[za0001].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Counters", za0001)
+				return
+			}
+		}
+	}
+	// write "l"
+	err = en.Append(0xa1, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Leaves)))
+	if err != nil {
+		err = msgp.WrapError(err, "Leaves")
+		return
+	}
+	for za0002 := range z.Leaves {
+		err = z.Leaves[za0002].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Leaves", za0002)
+			return
+		}
+	}
+	// write "ts"
+	err = en.Append(0xa2, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Timestamp)
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamp")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Batch) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "s"
+	o = append(o, 0x87, 0xa1, 0x73)
+	o = msgp.AppendUint64(o, z.Seq)
+	// string "r"
+	o = append(o, 0xa1, 0x72)
+	o, err = msgp.AppendExtension(o, &z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// string "t1"
+	o = append(o, 0xa2, 0x74, 0x31)
+	o = msgp.AppendInt64(o, z.StartDate)
+	// string "t2"
+	o = append(o, 0xa2, 0x74, 0x32)
+	o = msgp.AppendInt64(o, z.EndDate)
+	// string "c"
+	o = append(o, 0xa1, 0x63)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Counters)))
+	for za0001 := range z.Counters {
+		if z.Counters[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Counters[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Counters", za0001)
+				return
+			}
+		}
+	}
+	// string "l"
+	o = append(o, 0xa1, 0x6c)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Leaves)))
+	for za0002 := range z.Leaves {
+		o, err = z.Leaves[za0002].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Leaves", za0002)
+			return
+		}
+	}
+	// string "ts"
+	o = append(o, 0xa2, 0x74, 0x73)
+	o = msgp.AppendInt64(o, z.Timestamp)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Batch) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "s":
+			z.Seq, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Seq")
+				return
+			}
+		case "r":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "t1":
+			z.StartDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "StartDate")
+				return
+			}
+		case "t2":
+			z.EndDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EndDate")
+				return
+			}
+		case "c":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Counters")
+				return
+			}
+			if cap(z.Counters) >= int(zb0002) {
+				z.Counters = (z.Counters)[:zb0002]
+			} else {
+				z.Counters = make([]*cabi.CDRBatchCounter, zb0002)
+			}
+			for za0001 := range z.Counters {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Counters[za0001] = nil
+				} else {
+					if z.Counters[za0001] == nil {
+						z.Counters[za0001] = new(cabi.CDRBatchCounter)
+					}
+					bts, err = z.Counters[za0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Counters", za0001)
+						return
+					}
+				}
+			}
+		case "l":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Leaves")
+				return
+			}
+			if cap(z.Leaves) >= int(zb0003) {
+				z.Leaves = (z.Leaves)[:zb0003]
+			} else {
+				z.Leaves = make([]types.Hash, zb0003)
+			}
+			for za0002 := range z.Leaves {
+				bts, err = z.Leaves[za0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaves", za0002)
+					return
+				}
+			}
+		case "ts":
+			z.Timestamp, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Batch) Msgsize() (s int) {
+	s = 1 + 2 + msgp.Uint64Size + 2 + msgp.ExtensionPrefixSize + z.Root.Len() + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 2 + msgp.ArrayHeaderSize
+	for za0001 := range z.Counters {
+		if z.Counters[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Counters[za0001].Msgsize()
+		}
+	}
+	s += 2 + msgp.ArrayHeaderSize
+	for za0002 := range z.Leaves {
+		s += z.Leaves[za0002].Msgsize()
+	}
+	s += 3 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Record) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "p":
+			err = z.Param.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Param")
+				return
+			}
+		case "s":
+			z.Seq, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Seq")
+				return
+			}
+		case "i":
+			z.Position, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "Position")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Record) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "p"
+	err = en.Append(0x83, 0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = z.Param.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Param")
+		return
+	}
+	// write "s"
+	err = en.Append(0xa1, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Seq)
+	if err != nil {
+		err = msgp.WrapError(err, "Seq")
+		return
+	}
+	// write "i"
+	err = en.Append(0xa1, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.Position)
+	if err != nil {
+		err = msgp.WrapError(err, "Position")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Record) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "p"
+	o = append(o, 0x83, 0xa1, 0x70)
+	o, err = z.Param.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Param")
+		return
+	}
+	// string "s"
+	o = append(o, 0xa1, 0x73)
+	o = msgp.AppendUint64(o, z.Seq)
+	// string "i"
+	o = append(o, 0xa1, 0x69)
+	o = msgp.AppendUint32(o, z.Position)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Record) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "p":
+			bts, err = z.Param.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Param")
+				return
+			}
+		case "s":
+			z.Seq, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Seq")
+				return
+			}
+		case "i":
+			z.Position, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Position")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Record) Msgsize() (s int) {
+	s = 1 + 2 + z.Param.Msgsize() + 2 + msgp.Uint64Size + 2 + msgp.Uint32Size
+	return
+}
@@ -0,0 +1,286 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdr
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/merkle"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// DefaultMaxBatchSize is used if the max size of batch is not set
+const DefaultMaxBatchSize = 100000
+
+var (
+	ErrNoPending = errors.New("no pending CDR to seal")
+	ErrNotSealed = errors.New("CDR is not sealed into batch")
+
+	errStop = errors.New("stop iterator")
+	// sealing is serialized, so seq of batches is continuous
+	sealLock sync.Mutex
+)
+
+// IngestResult is the result of records ingested in bulk
+type IngestResult struct {
+	Total     int      `json:"total"`
+	Accepted  int      `json:"accepted"`
+	Duplicate int      `json:"duplicate"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// Proof proves that the record is a leaf of the batch root
+type Proof struct {
+	ContractAddress types.Address  `json:"contractAddress"`
+	Party           types.Address  `json:"party"`
+	Param           *cabi.CDRParam `json:"param"`
+	Seq             uint64         `json:"seq"`
+	Position        uint32         `json:"position"`
+	Root            types.Hash     `json:"root"`
+	Branch          []*types.Hash  `json:"branch"`
+}
+
+// Ingester keeps records of a party of settlement contract off the ledger and seals them into batches
+type Ingester struct {
+	l ledger.Store
+}
+
+func NewIngester(l ledger.Store) *Ingester {
+	return &Ingester{l: l}
+}
+
+// Ingest verifies and saves records of party, invalid records are reported in the result and records which
+// are ingested already are skipped
+func (i *Ingester) Ingest(contract, party types.Address, params []*cabi.CDRParam) (*IngestResult, error) {
+	ctx := vmstore.NewVMContext(i.l, &contractaddress.SettlementAddress)
+	cp, err := cabi.GetSettlementContract(ctx, &contract)
+	if err != nil {
+		return nil, err
+	}
+	if !cp.IsContractor(party) {
+		return nil, fmt.Errorf("%s is not contractor of %s", party.String(), contract.String())
+	}
+
+	result := &IngestResult{Total: len(params)}
+	for idx, param := range params {
+		if err := verify(cp, param); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("record %d: %s", idx, err))
+			continue
+		}
+		h, err := param.ToHash()
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := i.l.HasCDRRecord(contract, party, h); err != nil {
+			return nil, err
+		} else if ok {
+			result.Duplicate++
+			continue
+		}
+		record := &Record{Param: *param}
+		data, err := record.MarshalMsg(nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := i.l.AddCDRRecord(contract, party, h, data); err != nil {
+			return nil, err
+		}
+		result.Accepted++
+	}
+	return result, nil
+}
+
+func verify(cp *cabi.ContractParam, param *cabi.CDRParam) error {
+	if param == nil {
+		return errors.New("empty record")
+	}
+	if err := param.Verify(); err != nil {
+		return err
+	}
+	if param.SmsDt < cp.StartDate || param.SmsDt > cp.EndDate {
+		return fmt.Errorf("invalid %s date %d, should be in [%d, %d]", param.Kind.String(), param.SmsDt,
+			cp.StartDate, cp.EndDate)
+	}
+	_, err := cp.ServiceByKind(param.Kind)
+	return err
+}
+
+// Seal seals at most maxSize pending records of party into the next batch
+func (i *Ingester) Seal(contract, party types.Address, maxSize int) (*Batch, error) {
+	sealLock.Lock()
+	defer sealLock.Unlock()
+
+	if maxSize <= 0 {
+		maxSize = DefaultMaxBatchSize
+	}
+
+	var seq uint64
+	if err := i.l.GetCDRBatches(contract, party, func(s uint64, _ []byte) error {
+		seq = s
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	batch := &Batch{Seq: seq + 1}
+
+	counters := make(map[cabi.CDRKind]*cabi.CDRBatchCounter)
+	records := make(map[types.Hash][]byte)
+	if err := i.l.GetCDRPendings(contract, party, func(hash types.Hash, data []byte) error {
+		if len(batch.Leaves) >= maxSize {
+			return errStop
+		}
+		record := &Record{}
+		if _, err := record.UnmarshalMsg(data); err != nil {
+			return err
+		}
+		leaf, err := LeafHash(&record.Param)
+		if err != nil {
+			return err
+		}
+		record.Seq = batch.Seq
+		record.Position = uint32(len(batch.Leaves))
+		if records[hash], err = record.MarshalMsg(nil); err != nil {
+			return err
+		}
+		batch.Leaves = append(batch.Leaves, leaf)
+
+		c, ok := counters[record.Param.Kind]
+		if !ok {
+			c = &cabi.CDRBatchCounter{Kind: record.Param.Kind}
+			counters[record.Param.Kind] = c
+		}
+		c.Add(&record.Param)
+		if batch.StartDate == 0 || record.Param.SmsDt < batch.StartDate {
+			batch.StartDate = record.Param.SmsDt
+		}
+		if record.Param.SmsDt > batch.EndDate {
+			batch.EndDate = record.Param.SmsDt
+		}
+		return nil
+	}); err != nil && err != errStop {
+		return nil, err
+	}
+	if len(batch.Leaves) == 0 {
+		return nil, ErrNoPending
+	}
+
+	for _, c := range counters {
+		batch.Counters = append(batch.Counters, c)
+	}
+	sort.Slice(batch.Counters, func(i, j int) bool {
+		return batch.Counters[i].Kind < batch.Counters[j].Kind
+	})
+	batch.Root = merkle.CalcMerkleTreeRootHash(leaves(batch))
+	batch.Timestamp = common.TimeNow().Unix()
+
+	data, err := batch.MarshalMsg(nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.l.AddCDRBatch(contract, party, batch.Seq, data, records); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// Batch returns the sealed batch of seq
+func (i *Ingester) Batch(contract, party types.Address, seq uint64) (*Batch, error) {
+	data, err := i.l.GetCDRBatch(contract, party, seq)
+	if err != nil {
+		return nil, err
+	}
+	batch := &Batch{}
+	if _, err := batch.UnmarshalMsg(data); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// Batches returns the sealed batches of party in the order of seq
+func (i *Ingester) Batches(contract, party types.Address) ([]*Batch, error) {
+	var batches []*Batch
+	if err := i.l.GetCDRBatches(contract, party, func(_ uint64, data []byte) error {
+		batch := &Batch{}
+		if _, err := batch.UnmarshalMsg(data); err != nil {
+			return err
+		}
+		batches = append(batches, batch)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return batches, nil
+}
+
+// Pendings returns the count of records which are not sealed
+func (i *Ingester) Pendings(contract, party types.Address) (uint64, error) {
+	return i.l.CountCDRPendings(contract, party)
+}
+
+// Proof builds the merkle proof of record which is identified by hash of CDRParam.ToHash
+func (i *Ingester) Proof(contract, party types.Address, hash types.Hash) (*Proof, error) {
+	data, err := i.l.GetCDRRecord(contract, party, hash)
+	if err != nil {
+		return nil, err
+	}
+	record := &Record{}
+	if _, err := record.UnmarshalMsg(data); err != nil {
+		return nil, err
+	}
+	if record.Seq == 0 {
+		return nil, ErrNotSealed
+	}
+	batch, err := i.Batch(contract, party, record.Seq)
+	if err != nil {
+		return nil, err
+	}
+	branch := merkle.BuildMerkleBranch(merkle.BuildMerkleTreeStore(leaves(batch)), int(record.Position))
+	if branch == nil {
+		return nil, fmt.Errorf("invalid position %d of batch %d", record.Position, record.Seq)
+	}
+	return &Proof{
+		ContractAddress: contract,
+		Party:           party,
+		Param:           &record.Param,
+		Seq:             record.Seq,
+		Position:        record.Position,
+		Root:            batch.Root,
+		Branch:          branch,
+	}, nil
+}
+
+// VerifyProof verifies that the record of proof is the leaf at position of the root
+func VerifyProof(proof *Proof) error {
+	if proof == nil || proof.Param == nil {
+		return errors.New("invalid proof")
+	}
+	leaf, err := LeafHash(proof.Param)
+	if err != nil {
+		return err
+	}
+	if root := merkle.CalcMerkleRootByIndex(leaf, proof.Branch, int(proof.Position)); root != proof.Root {
+		return fmt.Errorf("invalid proof, exp root %s, got %s", proof.Root.String(), root.String())
+	}
+	return nil
+}
+
+func leaves(batch *Batch) []*types.Hash {
+	hashes := make([]*types.Hash, len(batch.Leaves))
+	for i := range batch.Leaves {
+		hashes[i] = &batch.Leaves[i]
+	}
+	return hashes
+}
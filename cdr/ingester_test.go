@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdr
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func setupTestCase(t *testing.T) (func(t *testing.T), *ledger.Ledger) {
+	t.Parallel()
+	dir := filepath.Join(config.QlcTestDataDir(), "cdr", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	l := ledger.NewLedger(cm.ConfigFile)
+
+	return func(t *testing.T) {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, l
+}
+
+func saveContract(t *testing.T, l *ledger.Ledger) (*cabi.ContractParam, types.Address) {
+	now := time.Now().Unix()
+	cp := &cabi.ContractParam{
+		CreateContractParam: cabi.CreateContractParam{
+			PartyA:   cabi.Contractor{Address: mock.Address(), Name: "PCCWG"},
+			PartyB:   cabi.Contractor{Address: mock.Address(), Name: "HKTCSL"},
+			Previous: mock.Hash(),
+			Services: []cabi.ContractService{
+				{ServiceId: mock.Hash().String(), TotalAmount: 100, UnitPrice: types.NewMoney(2, 0, ""),
+					Currency: "USD", Kind: cabi.CDRKindSms},
+				{ServiceId: mock.Hash().String(), TotalAmount: 100, UnitPrice: types.NewMoney(2, 0, ""),
+					Currency: "USD", Kind: cabi.CDRKindVoice},
+			},
+			SignDate:  now - 100,
+			StartDate: now - 100,
+			EndDate:   now + 3600,
+		},
+		Status: cabi.ContractStatusActivated,
+	}
+	addr, err := cp.Address()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := cp.ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+	if err := cabi.SaveContractParam(ctx, &addr, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	return cp, addr
+}
+
+func buildRecords(n int, dt int64) []*cabi.CDRParam {
+	var params []*cabi.CDRParam
+	for i := 0; i < n; i++ {
+		params = append(params, &cabi.CDRParam{
+			Index:         uint64(i + 1),
+			SmsDt:         dt + int64(i),
+			Sender:        "WeChat",
+			Destination:   "85257***343",
+			SendingStatus: cabi.SendingStatusSent,
+			DlrStatus:     cabi.DLRStatusDelivered,
+		})
+	}
+	params[0].Kind = cabi.CDRKindVoice
+	params[0].Voice = &cabi.VoiceCDR{Duration: 61, AnswerStatus: cabi.AnswerStatusAnswered}
+	return params
+}
+
+func TestIngester(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	cp, contract := saveContract(t, l)
+	party := cp.PartyA.Address
+	i := NewIngester(l)
+
+	if _, err := i.Ingest(contract, mock.Address(), nil); err == nil {
+		t.Fatal("only contractor can ingest records")
+	}
+
+	params := buildRecords(5, cp.StartDate)
+	params[4].Kind = cabi.CDRKindData
+	params[4].Data = &cabi.DataCDR{SessionId: "s1", Volume: 100}
+	result, err := i.Ingest(contract, party, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Total != 5 || result.Accepted != 4 || len(result.Errors) != 1 {
+		t.Fatal("invalid result", result)
+	}
+	if result, err := i.Ingest(contract, party, params[:2]); err != nil || result.Duplicate != 2 {
+		t.Fatal("records should be duplicate", result, err)
+	}
+	if _, err := i.Seal(contract, mock.Address(), 0); err != ErrNoPending {
+		t.Fatal("invalid error", err)
+	}
+
+	b1, err := i.Seal(contract, party, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b1.Seq != 1 || len(b1.Leaves) != 3 {
+		t.Fatal("invalid batch", b1)
+	}
+	if c, _ := i.Pendings(contract, party); c != 1 {
+		t.Fatal("invalid pendings", c)
+	}
+	b2, err := i.Seal(contract, party, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b2.Seq != 2 || len(b2.Leaves) != 1 {
+		t.Fatal("invalid batch", b2)
+	}
+	if _, err := i.Seal(contract, party, 3); err != ErrNoPending {
+		t.Fatal("invalid error", err)
+	}
+	if batches, err := i.Batches(contract, party); err != nil || len(batches) != 2 {
+		t.Fatal("invalid batches", batches, err)
+	}
+
+	var total, billable uint64
+	for _, b := range []*Batch{b1, b2} {
+		if err := b.ToParam(contract).Verify(); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range b.Counters {
+			total += c.Total
+			billable += c.Billable
+		}
+	}
+	// 3 SMS and a voice call billed by 2 minutes
+	if total != 4 || billable != 3+120 {
+		t.Fatal("invalid counters", total, billable)
+	}
+
+	for _, param := range params[:4] {
+		h, _ := param.ToHash()
+		proof, err := i.Proof(contract, party, h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyProof(proof); err != nil {
+			t.Fatal(err)
+		}
+		proof.Param.Destination = "85257***344"
+		if err := VerifyProof(proof); err == nil {
+			t.Fatal("modified record should not be proved")
+		}
+	}
+	if _, err := i.Proof(contract, party, mock.Hash()); err != ledger.ErrCDRNotFound {
+		t.Fatal("invalid error", err)
+	}
+}
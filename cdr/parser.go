@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdr
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// FormatOfFile returns the format by extension of file name
+func FormatOfFile(name string) (string, error) {
+	switch ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(name), ".")); ext {
+	case FormatCSV, FormatJSON:
+		return ext, nil
+	default:
+		return "", fmt.Errorf("unsupported CDR file %s", name)
+	}
+}
+
+// ParseFile parses records from CSV or JSON file
+func ParseFile(name string) ([]*cabi.CDRParam, error) {
+	format, err := FormatOfFile(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f, format)
+}
+
+// Parse parses records of format from reader
+func Parse(r io.Reader, format string) ([]*cabi.CDRParam, error) {
+	switch strings.ToLower(format) {
+	case FormatCSV:
+		return ParseCSV(r)
+	case FormatJSON:
+		return ParseJSON(r)
+	default:
+		return nil, fmt.Errorf("unsupported CDR format %s", format)
+	}
+}
+
+// ParseJSON parses records from a JSON array of CDRParam
+func ParseJSON(r io.Reader) ([]*cabi.CDRParam, error) {
+	var params []*cabi.CDRParam
+	if err := json.NewDecoder(r).Decode(&params); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// ParseCSV parses records from CSV, the header names the columns by the JSON field names of CDRParam,
+// details of voice and data records are in columns duration, answerStatus, sessionId, volume and increment,
+// enums are in their names and empty cells are default values
+func ParseCSV(r io.Reader) ([]*cabi.CDRParam, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("empty CDR file")
+		}
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+		if _, ok := csvColumns[header[i]]; !ok && header[i] != "increment" {
+			return nil, fmt.Errorf("unknown CDR column %s", header[i])
+		}
+	}
+
+	var params []*cabi.CDRParam
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		param := &cabi.CDRParam{}
+		increment := ""
+		for i, v := range row {
+			if v = strings.TrimSpace(v); len(v) == 0 {
+				continue
+			}
+			if header[i] == "increment" {
+				increment = v
+			} else if err := csvColumns[header[i]](param, v); err != nil {
+				return nil, fmt.Errorf("line %d, column %s: %s", line, header[i], err)
+			}
+		}
+		// increment belongs to the detail of record kind
+		if len(increment) > 0 {
+			if err := setIncrement(param, increment); err != nil {
+				return nil, fmt.Errorf("line %d, column increment: %s", line, err)
+			}
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+var csvColumns = map[string]func(p *cabi.CDRParam, v string) error{
+	"index": func(p *cabi.CDRParam, v string) (err error) {
+		p.Index, err = strconv.ParseUint(v, 10, 64)
+		return
+	},
+	"smsDt": func(p *cabi.CDRParam, v string) (err error) {
+		p.SmsDt, err = strconv.ParseInt(v, 10, 64)
+		return
+	},
+	"account":       func(p *cabi.CDRParam, v string) error { p.Account = v; return nil },
+	"sender":        func(p *cabi.CDRParam, v string) error { p.Sender = v; return nil },
+	"customer":      func(p *cabi.CDRParam, v string) error { p.Customer = v; return nil },
+	"destination":   func(p *cabi.CDRParam, v string) error { p.Destination = v; return nil },
+	"preStop":       func(p *cabi.CDRParam, v string) error { p.PreStop = v; return nil },
+	"nextStop":      func(p *cabi.CDRParam, v string) error { p.NextStop = v; return nil },
+	"sendingStatus": func(p *cabi.CDRParam, v string) error { return unmarshalText(&p.SendingStatus, v) },
+	"dlrStatus":     func(p *cabi.CDRParam, v string) error { return unmarshalText(&p.DlrStatus, v) },
+	"kind":          func(p *cabi.CDRParam, v string) error { return unmarshalText(&p.Kind, v) },
+	"duration": func(p *cabi.CDRParam, v string) (err error) {
+		p.Voice = voiceOf(p)
+		p.Voice.Duration, err = strconv.ParseUint(v, 10, 64)
+		return
+	},
+	"answerStatus": func(p *cabi.CDRParam, v string) error {
+		p.Voice = voiceOf(p)
+		return unmarshalText(&p.Voice.AnswerStatus, v)
+	},
+	"sessionId": func(p *cabi.CDRParam, v string) error {
+		p.Data = dataOf(p)
+		p.Data.SessionId = v
+		return nil
+	},
+	"volume": func(p *cabi.CDRParam, v string) (err error) {
+		p.Data = dataOf(p)
+		p.Data.Volume, err = strconv.ParseUint(v, 10, 64)
+		return
+	},
+}
+
+func setIncrement(p *cabi.CDRParam, v string) error {
+	increment, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return err
+	}
+	switch p.Kind {
+	case cabi.CDRKindVoice:
+		p.Voice = voiceOf(p)
+		p.Voice.Increment = increment
+	case cabi.CDRKindData:
+		p.Data = dataOf(p)
+		p.Data.Increment = increment
+	default:
+		return fmt.Errorf("%s record has no increment", p.Kind.String())
+	}
+	return nil
+}
+
+func voiceOf(p *cabi.CDRParam) *cabi.VoiceCDR {
+	if p.Voice == nil {
+		return &cabi.VoiceCDR{}
+	}
+	return p.Voice
+}
+
+func dataOf(p *cabi.CDRParam) *cabi.DataCDR {
+	if p.Data == nil {
+		return &cabi.DataCDR{}
+	}
+	return p.Data
+}
+
+func unmarshalText(v encoding.TextUnmarshaler, s string) error {
+	return v.UnmarshalText([]byte(s))
+}
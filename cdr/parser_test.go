@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package cdr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+)
+
+const csvRecords = `index,smsDt,sender,destination,sendingStatus,dlrStatus,kind,duration,answerStatus,sessionId,volume,increment
+1,1600000000,WeChat,85257***343,Sent,Delivered,,,,,,
+2,1600000001,WeChat,85257***344,,,voice,65,Answered,,,6
+3,1600000002,WeChat,85257***345,,,data,,,s1,2048,
+`
+
+func TestParseCSV(t *testing.T) {
+	params, err := ParseCSV(strings.NewReader(csvRecords))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []*cabi.CDRParam{
+		{Index: 1, SmsDt: 1600000000, Sender: "WeChat", Destination: "85257***343"},
+		{Index: 2, SmsDt: 1600000001, Sender: "WeChat", Destination: "85257***344", Kind: cabi.CDRKindVoice,
+			Voice: &cabi.VoiceCDR{Duration: 65, AnswerStatus: cabi.AnswerStatusAnswered, Increment: 6}},
+		{Index: 3, SmsDt: 1600000002, Sender: "WeChat", Destination: "85257***345", Kind: cabi.CDRKindData,
+			Data: &cabi.DataCDR{SessionId: "s1", Volume: 2048}},
+	}
+	if !reflect.DeepEqual(params, exp) {
+		t.Fatal("invalid records", params)
+	}
+
+	for _, data := range []string{
+		"",
+		"index,unknown\n1,2\n",
+		"index,kind\n1,fax\n",
+		"index,increment\n1,6\n",
+		"index,smsDt\nx,1\n",
+	} {
+		if _, err := ParseCSV(strings.NewReader(data)); err == nil {
+			t.Fatalf("%q should be invalid", data)
+		}
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	csvFile := filepath.Join(dir, "records.csv")
+	jsonFile := filepath.Join(dir, "records.JSON")
+	if err := ioutil.WriteFile(csvFile, []byte(csvRecords), 0600); err != nil {
+		t.Fatal(err)
+	}
+	data := `[{"index":2,"smsDt":1600000001,"sender":"WeChat","destination":"85257***344","kind":"voice",
+"voice":{"duration":65,"answerStatus":"Answered","increment":6}}]`
+	if err := ioutil.WriteFile(jsonFile, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p1, err := ParseFile(csvFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := ParseFile(jsonFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p2) != 1 || !reflect.DeepEqual(p1[1], p2[0]) {
+		t.Fatal("invalid records", p2)
+	}
+	if _, err := ParseFile(filepath.Join(dir, "records.txt")); err == nil {
+		t.Fatal("txt file should not be supported")
+	}
+}
@@ -24,8 +24,14 @@ import (
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
 )
 
+// interval to check and release time locks received by local accounts
+const timeLockReleaseInterval = time.Minute
+
 type AutoReceiveService struct {
 	common.ServiceLifecycle
 	subscriber *event.ActorSubscriber
@@ -107,8 +113,14 @@ func (as *AutoReceiveService) Start() error {
 
 	// auto receive
 	go func() {
+		ticker := time.NewTicker(timeLockReleaseInterval)
+		defer ticker.Stop()
 		for {
 			select {
+			case <-ticker.C:
+				if atomic.LoadUint32(&as.state) != 0 {
+					as.releaseTimeLocks(cc)
+				}
 			case <-as.quit:
 				atomic.StoreUint32(&as.state, 0)
 				if err := as.subscriber.Unsubscribe(topic.EventConfirmedBlock); err != nil {
@@ -122,6 +134,12 @@ func (as *AutoReceiveService) Start() error {
 					for _, account := range accounts {
 						addr := account.Address()
 						rxAddr := types.Address(blk.Link)
+						if isTimeLockSettle(blk, addr, cc) {
+							if err := ReceiveBlock(blk, account, cc); err != nil {
+								as.logger.Errorf("err[%s] when generate time lock receive block.", err)
+							}
+							break
+						}
 						if (blk.Type == types.Send || blk.Type == types.ContractSend) && addr == rxAddr {
 							var balance types.Balance
 							if blk.Token == config.ChainToken() {
@@ -145,6 +163,50 @@ func (as *AutoReceiveService) Start() error {
 	return nil
 }
 
+// releaseTimeLocks releases locks of local accounts whose release height and time are reached,
+// the funds are received when the release block is confirmed
+func (as *AutoReceiveService) releaseTimeLocks(cc *context.ChainContext) {
+	ledgerService, err := cc.Service(context.LedgerService)
+	if err != nil {
+		return
+	}
+	l := ledgerService.(*LedgerService).Ledger
+	povHeader, err := l.GetLatestPovHeader()
+	if err != nil {
+		return
+	}
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.TimeLockAddress)
+	for _, account := range cc.Accounts() {
+		locks, err := abi.ListTimeLocksByAddress(ctx, account.Address())
+		if err != nil {
+			as.logger.Error(err)
+			continue
+		}
+		for _, lock := range locks {
+			if lock.Receiver != account.Address() || !lock.Releasable(povHeader.GetHeight(), int64(povHeader.GetTimestamp())) {
+				continue
+			}
+			if err := ReleaseTimeLock(lock.LockId, account, cc); err != nil {
+				as.logger.Errorf("err[%s] when release time lock %s.", err, lock.LockId)
+			}
+		}
+	}
+}
+
+func isTimeLockSettle(blk *types.StateBlock, addr types.Address, cc *context.ChainContext) bool {
+	if blk.Type != types.ContractSend || blk.Link != types.Hash(contractaddress.TimeLockAddress) || blk.Address != addr {
+		return false
+	}
+	ledgerService, err := cc.Service(context.LedgerService)
+	if err != nil {
+		return false
+	}
+	l := ledgerService.(*LedgerService).Ledger
+	_, err = l.GetPending(&types.PendingKey{Address: addr, Hash: blk.GetHash()})
+	return err == nil
+}
+
 func (as *AutoReceiveService) Stop() error {
 	if !as.PreStop() {
 		return errors.New("pre stop fail")
@@ -199,6 +261,12 @@ func ReceiveBlock(sendBlock *types.StateBlock, account *types.Account, cc *conte
 		if err != nil {
 			return
 		}
+	} else if sendBlock.Type == types.ContractSend && sendBlock.Link == types.Hash(contractaddress.TimeLockAddress) {
+		err = client.Call(&receiveBlock, "timelock_getRewardBlock", sendBlock)
+		if err != nil {
+			return
+		}
+		signBlock(receiveBlock, account)
 	}
 	if receiveBlock != nil {
 		var h types.Hash
@@ -209,3 +277,40 @@ func ReceiveBlock(sendBlock *types.StateBlock, account *types.Account, cc *conte
 	}
 	return nil
 }
+
+// ReleaseTimeLock generates and processes the release block of time lock by its receiver
+func ReleaseTimeLock(lockId types.Hash, account *types.Account, cc *context.ChainContext) (err error) {
+	rpcService, err := cc.Service(context.RPCService)
+	if err != nil {
+		return
+	}
+	if rpcService.Status() != int32(common.Started) {
+		return fmt.Errorf("rpc service not started")
+	}
+
+	client, err := rpcService.(*RPCService).RPC().Attach()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if client != nil {
+			client.Close()
+		}
+	}()
+
+	var send *types.StateBlock
+	param := &api.TimeLockSettleParam{Address: account.Address(), LockId: lockId}
+	if err = client.Call(&send, "timelock_getReleaseBlock", param); err != nil {
+		return
+	}
+	signBlock(send, account)
+	var h types.Hash
+	return client.Call(&h, "ledger_process", send)
+}
+
+func signBlock(blk *types.StateBlock, account *types.Account) {
+	blk.Signature = account.Sign(blk.GetHash())
+	var w types.Work
+	worker, _ := types.NewWorker(w, blk.Root())
+	blk.Work = worker.NewWork()
+}
@@ -0,0 +1,18 @@
+// +build !testnet
+
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+// CDR ingestion commits batches to the settlement contract which is only available on testnet
+func registerCDRIngestService(_ *context.ChainContext, _ *config.Config, _ string) {}
@@ -0,0 +1,281 @@
+// +build testnet
+
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/cdr"
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+const (
+	cdrDoneDir   = "done"
+	cdrFailedDir = "failed"
+)
+
+// CDRIngestService ingests CDR files of settlement contracts in the watched directory, seals pending records
+// of local accounts into batches and commits the merkle roots of batches to the settlement contract
+type CDRIngestService struct {
+	common.ServiceLifecycle
+	cfgFile string
+	cc      *context.ChainContext
+	cfg     *config.CDRIngestConfig
+	dir     string
+	quit    chan struct{}
+	logger  *zap.SugaredLogger
+}
+
+func NewCDRIngestService(cfgFile string) *CDRIngestService {
+	return &CDRIngestService{
+		cfgFile: cfgFile,
+		cc:      context.NewChainContext(cfgFile),
+		quit:    make(chan struct{}),
+		logger:  log.NewLogger("cdr_ingest_service"),
+	}
+}
+
+func registerCDRIngestService(cc *context.ChainContext, cfg *config.Config, cfgFile string) {
+	if cfg.IsCDRIngestEnabled() {
+		cdrIngestService := NewCDRIngestService(cfgFile)
+		_ = cc.Register(context.CDRIngestService, cdrIngestService)
+	}
+}
+
+func (cs *CDRIngestService) Init() error {
+	if !cs.PreInit() {
+		return errors.New("pre init fail")
+	}
+	defer cs.PostInit()
+
+	cfg, err := cs.cc.Config()
+	if err != nil {
+		return err
+	}
+	cs.cfg = cfg.CDRIngest
+	if cs.cfg == nil {
+		return errors.New("CDR ingestion is not configured")
+	}
+	cs.dir = cfg.CDRDir()
+	for _, dir := range []string{cs.dir, filepath.Join(cs.dir, cdrDoneDir), filepath.Join(cs.dir, cdrFailedDir)} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *CDRIngestService) Start() error {
+	if !cs.PreStart() {
+		return errors.New("pre start fail")
+	}
+	defer cs.PostStart()
+
+	interval := cs.cfg.Interval
+	if interval <= 0 {
+		interval = 600
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-cs.quit:
+				return
+			case <-ticker.C:
+				cs.process()
+			}
+		}
+	}()
+	return nil
+}
+
+func (cs *CDRIngestService) process() {
+	ledgerService, err := cs.cc.Service(context.LedgerService)
+	if err != nil || ledgerService.Status() != int32(common.Started) {
+		return
+	}
+	l := ledgerService.(*LedgerService).Ledger
+	ingester := cdr.NewIngester(l)
+
+	cs.ingestFiles(l, ingester)
+
+	for _, account := range cs.cc.Accounts() {
+		addr := account.Address()
+		contracts, err := cabi.GetContractsByAddress(l, &addr)
+		if err != nil {
+			cs.logger.Error(err)
+			continue
+		}
+		for _, c := range contracts {
+			contractAddress, err := c.Address()
+			if err != nil {
+				continue
+			}
+			if err := cs.sealAndCommit(l, ingester, contractAddress, account); err != nil {
+				cs.logger.Errorf("err[%s] when commit CDR batch of %s to %s", err, addr, contractAddress)
+			}
+		}
+	}
+}
+
+// ingestFiles ingests files named <contract address>.*.csv|json, records belong to the local account which is
+// a party of the contract, files are moved to done or failed directory after ingested
+func (cs *CDRIngestService) ingestFiles(l ledger.Store, ingester *cdr.Ingester) {
+	files, err := ioutil.ReadDir(cs.dir)
+	if err != nil {
+		cs.logger.Error(err)
+		return
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		if _, err := cdr.FormatOfFile(f.Name()); err != nil {
+			continue
+		}
+		name := filepath.Join(cs.dir, f.Name())
+		target := cdrDoneDir
+		if result, err := cs.ingestFile(l, ingester, name); err != nil {
+			cs.logger.Errorf("err[%s] when ingest CDR file %s", err, f.Name())
+			target = cdrFailedDir
+		} else {
+			cs.logger.Infof("ingest CDR file %s, total %d, accepted %d, duplicate %d, invalid %d", f.Name(),
+				result.Total, result.Accepted, result.Duplicate, len(result.Errors))
+			for _, e := range result.Errors {
+				cs.logger.Warnf("%s: %s", f.Name(), e)
+			}
+		}
+		if err := os.Rename(name, filepath.Join(cs.dir, target, f.Name())); err != nil {
+			cs.logger.Error(err)
+		}
+	}
+}
+
+func (cs *CDRIngestService) ingestFile(l ledger.Store, ingester *cdr.Ingester, name string) (*cdr.IngestResult, error) {
+	contractAddress, err := types.HexToAddress(strings.Split(filepath.Base(name), ".")[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address of file: %s", err)
+	}
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+	c, err := cabi.GetSettlementContract(ctx, &contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	var party *types.Address
+	for _, account := range cs.cc.Accounts() {
+		if addr := account.Address(); c.IsContractor(addr) {
+			party = &addr
+			break
+		}
+	}
+	if party == nil {
+		return nil, fmt.Errorf("no local account is party of contract %s", contractAddress)
+	}
+
+	params, err := cdr.ParseFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return ingester.Ingest(contractAddress, *party, params)
+}
+
+// sealAndCommit seals all pending records of account into batches and commits batches which are not on chain
+// in the order of seq
+func (cs *CDRIngestService) sealAndCommit(l ledger.Store, ingester *cdr.Ingester, contractAddress types.Address,
+	account *types.Account) error {
+	party := account.Address()
+	for {
+		if _, err := ingester.Seal(contractAddress, party, cs.cfg.MaxBatchSize); err != nil {
+			if err == cdr.ErrNoPending {
+				break
+			}
+			return err
+		}
+	}
+
+	batches, err := ingester.Batches(contractAddress, party)
+	if err != nil {
+		return err
+	}
+	for _, batch := range batches {
+		ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+		if _, err := cabi.GetCDRBatch(ctx, contractAddress, party, batch.Seq); err == nil {
+			continue
+		}
+		if err := CommitCDRBatch(contractAddress, batch.Seq, account, cs.cc); err != nil {
+			return err
+		}
+		cs.logger.Infof("commit CDR batch %d of %s to %s, root %s", batch.Seq, party, contractAddress, batch.Root)
+	}
+	return nil
+}
+
+func (cs *CDRIngestService) Stop() error {
+	if !cs.PreStop() {
+		return errors.New("pre stop fail")
+	}
+	defer cs.PostStop()
+
+	close(cs.quit)
+	return nil
+}
+
+func (cs *CDRIngestService) Status() int32 {
+	return cs.State()
+}
+
+// CommitCDRBatch generates and processes the block which commits the sealed batch of account to settlement contract
+func CommitCDRBatch(contractAddress types.Address, seq uint64, account *types.Account, cc *context.ChainContext) (err error) {
+	rpcService, err := cc.Service(context.RPCService)
+	if err != nil {
+		return
+	}
+	if rpcService.Status() != int32(common.Started) {
+		return fmt.Errorf("rpc service not started")
+	}
+
+	client, err := rpcService.(*RPCService).RPC().Attach()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if client != nil {
+			client.Close()
+		}
+	}()
+
+	var send *types.StateBlock
+	param := &api.CommitCDRBatchParam{ContractAddress: contractAddress, Address: account.Address(), Seq: seq}
+	if err = client.Call(&send, "settlement_getCommitCDRBatchBlock", param); err != nil {
+		return
+	}
+	signBlock(send, account)
+	var h types.Hash
+	return client.Call(&h, "ledger_process", send)
+}
@@ -0,0 +1,133 @@
+// +build testnet
+
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/cdr"
+	ctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestCDRIngestService(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "cdr", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	cfg, _ := cm.Load()
+	cc := ctx.NewChainContext(cm.ConfigFile)
+	ls := NewLedgerService(cm.ConfigFile)
+	if err := ls.Init(); err != nil {
+		t.Fatal(err)
+	}
+	_ = ls.Start()
+	_ = cc.Register(ctx.LedgerService, ls)
+	defer func() {
+		_ = ls.Ledger.Close()
+		_ = cc.Stop()
+		_ = os.RemoveAll(dir)
+	}()
+	l := ls.Ledger
+
+	account := mock.Account()
+	cc.SetAccounts([]*types.Account{account})
+	now := time.Now().Unix()
+	cp := &cabi.ContractParam{
+		CreateContractParam: cabi.CreateContractParam{
+			PartyA:   cabi.Contractor{Address: mock.Address(), Name: "PCCWG"},
+			PartyB:   cabi.Contractor{Address: account.Address(), Name: "HKTCSL"},
+			Previous: mock.Hash(),
+			Services: []cabi.ContractService{{ServiceId: mock.Hash().String(), TotalAmount: 100,
+				UnitPrice: types.NewMoney(2, 0, ""), Currency: "USD"}},
+			SignDate:  now - 100,
+			StartDate: now - 100,
+			EndDate:   now + 3600,
+		},
+		Status: cabi.ContractStatusActivated,
+	}
+	contractAddress, _ := cp.Address()
+	data, _ := cp.ToABI()
+	vmCtx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+	if err := cabi.SaveContractParam(vmCtx, &contractAddress, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(vmCtx)); err != nil {
+		t.Fatal(err)
+	}
+
+	cs := NewCDRIngestService(cm.ConfigFile)
+	if err := cs.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if cs.dir != cfg.CDRDir() {
+		t.Fatal("invalid watch dir", cs.dir)
+	}
+	unknown := mock.Address().String() + ".csv"
+	records := fmt.Sprintf("index,smsDt,sender,destination\n1,%d,WeChat,85257***343\n2,%d,WeChat,85257***344\n", now, now)
+	files := map[string]string{
+		contractAddress.String() + ".1.csv": records,
+		unknown:                             records,
+		"readme.txt":                        "",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(cs.dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ingester := cdr.NewIngester(l)
+	cs.ingestFiles(l, ingester)
+	if c, err := ingester.Pendings(contractAddress, account.Address()); err != nil || c != 2 {
+		t.Fatal("invalid pendings", c, err)
+	}
+	for name, target := range map[string]string{
+		contractAddress.String() + ".1.csv": cdrDoneDir,
+		unknown:                             cdrFailedDir,
+		"readme.txt":                        "",
+	} {
+		if _, err := os.Stat(filepath.Join(cs.dir, target, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// batches are sealed even if they can not be committed without rpc service
+	if err := cs.sealAndCommit(l, ingester, contractAddress, account); err == nil {
+		t.Fatal("batch should not be committed")
+	}
+	if batches, err := ingester.Batches(contractAddress, account.Address()); err != nil || len(batches) != 1 {
+		t.Fatal("invalid batches", batches, err)
+	}
+
+	if err := cs.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if cs.Status() != int32(common.Started) {
+		t.Fatal("start failed")
+	}
+	if err := cs.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if cs.Status() != int32(common.Stopped) {
+		t.Fatal("stop failed")
+	}
+}
@@ -48,6 +48,8 @@ const (
 	ResendBlockService  = "resendBlockService"
 	PrivacyService      = "privacyService"
 	PermissionService   = "permissionService"
+	IndexerService      = "indexerService"
+	CDRIngestService    = "cdrIngestService"
 )
 
 type serviceManager interface {
@@ -364,7 +366,7 @@ func (cc *ChainContext) ConfigManager(opts ...Option) (*config.CfgManager, error
 		cc.cm = config.NewCfgManagerWithFile(cc.cfgFile)
 		_, err := cc.cm.Load(config.NewMigrationV1ToV2(), config.NewMigrationV2ToV3(), config.NewMigrationV3ToV4(),
 			config.NewMigrationV4ToV5(), config.NewMigrationV5ToV6(), config.NewMigrationV6ToV7(), config.NewMigrationV7ToV8(),
-			config.NewMigrationV8ToV9())
+			config.NewMigrationV8ToV9(), config.NewMigrationV9ToV10())
 		if err != nil {
 			return nil, err
 		}
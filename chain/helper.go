@@ -50,11 +50,14 @@ func RegisterServices(cc *context.ChainContext) error {
 		_ = cc.Register(context.RPCService, rpcService)
 	}
 
-	if cfg.PoV.PovEnabled {
+	if cfg.PoV.PovEnabled || cfg.IsLightNode() {
 		povService := NewPoVService(cfgFile)
 		_ = cc.Register(context.PovService, povService)
-		minerService := NewMinerService(cfgFile, povService.GetPoVEngine())
-		_ = cc.Register(context.MinerService, minerService)
+		// light node has no pov state to mine blocks
+		if !cfg.IsLightNode() {
+			minerService := NewMinerService(cfgFile, povService.GetPoVEngine())
+			_ = cc.Register(context.MinerService, minerService)
+		}
 	}
 
 	accounts := cc.Accounts()
@@ -79,6 +82,13 @@ func RegisterServices(cc *context.ChainContext) error {
 		_ = cc.Register(context.PrivacyService, privacyService)
 	}
 
+	if cfg.IsIndexerEnabled() {
+		indexerService := NewIndexerService(cfgFile)
+		_ = cc.Register(context.IndexerService, indexerService)
+	}
+
+	registerCDRIngestService(cc, cfg, cfgFile)
+
 	if cfg.WhiteList.Enable {
 		permService := NewPermissionService(cfgFile)
 		_ = cc.Register(context.PermissionService, permService)
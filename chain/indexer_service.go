@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	ctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/ledger/indexer"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+// IndexerService runs the indexer which writes the ledger into the relation database
+type IndexerService struct {
+	common.ServiceLifecycle
+	cfgFile string
+	cc      *ctx.ChainContext
+	indexer *indexer.Indexer
+	logger  *zap.SugaredLogger
+}
+
+func NewIndexerService(cfgFile string) *IndexerService {
+	return &IndexerService{
+		cfgFile: cfgFile,
+		cc:      ctx.NewChainContext(cfgFile),
+		logger:  log.NewLogger("indexer_service"),
+	}
+}
+
+func (is *IndexerService) Init() error {
+	if !is.PreInit() {
+		return errors.New("pre init fail")
+	}
+	defer is.PostInit()
+
+	return nil
+}
+
+func (is *IndexerService) Start() error {
+	if !is.PreStart() {
+		return errors.New("pre start fail")
+	}
+	defer is.PostStart()
+
+	ledgerService, err := is.cc.Service(ctx.LedgerService)
+	if err != nil {
+		return err
+	}
+	for ledgerService.Status() != int32(common.Started) {
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	idx, err := indexer.NewIndexer(is.cfgFile, ledgerService.(*LedgerService).Ledger)
+	if err != nil {
+		return err
+	}
+	if err := idx.Start(); err != nil {
+		return err
+	}
+	is.indexer = idx
+	return nil
+}
+
+func (is *IndexerService) Stop() error {
+	if !is.PreStop() {
+		return errors.New("pre stop fail")
+	}
+	defer is.PostStop()
+
+	if is.indexer != nil {
+		return is.indexer.Stop()
+	}
+	return nil
+}
+
+func (is *IndexerService) Status() int32 {
+	return is.State()
+}
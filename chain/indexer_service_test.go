@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	ctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+func TestIndexerService(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "indexer", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	cc := ctx.NewChainContext(cm.ConfigFile)
+	ls := NewLedgerService(cm.ConfigFile)
+	if err := ls.Init(); err != nil {
+		t.Fatal(err)
+	}
+	_ = ls.Start()
+	_ = cc.Register(ctx.LedgerService, ls)
+	defer func() {
+		_ = ls.Ledger.Close()
+		_ = cc.Stop()
+		_ = os.RemoveAll(dir)
+	}()
+
+	is := NewIndexerService(cm.ConfigFile)
+	if err := is.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := is.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if is.Status() != int32(common.Started) {
+		t.Fatal("start failed")
+	}
+	if err := is.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if is.Status() != int32(common.Stopped) {
+		t.Fatal("stop failed")
+	}
+}
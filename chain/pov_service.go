@@ -12,6 +12,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/consensus/pov"
 	"github.com/qlcchain/go-qlc/log"
@@ -24,7 +25,10 @@ type PoVService struct {
 }
 
 func NewPoVService(cfgFile string) *PoVService {
-	povEngine, _ := pov.NewPovEngine(cfgFile, false)
+	cc := context.NewChainContext(cfgFile)
+	cfg, _ := cc.Config()
+	// devnet seals pov blocks by the fake consensus, so blocks are produced in fixed interval
+	povEngine, _ := pov.NewPovEngine(cfgFile, cfg.IsDevnet())
 	return &PoVService{
 		povEngine: povEngine,
 		logger:    log.NewLogger("pov_service"),
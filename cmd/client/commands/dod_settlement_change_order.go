@@ -167,7 +167,7 @@ func DSChangeOrder(buyerAddressP, buyerNameP, sellerAddressP, sellerNameP, start
 		return err
 	}
 
-	price, err := strconv.ParseFloat(priceP, 64)
+	price, err := types.ParseMoney(priceP)
 	if err != nil {
 		return err
 	}
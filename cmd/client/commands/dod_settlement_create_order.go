@@ -194,7 +194,7 @@ func DSCreateOrder(buyerAddressP, buyerNameP, sellerAddressP, sellerNameP, srcPo
 		}
 	}
 
-	price, err := strconv.ParseFloat(priceP, 64)
+	price, err := types.ParseMoney(priceP)
 	if err != nil {
 		return err
 	}
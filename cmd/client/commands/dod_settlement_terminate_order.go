@@ -6,7 +6,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/rand"
-	"strconv"
 	"strings"
 
 	"github.com/abiosoft/ishell"
@@ -125,7 +124,7 @@ func DSTerminateOrder(buyerAddressP, buyerNameP, sellerAddressP, sellerNameP, pr
 		return err
 	}
 
-	price, err := strconv.ParseFloat(priceP, 64)
+	price, err := types.ParseMoney(priceP)
 	if err != nil {
 		return err
 	}
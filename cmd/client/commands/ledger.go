@@ -17,6 +17,7 @@ func addLedgerCmd() {
 		shell.AddCmd(ledgerCmd)
 		addLedgerDumpByIshell(ledgerCmd)
 		addLedgerGCByIshell(ledgerCmd)
+		addLedgerBackupByIshell(ledgerCmd)
 		addLedgerGenerateTestLedgerByIshell(ledgerCmd)
 		addLedgerBlockCountByIshell(ledgerCmd)
 		addLedgerTokensByIshell(ledgerCmd)
@@ -31,6 +32,7 @@ func addLedgerCmd() {
 		rootCmd.AddCommand(ledgerCmd)
 		addLedgerDumpByCobra(ledgerCmd)
 		addLedgerGCByCobra(ledgerCmd)
+		addLedgerBackupByCobra(ledgerCmd)
 		addLedgerGenerateTestLedgerByCobra(ledgerCmd)
 		addLedgerBlockCountByCobra(ledgerCmd)
 		addLedgerBalanceByCobra(ledgerCmd)
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	rpc "github.com/qlcchain/jsonrpc2"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/storage"
+)
+
+func addLedgerBackupByIshell(parentCmd *ishell.Cmd) {
+	since := util.Flag{
+		Name:  "since",
+		Must:  false,
+		Usage: "backup entries since the version, 0 for full backup, -1 for incremental backup after latest archive",
+		Value: 0,
+	}
+	args := []util.Flag{since}
+	c := &ishell.Cmd{
+		Name:                "backup",
+		Help:                "backup ledger of running node to archive",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			sinceP, err := util.IntVar(c.Args, since)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+			if err := backup(sinceP); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addLedgerBackupByCobra(parentCmd *cobra.Command) {
+	var sinceP int
+	var backupCmd = &cobra.Command{
+		Use:   "backup",
+		Short: "backup ledger of running node to archive",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := backup(sinceP)
+			if err != nil {
+				cmd.Println(err)
+				return
+			}
+		},
+	}
+	backupCmd.Flags().IntVar(&sinceP, "since", 0, "backup entries since the version, 0 for full backup, -1 for incremental backup after latest archive")
+	parentCmd.AddCommand(backupCmd)
+}
+
+func backup(since int) error {
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var path string
+	err = client.Call(&path, "debug_action", storage.Backup, since)
+	if err != nil {
+		return err
+	}
+
+	s := fmt.Sprintf("ledger backup to %s", path)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+
+	return nil
+}
@@ -25,6 +25,10 @@ func addMintageCmd() {
 
 		addMintageMintageCmdByShell(cmd)
 		addMintageWithdrawCmdByShell(cmd)
+		addMintageIssueCmdByShell(cmd)
+		addMintageBurnCmdByShell(cmd)
+		addMintageFreezeCmdByShell(cmd, true)
+		addMintageFreezeCmdByShell(cmd, false)
 	} else {
 		var cmd = &cobra.Command{
 			Use:   "mintage",
@@ -36,5 +40,9 @@ func addMintageCmd() {
 
 		addMintageMintageCmdByCobra(cmd)
 		addMintageWithdrawCmdByCobra(cmd)
+		addMintageIssueCmdByCobra(cmd)
+		addMintageBurnCmdByCobra(cmd)
+		addMintageFreezeCmdByCobra(cmd, true)
+		addMintageFreezeCmdByCobra(cmd, false)
 	}
 }
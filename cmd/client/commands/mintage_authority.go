@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	rpc "github.com/qlcchain/jsonrpc2"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+func addMintageIssueCmdByShell(parentCmd *ishell.Cmd) {
+	account := util.Flag{
+		Name:  "account",
+		Must:  true,
+		Usage: "issuer private hex string",
+	}
+	tokenId := util.Flag{
+		Name:  "tokenId",
+		Must:  true,
+		Usage: "token id hash hex string",
+	}
+	beneficial := util.Flag{
+		Name:  "beneficial",
+		Must:  true,
+		Usage: "beneficial private hex string",
+	}
+	amount := util.Flag{
+		Name:  "amount",
+		Must:  true,
+		Usage: "issue amount in minimal unit",
+	}
+	args := []util.Flag{account, tokenId, beneficial, amount}
+	s := &ishell.Cmd{
+		Name:                "issue",
+		Help:                "issue more token to beneficial",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			err := util.CheckArgs(c, args)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+
+			accountP := util.StringVar(c.Args, account)
+			tokenIdP := util.StringVar(c.Args, tokenId)
+			beneficialP := util.StringVar(c.Args, beneficial)
+			amountP := util.StringVar(c.Args, amount)
+
+			if err := issueMintageAction(accountP, tokenIdP, beneficialP, amountP); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addMintageIssueCmdByCobra(parentCmd *cobra.Command) {
+	var accountP, tokenIdP, beneficialP, amountP string
+	var cmd = &cobra.Command{
+		Use:   "issue",
+		Short: "issue more token to beneficial",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := issueMintageAction(accountP, tokenIdP, beneficialP, amountP)
+			if err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&accountP, "account", "", "issuer private hex string")
+	cmd.Flags().StringVar(&tokenIdP, "tokenId", "", "token id hash hex string")
+	cmd.Flags().StringVar(&beneficialP, "beneficial", "", "beneficial private hex string")
+	cmd.Flags().StringVar(&amountP, "amount", "", "issue amount in minimal unit")
+	parentCmd.AddCommand(cmd)
+}
+
+func issueMintageAction(account, tokenId, beneficial, amount string) error {
+	a, err := accountFromHex(account)
+	if err != nil {
+		return err
+	}
+	b, err := accountFromHex(beneficial)
+	if err != nil {
+		return err
+	}
+	id, err := types.NewHash(tokenId)
+	if err != nil {
+		return err
+	}
+
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	param := api.IssueParams{Issuer: a.Address(), TokenId: id, Beneficial: b.Address(), Amount: amount}
+	send := types.StateBlock{}
+	if err := client.Call(&send, "mintage_getIssueBlock", &param); err != nil {
+		return err
+	}
+	if err := signAndProcessBlock(client, a, &send); err != nil {
+		return err
+	}
+
+	reward := types.StateBlock{}
+	if err := client.Call(&reward, "mintage_getIssueRewardBlock", &send); err != nil {
+		return err
+	}
+	if err := signAndProcessBlock(client, b, &reward); err != nil {
+		return err
+	}
+	fmt.Printf("issue %s token %s to %s, %s\n", amount, id, b.Address(), reward.GetHash())
+	return nil
+}
+
+func addMintageBurnCmdByShell(parentCmd *ishell.Cmd) {
+	account := util.Flag{
+		Name:  "account",
+		Must:  true,
+		Usage: "issuer private hex string",
+	}
+	tokenId := util.Flag{
+		Name:  "tokenId",
+		Must:  true,
+		Usage: "token id hash hex string",
+	}
+	amount := util.Flag{
+		Name:  "amount",
+		Must:  true,
+		Usage: "burn amount in minimal unit",
+	}
+	args := []util.Flag{account, tokenId, amount}
+	s := &ishell.Cmd{
+		Name:                "burn",
+		Help:                "burn token of issuer",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			err := util.CheckArgs(c, args)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+
+			accountP := util.StringVar(c.Args, account)
+			tokenIdP := util.StringVar(c.Args, tokenId)
+			amountP := util.StringVar(c.Args, amount)
+
+			if err := burnMintageAction(accountP, tokenIdP, amountP); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addMintageBurnCmdByCobra(parentCmd *cobra.Command) {
+	var accountP, tokenIdP, amountP string
+	var cmd = &cobra.Command{
+		Use:   "burn",
+		Short: "burn token of issuer",
+		Run: func(cmd *cobra.Command, args []string) {
+			err := burnMintageAction(accountP, tokenIdP, amountP)
+			if err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&accountP, "account", "", "issuer private hex string")
+	cmd.Flags().StringVar(&tokenIdP, "tokenId", "", "token id hash hex string")
+	cmd.Flags().StringVar(&amountP, "amount", "", "burn amount in minimal unit")
+	parentCmd.AddCommand(cmd)
+}
+
+func burnMintageAction(account, tokenId, amount string) error {
+	a, err := accountFromHex(account)
+	if err != nil {
+		return err
+	}
+	id, err := types.NewHash(tokenId)
+	if err != nil {
+		return err
+	}
+
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	param := api.BurnParams{Issuer: a.Address(), TokenId: id, Amount: amount}
+	send := types.StateBlock{}
+	if err := client.Call(&send, "mintage_getBurnBlock", &param); err != nil {
+		return err
+	}
+	if err := signAndProcessBlock(client, a, &send); err != nil {
+		return err
+	}
+	fmt.Printf("burn %s token %s, %s\n", amount, id, send.GetHash())
+	return nil
+}
+
+func addMintageFreezeCmdByShell(parentCmd *ishell.Cmd, frozen bool) {
+	account := util.Flag{
+		Name:  "account",
+		Must:  true,
+		Usage: "issuer private hex string",
+	}
+	tokenId := util.Flag{
+		Name:  "tokenId",
+		Must:  true,
+		Usage: "token id hash hex string",
+	}
+	address := util.Flag{
+		Name:  "address",
+		Must:  true,
+		Usage: "address to freeze or unfreeze",
+	}
+	name, help := freezeCmdName(frozen)
+	args := []util.Flag{account, tokenId, address}
+	s := &ishell.Cmd{
+		Name:                name,
+		Help:                help,
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			err := util.CheckArgs(c, args)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
+
+			accountP := util.StringVar(c.Args, account)
+			tokenIdP := util.StringVar(c.Args, tokenId)
+			addressP := util.StringVar(c.Args, address)
+
+			if err := freezeMintageAction(accountP, tokenIdP, addressP, frozen); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addMintageFreezeCmdByCobra(parentCmd *cobra.Command, frozen bool) {
+	var accountP, tokenIdP, addressP string
+	name, help := freezeCmdName(frozen)
+	var cmd = &cobra.Command{
+		Use:   name,
+		Short: help,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := freezeMintageAction(accountP, tokenIdP, addressP, frozen)
+			if err != nil {
+				cmd.Println(err)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&accountP, "account", "", "issuer private hex string")
+	cmd.Flags().StringVar(&tokenIdP, "tokenId", "", "token id hash hex string")
+	cmd.Flags().StringVar(&addressP, "address", "", "address to freeze or unfreeze")
+	parentCmd.AddCommand(cmd)
+}
+
+func freezeCmdName(frozen bool) (string, string) {
+	if frozen {
+		return "freeze", "freeze account of token"
+	}
+	return "unfreeze", "unfreeze account of token"
+}
+
+func freezeMintageAction(account, tokenId, address string, frozen bool) error {
+	a, err := accountFromHex(account)
+	if err != nil {
+		return err
+	}
+	id, err := types.NewHash(tokenId)
+	if err != nil {
+		return err
+	}
+	addr, err := types.HexToAddress(address)
+	if err != nil {
+		return err
+	}
+
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	method := "mintage_getUnfreezeBlock"
+	if frozen {
+		method = "mintage_getFreezeBlock"
+	}
+	param := api.FreezeParams{Issuer: a.Address(), TokenId: id, Account: addr}
+	send := types.StateBlock{}
+	if err := client.Call(&send, method, &param); err != nil {
+		return err
+	}
+	if err := signAndProcessBlock(client, a, &send); err != nil {
+		return err
+	}
+	fmt.Printf("%s %s of token %s, %s\n", method, addr, id, send.GetHash())
+	return nil
+}
+
+func accountFromHex(account string) (*types.Account, error) {
+	bytes, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewAccount(bytes), nil
+}
+
+func signAndProcessBlock(client *rpc.Client, a *types.Account, blk *types.StateBlock) error {
+	blk.Signature = a.Sign(blk.GetHash())
+	var w types.Work
+	worker, _ := types.NewWorker(w, blk.Root())
+	blk.Work = worker.NewWork()
+	return client.Call(nil, "ledger_process", blk)
+}
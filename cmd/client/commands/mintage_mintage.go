@@ -52,7 +52,13 @@ func addMintageMintageCmdByShell(parentCmd *ishell.Cmd) {
 		Must:  true,
 		Usage: "token decimals",
 	}
-	args := []util.Flag{account, preHash, tokenName, tokenSymbol, totalSupply, decimals}
+	authorities := util.Flag{
+		Name:  "authorities",
+		Must:  false,
+		Usage: "issuer authorities of token, separated by comma (issue,burn,freeze,kyc)",
+		Value: "",
+	}
+	args := []util.Flag{account, preHash, tokenName, tokenSymbol, totalSupply, decimals, authorities}
 	s := &ishell.Cmd{
 		Name:                "mine",
 		Help:                "mine token",
@@ -77,9 +83,10 @@ func addMintageMintageCmdByShell(parentCmd *ishell.Cmd) {
 				util.Warn(err)
 				return
 			}
+			authoritiesP := util.StringSliceVar(c.Args, authorities)
 
 			fmt.Println(accountP, preHashP, tokenNameP, tokenSymbolP, totalSupplyP, decimalsP)
-			if err := mintageAction(accountP, preHashP, tokenNameP, tokenSymbolP, totalSupplyP, decimalsP); err != nil {
+			if err := mintageAction(accountP, preHashP, tokenNameP, tokenSymbolP, totalSupplyP, decimalsP, authoritiesP); err != nil {
 				util.Warn(err)
 				return
 			}
@@ -91,11 +98,12 @@ func addMintageMintageCmdByShell(parentCmd *ishell.Cmd) {
 func addMintageMintageCmdByCobra(parentCmd *cobra.Command) {
 	var accountP, preHashP, tokenNameP, tokenSymbolP, totalSupplyP string
 	var decimalsP int
+	var authoritiesP []string
 	var accountCmd = &cobra.Command{
 		Use:   "mine",
 		Short: "mine token",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := mintageAction(accountP, preHashP, tokenNameP, tokenSymbolP, totalSupplyP, decimalsP)
+			err := mintageAction(accountP, preHashP, tokenNameP, tokenSymbolP, totalSupplyP, decimalsP, authoritiesP)
 			if err != nil {
 				cmd.Println(err)
 			}
@@ -107,10 +115,11 @@ func addMintageMintageCmdByCobra(parentCmd *cobra.Command) {
 	accountCmd.Flags().StringVar(&tokenSymbolP, "tokenSymbol", "", "token symbol")
 	accountCmd.Flags().StringVar(&totalSupplyP, "totalSupply", "", "token total supply")
 	accountCmd.Flags().IntVar(&decimalsP, "decimals", 8, "token decimals")
+	accountCmd.Flags().StringSliceVar(&authoritiesP, "authorities", nil, "issuer authorities of token (issue,burn,freeze,kyc)")
 	parentCmd.AddCommand(accountCmd)
 }
 
-func mintageAction(account, preHash, tokenName, tokenSymbol, totalSupply string, decimals int) error {
+func mintageAction(account, preHash, tokenName, tokenSymbol, totalSupply string, decimals int, authorities []string) error {
 	bytes, err := hex.DecodeString(account)
 	if err != nil {
 		return err
@@ -133,7 +142,7 @@ func mintageAction(account, preHash, tokenName, tokenSymbol, totalSupply string,
 	mintageParam := api.MintageParams{
 		SelfAddr: a.Address(), PrevHash: previous, TokenName: tokenName,
 		TotalSupply: totalSupply, TokenSymbol: tokenSymbol, Decimals: d, Beneficial: a.Address(),
-		NEP5TxId: NEP5tTxId,
+		NEP5TxId: NEP5tTxId, Authorities: authorities,
 	}
 
 	send := types.StateBlock{}
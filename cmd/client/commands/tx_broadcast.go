@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	rpc "github.com/qlcchain/jsonrpc2"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+func addTxBroadcastCmdByShell(parentCmd *ishell.Cmd) {
+	in := util.Flag{
+		Name:  "in",
+		Must:  false,
+		Usage: "signed block file",
+		Value: defaultOfflineFile,
+	}
+	args := []util.Flag{in}
+	c := &ishell.Cmd{
+		Name:                "broadcast",
+		Help:                "broadcast signed offline blocks",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			inP := util.StringVar(c.Args, in)
+			if err := txBroadcastAction(inP); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addTxBroadcastCmdByCobra(parentCmd *cobra.Command) {
+	var inP string
+	var cmd = &cobra.Command{
+		Use:   "broadcast",
+		Short: "broadcast signed offline blocks",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := txBroadcastAction(inP); err != nil {
+				cmd.Println(err)
+				return
+			}
+		},
+	}
+	cmd.Flags().StringVar(&inP, "in", defaultOfflineFile, "signed block file")
+	parentCmd.AddCommand(cmd)
+}
+
+func txBroadcastAction(inP string) error {
+	blocks, err := util.ReadOfflineBlocks(inP)
+	if err != nil {
+		return err
+	}
+	if len(blocks.Blocks) == 0 {
+		return fmt.Errorf("no block in %s", inP)
+	}
+	// verify all blocks before submit any of them
+	for _, b := range blocks.Blocks {
+		if err := b.Verify(); err != nil {
+			return err
+		}
+	}
+
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	for _, b := range blocks.Blocks {
+		frontier, _, err := txFrontier(client, b.Block)
+		if err != nil {
+			return err
+		}
+		if frontier != b.Frontier {
+			return fmt.Errorf("frontier of %s changed from %s to %s, rebuild block %s", b.Block.Address, b.Frontier, frontier, b.Hash)
+		}
+
+		var h types.Hash
+		if err := client.Call(&h, "ledger_process", b.Block); err != nil {
+			return fmt.Errorf("process block %s: %s", b.Hash, err)
+		}
+
+		s := fmt.Sprintf("broadcast %s block %s of %s", b.Block.Type, h, b.Block.Address)
+		if interactive {
+			util.Info(s)
+		} else {
+			fmt.Println(s)
+		}
+	}
+	return nil
+}
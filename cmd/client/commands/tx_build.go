@@ -0,0 +1,294 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abiosoft/ishell"
+	rpc "github.com/qlcchain/jsonrpc2"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/rpc/api"
+)
+
+const defaultOfflineFile = "offline_blocks.json"
+
+type txBuildParam struct {
+	typ    string
+	from   string
+	to     string
+	token  string
+	amount string
+	hash   string
+	rep    string
+	method string
+	params string
+	out    string
+}
+
+func addTxBuildCmdByShell(parentCmd *ishell.Cmd) {
+	typ := util.Flag{
+		Name:  "type",
+		Must:  true,
+		Usage: "block type, send/receive/change/contract",
+		Value: "",
+	}
+	from := util.Flag{
+		Name:  "from",
+		Must:  false,
+		Usage: "account address for send and change",
+		Value: "",
+	}
+	to := util.Flag{
+		Name:  "to",
+		Must:  false,
+		Usage: "receive account address for send",
+		Value: "",
+	}
+	token := util.Flag{
+		Name:  "token",
+		Must:  false,
+		Usage: "token name for send(default is QLC)",
+		Value: "QLC",
+	}
+	amount := util.Flag{
+		Name:  "amount",
+		Must:  false,
+		Usage: "send amount",
+		Value: "",
+	}
+	hash := util.Flag{
+		Name:  "hash",
+		Must:  false,
+		Usage: "send block hash for receive",
+		Value: "",
+	}
+	rep := util.Flag{
+		Name:  "rep",
+		Must:  false,
+		Usage: "representative address for change",
+		Value: "",
+	}
+	method := util.Flag{
+		Name:  "method",
+		Must:  false,
+		Usage: "rpc method of contract block builder, e.g. pledge_getPledgeBlock",
+		Value: "",
+	}
+	params := util.Flag{
+		Name:  "params",
+		Must:  false,
+		Usage: "json array of contract block builder params",
+		Value: "[]",
+	}
+	out := util.Flag{
+		Name:  "out",
+		Must:  false,
+		Usage: "offline block file, the block is appended if file exists",
+		Value: defaultOfflineFile,
+	}
+	args := []util.Flag{typ, from, to, token, amount, hash, rep, method, params, out}
+	c := &ishell.Cmd{
+		Name:                "build",
+		Help:                "build unsigned block for offline signing",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			param := &txBuildParam{
+				typ:    util.StringVar(c.Args, typ),
+				from:   util.StringVar(c.Args, from),
+				to:     util.StringVar(c.Args, to),
+				token:  util.StringVar(c.Args, token),
+				amount: util.StringVar(c.Args, amount),
+				hash:   util.StringVar(c.Args, hash),
+				rep:    util.StringVar(c.Args, rep),
+				method: util.StringVar(c.Args, method),
+				params: util.StringVar(c.Args, params),
+				out:    util.StringVar(c.Args, out),
+			}
+			if err := txBuildAction(param); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addTxBuildCmdByCobra(parentCmd *cobra.Command) {
+	param := new(txBuildParam)
+	var cmd = &cobra.Command{
+		Use:   "build",
+		Short: "build unsigned block for offline signing",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := txBuildAction(param); err != nil {
+				cmd.Println(err)
+				return
+			}
+		},
+	}
+	cmd.Flags().StringVar(&param.typ, "type", "", "block type, send/receive/change/contract")
+	cmd.Flags().StringVar(&param.from, "from", "", "account address for send and change")
+	cmd.Flags().StringVar(&param.to, "to", "", "receive account address for send")
+	cmd.Flags().StringVar(&param.token, "token", "QLC", "token name for send")
+	cmd.Flags().StringVar(&param.amount, "amount", "", "send amount")
+	cmd.Flags().StringVar(&param.hash, "hash", "", "send block hash for receive")
+	cmd.Flags().StringVar(&param.rep, "rep", "", "representative address for change")
+	cmd.Flags().StringVar(&param.method, "method", "", "rpc method of contract block builder, e.g. pledge_getPledgeBlock")
+	cmd.Flags().StringVar(&param.params, "params", "[]", "json array of contract block builder params")
+	cmd.Flags().StringVar(&param.out, "out", defaultOfflineFile, "offline block file, the block is appended if file exists")
+	parentCmd.AddCommand(cmd)
+}
+
+// txBuildCall returns the rpc method and params to build the unsigned block
+func txBuildCall(param *txBuildParam) (string, []interface{}, error) {
+	switch param.typ {
+	case "send":
+		from, err := types.HexToAddress(param.from)
+		if err != nil {
+			return "", nil, err
+		}
+		to, err := types.HexToAddress(param.to)
+		if err != nil {
+			return "", nil, err
+		}
+		if param.amount == "" {
+			return "", nil, errors.New("invalid amount")
+		}
+		para := &api.APISendBlockPara{
+			From:      from,
+			TokenName: param.token,
+			To:        to,
+			Amount:    types.StringToBalance(param.amount),
+		}
+		return "ledger_generateSendBlock", []interface{}{para}, nil
+	case "receive":
+		hash, err := types.NewHash(param.hash)
+		if err != nil {
+			return "", nil, err
+		}
+		return "ledger_generateReceiveBlockByHash", []interface{}{hash}, nil
+	case "change":
+		from, err := types.HexToAddress(param.from)
+		if err != nil {
+			return "", nil, err
+		}
+		rep, err := types.HexToAddress(param.rep)
+		if err != nil {
+			return "", nil, err
+		}
+		return "ledger_generateChangeBlock", []interface{}{from, rep}, nil
+	case "contract":
+		if param.method == "" {
+			return "", nil, errors.New("invalid contract block builder method")
+		}
+		var raws []json.RawMessage
+		if err := json.Unmarshal([]byte(param.params), &raws); err != nil {
+			return "", nil, fmt.Errorf("invalid params, %s", err)
+		}
+		params := make([]interface{}, 0, len(raws))
+		for _, raw := range raws {
+			params = append(params, raw)
+		}
+		return param.method, params, nil
+	default:
+		return "", nil, fmt.Errorf("invalid block type %s", param.typ)
+	}
+}
+
+func txBuildAction(param *txBuildParam) error {
+	method, params, err := txBuildCall(param)
+	if err != nil {
+		return err
+	}
+
+	blocks, err := util.ReadOfflineBlocks(param.out)
+	if err != nil {
+		return err
+	}
+
+	client, err := rpc.Dial(endpointP)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	blk := new(types.StateBlock)
+	if err := client.Call(blk, method, params...); err != nil {
+		return err
+	}
+
+	frontier, balance, err := txFrontier(client, blk)
+	if err != nil {
+		return err
+	}
+
+	header := new(api.PovApiHeader)
+	if err := client.Call(header, "pov_getLatestHeader"); err != nil {
+		return err
+	}
+
+	ob := &util.OfflineBlock{
+		Method:    method,
+		Block:     blk,
+		Hash:      blk.GetHash(),
+		Frontier:  frontier,
+		PovHeight: header.GetHeight(),
+		PovHash:   header.GetHash(),
+		Timestamp: time.Now().Unix(),
+	}
+	// the online frontier does not include blocks in the file which are not broadcast yet
+	if pending := blocks.Pending(blk.Address, blk.Token, frontier); pending != nil {
+		if err := ob.ChainTo(pending, balance); err != nil {
+			return err
+		}
+	}
+	if err := blocks.Add(ob); err != nil {
+		return err
+	}
+	if err := blocks.Write(param.out); err != nil {
+		return err
+	}
+
+	s := fmt.Sprintf("build %s block %s of %s to %s", ob.Block.Type, ob.Hash, ob.Block.Address, param.out)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	return nil
+}
+
+// txFrontier returns the header of the token chain which the block will be appended to, and its balance
+func txFrontier(client *rpc.Client, blk *types.StateBlock) (types.Hash, types.Balance, error) {
+	info := new(api.APIAccount)
+	if err := client.Call(info, "ledger_accountInfo", blk.Address); err != nil {
+		if blk.IsOpen() {
+			return types.ZeroHash, types.ZeroBalance, nil
+		}
+		return types.ZeroHash, types.ZeroBalance, err
+	}
+	for _, tm := range info.Tokens {
+		if tm.Type == blk.Token {
+			return tm.Header, tm.Balance, nil
+		}
+	}
+	return types.ZeroHash, types.ZeroBalance, nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/ed25519"
+)
+
+func addTxSignCmdByShell(parentCmd *ishell.Cmd) {
+	priKey := util.Flag{
+		Name:  "priKey",
+		Must:  true,
+		Usage: "account private hex string",
+		Value: "",
+	}
+	in := util.Flag{
+		Name:  "in",
+		Must:  false,
+		Usage: "offline block file to sign",
+		Value: defaultOfflineFile,
+	}
+	out := util.Flag{
+		Name:  "out",
+		Must:  false,
+		Usage: "signed block file, default is the input file",
+		Value: "",
+	}
+	args := []util.Flag{priKey, in, out}
+	c := &ishell.Cmd{
+		Name:                "sign",
+		Help:                "sign offline blocks and compute work without node",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			priKeyP := util.StringVar(c.Args, priKey)
+			inP := util.StringVar(c.Args, in)
+			outP := util.StringVar(c.Args, out)
+			if err := txSignAction(priKeyP, inP, outP); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(c)
+}
+
+func addTxSignCmdByCobra(parentCmd *cobra.Command) {
+	var priKeyP string
+	var inP string
+	var outP string
+	var cmd = &cobra.Command{
+		Use:   "sign",
+		Short: "sign offline blocks and compute work without node",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := txSignAction(priKeyP, inP, outP); err != nil {
+				cmd.Println(err)
+				return
+			}
+		},
+	}
+	cmd.Flags().StringVar(&priKeyP, "priKey", "", "account private hex string")
+	cmd.Flags().StringVar(&inP, "in", defaultOfflineFile, "offline block file to sign")
+	cmd.Flags().StringVar(&outP, "out", "", "signed block file, default is the input file")
+	parentCmd.AddCommand(cmd)
+}
+
+func txSignAction(priKeyP, inP, outP string) error {
+	if priKeyP == "" {
+		return errors.New("invalid priKey value")
+	}
+	if outP == "" {
+		outP = inP
+	}
+	bytes, err := hex.DecodeString(priKeyP)
+	if err != nil {
+		return err
+	}
+	if len(bytes) != ed25519.PrivateKeySize {
+		return errors.New("invalid priKey length")
+	}
+	account := types.NewAccount(bytes)
+
+	blocks, err := util.ReadOfflineBlocks(inP)
+	if err != nil {
+		return err
+	}
+	if len(blocks.Blocks) == 0 {
+		return fmt.Errorf("no block in %s", inP)
+	}
+
+	count, err := blocks.Sign(account)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("no block of %s in %s", account.Address(), inP)
+	}
+	if err := blocks.Write(outP); err != nil {
+		return err
+	}
+
+	s := fmt.Sprintf("sign %d blocks of %s to %s", count, account.Address(), outP)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	return nil
+}
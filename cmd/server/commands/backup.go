@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+func backupLedger() {
+	if interactive {
+		since := cmdutil.Flag{
+			Name:  "since",
+			Must:  false,
+			Usage: "backup entries since the version, 0 for full backup, -1 for incremental backup after latest archive",
+			Value: 0,
+		}
+		upto := cmdutil.Flag{
+			Name:  "upto",
+			Must:  false,
+			Usage: "restore archives up to the version, 0 for all archives",
+			Value: 0,
+		}
+		backupArgs := []cmdutil.Flag{since}
+		cmdBackup := &ishell.Cmd{
+			Name:                "backup",
+			Help:                "backup ledger to archive",
+			CompleterWithPrefix: cmdutil.OptsCompleter(backupArgs),
+			Func: func(c *ishell.Context) {
+				if cmdutil.HelpText(c, backupArgs) {
+					return
+				}
+				if err := cmdutil.CheckArgs(c, backupArgs); err != nil {
+					cmdutil.Warn(err)
+					return
+				}
+				sinceP, _ := cmdutil.IntVar(c.Args, since)
+				backupAction(sinceP)
+			},
+		}
+		shell.AddCmd(cmdBackup)
+
+		restoreArgs := []cmdutil.Flag{upto}
+		cmdRestore := &ishell.Cmd{
+			Name:                "restore",
+			Help:                "restore ledger from archives",
+			CompleterWithPrefix: cmdutil.OptsCompleter(restoreArgs),
+			Func: func(c *ishell.Context) {
+				if cmdutil.HelpText(c, restoreArgs) {
+					return
+				}
+				if err := cmdutil.CheckArgs(c, restoreArgs); err != nil {
+					cmdutil.Warn(err)
+					return
+				}
+				uptoP, _ := cmdutil.IntVar(c.Args, upto)
+				restoreAction(uptoP)
+			},
+		}
+		shell.AddCmd(cmdRestore)
+	} else {
+		var sinceP int
+		var cmdBackup = &cobra.Command{
+			Use:   "backup",
+			Short: "backup ledger to archive",
+			Run: func(cmd *cobra.Command, args []string) {
+				backupAction(sinceP)
+			},
+		}
+		cmdBackup.Flags().IntVarP(&sinceP, "since", "", 0, "backup entries since the version, 0 for full backup, -1 for incremental backup after latest archive")
+		rootCmd.AddCommand(cmdBackup)
+
+		var uptoP int
+		var cmdRestore = &cobra.Command{
+			Use:   "restore",
+			Short: "restore ledger from archives",
+			Run: func(cmd *cobra.Command, args []string) {
+				restoreAction(uptoP)
+			},
+		}
+		cmdRestore.Flags().IntVarP(&uptoP, "upto", "", 0, "restore archives up to the version, 0 for all archives")
+		rootCmd.AddCommand(cmdRestore)
+	}
+}
+
+func backupAction(since int) {
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+
+	cfg, err := cm.Config()
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+
+	cmdutil.Info("starting to backup ledger, please wait...")
+	ledgerService := chain.NewLedgerService(cm.ConfigFile)
+	defer ledger.CloseLedger()
+
+	r, err := ledgerService.Ledger.Action(storage.Backup, since)
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+
+	cmdutil.Info("finished to backup ledger to", r)
+}
+
+func restoreAction(upto int) {
+	if upto < 0 {
+		cmdutil.Warn("invalid upto value")
+		return
+	}
+
+	chainContext := context.NewChainContext(cfgPathP)
+	cm, err := chainContext.ConfigManager()
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+
+	cfg, err := cm.Config()
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+
+	cmdutil.Info("ConfigFile", cm.ConfigFile)
+	cmdutil.Info("DataDir", cfg.DataDir)
+	cmdutil.Info("BackupDir", cfg.BackupDir())
+
+	cmdutil.Info("starting to restore ledger, please wait...")
+	dir, err := ledger.RestoreLedger(cfg.LedgerDir(), cfg.BackupDir(), uint64(upto))
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+
+	cmdutil.Info("finished to restore ledger to", dir, ", it will replace the ledger at next start")
+}
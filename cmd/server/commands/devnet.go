@@ -0,0 +1,503 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain"
+	"github.com/qlcchain/go-qlc/chain/context"
+	cmdutil "github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	devnetManifestFile = "devnet.json"
+	// devnetSeed is used when no genesis seed is given, so the genesis and representatives are the same in every devnet
+	devnetSeed         = "9bd19fc6c51b7e197f754fa19989bc12caec96861ea6e944ada5219a53e79a15"
+	devnetMaxNodes     = 32
+	devnetPortsPerNode = 10
+	// genesis accounts are the first two accounts of seed, representatives start from the third one
+	devnetRepIndex = 2
+)
+
+// devnetManifest describes the nodes generated by `devnet init`, it is saved in the devnet dir
+type devnetManifest struct {
+	Seed  string        `json:"seed"`
+	Nodes []*devnetNode `json:"nodes"`
+}
+
+type devnetNode struct {
+	ConfigFile     string        `json:"configFile"`
+	PeerID         string        `json:"peerId"`
+	Representative types.Address `json:"representative"`
+	PrivateKey     string        `json:"privateKey"`
+	RPCEndpoint    string        `json:"rpcEndpoint"`
+}
+
+func devnet() {
+	dir := cmdutil.Flag{
+		Name:  "dir",
+		Must:  false,
+		Usage: "devnet dir, every node is in its own sub dir",
+		Value: defaultDevnetDir(),
+	}
+	if interactive {
+		nodes := cmdutil.Flag{
+			Name:  "nodes",
+			Must:  false,
+			Usage: "count of nodes",
+			Value: 4,
+		}
+		basePort := cmdutil.Flag{
+			Name:  "basePort",
+			Must:  false,
+			Usage: "first port of nodes, every node uses 10 ports from base port",
+			Value: 19734,
+		}
+		process := cmdutil.Flag{
+			Name:  "process",
+			Must:  false,
+			Usage: "run every node in a child process",
+			Value: false,
+		}
+		devnetCmd := &ishell.Cmd{
+			Name: "devnet",
+			Help: "local multi-node network commands",
+			Func: func(c *ishell.Context) {
+				c.Println(c.Cmd.HelpText())
+			},
+		}
+		shell.AddCmd(devnetCmd)
+
+		initArgs := []cmdutil.Flag{dir, nodes, basePort, genesisSeed}
+		devnetCmd.AddCmd(&ishell.Cmd{
+			Name:                "init",
+			Help:                "generate node configs, genesis and funded representatives of devnet",
+			CompleterWithPrefix: cmdutil.OptsCompleter(initArgs),
+			Func: func(c *ishell.Context) {
+				if cmdutil.HelpText(c, initArgs) {
+					return
+				}
+				if err := cmdutil.CheckArgs(c, initArgs); err != nil {
+					cmdutil.Warn(err)
+					return
+				}
+				dirP := cmdutil.StringVar(c.Args, dir)
+				nodesP, _ := cmdutil.IntVar(c.Args, nodes)
+				basePortP, _ := cmdutil.IntVar(c.Args, basePort)
+				seedP := cmdutil.StringVar(c.Args, genesisSeed)
+				devnetInitAction(dirP, nodesP, basePortP, seedP)
+			},
+		})
+
+		startArgs := []cmdutil.Flag{dir, process}
+		devnetCmd.AddCmd(&ishell.Cmd{
+			Name:                "start",
+			Help:                "start all nodes of devnet",
+			CompleterWithPrefix: cmdutil.OptsCompleter(startArgs),
+			Func: func(c *ishell.Context) {
+				if cmdutil.HelpText(c, startArgs) {
+					return
+				}
+				if err := cmdutil.CheckArgs(c, startArgs); err != nil {
+					cmdutil.Warn(err)
+					return
+				}
+				dirP := cmdutil.StringVar(c.Args, dir)
+				processP := cmdutil.BoolVar(c.Args, process)
+				if err := devnetStart(dirP, processP); err != nil {
+					cmdutil.Warn(err)
+				}
+			},
+		})
+	} else {
+		devnetCmd := &cobra.Command{
+			Use:   "devnet",
+			Short: "local multi-node network commands",
+			Run: func(cmd *cobra.Command, args []string) {
+			},
+		}
+		rootCmd.AddCommand(devnetCmd)
+
+		var dirP string
+		var nodesP, basePortP int
+		initCmd := &cobra.Command{
+			Use:   "init",
+			Short: "generate node configs, genesis and funded representatives of devnet",
+			Run: func(cmd *cobra.Command, args []string) {
+				devnetInitAction(dirP, nodesP, basePortP, genesisSeedP)
+			},
+		}
+		initCmd.Flags().StringVar(&dirP, dir.Name, dir.Value.(string), dir.Usage)
+		initCmd.Flags().IntVar(&nodesP, "nodes", 4, "count of nodes")
+		initCmd.Flags().IntVar(&basePortP, "basePort", 19734, "first port of nodes, every node uses 10 ports from base port")
+		devnetCmd.AddCommand(initCmd)
+
+		var startDirP string
+		var processP bool
+		startCmd := &cobra.Command{
+			Use:   "start",
+			Short: "start all nodes of devnet",
+			Run: func(cmd *cobra.Command, args []string) {
+				if err := devnetStart(startDirP, processP); err != nil {
+					cmd.PrintErr(err)
+				}
+			},
+		}
+		startCmd.Flags().StringVar(&startDirP, dir.Name, dir.Value.(string), dir.Usage)
+		startCmd.Flags().BoolVar(&processP, "process", false, "run every node in a child process")
+		devnetCmd.AddCommand(startCmd)
+	}
+}
+
+func defaultDevnetDir() string {
+	return filepath.Join(config.DefaultDataDir(), "devnet")
+}
+
+func devnetInitAction(dir string, nodes, basePort int, seed string) {
+	cmdutil.Info("starting to generate devnet, please wait...")
+	m, err := devnetInit(dir, nodes, basePort, seed)
+	if err != nil {
+		cmdutil.Warn(err)
+		return
+	}
+	for i, n := range m.Nodes {
+		cmdutil.Info(fmt.Sprintf("node%d", i), n.ConfigFile, n.Representative.String(), n.RPCEndpoint)
+	}
+	cmdutil.Info("finished to generate devnet to", dir)
+}
+
+// devnetInit generates configs of nodes with fresh p2p identities and a custom genesis from seed,
+// then funds one representative for every node by the same blocks, so all nodes start from the same ledger
+func devnetInit(dir string, nodes, basePort int, seed string) (*devnetManifest, error) {
+	if nodes < 1 || nodes > devnetMaxNodes {
+		return nil, fmt.Errorf("nodes should be between 1 and %d", devnetMaxNodes)
+	}
+	if basePort < 1024 || basePort+nodes*devnetPortsPerNode > 65535 {
+		return nil, errors.New("invalid base port")
+	}
+	if seed == "" {
+		seed = devnetSeed
+	}
+	b, err := hex.DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid seed, %s", err)
+	}
+	s, err := types.BytesToSeed(b)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, devnetManifestFile)); err == nil {
+		return nil, fmt.Errorf("devnet already exists in %s", dir)
+	}
+
+	genesis := new(config.Config)
+	genesis.Genesis = &config.Genesis{GenesisBlocks: []*config.GenesisInfo{}}
+	if err := generateChainTokenGenesisBlock(seed, genesis); err != nil {
+		return nil, err
+	}
+	if err := generateGasTokenGenesisBlock(seed, genesis); err != nil {
+		return nil, err
+	}
+
+	reps := make([]*types.Account, 0, nodes)
+	for i := 0; i < nodes; i++ {
+		rep, err := s.Account(uint32(devnetRepIndex + i))
+		if err != nil {
+			return nil, err
+		}
+		reps = append(reps, rep)
+	}
+	blocks, err := devnetFundBlocks(s, genesis.Genesis.GenesisBlocks, reps)
+	if err != nil {
+		return nil, err
+	}
+
+	bootNode := fmt.Sprintf("127.0.0.1:%d", basePort+5)
+	m := &devnetManifest{Seed: seed}
+	for i := 0; i < nodes; i++ {
+		port := basePort + i*devnetPortsPerNode
+		cm := config.NewCfgManager(filepath.Join(dir, fmt.Sprintf("node%d", i)))
+		cfg, err := cm.Config()
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Genesis.GenesisBlocks = genesis.Genesis.GenesisBlocks
+		cfg.AutoGenerateReceive = true
+		cfg.Devnet.Enable = true
+
+		cfg.P2P.Listen = fmt.Sprintf("/ip4/127.0.0.1/tcp/%d", port)
+		cfg.P2P.ListeningIp = "127.0.0.1"
+		cfg.P2P.IsBootNode = i == 0
+		cfg.P2P.BootNodeHttpServer = bootNode
+		cfg.P2P.BootNodes = []string{fmt.Sprintf("http://%s/bootNode", bootNode)}
+		cfg.P2P.Discovery.MDNSEnabled = false
+		cfg.P2P.Discovery.DiscoveryInterval = 3
+
+		cfg.RPC.Enable = true
+		cfg.RPC.HTTPEndpoint = fmt.Sprintf("tcp4://127.0.0.1:%d", port+1)
+		cfg.RPC.WSEndpoint = fmt.Sprintf("tcp4://127.0.0.1:%d", port+2)
+		cfg.RPC.GRPCConfig.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", port+3)
+		cfg.RPC.GRPCConfig.HTTPListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", port+4)
+
+		// only the first node mines, so the pov chain of devnet has no forks
+		cfg.PoV.PovEnabled = true
+		cfg.PoV.MinerEnabled = i == 0
+		cfg.PoV.Coinbase = reps[0].Address().String()
+		cfg.PoV.AlgoName = types.ALGO_SHA256D.String()
+		cfg.PoV.ChainParams.MinerPledge = types.ZeroBalance
+
+		if err := cm.Save(); err != nil {
+			return nil, err
+		}
+		if err := devnetFund(cm.ConfigFile, blocks); err != nil {
+			return nil, fmt.Errorf("node%d: %s", i, err)
+		}
+
+		m.Nodes = append(m.Nodes, &devnetNode{
+			ConfigFile:     cm.ConfigFile,
+			PeerID:         cfg.P2P.ID.PeerID,
+			Representative: reps[i].Address(),
+			PrivateKey:     hex.EncodeToString(reps[i].PrivateKey()),
+			RPCEndpoint:    cfg.RPC.HTTPEndpoint,
+		})
+	}
+
+	data, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, devnetManifestFile), data, 0600); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// devnetFundBlocks returns blocks sending all chain tokens and gas tokens of genesis accounts to representatives
+// equally, and the open blocks of representatives. Timestamps are fixed, so the blocks are same for the same seed.
+func devnetFundBlocks(s *types.Seed, infos []*config.GenesisInfo, reps []*types.Account) ([]*types.StateBlock, error) {
+	var blocks []*types.StateBlock
+	timestamp := time.Unix(1573208071, 0).Add(time.Minute).Unix()
+	for _, info := range infos {
+		var from *types.Account
+		var err error
+		if info.ChainToken {
+			from, err = s.Account(0)
+		} else {
+			from, err = s.Account(1)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if from.Address() != info.Genesis.Address {
+			return nil, errors.New("genesis is not generated by seed")
+		}
+
+		previous := info.Genesis.GetHash()
+		balance := info.Genesis.Balance
+		amount, _ := balance.Div(int64(len(reps)))
+		for i, rep := range reps {
+			if i == len(reps)-1 {
+				amount = balance
+			}
+			balance = balance.Sub(amount)
+			send := &types.StateBlock{
+				Type:           types.Send,
+				Token:          info.Genesis.Token,
+				Address:        from.Address(),
+				Balance:        balance,
+				Vote:           types.ZeroBalance,
+				Network:        types.ZeroBalance,
+				Storage:        types.ZeroBalance,
+				Oracle:         types.ZeroBalance,
+				Previous:       previous,
+				Link:           types.Hash(rep.Address()),
+				Representative: info.Genesis.Representative,
+				Timestamp:      timestamp,
+			}
+			timestamp++
+			signBlock(send, from)
+			previous = send.GetHash()
+
+			open := &types.StateBlock{
+				Type:           types.Open,
+				Token:          info.Genesis.Token,
+				Address:        rep.Address(),
+				Balance:        amount,
+				Vote:           types.ZeroBalance,
+				Network:        types.ZeroBalance,
+				Storage:        types.ZeroBalance,
+				Oracle:         types.ZeroBalance,
+				Previous:       types.ZeroHash,
+				Link:           send.GetHash(),
+				Representative: rep.Address(),
+				Timestamp:      timestamp,
+			}
+			timestamp++
+			signBlock(open, rep)
+			blocks = append(blocks, send, open)
+		}
+	}
+	return blocks, nil
+}
+
+func signBlock(blk *types.StateBlock, account *types.Account) {
+	var w types.Work
+	worker, _ := types.NewWorker(w, blk.Root())
+	blk.Work = worker.NewWork()
+	blk.Signature = account.Sign(blk.GetHash())
+}
+
+// devnetFund saves genesis and funding blocks to the ledger of node
+func devnetFund(cfgFile string, blocks []*types.StateBlock) error {
+	chainContext := context.NewChainContext(cfgFile)
+	defer func() {
+		_ = chainContext.Destroy()
+	}()
+	if _, err := chainContext.Config(); err != nil {
+		return err
+	}
+
+	ledgerService := chain.NewLedgerService(cfgFile)
+	defer ledger.CloseLedger()
+	if err := ledgerService.Init(); err != nil {
+		return err
+	}
+	verifier := process.NewLedgerVerifier(ledgerService.Ledger)
+	for _, blk := range blocks {
+		if r, err := verifier.Process(blk); r != process.Progress {
+			return fmt.Errorf("process block %s: %s, %v", blk.GetHash(), r, err)
+		}
+	}
+	return ledgerService.Ledger.Flush()
+}
+
+func readDevnetManifest(dir string) (*devnetManifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, devnetManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("read devnet manifest, %s, please run `devnet init` first", err)
+	}
+	m := new(devnetManifest)
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	if len(m.Nodes) == 0 {
+		return nil, errors.New("no nodes in devnet")
+	}
+	return m, nil
+}
+
+// devnetStart starts all nodes of devnet in this process or in child processes, and stops them at interrupt
+func devnetStart(dir string, childProcess bool) error {
+	m, err := readDevnetManifest(dir)
+	if err != nil {
+		return err
+	}
+	if childProcess {
+		return devnetStartProcesses(m)
+	}
+
+	contexts := make([]*context.ChainContext, 0, len(m.Nodes))
+	defer func() {
+		for _, cc := range contexts {
+			if err := cc.Stop(); err != nil {
+				log.Root.Info(err)
+			}
+		}
+		log.Root.Info("devnet closed successfully")
+	}()
+	for i, n := range m.Nodes {
+		key, err := hex.DecodeString(n.PrivateKey)
+		if err != nil {
+			return err
+		}
+		cc := context.NewChainContext(n.ConfigFile)
+		cc.SetAccounts([]*types.Account{types.NewAccount(key)})
+		if err := cc.Init(func() error {
+			return chain.RegisterServices(cc)
+		}); err != nil {
+			return fmt.Errorf("init node%d: %s", i, err)
+		}
+		if len(m.Nodes) == 1 {
+			// there is no peer to sync with
+			cc.EventBus().Publish(topic.EventPovSyncState, topic.SyncDone)
+			cc.EventBus().Publish(topic.EventAddP2PStream, &topic.EventAddP2PStreamMsg{PeerID: n.PeerID})
+		}
+		if err := cc.Start(); err != nil {
+			return fmt.Errorf("start node%d: %s", i, err)
+		}
+		contexts = append(contexts, cc)
+		log.Root.Infof("devnet node%d started, rep: %s, rpc: %s", i, n.Representative, n.RPCEndpoint)
+	}
+
+	waitSignal()
+	return nil
+}
+
+func devnetStartProcesses(m *devnetManifest) error {
+	cmds := make([]*exec.Cmd, 0, len(m.Nodes))
+	defer func() {
+		for _, c := range cmds {
+			_ = c.Process.Signal(os.Interrupt)
+		}
+		for _, c := range cmds {
+			_ = c.Wait()
+		}
+		log.Root.Info("devnet closed successfully")
+	}()
+	for i, n := range m.Nodes {
+		args := []string{"--config", n.ConfigFile, "--privateKey", n.PrivateKey}
+		if len(m.Nodes) == 1 {
+			args = append(args, "--single")
+		}
+		out, err := os.OpenFile(filepath.Join(filepath.Dir(n.ConfigFile), "devnet.log"),
+			os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+
+		c := exec.Command(os.Args[0], args...)
+		c.Stdout = out
+		c.Stderr = out
+		if err := c.Start(); err != nil {
+			return fmt.Errorf("start node%d: %s", i, err)
+		}
+		cmds = append(cmds, c)
+		log.Root.Infof("devnet node%d started, pid: %d, rep: %s, rpc: %s", i, c.Process.Pid, n.Representative, n.RPCEndpoint)
+	}
+
+	waitSignal()
+	return nil
+}
+
+func waitSignal() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	<-c
+}
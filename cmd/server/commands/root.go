@@ -127,6 +127,8 @@ func addCommand() {
 	chainVersion()
 	removeDB()
 	purgePov()
+	backupLedger()
+	devnet()
 }
 
 func start() error {
@@ -209,8 +211,13 @@ func start() error {
 		}()
 
 		if b, err := session.VerifyPassword(passwordP); b && err == nil {
-			bytes, err := session.GetSeed()
-			tmp, err := seedToAccounts(bytes)
+			var tmp []*types.Account
+			if session.IsHD() {
+				tmp, err = session.HDRawKeys()
+			} else {
+				bytes, _ := session.GetSeed()
+				tmp, err = seedToAccounts(bytes)
+			}
 			if err != nil {
 				return err
 			}
@@ -22,6 +22,7 @@ func addWalletCmd() {
 			},
 		}
 		shell.AddCmd(walletCmd)
+		addCreateWalletCmdByShell(walletCmd)
 		addImportWalletCmdByShell(walletCmd)
 	} else {
 		walletCmd := &cobra.Command{
@@ -31,6 +32,7 @@ func addWalletCmd() {
 			},
 		}
 		rootCmd.AddCommand(walletCmd)
+		addCreateWalletCmdByCobra(walletCmd)
 		addImportWalletCmdByCobra(walletCmd)
 	}
 }
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/abiosoft/ishell"
+	"github.com/spf13/cobra"
+
+	"github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/cmd/util"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/wallet"
+)
+
+func addCreateWalletCmdByShell(parentCmd *ishell.Cmd) {
+	passphrase := util.Flag{
+		Name:  "passphrase",
+		Must:  false,
+		Usage: "BIP39 passphrase of mnemonic",
+		Value: "",
+	}
+	args := []util.Flag{passphrase, password, cfgPath}
+	s := &ishell.Cmd{
+		Name:                "create",
+		Help:                "create a HD wallet with new mnemonic",
+		CompleterWithPrefix: util.OptsCompleter(args),
+		Func: func(c *ishell.Context) {
+			if util.HelpText(c, args) {
+				return
+			}
+			if err := util.CheckArgs(c, args); err != nil {
+				util.Warn(err)
+				return
+			}
+			passphraseP := util.StringVar(c.Args, passphrase)
+			passwordP = util.StringVar(c.Args, password)
+			cfgPathP = util.StringVar(c.Args, cfgPath)
+			if err := createHDWallet(passphraseP); err != nil {
+				util.Warn(err)
+				return
+			}
+		},
+	}
+	parentCmd.AddCmd(s)
+}
+
+func addCreateWalletCmdByCobra(parentCmd *cobra.Command) {
+	var passphraseP string
+	wcCmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a HD wallet with new mnemonic",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := createHDWallet(passphraseP); err != nil {
+				cmd.PrintErr(err)
+				return
+			}
+		},
+	}
+	wcCmd.Flags().StringVar(&passphraseP, "passphrase", "", "BIP39 passphrase of mnemonic")
+	parentCmd.AddCommand(wcCmd)
+}
+
+func createHDWallet(passphraseP string) error {
+	chain := context.NewChainContext(cfgPathP)
+	defer func() {
+		if chain != nil {
+			_ = chain.Destroy()
+			ledger.CloseLedger()
+		}
+	}()
+	cm, err := chain.ConfigManager()
+	if err != nil {
+		return err
+	}
+	w := wallet.NewWalletStore(cm.ConfigFile)
+	defer func() {
+		if w != nil {
+			_ = w.Close()
+		}
+	}()
+
+	addr, mnemonic, err := w.NewHDWallet(passphraseP, passwordP)
+	if err != nil {
+		return err
+	}
+
+	s := fmt.Sprintf("create wallet => %s success, write down the mnemonic and keep it safe:\n%s", addr.String(), mnemonic)
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	return nil
+}
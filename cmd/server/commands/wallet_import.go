@@ -24,14 +24,32 @@ import (
 func addImportWalletCmdByShell(parentCmd *ishell.Cmd) {
 	seed := util.Flag{
 		Name:  "seed",
-		Must:  true,
+		Must:  false,
 		Usage: "seed for a wallet",
 		Value: "",
 	}
-	args := []util.Flag{seed, password, cfgPath}
+	mnemonic := util.Flag{
+		Name:  "mnemonic",
+		Must:  false,
+		Usage: "BIP39 mnemonic for a HD wallet",
+		Value: "",
+	}
+	passphrase := util.Flag{
+		Name:  "passphrase",
+		Must:  false,
+		Usage: "BIP39 passphrase of mnemonic",
+		Value: "",
+	}
+	gap := util.Flag{
+		Name:  "gap",
+		Must:  false,
+		Usage: "count of consecutive unused accounts to stop account discovery",
+		Value: wallet.DefaultGapLimit,
+	}
+	args := []util.Flag{seed, mnemonic, passphrase, gap, password, cfgPath}
 	s := &ishell.Cmd{
 		Name:                "import",
-		Help:                "import a wallet",
+		Help:                "import a wallet by seed or mnemonic",
 		CompleterWithPrefix: util.OptsCompleter(args),
 		Func: func(c *ishell.Context) {
 			if util.HelpText(c, args) {
@@ -42,10 +60,20 @@ func addImportWalletCmdByShell(parentCmd *ishell.Cmd) {
 				return
 			}
 			seedP = util.StringVar(c.Args, seed)
+			mnemonicP := util.StringVar(c.Args, mnemonic)
+			passphraseP := util.StringVar(c.Args, passphrase)
+			gapP, err := util.IntVar(c.Args, gap)
+			if err != nil {
+				util.Warn(err)
+				return
+			}
 			passwordP = util.StringVar(c.Args, password)
 			cfgPathP = util.StringVar(c.Args, cfgPath)
-			//if passwordP = ""
-			err := importWallet(seedP)
+			if mnemonicP != "" {
+				err = importHDWallet(mnemonicP, passphraseP, gapP)
+			} else {
+				err = importWallet(seedP)
+			}
 			if err != nil {
 				util.Warn(err)
 				return
@@ -56,11 +84,19 @@ func addImportWalletCmdByShell(parentCmd *ishell.Cmd) {
 }
 
 func addImportWalletCmdByCobra(parentCmd *cobra.Command) {
+	var mnemonicP string
+	var passphraseP string
+	var gapP int
 	wiCmd := &cobra.Command{
 		Use:   "import",
-		Short: "import a wallet",
+		Short: "import a wallet by seed or mnemonic",
 		Run: func(cmd *cobra.Command, args []string) {
-			err := importWallet(seedP)
+			var err error
+			if mnemonicP != "" {
+				err = importHDWallet(mnemonicP, passphraseP, gapP)
+			} else {
+				err = importWallet(seedP)
+			}
 			if err != nil {
 				cmd.PrintErr(err)
 				return
@@ -68,6 +104,9 @@ func addImportWalletCmdByCobra(parentCmd *cobra.Command) {
 		},
 	}
 	wiCmd.Flags().StringVarP(&seedP, "seed", "s", "", "seed for a wallet")
+	wiCmd.Flags().StringVarP(&mnemonicP, "mnemonic", "m", "", "BIP39 mnemonic for a HD wallet")
+	wiCmd.Flags().StringVar(&passphraseP, "passphrase", "", "BIP39 passphrase of mnemonic")
+	wiCmd.Flags().IntVar(&gapP, "gap", wallet.DefaultGapLimit, "count of consecutive unused accounts to stop account discovery")
 	parentCmd.AddCommand(wiCmd)
 }
 
@@ -83,7 +122,7 @@ func importWallet(seedP string) error {
 	if err != nil {
 		return err
 	}
-	if len(seedP) != types.SeedSize {
+	if len(seedP) != types.SeedSize*2 {
 		return errors.New("invalid seed")
 	}
 	w := wallet.NewWalletStore(cm.ConfigFile)
@@ -106,3 +145,70 @@ func importWallet(seedP string) error {
 	}
 	return nil
 }
+
+// importHDWallet imports wallet by mnemonic and discovers used accounts in local ledger
+func importHDWallet(mnemonicP, passphraseP string, gapP int) error {
+	chain := context.NewChainContext(cfgPathP)
+	defer func() {
+		if chain != nil {
+			_ = chain.Destroy()
+			ledger.CloseLedger()
+		}
+	}()
+	cm, err := chain.ConfigManager()
+	if err != nil {
+		return err
+	}
+	w := wallet.NewWalletStore(cm.ConfigFile)
+	defer func() {
+		if w != nil {
+			_ = w.Close()
+		}
+	}()
+
+	addr, err := w.NewWalletByMnemonic(mnemonicP, passphraseP, passwordP)
+	if err != nil {
+		return err
+	}
+
+	l := ledger.NewLedger(cm.ConfigFile)
+	session := w.NewSession(addr)
+	if _, err := session.VerifyPassword(passwordP); err != nil {
+		return err
+	}
+	found, err := session.Discover(gapP, func(address types.Address) (bool, error) {
+		return isAccountUsed(l, address)
+	})
+	if err != nil {
+		return err
+	}
+
+	s := fmt.Sprintf("import mnemonic => %s success, %d used accounts discovered", addr.String(), len(found))
+	if interactive {
+		util.Info(s)
+	} else {
+		fmt.Println(s)
+	}
+	for _, a := range found {
+		s := fmt.Sprintf("%s %s %s", a.Name, a.Path, a.Address)
+		if interactive {
+			util.Info(s)
+		} else {
+			fmt.Println(s)
+		}
+	}
+	return nil
+}
+
+// isAccountUsed checks the account has been opened or has pending receives
+func isAccountUsed(l *ledger.Ledger, address types.Address) (bool, error) {
+	if b, err := l.HasAccountMetaConfirmed(address); err != nil || b {
+		return b, err
+	}
+	used := false
+	err := l.GetPendingsByAddress(address, func(key *types.PendingKey, value *types.PendingInfo) error {
+		used = true
+		return nil
+	})
+	return used, err
+}
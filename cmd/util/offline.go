@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// OfflineVersion is the version of offline block file format
+const OfflineVersion = 1
+
+// OfflineBlock is an unsigned block exported by an online node, with the chain context it was built on
+type OfflineBlock struct {
+	Method    string            `json:"method"`
+	Block     *types.StateBlock `json:"block"`
+	Hash      types.Hash        `json:"hash"`
+	Frontier  types.Hash        `json:"frontier"`
+	PovHeight uint64            `json:"povHeight"`
+	PovHash   types.Hash        `json:"povHash"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// OfflineBlocks is the content of offline block file, blocks are broadcast in order
+type OfflineBlocks struct {
+	Version int             `json:"version"`
+	Blocks  []*OfflineBlock `json:"blocks"`
+}
+
+func NewOfflineBlocks() *OfflineBlocks {
+	return &OfflineBlocks{
+		Version: OfflineVersion,
+		Blocks:  make([]*OfflineBlock, 0),
+	}
+}
+
+// ReadOfflineBlocks loads offline blocks from file, a non-existent file is an empty set
+func ReadOfflineBlocks(path string) (*OfflineBlocks, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return NewOfflineBlocks(), nil
+		}
+		return nil, err
+	}
+	blocks := new(OfflineBlocks)
+	if err := json.Unmarshal(data, blocks); err != nil {
+		return nil, err
+	}
+	if blocks.Version != OfflineVersion {
+		return nil, fmt.Errorf("unsupported offline block version %d", blocks.Version)
+	}
+	return blocks, nil
+}
+
+func (o *OfflineBlocks) Write(path string) error {
+	data, err := json.MarshalIndent(o, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+func (o *OfflineBlocks) Add(blk *OfflineBlock) error {
+	for _, b := range o.Blocks {
+		if b.Hash == blk.Hash {
+			return fmt.Errorf("block %s already exists", blk.Hash)
+		}
+		if b.Block.Address == blk.Block.Address && b.Block.Token == blk.Block.Token && b.Block.Previous == blk.Block.Previous {
+			return fmt.Errorf("block %s has the same previous with %s, broadcast it first", blk.Hash, b.Hash)
+		}
+	}
+	o.Blocks = append(o.Blocks, blk)
+	return nil
+}
+
+// Pending returns the last block of the token chain of address in the file which follows frontier,
+// these blocks are not broadcast yet. It returns nil if no block is built on frontier.
+func (o *OfflineBlocks) Pending(address types.Address, token types.Hash, frontier types.Hash) *OfflineBlock {
+	var last *OfflineBlock
+	for found := true; found; {
+		found = false
+		for _, b := range o.Blocks {
+			if b.Block.Address == address && b.Block.Token == token && b.Frontier == frontier {
+				last, frontier, found = b, b.Hash, true
+				break
+			}
+		}
+	}
+	return last
+}
+
+// Sign signs all blocks of the account and computes their work, returns the count of signed blocks
+func (o *OfflineBlocks) Sign(account *types.Account) (int, error) {
+	count := 0
+	for _, b := range o.Blocks {
+		if b.Block.Address != account.Address() {
+			continue
+		}
+		if err := b.Sign(account); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// ChainTo rebases the block built on the online frontier, whose balance is given, to the pending block,
+// so several blocks of the same token chain can be signed and broadcast in order
+func (b *OfflineBlock) ChainTo(pending *OfflineBlock, balance types.Balance) error {
+	blk, p := b.Block, pending.Block
+	switch blk.Type {
+	case types.Send:
+		amount := balance.Sub(blk.Balance)
+		if p.Balance.Compare(amount) == types.BalanceCompSmaller {
+			return fmt.Errorf("balance %s of pending block %s is not enough to send %s", p.Balance, pending.Hash, amount)
+		}
+		blk.Balance = p.Balance.Sub(amount)
+		blk.Representative = p.Representative
+	case types.Receive, types.Open:
+		blk.Type = types.Receive
+		blk.Balance = p.Balance.Add(blk.Balance.Sub(balance))
+		blk.Representative = p.Representative
+	case types.Change:
+		blk.Balance = p.Balance
+	default:
+		return fmt.Errorf("%s block can not follow pending block %s, broadcast it first", blk.Type, pending.Hash)
+	}
+	blk.Previous = pending.Hash
+	blk.Vote = p.Vote
+	blk.Network = p.Network
+	blk.Oracle = p.Oracle
+	blk.Storage = p.Storage
+	b.Frontier = pending.Hash
+	b.Hash = blk.GetHash()
+	return nil
+}
+
+func (b *OfflineBlock) check() error {
+	if b.Block == nil {
+		return errors.New("offline block is empty")
+	}
+	if h := b.Block.GetHash(); h != b.Hash {
+		return fmt.Errorf("block hash mismatch, exp: %s, act: %s", b.Hash, h)
+	}
+	if !b.Block.IsOpen() && b.Block.Previous != b.Frontier {
+		return fmt.Errorf("block %s previous %s is not frontier %s", b.Hash, b.Block.Previous, b.Frontier)
+	}
+	return nil
+}
+
+func (b *OfflineBlock) Sign(account *types.Account) error {
+	if err := b.check(); err != nil {
+		return err
+	}
+	if b.Block.Address != account.Address() {
+		return fmt.Errorf("block %s belongs to %s, not %s", b.Hash, b.Block.Address, account.Address())
+	}
+	b.Block.Signature = account.Sign(b.Hash)
+	if !b.Block.IsValid() {
+		var w types.Work
+		worker, err := types.NewWorker(w, b.Block.Root())
+		if err != nil {
+			return err
+		}
+		b.Block.Work = worker.NewWork()
+	}
+	return nil
+}
+
+func (b *OfflineBlock) IsSigned() bool {
+	return b.Block != nil && !b.Block.Signature.IsZero()
+}
+
+// Verify checks the block is signed by its owner and has valid work
+func (b *OfflineBlock) Verify() error {
+	if err := b.check(); err != nil {
+		return err
+	}
+	if !b.IsSigned() {
+		return fmt.Errorf("block %s is not signed", b.Hash)
+	}
+	if !b.Block.Address.Verify(b.Hash.Bytes(), b.Block.Signature.Bytes()) {
+		return fmt.Errorf("invalid signature of block %s", b.Hash)
+	}
+	if !b.Block.IsValid() {
+		return fmt.Errorf("invalid work of block %s", b.Hash)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func newTestOfflineBlock(account *types.Account) *OfflineBlock {
+	blk := mock.StateBlockWithoutWork()
+	blk.Address = account.Address()
+	blk.Signature = types.ZeroSignature
+	blk.Work = 0
+	return &OfflineBlock{
+		Method:   "ledger_generateSendBlock",
+		Block:    blk,
+		Hash:     blk.GetHash(),
+		Frontier: blk.Previous,
+	}
+}
+
+func TestOfflineBlocks(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "offline", uuid.New().String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	path := filepath.Join(dir, "blocks.json")
+
+	blocks, err := ReadOfflineBlocks(path)
+	if err != nil || len(blocks.Blocks) != 0 {
+		t.Fatal(err)
+	}
+
+	a1 := mock.Account()
+	a2 := mock.Account()
+	b1 := newTestOfflineBlock(a1)
+	b2 := newTestOfflineBlock(a2)
+	if err := blocks.Add(b1); err != nil {
+		t.Fatal(err)
+	}
+	if err := blocks.Add(b2); err != nil {
+		t.Fatal(err)
+	}
+	if err := blocks.Add(b1); err == nil {
+		t.Fatal("duplicate block should be rejected")
+	}
+	if err := blocks.Write(path); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks, err = ReadOfflineBlocks(path)
+	if err != nil || len(blocks.Blocks) != 2 {
+		t.Fatal(err)
+	}
+	if err := blocks.Blocks[0].Verify(); err == nil {
+		t.Fatal("unsigned block should not be verified")
+	}
+
+	if count, err := blocks.Sign(a1); err != nil || count != 1 {
+		t.Fatal(err, count)
+	}
+	if err := blocks.Blocks[0].Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if blocks.Blocks[1].IsSigned() {
+		t.Fatal("block of other account should not be signed")
+	}
+	if err := blocks.Blocks[1].Sign(a1); err == nil {
+		t.Fatal("block should be signed by its owner")
+	}
+
+	// tampered block
+	blocks.Blocks[0].Block.Link = mock.Hash()
+	if err := blocks.Blocks[0].Verify(); err == nil {
+		t.Fatal("tampered block should not be verified")
+	}
+	if _, err := blocks.Sign(a1); err == nil {
+		t.Fatal("tampered block should not be signed")
+	}
+}
+
+func TestOfflineBlocks_Pending(t *testing.T) {
+	a := mock.Account()
+	frontier := mock.Hash()
+	newBlock := func(typ types.BlockType, previous types.Hash, balance int64) *OfflineBlock {
+		b := newTestOfflineBlock(a)
+		b.Block.Type = typ
+		b.Block.Previous = previous
+		b.Block.Balance = types.NewBalance(balance)
+		b.Hash = b.Block.GetHash()
+		b.Frontier = previous
+		return b
+	}
+
+	blocks := NewOfflineBlocks()
+	b1 := newBlock(types.Send, frontier, 70)
+	if err := blocks.Add(b1); err != nil {
+		t.Fatal(err)
+	}
+	if p := blocks.Pending(a.Address(), b1.Block.Token, b1.Hash); p != nil {
+		t.Fatal("no block follows the frontier", p)
+	}
+
+	// online balance is 100, both blocks send 30
+	b2 := newBlock(types.Send, frontier, 70)
+	b2.Block.Link = mock.Hash()
+	p := blocks.Pending(a.Address(), b2.Block.Token, frontier)
+	if p != b1 {
+		t.Fatal("pending block should be found", p)
+	}
+	if err := b2.ChainTo(p, types.NewBalance(100)); err != nil {
+		t.Fatal(err)
+	}
+	if b2.Block.Previous != b1.Hash || b2.Frontier != b1.Hash || b2.Hash != b2.Block.GetHash() || !b2.Block.Balance.Equal(types.NewBalance(40)) {
+		t.Fatal(b2.Block)
+	}
+	if err := blocks.Add(b2); err != nil {
+		t.Fatal(err)
+	}
+	if p := blocks.Pending(a.Address(), b2.Block.Token, frontier); p != b2 {
+		t.Fatal("last pending block should be found", p)
+	}
+
+	if err := newBlock(types.Send, frontier, 50).ChainTo(b2, types.NewBalance(100)); err == nil {
+		t.Fatal("send more than balance of pending block should be rejected")
+	}
+	if err := newBlock(types.ContractSend, frontier, 100).ChainTo(b2, types.NewBalance(100)); err == nil {
+		t.Fatal("contract block should not be chained")
+	}
+
+	b3 := newBlock(types.Open, types.ZeroHash, 20)
+	if err := b3.ChainTo(b2, types.ZeroBalance); err != nil {
+		t.Fatal(err)
+	}
+	if b3.Block.Type != types.Receive || b3.Block.Previous != b2.Hash || !b3.Block.Balance.Equal(types.NewBalance(60)) {
+		t.Fatal(b3.Block)
+	}
+}
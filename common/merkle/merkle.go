@@ -155,6 +155,30 @@ func BuildCoinbaseMerkleBranch(txHashes []*types.Hash) []*types.Hash {
 	return retBranches
 }
 
+// BuildMerkleBranch returns the sibling hashes from the leaf at index up to the root of
+// the tree built by BuildMerkleTreeStore, the leaf and the branch compute the root by
+// CalcMerkleRootByIndex. A missing right sibling is the node itself, as it is hashed
+// with itself when the tree is built.
+func BuildMerkleBranch(merkles []*types.Hash, index int) []*types.Hash {
+	branch := make([]*types.Hash, 0)
+	width := (len(merkles) + 1) / 2
+	if index < 0 || index >= width || merkles[index] == nil {
+		return nil
+	}
+
+	offset := 0
+	for ; width > 1; width /= 2 {
+		sibling := merkles[offset+(index^1)]
+		if sibling == nil {
+			sibling = merkles[offset+index]
+		}
+		branch = append(branch, sibling)
+		offset += width
+		index >>= 1
+	}
+	return branch
+}
+
 func CalcCoinbaseMerkleRoot(coinbaseHash *types.Hash, merkleBranch []*types.Hash) types.Hash {
 	hashMerkleRoot := coinbaseHash
 	for _, branchHash := range merkleBranch {
@@ -49,3 +49,33 @@ func TestCalcCoinbaseMerkleRoot_2(t *testing.T) {
 		t.Fatal("mr1 != mr2", mr1, mr2)
 	}
 }
+
+func TestBuildMerkleBranch(t *testing.T) {
+	for _, txNum := range []int{1, 2, 3, 5, 8, 13} {
+		txHashes := make([]*types.Hash, 0, txNum)
+		for txCnt := 0; txCnt < txNum; txCnt++ {
+			txData := make([]byte, 100)
+			_ = random.Bytes(txData)
+			txHash := types.Sha256DHashData(txData)
+			txHashes = append(txHashes, &txHash)
+		}
+
+		merkles := BuildMerkleTreeStore(txHashes)
+		root := *merkles[len(merkles)-1]
+		for i, txHash := range txHashes {
+			branch := BuildMerkleBranch(merkles, i)
+			if mr := CalcMerkleRootByIndex(*txHash, branch, i); mr != root {
+				t.Fatal("invalid branch", txNum, i, mr, root)
+			}
+			if i > 0 {
+				if mr := CalcMerkleRootByIndex(*txHash, branch, i-1); mr == root {
+					t.Fatal("branch should not match other index", txNum, i)
+				}
+			}
+		}
+
+		if branch := BuildMerkleBranch(merkles, len(txHashes)); branch != nil {
+			t.Fatal("branch of missing leaf should be nil", txNum)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package statedb
+
+import (
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+// GetAccountStateProof returns the merkle proof of account state against current state hash
+func (gsdb *PovGlobalStateDB) GetAccountStateProof(address types.Address) (*trie.Proof, error) {
+	return gsdb.getStateProof(PovCreateAccountStateKey(address))
+}
+
+// GetRepStateProof returns the merkle proof of rep state against current state hash
+func (gsdb *PovGlobalStateDB) GetRepStateProof(address types.Address) (*trie.Proof, error) {
+	return gsdb.getStateProof(PovCreateRepStateKey(address))
+}
+
+// GetContractStateProof returns the merkle proof of contract state against current state hash
+func (gsdb *PovGlobalStateDB) GetContractStateProof(address types.Address) (*trie.Proof, error) {
+	return gsdb.getStateProof(PovCreateContractStateKey(address))
+}
+
+func (gsdb *PovGlobalStateDB) getStateProof(key []byte) (*trie.Proof, error) {
+	if gsdb.curTrie == nil {
+		return nil, trie.ErrProofKeyNotFound
+	}
+	return gsdb.curTrie.Prove(key)
+}
+
+// VerifyPovAccountStateProof checks the proof of account state against the state hash in pov header
+func VerifyPovAccountStateProof(stateHash types.Hash, address types.Address, proof *trie.Proof) (*types.PovAccountState, error) {
+	val, err := trie.VerifyProof(stateHash, PovCreateAccountStateKey(address), proof)
+	if err != nil {
+		return nil, err
+	}
+	as := types.NewPovAccountState()
+	if err := as.Deserialize(val); err != nil {
+		return nil, fmt.Errorf("deserialize account state err %s", err)
+	}
+	if as.Account != address {
+		return nil, fmt.Errorf("account state address mismatch, expect %s, got %s", address, as.Account)
+	}
+	return as, nil
+}
+
+// VerifyPovRepStateProof checks the proof of rep state against the state hash in pov header
+func VerifyPovRepStateProof(stateHash types.Hash, address types.Address, proof *trie.Proof) (*types.PovRepState, error) {
+	val, err := trie.VerifyProof(stateHash, PovCreateRepStateKey(address), proof)
+	if err != nil {
+		return nil, err
+	}
+	rs := types.NewPovRepState()
+	if err := rs.Deserialize(val); err != nil {
+		return nil, fmt.Errorf("deserialize rep state err %s", err)
+	}
+	if rs.Account != address {
+		return nil, fmt.Errorf("rep state address mismatch, expect %s, got %s", address, rs.Account)
+	}
+	return rs, nil
+}
+
+// VerifyPovContractStateProof checks the proof of contract state against the state hash in pov header
+func VerifyPovContractStateProof(stateHash types.Hash, address types.Address, proof *trie.Proof) (*types.PovContractState, error) {
+	val, err := trie.VerifyProof(stateHash, PovCreateContractStateKey(address), proof)
+	if err != nil {
+		return nil, err
+	}
+	cs := types.NewPovContractState()
+	if err := cs.Deserialize(val); err != nil {
+		return nil, fmt.Errorf("deserialize contract state err %s", err)
+	}
+	return cs, nil
+}
@@ -0,0 +1,72 @@
+package statedb
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestPovStateDB_StateProof(t *testing.T) {
+	teardownTestCase, md := setupPovStateDBTestCase(t)
+	defer teardownTestCase(t)
+
+	gsdb := NewPovGlobalStateDB(md.l.DBStore(), types.ZeroHash)
+
+	ac1 := mock.Account()
+	as1 := types.NewPovAccountState()
+	as1.Balance = types.NewBalance(rand.Int63())
+	if err := gsdb.SetAccountState(ac1.Address(), as1); err != nil {
+		t.Fatal(err)
+	}
+	rs1 := types.NewPovRepState()
+	rs1.Balance = types.NewBalance(rand.Int63())
+	if err := gsdb.SetRepState(ac1.Address(), rs1); err != nil {
+		t.Fatal(err)
+	}
+	ac2 := mock.Account()
+	as2 := types.NewPovAccountState()
+	as2.Balance = types.NewBalance(rand.Int63())
+	if err := gsdb.SetAccountState(ac2.Address(), as2); err != nil {
+		t.Fatal(err)
+	}
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	stateHash := gsdb.GetCurHash()
+
+	proof, err := gsdb.GetAccountStateProof(ac1.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	retAs1, err := VerifyPovAccountStateProof(stateHash, ac1.Address(), proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retAs1.Balance.Compare(as1.Balance) != types.BalanceCompEqual {
+		t.Fatal("account state not equal", retAs1.Balance, as1.Balance)
+	}
+	if _, err := VerifyPovAccountStateProof(stateHash, ac2.Address(), proof); err == nil {
+		t.Fatal("proof should not be valid for other account")
+	}
+
+	proof, err = gsdb.GetRepStateProof(ac1.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	retRs1, err := VerifyPovRepStateProof(stateHash, ac1.Address(), proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retRs1.Balance.Compare(rs1.Balance) != types.BalanceCompEqual {
+		t.Fatal("rep state not equal", retRs1.Balance, rs1.Balance)
+	}
+
+	if _, err := gsdb.GetRepStateProof(ac2.Address()); err == nil {
+		t.Fatal("rep state of ac2 should not exist")
+	}
+	if _, err := gsdb.GetContractStateProof(ac1.Address()); err == nil {
+		t.Fatal("contract state of ac1 should not exist")
+	}
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/dgraph-io/badger/v2"
@@ -16,6 +17,8 @@ import (
 	"github.com/qlcchain/go-qlc/common/util"
 )
 
+const maxPendingWrites = 256
+
 type BadgerStore struct {
 	db *badger.DB
 }
@@ -243,7 +246,7 @@ func (b *BadgerStore) Close() error {
 	return b.db.Close()
 }
 
-func (b *BadgerStore) Action(at storage.ActionType) (interface{}, error) {
+func (b *BadgerStore) Action(at storage.ActionType, params ...interface{}) (interface{}, error) {
 	switch at {
 	case storage.GC:
 		err := b.db.RunValueLogGC(0.5)
@@ -257,6 +260,33 @@ func (b *BadgerStore) Action(at storage.ActionType) (interface{}, error) {
 		s["lsm"] = lsm
 		s["vlog"] = vlog
 		return s, nil
+	case storage.Backup:
+		// params: io.Writer, since version(uint64), returns the max version dumped
+		if len(params) != 2 {
+			return nil, errors.New("invalid backup params")
+		}
+		w, ok := params[0].(io.Writer)
+		if !ok {
+			return nil, errors.New("invalid backup writer")
+		}
+		since, ok := params[1].(uint64)
+		if !ok {
+			return nil, errors.New("invalid backup version")
+		}
+		return b.db.Backup(w, since)
+	case storage.Restore:
+		// params: io.Reader, the store should not be used by others during restoring
+		if len(params) != 1 {
+			return nil, errors.New("invalid restore params")
+		}
+		r, ok := params[0].(io.Reader)
+		if !ok {
+			return nil, errors.New("invalid restore reader")
+		}
+		if err := b.db.Load(r, maxPendingWrites); err != nil {
+			return nil, err
+		}
+		return nil, nil
 	default:
 		return "", errors.New("invalid action type")
 	}
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
@@ -274,3 +275,40 @@ func TestBadgerStore_Upgrade(t *testing.T) {
 	}
 
 }
+
+func TestBadgerStore_Backup(t *testing.T) {
+	teardownTestCase, db := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	if err := db.Put([]byte{1, 2, 3}, []byte{4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	r, err := db.Action(storage.Backup, buf, uint64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.(uint64) == 0 {
+		t.Fatal(r)
+	}
+	if _, err := db.Action(storage.Backup, buf); err == nil {
+		t.Fatal("invalid params should return error")
+	}
+
+	dir := filepath.Join(config.QlcTestDataDir(), "store", uuid.New().String())
+	db2, err := NewBadgerStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = db2.Close()
+		_ = os.RemoveAll(dir)
+	}()
+	if _, err := db2.Action(storage.Restore, buf); err != nil {
+		t.Fatal(err)
+	}
+	v, err := db2.Get([]byte{1, 2, 3})
+	if err != nil || !bytes.Equal(v, []byte{4, 5, 6}) {
+		t.Fatal(v, err)
+	}
+}
@@ -22,7 +22,7 @@ type Store interface {
 	Purge() error
 	Drop(prefix []byte) error
 	Upgrade(version int) error
-	Action(at ActionType) (interface{}, error)
+	Action(at ActionType, params ...interface{}) (interface{}, error)
 	Close() error
 }
 
@@ -85,6 +85,16 @@ const (
 	KeyPrefixPrivatePayload
 	KeyPrefixGapDoDSettleState
 	KeyPrefixGapPovHeight
+	KeyPrefixPeerBan      //prefix+peerID => peerBan
+	KeyPrefixBlockVmLogs  // prefix + blockHash => vmLogs emitted by the block
+	KeyPrefixVmLogIndex   // prefix + kind + [address|topic] + timestamp + blockHash => nil
+	KeyPrefixEquivocation // prefix + account + evidence key => equivocation evidence of representative
+	KeyPrefixHistoryIndex // prefix + kind + [address|token] + timestamp + blockHash => history entry
+	KeyPrefixFeeCredit    // prefix + account => QGAS fee credit
+	KeyPrefixCDRRecord    // prefix + contract + party + CDR hash => off-chain CDR record
+	KeyPrefixCDRPending   // prefix + contract + party + CDR hash => marker of records which are not batched
+	KeyPrefixCDRBatch     // prefix + contract + party + seq => off-chain CDR batch
+	KeyPrefixFeeReserve   // prefix + account + blockHash => fee of unconfirmed block reserved from fee credit
 
 	// Trie key space should be different
 	KeyPrefixTrieVMStorage = 100 // Deprecated vm_store.go, idPrefixStorage
@@ -73,6 +73,15 @@ type EventBandwidthStats struct {
 	TotalOut int64
 	RateIn   float64
 	RateOut  float64
+	// payload bytes of compressed p2p messages on wire and after decompression
+	CompressedIn    int64
+	CompressedOut   int64
+	UncompressedIn  int64
+	UncompressedOut int64
+	// inbound messages dropped by rate limits, and by full inbound queues
+	Throttled      int64
+	ThrottledBytes int64
+	Dropped        int64
 }
 
 type EventP2PConnectPeersMsg struct {
@@ -88,6 +97,12 @@ type EventBroadcastMsg struct {
 	Message interface{}
 }
 
+// EventPeerBanUpdateMsg notifies a manual ban of a peer, Ban is nil if the peer is unbanned
+type EventPeerBanUpdateMsg struct {
+	PeerID string
+	Ban    *types.PeerBan
+}
+
 const (
 	PermissionEventNodeUpdate uint8 = iota
 )
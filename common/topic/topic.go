@@ -37,6 +37,13 @@ const (
 	EventPovBulkPullRsp  TopicType = "povBulkPullRsp"
 	EventPovSyncState    TopicType = "povSyncState"
 
+	EventLightHeaderReq  TopicType = "lightHeaderReq"
+	EventLightHeaderRsp  TopicType = "lightHeaderRsp"
+	EventLightAccountReq TopicType = "lightAccountReq"
+	EventLightAccountRsp TopicType = "lightAccountRsp"
+	EventLightBlocksReq  TopicType = "lightBlocksReq"
+	EventLightBlocksRsp  TopicType = "lightBlocksRsp"
+
 	EventPovConnectBestBlock    TopicType = "povConnectBestBlock"
 	EventPovDisconnectBestBlock TopicType = "povDisconnectBestBlock"
 	EventRpcSyncCall            TopicType = "rpcSyncCall"
@@ -46,14 +53,21 @@ const (
 	EventSyncStateChange        TopicType = "syncStateChange"
 	EventConsensusSyncFinished  TopicType = "consensusSyncFinished"
 	EventRepresentativeNode     TopicType = "representativeNode"
+	EventRepEquivocation        TopicType = "repEquivocation"
 
 	EventAddBlockCache        TopicType = "addBlockCache"
 	EventPermissionNodeUpdate TopicType = "permissionNodeUpdate"
+	EventPeerBanUpdate        TopicType = "peerBanUpdate"
+	EventNewVmLogs            TopicType = "newVmLogs"
 
 	EventPrivacySendReq TopicType = "privacySendReq"
 	EventPrivacySendRsp TopicType = "privacySendRsp"
 	EventPrivacyRecvReq TopicType = "privacyRecvReq"
 	EventPrivacyRecvRsp TopicType = "privacyRecvRsp"
+
+	EventPrivacyPayloadPush TopicType = "privacyPayloadPush"
+	EventPrivacyPayloadReq  TopicType = "privacyPayloadReq"
+	EventPrivacyKeyAnnounce TopicType = "privacyKeyAnnounce"
 )
 
 // Sync state
@@ -40,6 +40,10 @@ type StateBlock struct {
 
 	Work      Work      `msg:"work,extension" json:"work"`
 	Signature Signature `msg:"signature,extension" json:"signature"`
+	// aggregated signatures of multisig account, the Signature is empty if it is set
+	MultiSig []byte `msg:"multiSig,omitempty" json:"multiSig,omitempty"`
+	// QGAS fee paid from the fee credit of the account, nil if no fee is paid
+	Fee *Balance `msg:"fee,extension" json:"fee,omitempty"`
 
 	// following fields just for cache, not marshaled in db or p2p message
 	Flag uint64 `msg:"-" json:"-"`
@@ -70,6 +74,9 @@ func (b *StateBlock) BuildHashData() []byte {
 	buf.Write(util.BE_Uint64ToBytes(b.PoVHeight))
 	buf.Write(b.Extra[:])
 	buf.Write(b.Representative[:])
+	if b.HasFee() {
+		buf.Write(b.Fee.Bytes())
+	}
 
 	// additional fields for private txs
 	if len(b.PrivateFrom) > 0 {
@@ -97,10 +104,14 @@ func (b *StateBlock) GetHash() Hash {
 
 func (b *StateBlock) GetHashWithoutPrivacy() Hash {
 	t := []byte{byte(b.Type)}
-	hash, _ := HashBytes(t, b.Token[:], b.Address[:], b.Balance.Bytes(), b.Vote.Bytes(), b.Network.Bytes(),
+	fields := [][]byte{t, b.Token[:], b.Address[:], b.Balance.Bytes(), b.Vote.Bytes(), b.Network.Bytes(),
 		b.Storage.Bytes(), b.Oracle.Bytes(), b.Previous[:], b.Link[:], b.Sender, b.Receiver, b.Message[:], b.Data,
 		util.BE_Int2Bytes(b.Timestamp), util.BE_Uint64ToBytes(b.PoVHeight),
-		b.Extra[:], b.Representative[:])
+		b.Extra[:], b.Representative[:]}
+	if b.HasFee() {
+		fields = append(fields, b.Fee.Bytes())
+	}
+	hash, _ := HashBytes(fields...)
 	return hash
 }
 
@@ -124,6 +135,18 @@ func (b *StateBlock) GetBalance() Balance {
 	return b.Balance
 }
 
+// HasFee returns true if the block pays a positive fee, blocks without fee keep their original hash
+func (b *StateBlock) HasFee() bool {
+	return b.Fee != nil && b.Fee.Int != nil && b.Fee.Sign() > 0
+}
+
+func (b *StateBlock) GetFee() Balance {
+	if !b.HasFee() {
+		return ZeroBalance
+	}
+	return *b.Fee
+}
+
 func (b *StateBlock) GetVote() Balance {
 	if b.Vote.Int == nil {
 		return ZeroBalance
@@ -175,6 +175,30 @@ func (z *StateBlock) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Signature")
 				return
 			}
+		case "multiSig":
+			z.MultiSig, err = dc.ReadBytes(z.MultiSig)
+			if err != nil {
+				err = msgp.WrapError(err, "MultiSig")
+				return
+			}
+		case "fee":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Fee")
+					return
+				}
+				z.Fee = nil
+			} else {
+				if z.Fee == nil {
+					z.Fee = new(Balance)
+				}
+				err = dc.ReadExtension(z.Fee)
+				if err != nil {
+					err = msgp.WrapError(err, "Fee")
+					return
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -188,9 +212,9 @@ func (z *StateBlock) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *StateBlock) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 23
+	// map header, size 25
 	// write "type"
-	err = en.Append(0xde, 0x0, 0x17, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	err = en.Append(0xde, 0x0, 0x19, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	if err != nil {
 		return
 	}
@@ -426,15 +450,42 @@ func (z *StateBlock) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Signature")
 		return
 	}
+	// write "multiSig"
+	err = en.Append(0xa8, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.MultiSig)
+	if err != nil {
+		err = msgp.WrapError(err, "MultiSig")
+		return
+	}
+	// write "fee"
+	err = en.Append(0xa3, 0x66, 0x65, 0x65)
+	if err != nil {
+		return
+	}
+	if z.Fee == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = en.WriteExtension(z.Fee)
+		if err != nil {
+			err = msgp.WrapError(err, "Fee")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *StateBlock) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 23
+	// map header, size 25
 	// string "type"
-	o = append(o, 0xde, 0x0, 0x17, 0xa4, 0x74, 0x79, 0x70, 0x65)
+	o = append(o, 0xde, 0x0, 0x19, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	o, err = z.Type.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "Type")
@@ -565,6 +616,20 @@ func (z *StateBlock) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Signature")
 		return
 	}
+	// string "multiSig"
+	o = append(o, 0xa8, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67)
+	o = msgp.AppendBytes(o, z.MultiSig)
+	// string "fee"
+	o = append(o, 0xa3, 0x66, 0x65, 0x65)
+	if z.Fee == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = msgp.AppendExtension(o, z.Fee)
+		if err != nil {
+			err = msgp.WrapError(err, "Fee")
+			return
+		}
+	}
 	return
 }
 
@@ -737,6 +802,29 @@ func (z *StateBlock) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Signature")
 				return
 			}
+		case "multiSig":
+			z.MultiSig, bts, err = msgp.ReadBytesBytes(bts, z.MultiSig)
+			if err != nil {
+				err = msgp.WrapError(err, "MultiSig")
+				return
+			}
+		case "fee":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Fee = nil
+			} else {
+				if z.Fee == nil {
+					z.Fee = new(Balance)
+				}
+				bts, err = msgp.ReadExtensionBytes(bts, z.Fee)
+				if err != nil {
+					err = msgp.WrapError(err, "Fee")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -755,7 +843,12 @@ func (z *StateBlock) Msgsize() (s int) {
 	for za0001 := range z.PrivateFor {
 		s += msgp.StringPrefixSize + len(z.PrivateFor[za0001])
 	}
-	s += 7 + msgp.StringPrefixSize + len(z.PrivateGroupID) + 5 + msgp.ExtensionPrefixSize + z.Work.Len() + 10 + msgp.ExtensionPrefixSize + z.Signature.Len()
+	s += 7 + msgp.StringPrefixSize + len(z.PrivateGroupID) + 5 + msgp.ExtensionPrefixSize + z.Work.Len() + 10 + msgp.ExtensionPrefixSize + z.Signature.Len() + 9 + msgp.BytesPrefixSize + len(z.MultiSig) + 4
+	if z.Fee == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.ExtensionPrefixSize + z.Fee.Len()
+	}
 	return
 }
 
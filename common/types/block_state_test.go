@@ -293,3 +293,55 @@ func TestStateBlock_PrivateHash(t *testing.T) {
 		t.Fatal("tx GetPrivatePayload")
 	}
 }
+
+func TestStateBlock_Fee(t *testing.T) {
+	b := StateBlock{}
+	if err := json.Unmarshal([]byte(testBlk), &b); err != nil {
+		t.Fatal(err)
+	}
+	h1 := b.GetHash()
+	if b.HasFee() || !b.GetFee().IsZero() {
+		t.Fatal("block should not have fee")
+	}
+
+	zero := NewBalance(0)
+	b.Fee = &zero
+	if b.GetHash() != h1 {
+		t.Fatal("zero fee should not change block hash")
+	}
+
+	fee := NewBalance(100000)
+	b.Fee = &fee
+	h2 := b.GetHash()
+	if h1 == h2 {
+		t.Fatal("fee should change block hash")
+	}
+	if h2 != b.GetHashWithoutPrivacy() {
+		t.Fatal("public GetHash != GetHashWithoutPrivacy")
+	}
+
+	buff, err := b.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b2 StateBlock
+	if err = b2.Deserialize(buff); err != nil {
+		t.Fatal(err)
+	}
+	if !b2.GetFee().Equal(fee) || b2.GetHash() != h2 {
+		t.Fatal("invalid fee ", b2.GetFee())
+	}
+
+	b.Fee = nil
+	buff, err = b.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b3 StateBlock
+	if err = b3.Deserialize(buff); err != nil {
+		t.Fatal(err)
+	}
+	if b3.Fee != nil || b3.GetHash() != h1 {
+		t.Fatal("invalid fee ", b3.GetFee())
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EquivocationAck is a signed confirm ack of representative
+//go:generate msgp
+type EquivocationAck struct {
+	Sequence  uint32    `msg:"sequence" json:"sequence"`
+	Hashes    []Hash    `msg:"hashes" json:"hashes"`
+	Signature Signature `msg:"signature,extension" json:"signature"`
+}
+
+// Equivocation is the evidence that a representative signed acks for two conflicting blocks with the same root,
+// it can be verified by anyone without trusting the node which detected it
+//go:generate msgp
+type Equivocation struct {
+	Account   Address          `msg:"account,extension" json:"account"`
+	Root      Hash             `msg:"root,extension" json:"root"`
+	BlockA    *StateBlock      `msg:"blockA" json:"blockA"`
+	AckA      *EquivocationAck `msg:"ackA" json:"ackA"`
+	BlockB    *StateBlock      `msg:"blockB" json:"blockB"`
+	AckB      *EquivocationAck `msg:"ackB" json:"ackB"`
+	Timestamp int64            `msg:"timestamp" json:"timestamp"`
+}
+
+// IsConflictBlock returns true if two different blocks have the same root on the same token chain
+func IsConflictBlock(a, b *StateBlock) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return a.GetHash() != b.GetHash() && a.Root() == b.Root() && a.GetToken() == b.GetToken()
+}
+
+// Key returns the identity of evidence, it is same for both block orders
+func (e *Equivocation) Key() Hash {
+	ha, hb := e.BlockA.GetHash(), e.BlockB.GetHash()
+	if ha.Cmp(hb) > 0 {
+		ha, hb = hb, ha
+	}
+	h, _ := HashBytes(e.Account[:], ha[:], hb[:])
+	return h
+}
+
+// Verify checks both acks are signed by the account and vote for the conflicting blocks
+func (e *Equivocation) Verify() error {
+	if e.BlockA == nil || e.BlockB == nil || e.AckA == nil || e.AckB == nil {
+		return errors.New("incomplete equivocation evidence")
+	}
+	if !IsConflictBlock(e.BlockA, e.BlockB) {
+		return errors.New("blocks are not conflicting")
+	}
+	if e.BlockA.Root() != e.Root {
+		return fmt.Errorf("root mismatch, %s != %s", e.BlockA.Root(), e.Root)
+	}
+	if err := e.AckA.verify(e.Account, e.BlockA.GetHash()); err != nil {
+		return err
+	}
+	return e.AckB.verify(e.Account, e.BlockB.GetHash())
+}
+
+func (a *EquivocationAck) verify(account Address, hash Hash) error {
+	found := false
+	hashBytes := make([]byte, 0, len(a.Hashes)*HashSize)
+	for _, h := range a.Hashes {
+		if h == hash {
+			found = true
+		}
+		hashBytes = append(hashBytes, h[:]...)
+	}
+	if !found {
+		return fmt.Errorf("ack does not vote for block %s", hash)
+	}
+
+	signHash, _ := HashBytes(hashBytes)
+	if !account.Verify(signHash[:], a.Signature[:]) {
+		return errors.New("invalid ack signature")
+	}
+	return nil
+}
+
+func (e *Equivocation) Serialize() ([]byte, error) {
+	return e.MarshalMsg(nil)
+}
+
+func (e *Equivocation) Deserialize(text []byte) error {
+	_, err := e.UnmarshalMsg(text)
+	if err != nil {
+		return err
+	}
+	return nil
+}
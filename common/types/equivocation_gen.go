@@ -0,0 +1,645 @@
+package types
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *Equivocation) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "account":
+			err = dc.ReadExtension(&z.Account)
+			if err != nil {
+				err = msgp.WrapError(err, "Account")
+				return
+			}
+		case "root":
+			err = dc.ReadExtension(&z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "blockA":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "BlockA")
+					return
+				}
+				z.BlockA = nil
+			} else {
+				if z.BlockA == nil {
+					z.BlockA = new(StateBlock)
+				}
+				err = z.BlockA.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "BlockA")
+					return
+				}
+			}
+		case "ackA":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "AckA")
+					return
+				}
+				z.AckA = nil
+			} else {
+				if z.AckA == nil {
+					z.AckA = new(EquivocationAck)
+				}
+				err = z.AckA.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "AckA")
+					return
+				}
+			}
+		case "blockB":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "BlockB")
+					return
+				}
+				z.BlockB = nil
+			} else {
+				if z.BlockB == nil {
+					z.BlockB = new(StateBlock)
+				}
+				err = z.BlockB.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "BlockB")
+					return
+				}
+			}
+		case "ackB":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "AckB")
+					return
+				}
+				z.AckB = nil
+			} else {
+				if z.AckB == nil {
+					z.AckB = new(EquivocationAck)
+				}
+				err = z.AckB.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "AckB")
+					return
+				}
+			}
+		case "timestamp":
+			z.Timestamp, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Equivocation) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "account"
+	err = en.Append(0x87, 0xa7, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Account)
+	if err != nil {
+		err = msgp.WrapError(err, "Account")
+		return
+	}
+	// write "root"
+	err = en.Append(0xa4, 0x72, 0x6f, 0x6f, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// write "blockA"
+	err = en.Append(0xa6, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41)
+	if err != nil {
+		return
+	}
+	if z.BlockA == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.BlockA.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "BlockA")
+			return
+		}
+	}
+	// write "ackA"
+	err = en.Append(0xa4, 0x61, 0x63, 0x6b, 0x41)
+	if err != nil {
+		return
+	}
+	if z.AckA == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.AckA.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "AckA")
+			return
+		}
+	}
+	// write "blockB"
+	err = en.Append(0xa6, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42)
+	if err != nil {
+		return
+	}
+	if z.BlockB == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.BlockB.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "BlockB")
+			return
+		}
+	}
+	// write "ackB"
+	err = en.Append(0xa4, 0x61, 0x63, 0x6b, 0x42)
+	if err != nil {
+		return
+	}
+	if z.AckB == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.AckB.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "AckB")
+			return
+		}
+	}
+	// write "timestamp"
+	err = en.Append(0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Timestamp)
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamp")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Equivocation) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "account"
+	o = append(o, 0x87, 0xa7, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+	o, err = msgp.AppendExtension(o, &z.Account)
+	if err != nil {
+		err = msgp.WrapError(err, "Account")
+		return
+	}
+	// string "root"
+	o = append(o, 0xa4, 0x72, 0x6f, 0x6f, 0x74)
+	o, err = msgp.AppendExtension(o, &z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// string "blockA"
+	o = append(o, 0xa6, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x41)
+	if z.BlockA == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.BlockA.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "BlockA")
+			return
+		}
+	}
+	// string "ackA"
+	o = append(o, 0xa4, 0x61, 0x63, 0x6b, 0x41)
+	if z.AckA == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.AckA.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "AckA")
+			return
+		}
+	}
+	// string "blockB"
+	o = append(o, 0xa6, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42)
+	if z.BlockB == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.BlockB.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "BlockB")
+			return
+		}
+	}
+	// string "ackB"
+	o = append(o, 0xa4, 0x61, 0x63, 0x6b, 0x42)
+	if z.AckB == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.AckB.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "AckB")
+			return
+		}
+	}
+	// string "timestamp"
+	o = append(o, 0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+	o = msgp.AppendInt64(o, z.Timestamp)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Equivocation) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "account":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Account)
+			if err != nil {
+				err = msgp.WrapError(err, "Account")
+				return
+			}
+		case "root":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "blockA":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.BlockA = nil
+			} else {
+				if z.BlockA == nil {
+					z.BlockA = new(StateBlock)
+				}
+				bts, err = z.BlockA.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "BlockA")
+					return
+				}
+			}
+		case "ackA":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.AckA = nil
+			} else {
+				if z.AckA == nil {
+					z.AckA = new(EquivocationAck)
+				}
+				bts, err = z.AckA.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AckA")
+					return
+				}
+			}
+		case "blockB":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.BlockB = nil
+			} else {
+				if z.BlockB == nil {
+					z.BlockB = new(StateBlock)
+				}
+				bts, err = z.BlockB.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "BlockB")
+					return
+				}
+			}
+		case "ackB":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.AckB = nil
+			} else {
+				if z.AckB == nil {
+					z.AckB = new(EquivocationAck)
+				}
+				bts, err = z.AckB.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AckB")
+					return
+				}
+			}
+		case "timestamp":
+			z.Timestamp, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Equivocation) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ExtensionPrefixSize + z.Account.Len() + 5 + msgp.ExtensionPrefixSize + z.Root.Len() + 7
+	if z.BlockA == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.BlockA.Msgsize()
+	}
+	s += 5
+	if z.AckA == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.AckA.Msgsize()
+	}
+	s += 7
+	if z.BlockB == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.BlockB.Msgsize()
+	}
+	s += 5
+	if z.AckB == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.AckB.Msgsize()
+	}
+	s += 10 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *EquivocationAck) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "sequence":
+			z.Sequence, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "Sequence")
+				return
+			}
+		case "hashes":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Hashes")
+				return
+			}
+			if cap(z.Hashes) >= int(zb0002) {
+				z.Hashes = (z.Hashes)[:zb0002]
+			} else {
+				z.Hashes = make([]Hash, zb0002)
+			}
+			for za0001 := range z.Hashes {
+				err = z.Hashes[za0001].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Hashes", za0001)
+					return
+				}
+			}
+		case "signature":
+			err = dc.ReadExtension(&z.Signature)
+			if err != nil {
+				err = msgp.WrapError(err, "Signature")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *EquivocationAck) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "sequence"
+	err = en.Append(0x83, 0xa8, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint32(z.Sequence)
+	if err != nil {
+		err = msgp.WrapError(err, "Sequence")
+		return
+	}
+	// write "hashes"
+	err = en.Append(0xa6, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Hashes)))
+	if err != nil {
+		err = msgp.WrapError(err, "Hashes")
+		return
+	}
+	for za0001 := range z.Hashes {
+		err = z.Hashes[za0001].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Hashes", za0001)
+			return
+		}
+	}
+	// write "signature"
+	err = en.Append(0xa9, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Signature)
+	if err != nil {
+		err = msgp.WrapError(err, "Signature")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *EquivocationAck) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "sequence"
+	o = append(o, 0x83, 0xa8, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65)
+	o = msgp.AppendUint32(o, z.Sequence)
+	// string "hashes"
+	o = append(o, 0xa6, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Hashes)))
+	for za0001 := range z.Hashes {
+		o, err = z.Hashes[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Hashes", za0001)
+			return
+		}
+	}
+	// string "signature"
+	o = append(o, 0xa9, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65)
+	o, err = msgp.AppendExtension(o, &z.Signature)
+	if err != nil {
+		err = msgp.WrapError(err, "Signature")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *EquivocationAck) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "sequence":
+			z.Sequence, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Sequence")
+				return
+			}
+		case "hashes":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Hashes")
+				return
+			}
+			if cap(z.Hashes) >= int(zb0002) {
+				z.Hashes = (z.Hashes)[:zb0002]
+			} else {
+				z.Hashes = make([]Hash, zb0002)
+			}
+			for za0001 := range z.Hashes {
+				bts, err = z.Hashes[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Hashes", za0001)
+					return
+				}
+			}
+		case "signature":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Signature)
+			if err != nil {
+				err = msgp.WrapError(err, "Signature")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *EquivocationAck) Msgsize() (s int) {
+	s = 1 + 9 + msgp.Uint32Size + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Hashes {
+		s += z.Hashes[za0001].Msgsize()
+	}
+	s += 10 + msgp.ExtensionPrefixSize + z.Signature.Len()
+	return
+}
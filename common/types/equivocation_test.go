@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"testing"
+)
+
+func newTestEquivocationAck(t *testing.T, account *Account, hashes ...Hash) *EquivocationAck {
+	hashBytes := make([]byte, 0)
+	for _, h := range hashes {
+		hashBytes = append(hashBytes, h[:]...)
+	}
+	signHash, err := HashBytes(hashBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &EquivocationAck{Sequence: 1, Hashes: hashes, Signature: account.Sign(signHash)}
+}
+
+func newTestEquivocation(t *testing.T) (*Equivocation, *Account) {
+	_, priv, err := KeypairFromSeed(seed, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := NewAccount(priv)
+
+	prev := Hash{}
+	_ = prev.Of("1ab8e2f0a2a4d8c0e0b2d6f4a3c3e1f5d7b9a8c6e4f2d0b1a3c5e7f9d8b6a4c2")
+	token := Hash{}
+	_ = token.Of("a7e8fa30c063e96a489a47bc43909505bd86735da4a109dca28be936118a8582")
+
+	blkA := &StateBlock{Type: Send, Token: token, Address: account.Address(), Previous: prev, Timestamp: 1}
+	blkB := &StateBlock{Type: Send, Token: token, Address: account.Address(), Previous: prev, Timestamp: 2}
+
+	return &Equivocation{
+		Account:   account.Address(),
+		Root:      prev,
+		BlockA:    blkA,
+		AckA:      newTestEquivocationAck(t, account, blkA.GetHash()),
+		BlockB:    blkB,
+		AckB:      newTestEquivocationAck(t, account, ZeroHash, blkB.GetHash()),
+		Timestamp: 100,
+	}, account
+}
+
+func TestIsConflictBlock(t *testing.T) {
+	eq, _ := newTestEquivocation(t)
+	if !IsConflictBlock(eq.BlockA, eq.BlockB) {
+		t.Fatal("blocks should be conflicting")
+	}
+	if IsConflictBlock(eq.BlockA, eq.BlockA) || IsConflictBlock(eq.BlockA, nil) {
+		t.Fatal("same block should not be conflicting")
+	}
+
+	blk := eq.BlockB.Clone()
+	blk.Token = ZeroHash
+	if IsConflictBlock(eq.BlockA, blk) {
+		t.Fatal("blocks of different token should not be conflicting")
+	}
+}
+
+func TestEquivocation_Verify(t *testing.T) {
+	eq, account := newTestEquivocation(t)
+	if err := eq.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	key := eq.Key()
+	eq.BlockA, eq.BlockB = eq.BlockB, eq.BlockA
+	if eq.Key() != key {
+		t.Fatal("key should not depend on block order")
+	}
+	if err := eq.Verify(); err == nil {
+		t.Fatal("acks do not match blocks")
+	}
+	eq.BlockA, eq.BlockB = eq.BlockB, eq.BlockA
+
+	eq.AckB = newTestEquivocationAck(t, account, eq.BlockA.GetHash())
+	if err := eq.Verify(); err == nil {
+		t.Fatal("ack should vote for block b")
+	}
+
+	eq.AckB = newTestEquivocationAck(t, account, eq.BlockB.GetHash())
+	eq.AckB.Signature[0]++
+	if err := eq.Verify(); err == nil {
+		t.Fatal("invalid signature should be rejected")
+	}
+
+	eq.AckB = nil
+	if err := eq.Verify(); err == nil {
+		t.Fatal("incomplete evidence should be rejected")
+	}
+}
+
+func TestEquivocation_Serialize(t *testing.T) {
+	eq, _ := newTestEquivocation(t)
+	data, err := eq.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	eq2 := new(Equivocation)
+	if err := eq2.Deserialize(data); err != nil {
+		t.Fatal(err)
+	}
+	if eq2.Key() != eq.Key() || eq2.Verify() != nil {
+		t.Fatal("invalid deserialized equivocation")
+	}
+}
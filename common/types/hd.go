@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/crypto/ed25519"
+	"github.com/qlcchain/go-qlc/crypto/slip10"
+)
+
+// HDCoinType is the coin type of QLC Chain in BIP44 derivation path
+const HDCoinType = 105
+
+// HDPath returns the default SLIP-0010 derivation path of account index
+func HDPath(index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'", HDCoinType, index)
+}
+
+// NewAccountByPath derives account of path from BIP39 seed
+func NewAccountByPath(seed []byte, path string) (*Account, error) {
+	key, err := slip10.DeriveForPath(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	pub, priv, err := ed25519.GenerateKey(bytes.NewReader(key.Key[:]))
+	if err != nil {
+		return nil, err
+	}
+	return &Account{pubKey: pub, privKey: priv}, nil
+}
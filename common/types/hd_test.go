@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestNewAccountByPath(t *testing.T) {
+	seed, _ := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	if HDPath(1) != "m/44'/105'/1'" {
+		t.Fatal(HDPath(1))
+	}
+	a0, err := NewAccountByPath(seed, HDPath(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a1, err := NewAccountByPath(seed, HDPath(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a0.Address() == a1.Address() {
+		t.Fatal("accounts of different path should be different")
+	}
+	a, _ := NewAccountByPath(seed, HDPath(0))
+	if a.Address() != a0.Address() {
+		t.Fatal("derivation should be deterministic")
+	}
+	sign := a0.Sign(ZeroHash)
+	if !a0.Address().Verify(ZeroHash[:], sign[:]) {
+		t.Fatal("invalid signature")
+	}
+	if _, err := NewAccountByPath(seed, "m/44'/105'/0"); err == nil {
+		t.Fatal("non-hardened path should be rejected")
+	}
+}
@@ -0,0 +1,386 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MoneyMaxPrecision is the max number of decimal places of money
+const MoneyMaxPrecision = 18
+
+var ErrInvalidMoney = errors.New("invalid money")
+
+// currencies whose minor unit is not cent, see ISO 4217
+var currencyPrecisions = map[string]uint8{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// CurrencyPrecision returns the number of decimal places of the minor unit of currency, it is 2 if unknown
+func CurrencyPrecision(currency string) uint8 {
+	if p, ok := currencyPrecisions[strings.ToUpper(currency)]; ok {
+		return p
+	}
+	return 2
+}
+
+// Money is a fixed point decimal amount, value is the amount scaled by 10^precision, currency is the
+// optional ISO 4217 code. Money is immutable, all operations return a new value.
+type Money struct {
+	value     *big.Int
+	precision uint8
+	currency  string
+}
+
+// NewMoney creates money of value scaled by 10^precision, e.g. NewMoney(1234, 2, "USD") is 12.34 USD
+func NewMoney(value int64, precision uint8, currency string) Money {
+	return newMoney(big.NewInt(value), precision, currency)
+}
+
+// MoneyFromUnits creates money of units of 10^-precision, like the amount of a token with precision decimals
+func MoneyFromUnits(units *big.Int, precision uint8, currency string) Money {
+	return newMoney(new(big.Int).Set(units), precision, currency)
+}
+
+// ZeroMoney returns zero with precision of the minor unit of currency
+func ZeroMoney(currency string) Money {
+	return Money{precision: CurrencyPrecision(currency), currency: currency}
+}
+
+func newMoney(value *big.Int, precision uint8, currency string) Money {
+	if value.Sign() == 0 {
+		value = nil
+	}
+	return Money{value: value, precision: precision, currency: currency}
+}
+
+// ParseMoney parses decimal string with optional currency code, like "12.34" or "12.34 USD"
+func ParseMoney(s string) (Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 || len(fields) > 2 {
+		return Money{}, fmt.Errorf("%s: %q", ErrInvalidMoney, s)
+	}
+	value, precision, err := parseDecimal(fields[0])
+	if err != nil {
+		return Money{}, err
+	}
+	if precision > MoneyMaxPrecision {
+		return Money{}, fmt.Errorf("%s: %q exceeds max precision %d", ErrInvalidMoney, s, MoneyMaxPrecision)
+	}
+	var currency string
+	if len(fields) == 2 {
+		currency = fields[1]
+	}
+	return newMoney(value, uint8(precision), currency), nil
+}
+
+// MoneyFromFloat converts float by the shortest decimal which represents it, it is used for prices which were
+// float before, the result is rounded to MoneyMaxPrecision
+func MoneyFromFloat(f float64) (Money, error) {
+	return moneyFromFloat(f, 64)
+}
+
+func moneyFromFloat(f float64, bitSize int) (Money, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Money{}, fmt.Errorf("%s: %f", ErrInvalidMoney, f)
+	}
+	value, precision, err := parseDecimal(strconv.FormatFloat(f, 'f', -1, bitSize))
+	if err != nil {
+		return Money{}, err
+	}
+	if precision > MoneyMaxPrecision {
+		return newMoney(roundQuo(value, pow10(precision-MoneyMaxPrecision)), MoneyMaxPrecision, ""), nil
+	}
+	return newMoney(value, uint8(precision), ""), nil
+}
+
+// parseDecimal parses decimal number with optional exponent, returns the scaled value and the precision
+func parseDecimal(s string) (*big.Int, int, error) {
+	mantissa, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > 1000 || e < -1000 {
+			return nil, 0, fmt.Errorf("%s: %q", ErrInvalidMoney, s)
+		}
+		mantissa, exp = s[:i], e
+	}
+
+	digits, precision := mantissa, 0
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		digits, precision = mantissa[:i]+mantissa[i+1:], len(mantissa)-i-1
+	}
+	start := 0
+	if len(digits) > 0 && (digits[0] == '-' || digits[0] == '+') {
+		start = 1
+	}
+	if len(digits) == start {
+		return nil, 0, fmt.Errorf("%s: %q", ErrInvalidMoney, s)
+	}
+	for _, c := range digits[start:] {
+		if c < '0' || c > '9' {
+			return nil, 0, fmt.Errorf("%s: %q", ErrInvalidMoney, s)
+		}
+	}
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil, 0, fmt.Errorf("%s: %q", ErrInvalidMoney, s)
+	}
+
+	precision -= exp
+	if precision < 0 {
+		value.Mul(value, pow10(-precision))
+		precision = 0
+	}
+	return value, precision, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundQuo returns x/y rounded half to even (banker's rounding)
+func roundQuo(x, y *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	c := new(big.Int).Abs(r)
+	c.Lsh(c, 1)
+	if cmp := c.Cmp(new(big.Int).Abs(y)); cmp > 0 || (cmp == 0 && q.Bit(0) == 1) {
+		if x.Sign() == y.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func (m Money) int() *big.Int {
+	if m.value == nil {
+		return new(big.Int)
+	}
+	return m.value
+}
+
+// scaled returns value of m scaled by 10^precision, precision should not be smaller than precision of m
+func (m Money) scaled(precision uint8) *big.Int {
+	if precision == m.precision {
+		return m.int()
+	}
+	return new(big.Int).Mul(m.int(), pow10(int(precision-m.precision)))
+}
+
+func (m Money) Precision() uint8 {
+	return m.precision
+}
+
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// In returns the same amount in currency
+func (m Money) In(currency string) Money {
+	return Money{value: m.value, precision: m.precision, currency: currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.value == nil || m.value.Sign() == 0
+}
+
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+// Cmp compares amounts of m and n regardless of precision and currency
+func (m Money) Cmp(n Money) int {
+	p := m.precision
+	if n.precision > p {
+		p = n.precision
+	}
+	return m.scaled(p).Cmp(n.scaled(p))
+}
+
+// Equal reports whether m and n are the same amount of the same currency
+func (m Money) Equal(n Money) bool {
+	return m.currency == n.currency && m.Cmp(n) == 0
+}
+
+// Add returns m+n with the larger precision, currency of m is kept if it is set
+func (m Money) Add(n Money) Money {
+	p := m.precision
+	if n.precision > p {
+		p = n.precision
+	}
+	return newMoney(new(big.Int).Add(m.scaled(p), n.scaled(p)), p, m.pick(n))
+}
+
+// Sub returns m-n with the larger precision, currency of m is kept if it is set
+func (m Money) Sub(n Money) Money {
+	return m.Add(n.Neg())
+}
+
+func (m Money) Neg() Money {
+	return newMoney(new(big.Int).Neg(m.int()), m.precision, m.currency)
+}
+
+func (m Money) pick(n Money) string {
+	if m.currency != "" {
+		return m.currency
+	}
+	return n.currency
+}
+
+// Mul returns m*n, the result is exact
+func (m Money) Mul(n int64) Money {
+	return newMoney(new(big.Int).Mul(m.int(), big.NewInt(n)), m.precision, m.currency)
+}
+
+// MulRat returns m*r rounded half to even to precision
+func (m Money) MulRat(r *big.Rat, precision uint8) Money {
+	x := new(big.Int).Mul(m.int(), r.Num())
+	x.Mul(x, pow10(int(precision)))
+	y := new(big.Int).Mul(r.Denom(), pow10(int(m.precision)))
+	return newMoney(roundQuo(x, y), precision, m.currency)
+}
+
+// Round returns m rounded half to even (banker's rounding) to precision
+func (m Money) Round(precision uint8) Money {
+	if precision >= m.precision {
+		return newMoney(m.scaled(precision), precision, m.currency)
+	}
+	return newMoney(roundQuo(m.int(), pow10(int(m.precision-precision))), precision, m.currency)
+}
+
+// Units returns m rounded to precision in units of 10^-precision, it is the reverse of MoneyFromUnits
+func (m Money) Units(precision uint8) *big.Int {
+	return new(big.Int).Set(m.Round(precision).int())
+}
+
+// RoundCurrency rounds m to the minor unit of its currency, like it is on an invoice line
+func (m Money) RoundCurrency() Money {
+	return m.Round(CurrencyPrecision(m.currency))
+}
+
+// Float64 returns the nearest float of m, it is only for APIs which can not carry decimals
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.Decimal(), 64)
+	return f
+}
+
+// Decimal returns the amount in decimal with all places of precision, e.g. "12.30"
+func (m Money) Decimal() string {
+	s := new(big.Int).Abs(m.int()).String()
+	if m.precision > 0 {
+		if len(s) <= int(m.precision) {
+			s = strings.Repeat("0", int(m.precision)-len(s)+1) + s
+		}
+		s = s[:len(s)-int(m.precision)] + "." + s[len(s)-int(m.precision):]
+	}
+	if m.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
+
+// String returns decimal amount followed by currency if it is set, e.g. "12.30 USD"
+func (m Money) String() string {
+	if m.currency == "" {
+		return m.Decimal()
+	}
+	return m.Decimal() + " " + m.currency
+}
+
+// MarshalJSON writes the amount as an exact decimal number, currency is carried by its own field
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.Decimal()), nil
+}
+
+// UnmarshalJSON accepts numbers and strings, floats are parsed by their text so no precision is lost
+func (m *Money) UnmarshalJSON(text []byte) error {
+	s := string(text)
+	if s == "null" {
+		*m = Money{}
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// MarshalMsg implements msgp.Marshaler, money is encoded as string, see String
+func (m Money) MarshalMsg(b []byte) ([]byte, error) {
+	return msgp.AppendString(b, m.String()), nil
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler, numbers which were encoded by float before are accepted
+func (m *Money) UnmarshalMsg(bts []byte) ([]byte, error) {
+	var err error
+	switch t := msgp.NextType(bts); t {
+	case msgp.StrType:
+		var s string
+		if s, bts, err = msgp.ReadStringBytes(bts); err != nil {
+			return bts, err
+		}
+		*m, err = ParseMoney(s)
+	case msgp.Float64Type:
+		var f float64
+		if f, bts, err = msgp.ReadFloat64Bytes(bts); err != nil {
+			return bts, err
+		}
+		*m, err = moneyFromFloat(f, 64)
+	case msgp.Float32Type:
+		var f float32
+		if f, bts, err = msgp.ReadFloat32Bytes(bts); err != nil {
+			return bts, err
+		}
+		*m, err = moneyFromFloat(float64(f), 32)
+	case msgp.IntType, msgp.UintType:
+		var i int64
+		if i, bts, err = msgp.ReadInt64Bytes(bts); err != nil {
+			return bts, err
+		}
+		*m = NewMoney(i, 0, "")
+	case msgp.NilType:
+		if bts, err = msgp.ReadNilBytes(bts); err != nil {
+			return bts, err
+		}
+		*m = Money{}
+	default:
+		return bts, msgp.TypeError{Method: msgp.StrType, Encoded: t}
+	}
+	return bts, err
+}
+
+// EncodeMsg implements msgp.Encodable
+func (m Money) EncodeMsg(en *msgp.Writer) error {
+	return en.WriteString(m.String())
+}
+
+// DecodeMsg implements msgp.Decodable
+func (m *Money) DecodeMsg(dc *msgp.Reader) error {
+	var raw msgp.Raw
+	if err := raw.DecodeMsg(dc); err != nil {
+		return err
+	}
+	_, err := m.UnmarshalMsg(raw)
+	return err
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (m Money) Msgsize() int {
+	return msgp.StringPrefixSize + len(m.String())
+}
@@ -0,0 +1,217 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in       string
+		decimal  string
+		currency string
+		wantErr  bool
+	}{
+		{"12.34", "12.34", "", false},
+		{"12.30 USD", "12.30", "USD", false},
+		{"-0.05", "-0.05", "", false},
+		{"+7", "7", "", false},
+		{".5", "0.5", "", false},
+		{"4.5e-3", "0.0045", "", false},
+		{"1.5E2", "150", "", false},
+		{"", "", "", true},
+		{"abc", "", "", true},
+		{"1.2.3", "", "", true},
+		{"1 USD x", "", "", true},
+		{"0.0000000000000000001", "", "", true},
+	}
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParseMoney(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if m.Decimal() != tt.decimal || m.Currency() != tt.currency {
+			t.Fatalf("ParseMoney(%q) = %s %s, want %s %s", tt.in, m.Decimal(), m.Currency(), tt.decimal, tt.currency)
+		}
+	}
+}
+
+func TestMoneyFromFloat(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{0.1, "0.1"},
+		{2, "2"},
+		{0.0045, "0.0045"},
+		{1e-20, "0.000000000000000000"},
+		{-3.25, "-3.25"},
+	}
+	for _, tt := range tests {
+		m, err := MoneyFromFloat(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Decimal() != tt.want {
+			t.Fatalf("MoneyFromFloat(%v) = %s, want %s", tt.in, m.Decimal(), tt.want)
+		}
+		if m.Float64() != tt.in && !m.IsZero() {
+			t.Fatalf("float of %s is %v", m, m.Float64())
+		}
+	}
+}
+
+func TestMoney_Round(t *testing.T) {
+	tests := []struct {
+		in        string
+		precision uint8
+		want      string
+	}{
+		{"0.125", 2, "0.12"},
+		{"0.135", 2, "0.14"},
+		{"0.1251", 2, "0.13"},
+		{"-0.125", 2, "-0.12"},
+		{"-0.135", 2, "-0.14"},
+		{"2.5", 0, "2"},
+		{"3.5", 0, "4"},
+		{"1.2", 3, "1.200"},
+	}
+	for _, tt := range tests {
+		m, _ := ParseMoney(tt.in)
+		if got := m.Round(tt.precision).Decimal(); got != tt.want {
+			t.Fatalf("Round(%s, %d) = %s, want %s", tt.in, tt.precision, got, tt.want)
+		}
+	}
+
+	if got := NewMoney(12345, 3, "JPY").RoundCurrency().String(); got != "12 JPY" {
+		t.Fatal(got)
+	}
+	if got := NewMoney(12345, 3, "USD").RoundCurrency().String(); got != "12.34 USD" {
+		t.Fatal(got)
+	}
+	if got := NewMoney(12345, 4, "KWD").RoundCurrency().String(); got != "1.234 KWD" {
+		t.Fatal(got)
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	// ten lines of 0.1 are exactly 1, which is not true for float
+	sum := Money{}
+	price, _ := ParseMoney("0.1 USD")
+	for i := 0; i < 10; i++ {
+		sum = sum.Add(price)
+	}
+	if !sum.Equal(NewMoney(1, 0, "USD")) || sum.Decimal() != "1.0" {
+		t.Fatal("invalid sum", sum)
+	}
+
+	if got := price.Mul(3).Sub(NewMoney(5, 2, "")); got.String() != "0.25 USD" {
+		t.Fatal(got)
+	}
+	if got := price.Neg(); got.Sign() >= 0 || got.Cmp(price) >= 0 {
+		t.Fatal(got)
+	}
+
+	// 1/3 of 10.00 is 3.33, 2/3 is 6.67
+	m := NewMoney(1000, 2, "EUR")
+	if got := m.MulRat(big.NewRat(1, 3), 2); got.String() != "3.33 EUR" {
+		t.Fatal(got)
+	}
+	if got := m.MulRat(big.NewRat(2, 3), 2); got.String() != "6.67 EUR" {
+		t.Fatal(got)
+	}
+	if got := NewMoney(1, 2, "").MulRat(big.NewRat(1, 2), 2); !got.IsZero() {
+		t.Fatal("half cent should be rounded to even", got)
+	}
+
+	if m.In("USD").Equal(m) || m.In("USD").Cmp(m) != 0 {
+		t.Fatal("currency should be compared by Equal only")
+	}
+	// 10.00 EUR is 10 * 10^8 units of a token with 8 decimals
+	units := m.Units(8)
+	if units.Cmp(big.NewInt(1e9)) != 0 || !MoneyFromUnits(units, 8, "EUR").Equal(m) {
+		t.Fatal("invalid units", units)
+	}
+	if got := NewMoney(125, 3, "").Units(2); got.Int64() != 12 {
+		t.Fatal("units should be rounded to even", got)
+	}
+
+	if z := ZeroMoney("USD"); !z.IsZero() || z.String() != "0.00 USD" {
+		t.Fatal("invalid zero", z)
+	}
+	if !NewMoney(0, 2, "").IsZero() || !(Money{}).IsZero() || (Money{}).Decimal() != "0" {
+		t.Fatal("invalid zero")
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	type invoice struct {
+		Price  Money `json:"price"`
+		Amount Money `json:"amount"`
+	}
+	var v invoice
+	if err := json.Unmarshal([]byte(`{"price":0.0045,"amount":"12.30 USD"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Price.Decimal() != "0.0045" || v.Amount.String() != "12.30 USD" {
+		t.Fatal("invalid money", v.Price, v.Amount)
+	}
+	data, err := json.Marshal(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"price":0.0045,"amount":12.30}` {
+		t.Fatal(string(data))
+	}
+	if err := json.Unmarshal([]byte(`{"price":null,"amount":"x"}`), &v); err == nil {
+		t.Fatal("invalid amount should be rejected")
+	}
+}
+
+func TestMoney_Msg(t *testing.T) {
+	m := NewMoney(-123450, 4, "USD")
+	bts, err := m.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bts) > m.Msgsize() {
+		t.Fatal("invalid msg size")
+	}
+	var m2 Money
+	if left, err := m2.UnmarshalMsg(bts); err != nil || len(left) != 0 {
+		t.Fatal(err, len(left))
+	}
+	if !reflect.DeepEqual(m, m2) {
+		t.Fatal("invalid money", m, m2)
+	}
+
+	// legacy encodings of prices
+	legacy := [][]byte{
+		msgp.AppendFloat64(nil, 0.3),
+		msgp.AppendFloat32(nil, 0.3),
+		msgp.AppendString(nil, "0.3"),
+	}
+	for _, b := range legacy {
+		var v Money
+		if _, err := v.UnmarshalMsg(b); err != nil {
+			t.Fatal(err)
+		}
+		if v.Decimal() != "0.3" {
+			t.Fatal("invalid legacy money", v)
+		}
+	}
+	var v Money
+	if _, err := v.UnmarshalMsg(msgp.AppendInt64(nil, 5)); err != nil || v.Decimal() != "5" {
+		t.Fatal(err, v)
+	}
+	if _, err := v.UnmarshalMsg(msgp.AppendBool(nil, true)); err == nil {
+		t.Fatal("bool should be rejected")
+	}
+}
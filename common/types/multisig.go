@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const multiSigItemSize = AddressSize + SignatureSize
+
+// MultiSigItem is a partial signature of multisig account block
+type MultiSigItem struct {
+	Signer    Address   `json:"signer"`
+	Signature Signature `json:"signature"`
+}
+
+// IsMultiSig checks the block is authorized by aggregated signatures
+func (b *StateBlock) IsMultiSig() bool {
+	return len(b.MultiSig) > 0
+}
+
+// GetMultiSig decodes aggregated signatures of the block
+func (b *StateBlock) GetMultiSig() ([]*MultiSigItem, error) {
+	if len(b.MultiSig)%multiSigItemSize != 0 {
+		return nil, fmt.Errorf("invalid multisig size[%d]", len(b.MultiSig))
+	}
+	items := make([]*MultiSigItem, 0, len(b.MultiSig)/multiSigItemSize)
+	for i := 0; i < len(b.MultiSig); i += multiSigItemSize {
+		item := new(MultiSigItem)
+		copy(item.Signer[:], b.MultiSig[i:i+AddressSize])
+		copy(item.Signature[:], b.MultiSig[i+AddressSize:i+multiSigItemSize])
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// SetMultiSig encodes aggregated signatures to the block
+func (b *StateBlock) SetMultiSig(items []*MultiSigItem) {
+	buf := new(bytes.Buffer)
+	for _, item := range items {
+		buf.Write(item.Signer[:])
+		buf.Write(item.Signature[:])
+	}
+	b.MultiSig = buf.Bytes()
+}
+
+// AddMultiSig adds partial signature of signer, the previous signature of the same signer is replaced
+func (b *StateBlock) AddMultiSig(signer Address, signature Signature) error {
+	items, err := b.GetMultiSig()
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item.Signer == signer {
+			item.Signature = signature
+			b.SetMultiSig(items)
+			return nil
+		}
+	}
+	items = append(items, &MultiSigItem{Signer: signer, Signature: signature})
+	b.SetMultiSig(items)
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package types
+
+import (
+	"testing"
+)
+
+func TestStateBlock_MultiSig(t *testing.T) {
+	seed, _ := NewSeed()
+	a1, _ := seed.Account(1)
+	a2, _ := seed.Account(2)
+
+	blk := new(StateBlock)
+	if blk.IsMultiSig() {
+		t.Fatal("block should not be multisig")
+	}
+	hash := blk.GetHash()
+	if err := blk.AddMultiSig(a1.Address(), a1.Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	if err := blk.AddMultiSig(a2.Address(), a2.Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	// replace signature of the same signer
+	if err := blk.AddMultiSig(a1.Address(), a1.Sign(hash)); err != nil {
+		t.Fatal(err)
+	}
+	if blk.GetHash() != hash {
+		t.Fatal("multisig should not change block hash")
+	}
+
+	data, err := blk.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk2 := new(StateBlock)
+	if err := blk2.Deserialize(data); err != nil {
+		t.Fatal(err)
+	}
+	items, err := blk2.GetMultiSig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].Signer != a1.Address() || items[1].Signer != a2.Address() {
+		t.Fatal(items)
+	}
+	for _, item := range items {
+		if !item.Signer.Verify(hash[:], item.Signature[:]) {
+			t.Fatal("invalid signature")
+		}
+	}
+
+	blk2.MultiSig = blk2.MultiSig[1:]
+	if _, err := blk2.GetMultiSig(); err == nil {
+		t.Fatal("invalid multisig should be rejected")
+	}
+}
@@ -20,3 +20,29 @@ func (p *PeerInfo) Deserialize(text []byte) error {
 	}
 	return nil
 }
+
+// PeerBan is a timed ban of a misbehaving peer, ExpireTime is 0 if the ban is permanent
+//go:generate msgp
+type PeerBan struct {
+	PeerID     string  `json:"peerid"`
+	Score      float64 `json:"score"`
+	Reason     string  `json:"reason"`
+	BanTime    int64   `json:"banTime"`
+	ExpireTime int64   `json:"expireTime"`
+}
+
+func (p *PeerBan) IsExpired(now int64) bool {
+	return p.ExpireTime > 0 && p.ExpireTime <= now
+}
+
+func (p *PeerBan) Serialize() ([]byte, error) {
+	return p.MarshalMsg(nil)
+}
+
+func (p *PeerBan) Deserialize(text []byte) error {
+	_, err := p.UnmarshalMsg(text)
+	if err != nil {
+		return err
+	}
+	return nil
+}
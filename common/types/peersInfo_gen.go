@@ -6,6 +6,209 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// DecodeMsg implements msgp.Decodable
+func (z *PeerBan) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "PeerID":
+			z.PeerID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "PeerID")
+				return
+			}
+		case "Score":
+			z.Score, err = dc.ReadFloat64()
+			if err != nil {
+				err = msgp.WrapError(err, "Score")
+				return
+			}
+		case "Reason":
+			z.Reason, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		case "BanTime":
+			z.BanTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "BanTime")
+				return
+			}
+		case "ExpireTime":
+			z.ExpireTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "ExpireTime")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *PeerBan) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "PeerID"
+	err = en.Append(0x85, 0xa6, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.PeerID)
+	if err != nil {
+		err = msgp.WrapError(err, "PeerID")
+		return
+	}
+	// write "Score"
+	err = en.Append(0xa5, 0x53, 0x63, 0x6f, 0x72, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteFloat64(z.Score)
+	if err != nil {
+		err = msgp.WrapError(err, "Score")
+		return
+	}
+	// write "Reason"
+	err = en.Append(0xa6, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Reason)
+	if err != nil {
+		err = msgp.WrapError(err, "Reason")
+		return
+	}
+	// write "BanTime"
+	err = en.Append(0xa7, 0x42, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.BanTime)
+	if err != nil {
+		err = msgp.WrapError(err, "BanTime")
+		return
+	}
+	// write "ExpireTime"
+	err = en.Append(0xaa, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.ExpireTime)
+	if err != nil {
+		err = msgp.WrapError(err, "ExpireTime")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *PeerBan) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "PeerID"
+	o = append(o, 0x85, 0xa6, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.PeerID)
+	// string "Score"
+	o = append(o, 0xa5, 0x53, 0x63, 0x6f, 0x72, 0x65)
+	o = msgp.AppendFloat64(o, z.Score)
+	// string "Reason"
+	o = append(o, 0xa6, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Reason)
+	// string "BanTime"
+	o = append(o, 0xa7, 0x42, 0x61, 0x6e, 0x54, 0x69, 0x6d, 0x65)
+	o = msgp.AppendInt64(o, z.BanTime)
+	// string "ExpireTime"
+	o = append(o, 0xaa, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65)
+	o = msgp.AppendInt64(o, z.ExpireTime)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *PeerBan) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "PeerID":
+			z.PeerID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PeerID")
+				return
+			}
+		case "Score":
+			z.Score, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Score")
+				return
+			}
+		case "Reason":
+			z.Reason, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reason")
+				return
+			}
+		case "BanTime":
+			z.BanTime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BanTime")
+				return
+			}
+		case "ExpireTime":
+			z.ExpireTime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ExpireTime")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *PeerBan) Msgsize() (s int) {
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.PeerID) + 6 + msgp.Float64Size + 7 + msgp.StringPrefixSize + len(z.Reason) + 8 + msgp.Int64Size + 11 + msgp.Int64Size
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *PeerInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
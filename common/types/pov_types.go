@@ -35,6 +35,20 @@ type PovMineResult struct {
 	AuxPow *PovAuxHeader
 }
 
+// PovStratumWorkerStat is the share statistics of a stratum worker
+type PovStratumWorkerStat struct {
+	Worker        string  `json:"worker"`
+	MinerAddr     Address `json:"minerAddr"`
+	AlgoName      string  `json:"algoName"`
+	Difficulty    float64 `json:"difficulty"`
+	Connections   int     `json:"connections"`
+	Accepted      uint64  `json:"accepted"`
+	Rejected      uint64  `json:"rejected"`
+	Stale         uint64  `json:"stale"`
+	Blocks        uint64  `json:"blocks"`
+	LastShareTime int64   `json:"lastShareTime"`
+}
+
 func NewPovMineResult() *PovMineResult {
 	r := new(PovMineResult)
 	return r
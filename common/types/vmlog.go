@@ -9,8 +9,9 @@ package types
 
 //go:generate msgp
 type VmLog struct {
-	Topics []Hash `msg:"topics" json:"topics"`
-	Data   []byte `msg:"data" json:"data"`
+	Address Address `msg:"address,extension" json:"address"`
+	Topics  []Hash  `msg:"topics" json:"topics"`
+	Data    []byte  `msg:"data" json:"data"`
 }
 
 //go:generate msgp
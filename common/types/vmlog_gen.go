@@ -24,6 +24,12 @@ func (z *VmLog) DecodeMsg(dc *msgp.Reader) (err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
+		case "address":
+			err = dc.ReadExtension(&z.Address)
+			if err != nil {
+				err = msgp.WrapError(err, "Address")
+				return
+			}
 		case "topics":
 			var zb0002 uint32
 			zb0002, err = dc.ReadArrayHeader()
@@ -62,9 +68,19 @@ func (z *VmLog) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *VmLog) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
+	// map header, size 3
+	// write "address"
+	err = en.Append(0x83, 0xa7, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Address)
+	if err != nil {
+		err = msgp.WrapError(err, "Address")
+		return
+	}
 	// write "topics"
-	err = en.Append(0x82, 0xa6, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73)
+	err = en.Append(0xa6, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73)
 	if err != nil {
 		return
 	}
@@ -96,9 +112,16 @@ func (z *VmLog) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *VmLog) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 3
+	// string "address"
+	o = append(o, 0x83, 0xa7, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	o, err = msgp.AppendExtension(o, &z.Address)
+	if err != nil {
+		err = msgp.WrapError(err, "Address")
+		return
+	}
 	// string "topics"
-	o = append(o, 0x82, 0xa6, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73)
+	o = append(o, 0xa6, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73)
 	o = msgp.AppendArrayHeader(o, uint32(len(z.Topics)))
 	for za0001 := range z.Topics {
 		o, err = z.Topics[za0001].MarshalMsg(o)
@@ -131,6 +154,12 @@ func (z *VmLog) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
+		case "address":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Address)
+			if err != nil {
+				err = msgp.WrapError(err, "Address")
+				return
+			}
 		case "topics":
 			var zb0002 uint32
 			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
@@ -170,7 +199,7 @@ func (z *VmLog) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *VmLog) Msgsize() (s int) {
-	s = 1 + 7 + msgp.ArrayHeaderSize
+	s = 1 + 8 + msgp.ExtensionPrefixSize + z.Address.Len() + 7 + msgp.ArrayHeaderSize
 	for za0001 := range z.Topics {
 		s += z.Topics[za0001].Msgsize()
 	}
@@ -220,53 +249,11 @@ func (z *VmLogs) DecodeMsg(dc *msgp.Reader) (err error) {
 					if z.Logs[za0001] == nil {
 						z.Logs[za0001] = new(VmLog)
 					}
-					var zb0003 uint32
-					zb0003, err = dc.ReadMapHeader()
+					err = z.Logs[za0001].DecodeMsg(dc)
 					if err != nil {
 						err = msgp.WrapError(err, "Logs", za0001)
 						return
 					}
-					for zb0003 > 0 {
-						zb0003--
-						field, err = dc.ReadMapKeyPtr()
-						if err != nil {
-							err = msgp.WrapError(err, "Logs", za0001)
-							return
-						}
-						switch msgp.UnsafeString(field) {
-						case "topics":
-							var zb0004 uint32
-							zb0004, err = dc.ReadArrayHeader()
-							if err != nil {
-								err = msgp.WrapError(err, "Logs", za0001, "Topics")
-								return
-							}
-							if cap(z.Logs[za0001].Topics) >= int(zb0004) {
-								z.Logs[za0001].Topics = (z.Logs[za0001].Topics)[:zb0004]
-							} else {
-								z.Logs[za0001].Topics = make([]Hash, zb0004)
-							}
-							for za0002 := range z.Logs[za0001].Topics {
-								err = z.Logs[za0001].Topics[za0002].DecodeMsg(dc)
-								if err != nil {
-									err = msgp.WrapError(err, "Logs", za0001, "Topics", za0002)
-									return
-								}
-							}
-						case "data":
-							z.Logs[za0001].Data, err = dc.ReadBytes(z.Logs[za0001].Data)
-							if err != nil {
-								err = msgp.WrapError(err, "Logs", za0001, "Data")
-								return
-							}
-						default:
-							err = dc.Skip()
-							if err != nil {
-								err = msgp.WrapError(err, "Logs", za0001)
-								return
-							}
-						}
-					}
 				}
 			}
 		default:
@@ -300,32 +287,9 @@ func (z *VmLogs) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		} else {
-			// map header, size 2
-			// write "topics"
-			err = en.Append(0x82, 0xa6, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73)
-			if err != nil {
-				return
-			}
-			err = en.WriteArrayHeader(uint32(len(z.Logs[za0001].Topics)))
-			if err != nil {
-				err = msgp.WrapError(err, "Logs", za0001, "Topics")
-				return
-			}
-			for za0002 := range z.Logs[za0001].Topics {
-				err = z.Logs[za0001].Topics[za0002].EncodeMsg(en)
-				if err != nil {
-					err = msgp.WrapError(err, "Logs", za0001, "Topics", za0002)
-					return
-				}
-			}
-			// write "data"
-			err = en.Append(0xa4, 0x64, 0x61, 0x74, 0x61)
-			if err != nil {
-				return
-			}
-			err = en.WriteBytes(z.Logs[za0001].Data)
+			err = z.Logs[za0001].EncodeMsg(en)
 			if err != nil {
-				err = msgp.WrapError(err, "Logs", za0001, "Data")
+				err = msgp.WrapError(err, "Logs", za0001)
 				return
 			}
 		}
@@ -344,20 +308,11 @@ func (z *VmLogs) MarshalMsg(b []byte) (o []byte, err error) {
 		if z.Logs[za0001] == nil {
 			o = msgp.AppendNil(o)
 		} else {
-			// map header, size 2
-			// string "topics"
-			o = append(o, 0x82, 0xa6, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73)
-			o = msgp.AppendArrayHeader(o, uint32(len(z.Logs[za0001].Topics)))
-			for za0002 := range z.Logs[za0001].Topics {
-				o, err = z.Logs[za0001].Topics[za0002].MarshalMsg(o)
-				if err != nil {
-					err = msgp.WrapError(err, "Logs", za0001, "Topics", za0002)
-					return
-				}
+			o, err = z.Logs[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Logs", za0001)
+				return
 			}
-			// string "data"
-			o = append(o, 0xa4, 0x64, 0x61, 0x74, 0x61)
-			o = msgp.AppendBytes(o, z.Logs[za0001].Data)
 		}
 	}
 	return
@@ -404,53 +359,11 @@ func (z *VmLogs) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					if z.Logs[za0001] == nil {
 						z.Logs[za0001] = new(VmLog)
 					}
-					var zb0003 uint32
-					zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+					bts, err = z.Logs[za0001].UnmarshalMsg(bts)
 					if err != nil {
 						err = msgp.WrapError(err, "Logs", za0001)
 						return
 					}
-					for zb0003 > 0 {
-						zb0003--
-						field, bts, err = msgp.ReadMapKeyZC(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "Logs", za0001)
-							return
-						}
-						switch msgp.UnsafeString(field) {
-						case "topics":
-							var zb0004 uint32
-							zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
-							if err != nil {
-								err = msgp.WrapError(err, "Logs", za0001, "Topics")
-								return
-							}
-							if cap(z.Logs[za0001].Topics) >= int(zb0004) {
-								z.Logs[za0001].Topics = (z.Logs[za0001].Topics)[:zb0004]
-							} else {
-								z.Logs[za0001].Topics = make([]Hash, zb0004)
-							}
-							for za0002 := range z.Logs[za0001].Topics {
-								bts, err = z.Logs[za0001].Topics[za0002].UnmarshalMsg(bts)
-								if err != nil {
-									err = msgp.WrapError(err, "Logs", za0001, "Topics", za0002)
-									return
-								}
-							}
-						case "data":
-							z.Logs[za0001].Data, bts, err = msgp.ReadBytesBytes(bts, z.Logs[za0001].Data)
-							if err != nil {
-								err = msgp.WrapError(err, "Logs", za0001, "Data")
-								return
-							}
-						default:
-							bts, err = msgp.Skip(bts)
-							if err != nil {
-								err = msgp.WrapError(err, "Logs", za0001)
-								return
-							}
-						}
-					}
 				}
 			}
 		default:
@@ -472,11 +385,7 @@ func (z *VmLogs) Msgsize() (s int) {
 		if z.Logs[za0001] == nil {
 			s += msgp.NilSize
 		} else {
-			s += 1 + 7 + msgp.ArrayHeaderSize
-			for za0002 := range z.Logs[za0001].Topics {
-				s += z.Logs[za0001].Topics[za0002].Msgsize()
-			}
-			s += 5 + msgp.BytesPrefixSize + len(z.Logs[za0001].Data)
+			s += z.Logs[za0001].Msgsize()
 		}
 	}
 	return
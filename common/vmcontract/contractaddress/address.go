@@ -18,10 +18,16 @@ var (
 	PtmKeyKVAddress, _           = GenerateBuiltinContractAddress(28)
 	DoDSettlementAddress, _      = GenerateBuiltinContractAddress(29)
 	KYCAddress, _                = GenerateBuiltinContractAddress(30)
+	MultiSigAddress, _           = GenerateBuiltinContractAddress(31)
+	TimeLockAddress, _           = GenerateBuiltinContractAddress(32)
+
+	// FeeAddress is not a contract, QGAS sent to it is never received and becomes fee credit of the sender
+	FeeAddress, _ = GenerateBuiltinContractAddress(33)
 
 	ChainContractAddressList = []types.Address{NEP5PledgeAddress, MintageAddress, RewardsAddress, MinerAddress,
 		BlackHoleAddress, RepAddress, PubKeyDistributionAddress, SettlementAddress, PermissionAddress,
-		PrivacyDemoKVAddress, PtmKeyKVAddress, DoDSettlementAddress, KYCAddress,
+		PrivacyDemoKVAddress, PtmKeyKVAddress, DoDSettlementAddress, KYCAddress, MultiSigAddress,
+		TimeLockAddress,
 	}
 	RewardContractAddressList = []types.Address{MinerAddress, RepAddress}
 )
@@ -9,6 +9,7 @@ package mintage
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 
@@ -22,14 +23,28 @@ const (
 	[
 		{"type":"function","name":"Mintage","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"tokenName","type":"string"},{"name":"tokenSymbol","type":"string"},{"name":"totalSupply","type":"uint256"},{"name":"decimals","type":"uint8"},{"name":"beneficial","type":"address"},{"name":"NEP5TxId","type":"string"}]},
 		{"type":"function","name":"Withdraw","inputs":[{"name":"tokenId","type":"tokenId"}]},
+		{"type":"function","name":"MintageWithAuthority","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"tokenName","type":"string"},{"name":"tokenSymbol","type":"string"},{"name":"totalSupply","type":"uint256"},{"name":"decimals","type":"uint8"},{"name":"beneficial","type":"address"},{"name":"NEP5TxId","type":"string"},{"name":"issuer","type":"address"},{"name":"flags","type":"uint8"}]},
+		{"type":"function","name":"Issue","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"beneficial","type":"address"},{"name":"amount","type":"uint256"}]},
+		{"type":"function","name":"Burn","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"amount","type":"uint256"}]},
+		{"type":"function","name":"Freeze","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"account","type":"address"}]},
+		{"type":"function","name":"Unfreeze","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"account","type":"address"}]},
 		{"type":"variable","name":"token","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"tokenName","type":"string"},{"name":"tokenSymbol","type":"string"},{"name":"totalSupply","type":"uint256"},{"name":"decimals","type":"uint8"},{"name":"owner","type":"address"},{"name":"pledgeAmount","type":"uint256"},{"name":"withdrawTime","type":"int64"},{"name":"pledgeAddress","type":"address"},{"name":"NEP5TxId","type":"string"}]},
-		{"type":"variable","name":"genesisToken","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"tokenName","type":"string"},{"name":"tokenSymbol","type":"string"},{"name":"totalSupply","type":"uint256"},{"name":"decimals","type":"uint8"},{"name":"owner","type":"address"},{"name":"pledgeAmount","type":"uint256"},{"name":"withdrawTime","type":"int64"},{"name":"pledgeAddress","type":"address"}]}
+		{"type":"variable","name":"genesisToken","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"tokenName","type":"string"},{"name":"tokenSymbol","type":"string"},{"name":"totalSupply","type":"uint256"},{"name":"decimals","type":"uint8"},{"name":"owner","type":"address"},{"name":"pledgeAmount","type":"uint256"},{"name":"withdrawTime","type":"int64"},{"name":"pledgeAddress","type":"address"}]},
+		{"type":"variable","name":"tokenAuthority","inputs":[{"name":"tokenId","type":"tokenId"},{"name":"issuer","type":"address"},{"name":"flags","type":"uint8"},{"name":"issued","type":"uint256"},{"name":"burned","type":"uint256"}]},
+		{"type":"variable","name":"tokenFreeze","inputs":[{"name":"frozen","type":"bool"}]}
 	]`
 
-	MethodNameMintage         = "Mintage"
-	MethodNameMintageWithdraw = "Withdraw"
-	VariableNameToken         = "token"
-	VariableNameGenesisToken  = "genesisToken"
+	MethodNameMintage              = "Mintage"
+	MethodNameMintageWithdraw      = "Withdraw"
+	MethodNameMintageWithAuthority = "MintageWithAuthority"
+	MethodNameMintageIssue         = "Issue"
+	MethodNameMintageBurn          = "Burn"
+	MethodNameMintageFreeze        = "Freeze"
+	MethodNameMintageUnfreeze      = "Unfreeze"
+	VariableNameToken              = "token"
+	VariableNameGenesisToken       = "genesisToken"
+	VariableNameTokenAuthority     = "tokenAuthority"
+	VariableNameTokenFreeze        = "tokenFreeze"
 )
 
 var (
@@ -44,6 +59,48 @@ type ParamMintage struct {
 	Decimals    uint8
 	Beneficial  types.Address
 	NEP5TxId    string
+	// issuer authority of the token, only set by MintageWithAuthority
+	Issuer types.Address
+	Flags  uint8
+}
+
+// HasAuthority returns true if the token is minted with issuer authority
+func (p *ParamMintage) HasAuthority() bool {
+	return p.Flags != 0
+}
+
+// ParseMintageParam unpacks param of both Mintage and MintageWithAuthority
+func ParseMintageParam(data []byte) (*ParamMintage, error) {
+	if len(data) < 4 {
+		return nil, errors.New("invalid mintage data")
+	}
+	method, err := MintageABI.MethodById(data[0:4])
+	if err != nil {
+		return nil, err
+	}
+	if !IsMintageMethod(method.Name) {
+		return nil, fmt.Errorf("invalid mintage method %s", method.Name)
+	}
+	param := new(ParamMintage)
+	if err := MintageABI.UnpackMethod(param, method.Name, data); err != nil {
+		return nil, err
+	}
+	return param, nil
+}
+
+// PackMintageParam packs the param by MintageWithAuthority if the token has issuer authority
+func PackMintageParam(param *ParamMintage) ([]byte, error) {
+	if param.HasAuthority() {
+		return MintageABI.PackMethod(MethodNameMintageWithAuthority, param.TokenId, param.TokenName, param.TokenSymbol,
+			param.TotalSupply, param.Decimals, param.Beneficial, param.NEP5TxId, param.Issuer, param.Flags)
+	}
+	return MintageABI.PackMethod(MethodNameMintage, param.TokenId, param.TokenName, param.TokenSymbol,
+		param.TotalSupply, param.Decimals, param.Beneficial, param.NEP5TxId)
+}
+
+// IsMintageMethod returns true if the method creates a new token
+func IsMintageMethod(name string) bool {
+	return name == MethodNameMintage || name == MethodNameMintageWithAuthority
 }
 
 func ParseTokenInfo(data []byte) (*types.TokenInfo, error) {
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package mintage
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// authorities the issuer keeps after the token is minted
+const (
+	AuthorityIssue uint8 = 1 << iota
+	AuthorityBurn
+	AuthorityFreeze
+	AuthorityKYC
+
+	AuthorityAll = AuthorityIssue | AuthorityBurn | AuthorityFreeze | AuthorityKYC
+)
+
+var authorityNames = []struct {
+	flag uint8
+	name string
+}{
+	{AuthorityIssue, "issue"},
+	{AuthorityBurn, "burn"},
+	{AuthorityFreeze, "freeze"},
+	{AuthorityKYC, "kyc"},
+}
+
+// storage key prefix of authority data in mintage contract, token info is saved by token id directly,
+// so keys with prefix never conflict with it
+const (
+	storagePrefixTokenAuthority byte = iota + 1
+	storagePrefixTokenFreeze
+)
+
+// TokenAuthority is the issuer authority of a token, supply changes are in minimal unit
+type TokenAuthority struct {
+	TokenId types.Hash    `json:"tokenId"`
+	Issuer  types.Address `json:"issuer"`
+	Flags   uint8         `json:"flags"`
+	Issued  *big.Int      `json:"issued"`
+	Burned  *big.Int      `json:"burned"`
+}
+
+func (a *TokenAuthority) Has(flag uint8) bool {
+	return a.Flags&flag == flag
+}
+
+type ParamIssue struct {
+	TokenId    types.Hash    `json:"tokenId"`
+	Beneficial types.Address `json:"beneficial"`
+	Amount     *big.Int      `json:"amount"`
+}
+
+type ParamBurn struct {
+	TokenId types.Hash `json:"tokenId"`
+	Amount  *big.Int   `json:"amount"`
+}
+
+type ParamFreeze struct {
+	TokenId types.Hash    `json:"tokenId"`
+	Account types.Address `json:"account"`
+}
+
+type TokenFreeze struct {
+	Frozen bool `json:"frozen"`
+}
+
+// ParseAuthorityFlags converts authority names to flags
+func ParseAuthorityFlags(names []string) (uint8, error) {
+	var flags uint8
+	for _, n := range names {
+		n = strings.TrimSpace(strings.ToLower(n))
+		if len(n) == 0 {
+			continue
+		}
+		found := false
+		for _, a := range authorityNames {
+			if a.name == n {
+				flags |= a.flag
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, errors.New("invalid authority " + n)
+		}
+	}
+	return flags, nil
+}
+
+// AuthorityFlagNames converts flags to authority names
+func AuthorityFlagNames(flags uint8) []string {
+	names := make([]string, 0)
+	for _, a := range authorityNames {
+		if flags&a.flag == a.flag {
+			names = append(names, a.name)
+		}
+	}
+	return names
+}
+
+func GetTokenAuthorityKey(tokenId types.Hash) []byte {
+	return append([]byte{storagePrefixTokenAuthority}, tokenId[:]...)
+}
+
+func GetTokenFreezeKey(tokenId types.Hash, account types.Address) []byte {
+	key := append([]byte{storagePrefixTokenFreeze}, tokenId[:]...)
+	return append(key, account[:]...)
+}
+
+// GetTokenFreezePrefix is the key prefix of all frozen accounts of the token
+func GetTokenFreezePrefix(tokenId types.Hash) []byte {
+	return append([]byte{storagePrefixTokenFreeze}, tokenId[:]...)
+}
+
+func ParseTokenAuthority(data []byte) (*TokenAuthority, error) {
+	if len(data) == 0 {
+		return nil, errors.New("token authority data is nil")
+	}
+	authority := new(TokenAuthority)
+	if err := MintageABI.UnpackVariable(authority, VariableNameTokenAuthority, data); err != nil {
+		return nil, err
+	}
+	return authority, nil
+}
+
+func ParseTokenFreeze(data []byte) (*TokenFreeze, error) {
+	if len(data) == 0 {
+		return nil, errors.New("token freeze data is nil")
+	}
+	freeze := new(TokenFreeze)
+	if err := MintageABI.UnpackVariable(freeze, VariableNameTokenFreeze, data); err != nil {
+		return nil, err
+	}
+	return freeze, nil
+}
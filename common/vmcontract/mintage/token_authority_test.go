@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package mintage
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestParseAuthorityFlags(t *testing.T) {
+	flags, err := ParseAuthorityFlags([]string{"issue", " Burn", "", "freeze", "kyc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flags != AuthorityAll {
+		t.Fatalf("exp: %d, act: %d", AuthorityAll, flags)
+	}
+	if names := AuthorityFlagNames(AuthorityIssue | AuthorityFreeze); !reflect.DeepEqual(names, []string{"issue", "freeze"}) {
+		t.Fatal(names)
+	}
+	if _, err := ParseAuthorityFlags([]string{"mint"}); err == nil {
+		t.Fatal("invalid authority should return error")
+	}
+	if flags, err := ParseAuthorityFlags(nil); err != nil || flags != 0 {
+		t.Fatal(flags, err)
+	}
+}
+
+func TestParseMintageParam(t *testing.T) {
+	param := &ParamMintage{
+		TokenId:     mock.Hash(),
+		TokenName:   "Test",
+		TokenSymbol: "T",
+		TotalSupply: big.NewInt(1000),
+		Decimals:    8,
+		Beneficial:  mock.Address(),
+		NEP5TxId:    "nep5",
+	}
+
+	data, err := PackMintageParam(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err := ParseMintageParam(data); err != nil {
+		t.Fatal(err)
+	} else if p.HasAuthority() || p.TokenId != param.TokenId {
+		t.Fatal(p)
+	}
+
+	param.Issuer = mock.Address()
+	param.Flags = AuthorityIssue | AuthorityKYC
+	data, err = PackMintageParam(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err := ParseMintageParam(data); err != nil {
+		t.Fatal(err)
+	} else if !p.HasAuthority() || p.Issuer != param.Issuer || p.Flags != param.Flags {
+		t.Fatal(p)
+	}
+
+	if !IsMintageMethod(MethodNameMintage) || !IsMintageMethod(MethodNameMintageWithAuthority) || IsMintageMethod(MethodNameMintageIssue) {
+		t.Fatal("invalid mintage method")
+	}
+
+	burn, err := MintageABI.PackMethod(MethodNameMintageBurn, param.TokenId, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseMintageParam(burn); err == nil {
+		t.Fatal("burn data should not be parsed as mintage")
+	}
+}
+
+func TestTokenAuthority(t *testing.T) {
+	tokenId := mock.Hash()
+	account := mock.Address()
+
+	if !bytes.HasPrefix(GetTokenFreezeKey(tokenId, account), GetTokenFreezePrefix(tokenId)) {
+		t.Fatal("invalid freeze key")
+	}
+	if key := GetTokenAuthorityKey(tokenId); len(key) == len(tokenId) || !bytes.Equal(key[1:], tokenId[:]) {
+		t.Fatal("invalid authority key")
+	}
+
+	issuer := mock.Address()
+	data, err := MintageABI.PackVariable(VariableNameTokenAuthority, tokenId, issuer, AuthorityBurn, big.NewInt(10), big.NewInt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := ParseTokenAuthority(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.TokenId != tokenId || a.Issuer != issuer || !a.Has(AuthorityBurn) || a.Has(AuthorityIssue) ||
+		a.Issued.Int64() != 10 || a.Burned.Int64() != 2 {
+		t.Fatal(a)
+	}
+	if _, err := ParseTokenAuthority(nil); err == nil {
+		t.Fatal("nil data should return error")
+	}
+
+	data, err = MintageABI.PackVariable(VariableNameTokenFreeze, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err := ParseTokenFreeze(data); err != nil || !f.Frozen {
+		t.Fatal(f, err)
+	}
+}
@@ -54,6 +54,10 @@ func (c *Config) LedgerDir() string {
 	return filepath.Join(c.DataDir, "ledger")
 }
 
+func (c *Config) BackupDir() string {
+	return filepath.Join(c.DataDir, backupDir)
+}
+
 func (c *Config) WalletDir() string {
 	return filepath.Join(c.DataDir, "wallet")
 }
@@ -61,3 +65,36 @@ func (c *Config) WalletDir() string {
 func (c *Config) SqliteDir() string {
 	return filepath.Join(c.LedgerDir(), relationDir)
 }
+
+// IsIndexerEnabled returns true if the relation database indexer is enabled
+func (c *Config) IsIndexerEnabled() bool {
+	return c != nil && c.Indexer != nil && c.Indexer.Enable
+}
+
+// IsLightNode returns true if node runs in light mode
+func (c *Config) IsLightNode() bool {
+	return c != nil && c.Light != nil && c.Light.Enable
+}
+
+// IsDevnet returns true if node runs in a local development network
+func (c *Config) IsDevnet() bool {
+	return c != nil && c.Devnet != nil && c.Devnet.Enable
+}
+
+// IsCDRIngestEnabled returns true if the bulk ingestion of CDR records is enabled
+func (c *Config) IsCDRIngestEnabled() bool {
+	return c != nil && c.CDRIngest != nil && c.CDRIngest.Enable
+}
+
+// CDRDir returns the directory watched for CDR files, it is under the data directory if not set
+func (c *Config) CDRDir() string {
+	if c.CDRIngest != nil && c.CDRIngest.WatchDir != "" {
+		return c.CDRIngest.WatchDir
+	}
+	return filepath.Join(c.DataDir, "cdr")
+}
+
+// IsNativePtm returns true if private payloads are handled by the native transaction manager
+func (c *Config) IsNativePtm() bool {
+	return c != nil && c.Privacy != nil && (c.Privacy.PtmNode == "" || c.Privacy.PtmNode == PtmNodeNative)
+}
@@ -321,6 +321,7 @@ func loadGenesisAccount(cfg *Config) {
 				}
 			}
 		}
+		genesisFee = cfg.Genesis.Fee
 	}
 }
 
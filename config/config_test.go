@@ -1,6 +1,7 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/qlcchain/go-qlc/common/util"
@@ -19,3 +20,85 @@ func TestConfig_Dir(t *testing.T) {
 	t.Log(c.WalletDir())
 	t.Log(QlcTestDataDir())
 }
+
+func TestConfig_IsLightNode(t *testing.T) {
+	cfg, _ := DefaultConfig(DefaultDataDir())
+	if cfg.IsLightNode() {
+		t.Fatal("light mode should be disabled by default")
+	}
+	cfg.Light = nil
+	if cfg.IsLightNode() {
+		t.Fatal("nil light config should be disabled")
+	}
+	cfg.Light = &LightConfig{Enable: true}
+	if !cfg.IsLightNode() {
+		t.Fatal("light mode should be enabled")
+	}
+}
+
+func TestConfig_IsIndexerEnabled(t *testing.T) {
+	cfg, _ := DefaultConfig(DefaultDataDir())
+	if cfg.IsIndexerEnabled() {
+		t.Fatal("indexer should be disabled by default")
+	}
+	cfg.Indexer = nil
+	if cfg.IsIndexerEnabled() {
+		t.Fatal("nil indexer config should be disabled")
+	}
+	cfg.Indexer = &IndexerConfig{Enable: true}
+	if !cfg.IsIndexerEnabled() {
+		t.Fatal("indexer should be enabled")
+	}
+}
+
+func TestConfig_IsDevnet(t *testing.T) {
+	cfg, _ := DefaultConfig(DefaultDataDir())
+	if cfg.IsDevnet() {
+		t.Fatal("devnet should be disabled by default")
+	}
+	cfg.Devnet = nil
+	if cfg.IsDevnet() {
+		t.Fatal("nil devnet config should be disabled")
+	}
+	cfg.Devnet = &DevnetConfig{Enable: true}
+	if !cfg.IsDevnet() {
+		t.Fatal("devnet should be enabled")
+	}
+}
+
+func TestConfig_IsCDRIngestEnabled(t *testing.T) {
+	cfg, _ := DefaultConfig(DefaultDataDir())
+	if cfg.IsCDRIngestEnabled() {
+		t.Fatal("CDR ingestion should be disabled by default")
+	}
+	if cfg.CDRDir() != filepath.Join(cfg.DataDir, "cdr") {
+		t.Fatal("invalid default CDR dir", cfg.CDRDir())
+	}
+	cfg.CDRIngest = nil
+	if cfg.IsCDRIngestEnabled() {
+		t.Fatal("nil CDR ingestion config should be disabled")
+	}
+	cfg.CDRIngest = &CDRIngestConfig{Enable: true, WatchDir: "/tmp/cdr"}
+	if !cfg.IsCDRIngestEnabled() || cfg.CDRDir() != "/tmp/cdr" {
+		t.Fatal("CDR ingestion should be enabled")
+	}
+}
+
+func TestConfig_IsNativePtm(t *testing.T) {
+	cfg, _ := DefaultConfig(DefaultDataDir())
+	if !cfg.IsNativePtm() {
+		t.Fatal("native ptm should be used by default")
+	}
+	cfg.Privacy.PtmNode = PtmNodeNative
+	if !cfg.IsNativePtm() {
+		t.Fatal("native ptm should be used")
+	}
+	cfg.Privacy.PtmNode = "unix:/tmp/tm.ipc"
+	if cfg.IsNativePtm() {
+		t.Fatal("external ptm should be used")
+	}
+	cfg.Privacy = nil
+	if cfg.IsNativePtm() {
+		t.Fatal("nil privacy config should not use native ptm")
+	}
+}
@@ -0,0 +1,14 @@
+// +build  testnet
+
+package config
+
+type ConfigV10 struct {
+	ConfigV9 `mapstructure:",squash"`
+}
+
+func DefaultConfigV10(dir string) (*ConfigV10, error) {
+	var cfg ConfigV10
+	cfg9, _ := DefaultConfigV9(dir)
+	cfg.ConfigV9 = *cfg9
+	return &cfg, nil
+}
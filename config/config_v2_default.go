@@ -68,6 +68,6 @@ func defaultGRPCConfig() *GRPCConfig {
 func defaultModules() []string {
 	modules := []string{"ledger", "account", "net", "util", "mintage", "contract", "pledge",
 		"rewards", "pov", "miner", "config", "debug", "destroy", "metrics", "rep", "chain", "dpki",
-		"permission", "privacy", "ptmkey"}
+		"permission", "privacy", "ptmkey", "multisig", "dpos", "timelock"}
 	return modules
 }
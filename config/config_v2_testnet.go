@@ -67,6 +67,6 @@ func defaultGRPCConfig() *GRPCConfig {
 func defaultModules() []string {
 	modules := []string{"ledger", "account", "net", "util", "mintage", "contract", "pledge",
 		"rewards", "pov", "miner", "config", "debug", "destroy", "metrics", "rep", "chain", "dpki", "settlement",
-		"permission", "privacy", "ptmkey", "DoDSettlement"}
+		"permission", "privacy", "ptmkey", "DoDSettlement", "multisig", "dpos", "timelock"}
 	return modules
 }
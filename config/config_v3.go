@@ -34,6 +34,7 @@ func DefaultConfigV3(dir string) (*ConfigV3, error) {
 
 var (
 	relationDir = "relation"
+	backupDir   = "backup"
 	pwLen       = 16
 )
 
@@ -13,6 +13,7 @@ type ConfigV6 struct {
 
 type Genesis struct {
 	GenesisBlocks []*GenesisInfo `json:"genesisBlocks"`
+	Fee           *FeeConfig     `json:"fee,omitempty"`
 }
 
 type GenesisInfo struct {
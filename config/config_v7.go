@@ -6,9 +6,14 @@ type ConfigV7 struct {
 	WhiteList *WhiteList `json:"whiteList"`
 }
 
+// PtmNodeNative selects the in-process transaction manager, it is also used when PtmNode is empty
+const PtmNodeNative = "native"
+
 type Privacy struct {
 	Enable  bool   `json:"enable"`
 	PtmNode string `json:"ptmNode"`
+	// base64 encoded private key of the native transaction manager, derived from the p2p identity if empty
+	PtmKey string `json:"ptmKey,omitempty"`
 }
 
 type WhiteList struct {
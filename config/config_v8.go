@@ -1,7 +1,91 @@
 package config
 
 type ConfigV8 struct {
-	ConfigV7 `mapstructure:",squash"`
+	ConfigV7  `mapstructure:",squash"`
+	Light     *LightConfig     `json:"light"`
+	Stratum   *StratumConfig   `json:"stratum"`
+	P2PCodec  *P2PCodecConfig  `json:"p2pCodec"`
+	P2PLimit  *P2PLimitConfig  `json:"p2pLimit"`
+	Indexer   *IndexerConfig   `json:"indexer"`
+	Devnet    *DevnetConfig    `json:"devnet"`
+	CDRIngest *CDRIngestConfig `json:"cdrIngest"`
+}
+
+// LightConfig enables light node mode, only pov headers and the chains of tracked accounts are synced,
+// account states are verified against pov state roots by proofs from full nodes
+type LightConfig struct {
+	Enable   bool     `json:"enable"`
+	Accounts []string `json:"accounts"`
+}
+
+// StratumConfig enables the built-in stratum v1 server for external pov miners,
+// every port serves one algorithm with its own vardiff settings
+type StratumConfig struct {
+	Enable bool                 `json:"enable"`
+	Ports  []*StratumPortConfig `json:"ports"`
+}
+
+type StratumPortConfig struct {
+	Listen   string `json:"listen"`
+	AlgoName string `json:"algoName"`
+
+	StartDiff float64 `json:"startDiff"`
+	MinDiff   float64 `json:"minDiff"`
+	MaxDiff   float64 `json:"maxDiff"`
+
+	// expected seconds between two shares of a connection
+	TargetTime int `json:"targetTime"`
+	// seconds between two difficulty adjustments of a connection
+	RetargetTime int `json:"retargetTime"`
+}
+
+// P2PCodecConfig sets the payload compression of p2p messages, the first algorithm in
+// Compressions supported by remote peer is used, payload smaller than MinSize is not compressed
+type P2PCodecConfig struct {
+	Enable       bool     `json:"enable"`
+	Compressions []string `json:"compressions"`
+	MinSize      int      `json:"minSize"`
+}
+
+// P2PLimitConfig limits the inbound messages of every peer by token buckets, messages over the limits
+// are dropped, MaxInboundQueue caps the sync messages being processed at the same time
+type P2PLimitConfig struct {
+	Enable          bool               `json:"enable"`
+	MaxInboundQueue int                `json:"maxInboundQueue"`
+	PeerBytesRate   int                `json:"peerBytesRate"`
+	PeerBytesBurst  int                `json:"peerBytesBurst"`
+	Messages        []*P2PMessageLimit `json:"messages"`
+}
+
+// P2PMessageLimit allows Rate messages per second with bursts of at most Burst messages of the type from one peer
+type P2PMessageLimit struct {
+	Type  string  `json:"type"`
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// IndexerConfig enables the indexer which writes confirmed blocks, accounts, pendings, pov blocks and contract
+// records into normalized tables of the relation database, derived tables are refreshed every FlushInterval seconds
+type IndexerConfig struct {
+	Enable        bool `json:"enable"`
+	FlushInterval int  `json:"flushInterval"`
+}
+
+// DevnetConfig runs node in a local development network, pov blocks are sealed by the fake consensus
+// without searching nonce, one block every PovBlockInterval seconds
+type DevnetConfig struct {
+	Enable           bool `json:"enable"`
+	PovBlockInterval int  `json:"povBlockInterval"`
+}
+
+// CDRIngestConfig enables the bulk ingestion of CDR records, files named <contract address>.*.csv|json in
+// WatchDir are ingested off the ledger, pending records are sealed every Interval seconds into batches of
+// at most MaxBatchSize records and the merkle roots of batches are committed to the settlement contract
+type CDRIngestConfig struct {
+	Enable       bool   `json:"enable"`
+	WatchDir     string `json:"watchDir"`
+	Interval     int    `json:"interval"`
+	MaxBatchSize int    `json:"maxBatchSize"`
 }
 
 func DefaultConfigV8(dir string) (*ConfigV8, error) {
@@ -10,5 +94,84 @@ func DefaultConfigV8(dir string) (*ConfigV8, error) {
 	cfg.ConfigV7 = *cfg7
 	cfg.RPC.PublicModules = defaultModules()
 	cfg.RPC.GRPCConfig = defaultGRPCConfig()
+	cfg.Light = defaultLight()
+	cfg.Stratum = defaultStratum()
+	cfg.P2PCodec = defaultP2PCodec()
+	cfg.P2PLimit = defaultP2PLimit()
+	cfg.Indexer = defaultIndexer()
+	cfg.Devnet = defaultDevnet()
+	cfg.CDRIngest = defaultCDRIngest()
 	return &cfg, nil
 }
+
+func defaultLight() *LightConfig {
+	return &LightConfig{
+		Enable:   false,
+		Accounts: []string{},
+	}
+}
+
+func defaultStratum() *StratumConfig {
+	return &StratumConfig{
+		Enable: false,
+		Ports: []*StratumPortConfig{
+			{Listen: "tcp4://0.0.0.0:3333", AlgoName: "SHA256D", StartDiff: 16384, MinDiff: 1024, MaxDiff: 1 << 40, TargetTime: 15, RetargetTime: 90},
+			{Listen: "tcp4://0.0.0.0:3334", AlgoName: "SCRYPT", StartDiff: 512, MinDiff: 16, MaxDiff: 1 << 30, TargetTime: 15, RetargetTime: 90},
+			{Listen: "tcp4://0.0.0.0:3335", AlgoName: "X11", StartDiff: 1024, MinDiff: 16, MaxDiff: 1 << 30, TargetTime: 15, RetargetTime: 90},
+		},
+	}
+}
+
+func defaultP2PCodec() *P2PCodecConfig {
+	return &P2PCodecConfig{
+		Enable:       true,
+		Compressions: []string{"zstd", "snappy"},
+		MinSize:      256,
+	}
+}
+
+func defaultP2PLimit() *P2PLimitConfig {
+	return &P2PLimitConfig{
+		Enable:          true,
+		MaxInboundQueue: 1024,
+		PeerBytesRate:   8 * 1024 * 1024,
+		PeerBytesBurst:  32 * 1024 * 1024,
+		Messages: []*P2PMessageLimit{
+			{Type: "PublishReq", Rate: 200, Burst: 400},
+			{Type: "ConfirmReq", Rate: 200, Burst: 400},
+			{Type: "ConfirmAck", Rate: 500, Burst: 1000},
+			{Type: "FrontierRequest", Rate: 2, Burst: 5},
+			{Type: "BulkPullRequest", Rate: 5, Burst: 10},
+			{Type: "PovPublishReq", Rate: 20, Burst: 50},
+			{Type: "PovBulkPullReq", Rate: 5, Burst: 10},
+			{Type: "LightHeaderReq", Rate: 10, Burst: 20},
+			{Type: "LightAccountReq", Rate: 10, Burst: 20},
+			{Type: "LightBlocksReq", Rate: 10, Burst: 20},
+			{Type: "PrivacyPayloadPush", Rate: 50, Burst: 100},
+			{Type: "PrivacyPayloadReq", Rate: 10, Burst: 20},
+		},
+	}
+}
+
+func defaultIndexer() *IndexerConfig {
+	return &IndexerConfig{
+		Enable:        false,
+		FlushInterval: 2,
+	}
+}
+
+func defaultDevnet() *DevnetConfig {
+	return &DevnetConfig{
+		Enable:           false,
+		PovBlockInterval: 2,
+	}
+}
+
+func defaultCDRIngest() *CDRIngestConfig {
+	return &CDRIngestConfig{
+		Enable:       false,
+		WatchDir:     "",
+		Interval:     600,
+		MaxBatchSize: 100000,
+	}
+}
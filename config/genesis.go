@@ -8,6 +8,8 @@
 package config
 
 import (
+	"math/big"
+
 	"github.com/qlcchain/go-qlc/common/types"
 )
 
@@ -27,8 +29,61 @@ var (
 	gasMintageHash  types.Hash
 	gasBlock        types.StateBlock
 	gasBlockHash    types.Hash
+
+	genesisFee *FeeConfig
+)
+
+const (
+	// FeeModeBurn burns the fee of blocks
+	FeeModeBurn = "burn"
+	// FeeModeRepresentative pays the fee of blocks to the fee credit of the block representative
+	FeeModeRepresentative = "representative"
 )
 
+// FeeConfig is the QGAS fee of blocks, it is part of genesis, so all nodes of a network must use the same parameters.
+// Only MinFee is enforced by ledger, the dynamic price above it is advisory, see Price
+type FeeConfig struct {
+	Enable bool `json:"enable"`
+	// fee of a block when the PoV tx pool is empty, blocks with less fee are rejected
+	MinFee types.Balance `json:"minFee"`
+	// upper limit of the dynamic fee
+	MaxFee types.Balance `json:"maxFee"`
+	// burn or representative
+	Mode string `json:"mode"`
+	// pending txs of PoV tx pool which double the fee, the fee is static if it is zero
+	TargetTxs uint32 `json:"targetTxs"`
+}
+
+// Price returns the suggested fee for a block with the count of pending txs in PoV tx pool.
+// The tx pool differs between nodes, so the price is advisory only and blocks are not rejected by it
+func (f *FeeConfig) Price(pending uint32) types.Balance {
+	if f == nil || !f.Enable || f.MinFee.Int == nil {
+		return types.ZeroBalance
+	}
+	fee := new(big.Int).Set(f.MinFee.Int)
+	if f.TargetTxs > 0 && pending > 0 {
+		extra := new(big.Int).Mul(f.MinFee.Int, new(big.Int).SetUint64(uint64(pending)))
+		fee.Add(fee, extra.Div(extra, new(big.Int).SetUint64(uint64(f.TargetTxs))))
+	}
+	if f.MaxFee.Int != nil && f.MaxFee.Sign() > 0 && fee.Cmp(f.MaxFee.Int) > 0 {
+		fee.Set(f.MaxFee.Int)
+	}
+	return types.Balance{Int: fee}
+}
+
+// IsBurn returns true if fee of blocks is burned
+func (f *FeeConfig) IsBurn() bool {
+	return f.Mode != FeeModeRepresentative
+}
+
+// GenesisFee returns the fee config of genesis, fee is disabled if it is not configured
+func GenesisFee() *FeeConfig {
+	if genesisFee == nil {
+		return &FeeConfig{Enable: false, MinFee: types.ZeroBalance, MaxFee: types.ZeroBalance, Mode: FeeModeBurn}
+	}
+	return genesisFee
+}
+
 func GenesisInfos() []*GenesisInfo {
 	return genesisInfos
 }
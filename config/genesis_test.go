@@ -117,3 +117,62 @@ func TestGenesisInfo(t *testing.T) {
 		t.Fatal("get all block error")
 	}
 }
+
+func TestFeeConfig_Price(t *testing.T) {
+	var fee *FeeConfig
+	if !fee.Price(10).IsZero() {
+		t.Fatal("nil fee config should be free")
+	}
+	fee = &FeeConfig{Enable: true, MinFee: types.NewBalance(100), MaxFee: types.NewBalance(250), Mode: FeeModeBurn, TargetTxs: 10}
+	cases := []struct {
+		pending uint32
+		price   int64
+	}{
+		{0, 100},
+		{5, 150},
+		{10, 200},
+		{100, 250},
+	}
+	for _, c := range cases {
+		if p := fee.Price(c.pending); !p.Equal(types.NewBalance(c.price)) {
+			t.Fatalf("pending %d, exp: %d, act: %s", c.pending, c.price, p)
+		}
+	}
+	fee.TargetTxs = 0
+	if p := fee.Price(100); !p.Equal(types.NewBalance(100)) {
+		t.Fatal("static fee should be min fee", p)
+	}
+	fee.Enable = false
+	if !fee.Price(0).IsZero() {
+		t.Fatal("disabled fee should be free")
+	}
+	if !fee.IsBurn() {
+		t.Fatal("fee should be burned")
+	}
+}
+
+func TestGenesisFee(t *testing.T) {
+	teardownTestCase := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	manager := NewCfgManager(configDir)
+	cfg, err := manager.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if GenesisFee().Enable {
+		t.Fatal("fee should be disabled by default")
+	}
+
+	cfg.Genesis.Fee = &FeeConfig{Enable: true, MinFee: types.NewBalance(100), MaxFee: types.NewBalance(1000), Mode: FeeModeRepresentative, TargetTxs: 10}
+	if err := manager.Save(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manager.Load(); err != nil {
+		t.Fatal(err)
+	}
+	fee := GenesisFee()
+	if !fee.Enable || !fee.MinFee.Equal(types.NewBalance(100)) || fee.IsBurn() {
+		t.Fatal("invalid genesis fee ", util.ToString(fee))
+	}
+}
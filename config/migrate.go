@@ -12,3 +12,20 @@ type CfgMigrate interface {
 	StartVersion() int
 	EndVersion() int
 }
+
+// appendModules appends the RPC modules which are not enabled yet
+func appendModules(modules []string, added ...string) []string {
+	for _, a := range added {
+		found := false
+		for _, m := range modules {
+			if m == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			modules = append(modules, a)
+		}
+	}
+	return modules
+}
@@ -0,0 +1,52 @@
+// +build  testnet
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMigrationV9ToV10_Migration(t *testing.T) {
+	dir := filepath.Join(QlcTestDataDir(), "config")
+	defer func() { _ = os.RemoveAll(dir) }()
+	cfg9, err := DefaultConfigV9(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg9.RPC.PublicModules = []string{"ledger", "account", "KYC", "multisig"}
+	bytes, err := json.Marshal(cfg9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMigrationV9ToV10()
+	migration, i, err := m.Migration(bytes, 9)
+	if err != nil || i != 10 {
+		t.Fatal("migration failed")
+	}
+
+	cfg10 := &Config{}
+	err = json.Unmarshal(migration, cfg10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.startVersion != 9 {
+		t.Fatal("start version error")
+	}
+	if m.endVersion != 10 {
+		t.Fatal("end version error")
+	}
+
+	exp := []string{"ledger", "account", "KYC", "multisig", "dpos", "timelock"}
+	if len(cfg10.RPC.PublicModules) != len(exp) {
+		t.Fatal(cfg10.RPC.PublicModules)
+	}
+	for i, pm := range exp {
+		if cfg10.RPC.PublicModules[i] != pm {
+			t.Fatal(cfg10.RPC.PublicModules)
+		}
+	}
+}
@@ -139,6 +139,9 @@ type DPoS struct {
 	febRpcMsgCh         chan *topic.EventRPCSyncCallMsg
 	repVH               chan *repVoteHeart
 	exited              *sync.WaitGroup
+	repAcks             gcache.Cache
+	repSuspects         gcache.Cache
+	repDecisions        gcache.Cache
 
 	privateRecvBlocks chan *consensus.BlockSource
 	privateRecvRspCh  chan *topic.EventPrivacyRecvRspMsg
@@ -195,6 +198,9 @@ func NewDPoS(cfgFile string) *DPoS {
 		repVH:               make(chan *repVoteHeart, 409600),
 		exited:              new(sync.WaitGroup),
 		gapHeight:           make(chan uint64, 10240),
+		repAcks:             gcache.New(repAckCacheSize).Expiration(repAckCacheTime).LRU().Build(),
+		repSuspects:         gcache.New(repAckCacheSize).Expiration(repAckCacheTime).LRU().Build(),
+		repDecisions:        gcache.New(repAckCacheSize).Expiration(repAckCacheTime).LRU().Build(),
 
 		privateRecvBlocks: make(chan *consensus.BlockSource, common.DPoSMaxBlocks),
 		privateRecvRspCh:  make(chan *topic.EventPrivacyRecvRspMsg, common.DPoSMaxBlocks),
@@ -208,6 +214,7 @@ func NewDPoS(cfgFile string) *DPoS {
 	}
 
 	dps.lastGapHeight = dps.ledger.GetLastGapPovHeight()
+	dps.pruneEquivocations()
 	pb, err := dps.ledger.GetLatestPovBlock()
 	if err == nil {
 		dps.curPovHeight = pb.Header.BasHdr.Height
@@ -376,6 +383,8 @@ func (dps *DPoS) Start() {
 			}
 		case <-timerGC.C:
 			dps.confirmedBlocks.gc()
+			dps.judgeEquivocations()
+			dps.pruneEquivocations()
 		case vh := <-dps.repVH:
 			dps.heartAndVoteIncDo(vh.hash, vh.addr, vh.kind, vh.height)
 		case height := <-dps.gapHeight:
@@ -703,6 +712,8 @@ func (dps *DPoS) dispatchMsg(bs *consensus.BlockSource) {
 		dps.saveOnlineRep(ack.Account)
 
 		if dps.getAckType(ack.Sequence) != ackTypeFindRep {
+			dps.checkEquivocation(ack)
+
 			for _, h := range ack.Hash {
 				dps.logger.Infof("dps recv confirmAck block[%s]", h)
 				dps.heartAndVoteInc(h, ack.Account, onlineKindVote)
@@ -855,7 +866,7 @@ func (dps *DPoS) dispatchAckedBlock(blk *types.StateBlock, hash types.Hash, loca
 			if err != nil {
 				dps.logger.Debugf("get contract method err %s", err)
 			} else {
-				if method.Name == mintage.MethodNameMintage {
+				if mintage.IsMintageMethod(method.Name) {
 					index := dps.getProcessorIndex(input.Address)
 					if localIndex != index {
 						dps.processors[index].tokenCreateNotify(hash)
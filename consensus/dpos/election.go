@@ -150,6 +150,7 @@ func (el *Election) haveQuorum() {
 		}
 
 		dps.acTrx.roots.Delete(el.vote.id)
+		dps.recordRootDecision(el.vote.id)
 		el.dps.logger.Infof("hash:%s block has confirmed,total vote is [%s]", confirmedHash, balance)
 
 		if el.status.winner.GetHash() != confirmedHash {
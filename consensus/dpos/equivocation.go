@@ -0,0 +1,197 @@
+package dpos
+
+import (
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+const (
+	repAckCacheSize    = 102400
+	repAckCacheTime    = 30 * time.Minute
+	equivocationExpiry = 7 * 24 * time.Hour
+	repVoteSwitchGrace = 5 * time.Second
+)
+
+// repAckKey identifies the vote of a representative for one root
+type repAckKey struct {
+	account types.Address
+	root    voteKey
+}
+
+type repAck struct {
+	block *types.StateBlock
+	ack   *protos.ConfirmAckBlock
+	seen  time.Time
+}
+
+// repSuspect is a pair of acks of one representative for conflicting blocks, a is seen before b,
+// it is judged after the root is decided
+type repSuspect struct {
+	account types.Address
+	a       *repAck
+	b       *repAck
+}
+
+// pruneEquivocations removes the evidences which are older than equivocationExpiry
+func (dps *DPoS) pruneEquivocations() {
+	expired := make([]*types.Equivocation, 0)
+	deadline := time.Now().Add(-equivocationExpiry).Unix()
+	err := dps.ledger.GetEquivocations(func(value *types.Equivocation) error {
+		if value.Timestamp < deadline {
+			expired = append(expired, value)
+		}
+		return nil
+	})
+	if err != nil {
+		dps.logger.Errorf("get equivocations err %s", err)
+		return
+	}
+
+	for _, eq := range expired {
+		if err := dps.ledger.DeleteEquivocation(eq.Account, eq.Key()); err != nil {
+			dps.logger.Errorf("delete equivocation err %s", err)
+		}
+	}
+}
+
+// getAckBlock finds the voted block in elections or in ledger, ack of unknown block can not be checked
+func (dps *DPoS) getAckBlock(hash types.Hash) *types.StateBlock {
+	if val, ok := dps.hash2el.Load(hash); ok {
+		if blk, ok := val.(*Election).blocks.Load(hash); ok {
+			return blk.(*types.StateBlock)
+		}
+	}
+
+	blk, err := dps.ledger.GetStateBlock(hash)
+	if err != nil {
+		return nil
+	}
+	return blk
+}
+
+// checkEquivocation remembers the first signed ack of a representative for every root,
+// an ack for another block with the same root makes the representative a suspect
+func (dps *DPoS) checkEquivocation(ack *protos.ConfirmAckBlock) {
+	for _, h := range ack.Hash {
+		// honest reps vote for the confirmed block after the fork is decided
+		if confirmed, _ := dps.ledger.HasStateBlockConfirmed(h); confirmed {
+			continue
+		}
+
+		blk := dps.getAckBlock(h)
+		if blk == nil {
+			continue
+		}
+
+		key := repAckKey{account: ack.Account, root: getVoteKey(blk)}
+		if val, err := dps.repAcks.Get(key); err == nil {
+			prev := val.(*repAck)
+			if types.IsConflictBlock(prev.block, blk) {
+				suspect := &repSuspect{account: ack.Account, a: prev, b: &repAck{block: blk, ack: ack, seen: time.Now()}}
+				if err := dps.repSuspects.Set(key, suspect); err != nil {
+					dps.logger.Errorf("set rep suspect cache err %s", err)
+				}
+			}
+			continue
+		}
+
+		if err := dps.repAcks.Set(key, &repAck{block: blk, ack: ack, seen: time.Now()}); err != nil {
+			dps.logger.Errorf("set rep ack cache err %s", err)
+		}
+	}
+}
+
+// getRootDecision returns the confirmed block of the root of blk
+func (dps *DPoS) getRootDecision(blk *types.StateBlock) (types.Hash, bool) {
+	if blk.IsOpen() {
+		tm, err := dps.ledger.GetTokenMeta(blk.Address, blk.Token)
+		if err != nil {
+			return types.ZeroHash, false
+		}
+		return tm.OpenBlock, true
+	}
+
+	child, err := dps.ledger.GetBlockChild(blk.Previous)
+	if err != nil {
+		return types.ZeroHash, false
+	}
+	return child, true
+}
+
+// recordRootDecision remembers when the election of root is decided by this node
+func (dps *DPoS) recordRootDecision(root voteKey) {
+	if err := dps.repDecisions.Set(root, time.Now()); err != nil {
+		dps.logger.Errorf("set rep decision cache err %s", err)
+	}
+}
+
+// isVoteSwitch returns true if the representative voted for the decided block after it learned the decision,
+// which is what an honest representative does. It may learn the decision a little earlier than this node,
+// and a root decided by sync has no decision time, then the later ack for the decided block is trusted
+func (dps *DPoS) isVoteSwitch(suspect *repSuspect, decided types.Hash) bool {
+	if suspect.b.block.GetHash() != decided {
+		return false
+	}
+
+	val, err := dps.repDecisions.Get(getVoteKey(suspect.a.block))
+	if err != nil {
+		return true
+	}
+	return !suspect.b.seen.Before(val.(time.Time).Add(-repVoteSwitchGrace))
+}
+
+// judgeEquivocations reports the suspects whose root is decided, unless the later ack is a vote switch to the
+// decided block, a suspect whose root is not decided expires with the cache
+func (dps *DPoS) judgeEquivocations() {
+	for key, val := range dps.repSuspects.GetALL(true) {
+		suspect := val.(*repSuspect)
+		decided, ok := dps.getRootDecision(suspect.a.block)
+		if !ok {
+			continue
+		}
+
+		dps.repSuspects.Remove(key)
+		if dps.isVoteSwitch(suspect, decided) {
+			continue
+		}
+		dps.reportEquivocation(newEquivocation(suspect.account, suspect.a, suspect.b))
+	}
+}
+
+func newEquivocation(account types.Address, a, b *repAck) *types.Equivocation {
+	return &types.Equivocation{
+		Account:   account,
+		Root:      a.block.Root(),
+		BlockA:    a.block,
+		AckA:      &types.EquivocationAck{Sequence: a.ack.Sequence, Hashes: a.ack.Hash, Signature: a.ack.Signature},
+		BlockB:    b.block,
+		AckB:      &types.EquivocationAck{Sequence: b.ack.Sequence, Hashes: b.ack.Hash, Signature: b.ack.Signature},
+		Timestamp: time.Now().Unix(),
+	}
+}
+
+// reportEquivocation saves and publishes the evidence, it is local to this node and does not change
+// the online weight of the representative, which must be agreed by all nodes
+func (dps *DPoS) reportEquivocation(eq *types.Equivocation) {
+	if err := eq.Verify(); err != nil {
+		dps.logger.Errorf("invalid equivocation of rep %s, err %s", eq.Account, err)
+		return
+	}
+
+	if has, _ := dps.ledger.HasEquivocation(eq.Account, eq.Key()); has {
+		return
+	}
+
+	dps.logger.Warnf("rep %s equivocated on root %s, block %s and %s", eq.Account, eq.Root,
+		eq.BlockA.GetHash(), eq.BlockB.GetHash())
+
+	if err := dps.ledger.AddEquivocation(eq); err != nil {
+		dps.logger.Errorf("add equivocation err %s", err)
+		return
+	}
+
+	dps.eb.Publish(topic.EventRepEquivocation, eq)
+}
@@ -0,0 +1,212 @@
+package dpos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func countEquivocations(t *testing.T, dps *DPoS) int {
+	count := 0
+	if err := dps.ledger.GetEquivocations(func(value *types.Equivocation) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+// addForkParent adds the confirmed parent block of forks
+func addForkParent(t *testing.T, dps *DPoS) types.Hash {
+	parent := mock.StateBlockWithoutWork()
+	parent.Previous = types.ZeroHash
+	if err := dps.ledger.AddStateBlock(parent); err != nil {
+		t.Fatal(err)
+	}
+	return parent.GetHash()
+}
+
+func TestCheckEquivocation(t *testing.T) {
+	dps := getTestDpos()
+
+	blkA := mock.StateBlockWithoutWork()
+	blkA.Previous = addForkParent(t, dps)
+	blkB := blkA.Clone()
+	blkB.Timestamp++
+	blkC := blkA.Clone()
+	blkC.Timestamp += 2
+
+	// all forked blocks are in the same election
+	el := newElection(dps, blkA)
+	for _, blk := range []*types.StateBlock{blkB, blkC} {
+		el.blocks.Store(blk.GetHash(), blk)
+		dps.hash2el.Store(blk.GetHash(), el)
+	}
+
+	rep := mock.Account()
+	ackA, _ := dps.voteGenerateWithSeq(blkA, rep.Address(), rep, ackTypeCommon)
+	ackB, _ := dps.voteGenerateWithSeq(blkB, rep.Address(), rep, ackTypeCommon)
+
+	// repeated votes for the same block are fine
+	dps.checkEquivocation(ackA)
+	dps.checkEquivocation(ackA)
+	if dps.repSuspects.Len(false) != 0 {
+		t.Fatal("rep should not be suspect")
+	}
+
+	// conflicting votes are judged after the root is decided
+	dps.checkEquivocation(ackB)
+	if dps.repSuspects.Len(false) != 1 {
+		t.Fatal("rep should be suspect")
+	}
+	dps.judgeEquivocations()
+	if countEquivocations(t, dps) != 0 || dps.repSuspects.Len(false) != 1 {
+		t.Fatal("undecided root should not be judged")
+	}
+
+	// root is decided on a third block, both votes were signed before the decision
+	if err := dps.ledger.AddStateBlock(blkC); err != nil {
+		t.Fatal(err)
+	}
+	dps.judgeEquivocations()
+	if dps.repSuspects.Len(false) != 0 {
+		t.Fatal("suspect should be judged")
+	}
+
+	eqs := make([]*types.Equivocation, 0)
+	err := dps.ledger.GetEquivocationsByAccount(rep.Address(), func(value *types.Equivocation) error {
+		eqs = append(eqs, value)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(eqs) != 1 || eqs[0].Verify() != nil || eqs[0].Root != blkA.Root() {
+		t.Fatal("invalid equivocation evidence")
+	}
+
+	// votes for the confirmed block are not checked
+	ackC, _ := dps.voteGenerateWithSeq(blkC, rep.Address(), rep, ackTypeCommon)
+	dps.checkEquivocation(ackC)
+	if dps.repSuspects.Len(false) != 0 {
+		t.Fatal("vote for confirmed block should be ignored")
+	}
+
+	// forged ack is not accepted as evidence
+	other := mock.Account()
+	forged, _ := dps.voteGenerateWithSeq(blkA, other.Address(), other, ackTypeCommon)
+	dps.checkEquivocation(forged)
+	forged, _ = dps.voteGenerateWithSeq(blkB, other.Address(), rep, ackTypeCommon)
+	dps.checkEquivocation(forged)
+	dps.judgeEquivocations()
+	if countEquivocations(t, dps) != 1 {
+		t.Fatal("forged ack should be ignored")
+	}
+
+	// evidences are not counted against online reps
+	dps.onlineReps.Store(rep.Address(), time.Now().Add(repTimeout).Unix())
+	dps.cleanOnlineReps()
+	reps, _ := dps.ledger.GetOnlineRepresentations()
+	found := false
+	for _, r := range reps {
+		if r == rep.Address() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("rep should be online")
+	}
+
+	// evidences expire
+	eqs[0].Timestamp = time.Now().Add(-equivocationExpiry - time.Minute).Unix()
+	if err := dps.ledger.AddEquivocation(eqs[0]); err != nil {
+		t.Fatal(err)
+	}
+	dps.pruneEquivocations()
+	if countEquivocations(t, dps) != 0 {
+		t.Fatal("expired evidence should be pruned")
+	}
+}
+
+// addTwoBlockFork returns two conflicting blocks in the same election
+func addTwoBlockFork(t *testing.T, dps *DPoS) (*types.StateBlock, *types.StateBlock) {
+	blkA := mock.StateBlockWithoutWork()
+	blkA.Previous = addForkParent(t, dps)
+	blkB := blkA.Clone()
+	blkB.Timestamp++
+
+	el := newElection(dps, blkA)
+	el.blocks.Store(blkB.GetHash(), blkB)
+	dps.hash2el.Store(blkB.GetHash(), el)
+	return blkA, blkB
+}
+
+func TestJudgeEquivocation_ChangedVote(t *testing.T) {
+	dps := getTestDpos()
+	blkA, blkB := addTwoBlockFork(t, dps)
+
+	// rep voted for A, then for B after B is decided
+	rep := mock.Account()
+	ackA, _ := dps.voteGenerateWithSeq(blkA, rep.Address(), rep, ackTypeCommon)
+	ackB, _ := dps.voteGenerateWithSeq(blkB, rep.Address(), rep, ackTypeCommon)
+	dps.checkEquivocation(ackA)
+	dps.recordRootDecision(getVoteKey(blkB))
+	dps.checkEquivocation(ackB)
+
+	if err := dps.ledger.AddStateBlock(blkB); err != nil {
+		t.Fatal(err)
+	}
+	dps.judgeEquivocations()
+	if countEquivocations(t, dps) != 0 || dps.repSuspects.Len(false) != 0 {
+		t.Fatal("vote for the decided block should not be equivocation")
+	}
+}
+
+func TestJudgeEquivocation_TwoBlockFork(t *testing.T) {
+	dps := getTestDpos()
+	blkA, blkB := addTwoBlockFork(t, dps)
+
+	// rep voted for both blocks before the root is decided on B
+	rep := mock.Account()
+	ackA, _ := dps.voteGenerateWithSeq(blkA, rep.Address(), rep, ackTypeCommon)
+	ackB, _ := dps.voteGenerateWithSeq(blkB, rep.Address(), rep, ackTypeCommon)
+	dps.checkEquivocation(ackA)
+	dps.checkEquivocation(ackB)
+	if val, err := dps.repSuspects.Get(repAckKey{account: rep.Address(), root: getVoteKey(blkA)}); err == nil {
+		val.(*repSuspect).b.seen = time.Now().Add(-repVoteSwitchGrace - time.Second)
+	} else {
+		t.Fatal(err)
+	}
+
+	dps.recordRootDecision(getVoteKey(blkB))
+	if err := dps.ledger.AddStateBlock(blkB); err != nil {
+		t.Fatal(err)
+	}
+	dps.judgeEquivocations()
+	if countEquivocations(t, dps) != 1 || dps.repSuspects.Len(false) != 0 {
+		t.Fatal("votes for both blocks of fork should be equivocation")
+	}
+}
+
+func TestJudgeEquivocation_VoteForWinnerFirst(t *testing.T) {
+	dps := getTestDpos()
+	blkA, blkB := addTwoBlockFork(t, dps)
+
+	// rep voted for A which is decided, then for B
+	rep := mock.Account()
+	ackA, _ := dps.voteGenerateWithSeq(blkA, rep.Address(), rep, ackTypeCommon)
+	ackB, _ := dps.voteGenerateWithSeq(blkB, rep.Address(), rep, ackTypeCommon)
+	dps.checkEquivocation(ackA)
+	dps.checkEquivocation(ackB)
+
+	if err := dps.ledger.AddStateBlock(blkA); err != nil {
+		t.Fatal(err)
+	}
+	dps.judgeEquivocations()
+	if countEquivocations(t, dps) != 1 {
+		t.Fatal("vote away from the decided block should be equivocation")
+	}
+}
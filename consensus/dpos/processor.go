@@ -523,6 +523,8 @@ func (p *Processor) processResult(result process.ProcessResult, bs *consensus.Bl
 		// dps.processGapSmartContract(blk)
 	case process.InvalidData:
 		dps.logger.Errorf("InvalidData for block: %s", hash)
+	case process.InsufficientFee:
+		dps.logger.Errorf("Insufficient fee for block: %s", hash)
 	case process.Other:
 		dps.logger.Errorf("UnKnow process result for block: %s", hash)
 	case process.Fork:
@@ -891,8 +893,8 @@ func (p *Processor) dequeueGapToken(hash types.Hash) {
 		return
 	}
 
-	param := new(mintage.ParamMintage)
-	if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintage, input.GetData()); err != nil {
+	param, err := mintage.ParseMintageParam(input.GetData())
+	if err != nil {
 		return
 	}
 
@@ -26,6 +26,8 @@ type ConsensusPov interface {
 	PrepareHeader(header *types.PovHeader) error
 	FinalizeHeader(header *types.PovHeader) error
 	VerifyHeader(header *types.PovHeader) error
+	// VerifyTarget only checks the work of header, it does not need the state of previous block
+	VerifyTarget(header *types.PovHeader) error
 }
 
 func NewPovConsensus(mode int, chainR PovConsensusChainReader) ConsensusPov {
@@ -165,6 +165,11 @@ func (bc *PovBlockChain) Init() error {
 		bc.genesisBlock = genesisBlock
 	}
 
+	// light node only has headers, no block bodies and states
+	if bc.config.IsLightNode() {
+		return bc.loadLastHeader()
+	}
+
 	err = bc.loadLastState()
 	if err != nil {
 		return err
@@ -180,6 +185,9 @@ func (bc *PovBlockChain) Init() error {
 }
 
 func (bc *PovBlockChain) Start() error {
+	if bc.config.IsLightNode() {
+		return nil
+	}
 	common.Go(bc.statLoop)
 	return nil
 }
@@ -0,0 +1,98 @@
+package pov
+
+import (
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+func (bc *PovBlockChain) loadLastHeader() error {
+	latestHeader, err := bc.getLedger().GetLatestPovHeader()
+	if err != nil {
+		bc.logger.Errorf("failed to get latest header, err %s", err)
+		return err
+	}
+
+	bc.StoreLatestBlock(&types.PovBlock{Header: *latestHeader})
+
+	bc.logger.Infof("loaded latest header %d/%s", latestHeader.GetHeight(), latestHeader.GetHash())
+	return nil
+}
+
+// InsertHeader is used by light node to insert verified header without body to chain,
+// the best chain is switched to the header if its total difficulty is bigger than latest
+func (bc *PovBlockChain) InsertHeader(header *types.PovHeader) (ChainState, error) {
+	prevTD := bc.GetBlockTDByHash(header.GetPrevious())
+	if prevTD == nil {
+		return ChainStateNone, ErrPovUnknownAncestor
+	}
+
+	if !bc.HasHeader(header.GetHash(), header.GetHeight()) {
+		if err := bc.getLedger().AddPovHeader(header); err != nil {
+			return ChainStateNone, err
+		}
+		if err := bc.getLedger().AddPovHeight(header.GetHash(), header.GetHeight()); err != nil {
+			return ChainStateNone, err
+		}
+		td := bc.CalcTotalDifficulty(prevTD, header)
+		if err := bc.getLedger().AddPovTD(header.GetHash(), header.GetHeight(), td); err != nil {
+			return ChainStateNone, err
+		}
+		_ = bc.hashTdCache.Set(header.GetHash(), td)
+	}
+	_ = bc.hashHeaderCache.Set(header.GetHash(), header)
+
+	td := bc.GetBlockTDByHashAndHeight(header.GetHash(), header.GetHeight())
+	latestHeader := bc.LatestHeader()
+	latestTD := bc.GetBlockTDByHashAndHeight(latestHeader.GetHash(), latestHeader.GetHeight())
+	if td == nil || latestTD == nil {
+		return ChainStateNone, fmt.Errorf("failed to get td of header %s", header.GetHash())
+	}
+	if td.Chain.Cmp(&latestTD.Chain) <= 0 {
+		return ChainStateSide, nil
+	}
+
+	// find the fork point in best chain, then switch best hashes to new chain
+	var newHeaders []*types.PovHeader
+	for iter := header; ; {
+		bestHash, _ := bc.getLedger().GetPovBestHash(iter.GetHeight())
+		if bestHash == iter.GetHash() {
+			break
+		}
+		newHeaders = append(newHeaders, iter)
+		iter = bc.GetHeaderByHash(iter.GetPrevious())
+		if iter == nil {
+			return ChainStateNone, ErrPovInvalidFork
+		}
+	}
+	forkHeight := header.GetHeight() - uint64(len(newHeaders))
+
+	err := bc.getLedger().DBStore().BatchWrite(true, func(batch storage.Batch) error {
+		for height := latestHeader.GetHeight(); height > forkHeight; height-- {
+			if err := bc.getLedger().DeletePovBestHash(height, batch); err != nil {
+				return err
+			}
+		}
+		for _, h := range newHeaders {
+			if err := bc.getLedger().AddPovBestHash(h.GetHeight(), h.GetHash(), batch); err != nil {
+				return err
+			}
+		}
+		return bc.getLedger().SetPovLatestHeight(header.GetHeight(), batch)
+	})
+	if err != nil {
+		return ChainStateNone, err
+	}
+
+	for height := latestHeader.GetHeight(); height > forkHeight; height-- {
+		bc.heightBlockCache.Remove(height)
+		bc.heightHeaderCache.Remove(height)
+	}
+	for _, h := range newHeaders {
+		_ = bc.heightHeaderCache.Set(h.GetHeight(), h)
+	}
+	bc.StoreLatestBlock(&types.PovBlock{Header: *header})
+
+	return ChainStateMain, nil
+}
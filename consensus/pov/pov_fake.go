@@ -48,6 +48,10 @@ func (c *ConsensusFake) VerifyHeader(header *types.PovHeader) error {
 	return nil
 }
 
+func (c *ConsensusFake) VerifyTarget(header *types.PovHeader) error {
+	return c.VerifyHeader(header)
+}
+
 func (c *ConsensusFake) calcNextRequiredTarget(header *types.PovHeader) (uint32, error) {
 	return common.PovGenesisPowBits, nil
 }
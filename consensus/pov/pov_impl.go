@@ -1,6 +1,7 @@
 package pov
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -42,6 +43,8 @@ type PoVEngine struct {
 	cs       ConsensusPov
 	verifier *PovVerifier
 	syncer   *PovSyncer
+	lightSrv *PovLightServer
+	lightSyn *PovLightSyncer
 
 	quitCh         chan struct{}
 	febRpcMsgCh    chan *topic.EventRPCSyncCallMsg
@@ -79,10 +82,41 @@ func NewPovEngine(cfgFile string, fakePow bool) (*PoVEngine, error) {
 
 	pov.bp = NewPovBlockProcessor(pov.eb, pov.ledger, pov.chain, pov.verifier, pov.syncer)
 
+	if cfg.IsLightNode() {
+		pov.lightSyn = NewPovLightSyncer(pov.eb, pov.ledger, pov.chain, pov.verifier, pov.lightAccounts())
+	} else {
+		pov.lightSrv = NewPovLightServer(pov.eb, pov.ledger, pov.chain)
+	}
+
 	return pov, nil
 }
 
+// lightAccounts returns accounts tracked by light node, which are accounts in wallet and config
+func (pov *PoVEngine) lightAccounts() []types.Address {
+	var addrs []types.Address
+	for _, acc := range pov.accounts {
+		addrs = append(addrs, acc.Address())
+	}
+	for _, s := range pov.cfg.Light.Accounts {
+		addr, err := types.HexToAddress(s)
+		if err != nil {
+			pov.logger.Errorf("invalid light account %s, %s", s, err)
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+func (pov *PoVEngine) IsLightNode() bool {
+	return pov.lightSyn != nil
+}
+
 func (pov *PoVEngine) Init() error {
+	if pov.IsLightNode() {
+		return pov.initLight()
+	}
+
 	err := pov.bp.Init()
 	if err != nil {
 		return err
@@ -103,6 +137,10 @@ func (pov *PoVEngine) Init() error {
 	if err != nil {
 		return err
 	}
+	err = pov.lightSrv.Init()
+	if err != nil {
+		return err
+	}
 
 	err = pov.setEvent()
 	if err != nil {
@@ -115,6 +153,10 @@ func (pov *PoVEngine) Init() error {
 func (pov *PoVEngine) Start() error {
 	pov.logger.Info("start pov engine service")
 
+	if pov.IsLightNode() {
+		return pov.startLight()
+	}
+
 	err := pov.txpool.Start()
 	if err != nil {
 		return err
@@ -152,6 +194,14 @@ func (pov *PoVEngine) Stop() error {
 
 	pov.unsetEvent()
 
+	if pov.IsLightNode() {
+		pov.lightSyn.Stop()
+		_ = pov.chain.Stop()
+		return nil
+	}
+
+	pov.lightSrv.Stop()
+
 	pov.syncer.Stop()
 
 	pov.txpool.Stop()
@@ -165,6 +215,33 @@ func (pov *PoVEngine) Stop() error {
 	return nil
 }
 
+// initLight only inits chain and light syncer, blocks are not processed by light node
+func (pov *PoVEngine) initLight() error {
+	err := pov.chain.Init()
+	if err != nil {
+		return err
+	}
+	err = pov.lightSyn.Init()
+	if err != nil {
+		return err
+	}
+	return pov.setEvent()
+}
+
+func (pov *PoVEngine) startLight() error {
+	err := pov.chain.Start()
+	if err != nil {
+		return err
+	}
+	err = pov.lightSyn.Start()
+	if err != nil {
+		return err
+	}
+
+	common.Go(pov.loop)
+	return nil
+}
+
 func (pov *PoVEngine) GetConfig() *config.Config {
 	return pov.cfg
 }
@@ -202,6 +279,9 @@ func (pov *PoVEngine) GetAccounts() []*types.Account {
 }
 
 func (pov *PoVEngine) AddMinedBlock(block *types.PovBlock) error {
+	if pov.IsLightNode() {
+		return errors.New("light node can not add mined block")
+	}
 	_ = pov.blkRecvCache.Set(block.GetHash(), struct{}{})
 	err := pov.bp.AddMinedBlock(block)
 	if err == nil {
@@ -255,6 +335,13 @@ func (pov *PoVEngine) unsetEvent() {
 }
 
 func (pov *PoVEngine) onRecvPovBlock(msg *topic.EventPovRecvBlockMsg) error {
+	if pov.IsLightNode() {
+		if msg.ResponseChan != nil {
+			msg.ResponseChan <- errors.New("light node does not process pov blocks")
+		}
+		return nil
+	}
+
 	blockHash := msg.Block.GetHash()
 
 	if msg.From == types.PovBlockFromLocal {
@@ -302,11 +389,25 @@ func (pov *PoVEngine) onEventRPCSyncCall(msg *topic.EventRPCSyncCallMsg) {
 		pov.getDebugInfo(msg.In, msg.Out)
 		needRsp = true
 	}
+	if msg.Name == "Pov.PendingTxNum" {
+		pov.getPendingTxNum(msg.In, msg.Out)
+		needRsp = true
+	}
 	if needRsp && msg.ResponseChan != nil {
 		msg.ResponseChan <- msg.Out
 	}
 }
 
+func (pov *PoVEngine) getPendingTxNum(in interface{}, out interface{}) {
+	outArgs := out.(map[string]interface{})
+
+	outArgs["err"] = nil
+	outArgs["pendingTxNum"] = uint32(0)
+	if pov.txpool != nil {
+		outArgs["pendingTxNum"] = pov.txpool.GetPendingTxNum()
+	}
+}
+
 func (pov *PoVEngine) getDebugInfo(in interface{}, out interface{}) {
 	outArgs := out.(map[string]interface{})
 
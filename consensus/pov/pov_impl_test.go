@@ -109,6 +109,22 @@ func TestPovImpl_StartStop(t *testing.T) {
 		t.Fatal("ResponseChan timeout")
 	}
 
+	msg = &topic.EventRPCSyncCallMsg{
+		Name:         "Pov.PendingTxNum",
+		In:           make(map[string]interface{}),
+		Out:          make(map[string]interface{}),
+		ResponseChan: make(chan interface{}, 1),
+	}
+	povImpl.onEventRPCSyncCall(msg)
+	select {
+	case out := <-msg.ResponseChan:
+		if _, ok := out.(map[string]interface{})["pendingTxNum"].(uint32); !ok {
+			t.Fatal("invalid pending tx num")
+		}
+	case <-time.After(10 * time.Millisecond):
+		t.Fatal("ResponseChan timeout")
+	}
+
 	err = povImpl.Stop()
 	if err != nil {
 		t.Fatal(err)
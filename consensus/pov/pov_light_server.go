@@ -0,0 +1,180 @@
+package pov
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+const (
+	maxLightHeaderPerReq  = 1000
+	maxLightAccountPerReq = 100
+	maxLightBlockPerReq   = 500
+)
+
+type PovLightServerChainReader interface {
+	TrieDb() storage.Store
+	LatestHeader() *types.PovHeader
+	GetHeaderByHeight(height uint64) *types.PovHeader
+}
+
+// PovLightServer runs in full node, it serves headers, account state proofs and account chains to light nodes
+type PovLightServer struct {
+	eb         event.EventBus
+	subscriber *event.ActorSubscriber
+	ledger     ledger.Store
+	chain      PovLightServerChainReader
+	logger     *zap.SugaredLogger
+}
+
+func NewPovLightServer(eb event.EventBus, l ledger.Store, chain PovLightServerChainReader) *PovLightServer {
+	return &PovLightServer{
+		eb:     eb,
+		ledger: l,
+		chain:  chain,
+		logger: log.NewLogger("pov_light_server"),
+	}
+}
+
+func (ls *PovLightServer) Init() error {
+	if ls.eb == nil {
+		return nil
+	}
+
+	ls.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+		switch msg := c.Message().(type) {
+		case *p2p.EventLightHeaderReqMsg:
+			ls.onLightHeaderReq(msg.Req, msg.From)
+		case *p2p.EventLightAccountReqMsg:
+			ls.onLightAccountReq(msg.Req, msg.From)
+		case *p2p.EventLightBlocksReqMsg:
+			ls.onLightBlocksReq(msg.Req, msg.From)
+		}
+	}), ls.eb)
+
+	if err := ls.subscriber.Subscribe(topic.EventLightHeaderReq, topic.EventLightAccountReq, topic.EventLightBlocksReq); err != nil {
+		ls.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (ls *PovLightServer) Stop() {
+	if ls.subscriber != nil {
+		if err := ls.subscriber.UnsubscribeAll(); err != nil {
+			ls.logger.Error(err)
+		}
+	}
+}
+
+func (ls *PovLightServer) onLightHeaderReq(req *protos.LightHeaderReq, msgPeer string) {
+	ls.logger.Debugf("recv LightHeaderReq from peer %s, height %d count %d", msgPeer, req.StartHeight, req.Count)
+
+	rsp := ls.getHeaders(req)
+	ls.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.LightHeaderRsp,
+		Message: rsp,
+		PeerID:  msgPeer,
+	})
+}
+
+func (ls *PovLightServer) getHeaders(req *protos.LightHeaderReq) *protos.LightHeaderRsp {
+	count := req.Count
+	if count == 0 || count > maxLightHeaderPerReq {
+		count = maxLightHeaderPerReq
+	}
+
+	rsp := &protos.LightHeaderRsp{Headers: make([]*types.PovHeader, 0)}
+	for height := req.StartHeight; height < req.StartHeight+uint64(count); height++ {
+		header := ls.chain.GetHeaderByHeight(height)
+		if header == nil {
+			break
+		}
+		rsp.Headers = append(rsp.Headers, header)
+	}
+	return rsp
+}
+
+func (ls *PovLightServer) onLightAccountReq(req *protos.LightAccountReq, msgPeer string) {
+	ls.logger.Debugf("recv LightAccountReq from peer %s, height %d accounts %d", msgPeer, req.Height, len(req.Addresses))
+
+	rsp := ls.getAccountProofs(req)
+	if rsp == nil {
+		return
+	}
+	ls.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.LightAccountRsp,
+		Message: rsp,
+		PeerID:  msgPeer,
+	})
+}
+
+func (ls *PovLightServer) getAccountProofs(req *protos.LightAccountReq) *protos.LightAccountRsp {
+	var header *types.PovHeader
+	if req.Height == 0 {
+		header = ls.chain.LatestHeader()
+	} else {
+		header = ls.chain.GetHeaderByHeight(req.Height)
+	}
+	if header == nil {
+		ls.logger.Debugf("failed to get header by height %d", req.Height)
+		return nil
+	}
+
+	gsdb := statedb.NewPovGlobalStateDB(ls.chain.TrieDb(), header.GetStateHash())
+	rsp := &protos.LightAccountRsp{
+		Height: header.GetHeight(),
+		Hash:   header.GetHash(),
+		Proofs: make([]*protos.LightStateProof, 0, len(req.Addresses)),
+	}
+	for i, addr := range req.Addresses {
+		if i >= maxLightAccountPerReq {
+			break
+		}
+		// account not exist in state trie has an empty proof
+		proof, _ := gsdb.GetAccountStateProof(addr)
+		rsp.Proofs = append(rsp.Proofs, &protos.LightStateProof{Address: addr, Proof: proof})
+	}
+	return rsp
+}
+
+func (ls *PovLightServer) onLightBlocksReq(req *protos.LightBlocksReq, msgPeer string) {
+	ls.logger.Debugf("recv LightBlocksReq from peer %s, hash %s count %d", msgPeer, req.StartHash, req.Count)
+
+	rsp := ls.getBlocks(req)
+	ls.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.LightBlocksRsp,
+		Message: rsp,
+		PeerID:  msgPeer,
+	})
+}
+
+// getBlocks returns blocks from start hash back to the open block of the token chain
+func (ls *PovLightServer) getBlocks(req *protos.LightBlocksReq) *protos.LightBlocksRsp {
+	count := req.Count
+	if count == 0 || count > maxLightBlockPerReq {
+		count = maxLightBlockPerReq
+	}
+
+	rsp := &protos.LightBlocksRsp{StartHash: req.StartHash, Blocks: make(types.StateBlockList, 0)}
+	hash := req.StartHash
+	for i := uint32(0); i < count && !hash.IsZero(); i++ {
+		blk, err := ls.ledger.GetStateBlockConfirmed(hash)
+		if err != nil {
+			ls.logger.Debugf("failed to get block %s, err %s", hash, err)
+			break
+		}
+		rsp.Blocks = append(rsp.Blocks, blk)
+		hash = blk.GetPrevious()
+	}
+	return rsp
+}
@@ -0,0 +1,494 @@
+package pov
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+var (
+	LightSyncCheckTime  = 5 * time.Second
+	LightSyncReqTimeout = 30 * time.Second
+)
+
+type PovLightSyncerChainReader interface {
+	GenesisBlock() *types.PovBlock
+	LatestHeader() *types.PovHeader
+	GetHeaderByHeight(height uint64) *types.PovHeader
+	GetHeaderByHash(hash types.Hash) *types.PovHeader
+	GetBlockTDByHash(hash types.Hash) *types.PovTD
+	InsertHeader(header *types.PovHeader) (ChainState, error)
+}
+
+type PovLightHeaderVerifier interface {
+	VerifyHeader(header *types.PovHeader) *PovVerifyStat
+}
+
+type povLightPeer struct {
+	peerID    string
+	height    uint64
+	td        *big.Int
+	lastSeen  time.Time
+	misbehave bool
+}
+
+// povLightChain is a token chain being fetched, blocks are ordered from header to open block
+type povLightChain struct {
+	address types.Address
+	state   *types.PovAccountState
+	token   *types.PovTokenState
+	blocks  []*types.StateBlock
+	reqTime time.Time
+}
+
+// PovLightSyncer runs in light node, it syncs pov headers only, and fetches account chains of tracked
+// accounts, which are verified by account state proofs against the state hash of best header,
+// all messages are processed in main loop, so no lock is needed
+type PovLightSyncer struct {
+	eb         event.EventBus
+	subscriber *event.ActorSubscriber
+	ledger     ledger.Store
+	chain      PovLightSyncerChainReader
+	verifier   PovLightHeaderVerifier
+	accounts   []types.Address
+	logger     *zap.SugaredLogger
+
+	peers       map[string]*povLightPeer
+	syncPeerID  string
+	syncBackoff uint64
+	reqTime     time.Time
+	syncDone    *atomic.Bool
+	chains      map[types.Hash]*povLightChain
+
+	messageCh chan interface{}
+	quitCh    chan struct{}
+}
+
+func NewPovLightSyncer(eb event.EventBus, l ledger.Store, chain PovLightSyncerChainReader, verifier PovLightHeaderVerifier,
+	accounts []types.Address) *PovLightSyncer {
+	return &PovLightSyncer{
+		eb:        eb,
+		ledger:    l,
+		chain:     chain,
+		verifier:  verifier,
+		accounts:  accounts,
+		logger:    log.NewLogger("pov_light_sync"),
+		peers:     make(map[string]*povLightPeer),
+		syncDone:  atomic.NewBool(false),
+		chains:    make(map[types.Hash]*povLightChain),
+		messageCh: make(chan interface{}, 200),
+		quitCh:    make(chan struct{}),
+	}
+}
+
+func (ls *PovLightSyncer) Init() error {
+	if ls.eb == nil {
+		return nil
+	}
+
+	ls.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+		switch msg := c.Message().(type) {
+		case *topic.EventDeleteP2PStreamMsg, *p2p.EventPovPeerStatusMsg, *p2p.EventLightHeaderRspMsg,
+			*p2p.EventLightAccountRspMsg, *p2p.EventLightBlocksRspMsg:
+			ls.messageCh <- msg
+		}
+	}), ls.eb)
+
+	if err := ls.subscriber.Subscribe(topic.EventDeleteP2PStream, topic.EventPovPeerStatus, topic.EventLightHeaderRsp,
+		topic.EventLightAccountRsp, topic.EventLightBlocksRsp); err != nil {
+		ls.logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (ls *PovLightSyncer) Start() error {
+	ls.eb.Publish(topic.EventPovSyncState, topic.Syncing)
+	common.Go(ls.mainLoop)
+	return nil
+}
+
+func (ls *PovLightSyncer) Stop() {
+	if ls.subscriber != nil {
+		if err := ls.subscriber.UnsubscribeAll(); err != nil {
+			ls.logger.Error(err)
+		}
+	}
+	close(ls.quitCh)
+}
+
+func (ls *PovLightSyncer) IsSyncDone() bool {
+	return ls.syncDone.Load()
+}
+
+func (ls *PovLightSyncer) mainLoop() {
+	checkTicker := time.NewTicker(LightSyncCheckTime)
+	defer checkTicker.Stop()
+
+	for {
+		select {
+		case <-ls.quitCh:
+			return
+		case <-checkTicker.C:
+			ls.checkSync()
+		case msg := <-ls.messageCh:
+			ls.processMessage(msg)
+		}
+	}
+}
+
+func (ls *PovLightSyncer) processMessage(msg interface{}) {
+	switch m := msg.(type) {
+	case *topic.EventDeleteP2PStreamMsg:
+		delete(ls.peers, m.PeerID)
+	case *p2p.EventPovPeerStatusMsg:
+		ls.onPovStatus(m.Status, m.From)
+	case *p2p.EventLightHeaderRspMsg:
+		ls.onLightHeaderRsp(m.Rsp, m.From)
+	case *p2p.EventLightAccountRspMsg:
+		ls.onLightAccountRsp(m.Rsp, m.From)
+	case *p2p.EventLightBlocksRspMsg:
+		ls.onLightBlocksRsp(m.Rsp, m.From)
+	}
+}
+
+func (ls *PovLightSyncer) onPovStatus(status *protos.PovStatus, msgPeer string) {
+	genBlk := ls.chain.GenesisBlock()
+	if genBlk == nil || status.GenesisHash != genBlk.GetHash() {
+		ls.logger.Warnf("peer %s genesis hash %s is invalid", msgPeer, status.GenesisHash)
+		return
+	}
+
+	peer, ok := ls.peers[msgPeer]
+	if !ok {
+		peer = &povLightPeer{peerID: msgPeer}
+		ls.peers[msgPeer] = peer
+	}
+	peer.height = status.CurrentHeight
+	peer.td = new(big.Int).SetBytes(status.CurrentTD)
+	peer.lastSeen = time.Now()
+}
+
+func (ls *PovLightSyncer) getBestPeer() *povLightPeer {
+	var best *povLightPeer
+	for _, peer := range ls.peers {
+		if peer.misbehave || peer.td == nil {
+			continue
+		}
+		if best == nil || peer.td.Cmp(best.td) > 0 {
+			best = peer
+		}
+	}
+	return best
+}
+
+func (ls *PovLightSyncer) checkSync() {
+	if ls.syncPeerID != "" && time.Since(ls.reqTime) < LightSyncReqTimeout {
+		return
+	}
+	ls.syncPeerID = ""
+
+	peer := ls.getBestPeer()
+	if peer == nil {
+		return
+	}
+
+	latestHeader := ls.chain.LatestHeader()
+	latestTD := ls.chain.GetBlockTDByHash(latestHeader.GetHash())
+	if latestTD == nil {
+		ls.logger.Errorf("failed to get td of latest header %s", latestHeader.GetHash())
+		return
+	}
+
+	if peer.td.Cmp(latestTD.Chain.ToBigInt()) > 0 {
+		ls.requestHeaders(peer.peerID)
+		return
+	}
+
+	if ls.syncDone.CAS(false, true) {
+		ls.logger.Infof("light sync done, latest header %d/%s", latestHeader.GetHeight(), latestHeader.GetHash())
+		ls.eb.Publish(topic.EventPovSyncState, topic.SyncDone)
+	}
+	ls.requestAccounts(peer.peerID)
+	ls.checkChainTimeout()
+}
+
+func (ls *PovLightSyncer) requestHeaders(peerID string) {
+	startHeight := ls.chain.LatestHeader().GetHeight() + 1
+	// back off to find the fork point if headers can not be connected to local chain
+	if ls.syncBackoff >= startHeight {
+		startHeight = 1
+	} else {
+		startHeight -= ls.syncBackoff
+	}
+
+	ls.syncPeerID = peerID
+	ls.reqTime = time.Now()
+	ls.logger.Infof("request headers from peer %s, height %d", peerID, startHeight)
+	ls.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.LightHeaderReq,
+		Message: &protos.LightHeaderReq{StartHeight: startHeight, Count: maxLightHeaderPerReq},
+		PeerID:  peerID,
+	})
+}
+
+func (ls *PovLightSyncer) onLightHeaderRsp(rsp *protos.LightHeaderRsp, msgPeer string) {
+	if msgPeer != ls.syncPeerID {
+		return
+	}
+	ls.syncPeerID = ""
+
+	if len(rsp.Headers) == 0 {
+		return
+	}
+
+	if ls.chain.GetHeaderByHash(rsp.Headers[0].GetPrevious()) == nil {
+		if ls.syncBackoff == 0 {
+			ls.syncBackoff = 1
+		} else {
+			ls.syncBackoff *= 2
+		}
+		ls.logger.Infof("headers from peer %s not connected, back off %d", msgPeer, ls.syncBackoff)
+		ls.requestHeaders(msgPeer)
+		return
+	}
+	ls.syncBackoff = 0
+
+	for _, header := range rsp.Headers {
+		if err := ls.insertHeader(header); err != nil {
+			ls.logger.Warnf("invalid header %d/%s from peer %s, %s", header.GetHeight(), header.GetHash(), msgPeer, err)
+			if peer, ok := ls.peers[msgPeer]; ok {
+				peer.misbehave = true
+			}
+			return
+		}
+	}
+
+	// continue to request next headers
+	if len(rsp.Headers) >= maxLightHeaderPerReq {
+		ls.requestHeaders(msgPeer)
+	}
+}
+
+func (ls *PovLightSyncer) insertHeader(header *types.PovHeader) error {
+	if ls.chain.GetHeaderByHash(header.GetHash()) != nil {
+		return nil
+	}
+
+	stat := ls.verifier.VerifyHeader(header)
+	if stat.Result != process.Progress {
+		return fmt.Errorf("verify result %s, %s", stat.Result, stat.ErrMsg)
+	}
+
+	_, err := ls.chain.InsertHeader(header)
+	return err
+}
+
+func (ls *PovLightSyncer) requestAccounts(peerID string) {
+	if len(ls.accounts) == 0 {
+		return
+	}
+
+	latestHeader := ls.chain.LatestHeader()
+	req := &protos.LightAccountReq{Height: latestHeader.GetHeight(), Addresses: ls.accounts}
+	if len(req.Addresses) > maxLightAccountPerReq {
+		req.Addresses = req.Addresses[:maxLightAccountPerReq]
+	}
+	ls.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.LightAccountReq,
+		Message: req,
+		PeerID:  peerID,
+	})
+}
+
+func (ls *PovLightSyncer) isTrackedAccount(address types.Address) bool {
+	for _, addr := range ls.accounts {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+func (ls *PovLightSyncer) onLightAccountRsp(rsp *protos.LightAccountRsp, msgPeer string) {
+	header := ls.chain.GetHeaderByHeight(rsp.Height)
+	if header == nil || header.GetHash() != rsp.Hash {
+		ls.logger.Debugf("account proofs of header %d/%s not in best chain", rsp.Height, rsp.Hash)
+		return
+	}
+
+	for _, p := range rsp.Proofs {
+		if !ls.isTrackedAccount(p.Address) || p.Proof == nil {
+			continue
+		}
+
+		as, err := statedb.VerifyPovAccountStateProof(header.GetStateHash(), p.Address, p.Proof)
+		if err != nil {
+			ls.logger.Warnf("invalid account proof of %s from peer %s, %s", p.Address, msgPeer, err)
+			if peer, ok := ls.peers[msgPeer]; ok {
+				peer.misbehave = true
+			}
+			return
+		}
+
+		for _, ts := range as.TokenStates {
+			tm, _ := ls.ledger.GetTokenMeta(p.Address, ts.Type)
+			if tm != nil && tm.Header == ts.Hash {
+				continue
+			}
+			ls.requestChain(msgPeer, &povLightChain{address: p.Address, state: as, token: ts}, ts.Hash)
+		}
+	}
+}
+
+func (ls *PovLightSyncer) requestChain(peerID string, lc *povLightChain, startHash types.Hash) {
+	if c, ok := ls.chains[lc.token.Hash]; ok && c != lc && time.Since(c.reqTime) < LightSyncReqTimeout {
+		return
+	}
+	lc.reqTime = time.Now()
+	ls.chains[lc.token.Hash] = lc
+
+	ls.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.LightBlocksReq,
+		Message: &protos.LightBlocksReq{StartHash: startHash, Count: maxLightBlockPerReq},
+		PeerID:  peerID,
+	})
+}
+
+func (ls *PovLightSyncer) checkChainTimeout() {
+	for hash, lc := range ls.chains {
+		if time.Since(lc.reqTime) >= LightSyncReqTimeout {
+			delete(ls.chains, hash)
+		}
+	}
+}
+
+func (ls *PovLightSyncer) findChain(startHash types.Hash) *povLightChain {
+	for _, lc := range ls.chains {
+		expect := lc.token.Hash
+		if len(lc.blocks) > 0 {
+			expect = lc.blocks[len(lc.blocks)-1].GetPrevious()
+		}
+		if expect == startHash {
+			return lc
+		}
+	}
+	return nil
+}
+
+func (ls *PovLightSyncer) onLightBlocksRsp(rsp *protos.LightBlocksRsp, msgPeer string) {
+	lc := ls.findChain(rsp.StartHash)
+	if lc == nil {
+		return
+	}
+
+	done, err := ls.appendChainBlocks(lc, rsp.Blocks)
+	if err != nil {
+		ls.logger.Warnf("invalid blocks of %s from peer %s, %s", lc.address, msgPeer, err)
+		ls.removeChain(lc)
+		return
+	}
+	if !done {
+		if len(rsp.Blocks) == 0 {
+			ls.removeChain(lc)
+			return
+		}
+		ls.requestChain(msgPeer, lc, lc.blocks[len(lc.blocks)-1].GetPrevious())
+		return
+	}
+
+	ls.removeChain(lc)
+	if err := ls.saveChain(lc); err != nil {
+		ls.logger.Errorf("failed to save chain of %s, %s", lc.address, err)
+	}
+}
+
+func (ls *PovLightSyncer) removeChain(lc *povLightChain) {
+	delete(ls.chains, lc.token.Hash)
+}
+
+// appendChainBlocks checks blocks are linked by previous hash from the proved token header,
+// returns true if the chain reaches open block or a block already in local ledger
+func (ls *PovLightSyncer) appendChainBlocks(lc *povLightChain, blocks types.StateBlockList) (bool, error) {
+	for _, blk := range blocks {
+		expect := lc.token.Hash
+		if len(lc.blocks) > 0 {
+			expect = lc.blocks[len(lc.blocks)-1].GetPrevious()
+		}
+		if blk.GetHash() != expect {
+			return false, fmt.Errorf("block hash %s not equal %s", blk.GetHash(), expect)
+		}
+		if blk.GetAddress() != lc.address || blk.GetToken() != lc.token.Type {
+			return false, fmt.Errorf("block %s not belong to chain", blk.GetHash())
+		}
+		lc.blocks = append(lc.blocks, blk)
+
+		if blk.IsOpen() {
+			return true, nil
+		}
+		if ok, _ := ls.ledger.HasStateBlockConfirmed(blk.GetPrevious()); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (ls *PovLightSyncer) saveChain(lc *povLightChain) error {
+	if len(lc.blocks) == 0 {
+		return errors.New("chain is empty")
+	}
+
+	for i := len(lc.blocks) - 1; i >= 0; i-- {
+		if err := ls.ledger.AddStateBlock(lc.blocks[i]); err != nil {
+			return err
+		}
+	}
+
+	am, _ := ls.ledger.GetAccountMeta(lc.address)
+	if am == nil {
+		am = &types.AccountMeta{Address: lc.address}
+	}
+	am.CoinBalance = lc.state.Balance
+	am.CoinVote = lc.state.Vote
+	am.CoinNetwork = lc.state.Network
+	am.CoinStorage = lc.state.Storage
+	am.CoinOracle = lc.state.Oracle
+
+	last := lc.blocks[len(lc.blocks)-1]
+	tm := am.Token(lc.token.Type)
+	if tm == nil {
+		tm = &types.TokenMeta{Type: lc.token.Type, BelongTo: lc.address}
+		am.Tokens = append(am.Tokens, tm)
+	}
+	if last.IsOpen() {
+		tm.OpenBlock = last.GetHash()
+		tm.BlockCount = int64(len(lc.blocks))
+	} else {
+		tm.BlockCount += int64(len(lc.blocks))
+	}
+	tm.Header = lc.token.Hash
+	tm.Representative = lc.token.Representative
+	tm.Balance = lc.token.Balance
+	tm.Modified = common.TimeNow().Unix()
+
+	ls.logger.Infof("light sync %d blocks of %s, token %s", len(lc.blocks), lc.address, lc.token.Type)
+	return ls.ledger.Cache().BatchUpdate(func(c *ledger.Cache) error {
+		return ls.ledger.UpdateAccountMeta(am, c)
+	})
+}
@@ -0,0 +1,248 @@
+package pov
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+type povLightMockData struct {
+	eb event.EventBus
+
+	fullLedger ledger.Store
+	fullChain  *PovBlockChain
+	server     *PovLightServer
+
+	lightLedger ledger.Store
+	lightChain  *PovBlockChain
+	syncer      *PovLightSyncer
+
+	address types.Address
+	blocks  []*types.StateBlock
+}
+
+func setupPovLightTestCase(t *testing.T) (func(t *testing.T), *povLightMockData) {
+	t.Parallel()
+	md := &povLightMockData{}
+
+	uid := uuid.New().String()
+	rootDir := filepath.Join(config.QlcTestDataDir(), uid)
+	md.eb = event.GetEventBus(uid)
+
+	newLedger := func(dir string) (*config.Config, ledger.Store) {
+		cfg, _ := config.DefaultConfig(dir)
+		lDir := filepath.Join(dir, "ledger")
+		_ = os.RemoveAll(lDir)
+		cm := config.NewCfgManager(lDir)
+		_, _ = cm.Load()
+		return cfg, ledger.NewLedger(cm.ConfigFile)
+	}
+
+	fullCfg, fullLedger := newLedger(filepath.Join(rootDir, "full"))
+	md.fullLedger = fullLedger
+	md.fullChain = NewPovBlockChain(fullCfg, md.eb, md.fullLedger)
+	if err := md.fullChain.Init(); err != nil {
+		t.Fatal(err)
+	}
+	md.server = NewPovLightServer(md.eb, md.fullLedger, md.fullChain)
+
+	lightCfg, lightLedger := newLedger(filepath.Join(rootDir, "light"))
+	lightCfg.Light.Enable = true
+	md.lightLedger = lightLedger
+	md.lightChain = NewPovBlockChain(lightCfg, md.eb, md.lightLedger)
+	if err := md.lightChain.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	// account chain with open and send block in full node
+	md.address = mock.Address()
+	open := mock.StateBlockWithoutWork()
+	open.Address = md.address
+	open.Token = config.ChainToken()
+	open.Previous = types.ZeroHash
+	send := mock.StateBlockWithoutWork()
+	send.Address = md.address
+	send.Token = config.ChainToken()
+	send.Previous = open.GetHash()
+	md.blocks = []*types.StateBlock{open, send}
+	for _, blk := range md.blocks {
+		if err := md.fullLedger.AddStateBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// pov block 1 commits the account state, block 2 keeps the state
+	genBlk := md.fullChain.GenesisBlock()
+	gsdb := statedb.NewPovGlobalStateDB(md.fullChain.TrieDb(), genBlk.GetStateHash())
+	as := types.NewPovAccountState()
+	as.Account = md.address
+	as.Balance = send.Balance
+	as.TokenStates = []*types.PovTokenState{{
+		Type:           send.Token,
+		Hash:           send.GetHash(),
+		Representative: send.Representative,
+		Balance:        send.Balance,
+	}}
+	if err := gsdb.SetAccountState(md.address, as); err != nil {
+		t.Fatal(err)
+	}
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+
+	blk1, _ := mock.GeneratePovBlockByFakePow(genBlk, 0)
+	blk1.Header.CbTx.StateHash = gsdb.GetCurHash()
+	mock.UpdatePovHash(blk1)
+	if err := md.fullChain.InsertBlock(blk1, gsdb); err != nil {
+		t.Fatal(err)
+	}
+	blk2, _ := mock.GeneratePovBlockByFakePow(blk1, 0)
+	if err := md.fullChain.InsertBlock(blk2, statedb.NewPovGlobalStateDB(md.fullChain.TrieDb(), blk2.GetStateHash())); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier := NewPovVerifier(md.lightLedger, md.lightChain, NewConsensusFake(md.lightChain))
+	md.syncer = NewPovLightSyncer(md.eb, md.lightLedger, md.lightChain, verifier, []types.Address{md.address})
+
+	return func(t *testing.T) {
+		if err := md.fullLedger.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := md.lightLedger.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(rootDir); err != nil {
+			t.Fatal(err)
+		}
+	}, md
+}
+
+func (md *povLightMockData) sendStatus(peerID string) {
+	latest := md.fullChain.LatestHeader()
+	td := md.fullChain.GetBlockTDByHash(latest.GetHash())
+	md.syncer.onPovStatus(&protos.PovStatus{
+		CurrentHeight: latest.GetHeight(),
+		CurrentTD:     td.Chain.Bytes(),
+		CurrentHash:   latest.GetHash(),
+		GenesisHash:   md.fullChain.GenesisBlock().GetHash(),
+	}, peerID)
+}
+
+func TestPovLightSyncer_Sync(t *testing.T) {
+	teardownTestCase, md := setupPovLightTestCase(t)
+	defer teardownTestCase(t)
+
+	peerID := "full"
+	md.sendStatus(peerID)
+
+	// headers
+	md.syncer.checkSync()
+	if md.syncer.syncPeerID != peerID {
+		t.Fatal("headers not requested")
+	}
+	md.syncer.onLightHeaderRsp(md.server.getHeaders(&protos.LightHeaderReq{StartHeight: 1}), peerID)
+	latest := md.fullChain.LatestHeader()
+	if md.lightChain.LatestHeader().GetHash() != latest.GetHash() {
+		t.Fatalf("light latest header %d, exp %d", md.lightChain.LatestHeader().GetHeight(), latest.GetHeight())
+	}
+	if h, _ := md.lightLedger.GetLatestPovHeader(); h == nil || h.GetHash() != latest.GetHash() {
+		t.Fatal("latest header not saved")
+	}
+	md.syncer.checkSync()
+	if !md.syncer.IsSyncDone() {
+		t.Fatal("sync should be done")
+	}
+
+	// account proofs
+	rsp := md.server.getAccountProofs(&protos.LightAccountReq{Height: latest.GetHeight(), Addresses: []types.Address{md.address, mock.Address()}})
+	if rsp == nil || len(rsp.Proofs) != 2 || rsp.Proofs[0].Proof == nil || rsp.Proofs[1].Proof != nil {
+		t.Fatal("invalid account proofs")
+	}
+	md.syncer.onLightAccountRsp(rsp, peerID)
+	if len(md.syncer.chains) != 1 {
+		t.Fatal("account chain not requested")
+	}
+
+	// account chain
+	send := md.blocks[1]
+	md.syncer.onLightBlocksRsp(md.server.getBlocks(&protos.LightBlocksReq{StartHash: send.GetHash()}), peerID)
+	tm, err := md.lightLedger.GetTokenMeta(md.address, send.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm.Header != send.GetHash() || tm.OpenBlock != md.blocks[0].GetHash() || tm.BlockCount != 2 {
+		t.Fatal(tm)
+	}
+	for _, blk := range md.blocks {
+		if _, err := md.lightLedger.GetStateBlockConfirmed(blk.GetHash()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(md.syncer.chains) != 0 {
+		t.Fatal("account chain not finished")
+	}
+
+	// account is up to date
+	md.syncer.onLightAccountRsp(rsp, peerID)
+	if len(md.syncer.chains) != 0 {
+		t.Fatal("account chain should not be requested")
+	}
+}
+
+func TestPovLightSyncer_Invalid(t *testing.T) {
+	teardownTestCase, md := setupPovLightTestCase(t)
+	defer teardownTestCase(t)
+
+	peerID := "full"
+	md.sendStatus(peerID)
+	md.syncer.checkSync()
+
+	// tampered header
+	hdrRsp := md.server.getHeaders(&protos.LightHeaderReq{StartHeight: 1})
+	hdrRsp.Headers[1] = hdrRsp.Headers[1].Copy()
+	hdrRsp.Headers[1].BasHdr.Timestamp++
+	md.syncer.onLightHeaderRsp(hdrRsp, peerID)
+	if md.lightChain.LatestHeader().GetHeight() != 1 || !md.syncer.peers[peerID].misbehave {
+		t.Fatal("tampered header should be rejected")
+	}
+	if md.syncer.getBestPeer() != nil {
+		t.Fatal("misbehaved peer should not be selected")
+	}
+
+	// headers not connected to local chain
+	md.sendStatus("other")
+	md.syncer.syncPeerID = "other"
+	unknown := md.fullChain.LatestHeader().Copy()
+	unknown.BasHdr.Previous = mock.Hash()
+	md.syncer.onLightHeaderRsp(&protos.LightHeaderRsp{Headers: []*types.PovHeader{unknown}}, "other")
+	if md.syncer.syncBackoff != 1 || md.syncer.syncPeerID != "other" {
+		t.Fatal("sync should back off")
+	}
+
+	// proof of other account
+	md.syncer.syncPeerID = "other"
+	md.syncer.onLightHeaderRsp(md.server.getHeaders(&protos.LightHeaderReq{StartHeight: 2}), "other")
+	rsp := md.server.getAccountProofs(&protos.LightAccountReq{Height: 2, Addresses: []types.Address{md.address}})
+	rsp.Proofs[0].Address = mock.Address()
+	md.syncer.accounts = append(md.syncer.accounts, rsp.Proofs[0].Address)
+	md.syncer.onLightAccountRsp(rsp, "other")
+	if len(md.syncer.chains) != 0 || !md.syncer.peers["other"].misbehave {
+		t.Fatal("invalid proof should be rejected")
+	}
+
+	// blocks not linked to token header
+	lc := &povLightChain{address: md.address, token: &types.PovTokenState{Type: config.ChainToken(), Hash: md.blocks[1].GetHash()}}
+	if _, err := md.syncer.appendChainBlocks(lc, types.StateBlockList{md.blocks[0]}); err == nil {
+		t.Fatal("unlinked blocks should be rejected")
+	}
+}
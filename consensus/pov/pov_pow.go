@@ -72,6 +72,10 @@ func (c *ConsensusPow) VerifyHeader(header *types.PovHeader) error {
 	return nil
 }
 
+func (c *ConsensusPow) VerifyTarget(header *types.PovHeader) error {
+	return c.verifyTarget(header)
+}
+
 func (c *ConsensusPow) verifyProducer(header *types.PovHeader) error {
 	cfg := c.chainR.GetConfig()
 	if cfg.PoV.ChainParams.MinerPledge.Sign() <= 0 {
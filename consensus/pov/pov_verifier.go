@@ -162,6 +162,45 @@ func (pv *PovVerifier) VerifyFull(block *types.PovBlock) *PovVerifyStat {
 	return stat
 }
 
+// VerifyHeader verifies header without body and state, it is used by light node which only syncs headers
+func (pv *PovVerifier) VerifyHeader(header *types.PovHeader) *PovVerifyStat {
+	stat := NewPovVerifyStat()
+	block := &types.PovBlock{Header: *header}
+	stat.CurHeader = header
+
+	result, err := pv.verifyDataIntegrity(block, stat)
+	if err != nil || result != process.Progress {
+		stat.setResult(result, err)
+		return stat
+	}
+
+	result, err = pv.verifyTimestamp(block, stat)
+	if err != nil || result != process.Progress {
+		stat.setResult(result, err)
+		return stat
+	}
+
+	result, err = pv.verifyReferred(block, stat)
+	if err != nil || result != process.Progress {
+		stat.setResult(result, err)
+		return stat
+	}
+
+	result, err = pv.verifyAuxHeader(block, stat)
+	if err != nil || result != process.Progress {
+		stat.setResult(result, err)
+		return stat
+	}
+
+	if err := pv.cs.VerifyTarget(header); err != nil {
+		stat.setResult(process.BadConsensus, err)
+		return stat
+	}
+
+	stat.Result = process.Progress
+	return stat
+}
+
 func (pv *PovVerifier) verifyDataIntegrity(block *types.PovBlock, stat *PovVerifyStat) (process.ProcessResult, error) {
 	blkHash := block.GetHash()
 
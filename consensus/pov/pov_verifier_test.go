@@ -180,3 +180,36 @@ func TestPovVerifier_VerifyFull_Aux(t *testing.T) {
 		//t.Fatalf("result %s err %s", stat1.Result, stat1.ErrMsg)
 	}
 }
+
+func TestPovVerifier_VerifyHeader(t *testing.T) {
+	teardownTestCase, md := setupPovVerifierTestCase(t)
+	defer teardownTestCase(t)
+
+	verifier := NewPovVerifier(md.ledger, md.chainV, NewConsensusFake(md.chainCs))
+
+	genBlk, _ := mock.GenerateGenesisPovBlock()
+	blk1, _ := mock.GeneratePovBlockByFakePow(genBlk, 0)
+	stat := verifier.VerifyHeader(blk1.GetHeader())
+	if stat.Result != process.Progress {
+		t.Fatalf("result %s err %s", stat.Result, stat.ErrMsg)
+	}
+
+	blk2, _ := mock.GeneratePovBlockByFakePow(blk1, 0)
+	stat = verifier.VerifyHeader(blk2.GetHeader())
+	if stat.Result != process.GapPrevious {
+		t.Fatalf("result %s err %s", stat.Result, stat.ErrMsg)
+	}
+
+	header := blk1.GetHeader().Copy()
+	header.BasHdr.Timestamp++
+	stat = verifier.VerifyHeader(header)
+	if stat.Result != process.BadHash {
+		t.Fatalf("result %s err %s", stat.Result, stat.ErrMsg)
+	}
+
+	header.BasHdr.Hash = header.ComputeHash()
+	stat = verifier.VerifyHeader(header)
+	if stat.Result != process.BadConsensus {
+		t.Fatalf("result %s err %s", stat.Result, stat.ErrMsg)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package bip39
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/qlcchain/go-qlc/crypto/random"
+)
+
+const (
+	// DefaultEntropySize is the entropy bits of a 24 words mnemonic
+	DefaultEntropySize = 256
+	// SeedSize is the size of seed generated from mnemonic
+	SeedSize = 64
+
+	seedIterations = 2048
+	wordBits       = 11
+)
+
+var (
+	ErrInvalidEntropySize = errors.New("entropy size must be a multiple of 32 between 128 and 256")
+	ErrInvalidMnemonic    = errors.New("invalid mnemonic")
+	ErrChecksumMismatch   = errors.New("mnemonic checksum mismatch")
+
+	wordIndex = make(map[string]int, len(english))
+)
+
+func init() {
+	for i, w := range english {
+		wordIndex[w] = i
+	}
+}
+
+func checkEntropySize(bitSize int) error {
+	if bitSize < 128 || bitSize > 256 || bitSize%32 != 0 {
+		return ErrInvalidEntropySize
+	}
+	return nil
+}
+
+// NewEntropy generates random entropy of bitSize bits
+func NewEntropy(bitSize int) ([]byte, error) {
+	if err := checkEntropySize(bitSize); err != nil {
+		return nil, err
+	}
+	entropy := make([]byte, bitSize/8)
+	if err := random.Bytes(entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
+
+// NewMnemonic encodes entropy to mnemonic sentence
+func NewMnemonic(entropy []byte) (string, error) {
+	bitSize := len(entropy) * 8
+	if err := checkEntropySize(bitSize); err != nil {
+		return "", err
+	}
+	checksumBits := bitSize / 32
+
+	// entropy bits followed by the first bits of its sha256 hash
+	hash := sha256.Sum256(entropy)
+	b := new(big.Int).SetBytes(entropy)
+	b.Lsh(b, uint(checksumBits))
+	b.Or(b, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	count := (bitSize + checksumBits) / wordBits
+	words := make([]string, count)
+	mask := big.NewInt(1<<wordBits - 1)
+	for i := count - 1; i >= 0; i-- {
+		idx := new(big.Int).And(b, mask)
+		words[i] = english[idx.Int64()]
+		b.Rsh(b, wordBits)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// NewRandomMnemonic generates a new mnemonic from random entropy of bitSize bits
+func NewRandomMnemonic(bitSize int) (string, error) {
+	entropy, err := NewEntropy(bitSize)
+	if err != nil {
+		return "", err
+	}
+	return NewMnemonic(entropy)
+}
+
+// EntropyFromMnemonic decodes mnemonic sentence to entropy and verifies its checksum
+func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	count := len(words)
+	if count < 12 || count > 24 || count%3 != 0 {
+		return nil, ErrInvalidMnemonic
+	}
+
+	b := new(big.Int)
+	for _, w := range words {
+		idx, ok := wordIndex[strings.ToLower(w)]
+		if !ok {
+			return nil, fmt.Errorf("invalid mnemonic word %s", w)
+		}
+		b.Lsh(b, wordBits)
+		b.Or(b, big.NewInt(int64(idx)))
+	}
+
+	checksumBits := count * wordBits / 33
+	checksum := new(big.Int).And(b, big.NewInt(1<<uint(checksumBits)-1))
+	b.Rsh(b, uint(checksumBits))
+
+	entropy := make([]byte, checksumBits*4)
+	raw := b.Bytes()
+	copy(entropy[len(entropy)-len(raw):], raw)
+
+	hash := sha256.Sum256(entropy)
+	if int64(hash[0]>>(8-checksumBits)) != checksum.Int64() {
+		return nil, ErrChecksumMismatch
+	}
+	return entropy, nil
+}
+
+// IsValid checks all words and checksum of the mnemonic
+func IsValid(mnemonic string) bool {
+	_, err := EntropyFromMnemonic(mnemonic)
+	return err == nil
+}
+
+// NewSeed generates seed from mnemonic with optional passphrase, passphrase is not normalized,
+// so it should be ASCII to be compatible with other wallets
+func NewSeed(mnemonic, passphrase string) ([]byte, error) {
+	if _, err := EntropyFromMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	normalized := strings.ToLower(strings.Join(strings.Fields(mnemonic), " "))
+	return pbkdf2.Key([]byte(normalized), []byte("mnemonic"+passphrase), seedIterations, SeedSize, sha512.New), nil
+}
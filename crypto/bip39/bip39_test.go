@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package bip39
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// test vectors from https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+var vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+		seed:     "2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		entropy:  "80808080808080808080808080808080",
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		seed:     "d71de856f81a8acc65e6fc851a38d4d7ec216fd0796d0a6827a3ad6ed5511a30fa280f12eb2e47ed2ac03b5c462a0358d18d69fe4f985ec81778c1b370b652a8",
+	},
+	{
+		entropy:  "ffffffffffffffffffffffffffffffff",
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+		seed:     "ac27495480225222079d7be181583751e86f571027b0497b5b5d11218e0a8a13332572917f0f8e5a589620c6f15b11c61dee327651a14c34e18231052e48c069",
+	},
+	{
+		entropy:  "9e885d952ad362caeb4efe34a8e91bd2",
+		mnemonic: "ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+	},
+	{
+		entropy:  "0000000000000000000000000000000000000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+	},
+	{
+		entropy:  "68a79eaca2324873eacc50cb9c6eca8cc68ea5d936f98787c60c7ebc74e6ce7c",
+		mnemonic: "hamster diagram private dutch cause delay private meat slide toddler razor book happy fancy gospel tennis maple dilemma loan word shrug inflict delay length",
+	},
+	{
+		entropy:  "f585c11aec520db57dd353c69554b21a89b20fb0650966fa0a9d6f74fd989d8f",
+		mnemonic: "void come effort suffer camp survey warrior heavy shoot primary clutch crush open amazing screen patrol group space point ten exist slush involve unfold",
+	},
+}
+
+func TestWordlist(t *testing.T) {
+	if !sort.StringsAreSorted(english[:]) {
+		t.Fatal("wordlist is not sorted")
+	}
+	if len(wordIndex) != len(english) {
+		t.Fatal("duplicate words in wordlist")
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	for _, v := range vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		mnemonic, err := NewMnemonic(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mnemonic != v.mnemonic {
+			t.Fatalf("exp: %s, act: %s", v.mnemonic, mnemonic)
+		}
+		e, err := EntropyFromMnemonic(mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(e) != v.entropy {
+			t.Fatalf("exp: %s, act: %x", v.entropy, e)
+		}
+		if v.seed == "" {
+			continue
+		}
+		seed, err := NewSeed(mnemonic, "TREZOR")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(seed) != v.seed {
+			t.Fatalf("exp: %s, act: %x", v.seed, seed)
+		}
+	}
+
+	if _, err := NewMnemonic(make([]byte, 15)); err != ErrInvalidEntropySize {
+		t.Fatal(err)
+	}
+}
+
+func TestNewRandomMnemonic(t *testing.T) {
+	for _, size := range []int{128, 160, 192, 224, 256} {
+		mnemonic, err := NewRandomMnemonic(size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(strings.Fields(mnemonic)) != size/32*3 {
+			t.Fatal(mnemonic)
+		}
+		if !IsValid(mnemonic) {
+			t.Fatal(mnemonic)
+		}
+	}
+	if _, err := NewRandomMnemonic(100); err == nil {
+		t.Fatal("invalid entropy size should be rejected")
+	}
+}
+
+func TestEntropyFromMnemonic(t *testing.T) {
+	if _, err := EntropyFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"); err != ErrChecksumMismatch {
+		t.Fatal(err)
+	}
+	if _, err := EntropyFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon qlc"); err == nil {
+		t.Fatal("invalid word should be rejected")
+	}
+	if _, err := EntropyFromMnemonic("abandon about"); err != ErrInvalidMnemonic {
+		t.Fatal(err)
+	}
+	// extra spaces and upper case are tolerated
+	m := "  Legal winner thank year wave sausage worth useful legal winner thank   yellow "
+	seed, err := NewSeed(m, "TREZOR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(seed) != vectors[1].seed {
+		t.Fatalf("%x", seed)
+	}
+}
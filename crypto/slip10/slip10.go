@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package slip10
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HardenedOffset is the first index of hardened child key
+	HardenedOffset uint32 = 0x80000000
+	// KeySize is the size of private key and chain code
+	KeySize = 32
+
+	curveSeed = "ed25519 seed"
+)
+
+var (
+	ErrInvalidPath     = errors.New("invalid derivation path")
+	ErrNonHardenedPath = errors.New("ed25519 only supports hardened derivation")
+)
+
+// Key is an extended ed25519 private key
+type Key struct {
+	Key       [KeySize]byte
+	ChainCode [KeySize]byte
+}
+
+func newKey(hmacKey, data []byte) *Key {
+	h := hmac.New(sha512.New, hmacKey)
+	h.Write(data)
+	sum := h.Sum(nil)
+
+	k := new(Key)
+	copy(k.Key[:], sum[:KeySize])
+	copy(k.ChainCode[:], sum[KeySize:])
+	return k
+}
+
+// NewMasterKey generates master key from seed
+func NewMasterKey(seed []byte) (*Key, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("invalid seed size[%d]", len(seed))
+	}
+	return newKey([]byte(curveSeed), seed), nil
+}
+
+// Derive derives hardened child key of index
+func (k *Key) Derive(index uint32) (*Key, error) {
+	if index < HardenedOffset {
+		return nil, ErrNonHardenedPath
+	}
+	data := make([]byte, 1+KeySize+4)
+	copy(data[1:], k.Key[:])
+	binary.BigEndian.PutUint32(data[1+KeySize:], index)
+	return newKey(k.ChainCode[:], data), nil
+}
+
+// ParsePath parses derivation path like m/44'/105'/0', all segments must be hardened
+func ParsePath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if len(segments) == 0 || segments[0] != "m" {
+		return nil, ErrInvalidPath
+	}
+
+	indexes := make([]uint32, 0, len(segments)-1)
+	for _, s := range segments[1:] {
+		if !strings.HasSuffix(s, "'") && !strings.HasSuffix(s, "H") {
+			return nil, ErrNonHardenedPath
+		}
+		i, err := strconv.ParseUint(s[:len(s)-1], 10, 32)
+		if err != nil || uint32(i) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid path segment %s", s)
+		}
+		indexes = append(indexes, uint32(i)+HardenedOffset)
+	}
+	return indexes, nil
+}
+
+// DeriveForPath derives key of path from seed
+func DeriveForPath(seed []byte, path string) (*Key, error) {
+	indexes, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range indexes {
+		if key, err = key.Derive(i); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
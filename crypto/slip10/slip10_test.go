@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package slip10
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// test vector 1 for ed25519 from https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func TestDeriveForPath(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	vectors := []struct {
+		path      string
+		chainCode string
+		key       string
+	}{
+		{"m", "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{"m/0'", "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{"m/0'/1'", "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{"m/0H/1H/2H", "2e69929e00b5ab250f49c3fb1c12f252de4fed2c1db88387094a0f8c4c9ccd6c", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+	}
+	for _, v := range vectors {
+		key, err := DeriveForPath(seed, v.path)
+		if err != nil {
+			t.Fatal(v.path, err)
+		}
+		if hex.EncodeToString(key.ChainCode[:]) != v.chainCode {
+			t.Fatalf("%s: invalid chain code %x", v.path, key.ChainCode)
+		}
+		if hex.EncodeToString(key.Key[:]) != v.key {
+			t.Fatalf("%s: invalid key %x", v.path, key.Key)
+		}
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	indexes, err := ParsePath("m/44'/105'/3'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indexes) != 3 || indexes[0] != 44+HardenedOffset || indexes[2] != 3+HardenedOffset {
+		t.Fatal(indexes)
+	}
+
+	for _, p := range []string{"", "44'/0'", "m/44'/0", "m/a'", "m/2147483648'", "m//"} {
+		if _, err := ParsePath(p); err == nil {
+			t.Fatalf("path %s should be invalid", p)
+		}
+	}
+
+	key, _ := NewMasterKey(make([]byte, 16))
+	if _, err := key.Derive(1); err != ErrNonHardenedPath {
+		t.Fatal(err)
+	}
+	if _, err := NewMasterKey(make([]byte, 8)); err == nil {
+		t.Fatal("short seed should be rejected")
+	}
+}
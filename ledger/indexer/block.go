@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package indexer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/vm/contract"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/contract/abi/settlement"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// row is a record to be inserted into table
+type row struct {
+	table string
+	cols  []string
+	vals  []interface{}
+}
+
+func insertRow(tx *sqlx.Tx, r *row) error {
+	placeholders := strings.TrimRight(strings.Repeat("?,", len(r.cols)), ",")
+	sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", r.table, strings.Join(r.cols, ","), placeholders)
+	if _, err := tx.Exec(tx.Rebind(sql), r.vals...); err != nil {
+		return fmt.Errorf("insert %s: %s", r.table, err)
+	}
+	return nil
+}
+
+// blockRows returns the records of block and the address whose pendings are changed by the block besides its own
+func blockRows(l ledger.Store, blk *types.StateBlock) ([]*row, types.Address) {
+	amount, err := l.CalculateAmount(blk)
+	if err != nil || amount.Int == nil {
+		amount = types.ZeroBalance
+	}
+	receiver := blockReceiver(l, blk)
+
+	rows := []*row{
+		{
+			table: tableBlocks,
+			cols: []string{"hash", "type", "address", "token", "previous", "link", "receiver", "representative",
+				"balance", "amount", "vote", "network", "storage", "oracle", "pov_height", "timestamp"},
+			vals: []interface{}{blk.GetHash().String(), blk.GetType().String(), blk.GetAddress().String(),
+				blk.GetToken().String(), blk.GetPrevious().String(), blk.GetLink().String(), receiver.String(),
+				blk.GetRepresentative().String(), balanceString(blk.GetBalance()), amount.String(),
+				balanceString(blk.GetVote()), balanceString(blk.GetNetwork()), balanceString(blk.GetStorage()),
+				balanceString(blk.GetOracle()), blk.PoVHeight, blk.GetTimestamp()},
+		},
+	}
+	if blk.GetType() == types.ContractSend {
+		rows = append(rows, contractRows(blk, amount)...)
+	}
+	return rows, receiver
+}
+
+func blockReceiver(l ledger.Store, blk *types.StateBlock) types.Address {
+	switch blk.GetType() {
+	case types.Send:
+		return types.Address(blk.GetLink())
+	case types.ContractSend:
+		addr := types.Address(blk.GetLink())
+		c, ok, err := contract.GetChainContract(addr, blk.GetPayload())
+		if !ok || err != nil {
+			return types.ZeroAddress
+		}
+		receiver, err := c.GetTargetReceiver(vmstore.NewVMContext(l, &addr), blk)
+		if err != nil {
+			return types.ZeroAddress
+		}
+		return receiver
+	default:
+		return types.ZeroAddress
+	}
+}
+
+// contractRows returns the pledge, settlement and DoD records of a contract send block,
+// data which can not be parsed is skipped, the block itself is always indexed
+func contractRows(blk *types.StateBlock, amount types.Balance) []*row {
+	addr := types.Address(blk.GetLink())
+	data := blk.GetPayload()
+	method, ok, err := contract.GetChainContractName(addr, data)
+	if !ok || err != nil {
+		return nil
+	}
+	hash := blk.GetHash().String()
+
+	switch addr {
+	case contractaddress.NEP5PledgeAddress:
+		return pledgeRows(hash, method, data, amount, blk.GetTimestamp())
+	case contractaddress.SettlementAddress:
+		return settlementRows(hash, method, data, blk.GetTimestamp())
+	case contractaddress.DoDSettlementAddress:
+		return dodRows(hash, method, blk.GetPrevious(), data, blk.GetTimestamp())
+	}
+	return nil
+}
+
+func pledgeRows(hash, method string, data []byte, amount types.Balance, timestamp int64) []*row {
+	cols := []string{"block_hash", "action", "beneficial", "pledge_address", "amount", "pledge_type", "nep5_tx_id", "timestamp"}
+	switch method {
+	case abi.MethodNEP5Pledge:
+		param, err := abi.ParsePledgeParam(data)
+		if err != nil {
+			return nil
+		}
+		return []*row{{table: tablePledges, cols: cols, vals: []interface{}{hash, "pledge", param.Beneficial.String(),
+			param.PledgeAddress.String(), amount.String(), abi.PledgeType(param.PType).String(), param.NEP5TxId, timestamp}}}
+	case abi.MethodWithdrawNEP5Pledge:
+		param, err := abi.ParseWithdrawPledgeParam(data)
+		if err != nil {
+			return nil
+		}
+		return []*row{{table: tablePledges, cols: cols, vals: []interface{}{hash, "withdraw", param.Beneficial.String(),
+			"", types.Balance{Int: param.Amount}.String(), abi.PledgeType(param.PType).String(), param.NEP5TxId, timestamp}}}
+	}
+	return nil
+}
+
+func settlementRows(hash, method string, data []byte, timestamp int64) []*row {
+	cols := []string{"block_hash", "action", "contract_address", "party_a", "party_b", "timestamp"}
+	switch method {
+	case settlement.MethodNameCreateContract:
+		param := new(settlement.CreateContractParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		address, err := param.Address()
+		if err != nil {
+			return nil
+		}
+		return []*row{{table: tableSettlementContracts, cols: cols, vals: []interface{}{hash, "create", address.String(),
+			param.PartyA.Address.String(), param.PartyB.Address.String(), timestamp}}}
+	case settlement.MethodNameSignContract:
+		param := new(settlement.SignContractParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		return []*row{{table: tableSettlementContracts, cols: cols, vals: []interface{}{hash, "sign",
+			param.ContractAddress.String(), "", "", timestamp}}}
+	case settlement.MethodNameTerminateContract:
+		param := new(settlement.TerminateParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		action := "terminate"
+		if !param.Request {
+			action = "cancelTerminate"
+		}
+		return []*row{{table: tableSettlementContracts, cols: cols, vals: []interface{}{hash, action,
+			param.ContractAddress.String(), "", "", timestamp}}}
+	case settlement.MethodNameProcessCDR:
+		param := new(settlement.CDRParamList)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		cdrCols := []string{"block_hash", "seq", "contract_address", "cdr_index", "sms_dt", "account", "sender",
+			"customer", "destination", "sending_status", "dlr_status", "pre_stop", "next_stop"}
+		rows := make([]*row, 0, len(param.Params))
+		for i, cdr := range param.Params {
+			rows = append(rows, &row{table: tableSettlementCDRs, cols: cdrCols, vals: []interface{}{hash, i,
+				param.ContractAddress.String(), cdr.Index, cdr.SmsDt, cdr.Account, cdr.Sender, cdr.Customer,
+				cdr.Destination, cdr.SendingStatus.String(), cdr.DlrStatus.String(), cdr.PreStop, cdr.NextStop}})
+		}
+		return rows
+	}
+	return nil
+}
+
+func dodRows(hash, method string, previous types.Hash, data []byte, timestamp int64) []*row {
+	cols := []string{"block_hash", "action", "internal_id", "order_id", "buyer", "seller", "status", "timestamp"}
+	newRow := func(action string, internalId types.Hash, orderId string, buyer, seller *abi.DoDSettleUser, status string) []*row {
+		b, s := "", ""
+		if buyer != nil {
+			b = buyer.Address.String()
+		}
+		if seller != nil {
+			s = seller.Address.String()
+		}
+		return []*row{{table: tableDoDOrders, cols: cols, vals: []interface{}{hash, action, internalId.String(), orderId,
+			b, s, status, timestamp}}}
+	}
+
+	// orders created by create, change and terminate are identified by the previous hash of the block
+	switch method {
+	case abi.MethodNameDoDSettleCreateOrder:
+		param := new(abi.DoDSettleCreateOrderParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		return newRow("create", previous, "", param.Buyer, param.Seller, "")
+	case abi.MethodNameDoDSettleChangeOrder:
+		param := new(abi.DoDSettleChangeOrderParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		return newRow("change", previous, "", param.Buyer, param.Seller, "")
+	case abi.MethodNameDoDSettleTerminateOrder:
+		param := new(abi.DoDSettleTerminateOrderParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		return newRow("terminate", previous, "", param.Buyer, param.Seller, "")
+	case abi.MethodNameDoDSettleUpdateOrderInfo:
+		param := new(abi.DoDSettleUpdateOrderInfoParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		return newRow("updateOrderInfo", param.InternalId, param.OrderId, nil, nil, param.Status.String())
+	case abi.MethodNameDoDSettleUpdateProductInfo:
+		param := new(abi.DoDSettleUpdateProductInfoParam)
+		if err := param.FromABI(data); err != nil {
+			return nil
+		}
+		return newRow("updateProductInfo", types.ZeroHash, param.OrderId, nil, nil, "")
+	}
+	return nil
+}
+
+func balanceString(b types.Balance) string {
+	if b.Int == nil {
+		return types.ZeroBalance.String()
+	}
+	return b.String()
+}
@@ -0,0 +1,374 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+
+	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/relation"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	defaultFlushInterval = 2 * time.Second
+	batchMaxCount        = 200
+)
+
+type blockEvent struct {
+	block *types.StateBlock
+}
+
+type rollbackEvent struct {
+	hash types.Hash
+}
+
+type povEvent struct {
+	block   *types.PovBlock
+	connect bool
+}
+
+// Indexer writes the confirmed ledger into normalized tables of the relation database.
+// Blocks and the contract records of blocks are appended when blocks are confirmed and deleted by
+// block hash when blocks are rolled back, accounts, token balances and pendings of the changed
+// addresses are reloaded from the ledger periodically, pov blocks follow the best chain.
+type Indexer struct {
+	l          ledger.Store
+	db         *sqlx.DB
+	eb         event.EventBus
+	subscriber *event.ActorSubscriber
+	events     chan interface{}
+	dirty      map[types.Address]struct{}
+	interval   time.Duration
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+	logger     *zap.SugaredLogger
+}
+
+func NewIndexer(cfgFile string, l ledger.Store) (*Indexer, error) {
+	cc := chainctx.NewChainContext(cfgFile)
+	cfg, err := cc.Config()
+	if err != nil {
+		return nil, err
+	}
+	r, err := relation.NewRelation(cfgFile)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := defaultFlushInterval
+	if cfg.Indexer != nil && cfg.Indexer.FlushInterval > 0 {
+		interval = time.Duration(cfg.Indexer.FlushInterval) * time.Second
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Indexer{
+		l:        l,
+		db:       r.DB(),
+		eb:       l.EventBus(),
+		events:   make(chan interface{}, 10240),
+		dirty:    make(map[types.Address]struct{}),
+		interval: interval,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		logger:   log.NewLogger("indexer"),
+	}, nil
+}
+
+// Start creates the tables, catches up with the ledger and follows the ledger events
+func (idx *Indexer) Start() error {
+	for _, s := range schemas {
+		if _, err := idx.db.Exec(s); err != nil {
+			return fmt.Errorf("create table: %s", err)
+		}
+	}
+
+	idx.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+		switch msg := c.Message().(type) {
+		case *types.StateBlock:
+			idx.events <- &blockEvent{block: msg}
+		case types.Hash:
+			idx.events <- &rollbackEvent{hash: msg}
+		}
+	}), idx.eb)
+	if err := idx.subscriber.Subscribe(topic.EventAddRelation, topic.EventRollback); err != nil {
+		return err
+	}
+	if err := idx.subscriber.SubscribeOne(topic.EventPovConnectBestBlock, event.Spawn(func(c actor.Context) {
+		if blk, ok := c.Message().(*types.PovBlock); ok {
+			idx.events <- &povEvent{block: blk, connect: true}
+		}
+	})); err != nil {
+		return err
+	}
+	if err := idx.subscriber.SubscribeOne(topic.EventPovDisconnectBestBlock, event.Spawn(func(c actor.Context) {
+		if blk, ok := c.Message().(*types.PovBlock); ok {
+			idx.events <- &povEvent{block: blk, connect: false}
+		}
+	})); err != nil {
+		return err
+	}
+
+	go idx.process()
+	return nil
+}
+
+func (idx *Indexer) Stop() error {
+	idx.cancel()
+	<-idx.done
+	if idx.subscriber != nil {
+		return idx.subscriber.UnsubscribeAll()
+	}
+	return nil
+}
+
+func (idx *Indexer) process() {
+	defer close(idx.done)
+
+	if err := idx.sync(); err != nil {
+		idx.logger.Errorf("sync indexer: %s", err)
+	}
+
+	ticker := time.NewTicker(idx.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-idx.ctx.Done():
+			idx.flush()
+			return
+		case e := <-idx.events:
+			var err error
+			switch ev := e.(type) {
+			case *blockEvent:
+				err = idx.addBlock(ev.block)
+			case *rollbackEvent:
+				err = idx.rollback(ev.hash)
+			case *povEvent:
+				if ev.connect {
+					err = idx.connectPov(ev.block)
+				} else {
+					err = idx.disconnectPov(ev.block)
+				}
+			}
+			if err != nil {
+				idx.logger.Error(err)
+			}
+		case <-ticker.C:
+			idx.flush()
+		}
+	}
+}
+
+// sync rebuilds the block tables if they mismatch the ledger and indexes the missing pov blocks
+func (idx *Indexer) sync() error {
+	var count uint64
+	if err := idx.db.Get(&count, fmt.Sprintf("SELECT COUNT(*) FROM %s", tableBlocks)); err != nil {
+		return err
+	}
+	total, err := idx.l.CountStateBlocks()
+	if err != nil {
+		return err
+	}
+
+	if count != total {
+		idx.logger.Infof("rebuild indexer, indexed %d, ledger %d", count, total)
+		if err := idx.update(func(tx *sqlx.Tx) error {
+			for _, t := range stateTables {
+				if _, err := tx.Exec(fmt.Sprintf("DELETE FROM %s", t)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		blocks := make([]*types.StateBlock, 0, batchMaxCount)
+		if err := idx.l.GetStateBlocksConfirmed(func(blk *types.StateBlock) error {
+			blocks = append(blocks, blk)
+			if len(blocks) >= batchMaxCount {
+				if err := idx.addBlocks(blocks); err != nil {
+					return err
+				}
+				blocks = blocks[:0]
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := idx.addBlocks(blocks); err != nil {
+			return err
+		}
+
+		if err := idx.l.GetAccountMetas(func(am *types.AccountMeta) error {
+			idx.dirty[am.Address] = struct{}{}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := idx.l.GetPendings(func(key *types.PendingKey, info *types.PendingInfo) error {
+			idx.dirty[key.Address] = struct{}{}
+			return nil
+		}); err != nil {
+			return err
+		}
+		idx.flush()
+	}
+
+	return idx.syncPov()
+}
+
+func (idx *Indexer) addBlock(blk *types.StateBlock) error {
+	if ok, _ := idx.l.HasStateBlockConfirmed(blk.GetHash()); !ok {
+		return nil
+	}
+	var count int
+	if err := idx.db.Get(&count, idx.db.Rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE hash = ?", tableBlocks)),
+		blk.GetHash().String()); err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return idx.addBlocks([]*types.StateBlock{blk})
+}
+
+func (idx *Indexer) addBlocks(blocks []*types.StateBlock) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	return idx.update(func(tx *sqlx.Tx) error {
+		for _, blk := range blocks {
+			rows, receiver := blockRows(idx.l, blk)
+			for _, r := range rows {
+				if err := insertRow(tx, r); err != nil {
+					return fmt.Errorf("index block %s: %s", blk.GetHash(), err)
+				}
+			}
+			idx.markDirty(blk.GetAddress(), receiver)
+		}
+		return nil
+	})
+}
+
+// rollback deletes the block and its records, the accounts and pendings it changed are reloaded at next flush
+func (idx *Indexer) rollback(hash types.Hash) error {
+	var blk struct {
+		Address  string `db:"address"`
+		Receiver string `db:"receiver"`
+	}
+	err := idx.db.Get(&blk, idx.db.Rebind(fmt.Sprintf("SELECT address, receiver FROM %s WHERE hash = ?", tableBlocks)),
+		hash.String())
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := idx.update(func(tx *sqlx.Tx) error {
+		for _, t := range blockTables {
+			if _, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE block_hash = ?", t)), hash.String()); err != nil {
+				return err
+			}
+		}
+		_, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE hash = ?", tableBlocks)), hash.String())
+		return err
+	}); err != nil {
+		return fmt.Errorf("rollback block %s: %s", hash, err)
+	}
+
+	address, _ := types.HexToAddress(blk.Address)
+	receiver, _ := types.HexToAddress(blk.Receiver)
+	idx.markDirty(address, receiver)
+	return nil
+}
+
+func (idx *Indexer) markDirty(addresses ...types.Address) {
+	for _, addr := range addresses {
+		if !addr.IsZero() {
+			idx.dirty[addr] = struct{}{}
+		}
+	}
+}
+
+func (idx *Indexer) flush() {
+	for addr := range idx.dirty {
+		if err := idx.refresh(addr); err != nil {
+			idx.logger.Errorf("refresh %s: %s", addr, err)
+		}
+		delete(idx.dirty, addr)
+	}
+}
+
+// refresh reloads the account, token balances and pendings of address from the ledger
+func (idx *Indexer) refresh(address types.Address) error {
+	return idx.update(func(tx *sqlx.Tx) error {
+		for _, t := range []string{tableAccounts, tableTokenBalances, tablePendings} {
+			if _, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE address = ?", t)), address.String()); err != nil {
+				return err
+			}
+		}
+
+		if am, err := idx.l.GetAccountMetaConfirmed(address); err == nil {
+			if err := insertRow(tx, &row{
+				table: tableAccounts,
+				cols:  []string{"address", "coin_balance", "coin_vote", "coin_network", "coin_storage", "coin_oracle"},
+				vals: []interface{}{address.String(), balanceString(am.CoinBalance), balanceString(am.CoinVote),
+					balanceString(am.CoinNetwork), balanceString(am.CoinStorage), balanceString(am.CoinOracle)},
+			}); err != nil {
+				return err
+			}
+			for _, tm := range am.Tokens {
+				if err := insertRow(tx, &row{
+					table: tableTokenBalances,
+					cols: []string{"address", "token", "balance", "header", "representative", "open_block",
+						"block_count", "modified"},
+					vals: []interface{}{address.String(), tm.Type.String(), balanceString(tm.Balance), tm.Header.String(),
+						tm.Representative.String(), tm.OpenBlock.String(), tm.BlockCount, tm.Modified},
+				}); err != nil {
+					return err
+				}
+			}
+		} else if err != ledger.ErrAccountNotFound {
+			return err
+		}
+
+		return idx.l.GetPendingsByAddress(address, func(key *types.PendingKey, info *types.PendingInfo) error {
+			return insertRow(tx, &row{
+				table: tablePendings,
+				cols:  []string{"address", "hash", "source", "amount", "token"},
+				vals: []interface{}{key.Address.String(), key.Hash.String(), info.Source.String(),
+					balanceString(info.Amount), info.Type.String()},
+			})
+		})
+	})
+}
+
+func (idx *Indexer) update(fn func(tx *sqlx.Tx) error) error {
+	tx, err := idx.db.Beginx()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package indexer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+func setupTestCase(t *testing.T) (func(t *testing.T), *ledger.Ledger, *Indexer) {
+	t.Parallel()
+	dir := filepath.Join(config.QlcTestDataDir(), "indexer", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	l := ledger.NewLedger(cm.ConfigFile)
+
+	idx, err := NewIndexer(cm.ConfigFile, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range schemas {
+		if _, err := idx.db.Exec(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return func(t *testing.T) {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, l, idx
+}
+
+func count(t *testing.T, idx *Indexer, table string) int {
+	var c int
+	if err := idx.db.Get(&c, fmt.Sprintf("SELECT COUNT(*) FROM %s", table)); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func processBlocks(t *testing.T, l *ledger.Ledger) []*types.StateBlock {
+	bs, err := mock.BlockChain(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lv := process.NewLedgerVerifier(l)
+	for _, b := range bs {
+		if err := lv.BlockProcess(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return bs
+}
+
+func TestIndexer_Sync(t *testing.T) {
+	teardownTestCase, l, idx := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	bs := processBlocks(t, l)
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := idx.sync(); err != nil {
+		t.Fatal(err)
+	}
+	total, err := l.CountStateBlocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := count(t, idx, tableBlocks); uint64(c) != total {
+		t.Fatalf("exp: %d, act: %d", total, c)
+	}
+	var accounts int
+	_ = l.GetAccountMetas(func(am *types.AccountMeta) error {
+		accounts++
+		return nil
+	})
+	if c := count(t, idx, tableAccounts); c != accounts {
+		t.Fatalf("exp: %d, act: %d", accounts, c)
+	}
+	if count(t, idx, tableTokenBalances) == 0 {
+		t.Fatal("token balances not indexed")
+	}
+	var pendings int
+	_ = l.GetPendings(func(key *types.PendingKey, info *types.PendingInfo) error {
+		pendings++
+		return nil
+	})
+	if c := count(t, idx, tablePendings); c != pendings {
+		t.Fatalf("exp: %d, act: %d", pendings, c)
+	}
+
+	// indexed blocks are not duplicated
+	if err := idx.addBlock(bs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := idx.sync(); err != nil {
+		t.Fatal(err)
+	}
+	if c := count(t, idx, tableBlocks); uint64(c) != total {
+		t.Fatalf("exp: %d, act: %d", total, c)
+	}
+
+	// rollback
+	last := bs[len(bs)-1]
+	if err := idx.rollback(last.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if c := count(t, idx, tableBlocks); uint64(c) != total-1 {
+		t.Fatalf("exp: %d, act: %d", total-1, c)
+	}
+	if _, ok := idx.dirty[last.GetAddress()]; !ok {
+		t.Fatal("address of rolled back block should be refreshed")
+	}
+	idx.flush()
+	if len(idx.dirty) != 0 {
+		t.Fatal("dirty addresses should be flushed")
+	}
+	if err := idx.rollback(mock.Hash()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIndexer_StartStop(t *testing.T) {
+	teardownTestCase, l, idx := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	idx.interval = 100 * time.Millisecond
+	if err := idx.Start(); err != nil {
+		t.Fatal(err)
+	}
+	bs := processBlocks(t, l)
+
+	last := bs[len(bs)-1].GetHash()
+	indexed := func() bool {
+		var c int
+		if err := idx.db.Get(&c, idx.db.Rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE hash = ?", tableBlocks)),
+			last.String()); err != nil {
+			t.Fatal(err)
+		}
+		return c == 1
+	}
+	for i := 0; !indexed(); i++ {
+		if i > 50 {
+			t.Fatal("blocks are not indexed")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	l.EventBus().Publish(topic.EventRollback, last)
+	for i := 0; indexed(); i++ {
+		if i > 50 {
+			t.Fatal("block is not rolled back")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if err := idx.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIndexer_Pov(t *testing.T) {
+	teardownTestCase, _, idx := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blk1, _ := mock.GeneratePovBlock(nil, 2)
+	if err := idx.connectPov(blk1); err != nil {
+		t.Fatal(err)
+	}
+	if count(t, idx, tablePovBlocks) != 1 || count(t, idx, tablePovTxs) != 2 {
+		t.Fatal("pov block not indexed")
+	}
+
+	// a fork block at the same height replaces the old one
+	blk2, _ := mock.GeneratePovBlock(nil, 1)
+	if err := idx.connectPov(blk2); err != nil {
+		t.Fatal(err)
+	}
+	if count(t, idx, tablePovBlocks) != 1 || count(t, idx, tablePovTxs) != 1 {
+		t.Fatal("pov block not replaced")
+	}
+
+	if err := idx.disconnectPov(blk2); err != nil {
+		t.Fatal(err)
+	}
+	if count(t, idx, tablePovBlocks) != 0 || count(t, idx, tablePovTxs) != 0 {
+		t.Fatal("pov block not disconnected")
+	}
+}
+
+func TestContractRows(t *testing.T) {
+	pledge := &abi.PledgeParam{
+		Beneficial:    mock.Address(),
+		PledgeAddress: mock.Address(),
+		PType:         uint8(abi.Vote),
+		NEP5TxId:      mock.Hash().String(),
+	}
+	data, err := pledge.ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := mock.StateBlockWithoutWork()
+	blk.Type = types.ContractSend
+	blk.Link = types.Hash(contractaddress.NEP5PledgeAddress)
+	blk.Data = data
+	rows := contractRows(blk, types.Balance{Int: types.NewBalance(100).Int})
+	if len(rows) != 1 || rows[0].table != tablePledges || rows[0].vals[1] != "pledge" ||
+		rows[0].vals[2] != pledge.Beneficial.String() {
+		t.Fatal("invalid pledge rows")
+	}
+
+	blk.Data = []byte{1, 2, 3}
+	if rows := contractRows(blk, types.ZeroBalance); len(rows) != 0 {
+		t.Fatal("invalid data should be skipped")
+	}
+}
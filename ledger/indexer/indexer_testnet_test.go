@@ -0,0 +1,41 @@
+// +build testnet
+
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package indexer
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+func TestContractRows_DoD(t *testing.T) {
+	order := &abi.DoDSettleUpdateOrderInfoParam{
+		Buyer:      mock.Address(),
+		InternalId: mock.Hash(),
+		OrderId:    "order1",
+		Status:     abi.DoDSettleOrderStateSuccess,
+	}
+	data, err := order.ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := mock.StateBlockWithoutWork()
+	blk.Type = types.ContractSend
+	blk.Link = types.Hash(contractaddress.DoDSettlementAddress)
+	blk.Data = data
+	rows := contractRows(blk, types.ZeroBalance)
+	if len(rows) != 1 || rows[0].table != tableDoDOrders || rows[0].vals[2] != order.InternalId.String() ||
+		rows[0].vals[3] != "order1" || rows[0].vals[6] != order.Status.String() {
+		t.Fatal("invalid dod rows")
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+// syncPov drops the indexed pov blocks which are not in the best chain any more, then indexes the missing ones
+func (idx *Indexer) syncPov() error {
+	latest, err := idx.l.GetLatestPovHeader()
+	if err != nil {
+		// pov is not enabled or not started
+		return nil
+	}
+
+	var maxHeight sql.NullInt64
+	if err := idx.db.Get(&maxHeight, fmt.Sprintf("SELECT MAX(height) FROM %s", tablePovBlocks)); err != nil {
+		return err
+	}
+
+	start := uint64(0)
+	if maxHeight.Valid {
+		for height := uint64(maxHeight.Int64); ; height-- {
+			var hash string
+			err := idx.db.Get(&hash, idx.db.Rebind(fmt.Sprintf("SELECT hash FROM %s WHERE height = ?", tablePovBlocks)), height)
+			if err == nil && height <= latest.GetHeight() {
+				if best, err := idx.l.GetPovBestHash(height); err == nil && best.String() == hash {
+					start = height + 1
+					break
+				}
+			} else if err != nil && err != sql.ErrNoRows {
+				return err
+			}
+			if err := idx.update(func(tx *sqlx.Tx) error {
+				return deletePovHeight(tx, height)
+			}); err != nil {
+				return err
+			}
+			if height == 0 {
+				break
+			}
+		}
+	}
+
+	for height := start; height <= latest.GetHeight(); height++ {
+		blk, err := idx.l.GetPovBlockByHeight(height)
+		if err != nil {
+			return fmt.Errorf("get pov block %d: %s", height, err)
+		}
+		if err := idx.connectPov(blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// connectPov replaces the pov block at the height of block
+func (idx *Indexer) connectPov(blk *types.PovBlock) error {
+	return idx.update(func(tx *sqlx.Tx) error {
+		if err := deletePovHeight(tx, blk.GetHeight()); err != nil {
+			return err
+		}
+		hash := blk.GetHash().String()
+		if err := insertRow(tx, &row{
+			table: tablePovBlocks,
+			cols:  []string{"hash", "height", "previous", "miner", "algo", "tx_num", "timestamp"},
+			vals: []interface{}{hash, blk.GetHeight(), blk.GetPrevious().String(), blk.GetMinerAddr().String(),
+				blk.GetHeader().GetAlgoType().String(), blk.GetTxNum(), blk.GetTimestamp()},
+		}); err != nil {
+			return err
+		}
+		for _, t := range blk.GetAccountTxs() {
+			if err := insertRow(tx, &row{
+				table: tablePovTxs,
+				cols:  []string{"pov_hash", "tx_hash", "height"},
+				vals:  []interface{}{hash, t.Hash.String(), blk.GetHeight()},
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (idx *Indexer) disconnectPov(blk *types.PovBlock) error {
+	return idx.update(func(tx *sqlx.Tx) error {
+		hash := blk.GetHash().String()
+		if _, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE pov_hash = ?", tablePovTxs)), hash); err != nil {
+			return err
+		}
+		_, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE hash = ?", tablePovBlocks)), hash)
+		return err
+	})
+}
+
+func deletePovHeight(tx *sqlx.Tx, height uint64) error {
+	if _, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE height = ?", tablePovTxs)), height); err != nil {
+		return err
+	}
+	_, err := tx.Exec(tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE height = ?", tablePovBlocks)), height)
+	return err
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package indexer
+
+const (
+	tableBlocks              = "blocks"
+	tableAccounts            = "accounts"
+	tableTokenBalances       = "token_balances"
+	tablePendings            = "pendings"
+	tablePovBlocks           = "pov_blocks"
+	tablePovTxs              = "pov_txs"
+	tablePledges             = "pledges"
+	tableSettlementContracts = "settlement_contracts"
+	tableSettlementCDRs      = "settlement_cdrs"
+	tableDoDOrders           = "dod_orders"
+)
+
+// the types are supported by sqlite, mysql and postgres, hashes and addresses are saved as strings,
+// balances as decimal strings because they may exceed 64 bits
+var schemas = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		hash varchar(64) PRIMARY KEY NOT NULL,
+		type varchar(16),
+		address varchar(64),
+		token varchar(64),
+		previous varchar(64),
+		link varchar(64),
+		receiver varchar(64),
+		representative varchar(64),
+		balance varchar(80),
+		amount varchar(80),
+		vote varchar(80),
+		network varchar(80),
+		storage varchar(80),
+		oracle varchar(80),
+		pov_height bigint,
+		timestamp bigint
+	)`,
+	`CREATE TABLE IF NOT EXISTS accounts (
+		address varchar(64) PRIMARY KEY NOT NULL,
+		coin_balance varchar(80),
+		coin_vote varchar(80),
+		coin_network varchar(80),
+		coin_storage varchar(80),
+		coin_oracle varchar(80)
+	)`,
+	`CREATE TABLE IF NOT EXISTS token_balances (
+		address varchar(64) NOT NULL,
+		token varchar(64) NOT NULL,
+		balance varchar(80),
+		header varchar(64),
+		representative varchar(64),
+		open_block varchar(64),
+		block_count bigint,
+		modified bigint,
+		PRIMARY KEY (address, token)
+	)`,
+	`CREATE TABLE IF NOT EXISTS pendings (
+		address varchar(64) NOT NULL,
+		hash varchar(64) NOT NULL,
+		source varchar(64),
+		amount varchar(80),
+		token varchar(64),
+		PRIMARY KEY (address, hash)
+	)`,
+	`CREATE TABLE IF NOT EXISTS pov_blocks (
+		hash varchar(64) PRIMARY KEY NOT NULL,
+		height bigint,
+		previous varchar(64),
+		miner varchar(64),
+		algo varchar(16),
+		tx_num bigint,
+		timestamp bigint
+	)`,
+	`CREATE TABLE IF NOT EXISTS pov_txs (
+		pov_hash varchar(64) NOT NULL,
+		tx_hash varchar(64) NOT NULL,
+		height bigint,
+		PRIMARY KEY (pov_hash, tx_hash)
+	)`,
+	`CREATE TABLE IF NOT EXISTS pledges (
+		block_hash varchar(64) PRIMARY KEY NOT NULL,
+		action varchar(16),
+		beneficial varchar(64),
+		pledge_address varchar(64),
+		amount varchar(80),
+		pledge_type varchar(16),
+		nep5_tx_id varchar(128),
+		timestamp bigint
+	)`,
+	`CREATE TABLE IF NOT EXISTS settlement_contracts (
+		block_hash varchar(64) PRIMARY KEY NOT NULL,
+		action varchar(32),
+		contract_address varchar(64),
+		party_a varchar(64),
+		party_b varchar(64),
+		timestamp bigint
+	)`,
+	`CREATE TABLE IF NOT EXISTS settlement_cdrs (
+		block_hash varchar(64) NOT NULL,
+		seq bigint NOT NULL,
+		contract_address varchar(64),
+		cdr_index bigint,
+		sms_dt bigint,
+		account varchar(128),
+		sender varchar(128),
+		customer varchar(128),
+		destination varchar(128),
+		sending_status varchar(32),
+		dlr_status varchar(32),
+		pre_stop varchar(128),
+		next_stop varchar(128),
+		PRIMARY KEY (block_hash, seq)
+	)`,
+	`CREATE TABLE IF NOT EXISTS dod_orders (
+		block_hash varchar(64) PRIMARY KEY NOT NULL,
+		action varchar(32),
+		internal_id varchar(64),
+		order_id varchar(128),
+		buyer varchar(64),
+		seller varchar(64),
+		status varchar(32),
+		timestamp bigint
+	)`,
+}
+
+// blockTables are the tables of records created by a block, rows are deleted by block_hash when the block is rolled back
+var blockTables = []string{tablePledges, tableSettlementContracts, tableSettlementCDRs, tableDoDOrders}
+
+// stateTables are rebuilt when the indexed blocks mismatch the ledger
+var stateTables = []string{tableBlocks, tableAccounts, tableTokenBalances, tablePendings, tablePledges,
+	tableSettlementContracts, tableSettlementCDRs, tableDoDOrders}
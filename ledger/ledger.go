@@ -53,6 +53,7 @@ type LedgerStore interface {
 type Ledger struct {
 	io.Closer
 	dir            string
+	backupDir      string
 	store          storage.Store
 	cache          *MemoryCache
 	rcache         *rCache
@@ -92,6 +93,7 @@ var (
 	ErrLinkNotFound    = errors.New("link not found")
 	ErrPeerExists      = errors.New("peer already exists")
 	ErrPeerNotFound    = errors.New("peer not found")
+	ErrPeerBanNotFound = errors.New("peer ban not found")
 )
 
 var (
@@ -99,7 +101,7 @@ var (
 	lock   = sync.RWMutex{}
 )
 
-const version = 16
+const version = 18
 
 func NewLedger(cfgFile string) *Ledger {
 	lock.Lock()
@@ -112,6 +114,7 @@ func NewLedger(cfgFile string) *Ledger {
 		ctx, cancel := context.WithCancel(context.Background())
 		l := &Ledger{
 			dir:            dir,
+			backupDir:      cfg.BackupDir(),
 			EB:             cc.EventBus(),
 			ctx:            ctx,
 			cancel:         cancel,
@@ -120,6 +123,9 @@ func NewLedger(cfgFile string) *Ledger {
 			logger:         log.NewLogger("ledger"),
 			tokenCache:     sync.Map{},
 		}
+		if err := applyRestore(dir); err != nil {
+			l.logger.Fatal(err.Error())
+		}
 		store, err := db.NewBadgerStore(dir)
 		if err != nil {
 			l.logger.Fatal(err.Error())
@@ -266,13 +272,20 @@ func (l *Ledger) upgrade() error {
 			new(migration.MigrationV13ToV14),
 			new(migration.MigrationV14ToV15),
 			new(migration.MigrationV15ToV16),
+			new(migration.MigrationV16ToV18),
 		}
 
 		err = migration.Upgrade(ms, l.store)
 		if err != nil {
 			l.logger.Error(err)
+			return err
 		}
-		return err
+		if v < historyIndexVersion {
+			if err := l.rebuildHistoryIndex(); err != nil {
+				return fmt.Errorf("rebuild history index: %s", err)
+			}
+		}
+		return l.setVersion(version)
 	}
 }
 
@@ -746,6 +759,13 @@ func (l *Ledger) Action(at storage.ActionType, t int) (interface{}, error) {
 		return l.store.Action(at)
 	case storage.Size:
 		return l.store.Action(at)
+	case storage.Backup:
+		return l.Backup(int64(t))
+	case storage.Restore:
+		if t < 0 {
+			return "", errors.New("invalid restore version")
+		}
+		return l.Restore(uint64(t))
 	default:
 		return "", errors.New("invalid action type")
 	}
@@ -403,18 +403,22 @@ func (l *Ledger) Weight(account types.Address) types.Balance {
 }
 
 func (l *Ledger) CalculateAmount(block *types.StateBlock) (types.Balance, error) {
+	return l.calculateAmount(block, nil)
+}
+
+func (l *Ledger) calculateAmount(block *types.StateBlock, c storage.Cache) (types.Balance, error) {
 	var prev *types.StateBlock
 	var err error
 	switch block.GetType() {
 	case types.Open:
 		return block.TotalBalance(), err
 	case types.Send:
-		if prev, err = l.GetStateBlock(block.GetPrevious()); err != nil {
+		if prev, err = l.GetStateBlock(block.GetPrevious(), c); err != nil {
 			return types.ZeroBalance, err
 		}
 		return prev.TotalBalance().Sub(block.TotalBalance()), nil
 	case types.Receive:
-		if prev, err = l.GetStateBlock(block.GetPrevious()); err != nil {
+		if prev, err = l.GetStateBlock(block.GetPrevious(), c); err != nil {
 			return types.ZeroBalance, err
 		}
 		return block.TotalBalance().Sub(prev.TotalBalance()), nil
@@ -425,7 +429,7 @@ func (l *Ledger) CalculateAmount(block *types.StateBlock) (types.Balance, error)
 		if prevHash.IsZero() {
 			return block.TotalBalance(), nil
 		} else {
-			if prev, err = l.GetStateBlock(prevHash); err != nil {
+			if prev, err = l.GetStateBlock(prevHash, c); err != nil {
 				return types.ZeroBalance, err
 			}
 			return block.TotalBalance().Sub(prev.TotalBalance()), nil
@@ -434,7 +438,7 @@ func (l *Ledger) CalculateAmount(block *types.StateBlock) (types.Balance, error)
 		if config.IsGenesisBlock(block) {
 			return block.GetBalance(), nil
 		} else {
-			if prev, err = l.GetStateBlock(block.GetPrevious()); err != nil {
+			if prev, err = l.GetStateBlock(block.GetPrevious(), c); err != nil {
 				return types.ZeroBalance, err
 			}
 			return prev.TotalBalance().Sub(block.TotalBalance()), nil
@@ -0,0 +1,332 @@
+package ledger
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/storage/db"
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+const (
+	backupFormat    = uint16(1)
+	backupExt       = ".qlcbak"
+	restoreSuffix   = ".restore"
+	restoreDoneFile = "RESTORED"
+)
+
+var backupMagic = [8]byte{'Q', 'L', 'C', 'L', 'E', 'D', 'G', 'R'}
+
+var (
+	ErrBackupInvalid      = errors.New("invalid ledger backup archive")
+	ErrBackupNotFound     = errors.New("ledger backup archive not found")
+	ErrBackupDiscontinued = errors.New("ledger backup archives are discontinued")
+	ErrBackupNothing      = errors.New("nothing to back up")
+)
+
+// BackupHeader is the fixed size header at the beginning of each archive, the badger backup stream follows it
+type BackupHeader struct {
+	Magic         [8]byte
+	Format        uint16
+	LedgerVersion int64
+	Since         uint64
+	Upto          uint64
+	Timestamp     int64
+}
+
+func (h *BackupHeader) verify() error {
+	if h.Magic != backupMagic {
+		return ErrBackupInvalid
+	}
+	if h.Format != backupFormat {
+		return fmt.Errorf("unsupported backup format %d", h.Format)
+	}
+	if h.LedgerVersion > version {
+		return fmt.Errorf("backup ledger version %d is newer than current version %d", h.LedgerVersion, version)
+	}
+	return nil
+}
+
+// ReadBackupHeader reads and verifies the header of a backup archive
+func ReadBackupHeader(file string) (*BackupHeader, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readBackupHeader(f)
+}
+
+func readBackupHeader(r io.Reader) (*BackupHeader, error) {
+	h := new(BackupHeader)
+	if err := binary.Read(r, binary.BigEndian, h); err != nil {
+		return nil, fmt.Errorf("read backup header: %s", err)
+	}
+	if err := h.verify(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Backup writes all entries of the store newer than or equal to `since` to a new archive in backup dir,
+// since is 0 for a full backup and -1 for an incremental backup based on the latest archive.
+// Entries in the memory cache are flushed to the store first, so the archive includes all entries written before.
+func (l *Ledger) Backup(since int64) (string, error) {
+	if err := util.CreateDirIfNotExist(l.backupDir); err != nil {
+		return "", err
+	}
+	if err := l.Flush(); err != nil {
+		return "", fmt.Errorf("flush cache: %s", err)
+	}
+	var s uint64
+	if since < 0 {
+		headers, _, err := backupHeaders(l.backupDir)
+		if err != nil {
+			return "", err
+		}
+		if len(headers) == 0 {
+			return "", ErrBackupNotFound
+		}
+		s = headers[len(headers)-1].Upto + 1
+	} else {
+		s = uint64(since)
+	}
+
+	v, err := l.getVersion()
+	if err != nil {
+		return "", fmt.Errorf("get ledger version: %s", err)
+	}
+
+	tmp := filepath.Join(l.backupDir, fmt.Sprintf("backup_%d.processing", time.Now().UnixNano()))
+	f, err := os.Create(tmp)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+	}()
+
+	h := &BackupHeader{
+		Magic:         backupMagic,
+		Format:        backupFormat,
+		LedgerVersion: v,
+		Since:         s,
+		Timestamp:     time.Now().Unix(),
+	}
+	// write a placeholder header, rewrite it after knowing the max version dumped
+	if err := binary.Write(f, binary.BigEndian, h); err != nil {
+		return "", err
+	}
+	w := bufio.NewWriter(f)
+	r, err := l.store.Action(storage.Backup, w, s)
+	if err != nil {
+		return "", fmt.Errorf("backup store: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	h.Upto = r.(uint64)
+	// no entry newer than since, keep the archives continuous instead of adding an empty one
+	if h.Upto == 0 || h.Upto < s {
+		return "", ErrBackupNothing
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	if err := binary.Write(f, binary.BigEndian, h); err != nil {
+		return "", err
+	}
+	if err := f.Sync(); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	file := filepath.Join(l.backupDir, fmt.Sprintf("ledger_%d_%d_%d%s", h.Since, h.Upto, h.Timestamp, backupExt))
+	if err := os.Rename(tmp, file); err != nil {
+		return "", err
+	}
+	l.logger.Infof("ledger backup to %s, version %d-%d", file, h.Since, h.Upto)
+	return file, nil
+}
+
+// Restore rebuilds the ledger from archives in backup dir into a staging directory,
+// which will replace the ledger directory at next start. Archives newer than `upto` are skipped if upto > 0.
+func (l *Ledger) Restore(upto uint64) (string, error) {
+	return RestoreLedger(l.dir, l.backupDir, upto)
+}
+
+// RestoreLedger restores archives in backupDir into the staging directory of ledgerDir
+func RestoreLedger(ledgerDir, backupDir string, upto uint64) (string, error) {
+	archives, err := BackupArchives(backupDir, upto)
+	if err != nil {
+		return "", err
+	}
+	staging := ledgerDir + restoreSuffix
+	if err := os.RemoveAll(staging); err != nil {
+		return "", err
+	}
+	if err := restore(staging, archives); err != nil {
+		_ = os.RemoveAll(staging)
+		return "", err
+	}
+	if err := ioutil.WriteFile(filepath.Join(staging, restoreDoneFile), []byte(time.Now().String()), 0600); err != nil {
+		return "", err
+	}
+	return staging, nil
+}
+
+// BackupArchives returns the archives to restore in order, which begins with the latest full backup
+// and followed by continuous incremental backups.
+func BackupArchives(backupDir string, upto uint64) ([]string, error) {
+	headers, files, err := backupHeaders(backupDir)
+	if err != nil {
+		return nil, err
+	}
+
+	start := -1
+	for i, h := range headers {
+		if upto > 0 && h.Upto > upto {
+			break
+		}
+		if h.Since == 0 {
+			start = i
+		}
+	}
+	if start < 0 {
+		return nil, ErrBackupNotFound
+	}
+
+	archives := []string{files[start]}
+	cur := headers[start].Upto
+	for i := start + 1; i < len(headers); i++ {
+		h := headers[i]
+		if upto > 0 && h.Upto > upto {
+			break
+		}
+		if h.Upto <= cur {
+			continue
+		}
+		if h.Since == 0 || h.Since > cur+1 {
+			return nil, ErrBackupDiscontinued
+		}
+		archives = append(archives, files[i])
+		cur = h.Upto
+	}
+	return archives, nil
+}
+
+// backupHeaders returns headers of all archives in backupDir, sorted by upto version
+func backupHeaders(backupDir string) ([]*BackupHeader, []string, error) {
+	files, err := filepath.Glob(filepath.Join(backupDir, "*"+backupExt))
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := make([]*BackupHeader, 0)
+	archives := make([]string, 0)
+	for _, file := range files {
+		h, err := ReadBackupHeader(file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %s", file, err)
+		}
+		headers = append(headers, h)
+		archives = append(archives, file)
+	}
+	sort.Sort(byUpto{headers, archives})
+	return headers, archives, nil
+}
+
+type byUpto struct {
+	headers []*BackupHeader
+	files   []string
+}
+
+func (b byUpto) Len() int { return len(b.headers) }
+func (b byUpto) Less(i, j int) bool {
+	if b.headers[i].Upto == b.headers[j].Upto {
+		return b.headers[i].Since < b.headers[j].Since
+	}
+	return b.headers[i].Upto < b.headers[j].Upto
+}
+func (b byUpto) Swap(i, j int) {
+	b.headers[i], b.headers[j] = b.headers[j], b.headers[i]
+	b.files[i], b.files[j] = b.files[j], b.files[i]
+}
+
+func restore(dir string, archives []string) error {
+	store, err := db.NewBadgerStore(dir)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	var last *BackupHeader
+	for _, archive := range archives {
+		h, err := restoreArchive(store, archive)
+		if err != nil {
+			return fmt.Errorf("restore %s: %s", archive, err)
+		}
+		last = h
+	}
+	if last == nil {
+		return ErrBackupNotFound
+	}
+
+	val, err := store.Get(getVersionKey())
+	if err != nil {
+		return fmt.Errorf("get restored ledger version: %s", err)
+	}
+	v, _ := binary.Varint(val)
+	if v != last.LedgerVersion {
+		return fmt.Errorf("restored ledger version %d mismatch with backup version %d", v, last.LedgerVersion)
+	}
+	return nil
+}
+
+func restoreArchive(store storage.Store, archive string) (*BackupHeader, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	h, err := readBackupHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := store.Action(storage.Restore, r); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// applyRestore replaces the ledger directory with a completed restore staging directory
+func applyRestore(dir string) error {
+	staging := dir + restoreSuffix
+	done := filepath.Join(staging, restoreDoneFile)
+	if _, err := os.Stat(done); err != nil {
+		return nil
+	}
+	if _, err := os.Stat(dir); err == nil {
+		old := fmt.Sprintf("%s.%d", strings.TrimRight(dir, string(filepath.Separator)), time.Now().Unix())
+		if err := os.Rename(dir, old); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(staging, dir); err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(dir, restoreDoneFile))
+}
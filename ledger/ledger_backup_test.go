@@ -0,0 +1,156 @@
+package ledger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/storage/db"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestLedger_Backup(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	if _, err := l.Backup(-1); err != ErrBackupNotFound {
+		t.Fatal(err)
+	}
+	if err := l.store.Put([]byte{1, 2, 3}, []byte{4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+	full, err := l.Action(storage.Backup, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.store.Put([]byte{1, 2, 4}, []byte{4, 5, 7}); err != nil {
+		t.Fatal(err)
+	}
+	incr, err := l.Action(storage.Backup, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := l.Action(storage.Backup, -1); err != ErrBackupNothing {
+		t.Fatal("backup without new entries should fail", err)
+	}
+	if _, err := l.Backup(-1); err != ErrBackupNothing {
+		t.Fatal("backup without new entries should fail", err)
+	}
+
+	h1, err := ReadBackupHeader(full.(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := ReadBackupHeader(incr.(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1.Since != 0 || h2.Since != h1.Upto+1 || h2.Upto <= h1.Upto || h2.LedgerVersion != version {
+		t.Fatal(h1, h2)
+	}
+
+	archives, err := BackupArchives(l.backupDir, 0)
+	if err != nil || len(archives) != 2 {
+		t.Fatal(archives, err)
+	}
+	archives, err = BackupArchives(l.backupDir, h1.Upto)
+	if err != nil || len(archives) != 1 {
+		t.Fatal(archives, err)
+	}
+
+	r, err := l.Action(storage.Restore, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staging := r.(string)
+	if staging != l.dir+restoreSuffix {
+		t.Fatal(staging)
+	}
+	if _, err := os.Stat(filepath.Join(staging, restoreDoneFile)); err != nil {
+		t.Fatal(err)
+	}
+	_ = os.Remove(filepath.Join(staging, restoreDoneFile))
+
+	store, err := db.NewBadgerStore(staging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+		_ = os.RemoveAll(staging)
+	}()
+	for _, k := range [][]byte{{1, 2, 3}, {1, 2, 4}} {
+		if b, err := store.Has(k); !b || err != nil {
+			t.Fatal(k, err)
+		}
+	}
+}
+
+func TestLedger_BackupDiscontinued(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	if _, err := l.Action(storage.Restore, 0); err != ErrBackupNotFound {
+		t.Fatal(err)
+	}
+	if _, err := l.Backup(0); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := l.store.Put([]byte{1, byte(i)}, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	headers, _, err := backupHeaders(l.backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.Backup(int64(headers[0].Upto + 5)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BackupArchives(l.backupDir, 0); err != ErrBackupDiscontinued {
+		t.Fatal(err)
+	}
+}
+
+func TestLedger_BackupCache(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	// blocks are in memory cache right after added, backup should include them
+	blocks := []*types.StateBlock{mock.StateBlockWithoutWork(), mock.StateBlockWithoutWork()}
+	for _, blk := range blocks {
+		if err := l.AddStateBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := l.Backup(0); err != nil {
+		t.Fatal(err)
+	}
+	staging, err := l.Restore(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = os.Remove(filepath.Join(staging, restoreDoneFile))
+
+	store, err := db.NewBadgerStore(staging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+		_ = os.RemoveAll(staging)
+	}()
+	for _, blk := range blocks {
+		k, err := storage.GetKeyOfParts(storage.KeyPrefixBlock, blk.GetHash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b, err := store.Has(k); !b || err != nil {
+			t.Fatal("block should be restored", blk.GetHash(), err)
+		}
+	}
+}
@@ -150,6 +150,12 @@ func (l *Ledger) setStateBlock(block *types.StateBlock, c storage.Cache) error {
 	if err := l.setBlockLink(block, c); err != nil {
 		return fmt.Errorf("add block link error: %s", err)
 	}
+	if err := l.addHistoryIndex(block, c); err != nil {
+		return fmt.Errorf("add history index error: %s", err)
+	}
+	if err := l.addFeeCredits(block, c); err != nil {
+		return fmt.Errorf("add fee credits error: %s", err)
+	}
 	return c.Put(k, block.Clone())
 }
 
@@ -175,6 +181,12 @@ func (l *Ledger) DeleteStateBlock(key types.Hash, c storage.Cache) error {
 	if err := l.deleteBlockLink(blk, c); err != nil {
 		return fmt.Errorf("delete link error: %s", err)
 	}
+	if err := l.deleteHistoryIndex(blk, c); err != nil {
+		return fmt.Errorf("delete history index error: %s", err)
+	}
+	if err := l.deleteFeeCredits(blk, c); err != nil {
+		return fmt.Errorf("delete fee credits error: %s", err)
+	}
 
 	l.logger.Info("publish deleteRelation,", key.String())
 	l.EB.Publish(topic.EventDeleteRelation, key)
@@ -411,7 +423,7 @@ func (l *Ledger) AddBlockCache(blk *types.StateBlock, batch ...storage.Batch) er
 	if err := b.Put(k, v); err != nil {
 		return err
 	}
-	return nil
+	return l.reserveFee(blk, b)
 }
 
 func (l *Ledger) GetBlockCache(hash types.Hash) (*types.StateBlock, error) {
@@ -455,7 +467,16 @@ func (l *Ledger) DeleteBlockCache(hash types.Hash, batch ...storage.Batch) error
 	if err != nil {
 		return err
 	}
-
+	// release the fee reserved by the block
+	if blk, err := l.GetBlockCache(hash); err == nil && blk.HasFee() {
+		rk, err := getFeeReserveKey(blk)
+		if err != nil {
+			return err
+		}
+		if err := b.Delete(rk); err != nil {
+			return err
+		}
+	}
 	return b.Delete(k)
 }
 
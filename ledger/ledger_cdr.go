@@ -0,0 +1,149 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+// CDRStore keeps CDR records which are ingested in bulk off the ledger, records of a party of a settlement
+// contract are pending until they are sealed into a batch, only the merkle root of the batch is put on chain
+type CDRStore interface {
+	AddCDRRecord(contract, party types.Address, hash types.Hash, record []byte) error
+	GetCDRRecord(contract, party types.Address, hash types.Hash) ([]byte, error)
+	HasCDRRecord(contract, party types.Address, hash types.Hash) (bool, error)
+	GetCDRPendings(contract, party types.Address, fn func(hash types.Hash, record []byte) error) error
+	CountCDRPendings(contract, party types.Address) (uint64, error)
+	AddCDRBatch(contract, party types.Address, seq uint64, batch []byte, records map[types.Hash][]byte) error
+	GetCDRBatch(contract, party types.Address, seq uint64) ([]byte, error)
+	GetCDRBatches(contract, party types.Address, fn func(seq uint64, batch []byte) error) error
+}
+
+var ErrCDRNotFound = errors.New("CDR not found")
+
+// AddCDRRecord saves the record and marks it pending
+func (l *Ledger) AddCDRRecord(contract, party types.Address, hash types.Hash, record []byte) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixCDRRecord, contract, party, hash)
+	if err != nil {
+		return err
+	}
+	pk, err := storage.GetKeyOfParts(storage.KeyPrefixCDRPending, contract, party, hash)
+	if err != nil {
+		return err
+	}
+	return l.store.BatchWrite(false, func(batch storage.Batch) error {
+		if err := batch.Put(k, record); err != nil {
+			return err
+		}
+		return batch.Put(pk, []byte{1})
+	})
+}
+
+func (l *Ledger) GetCDRRecord(contract, party types.Address, hash types.Hash) ([]byte, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixCDRRecord, contract, party, hash)
+	if err != nil {
+		return nil, err
+	}
+	v, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrCDRNotFound
+		}
+		return nil, fmt.Errorf("get CDR record error: %s", err)
+	}
+	return v, nil
+}
+
+func (l *Ledger) HasCDRRecord(contract, party types.Address, hash types.Hash) (bool, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixCDRRecord, contract, party, hash)
+	if err != nil {
+		return false, err
+	}
+	return l.store.Has(k)
+}
+
+// GetCDRPendings iterates the records which are not sealed into a batch, ordered by hash
+func (l *Ledger) GetCDRPendings(contract, party types.Address, fn func(hash types.Hash, record []byte) error) error {
+	prefix, err := storage.GetKeyOfParts(storage.KeyPrefixCDRPending, contract, party)
+	if err != nil {
+		return err
+	}
+	return l.store.Iterator(prefix, nil, func(key []byte, _ []byte) error {
+		hash, err := types.BytesToHash(key[len(prefix):])
+		if err != nil {
+			return err
+		}
+		record, err := l.GetCDRRecord(contract, party, hash)
+		if err != nil {
+			return err
+		}
+		return fn(hash, record)
+	})
+}
+
+func (l *Ledger) CountCDRPendings(contract, party types.Address) (uint64, error) {
+	prefix, err := storage.GetKeyOfParts(storage.KeyPrefixCDRPending, contract, party)
+	if err != nil {
+		return 0, err
+	}
+	return l.store.Count(prefix)
+}
+
+// AddCDRBatch saves the batch and the records which are sealed into it, the records are not pending any more
+func (l *Ledger) AddCDRBatch(contract, party types.Address, seq uint64, batch []byte, records map[types.Hash][]byte) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixCDRBatch, contract, party, seq)
+	if err != nil {
+		return err
+	}
+	return l.store.BatchWrite(false, func(b storage.Batch) error {
+		if err := b.Put(k, batch); err != nil {
+			return err
+		}
+		for hash, record := range records {
+			rk, err := storage.GetKeyOfParts(storage.KeyPrefixCDRRecord, contract, party, hash)
+			if err != nil {
+				return err
+			}
+			if err := b.Put(rk, record); err != nil {
+				return err
+			}
+			pk, err := storage.GetKeyOfParts(storage.KeyPrefixCDRPending, contract, party, hash)
+			if err != nil {
+				return err
+			}
+			if err := b.Delete(pk); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (l *Ledger) GetCDRBatch(contract, party types.Address, seq uint64) ([]byte, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixCDRBatch, contract, party, seq)
+	if err != nil {
+		return nil, err
+	}
+	v, err := l.store.Get(k)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrCDRNotFound
+		}
+		return nil, fmt.Errorf("get CDR batch error: %s", err)
+	}
+	return v, nil
+}
+
+// GetCDRBatches iterates batches of the party in the order of seq
+func (l *Ledger) GetCDRBatches(contract, party types.Address, fn func(seq uint64, batch []byte) error) error {
+	prefix, err := storage.GetKeyOfParts(storage.KeyPrefixCDRBatch, contract, party)
+	if err != nil {
+		return err
+	}
+	return l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		return fn(util.BE_BytesToUint64(key[len(prefix):]), val)
+	})
+}
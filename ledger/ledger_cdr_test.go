@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestLedger_CDR(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	contract := mock.Address()
+	party := mock.Address()
+	hashes := []types.Hash{mock.Hash(), mock.Hash(), mock.Hash()}
+	for i, h := range hashes {
+		if err := l.AddCDRRecord(contract, party, h, []byte{byte(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if ok, err := l.HasCDRRecord(contract, party, hashes[0]); err != nil || !ok {
+		t.Fatal("record should exist", err)
+	}
+	if ok, _ := l.HasCDRRecord(contract, mock.Address(), hashes[0]); ok {
+		t.Fatal("record of other party should not exist")
+	}
+	if _, err := l.GetCDRRecord(contract, party, mock.Hash()); err != ErrCDRNotFound {
+		t.Fatal("invalid error", err)
+	}
+	if c, err := l.CountCDRPendings(contract, party); err != nil || c != 3 {
+		t.Fatal("invalid pending count", c, err)
+	}
+
+	records := map[types.Hash][]byte{hashes[0]: {10}, hashes[1]: {11}}
+	if err := l.AddCDRBatch(contract, party, 1, []byte{1}, records); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddCDRBatch(contract, party, 2, []byte{2}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var pendings []types.Hash
+	if err := l.GetCDRPendings(contract, party, func(hash types.Hash, record []byte) error {
+		pendings = append(pendings, hash)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(pendings) != 1 || pendings[0] != hashes[2] {
+		t.Fatal("invalid pendings", pendings)
+	}
+	if r, err := l.GetCDRRecord(contract, party, hashes[1]); err != nil || !bytes.Equal(r, []byte{11}) {
+		t.Fatal("record should be updated", r, err)
+	}
+
+	if b, err := l.GetCDRBatch(contract, party, 2); err != nil || !bytes.Equal(b, []byte{2}) {
+		t.Fatal("invalid batch", b, err)
+	}
+	if _, err := l.GetCDRBatch(contract, party, 3); err != ErrCDRNotFound {
+		t.Fatal("invalid error", err)
+	}
+	var seqs []uint64
+	if err := l.GetCDRBatches(contract, party, func(seq uint64, batch []byte) error {
+		seqs = append(seqs, seq)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(seqs) != 2 || seqs[0] != 1 || seqs[1] != 2 {
+		t.Fatal("invalid batches", seqs)
+	}
+}
@@ -2,12 +2,18 @@ package ledger
 
 import (
 	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
 )
 
 type DposStore interface {
 	GetLastGapPovHeight() uint64
 	SetLastGapPovHeight(height uint64) error
+	AddEquivocation(value *types.Equivocation) error
+	HasEquivocation(account types.Address, key types.Hash) (bool, error)
+	DeleteEquivocation(account types.Address, key types.Hash) error
+	GetEquivocations(fn func(value *types.Equivocation) error) error
+	GetEquivocationsByAccount(account types.Address, fn func(value *types.Equivocation) error) error
 }
 
 const (
@@ -35,3 +41,56 @@ func (l *Ledger) SetLastGapPovHeight(height uint64) error {
 	key := getDPoSDataKey(DPoSDataLastGapPovHeight)
 	return l.store.Put(key, util.BE_Uint64ToBytes(height))
 }
+
+func getEquivocationKey(account types.Address, key types.Hash) ([]byte, error) {
+	return storage.GetKeyOfParts(storage.KeyPrefixEquivocation, account, key)
+}
+
+func (l *Ledger) AddEquivocation(value *types.Equivocation) error {
+	k, err := getEquivocationKey(value.Account, value.Key())
+	if err != nil {
+		return err
+	}
+	v, err := value.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) HasEquivocation(account types.Address, key types.Hash) (bool, error) {
+	k, err := getEquivocationKey(account, key)
+	if err != nil {
+		return false, err
+	}
+	return l.store.Has(k)
+}
+
+func (l *Ledger) DeleteEquivocation(account types.Address, key types.Hash) error {
+	k, err := getEquivocationKey(account, key)
+	if err != nil {
+		return err
+	}
+	return l.store.Delete(k)
+}
+
+func (l *Ledger) GetEquivocations(fn func(value *types.Equivocation) error) error {
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixEquivocation)
+	return l.iterateEquivocations(prefix, fn)
+}
+
+func (l *Ledger) GetEquivocationsByAccount(account types.Address, fn func(value *types.Equivocation) error) error {
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixEquivocation, account)
+	return l.iterateEquivocations(prefix, fn)
+}
+
+func (l *Ledger) iterateEquivocations(prefix []byte, fn func(value *types.Equivocation) error) error {
+	return l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		eq := new(types.Equivocation)
+		if err := eq.Deserialize(val); err != nil {
+			l.logger.Errorf("deserialize equivocation error: %s", err)
+			return nil
+		}
+		return fn(eq)
+	})
+}
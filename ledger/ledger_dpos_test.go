@@ -1,6 +1,11 @@
 package ledger
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
 
 func TestLedger_GetLastGapPovHeight(t *testing.T) {
 	teardownTestCase, l := setupTestCase(t)
@@ -21,3 +26,61 @@ func TestLedger_GetLastGapPovHeight(t *testing.T) {
 		t.Fatal(height)
 	}
 }
+
+func TestLedger_AddEquivocation(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blkA := mock.StateBlockWithoutWork()
+	blkB := blkA.Clone()
+	blkB.Timestamp++
+	eq := &types.Equivocation{
+		Account: mock.Address(),
+		Root:    blkA.Root(),
+		BlockA:  blkA,
+		AckA:    &types.EquivocationAck{Hashes: []types.Hash{blkA.GetHash()}},
+		BlockB:  blkB,
+		AckB:    &types.EquivocationAck{Hashes: []types.Hash{blkB.GetHash()}},
+	}
+
+	if has, _ := l.HasEquivocation(eq.Account, eq.Key()); has {
+		t.Fatal()
+	}
+	if err := l.AddEquivocation(eq); err != nil {
+		t.Fatal(err)
+	}
+	if has, err := l.HasEquivocation(eq.Account, eq.Key()); err != nil || !has {
+		t.Fatal(err)
+	}
+
+	eq2 := &types.Equivocation{Account: mock.Address(), BlockA: blkA, BlockB: blkB}
+	if err := l.AddEquivocation(eq2); err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	if err := l.GetEquivocations(func(value *types.Equivocation) error {
+		count++
+		return nil
+	}); err != nil || count != 2 {
+		t.Fatal(err, count)
+	}
+
+	count = 0
+	if err := l.GetEquivocationsByAccount(eq.Account, func(value *types.Equivocation) error {
+		if value.Key() != eq.Key() {
+			t.Fatal("invalid equivocation")
+		}
+		count++
+		return nil
+	}); err != nil || count != 1 {
+		t.Fatal(err, count)
+	}
+
+	if err := l.DeleteEquivocation(eq.Account, eq.Key()); err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := l.HasEquivocation(eq.Account, eq.Key()); has {
+		t.Fatal("equivocation should be deleted")
+	}
+}
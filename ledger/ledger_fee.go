@@ -0,0 +1,172 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+)
+
+type FeeStore interface {
+	GetFeeCredit(address types.Address, c ...storage.Cache) (types.Balance, error)
+	GetReservedFee(address types.Address) (types.Balance, error)
+}
+
+var ErrInsufficientFeeCredit = errors.New("insufficient fee credit")
+
+// IsFeeDeposit checks if the block sends QGAS to the fee address, the amount becomes fee credit of the sender
+func IsFeeDeposit(block *types.StateBlock) bool {
+	return block.GetType() == types.Send && block.GetToken() == config.GasToken() &&
+		types.Address(block.GetLink()) == contractaddress.FeeAddress
+}
+
+func getFeeCreditKey(address types.Address) ([]byte, error) {
+	return storage.GetKeyOfParts(storage.KeyPrefixFeeCredit, address)
+}
+
+// GetFeeCredit returns the QGAS which can be used to pay fee of blocks of the account
+func (l *Ledger) GetFeeCredit(address types.Address, c ...storage.Cache) (types.Balance, error) {
+	k, err := getFeeCreditKey(address)
+	if err != nil {
+		return types.ZeroBalance, err
+	}
+	i, r, err := l.GetObject(k, c...)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return types.ZeroBalance, nil
+		}
+		return types.ZeroBalance, err
+	}
+	if v, ok := i.([]byte); ok {
+		r = v
+	}
+	return types.Balance{Int: new(big.Int).SetBytes(r)}, nil
+}
+
+// GetReservedFee returns the total fee of unconfirmed blocks of the account, the fee is reserved from the fee credit
+// when the block is added to block cache, and is released when the block is confirmed or removed from block cache
+func (l *Ledger) GetReservedFee(address types.Address) (types.Balance, error) {
+	prefix, err := storage.GetKeyOfParts(storage.KeyPrefixFeeReserve, address)
+	if err != nil {
+		return types.ZeroBalance, err
+	}
+	reserved := types.ZeroBalance
+	err = l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		reserved = reserved.Add(types.Balance{Int: new(big.Int).SetBytes(val)})
+		return nil
+	})
+	return reserved, err
+}
+
+func getFeeReserveKey(block *types.StateBlock) ([]byte, error) {
+	return storage.GetKeyOfParts(storage.KeyPrefixFeeReserve, block.GetAddress(), block.GetHash())
+}
+
+func (l *Ledger) reserveFee(block *types.StateBlock, b storage.Batch) error {
+	if !block.HasFee() {
+		return nil
+	}
+	k, err := getFeeReserveKey(block)
+	if err != nil {
+		return err
+	}
+	return b.Put(k, block.GetFee().Bytes())
+}
+
+func (l *Ledger) releaseFee(block *types.StateBlock, c storage.Cache) error {
+	if !block.HasFee() {
+		return nil
+	}
+	k, err := getFeeReserveKey(block)
+	if err != nil {
+		return err
+	}
+	return c.Delete(k)
+}
+
+func (l *Ledger) addFeeCredit(address types.Address, amount types.Balance, c storage.Cache) error {
+	credit, err := l.GetFeeCredit(address, c)
+	if err != nil {
+		return err
+	}
+	return l.setFeeCredit(address, credit.Add(amount), c)
+}
+
+func (l *Ledger) subFeeCredit(address types.Address, amount types.Balance, c storage.Cache) error {
+	credit, err := l.GetFeeCredit(address, c)
+	if err != nil {
+		return err
+	}
+	if credit.Compare(amount) == types.BalanceCompSmaller {
+		return fmt.Errorf("%s: %s, credit %s, amount %s", ErrInsufficientFeeCredit, address, credit, amount)
+	}
+	return l.setFeeCredit(address, credit.Sub(amount), c)
+}
+
+func (l *Ledger) setFeeCredit(address types.Address, credit types.Balance, c storage.Cache) error {
+	k, err := getFeeCreditKey(address)
+	if err != nil {
+		return err
+	}
+	if credit.Sign() == 0 {
+		return c.Delete(k)
+	}
+	return c.Put(k, credit.Bytes())
+}
+
+// addFeeCredits updates fee credits of the confirmed block, deposit is credited to the sender,
+// fee is paid by the account and is credited to the representative if fee is not burned
+func (l *Ledger) addFeeCredits(block *types.StateBlock, c storage.Cache) error {
+	if IsFeeDeposit(block) {
+		amount, err := l.calculateAmount(block, c)
+		if err != nil {
+			return err
+		}
+		if err := l.addFeeCredit(block.GetAddress(), amount, c); err != nil {
+			return err
+		}
+	}
+	if block.HasFee() {
+		if err := l.releaseFee(block, c); err != nil {
+			return err
+		}
+		if err := l.subFeeCredit(block.GetAddress(), block.GetFee(), c); err != nil {
+			return err
+		}
+		if !config.GenesisFee().IsBurn() {
+			return l.addFeeCredit(block.GetRepresentative(), block.GetFee(), c)
+		}
+	}
+	return nil
+}
+
+// deleteFeeCredits reverts fee credits of the rollback block
+func (l *Ledger) deleteFeeCredits(block *types.StateBlock, c storage.Cache) error {
+	if block.HasFee() {
+		if !config.GenesisFee().IsBurn() {
+			// the representative may have paid with the credit already, so it is reduced to zero at most
+			credit, err := l.GetFeeCredit(block.GetRepresentative(), c)
+			if err != nil {
+				return err
+			}
+			if err := l.setFeeCredit(block.GetRepresentative(), credit.Sub(block.GetFee()), c); err != nil {
+				return err
+			}
+		}
+		if err := l.addFeeCredit(block.GetAddress(), block.GetFee(), c); err != nil {
+			return err
+		}
+	}
+	if IsFeeDeposit(block) {
+		amount, err := l.calculateAmount(block, c)
+		if err != nil {
+			return err
+		}
+		return l.subFeeCredit(block.GetAddress(), amount, c)
+	}
+	return nil
+}
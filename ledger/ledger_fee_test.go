@@ -0,0 +1,80 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestLedger_FeeCredit(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	account := mock.Account()
+	open := mock.StateBlockWithoutWork()
+	open.Type = types.Open
+	open.Token = config.GasToken()
+	open.Address = account.Address()
+	open.Previous = types.ZeroHash
+	open.Balance = types.NewBalance(1000)
+
+	deposit := mock.StateBlockWithoutWork()
+	deposit.Type = types.Send
+	deposit.Token = config.GasToken()
+	deposit.Address = account.Address()
+	deposit.Previous = open.GetHash()
+	deposit.Link = types.Hash(contractaddress.FeeAddress)
+	deposit.Balance = types.NewBalance(600)
+	if !IsFeeDeposit(deposit) {
+		t.Fatal("block should be fee deposit")
+	}
+
+	fee := types.NewBalance(100)
+	change := mock.StateBlockWithoutWork()
+	change.Type = types.Change
+	change.Token = config.GasToken()
+	change.Address = account.Address()
+	change.Previous = deposit.GetHash()
+	change.Balance = types.NewBalance(600)
+	change.Fee = &fee
+
+	for _, b := range []*types.StateBlock{open, deposit, change} {
+		if err := l.AddStateBlock(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if credit, err := l.GetFeeCredit(account.Address()); err != nil || !credit.Equal(types.NewBalance(300)) {
+		t.Fatal("invalid fee credit", credit, err)
+	}
+
+	// fee larger than the credit can not be paid
+	overpay := types.NewBalance(500)
+	blk := mock.StateBlockWithoutWork()
+	blk.Type = types.Change
+	blk.Address = account.Address()
+	blk.Previous = change.GetHash()
+	blk.Fee = &overpay
+	if err := l.AddStateBlock(blk); err == nil {
+		t.Fatal("fee should be larger than credit")
+	}
+
+	if err := l.cache.BatchUpdate(func(c *Cache) error {
+		return l.DeleteStateBlock(change.GetHash(), c)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if credit, _ := l.GetFeeCredit(account.Address()); !credit.Equal(types.NewBalance(400)) {
+		t.Fatal("invalid fee credit after rollback", credit)
+	}
+	if err := l.cache.BatchUpdate(func(c *Cache) error {
+		return l.DeleteStateBlock(deposit.GetHash(), c)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if credit, _ := l.GetFeeCredit(account.Address()); !credit.IsZero() {
+		t.Fatal("invalid fee credit after rollback", credit)
+	}
+}
@@ -0,0 +1,362 @@
+package ledger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+type HistoryStore interface {
+	AccountHistory(address types.Address, filter *HistoryFilter, cursor string, limit int) ([]*HistoryEntry, string, error)
+	TokenTransfers(token types.Hash, filter *HistoryFilter, cursor string, limit int) ([]*HistoryEntry, string, error)
+}
+
+var ErrInvalidHistoryCursor = errors.New("invalid history cursor")
+
+// MaxHistoryLimit is the max count of entries returned by one history query
+const MaxHistoryLimit = 1000
+
+const (
+	historyIndexAccount byte = iota
+	historyIndexToken
+)
+
+// historyIndexVersion is the ledger version since which the history indexes are maintained
+const historyIndexVersion = 17
+
+const historyRebuildBatchSize = 1000
+
+// HistoryFilter filters confirmed blocks, an empty list or a nil bound matches any value.
+// Counterparty is the receiver of a send or the sender of a receive, StartTime and EndTime
+// bound the block timestamp inclusively when they are not zero.
+type HistoryFilter struct {
+	Tokens       []types.Hash      `json:"tokens"`
+	Types        []types.BlockType `json:"types"`
+	Counterparty *types.Address    `json:"counterparty,omitempty"`
+	MinAmount    *types.Balance    `json:"minAmount,omitempty"`
+	MaxAmount    *types.Balance    `json:"maxAmount,omitempty"`
+	StartTime    int64             `json:"startTime"`
+	EndTime      int64             `json:"endTime"`
+}
+
+// HistoryEntry is a confirmed block as it is saved in the history indexes
+type HistoryEntry struct {
+	Hash         types.Hash      `json:"hash"`
+	Type         types.BlockType `json:"type"`
+	Address      types.Address   `json:"address"`
+	Token        types.Hash      `json:"token"`
+	Counterparty types.Address   `json:"counterparty"`
+	Amount       types.Balance   `json:"amount"`
+	Timestamp    int64           `json:"timestamp"`
+}
+
+// Match checks the entry against the filter
+func (f *HistoryFilter) Match(entry *HistoryEntry) bool {
+	if len(f.Tokens) > 0 {
+		var found bool
+		for _, t := range f.Tokens {
+			if t == entry.Token {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Types) > 0 {
+		var found bool
+		for _, t := range f.Types {
+			if t == entry.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Counterparty != nil && *f.Counterparty != entry.Counterparty {
+		return false
+	}
+	if f.MinAmount != nil && f.MinAmount.Int != nil && entry.Amount.Compare(*f.MinAmount) == types.BalanceCompSmaller {
+		return false
+	}
+	if f.MaxAmount != nil && f.MaxAmount.Int != nil && entry.Amount.Compare(*f.MaxAmount) == types.BalanceCompBigger {
+		return false
+	}
+	if f.StartTime > 0 && entry.Timestamp < f.StartTime {
+		return false
+	}
+	if f.EndTime > 0 && entry.Timestamp > f.EndTime {
+		return false
+	}
+	return true
+}
+
+// AccountHistory returns the confirmed blocks of the account matching the filter, newest first.
+// Pass the returned cursor to get the next page, an empty cursor means there is no more entries.
+// Blocks confirmed after the first page are newer than the cursor, so they do not shift the pages.
+func (l *Ledger) AccountHistory(address types.Address, filter *HistoryFilter, cursor string,
+	limit int) ([]*HistoryEntry, string, error) {
+	return l.queryHistory(historyIndexPrefix(historyIndexAccount, address[:]), filter, cursor, limit)
+}
+
+// TokenTransfers returns the confirmed blocks of the token matching the filter, newest first, it pages like AccountHistory
+func (l *Ledger) TokenTransfers(token types.Hash, filter *HistoryFilter, cursor string,
+	limit int) ([]*HistoryEntry, string, error) {
+	return l.queryHistory(historyIndexPrefix(historyIndexToken, token[:]), filter, cursor, limit)
+}
+
+// errHistoryPageFull stops the index iteration once a page is filled
+var errHistoryPageFull = errors.New("history page is full")
+
+func (l *Ledger) queryHistory(prefix []byte, filter *HistoryFilter, cursor string,
+	limit int) ([]*HistoryEntry, string, error) {
+	if limit < 1 || limit > MaxHistoryLimit {
+		return nil, "", fmt.Errorf("limit should be between 1 and %d", MaxHistoryLimit)
+	}
+	if filter == nil {
+		filter = new(HistoryFilter)
+	}
+
+	// keys are ordered newest first, EndTime bounds the first key and StartTime bounds the last key
+	start := append([]byte{}, prefix...)
+	if filter.EndTime > 0 {
+		start = append(start, historyTimeKey(filter.EndTime)...)
+	}
+	if cursor != "" {
+		after, err := hex.DecodeString(cursor)
+		if err != nil || len(after) != 8+types.HashSize {
+			return nil, "", ErrInvalidHistoryCursor
+		}
+		// the next page starts right after the cursor
+		after = append(append(append([]byte{}, prefix...), after...), 0)
+		if bytes.Compare(after, start) > 0 {
+			start = after
+		}
+	}
+	end := upperBoundOfPrefix(prefix)
+	if filter.StartTime > 0 {
+		end = upperBoundOfPrefix(append(append([]byte{}, prefix...), historyTimeKey(filter.StartTime)...))
+	}
+	inRange := func(k []byte) bool {
+		return len(k) == len(prefix)+8+types.HashSize && bytes.Compare(k, start) >= 0 &&
+			(end == nil || bytes.Compare(k, end) < 0)
+	}
+
+	type item struct {
+		suffix []byte
+		entry  *HistoryEntry
+	}
+	items := make([]*item, 0)
+	match := func(k, v []byte) error {
+		suffix := k[len(prefix):]
+		entry, err := decodeHistoryEntry(suffix, v)
+		if err != nil {
+			return err
+		}
+		if filter.Match(entry) {
+			items = append(items, &item{suffix: append([]byte{}, suffix...), entry: entry})
+		}
+		return nil
+	}
+
+	// entries not flushed yet are in the cache, they are merged with the entries of the store
+	cached, err := l.cache.prefixIterator(prefix, func(k []byte, v []byte) error {
+		if !inRange(k) {
+			return nil
+		}
+		return match(k, v)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	found := 0
+	storeEnd := end
+	if storeEnd == nil {
+		storeEnd = []byte{0xff}
+	}
+	err = l.DBStore().Iterator(start, storeEnd, func(k []byte, v []byte) error {
+		if !inRange(k) || contain(cached, k) {
+			return nil
+		}
+		n := len(items)
+		if err := match(k, v); err != nil {
+			return err
+		}
+		if len(items) > n {
+			found++
+			if found == limit {
+				return errHistoryPageFull
+			}
+		}
+		return nil
+	})
+	if err != nil && err != errHistoryPageFull {
+		return nil, "", err
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i].suffix, items[j].suffix) < 0
+	})
+	entries := make([]*HistoryEntry, 0)
+	for _, it := range items {
+		entries = append(entries, it.entry)
+		if len(entries) == limit {
+			return entries, hex.EncodeToString(it.suffix), nil
+		}
+	}
+	return entries, "", nil
+}
+
+// upperBoundOfPrefix returns the smallest key which is bigger than all keys with the prefix, nil if there is none
+func upperBoundOfPrefix(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// addHistoryIndex saves the account and token history indexes of the confirmed block
+func (l *Ledger) addHistoryIndex(block *types.StateBlock, c storage.Cache) error {
+	value := encodeHistoryValue(l.historyEntry(block, c))
+	for _, k := range historyIndexKeys(block) {
+		if err := c.Put(k, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Ledger) deleteHistoryIndex(block *types.StateBlock, c storage.Cache) error {
+	for _, k := range historyIndexKeys(block) {
+		if err := c.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildHistoryIndex indexes the blocks confirmed before the history indexes are introduced,
+// the indexes are written in batches of historyRebuildBatchSize blocks
+func (l *Ledger) rebuildHistoryIndex() error {
+	blocks := make([]*types.StateBlock, 0, historyRebuildBatchSize)
+	write := func() error {
+		err := l.store.BatchWrite(false, func(batch storage.Batch) error {
+			for _, block := range blocks {
+				value := encodeHistoryValue(l.historyEntry(block, nil))
+				for _, k := range historyIndexKeys(block) {
+					if err := batch.Put(k, value); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+		blocks = blocks[:0]
+		return err
+	}
+
+	if err := l.GetStateBlocksConfirmed(func(block *types.StateBlock) error {
+		blocks = append(blocks, block)
+		if len(blocks) == historyRebuildBatchSize {
+			return write()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return write()
+}
+
+// historyEntry returns the entry of block, the amount and counterparty are left empty if they can not be found
+func (l *Ledger) historyEntry(block *types.StateBlock, c storage.Cache) *HistoryEntry {
+	entry := &HistoryEntry{
+		Hash:      block.GetHash(),
+		Type:      block.GetType(),
+		Address:   block.GetAddress(),
+		Token:     block.GetToken(),
+		Amount:    types.ZeroBalance,
+		Timestamp: block.GetTimestamp(),
+	}
+	if amount, err := l.calculateAmount(block, c); err == nil && amount.Int != nil {
+		entry.Amount = amount
+	}
+	switch block.GetType() {
+	case types.Send, types.ContractSend:
+		entry.Counterparty = types.Address(block.GetLink())
+	case types.Open, types.Receive, types.ContractReward:
+		if send, err := l.GetStateBlockConfirmed(block.GetLink(), c); err == nil {
+			entry.Counterparty = send.GetAddress()
+		}
+	}
+	return entry
+}
+
+func historyIndexPrefix(kind byte, key []byte) []byte {
+	prefix := []byte{byte(storage.KeyPrefixHistoryIndex), kind}
+	return append(prefix, key...)
+}
+
+// historyTimeKey is the inverted timestamp, so newer entries are iterated first
+func historyTimeKey(ts int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, math.MaxUint64-uint64(ts))
+	return key
+}
+
+// historyIndexKeys returns the index keys of the block, which are suffixed with inverted block timestamp and hash
+func historyIndexKeys(block *types.StateBlock) [][]byte {
+	hash := block.GetHash()
+	suffix := append(historyTimeKey(block.GetTimestamp()), hash[:]...)
+
+	address := block.GetAddress()
+	token := block.GetToken()
+	return [][]byte{
+		append(historyIndexPrefix(historyIndexAccount, address[:]), suffix...),
+		append(historyIndexPrefix(historyIndexToken, token[:]), suffix...),
+	}
+}
+
+// encodeHistoryValue encodes type + address + token + counterparty + amount, hash and timestamp are in the key
+func encodeHistoryValue(entry *HistoryEntry) []byte {
+	value := make([]byte, 0, 1+types.AddressSize*2+types.HashSize+16)
+	value = append(value, byte(entry.Type))
+	value = append(value, entry.Address[:]...)
+	value = append(value, entry.Token[:]...)
+	value = append(value, entry.Counterparty[:]...)
+	return append(value, entry.Amount.Bytes()...)
+}
+
+func decodeHistoryEntry(suffix, value []byte) (*HistoryEntry, error) {
+	size := 1 + types.AddressSize*2 + types.HashSize
+	if len(value) < size {
+		return nil, errors.New("invalid history index value")
+	}
+	entry := &HistoryEntry{
+		Type:      types.BlockType(value[0]),
+		Timestamp: int64(math.MaxUint64 - binary.BigEndian.Uint64(suffix)),
+		Amount:    types.Balance{Int: new(big.Int).SetBytes(value[size:])},
+	}
+	copy(entry.Hash[:], suffix[8:])
+	offset := 1
+	copy(entry.Address[:], value[offset:offset+types.AddressSize])
+	offset += types.AddressSize
+	copy(entry.Token[:], value[offset:offset+types.HashSize])
+	offset += types.HashSize
+	copy(entry.Counterparty[:], value[offset:offset+types.AddressSize])
+	return entry, nil
+}
@@ -0,0 +1,224 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func addHistoryBlocks(t *testing.T, l *Ledger) ([]*types.StateBlock, *types.Account, *types.Account) {
+	blocks, ac1, ac2, err := mock.BlockChainWithAccount(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range blocks {
+		if err := l.AddStateBlock(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return blocks, ac1, ac2
+}
+
+func allHistory(t *testing.T, l *Ledger, address types.Address, filter *HistoryFilter, limit int) []*HistoryEntry {
+	entries := make([]*HistoryEntry, 0)
+	cursor := ""
+	for {
+		page, next, err := l.AccountHistory(address, filter, cursor, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) > limit {
+			t.Fatalf("page size %d exceeds limit %d", len(page), limit)
+		}
+		entries = append(entries, page...)
+		if next == "" {
+			return entries
+		}
+		cursor = next
+	}
+}
+
+func TestLedger_AccountHistory(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blocks, ac1, ac2 := addHistoryBlocks(t, l)
+
+	entries := allHistory(t, l, ac1.Address(), nil, 1)
+	if len(entries) != 3 {
+		t.Fatalf("exp: 3, act: %d", len(entries))
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Timestamp > entries[i-1].Timestamp {
+			t.Fatal("entries should be newest first")
+		}
+	}
+	seen := make(map[types.Hash]bool)
+	for _, e := range entries {
+		if seen[e.Hash] {
+			t.Fatal("duplicate entry", e.Hash)
+		}
+		seen[e.Hash] = true
+	}
+
+	// b4 is the send of ac2 to ac1, b5 is the receive of ac1
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{Types: []types.BlockType{types.Receive}}, 10)
+	if len(entries) != 1 || entries[0].Hash != blocks[5].GetHash() || entries[0].Counterparty != ac2.Address() {
+		t.Fatal("invalid receive entry", entries)
+	}
+	amount := types.Balance{Int: blocks[5].GetBalance().Sub(blocks[1].GetBalance()).Int}
+	if !entries[0].Amount.Equal(amount) {
+		t.Fatalf("exp: %s, act: %s", amount, entries[0].Amount)
+	}
+
+	counterparty := ac1.Address()
+	entries = allHistory(t, l, ac2.Address(), &HistoryFilter{Counterparty: &counterparty}, 10)
+	if len(entries) != 2 {
+		t.Fatalf("exp: 2, act: %d", len(entries))
+	}
+
+	min := types.Balance{Int: amount.Int}
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{MinAmount: &min}, 10)
+	for _, e := range entries {
+		if e.Amount.Compare(min) == types.BalanceCompSmaller {
+			t.Fatal("amount should not be smaller than min")
+		}
+	}
+	max := types.ZeroBalance
+	entries = allHistory(t, l, ac2.Address(), &HistoryFilter{MaxAmount: &max}, 10)
+	if len(entries) != 1 || entries[0].Type != types.Change {
+		t.Fatal("invalid max amount filter", entries)
+	}
+
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{Tokens: []types.Hash{mock.Hash()}}, 10)
+	if len(entries) != 0 {
+		t.Fatal("token should not match")
+	}
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{StartTime: blocks[5].GetTimestamp() + 1}, 10)
+	if len(entries) != 0 {
+		t.Fatal("time window should not match")
+	}
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{EndTime: blocks[5].GetTimestamp()}, 10)
+	if len(entries) != 3 {
+		t.Fatalf("exp: 3, act: %d", len(entries))
+	}
+
+	// invalid parameters
+	if _, _, err := l.AccountHistory(ac1.Address(), nil, "", 0); err == nil {
+		t.Fatal("limit should be checked")
+	}
+	if _, _, err := l.AccountHistory(ac1.Address(), nil, "0102", 10); err != ErrInvalidHistoryCursor {
+		t.Fatal("cursor should be checked")
+	}
+
+	// rolled back blocks are removed from history
+	if err := l.cache.BatchUpdate(func(c *Cache) error {
+		return l.DeleteStateBlock(blocks[5].GetHash(), c)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	entries = allHistory(t, l, ac1.Address(), nil, 10)
+	if len(entries) != 2 {
+		t.Fatalf("exp: 2, act: %d", len(entries))
+	}
+}
+
+func TestLedger_AccountHistoryFlushed(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blocks, ac1, _ := addHistoryBlocks(t, l)
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// pages are read from the store in key order
+	entries := allHistory(t, l, ac1.Address(), nil, 1)
+	if len(entries) != 3 {
+		t.Fatalf("exp: 3, act: %d", len(entries))
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Timestamp > entries[i-1].Timestamp {
+			t.Fatal("entries should be newest first")
+		}
+	}
+	// blocks may be created in the same second, entries of the same time are ordered by hash
+	ts := blocks[5].GetTimestamp()
+	if entries[0].Timestamp != ts {
+		t.Fatal("invalid newest entry", entries[0])
+	}
+
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{StartTime: ts, EndTime: ts}, 1)
+	found := false
+	for _, e := range entries {
+		if e.Timestamp != ts {
+			t.Fatal("entry should be in time window", e)
+		}
+		if e.Hash == blocks[5].GetHash() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("invalid time window", entries)
+	}
+	entries = allHistory(t, l, ac1.Address(), &HistoryFilter{EndTime: ts - 1}, 1)
+	for _, e := range entries {
+		if e.Timestamp > ts-1 {
+			t.Fatal("entry should be before end time", e)
+		}
+	}
+}
+
+func TestLedger_TokenTransfers(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	blocks, _, _ := addHistoryBlocks(t, l)
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	token := config.ChainToken()
+	count := func() int {
+		n, cursor := 0, ""
+		for {
+			page, next, err := l.TokenTransfers(token, &HistoryFilter{Types: []types.BlockType{types.Send}}, cursor, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range page {
+				if e.Type != types.Send || e.Token != token {
+					t.Fatal("invalid entry", e)
+				}
+			}
+			n += len(page)
+			if next == "" {
+				return n
+			}
+			cursor = next
+		}
+	}
+	if c := count(); c != 2 {
+		t.Fatalf("exp: 2, act: %d", c)
+	}
+
+	// indexes of existing ledger are rebuilt on upgrade
+	if err := l.store.Drop([]byte{byte(storage.KeyPrefixHistoryIndex)}); err != nil {
+		t.Fatal(err)
+	}
+	if c := count(); c != 0 {
+		t.Fatalf("exp: 0, act: %d", c)
+	}
+	if err := l.rebuildHistoryIndex(); err != nil {
+		t.Fatal(err)
+	}
+	if c := count(); c != 2 {
+		t.Fatalf("exp: 2, act: %d", c)
+	}
+	if entries, _, _ := l.TokenTransfers(token, nil, "", 10); len(entries) != len(blocks) {
+		t.Fatalf("exp: %d, act: %d", len(blocks), len(entries))
+	}
+}
@@ -15,6 +15,10 @@ type PeerInfoStore interface {
 	CountPeersInfo() (uint64, error)
 	UpdatePeerInfo(value *types.PeerInfo) error
 	AddOrUpdatePeerInfo(value *types.PeerInfo) error
+	AddOrUpdatePeerBan(value *types.PeerBan) error
+	GetPeerBan(peerID string) (*types.PeerBan, error)
+	GetPeerBans(fn func(ban *types.PeerBan) error) error
+	DeletePeerBan(peerID string) error
 }
 
 func (l *Ledger) AddPeerInfo(info *types.PeerInfo) error {
@@ -119,3 +123,56 @@ func (l *Ledger) AddOrUpdatePeerInfo(value *types.PeerInfo) error {
 	}
 	return l.store.Put(k, v)
 }
+
+func (l *Ledger) AddOrUpdatePeerBan(value *types.PeerBan) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPeerBan, []byte(value.PeerID))
+	if err != nil {
+		return err
+	}
+	v, err := value.Serialize()
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, v)
+}
+
+func (l *Ledger) GetPeerBan(peerID string) (*types.PeerBan, error) {
+	key, err := storage.GetKeyOfParts(storage.KeyPrefixPeerBan, []byte(peerID))
+	if err != nil {
+		return nil, err
+	}
+
+	pb := new(types.PeerBan)
+	val, err := l.store.Get(key)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrPeerBanNotFound
+		}
+		return nil, err
+	}
+	if err := pb.Deserialize(val); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (l *Ledger) GetPeerBans(fn func(ban *types.PeerBan) error) error {
+	prefix, _ := storage.GetKeyOfParts(storage.KeyPrefixPeerBan)
+
+	return l.store.Iterator(prefix, nil, func(key []byte, val []byte) error {
+		pb := new(types.PeerBan)
+		if err := pb.Deserialize(val); err != nil {
+			l.logger.Errorf("deserialize peerBan error: %s", err)
+			return nil
+		}
+		return fn(pb)
+	})
+}
+
+func (l *Ledger) DeletePeerBan(peerID string) error {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPeerBan, []byte(peerID))
+	if err != nil {
+		return err
+	}
+	return l.store.Delete(k)
+}
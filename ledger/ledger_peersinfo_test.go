@@ -234,3 +234,48 @@ func TestLedger_CountPeersInfo(t *testing.T) {
 		t.Fatal("PeerInfo Count err")
 	}
 }
+
+func TestLedger_PeerBan(t *testing.T) {
+	teardownTestCase, l := setupPovTestCase(t)
+	defer teardownTestCase(t)
+
+	pb := &types.PeerBan{
+		PeerID:     random.RandomHexString(46),
+		Score:      120,
+		Reason:     "invalid block",
+		BanTime:    100,
+		ExpireTime: 200,
+	}
+	if _, err := l.GetPeerBan(pb.PeerID); err != ErrPeerBanNotFound {
+		t.Fatal(err)
+	}
+	if err := l.AddOrUpdatePeerBan(pb); err != nil {
+		t.Fatal(err)
+	}
+	pb2, err := l.GetPeerBan(pb.PeerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb2.Reason != pb.Reason || pb2.ExpireTime != pb.ExpireTime {
+		t.Fatal("peer ban mismatch", pb2)
+	}
+	if !pb2.IsExpired(200) || pb2.IsExpired(199) {
+		t.Fatal("expire time error")
+	}
+
+	count := 0
+	err = l.GetPeerBans(func(ban *types.PeerBan) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 1 {
+		t.Fatal(count, err)
+	}
+
+	if err := l.DeletePeerBan(pb.PeerID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.GetPeerBan(pb.PeerID); err != ErrPeerBanNotFound {
+		t.Fatal(err)
+	}
+}
@@ -12,6 +12,8 @@ type PrivacyStore interface {
 	AddBlockPrivatePayload(hash types.Hash, payload []byte) error
 	DeleteBlockPrivatePayload(hash types.Hash) error
 	GetBlockPrivatePayload(hash types.Hash) ([]byte, error)
+	AddPrivatePayloadEnvelope(enclaveKey []byte, envelope []byte) error
+	GetPrivatePayloadEnvelope(enclaveKey []byte) ([]byte, error)
 }
 
 func (l *Ledger) AddBlockPrivatePayload(hash types.Hash, payload []byte) error {
@@ -51,3 +53,27 @@ func (l *Ledger) GetBlockPrivatePayload(hash types.Hash) ([]byte, error) {
 	}
 	return pl, nil
 }
+
+// AddPrivatePayloadEnvelope saves the encrypted payload of the native transaction manager,
+// enclave key is longer than block hash, so they never overlap under the same prefix
+func (l *Ledger) AddPrivatePayloadEnvelope(enclaveKey []byte, envelope []byte) error {
+	if len(enclaveKey) == 0 || len(enclaveKey) == types.HashSize {
+		return fmt.Errorf("invalid enclave key length %d", len(enclaveKey))
+	}
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPrivatePayload, enclaveKey)
+	if err != nil {
+		return err
+	}
+	return l.store.Put(k, envelope)
+}
+
+func (l *Ledger) GetPrivatePayloadEnvelope(enclaveKey []byte) ([]byte, error) {
+	if len(enclaveKey) == 0 || len(enclaveKey) == types.HashSize {
+		return nil, fmt.Errorf("invalid enclave key length %d", len(enclaveKey))
+	}
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixPrivatePayload, enclaveKey)
+	if err != nil {
+		return nil, err
+	}
+	return l.store.Get(k)
+}
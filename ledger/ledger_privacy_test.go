@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/mock"
 )
@@ -39,3 +40,29 @@ func TestLedger_BlockPrivatePayload(t *testing.T) {
 		t.Fatal("deleted payload exist")
 	}
 }
+
+func TestLedger_PrivatePayloadEnvelope(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	key := util.RandomFixedBytes(64)
+	envelope := util.RandomFixedBytes(256)
+
+	if _, err := l.GetPrivatePayloadEnvelope(key); err != storage.KeyNotFound {
+		t.Fatal("envelope should not exist", err)
+	}
+	if err := l.AddPrivatePayloadEnvelope(key, envelope); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := l.GetPrivatePayloadEnvelope(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ret, envelope) {
+		t.Fatal("envelope not equal")
+	}
+
+	if err := l.AddPrivatePayloadEnvelope(util.RandomFixedBytes(32), envelope); err == nil {
+		t.Fatal("enclave key with hash size should be rejected")
+	}
+}
@@ -32,6 +32,9 @@ type Store interface {
 	PrivacyStore
 	VmlogsStore
 	VmStore
+	HistoryStore
+	FeeStore
+	CDRStore
 }
 
 type ContractStore interface {
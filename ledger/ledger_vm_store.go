@@ -128,6 +128,17 @@ func (l *Ledger) RemoveStorage(key []byte, val []byte, c storage.Cache) error {
 }
 
 func (l *Ledger) ListTokens() ([]*types.TokenInfo, error) {
+	infos, err := l.listTokens()
+	if err != nil {
+		return nil, err
+	}
+	for i, info := range infos {
+		infos[i] = l.withTokenSupply(info)
+	}
+	return infos, nil
+}
+
+func (l *Ledger) listTokens() ([]*types.TokenInfo, error) {
 	logger := log.NewLogger("ListTokens")
 	defer func() {
 		logger.Sync()
@@ -137,7 +148,8 @@ func (l *Ledger) ListTokens() ([]*types.TokenInfo, error) {
 
 	var infos []*types.TokenInfo
 	if err := iterator.Next(nil, func(key []byte, value []byte) error {
-		if len(value) > 0 {
+		// other data of mintage contract, such as issuer authority, is saved with a key prefix
+		if len(value) > 0 && len(key) == types.AddressSize+types.HashSize {
 			tokenId, _ := types.BytesToHash(key[types.AddressSize:])
 			if config.IsGenesisToken(tokenId) {
 				if info, err := mintage.ParseGenesisTokenInfo(value); err == nil {
@@ -170,7 +182,7 @@ func (l *Ledger) GetTokenById(tokenId types.Hash) (*types.TokenInfo, error) {
 		}
 	}
 	if ti, ok := l.tokenCache.Load(tokenId); ok {
-		return ti.(*types.TokenInfo), nil
+		return l.withTokenSupply(ti.(*types.TokenInfo)), nil
 	}
 
 	return nil, fmt.Errorf("can not find token %s", tokenId.String())
@@ -183,14 +195,37 @@ func (l *Ledger) GetTokenByName(tokenName string) (*types.TokenInfo, error) {
 		}
 	}
 	if ti, ok := l.tokenCache.Load(tokenName); ok {
-		return ti.(*types.TokenInfo), nil
+		return l.withTokenSupply(ti.(*types.TokenInfo)), nil
 	}
 
 	return nil, fmt.Errorf("can not find token %s", tokenName)
 }
 
+// withTokenSupply returns a copy of token info whose total supply includes the amount issued and burned
+// by the issuer, the cached token info keeps the supply of mintage
+func (l *Ledger) withTokenSupply(info *types.TokenInfo) *types.TokenInfo {
+	var key []byte
+	key = append(key, byte(storage.KeyPrefixVMStorage))
+	key = append(key, contractaddress.MintageAddress[:]...)
+	key = append(key, contractaddress.MintageAddress[:]...)
+	key = append(key, mintage.GetTokenAuthorityKey(info.TokenId)...)
+	val, err := l.Get(key)
+	if err != nil {
+		return info
+	}
+	authority, err := mintage.ParseTokenAuthority(val)
+	if err != nil {
+		return info
+	}
+
+	ti := *info
+	ti.TotalSupply = new(big.Int).Add(info.TotalSupply, authority.Issued)
+	ti.TotalSupply.Sub(ti.TotalSupply, authority.Burned)
+	return &ti
+}
+
 func (l *Ledger) saveCache() error {
-	if infos, err := l.ListTokens(); err == nil {
+	if infos, err := l.listTokens(); err == nil {
 		for _, v := range infos {
 			l.tokenCache.Store(v.TokenId, v)
 			l.tokenCache.Store(v.TokenName, v)
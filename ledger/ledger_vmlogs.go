@@ -1,8 +1,12 @@
 package ledger
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -15,13 +19,107 @@ type VmlogsStore interface {
 	HasVmLogs(key types.Hash, c ...storage.Cache) (bool, error)
 	SearchVmLogs(fn func(key types.Hash, value *types.VmLogs) error) error
 	CountVmLogs() (uint64, error)
+	AddBlockVmLogs(block *types.StateBlock, logs *types.VmLogs, c storage.Cache) error
+	GetBlockVmLogs(hash types.Hash, c ...storage.Cache) (*types.VmLogs, error)
+	DeleteBlockVmLogs(hash types.Hash, c storage.Cache) error
+	GetLogs(filter *VmLogFilter) ([]*VmLogEntry, error)
 }
 
 var (
 	ErrVmLogsExists   = errors.New("the VmLogs is empty")
 	ErrVmLogsNotFound = errors.New("VmLogs not found")
+	ErrVmLogsTooMany  = fmt.Errorf("query returned more than %d logs", MaxVmLogsResult)
 )
 
+// MaxVmLogsResult is the max count of logs returned by one query
+const MaxVmLogsResult = 10000
+
+const (
+	vmLogIndexAll byte = iota
+	vmLogIndexAddress
+	vmLogIndexAccount
+	vmLogIndexTopic
+)
+
+// VmLogFilter filters contract logs, Addresses matches the contract address, Accounts matches the account of the
+// block which emits the log, Topics[i] matches the topic at position i, an empty list matches any value.
+// BlockHash restricts logs to one block, otherwise FromBlock and ToBlock bound the timestamp of blocks.
+type VmLogFilter struct {
+	Addresses []types.Address `json:"addresses"`
+	Accounts  []types.Address `json:"accounts"`
+	Topics    [][]types.Hash  `json:"topics"`
+	BlockHash *types.Hash     `json:"blockHash,omitempty"`
+	FromBlock *types.Hash     `json:"fromBlock,omitempty"`
+	ToBlock   *types.Hash     `json:"toBlock,omitempty"`
+}
+
+// VmLogEntry is a contract log with the block context it is emitted in
+type VmLogEntry struct {
+	Address   types.Address `json:"address"`
+	Topics    []types.Hash  `json:"topics"`
+	Data      []byte        `json:"data"`
+	Account   types.Address `json:"account"`
+	BlockHash types.Hash    `json:"blockHash"`
+	Timestamp int64         `json:"timestamp"`
+	LogIndex  int           `json:"logIndex"`
+}
+
+// NewVmLogEntries returns the log entries of logs emitted by block
+func NewVmLogEntries(block *types.StateBlock, logs *types.VmLogs) []*VmLogEntry {
+	entries := make([]*VmLogEntry, 0, len(logs.Logs))
+	hash := block.GetHash()
+	for i, log := range logs.Logs {
+		entries = append(entries, &VmLogEntry{
+			Address:   log.Address,
+			Topics:    log.Topics,
+			Data:      log.Data,
+			Account:   block.GetAddress(),
+			BlockHash: hash,
+			Timestamp: block.GetTimestamp(),
+			LogIndex:  i,
+		})
+	}
+	return entries
+}
+
+// Match checks the entry against addresses, accounts and topics of the filter, block ranges are not checked
+func (f *VmLogFilter) Match(entry *VmLogEntry) bool {
+	if len(f.Addresses) > 0 && !containAddress(f.Addresses, entry.Address) {
+		return false
+	}
+	if len(f.Accounts) > 0 && !containAddress(f.Accounts, entry.Account) {
+		return false
+	}
+	for i, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(entry.Topics) {
+			return false
+		}
+		var found bool
+		for _, t := range topics {
+			if t == entry.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func containAddress(addresses []types.Address, address types.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
 func (l *Ledger) AddOrUpdateVmLogs(value *types.VmLogs, c storage.Cache) error {
 	key := value.Hash()
 	k, err := storage.GetKeyOfParts(storage.KeyPrefixVmLogs, key)
@@ -96,3 +194,250 @@ func (l *Ledger) SearchVmLogs(fn func(key types.Hash, value *types.VmLogs) error
 func (l *Ledger) CountVmLogs() (uint64, error) {
 	return l.store.Count([]byte{storage.KeyPrefixVmLogs})
 }
+
+// AddBlockVmLogs saves the logs emitted by block and the indexes of contract address, account and topics
+func (l *Ledger) AddBlockVmLogs(block *types.StateBlock, logs *types.VmLogs, c storage.Cache) error {
+	if logs == nil || len(logs.Logs) == 0 {
+		return nil
+	}
+	hash := block.GetHash()
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixBlockVmLogs, hash)
+	if err != nil {
+		return err
+	}
+	if err := c.Put(k, logs); err != nil {
+		return err
+	}
+	for _, key := range vmLogIndexKeys(block, logs) {
+		if err := c.Put(key, []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *Ledger) GetBlockVmLogs(hash types.Hash, c ...storage.Cache) (*types.VmLogs, error) {
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixBlockVmLogs, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	i, r, err := l.GetObject(k, c...)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, ErrVmLogsNotFound
+		}
+		return nil, err
+	}
+	if i != nil {
+		return i.(*types.VmLogs), nil
+	}
+	logs := new(types.VmLogs)
+	if err := logs.Deserialize(r); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// DeleteBlockVmLogs removes the logs emitted by the block and their indexes
+func (l *Ledger) DeleteBlockVmLogs(hash types.Hash, c storage.Cache) error {
+	logs, err := l.GetBlockVmLogs(hash, c)
+	if err != nil {
+		if err == ErrVmLogsNotFound {
+			return nil
+		}
+		return err
+	}
+	block, err := l.GetStateBlockConfirmed(hash, c)
+	if err != nil {
+		return fmt.Errorf("get block of vmlogs: %s", err)
+	}
+	for _, key := range vmLogIndexKeys(block, logs) {
+		if err := c.Delete(key); err != nil {
+			return err
+		}
+	}
+	k, err := storage.GetKeyOfParts(storage.KeyPrefixBlockVmLogs, hash)
+	if err != nil {
+		return err
+	}
+	return c.Delete(k)
+}
+
+// GetLogs returns the logs matching the filter, sorted by block timestamp
+func (l *Ledger) GetLogs(filter *VmLogFilter) ([]*VmLogEntry, error) {
+	if filter == nil {
+		filter = new(VmLogFilter)
+	}
+	if filter.BlockHash != nil {
+		return l.getBlockLogs(*filter.BlockHash, filter, make([]*VmLogEntry, 0))
+	}
+
+	from, to := int64(0), int64(math.MaxInt64)
+	if filter.FromBlock != nil {
+		blk, err := l.GetStateBlockConfirmed(*filter.FromBlock)
+		if err != nil {
+			return nil, fmt.Errorf("get from block: %s", err)
+		}
+		from = blk.GetTimestamp()
+	}
+	if filter.ToBlock != nil {
+		blk, err := l.GetStateBlockConfirmed(*filter.ToBlock)
+		if err != nil {
+			return nil, fmt.Errorf("get to block: %s", err)
+		}
+		to = blk.GetTimestamp()
+	}
+	if from > to {
+		return nil, errors.New("from block is later than to block")
+	}
+
+	// look up the most selective index, other conditions are checked on the logs
+	var prefixes [][]byte
+	switch {
+	case len(filter.Addresses) > 0:
+		for _, a := range filter.Addresses {
+			prefixes = append(prefixes, vmLogIndexPrefix(vmLogIndexAddress, a[:]))
+		}
+	case len(filter.Accounts) > 0:
+		for _, a := range filter.Accounts {
+			prefixes = append(prefixes, vmLogIndexPrefix(vmLogIndexAccount, a[:]))
+		}
+	default:
+		for _, topics := range filter.Topics {
+			if len(topics) > 0 {
+				for _, t := range topics {
+					prefixes = append(prefixes, vmLogIndexPrefix(vmLogIndexTopic, t[:]))
+				}
+				break
+			}
+		}
+		if len(prefixes) == 0 {
+			prefixes = append(prefixes, vmLogIndexPrefix(vmLogIndexAll, nil))
+		}
+	}
+
+	blocks := make(map[types.Hash]int64)
+	for _, prefix := range prefixes {
+		err := l.iterateVmLogIndex(prefix, from, to, func(hash types.Hash, ts int64) {
+			blocks[hash] = ts
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	hashes := make([]types.Hash, 0, len(blocks))
+	for h := range blocks {
+		hashes = append(hashes, h)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		if blocks[hashes[i]] == blocks[hashes[j]] {
+			return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+		}
+		return blocks[hashes[i]] < blocks[hashes[j]]
+	})
+
+	entries := make([]*VmLogEntry, 0)
+	for _, h := range hashes {
+		var err error
+		if entries, err = l.getBlockLogs(h, filter, entries); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// iterateVmLogIndex iterates the index keys of prefix whose block timestamp is between from and to,
+// from is put into the start key and to into the end key so keys out of range are not read from the store
+func (l *Ledger) iterateVmLogIndex(prefix []byte, from, to int64, fn func(hash types.Hash, ts int64)) error {
+	start := append(append([]byte{}, prefix...), vmLogTimeKey(from)...)
+	end := upperBoundOfPrefix(append(append([]byte{}, prefix...), vmLogTimeKey(to)...))
+	inRange := func(k []byte) bool {
+		return len(k) == len(prefix)+8+types.HashSize && bytes.Compare(k, start) >= 0 && bytes.Compare(k, end) < 0
+	}
+	visit := func(k []byte) error {
+		if !inRange(k) {
+			return nil
+		}
+		hash, err := types.BytesToHash(k[len(prefix)+8:])
+		if err != nil {
+			return err
+		}
+		fn(hash, int64(binary.BigEndian.Uint64(k[len(prefix):])))
+		return nil
+	}
+
+	// keys not flushed yet are in the cache, they are merged with the keys of the store
+	cached, err := l.cache.prefixIterator(prefix, func(k []byte, v []byte) error {
+		return visit(k)
+	})
+	if err != nil {
+		return err
+	}
+	return l.DBStore().Iterator(start, end, func(k []byte, v []byte) error {
+		if contain(cached, k) {
+			return nil
+		}
+		return visit(k)
+	})
+}
+
+func (l *Ledger) getBlockLogs(hash types.Hash, filter *VmLogFilter, entries []*VmLogEntry) ([]*VmLogEntry, error) {
+	logs, err := l.GetBlockVmLogs(hash)
+	if err != nil {
+		if err == ErrVmLogsNotFound {
+			return entries, nil
+		}
+		return nil, err
+	}
+	block, err := l.GetStateBlockConfirmed(hash)
+	if err != nil {
+		return nil, fmt.Errorf("get block of vmlogs: %s", err)
+	}
+	for _, entry := range NewVmLogEntries(block, logs) {
+		if filter.Match(entry) {
+			if len(entries) >= MaxVmLogsResult {
+				return nil, ErrVmLogsTooMany
+			}
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func vmLogIndexPrefix(kind byte, key []byte) []byte {
+	prefix := []byte{byte(storage.KeyPrefixVmLogIndex), kind}
+	return append(prefix, key...)
+}
+
+func vmLogTimeKey(ts int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(ts))
+	return key
+}
+
+// vmLogIndexKeys returns all index keys of the logs, which are suffixed with block timestamp and hash
+func vmLogIndexKeys(block *types.StateBlock, logs *types.VmLogs) [][]byte {
+	hash := block.GetHash()
+	suffix := append(vmLogTimeKey(block.GetTimestamp()), hash[:]...)
+
+	seen := make(map[string]bool)
+	keys := make([][]byte, 0)
+	add := func(kind byte, key []byte) {
+		k := append(vmLogIndexPrefix(kind, key), suffix...)
+		if !seen[string(k)] {
+			seen[string(k)] = true
+			keys = append(keys, k)
+		}
+	}
+	add(vmLogIndexAll, nil)
+	account := block.GetAddress()
+	add(vmLogIndexAccount, account[:])
+	for _, log := range logs.Logs {
+		add(vmLogIndexAddress, log.Address[:])
+		for _, t := range log.Topics {
+			add(vmLogIndexTopic, t[:])
+		}
+	}
+	return keys
+}
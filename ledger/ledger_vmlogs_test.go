@@ -122,3 +122,110 @@ func TestLedger_HasVmLogs(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestLedger_GetLogs(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	contract := mock.Address()
+	topic := mock.Hash()
+	blocks := make([]*types.StateBlock, 0)
+	for i := 0; i < 3; i++ {
+		blk := mock.StateBlockWithoutWork()
+		blk.Timestamp = int64(1000 + i)
+		if err := l.AddStateBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		vmLog := generateVmlog()
+		vmLog.Address = contract
+		if i != 1 {
+			vmLog.Topics[0] = topic
+		}
+		logs := &types.VmLogs{Logs: []*types.VmLog{vmLog, generateVmlog()}}
+		if err := l.AddBlockVmLogs(blk, logs, l.cache.GetCache()); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, blk)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if logs, err := l.GetBlockVmLogs(blocks[0].GetHash()); err != nil || len(logs.Logs) != 2 {
+		t.Fatal(logs, err)
+	}
+	if entries, err := l.GetLogs(nil); err != nil || len(entries) != 6 || entries[0].BlockHash != blocks[0].GetHash() {
+		t.Fatal(entries, err)
+	}
+
+	entries, err := l.GetLogs(&VmLogFilter{Addresses: []types.Address{contract}})
+	if err != nil || len(entries) != 3 {
+		t.Fatal(entries, err)
+	}
+	for i, entry := range entries {
+		if entry.BlockHash != blocks[i].GetHash() || entry.Account != blocks[i].Address || entry.LogIndex != 0 {
+			t.Fatal(entry)
+		}
+	}
+
+	entries, err = l.GetLogs(&VmLogFilter{Topics: [][]types.Hash{{topic}}})
+	if err != nil || len(entries) != 2 {
+		t.Fatal(entries, err)
+	}
+	entries, err = l.GetLogs(&VmLogFilter{Topics: [][]types.Hash{{}, {topic}}})
+	if err != nil || len(entries) != 0 {
+		t.Fatal(entries, err)
+	}
+
+	b1, b2 := blocks[1].GetHash(), blocks[2].GetHash()
+	entries, err = l.GetLogs(&VmLogFilter{Addresses: []types.Address{contract}, FromBlock: &b1, ToBlock: &b2})
+	if err != nil || len(entries) != 2 {
+		t.Fatal(entries, err)
+	}
+	entries, err = l.GetLogs(&VmLogFilter{Accounts: []types.Address{blocks[1].Address}, BlockHash: &b1})
+	if err != nil || len(entries) != 2 {
+		t.Fatal(entries, err)
+	}
+	if _, err := l.GetLogs(&VmLogFilter{FromBlock: &b2, ToBlock: &b1}); err == nil {
+		t.Fatal("invalid block range should fail")
+	}
+
+	if err := l.DeleteBlockVmLogs(b1, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.GetBlockVmLogs(b1); err != ErrVmLogsNotFound {
+		t.Fatal(err)
+	}
+	entries, err = l.GetLogs(&VmLogFilter{Accounts: []types.Address{blocks[1].Address}})
+	if err != nil || len(entries) != 0 {
+		t.Fatal(entries, err)
+	}
+
+	// logs not flushed are merged with the logs in store within the range
+	blk := mock.StateBlockWithoutWork()
+	blk.Timestamp = 1003
+	if err := l.AddStateBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	vmLog := generateVmlog()
+	vmLog.Address = contract
+	if err := l.AddBlockVmLogs(blk, &types.VmLogs{Logs: []*types.VmLog{vmLog}}, l.cache.GetCache()); err != nil {
+		t.Fatal(err)
+	}
+	b3 := blk.GetHash()
+	entries, err = l.GetLogs(&VmLogFilter{Addresses: []types.Address{contract}, FromBlock: &b2, ToBlock: &b3})
+	if err != nil || len(entries) != 2 || entries[0].BlockHash != b2 || entries[1].BlockHash != b3 {
+		t.Fatal(entries, err)
+	}
+	entries, err = l.GetLogs(&VmLogFilter{Addresses: []types.Address{contract}, ToBlock: &b2})
+	if err != nil || len(entries) != 2 || entries[1].BlockHash != b2 {
+		t.Fatal(entries, err)
+	}
+	entries, err = l.GetLogs(&VmLogFilter{FromBlock: &b3})
+	if err != nil || len(entries) != 1 || entries[0].BlockHash != b3 {
+		t.Fatal(entries, err)
+	}
+}
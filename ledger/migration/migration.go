@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/tinylib/msgp/msgp"
+
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
@@ -365,6 +367,163 @@ func (m MigrationV15ToV16) EndVersion() int {
 	return 16
 }
 
+// MigrationV16ToV18 rewrites prices of settlement contracts and DoD orders from float to decimal money,
+// ledger v17 only rebuilds the history index, so both versions are migrated here
+type MigrationV16ToV18 struct {
+}
+
+// money fields of contract storage, tables are DoDSettleDBTableOrder, DoDSettleDBTableProduct and DoDSettleDBTableConnRawParam
+var moneyStorages = []struct {
+	prefix      []byte
+	moneyKeys   map[string]bool
+	currencyKey string
+}{
+	{
+		prefix:      append(append([]byte{}, contractaddress.SettlementAddress[:]...), contractaddress.SettlementAddress[:]...),
+		moneyKeys:   map[string]bool{"u": true},
+		currencyKey: "c",
+	},
+	{
+		prefix:      append(append([]byte{}, contractaddress.DoDSettlementAddress[:]...), 0),
+		moneyKeys:   map[string]bool{"p": true, "ad": true},
+		currencyKey: "cr",
+	},
+	{
+		prefix:      append(append([]byte{}, contractaddress.DoDSettlementAddress[:]...), 1),
+		moneyKeys:   map[string]bool{"p": true, "ad": true},
+		currencyKey: "cr",
+	},
+	{
+		prefix:      append(append([]byte{}, contractaddress.DoDSettlementAddress[:]...), 6),
+		moneyKeys:   map[string]bool{"p": true, "ad": true},
+		currencyKey: "cr",
+	},
+}
+
+func (m MigrationV16ToV18) Migrate(store storage.Store) error {
+	return store.BatchWrite(false, func(batch storage.Batch) error {
+		b, err := checkVersion(m, store)
+		if err != nil {
+			return err
+		}
+		if b {
+			fmt.Println("migrate ledger v16 to v18 ")
+			for _, ms := range moneyStorages {
+				cs := make([]bytesKV, 0)
+				prefix := append([]byte{byte(storage.KeyPrefixVMStorage)}, ms.prefix...)
+				if err := store.Iterator(prefix, nil, func(k, v []byte) error {
+					key := make([]byte, len(k))
+					copy(key, k)
+					value := make([]byte, len(v))
+					copy(value, v)
+					cs = append(cs, bytesKV{
+						key:   key,
+						value: value,
+					})
+					return nil
+				}); err != nil {
+					return err
+				}
+
+				for _, c := range cs {
+					value, left, err := rewriteMoney(nil, c.value, ms.moneyKeys, ms.currencyKey)
+					if err != nil || len(left) != 0 {
+						// not a msgp map, leave it as it is
+						continue
+					}
+					if err := batch.Put(c.key, value); err != nil {
+						return err
+					}
+				}
+			}
+			return updateVersion(m, batch)
+		}
+		return nil
+	})
+}
+
+// StartVersion is 16, checkVersion migrates any version in [StartVersion, EndVersion), so ledgers of v17 are migrated too
+func (m MigrationV16ToV18) StartVersion() int {
+	return 16
+}
+
+func (m MigrationV16ToV18) EndVersion() int {
+	return 18
+}
+
+// rewriteMoney copies one msgp value to o, float values of money keys in maps are replaced by
+// money encoding with the currency of the same map
+func rewriteMoney(o, b []byte, moneyKeys map[string]bool, currencyKey string) ([]byte, []byte, error) {
+	switch msgp.NextType(b) {
+	case msgp.MapType:
+		sz, left, err := msgp.ReadMapHeaderBytes(b)
+		if err != nil {
+			return o, b, err
+		}
+		currency := ""
+		next := left
+		for i := uint32(0); i < sz; i++ {
+			var key string
+			if key, next, err = msgp.ReadStringBytes(next); err != nil {
+				return o, b, err
+			}
+			if key == currencyKey && msgp.NextType(next) == msgp.StrType {
+				if currency, _, err = msgp.ReadStringBytes(next); err != nil {
+					return o, b, err
+				}
+			}
+			if next, err = msgp.Skip(next); err != nil {
+				return o, b, err
+			}
+		}
+
+		o = msgp.AppendMapHeader(o, sz)
+		for i := uint32(0); i < sz; i++ {
+			var key string
+			if key, left, err = msgp.ReadStringBytes(left); err != nil {
+				return o, b, err
+			}
+			o = msgp.AppendString(o, key)
+			if moneyKeys[key] && msgp.NextType(left) == msgp.Float64Type {
+				var f float64
+				if f, left, err = msgp.ReadFloat64Bytes(left); err != nil {
+					return o, b, err
+				}
+				money, err := types.MoneyFromFloat(f)
+				if err != nil {
+					return o, b, err
+				}
+				if o, err = money.In(currency).MarshalMsg(o); err != nil {
+					return o, b, err
+				}
+				continue
+			}
+			if o, left, err = rewriteMoney(o, left, moneyKeys, currencyKey); err != nil {
+				return o, b, err
+			}
+		}
+		return o, left, nil
+	case msgp.ArrayType:
+		sz, left, err := msgp.ReadArrayHeaderBytes(b)
+		if err != nil {
+			return o, b, err
+		}
+		o = msgp.AppendArrayHeader(o, sz)
+		for i := uint32(0); i < sz; i++ {
+			if o, left, err = rewriteMoney(o, left, moneyKeys, currencyKey); err != nil {
+				return o, b, err
+			}
+		}
+		return o, left, nil
+	default:
+		left, err := msgp.Skip(b)
+		if err != nil {
+			return o, b, err
+		}
+		return append(o, b[:len(b)-len(left)]...), left, nil
+	}
+}
+
 func checkVersion(m Migration, s storage.Store) (bool, error) {
 	v, err := getVersion(s)
 	if err != nil {
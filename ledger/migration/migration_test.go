@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"bytes"
 	"encoding/binary"
 	"math/big"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/tinylib/msgp/msgp"
 
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/storage/db"
@@ -168,3 +170,187 @@ func TestMigration_MigrateV15ToV16(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMigration_MigrateV16ToV18(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "store", uuid.New().String())
+	store, err := db.NewBadgerStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+
+	key := []byte{byte(storage.KeyPrefixVersion)}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, 16)
+	if err := store.Put(key, buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+
+	// contract param with a service priced by float
+	var service []byte
+	service = msgp.AppendMapHeader(service, 3)
+	service = msgp.AppendString(service, "id")
+	service = msgp.AppendString(service, "service1")
+	service = msgp.AppendString(service, "u")
+	service = msgp.AppendFloat64(service, 0.045)
+	service = msgp.AppendString(service, "c")
+	service = msgp.AppendString(service, "USD")
+	var param []byte
+	param = msgp.AppendMapHeader(param, 2)
+	param = msgp.AppendString(param, "s")
+	param = msgp.AppendArrayHeader(param, 1)
+	param = append(param, service...)
+	param = msgp.AppendString(param, "t")
+	param = msgp.AppendInt64(param, 100)
+
+	k1 := []byte{byte(storage.KeyPrefixVMStorage)}
+	k1 = append(k1, contractaddress.SettlementAddress[:]...)
+	k1 = append(k1, contractaddress.SettlementAddress[:]...)
+	k1 = append(k1, 0)
+	k1 = append(k1, mock.Address().Bytes()...)
+	if err := store.Put(k1, param); err != nil {
+		t.Fatal(err)
+	}
+
+	// DoD connection with embedded dynamic param
+	var dynamic []byte
+	dynamic = msgp.AppendMapHeader(dynamic, 3)
+	dynamic = msgp.AppendString(dynamic, "cr")
+	dynamic = msgp.AppendString(dynamic, "JPY")
+	dynamic = msgp.AppendString(dynamic, "p")
+	dynamic = msgp.AppendFloat64(dynamic, 12.5)
+	dynamic = msgp.AppendString(dynamic, "ad")
+	dynamic = msgp.AppendFloat64(dynamic, 3)
+	var conn []byte
+	conn = msgp.AppendMapHeader(conn, 1)
+	conn = msgp.AppendString(conn, "DoDSettleConnectionDynamicParam")
+	conn = append(conn, dynamic...)
+
+	k2 := []byte{byte(storage.KeyPrefixVMStorage)}
+	k2 = append(k2, contractaddress.DoDSettlementAddress[:]...)
+	k2 = append(k2, 1)
+	k2 = append(k2, mock.Hash().Bytes()...)
+	if err := store.Put(k2, conn); err != nil {
+		t.Fatal(err)
+	}
+
+	// other tables are not changed
+	k3 := []byte{byte(storage.KeyPrefixVMStorage)}
+	k3 = append(k3, contractaddress.DoDSettlementAddress[:]...)
+	k3 = append(k3, 3)
+	k3 = append(k3, mock.Hash().Bytes()...)
+	if err := store.Put(k3, dynamic); err != nil {
+		t.Fatal(err)
+	}
+
+	migrations := []Migration{MigrationV15ToV16{}, MigrationV16ToV18{}}
+	if err := Upgrade(migrations, store); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := getVersion(store); err != nil || v != 18 {
+		t.Fatal(v, err)
+	}
+
+	money := func(data []byte, path ...string) string {
+		for _, p := range path {
+			if data = msgp.Locate(p, data); data == nil {
+				t.Fatal("field not found", p)
+			}
+		}
+		var m types.Money
+		if _, err := m.UnmarshalMsg(data); err != nil {
+			t.Fatal(err)
+		}
+		return m.String()
+	}
+
+	v1, err := store.Get(k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := msgp.Locate("s", v1)
+	if _, s, err = msgp.ReadArrayHeaderBytes(s); err != nil {
+		t.Fatal(err)
+	}
+	if m := money(s, "u"); m != "0.045 USD" {
+		t.Fatal(m)
+	}
+	if msgp.NextType(msgp.Locate("t", v1)) != msgp.IntType {
+		t.Fatal("other fields should be kept")
+	}
+
+	v2, err := store.Get(k2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := money(v2, "DoDSettleConnectionDynamicParam", "p"); m != "12.5 JPY" {
+		t.Fatal(m)
+	}
+	if m := money(v2, "DoDSettleConnectionDynamicParam", "ad"); m != "3 JPY" {
+		t.Fatal(m)
+	}
+
+	v3, err := store.Get(k3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(v3, dynamic) {
+		t.Fatal("other tables should not be migrated")
+	}
+}
+
+func TestMigration_MigrateV17ToV18(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "store", uuid.New().String())
+	store, err := db.NewBadgerStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(dir)
+	}()
+
+	// v17 ledger has history indexes but prices are still float
+	key := []byte{byte(storage.KeyPrefixVersion)}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, 17)
+	if err := store.Put(key, buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+
+	var disconnect []byte
+	disconnect = msgp.AppendMapHeader(disconnect, 2)
+	disconnect = msgp.AppendString(disconnect, "p")
+	disconnect = msgp.AppendFloat64(disconnect, 0.1)
+	disconnect = msgp.AppendString(disconnect, "cr")
+	disconnect = msgp.AppendString(disconnect, "USD")
+
+	k := []byte{byte(storage.KeyPrefixVMStorage)}
+	k = append(k, contractaddress.DoDSettlementAddress[:]...)
+	k = append(k, 6)
+	k = append(k, mock.Hash().Bytes()...)
+	if err := store.Put(k, disconnect); err != nil {
+		t.Fatal(err)
+	}
+
+	migrations := []Migration{MigrationV15ToV16{}, MigrationV16ToV18{}}
+	if err := Upgrade(migrations, store); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := getVersion(store); err != nil || v != 18 {
+		t.Fatal(v, err)
+	}
+
+	v, err := store.Get(k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m types.Money
+	if _, err := m.UnmarshalMsg(msgp.Locate("p", v)); err != nil {
+		t.Fatal(err)
+	}
+	if m.String() != "0.1 USD" {
+		t.Fatal(m)
+	}
+}
@@ -43,6 +43,8 @@ type cacheSendBlockCheck struct {
 	cacheBlockBaseInfoCheck
 	cacheBlockForkCheck
 	cacheBlockBalanceCheck
+	cacheBlockTokenCheck
+	cacheBlockFeeCheck
 }
 
 func (c *cacheSendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -52,14 +54,22 @@ func (c *cacheSendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock)
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.balance(lv, block)
+	if r, err := c.balance(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type cacheContractSendBlockCheck struct {
 	cacheBlockBaseInfoCheck
 	cacheBlockForkCheck
 	cacheBlockBalanceCheck
+	cacheBlockTokenCheck
 	cacheBlockContractCheck
+	cacheBlockFeeCheck
 }
 
 func (c *cacheContractSendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -76,7 +86,13 @@ func (c *cacheContractSendBlockCheck) Check(lv *LedgerVerifier, block *types.Sta
 	if r, err := c.balance(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.contract(lv, block)
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.contract(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type cacheReceiveBlockCheck struct {
@@ -84,6 +100,8 @@ type cacheReceiveBlockCheck struct {
 	cacheBlockForkCheck
 	cacheBlockSourceCheck
 	cacheBlockPendingCheck
+	cacheBlockTokenCheck
+	cacheBlockFeeCheck
 }
 
 func (c *cacheReceiveBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -96,7 +114,13 @@ func (c *cacheReceiveBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlo
 	if r, err := c.source(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.pending(lv, block)
+	if r, err := c.pending(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type cacheContractReceiveBlockCheck struct {
@@ -104,7 +128,9 @@ type cacheContractReceiveBlockCheck struct {
 	cacheBlockForkCheck
 	cacheBlockPendingCheck
 	cacheBlockSourceCheck
+	cacheBlockTokenCheck
 	cacheBlockContractCheck
+	cacheBlockFeeCheck
 }
 
 func (c *cacheContractReceiveBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -124,7 +150,13 @@ func (c *cacheContractReceiveBlockCheck) Check(lv *LedgerVerifier, block *types.
 	if r, err := c.pending(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.contract(lv, block)
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.contract(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type cacheOpenBlockCheck struct {
@@ -132,6 +164,8 @@ type cacheOpenBlockCheck struct {
 	cacheBlockForkCheck
 	cacheBlockSourceCheck
 	cacheBlockPendingCheck
+	cacheBlockTokenCheck
+	cacheBlockFeeCheck
 }
 
 func (c *cacheOpenBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -149,13 +183,20 @@ func (c *cacheOpenBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock)
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.pending(lv, block)
+	if r, err := c.pending(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type cacheChangeBlockCheck struct {
 	cacheBlockBaseInfoCheck
 	cacheBlockForkCheck
 	cacheBlockBalanceCheck
+	cacheBlockFeeCheck
 }
 
 func (c *cacheChangeBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -173,7 +214,10 @@ func (c *cacheChangeBlockCheck) Check(lv *LedgerVerifier, block *types.StateBloc
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.balance(lv, block)
+	if r, err := c.balance(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 func (lv *LedgerVerifier) BlockCacheProcess(block *types.StateBlock) error {
@@ -9,8 +9,10 @@ import (
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/vm/contract"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
 )
 
@@ -24,7 +26,6 @@ type blockBaseInfoCheck struct {
 
 func (blockBaseInfoCheck) baseInfo(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
 	hash := block.GetHash()
-	address := block.GetAddress()
 	checkWork := true
 	checkSign := true
 
@@ -72,9 +73,8 @@ func (blockBaseInfoCheck) baseInfo(lv *LedgerVerifier, block *types.StateBlock)
 	}
 
 	if checkSign {
-		signature := block.GetSignature()
-		if !address.Verify(hash[:], signature[:]) {
-			return errorP(block, BadSignature, nil)
+		if err := checkSignature(lv, block); err != nil {
+			return errorP(block, BadSignature, err)
 		}
 	}
 
@@ -86,7 +86,6 @@ type cacheBlockBaseInfoCheck struct {
 
 func (cacheBlockBaseInfoCheck) baseInfo(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
 	hash := block.GetHash()
-	address := block.GetAddress()
 	checkWork := true
 	checkSign := true
 
@@ -142,13 +141,36 @@ func (cacheBlockBaseInfoCheck) baseInfo(lv *LedgerVerifier, block *types.StateBl
 	}
 
 	if checkSign {
+		if err := checkSignature(lv, block); err != nil {
+			return errorP(block, BadSignature, err)
+		}
+	}
+
+	return Progress, nil
+}
+
+// checkSignature verifies the block is signed by its address, or by enough keys of the registered multisig account
+func checkSignature(lv *LedgerVerifier, block *types.StateBlock) error {
+	hash := block.GetHash()
+	address := block.GetAddress()
+	if !block.IsMultiSig() {
 		signature := block.GetSignature()
 		if !address.Verify(hash[:], signature[:]) {
-			return errorP(block, BadSignature, nil)
+			return errors.New("invalid signature")
 		}
+		return nil
 	}
 
-	return Progress, nil
+	items, err := block.GetMultiSig()
+	if err != nil {
+		return err
+	}
+	ctx := vmstore.NewVMContext(lv.l, &contractaddress.MultiSigAddress)
+	info, err := cabi.GetMultiSigInfo(ctx, address)
+	if err != nil {
+		return fmt.Errorf("%s is not a multisig account", address)
+	}
+	return info.VerifySignatures(hash, items)
 }
 
 func checkReceiveBlockRepeat(lv *LedgerVerifier, block *types.StateBlock) ProcessResult {
@@ -799,6 +821,130 @@ func checkContractSendBlock(lv *LedgerVerifier, block *types.StateBlock) (Proces
 	}
 }
 
+// check token transfer restrictions of issuer authority
+type tokenCheck interface {
+	token(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error)
+}
+
+type blockTokenCheck struct {
+}
+
+func (blockTokenCheck) token(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
+	return checkTokenAuthority(lv, block, func(hash types.Hash) (*types.StateBlock, error) {
+		return lv.l.GetStateBlockConfirmed(hash)
+	})
+}
+
+type cacheBlockTokenCheck struct {
+}
+
+func (cacheBlockTokenCheck) token(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
+	return checkTokenAuthority(lv, block, func(hash types.Hash) (*types.StateBlock, error) {
+		return lv.l.GetStateBlock(hash)
+	})
+}
+
+func checkTokenAuthority(lv *LedgerVerifier, block *types.StateBlock, getBlock func(hash types.Hash) (*types.StateBlock, error)) (ProcessResult, error) {
+	token := block.GetToken()
+	if token == config.ChainToken() || token == config.GasToken() {
+		return Progress, nil
+	}
+
+	ctx := vmstore.NewVMContext(lv.l, &contractaddress.MintageAddress)
+	switch block.GetType() {
+	case types.Send, types.ContractSend:
+		if err := contract.CheckTokenSend(ctx, token, block.GetAddress(), types.Address(block.GetLink())); err != nil {
+			return errorP(block, Other, err)
+		}
+	case types.Receive, types.Open:
+		if err := contract.CheckTokenReceive(ctx, token, block.GetAddress()); err != nil {
+			return errorP(block, Other, err)
+		}
+		// token sent by contract must be received by contract reward, so the authority data is updated
+		if _, err := contract.GetTokenAuthority(ctx, token); err == nil {
+			if input, err := getBlock(block.GetLink()); err == nil && input.GetType() == types.ContractSend {
+				return errorP(block, Other, errors.New("contract send can only be received by contract reward"))
+			}
+		}
+	case types.ContractReward:
+		// token paid out by contract, e.g. time lock release, reaches its final recipient here
+		if err := contract.CheckTokenReceive(ctx, token, block.GetAddress()); err != nil {
+			return errorP(block, Other, err)
+		}
+	}
+	return Progress, nil
+}
+
+// check QGAS fee of block
+type feeCheck interface {
+	fee(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error)
+}
+
+type blockFeeCheck struct {
+}
+
+// fee of confirmed blocks is paid by the confirmed fee credit
+func (blockFeeCheck) fee(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
+	if r, err := checkMinFee(block); r != Progress || err != nil {
+		return r, err
+	}
+	if block.HasFee() {
+		credit, err := lv.l.GetFeeCredit(block.GetAddress())
+		if err != nil {
+			return errorP(block, Other, err)
+		}
+		return checkFeeCredit(block, credit)
+	}
+	return Progress, nil
+}
+
+type cacheBlockFeeCheck struct {
+}
+
+// fee of unconfirmed blocks is reserved from the fee credit, so the credit can not be spent twice by blocks
+// which are not confirmed yet
+func (cacheBlockFeeCheck) fee(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
+	if r, err := checkMinFee(block); r != Progress || err != nil {
+		return r, err
+	}
+	if block.HasFee() {
+		credit, err := lv.l.GetFeeCredit(block.GetAddress())
+		if err != nil {
+			return errorP(block, Other, err)
+		}
+		reserved, err := lv.l.GetReservedFee(block.GetAddress())
+		if err != nil {
+			return errorP(block, Other, err)
+		}
+		if credit.Compare(reserved) == types.BalanceCompSmaller {
+			return errorP(block, InsufficientFee, fmt.Errorf("fee %s, fee credit %s, reserved %s", block.GetFee(), credit, reserved))
+		}
+		return checkFeeCredit(block, credit.Sub(reserved))
+	}
+	return Progress, nil
+}
+
+// checkMinFee checks the fee of send and change blocks is not less than the min fee of genesis.
+// It is the only fee enforced, the price by PoV tx pool is advisory since the pool is not same on all nodes.
+// Deposit to the fee address is free, otherwise no credit could be got
+func checkMinFee(block *types.StateBlock) (ProcessResult, error) {
+	cfg := config.GenesisFee()
+	typ := block.GetType()
+	if cfg.Enable && (typ == types.Send || typ == types.Change) && !ledger.IsFeeDeposit(block) {
+		if cfg.MinFee.Int != nil && block.GetFee().Compare(cfg.MinFee) == types.BalanceCompSmaller {
+			return errorP(block, InsufficientFee, fmt.Errorf("fee %s, min fee %s", block.GetFee(), cfg.MinFee))
+		}
+	}
+	return Progress, nil
+}
+
+func checkFeeCredit(block *types.StateBlock, credit types.Balance) (ProcessResult, error) {
+	if credit.Compare(block.GetFee()) == types.BalanceCompSmaller {
+		return errorP(block, InsufficientFee, fmt.Errorf("fee %s, fee credit %s", block.GetFee(), credit))
+	}
+	return Progress, nil
+}
+
 func invalidBlockType(typ string) error {
 	return fmt.Errorf("invalid process block type, %s", typ)
 }
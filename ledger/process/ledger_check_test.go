@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package process
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+	cabi "github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestCheckSignature(t *testing.T) {
+	teardownTestCase, l, lv := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ac := mock.Account()
+	blk := mock.StateBlockWithoutWork()
+	blk.Address = ac.Address()
+	blk.Signature = ac.Sign(blk.GetHash())
+	if err := checkSignature(lv, blk); err != nil {
+		t.Fatal(err)
+	}
+	blk.Signature = mock.Account().Sign(blk.GetHash())
+	if err := checkSignature(lv, blk); err == nil {
+		t.Fatal("invalid signature should be rejected")
+	}
+
+	k1 := mock.Account()
+	k2 := mock.Account()
+	param := &cabi.MultiSigParam{Keys: []types.Address{k1.Address(), k2.Address()}, Threshold: 2}
+	account, _ := param.Account()
+	blk.Address = account
+	blk.Signature = types.ZeroSignature
+	hash := blk.GetHash()
+	blk.SetMultiSig([]*types.MultiSigItem{
+		{Signer: k1.Address(), Signature: k1.Sign(hash)},
+		{Signer: k2.Address(), Signature: k2.Sign(hash)},
+	})
+	if err := checkSignature(lv, blk); err == nil {
+		t.Fatal("unregistered multisig account should be rejected")
+	}
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.MultiSigAddress)
+	data, _ := cabi.MultiSigABI.PackVariable(cabi.VariableNameMultiSigInfo, param.SortedKeys(), param.Threshold, mock.Address())
+	_ = ctx.SetStorage(contractaddress.MultiSigAddress[:], account[:], data)
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkSignature(lv, blk); err != nil {
+		t.Fatal(err)
+	}
+
+	blk.SetMultiSig([]*types.MultiSigItem{{Signer: k1.Address(), Signature: k1.Sign(hash)}})
+	if err := checkSignature(lv, blk); err == nil {
+		t.Fatal("signatures less than threshold should be rejected")
+	}
+}
+
+// loadGenesisFee reloads genesis with the fee config, and returns a function to disable the fee
+func loadGenesisFee(t *testing.T, fee *config.FeeConfig) func() {
+	dir := filepath.Join(config.QlcTestDataDir(), "fee", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	cfg, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	load := func(fee *config.FeeConfig) {
+		cfg.Genesis.Fee = fee
+		if err := cm.Save(cfg); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cm.Load(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	load(fee)
+	return func() {
+		load(nil)
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestCheckFee(t *testing.T) {
+	teardownTestCase, l, lv := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	reset := loadGenesisFee(t, &config.FeeConfig{Enable: true, MinFee: types.NewBalance(100), MaxFee: types.NewBalance(1000), Mode: config.FeeModeBurn})
+	defer reset()
+
+	fc, cfc := blockFeeCheck{}, cacheBlockFeeCheck{}
+	ac := mock.Account()
+	open := mock.StateBlockWithoutWork()
+	open.Type = types.Open
+	open.Token = config.GasToken()
+	open.Address = ac.Address()
+	open.Previous = types.ZeroHash
+	open.Balance = types.NewBalance(1000)
+
+	deposit := mock.StateBlockWithoutWork()
+	deposit.Type = types.Send
+	deposit.Token = config.GasToken()
+	deposit.Address = ac.Address()
+	deposit.Previous = open.GetHash()
+	deposit.Link = types.Hash(contractaddress.FeeAddress)
+	deposit.Balance = types.NewBalance(500)
+	if r, err := fc.fee(lv, deposit); r != Progress {
+		t.Fatal("deposit should be free", r, err)
+	}
+
+	send := mock.StateBlockWithoutWork()
+	send.Type = types.Send
+	send.Address = ac.Address()
+	if r, _ := fc.fee(lv, send); r != InsufficientFee {
+		t.Fatal("send without fee should be rejected", r)
+	}
+	fee := types.NewBalance(100)
+	send.Fee = &fee
+	if r, _ := fc.fee(lv, send); r != InsufficientFee {
+		t.Fatal("fee without credit should be rejected", r)
+	}
+
+	for _, b := range []*types.StateBlock{open, deposit} {
+		if err := l.AddStateBlock(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if r, err := fc.fee(lv, send); r != Progress {
+		t.Fatal(r, err)
+	}
+	fee = types.NewBalance(800)
+	if r, _ := fc.fee(lv, send); r != InsufficientFee {
+		t.Fatal("fee larger than credit should be rejected", r)
+	}
+
+	receive := mock.StateBlockWithoutWork()
+	receive.Type = types.Receive
+	if r, err := fc.fee(lv, receive); r != Progress {
+		t.Fatal("receive should be free", r, err)
+	}
+
+	// the credit of 500 can not be spent twice by unconfirmed blocks
+	fee = types.NewBalance(300)
+	if r, err := cfc.fee(lv, send); r != Progress {
+		t.Fatal(r, err)
+	}
+	if err := l.AddBlockCache(send); err != nil {
+		t.Fatal(err)
+	}
+	if reserved, err := l.GetReservedFee(ac.Address()); err != nil || !reserved.Equal(fee) {
+		t.Fatal("invalid reserved fee", reserved, err)
+	}
+	send2 := mock.StateBlockWithoutWork()
+	send2.Type = types.Send
+	send2.Address = ac.Address()
+	fee2 := types.NewBalance(300)
+	send2.Fee = &fee2
+	if r, _ := cfc.fee(lv, send2); r != InsufficientFee {
+		t.Fatal("reserved fee should not be spent", r)
+	}
+	if r, err := fc.fee(lv, send2); r != Progress {
+		t.Fatal(r, err)
+	}
+	if err := l.DeleteBlockCache(send.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if r, err := cfc.fee(lv, send2); r != Progress {
+		t.Fatal("fee should be released", r, err)
+	}
+}
+
+func TestCheckTokenAuthority_ContractReward(t *testing.T) {
+	teardownTestCase, l, lv := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	issuer := mock.Address()
+	frozen := mock.Address()
+	tokenId := mock.Hash()
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	data, err := mintage.MintageABI.PackVariable(mintage.VariableNameTokenAuthority, tokenId, issuer,
+		mintage.AuthorityFreeze, big.NewInt(0), big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetStorage(contractaddress.MintageAddress[:], mintage.GetTokenAuthorityKey(tokenId), data); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = mintage.MintageABI.PackVariable(mintage.VariableNameTokenFreeze, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetStorage(contractaddress.MintageAddress[:], mintage.GetTokenFreezeKey(tokenId, frozen), data); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	// token paid out by contract, e.g. released time lock, can not reach frozen account
+	reward := mock.StateBlockWithoutWork()
+	reward.Type = types.ContractReward
+	reward.Token = tokenId
+	reward.Address = frozen
+	tc, ctc := blockTokenCheck{}, cacheBlockTokenCheck{}
+	if r, _ := tc.token(lv, reward); r != Other {
+		t.Fatal("frozen account should not receive contract reward", r)
+	}
+	if r, _ := ctc.token(lv, reward); r != Other {
+		t.Fatal("frozen account should not receive contract reward", r)
+	}
+
+	reward.Address = mock.Address()
+	if r, err := tc.token(lv, reward); r != Progress {
+		t.Fatal(r, err)
+	}
+}
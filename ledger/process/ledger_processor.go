@@ -97,6 +97,8 @@ type sendBlockCheck struct {
 	blockBaseInfoCheck
 	blockForkCheck
 	blockBalanceCheck
+	blockTokenCheck
+	blockFeeCheck
 }
 
 func (c *sendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -106,14 +108,22 @@ func (c *sendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (Pro
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.balance(lv, block)
+	if r, err := c.balance(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type contractSendBlockCheck struct {
 	blockBaseInfoCheck
 	blockForkCheck
 	blockBalanceCheck
+	blockTokenCheck
 	blockContractCheck
+	blockFeeCheck
 }
 
 func (c *contractSendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -130,7 +140,13 @@ func (c *contractSendBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlo
 	if r, err := c.balance(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.contract(lv, block)
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.contract(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type receiveBlockCheck struct {
@@ -138,6 +154,8 @@ type receiveBlockCheck struct {
 	blockForkCheck
 	blockSourceCheck
 	blockPendingCheck
+	blockTokenCheck
+	blockFeeCheck
 }
 
 func (c *receiveBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -150,7 +168,13 @@ func (c *receiveBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (
 	if r, err := c.source(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.pending(lv, block)
+	if r, err := c.pending(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type contractReceiveBlockCheck struct {
@@ -158,7 +182,9 @@ type contractReceiveBlockCheck struct {
 	blockForkCheck
 	blockPendingCheck
 	blockSourceCheck
+	blockTokenCheck
 	blockContractCheck
+	blockFeeCheck
 }
 
 func (c *contractReceiveBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -178,7 +204,13 @@ func (c *contractReceiveBlockCheck) Check(lv *LedgerVerifier, block *types.State
 	if r, err := c.source(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.contract(lv, block)
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.contract(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type openBlockCheck struct {
@@ -186,6 +218,8 @@ type openBlockCheck struct {
 	blockForkCheck
 	blockSourceCheck
 	blockPendingCheck
+	blockTokenCheck
+	blockFeeCheck
 }
 
 func (c *openBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -203,13 +237,20 @@ func (c *openBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (Pro
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.pending(lv, block)
+	if r, err := c.pending(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	if r, err := c.token(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type changeBlockCheck struct {
 	blockBaseInfoCheck
 	blockForkCheck
 	blockBalanceCheck
+	blockFeeCheck
 }
 
 func (c *changeBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -227,13 +268,17 @@ func (c *changeBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (P
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.balance(lv, block)
+	if r, err := c.balance(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 type onlineBlockCheck struct {
 	blockBaseInfoCheck
 	blockForkCheck
 	blockBalanceCheck
+	blockFeeCheck
 }
 
 func (c *onlineBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (ProcessResult, error) {
@@ -251,7 +296,10 @@ func (c *onlineBlockCheck) Check(lv *LedgerVerifier, block *types.StateBlock) (P
 	if r, err := c.fork(lv, block); r != Progress || err != nil {
 		return r, err
 	}
-	return c.balance(lv, block)
+	if r, err := c.balance(lv, block); r != Progress || err != nil {
+		return r, err
+	}
+	return c.fee(lv, block)
 }
 
 func (lv *LedgerVerifier) BlockProcess(block *types.StateBlock) error {
@@ -272,9 +320,19 @@ func (lv *LedgerVerifier) BlockProcess(block *types.StateBlock) error {
 	lv.logger.Debug("publish addRelation,", block.GetHash())
 	lv.l.EventBus().Publish(topic.EventAddRelation, block)
 	lv.l.BlockConfirmed(block)
+	lv.publishVmLogs(block)
 	return nil
 }
 
+func (lv *LedgerVerifier) publishVmLogs(block *types.StateBlock) {
+	if !block.IsContractBlock() {
+		return
+	}
+	if logs, err := lv.l.GetBlockVmLogs(block.GetHash()); err == nil {
+		lv.l.EventBus().Publish(topic.EventNewVmLogs, ledger.NewVmLogEntries(block, logs))
+	}
+}
+
 func (lv *LedgerVerifier) processStateBlock(block *types.StateBlock, cache *ledger.Cache) error {
 	am, err := lv.l.GetAccountMetaConfirmed(block.GetAddress())
 	if err != nil && err != ledger.ErrAccountNotFound {
@@ -550,6 +608,9 @@ func (lv *LedgerVerifier) updateContractData(block *types.StateBlock, cache *led
 					if err != nil {
 						return fmt.Errorf("reward block save trie error: %s", err)
 					}
+					if err := lv.l.AddBlockVmLogs(block, vmstore.LogList(ctx), cache); err != nil {
+						return fmt.Errorf("reward block save logs error: %s", err)
+					}
 					return nil
 				}
 			}
@@ -577,6 +638,9 @@ func (lv *LedgerVerifier) updateContractData(block *types.StateBlock, cache *led
 						if err != nil {
 							return fmt.Errorf("send block save trie error: %s", err)
 						}
+						if err := lv.l.AddBlockVmLogs(block, vmstore.LogList(vmCtx), cache); err != nil {
+							return fmt.Errorf("send block save logs error: %s", err)
+						}
 					} else {
 						lv.logger.Errorf("process send error, %s", err)
 					}
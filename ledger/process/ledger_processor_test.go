@@ -290,3 +290,70 @@ func TestBlock_fork(t *testing.T) {
 		t.Fatal(r, err)
 	}
 }
+
+func TestProcess_InsufficientFee(t *testing.T) {
+	teardownTestCase, l, lv := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	reset := loadGenesisFee(t, &config.FeeConfig{Enable: true, MinFee: types.NewBalance(100), MaxFee: types.NewBalance(1000), Mode: config.FeeModeBurn})
+	defer reset()
+
+	ac := mock.Account()
+	open := mock.StateBlockWithoutWork()
+	open.Type = types.Open
+	open.Token = config.ChainToken()
+	open.Address = ac.Address()
+	open.Previous = types.ZeroHash
+	open.Balance = types.NewBalance(1000)
+	if err := l.AddStateBlock(open); err != nil {
+		t.Fatal(err)
+	}
+
+	newSend := func(fee *types.Balance) *types.StateBlock {
+		send := mock.StateBlockWithoutWork()
+		send.Type = types.Send
+		send.Token = open.Token
+		send.Address = ac.Address()
+		send.Previous = open.GetHash()
+		send.Representative = open.Representative
+		send.Balance = types.NewBalance(900)
+		send.Vote = open.Vote
+		send.Network = open.Network
+		send.Storage = open.Storage
+		send.Oracle = open.Oracle
+		send.Link = mock.Hash()
+		send.Fee = fee
+		send.Signature = ac.Sign(send.GetHash())
+		var w types.Work
+		worker, err := types.NewWorker(w, send.Root())
+		if err != nil {
+			t.Fatal(err)
+		}
+		send.Work = worker.NewWork()
+		return send
+	}
+
+	// blocks with fee less than the min fee of genesis are rejected, whatever the suggested fee is
+	for _, fee := range []types.Balance{types.NewBalance(0), types.NewBalance(99)} {
+		send := newSend(&fee)
+		if r, err := lv.BlockCheck(send); r != InsufficientFee {
+			t.Fatal("block with fee less than min fee should be rejected", fee, r, err)
+		}
+		if r, err := lv.BlockCacheCheck(send); r != InsufficientFee {
+			t.Fatal("block with fee less than min fee should be rejected", fee, r, err)
+		}
+	}
+	if r, err := lv.Process(newSend(nil)); r != InsufficientFee {
+		t.Fatal("block without fee should be rejected", r, err)
+	}
+
+	// fee more than the credit of account is rejected too
+	fee := types.NewBalance(100)
+	send := newSend(&fee)
+	if r, err := lv.Process(send); r != InsufficientFee {
+		t.Fatal("block without fee credit should be rejected", r, err)
+	}
+	if b, err := l.HasStateBlockConfirmed(send.GetHash()); b || err != nil {
+		t.Fatal("rejected block should not be saved", err)
+	}
+}
@@ -787,6 +787,10 @@ func (lv *LedgerVerifier) rollBackPendingDel(blockCur *types.StateBlock, cache *
 func (lv *LedgerVerifier) rollBackContractData(block *types.StateBlock, cache *ledger.Cache) error {
 	lv.logger.Warnf("rollback contract data, block:%s", block.GetHash().String())
 
+	if err := lv.l.DeleteBlockVmLogs(block.GetHash(), cache); err != nil {
+		return fmt.Errorf("delete vm logs: %s", err)
+	}
+
 	extra := block.GetExtra()
 	if !extra.IsZero() {
 		lv.logger.Warnf("rollback contract data, block:%s, extra:%s", block.GetHash().String(), extra.String())
@@ -864,9 +868,8 @@ func (lv *LedgerVerifier) RollbackUnchecked(hash types.Hash) {
 			}
 			address := types.Address(input.GetLink())
 			if address == contractaddress.MintageAddress {
-				var param = new(mintage.ParamMintage)
-				tokenId = param.TokenId
-				if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintage, input.GetPayload()); err == nil {
+				if param, err := mintage.ParseMintageParam(input.GetPayload()); err == nil {
+					tokenId = param.TokenId
 					blkToken, _, _ = lv.l.GetUncheckedBlock(tokenId, types.UncheckedKindTokenInfo)
 				}
 			}
@@ -42,6 +42,7 @@ const (
 	BadConsensus
 	ReceiveRepeated
 	BadAuxHeader
+	InsufficientFee
 	Other
 )
 
@@ -103,6 +104,8 @@ func (r ProcessResult) String() string {
 		return "ReceiveRepeated"
 	case BadAuxHeader:
 		return "BadAuxHeader"
+	case InsufficientFee:
+		return "InsufficientFee"
 	default:
 		return "<invalid>"
 	}
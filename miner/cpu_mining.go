@@ -19,7 +19,12 @@ func (w *PovWorker) cpuMiningLoop() {
 	nextBlkTicker := time.NewTicker(time.Second)
 	defer nextBlkTicker.Stop()
 
-	checkMinerTicker := time.NewTicker(time.Minute)
+	checkInterval := time.Minute
+	if cfg := w.GetConfig(); cfg.IsDevnet() {
+		// devnet starts mining right after sync done
+		checkInterval = time.Second
+	}
+	checkMinerTicker := time.NewTicker(checkInterval)
 	defer checkMinerTicker.Stop()
 
 	isMinerValid := w.checkValidMiner()
@@ -172,6 +177,11 @@ Loop:
 }
 
 func (w *PovWorker) sealHeader(header *types.PovHeader, quitCh chan struct{}, resultCh chan<- *types.PovHeader) error {
+	if cfg := w.GetConfig(); cfg.IsDevnet() {
+		w.fakeSealHeader(header, time.Duration(cfg.Devnet.PovBlockInterval)*time.Second, quitCh, resultCh)
+		return nil
+	}
+
 	var wgMine sync.WaitGroup
 
 	abortCh := make(chan struct{})
@@ -204,6 +214,29 @@ func (w *PovWorker) sealHeader(header *types.PovHeader, quitCh chan struct{}, re
 	return nil
 }
 
+// fakeSealHeader seals header for the fake consensus of devnet, which only requires nonce equals to timestamp
+func (w *PovWorker) fakeSealHeader(header *types.PovHeader, interval time.Duration, quitCh chan struct{}, resultCh chan<- *types.PovHeader) {
+	copyHdr := header.Copy()
+
+	go func() {
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+
+		select {
+		case <-quitCh:
+			return
+		case <-timer.C:
+		}
+
+		copyHdr.BasHdr.Timestamp = uint32(time.Now().Unix())
+		copyHdr.BasHdr.Nonce = copyHdr.BasHdr.Timestamp
+		select {
+		case <-quitCh:
+		case resultCh <- copyHdr:
+		}
+	}()
+}
+
 func (w *PovWorker) mineWorker(id int, gap int, header *types.PovHeader, abortCh chan struct{}, localCh chan *types.PovHeader) {
 	copyHdr := header.Copy()
 	targetIntAlgo := copyHdr.GetAlgoTargetInt()
@@ -27,6 +27,7 @@ type Miner struct {
 	cs     PovConsensusReader
 
 	povWorker *PovWorker
+	stratum   *StratumServer
 	syncState topic.SyncState
 }
 
@@ -64,6 +65,9 @@ func NewMiner(cfgFile string, chain PovChainReader, txPool PovTxPoolReader, cs P
 	miner.l = ledger.NewLedger(cfgFile)
 
 	miner.povWorker = NewPovWorker(cc, miner)
+	if cfg.Stratum != nil && cfg.Stratum.Enable {
+		miner.stratum = NewStratumServer(miner.povWorker, cfg.Stratum)
+	}
 
 	return miner
 }
@@ -74,6 +78,13 @@ func (miner *Miner) Init() error {
 		return err
 	}
 
+	if miner.stratum != nil {
+		err = miner.stratum.Init()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -85,12 +96,26 @@ func (miner *Miner) Start() error {
 		return err
 	}
 
+	if miner.stratum != nil {
+		err = miner.stratum.Start()
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (miner *Miner) Stop() error {
 	miner.logger.Info("stop miner service")
 
+	if miner.stratum != nil {
+		err := miner.stratum.Stop()
+		if err != nil {
+			return err
+		}
+	}
+
 	err := miner.povWorker.Stop()
 	if err != nil {
 		return err
@@ -76,10 +76,12 @@ func (tp *mockPovTxPoolReader) GetPendingTxNum() uint32 {
 }
 
 type mockPovConsensusReader struct {
-	md *mockDataTestMiner
+	md   *mockDataTestMiner
+	bits uint32
 }
 
 func (cs *mockPovConsensusReader) PrepareHeader(header *types.PovHeader) error {
+	header.BasHdr.Bits = cs.bits
 	return nil
 }
 
@@ -248,6 +250,41 @@ func TestMiner_Mining(t *testing.T) {
 	_ = md.m.Stop()
 }
 
+func TestMiner_DevnetSeal(t *testing.T) {
+	tearDone, md := setupTestCasePov(t)
+	defer tearDone(t)
+
+	cfg := md.m.GetConfig()
+	cfg.Devnet = &config.DevnetConfig{Enable: true, PovBlockInterval: 0}
+
+	blk, _ := mock.GeneratePovBlock(nil, 0)
+	resultCh := make(chan *types.PovHeader)
+	quitCh := make(chan struct{})
+	if err := md.m.povWorker.sealHeader(blk.GetHeader(), quitCh, resultCh); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case header := <-resultCh:
+		if header.BasHdr.Nonce != header.BasHdr.Timestamp {
+			t.Fatal("nonce should be equal to timestamp")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("header is not sealed")
+	}
+
+	// sealing is aborted by quit
+	cfg.Devnet.PovBlockInterval = 60
+	if err := md.m.povWorker.sealHeader(blk.GetHeader(), quitCh, resultCh); err != nil {
+		t.Fatal(err)
+	}
+	close(quitCh)
+	select {
+	case <-resultCh:
+		t.Fatal("header should not be sealed after quit")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func mockMinerGeneratePovBlocksToLedger(l ledger.Store, blkNum int) ([]*types.PovBlock, error) {
 	var prevBlk *types.PovBlock
 	var allBlks []*types.PovBlock
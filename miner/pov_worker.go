@@ -189,6 +189,9 @@ func (w *PovWorker) OnEventRpcSyncCall(msg *topic.EventRPCSyncCallMsg) {
 	case "Miner.StopMining":
 		w.StopMining(msg.In, msg.Out)
 		needRsp = true
+	case "Miner.GetStratumInfo":
+		w.GetStratumInfo(msg.In, msg.Out)
+		needRsp = true
 	}
 	if needRsp && msg.ResponseChan != nil {
 		msg.ResponseChan <- msg.Out
@@ -315,6 +318,19 @@ func (w *PovWorker) StopMining(in interface{}, out interface{}) {
 	outArgs["err"] = nil
 }
 
+func (w *PovWorker) GetStratumInfo(in interface{}, out interface{}) {
+	//inArgs := in.(map[interface{}]interface{})
+	outArgs := out.(map[interface{}]interface{})
+
+	if w.miner.stratum == nil {
+		outArgs["err"] = errors.New("stratum server is disabled")
+		return
+	}
+
+	outArgs["workers"] = w.miner.stratum.WorkerStats()
+	outArgs["err"] = nil
+}
+
 func (w *PovWorker) GetMiningInfo(in interface{}, out interface{}) {
 	//inArgs := in.(map[interface{}]interface{})
 	outArgs := out.(map[interface{}]interface{})
@@ -0,0 +1,353 @@
+package miner
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	stratumExtraNonce1Size = 4
+	stratumExtraNonce2Size = 4
+
+	stratumJobRefreshTime = 30 * time.Second
+	stratumVarDiffTick    = 5 * time.Second
+	stratumMaxSessionJobs = 8
+)
+
+var (
+	// share difficulty 1 target of pool software, scrypt pools use a 65536 times easier target
+	stratumDiff1Sha256d, _ = new(big.Int).SetString("00000000ffff0000000000000000000000000000000000000000000000000000", 16)
+	stratumDiff1Scrypt, _  = new(big.Int).SetString("0000ffff00000000000000000000000000000000000000000000000000000000", 16)
+)
+
+func stratumDiff1Target(algo types.PovAlgoType) *big.Int {
+	switch algo {
+	case types.ALGO_SCRYPT:
+		return stratumDiff1Scrypt
+	default:
+		return stratumDiff1Sha256d
+	}
+}
+
+// stratumShareTarget converts a share difficulty to the target of the algorithm
+func stratumShareTarget(algo types.PovAlgoType, diff float64) *big.Int {
+	if diff <= 0 {
+		diff = 1
+	}
+	diff1 := new(big.Float).SetInt(stratumDiff1Target(algo))
+	target, _ := new(big.Float).Quo(diff1, big.NewFloat(diff)).Int(nil)
+	return target
+}
+
+type stratumPort struct {
+	cfg      *config.StratumPortConfig
+	algo     types.PovAlgoType
+	listener net.Listener
+}
+
+// StratumServer serves stratum v1 protocol for external pov miners,
+// every connection gets its own block template with the authorized miner address as coinbase
+type StratumServer struct {
+	worker *PovWorker
+	cfg    *config.StratumConfig
+	logger *zap.SugaredLogger
+
+	ports      []*stratumPort
+	subscriber *event.ActorSubscriber
+
+	sessionSeq     uint64
+	extraNonce1Seq uint32
+	jobSeq         uint64
+
+	sessions   map[uint64]*stratumSession
+	sessionMux sync.RWMutex
+
+	stats    map[string]*types.PovStratumWorkerStat
+	statsMux sync.Mutex
+
+	quitCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewStratumServer(worker *PovWorker, cfg *config.StratumConfig) *StratumServer {
+	return &StratumServer{
+		worker:   worker,
+		cfg:      cfg,
+		logger:   log.NewLogger("pov_stratum"),
+		sessions: make(map[uint64]*stratumSession),
+		stats:    make(map[string]*types.PovStratumWorkerStat),
+		quitCh:   make(chan struct{}),
+	}
+}
+
+func (s *StratumServer) Init() error {
+	for _, pc := range s.cfg.Ports {
+		algo := types.NewPoVHashAlgoFromStr(pc.AlgoName)
+		if !common.PovIsAlgoSupported(algo) {
+			return fmt.Errorf("stratum port %s has unsupported algo %s", pc.Listen, pc.AlgoName)
+		}
+		if pc.MinDiff <= 0 || pc.MaxDiff < pc.MinDiff || pc.StartDiff < pc.MinDiff || pc.StartDiff > pc.MaxDiff {
+			return fmt.Errorf("stratum port %s has invalid difficulty range", pc.Listen)
+		}
+		if pc.TargetTime <= 0 || pc.RetargetTime <= 0 {
+			return fmt.Errorf("stratum port %s has invalid vardiff time", pc.Listen)
+		}
+		s.ports = append(s.ports, &stratumPort{cfg: pc, algo: algo})
+	}
+	return nil
+}
+
+func (s *StratumServer) Start() error {
+	for _, port := range s.ports {
+		u, err := url.Parse(port.cfg.Listen)
+		if err != nil {
+			return err
+		}
+		port.listener, err = net.Listen(u.Scheme, u.Host)
+		if err != nil {
+			return err
+		}
+		s.logger.Infof("stratum server listen on %s, algo %s", port.listener.Addr(), port.algo)
+
+		s.wg.Add(1)
+		go s.acceptLoop(port)
+	}
+
+	s.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+		switch msg := c.Message().(type) {
+		case *types.PovBlock:
+			s.onPovConnectBestBlock(msg)
+		}
+	}), s.worker.miner.eb)
+	if err := s.subscriber.Subscribe(topic.EventPovConnectBestBlock); err != nil {
+		return err
+	}
+
+	s.wg.Add(1)
+	go s.loop()
+
+	return nil
+}
+
+func (s *StratumServer) Stop() error {
+	if s.subscriber != nil {
+		if err := s.subscriber.UnsubscribeAll(); err != nil {
+			s.logger.Error(err)
+		}
+	}
+
+	close(s.quitCh)
+	for _, port := range s.ports {
+		if port.listener != nil {
+			_ = port.listener.Close()
+		}
+	}
+
+	s.sessionMux.RLock()
+	for _, ss := range s.sessions {
+		ss.close()
+	}
+	s.sessionMux.RUnlock()
+
+	s.wg.Wait()
+	return nil
+}
+
+func (s *StratumServer) acceptLoop(port *stratumPort) {
+	defer s.wg.Done()
+
+	for {
+		conn, err := port.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.quitCh:
+				return
+			default:
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Temporary() {
+				time.Sleep(100 * time.Millisecond)
+				continue
+			}
+			s.logger.Errorf("stratum port %s accept err %s", port.cfg.Listen, err)
+			return
+		}
+
+		ss := s.newSession(port, conn)
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			ss.serve()
+			s.removeSession(ss)
+		}()
+	}
+}
+
+func (s *StratumServer) newSession(port *stratumPort, conn net.Conn) *stratumSession {
+	extraNonce1 := make([]byte, stratumExtraNonce1Size)
+	binary.BigEndian.PutUint32(extraNonce1, atomic.AddUint32(&s.extraNonce1Seq, 1))
+
+	ss := newStratumSession(s, port, conn, atomic.AddUint64(&s.sessionSeq, 1), extraNonce1)
+
+	s.sessionMux.Lock()
+	s.sessions[ss.id] = ss
+	s.sessionMux.Unlock()
+
+	s.logger.Debugf("stratum session %d connected from %s", ss.id, conn.RemoteAddr())
+	return ss
+}
+
+func (s *StratumServer) removeSession(ss *stratumSession) {
+	s.sessionMux.Lock()
+	delete(s.sessions, ss.id)
+	s.sessionMux.Unlock()
+
+	workers := ss.getWorkerNames()
+	s.statsMux.Lock()
+	for _, worker := range workers {
+		if stat := s.stats[worker]; stat != nil && stat.Connections > 0 {
+			stat.Connections--
+		}
+	}
+	s.statsMux.Unlock()
+
+	s.logger.Debugf("stratum session %d disconnected", ss.id)
+}
+
+func (s *StratumServer) allSessions() []*stratumSession {
+	s.sessionMux.RLock()
+	defer s.sessionMux.RUnlock()
+
+	sessions := make([]*stratumSession, 0, len(s.sessions))
+	for _, ss := range s.sessions {
+		sessions = append(sessions, ss)
+	}
+	return sessions
+}
+
+func (s *StratumServer) loop() {
+	defer s.wg.Done()
+
+	refreshTicker := time.NewTicker(stratumJobRefreshTime)
+	defer refreshTicker.Stop()
+
+	varDiffTicker := time.NewTicker(stratumVarDiffTick)
+	defer varDiffTicker.Stop()
+
+	for {
+		select {
+		case <-s.quitCh:
+			return
+		case <-refreshTicker.C:
+			// refresh templates to pack new txs in pool
+			for _, ss := range s.allSessions() {
+				ss.sendJob(false)
+			}
+		case <-varDiffTicker.C:
+			for _, ss := range s.allSessions() {
+				ss.checkVarDiff()
+			}
+		}
+	}
+}
+
+func (s *StratumServer) onPovConnectBestBlock(block *types.PovBlock) {
+	if s.worker.miner.GetSyncState() != topic.SyncDone {
+		return
+	}
+
+	s.logger.Debugf("push stratum jobs after best block %d/%s", block.GetHeight(), block.GetHash())
+	for _, ss := range s.allSessions() {
+		ss.sendJob(true)
+	}
+}
+
+func (s *StratumServer) nextJobID() string {
+	return fmt.Sprintf("%x", atomic.AddUint64(&s.jobSeq, 1))
+}
+
+func (s *StratumServer) getWorkerStat(worker string, minerAddr types.Address, algo types.PovAlgoType) *types.PovStratumWorkerStat {
+	stat := s.stats[worker]
+	if stat == nil {
+		stat = &types.PovStratumWorkerStat{Worker: worker}
+		s.stats[worker] = stat
+	}
+	stat.MinerAddr = minerAddr
+	stat.AlgoName = algo.String()
+	return stat
+}
+
+func (s *StratumServer) onWorkerAuthorized(worker string, minerAddr types.Address, algo types.PovAlgoType, diff float64) {
+	s.statsMux.Lock()
+	defer s.statsMux.Unlock()
+
+	stat := s.getWorkerStat(worker, minerAddr, algo)
+	stat.Connections++
+	stat.Difficulty = diff
+}
+
+func (s *StratumServer) onWorkerDifficulty(worker string, diff float64) {
+	s.statsMux.Lock()
+	defer s.statsMux.Unlock()
+
+	if stat := s.stats[worker]; stat != nil {
+		stat.Difficulty = diff
+	}
+}
+
+func (s *StratumServer) onWorkerShare(worker string, result stratumShareResult) {
+	s.statsMux.Lock()
+	defer s.statsMux.Unlock()
+
+	stat := s.stats[worker]
+	if stat == nil {
+		return
+	}
+	switch result {
+	case stratumShareAccepted:
+		stat.Accepted++
+		stat.LastShareTime = time.Now().Unix()
+	case stratumShareBlock:
+		stat.Accepted++
+		stat.Blocks++
+		stat.LastShareTime = time.Now().Unix()
+	case stratumShareStale:
+		stat.Stale++
+	default:
+		stat.Rejected++
+	}
+}
+
+// WorkerStats returns the share statistics of all workers sorted by name
+func (s *StratumServer) WorkerStats() []*types.PovStratumWorkerStat {
+	s.statsMux.Lock()
+	defer s.statsMux.Unlock()
+
+	stats := make([]*types.PovStratumWorkerStat, 0, len(s.stats))
+	for _, stat := range s.stats {
+		statCopy := *stat
+		stats = append(stats, &statCopy)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Worker < stats[j].Worker
+	})
+	return stats
+}
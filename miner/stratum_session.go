@@ -0,0 +1,503 @@
+package miner
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/merkle"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+const stratumMaxLineSize = 16 * 1024
+
+type stratumShareResult int
+
+const (
+	stratumShareAccepted stratumShareResult = iota
+	stratumShareBlock
+	stratumShareStale
+	stratumShareRejected
+)
+
+// stratum error codes used by common pool software
+var (
+	errStratumOther        = &stratumError{code: 20, msg: "other/unknown"}
+	errStratumJobNotFound  = &stratumError{code: 21, msg: "job not found"}
+	errStratumDuplicate    = &stratumError{code: 22, msg: "duplicate share"}
+	errStratumLowDiff      = &stratumError{code: 23, msg: "low difficulty share"}
+	errStratumUnauthorized = &stratumError{code: 24, msg: "unauthorized worker"}
+	errStratumNotSubscribe = &stratumError{code: 25, msg: "not subscribed"}
+)
+
+type stratumError struct {
+	code int
+	msg  string
+}
+
+func (e *stratumError) Error() string {
+	return e.msg
+}
+
+func (e *stratumError) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{e.code, e.msg, nil})
+}
+
+func newStratumError(msg string) *stratumError {
+	return &stratumError{code: errStratumOther.code, msg: msg}
+}
+
+type stratumRequest struct {
+	ID     interface{}       `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type stratumResponse struct {
+	ID     interface{}   `json:"id"`
+	Result interface{}   `json:"result"`
+	Error  *stratumError `json:"error"`
+}
+
+type stratumNotify struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+type stratumJob struct {
+	id         string
+	mineBlock  *types.PovMineBlock
+	difficulty float64
+	shares     map[string]struct{}
+}
+
+type stratumSession struct {
+	id     uint64
+	server *StratumServer
+	port   *stratumPort
+	conn   net.Conn
+
+	sendMux sync.Mutex
+	enc     *json.Encoder
+
+	mux         sync.Mutex
+	extraNonce1 []byte
+	subscribed  bool
+	workers     map[string]struct{}
+	minerAddr   types.Address
+	difficulty  float64
+	jobs        map[string]*stratumJob
+	jobIDs      []string
+
+	varDiffTime   time.Time
+	varDiffShares int
+
+	closeOnce sync.Once
+}
+
+func newStratumSession(server *StratumServer, port *stratumPort, conn net.Conn, id uint64, extraNonce1 []byte) *stratumSession {
+	return &stratumSession{
+		id:          id,
+		server:      server,
+		port:        port,
+		conn:        conn,
+		enc:         json.NewEncoder(conn),
+		extraNonce1: extraNonce1,
+		workers:     make(map[string]struct{}),
+		difficulty:  port.cfg.StartDiff,
+		jobs:        make(map[string]*stratumJob),
+	}
+}
+
+func (ss *stratumSession) close() {
+	ss.closeOnce.Do(func() {
+		_ = ss.conn.Close()
+	})
+}
+
+func (ss *stratumSession) serve() {
+	defer ss.close()
+
+	scanner := bufio.NewScanner(ss.conn)
+	scanner.Buffer(make([]byte, 1024), stratumMaxLineSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		req := new(stratumRequest)
+		if err := json.Unmarshal(line, req); err != nil {
+			ss.server.logger.Debugf("stratum session %d invalid request, err %s", ss.id, err)
+			return
+		}
+		ss.handleRequest(req)
+	}
+}
+
+func (ss *stratumSession) handleRequest(req *stratumRequest) {
+	switch req.Method {
+	case "mining.subscribe":
+		ss.handleSubscribe(req)
+	case "mining.extranonce.subscribe":
+		ss.reply(req.ID, true, nil)
+	case "mining.authorize":
+		ss.handleAuthorize(req)
+	case "mining.submit":
+		ss.handleSubmit(req)
+	default:
+		ss.reply(req.ID, nil, newStratumError(fmt.Sprintf("unsupported method %s", req.Method)))
+	}
+}
+
+func (ss *stratumSession) send(v interface{}) {
+	ss.sendMux.Lock()
+	defer ss.sendMux.Unlock()
+
+	if err := ss.enc.Encode(v); err != nil {
+		ss.server.logger.Debugf("stratum session %d send err %s", ss.id, err)
+		ss.close()
+	}
+}
+
+func (ss *stratumSession) reply(id interface{}, result interface{}, err *stratumError) {
+	ss.send(&stratumResponse{ID: id, Result: result, Error: err})
+}
+
+func (ss *stratumSession) notify(method string, params ...interface{}) {
+	ss.send(&stratumNotify{ID: nil, Method: method, Params: params})
+}
+
+func (ss *stratumSession) handleSubscribe(req *stratumRequest) {
+	ss.mux.Lock()
+	ss.subscribed = true
+	extraNonce1 := hex.EncodeToString(ss.extraNonce1)
+	ss.mux.Unlock()
+
+	subID := strconv.FormatUint(ss.id, 16)
+	ss.reply(req.ID, []interface{}{
+		[][]string{{"mining.set_difficulty", subID}, {"mining.notify", subID}},
+		extraNonce1,
+		stratumExtraNonce2Size,
+	}, nil)
+}
+
+// handleAuthorize accepts username as "address[.worker]", the address is used as coinbase of following jobs
+func (ss *stratumSession) handleAuthorize(req *stratumRequest) {
+	worker, err := stratumParamString(req.Params, 0)
+	if err != nil {
+		ss.reply(req.ID, nil, newStratumError(err.Error()))
+		return
+	}
+
+	minerAddr, err := types.HexToAddress(strings.SplitN(worker, ".", 2)[0])
+	if err != nil {
+		ss.reply(req.ID, false, errStratumUnauthorized)
+		return
+	}
+	if err := ss.server.worker.checkMinerPledge(minerAddr); err != nil {
+		ss.reply(req.ID, false, newStratumError(err.Error()))
+		return
+	}
+
+	ss.mux.Lock()
+	if !ss.subscribed {
+		ss.mux.Unlock()
+		ss.reply(req.ID, false, errStratumNotSubscribe)
+		return
+	}
+	_, exist := ss.workers[worker]
+	ss.workers[worker] = struct{}{}
+	ss.minerAddr = minerAddr
+	diff := ss.difficulty
+	ss.varDiffTime = time.Now()
+	ss.varDiffShares = 0
+	ss.mux.Unlock()
+
+	if !exist {
+		ss.server.onWorkerAuthorized(worker, minerAddr, ss.port.algo, diff)
+	}
+	ss.server.logger.Infof("stratum session %d authorized worker %s", ss.id, worker)
+
+	ss.reply(req.ID, true, nil)
+	ss.notify("mining.set_difficulty", diff)
+	ss.sendJob(true)
+}
+
+func (ss *stratumSession) getWorkerNames() []string {
+	ss.mux.Lock()
+	defer ss.mux.Unlock()
+
+	names := make([]string, 0, len(ss.workers))
+	for name := range ss.workers {
+		names = append(names, name)
+	}
+	return names
+}
+
+// sendJob makes a new block template for the connection and pushes it by mining.notify,
+// all previous jobs are dropped if clean is true
+func (ss *stratumSession) sendJob(clean bool) {
+	if ss.server.worker.miner.GetSyncState() != topic.SyncDone {
+		return
+	}
+
+	ss.mux.Lock()
+	if ss.minerAddr.IsZero() {
+		ss.mux.Unlock()
+		return
+	}
+
+	mineBlock, err := ss.server.worker.newBlockTemplate(ss.minerAddr, ss.port.algo)
+	if err != nil {
+		ss.mux.Unlock()
+		ss.server.logger.Warnf("stratum session %d failed to generate block, err %s", ss.id, err)
+		return
+	}
+
+	job := ss.addJob(mineBlock, clean)
+	ss.mux.Unlock()
+
+	ss.notifyJob(job, clean)
+}
+
+func (ss *stratumSession) addJob(mineBlock *types.PovMineBlock, clean bool) *stratumJob {
+	job := &stratumJob{
+		id:         ss.server.nextJobID(),
+		mineBlock:  mineBlock,
+		difficulty: ss.difficulty,
+		shares:     make(map[string]struct{}),
+	}
+
+	if clean {
+		ss.jobs = make(map[string]*stratumJob)
+		ss.jobIDs = nil
+	}
+	ss.jobs[job.id] = job
+	ss.jobIDs = append(ss.jobIDs, job.id)
+	for len(ss.jobIDs) > stratumMaxSessionJobs {
+		delete(ss.jobs, ss.jobIDs[0])
+		ss.jobIDs = ss.jobIDs[1:]
+	}
+	return job
+}
+
+func (ss *stratumSession) notifyJob(job *stratumJob, clean bool) {
+	header := job.mineBlock.Header
+	cbtx := header.CbTx
+
+	// coinbase = coinbase1 + extra nonce1 + extra nonce2 + coinbase2
+	coinbase1 := cbtx.GetCoinBaseData1()
+	coinbase1 = append(coinbase1, util.LE_EncodeVarInt(uint64(stratumExtraNonce1Size+stratumExtraNonce2Size))...)
+	coinbase2 := cbtx.GetCoinBaseData2()
+
+	branches := make([]string, 0, len(job.mineBlock.CoinbaseBranch))
+	for _, branch := range job.mineBlock.CoinbaseBranch {
+		branches = append(branches, hex.EncodeToString(branch.Bytes()))
+	}
+
+	ss.notify("mining.notify",
+		job.id,
+		hex.EncodeToString(stratumSwap32(header.GetPrevious().Bytes())),
+		hex.EncodeToString(coinbase1),
+		hex.EncodeToString(coinbase2),
+		branches,
+		fmt.Sprintf("%08x", header.BasHdr.Version),
+		fmt.Sprintf("%08x", header.BasHdr.Bits),
+		fmt.Sprintf("%08x", header.BasHdr.Timestamp),
+		clean,
+	)
+}
+
+// handleSubmit verifies a share with params [worker, job id, extra nonce2, ntime, nonce]
+func (ss *stratumSession) handleSubmit(req *stratumRequest) {
+	params := make([]string, 5)
+	for i := range params {
+		p, err := stratumParamString(req.Params, i)
+		if err != nil {
+			ss.reply(req.ID, false, newStratumError(err.Error()))
+			return
+		}
+		params[i] = p
+	}
+	worker := params[0]
+
+	ss.mux.Lock()
+	_, authorized := ss.workers[worker]
+	ss.mux.Unlock()
+	if !authorized {
+		ss.reply(req.ID, false, errStratumUnauthorized)
+		return
+	}
+
+	result, err := ss.checkShare(params[1], params[2], params[3], params[4])
+	ss.server.onWorkerShare(worker, result)
+	if err != nil {
+		ss.server.logger.Debugf("stratum session %d worker %s share rejected, err %s", ss.id, worker, err)
+		ss.reply(req.ID, false, err)
+		return
+	}
+	ss.reply(req.ID, true, nil)
+}
+
+func (ss *stratumSession) checkShare(jobID, extraNonce2Hex, nTimeHex, nonceHex string) (stratumShareResult, *stratumError) {
+	ss.mux.Lock()
+	defer ss.mux.Unlock()
+
+	job := ss.jobs[jobID]
+	if job == nil {
+		return stratumShareStale, errStratumJobNotFound
+	}
+
+	extraNonce2, err := hex.DecodeString(extraNonce2Hex)
+	if err != nil || len(extraNonce2) != stratumExtraNonce2Size {
+		return stratumShareRejected, newStratumError("invalid extranonce2")
+	}
+	nTime, err := strconv.ParseUint(nTimeHex, 16, 32)
+	if err != nil {
+		return stratumShareRejected, newStratumError("invalid ntime")
+	}
+	nonce, err := strconv.ParseUint(nonceHex, 16, 32)
+	if err != nil {
+		return stratumShareRejected, newStratumError("invalid nonce")
+	}
+	if uint32(nTime) <= job.mineBlock.MinTime || int64(nTime) > time.Now().Unix()+int64(common.PovMaxAllowedFutureTimeSec) {
+		return stratumShareRejected, newStratumError("ntime out of range")
+	}
+
+	shareKey := extraNonce2Hex + nTimeHex + nonceHex
+	if _, ok := job.shares[shareKey]; ok {
+		return stratumShareRejected, errStratumDuplicate
+	}
+	job.shares[shareKey] = struct{}{}
+
+	extra := make([]byte, 0, stratumExtraNonce1Size+stratumExtraNonce2Size)
+	extra = append(extra, ss.extraNonce1...)
+	extra = append(extra, extraNonce2...)
+
+	cbtx := job.mineBlock.Header.CbTx.Copy()
+	cbtx.TxIns[0].Extra = extra
+	cbHash := cbtx.ComputeHash()
+
+	header := job.mineBlock.Header.Copy()
+	header.BasHdr.MerkleRoot = merkle.CalcCoinbaseMerkleRoot(&cbHash, job.mineBlock.CoinbaseBranch)
+	header.BasHdr.Timestamp = uint32(nTime)
+	header.BasHdr.Nonce = uint32(nonce)
+
+	powHash := header.ComputePowHash()
+	powInt := powHash.ToBigInt()
+
+	if powInt.Cmp(header.GetAlgoTargetInt()) <= 0 {
+		mineResult := types.NewPovMineResult()
+		mineResult.WorkHash = job.mineBlock.WorkHash
+		mineResult.BlockHash = header.ComputeHash()
+		mineResult.MerkleRoot = header.BasHdr.MerkleRoot
+		mineResult.Timestamp = header.BasHdr.Timestamp
+		mineResult.Nonce = header.BasHdr.Nonce
+		mineResult.CoinbaseExtra = extra
+		mineResult.CoinbaseHash = cbHash
+
+		if err := ss.server.worker.checkAndFillBlockByResult(job.mineBlock, mineResult); err != nil {
+			return stratumShareRejected, newStratumError(err.Error())
+		}
+		ss.server.logger.Infof("stratum session %d found block %d/%s", ss.id, header.GetHeight(), mineResult.BlockHash)
+		ss.server.worker.submitBlock(job.mineBlock)
+
+		ss.varDiffShares++
+		return stratumShareBlock, nil
+	}
+
+	if powInt.Cmp(stratumShareTarget(ss.port.algo, job.difficulty)) > 0 {
+		return stratumShareRejected, errStratumLowDiff
+	}
+
+	ss.varDiffShares++
+	return stratumShareAccepted, nil
+}
+
+// checkVarDiff adjusts share difficulty to keep the share rate of connection around the target time
+func (ss *stratumSession) checkVarDiff() {
+	cfg := ss.port.cfg
+
+	ss.mux.Lock()
+	if ss.minerAddr.IsZero() || len(ss.jobIDs) == 0 {
+		ss.mux.Unlock()
+		return
+	}
+	elapsed := time.Since(ss.varDiffTime).Seconds()
+	if elapsed < float64(cfg.RetargetTime) {
+		ss.mux.Unlock()
+		return
+	}
+
+	shares := ss.varDiffShares
+	if shares == 0 {
+		shares = 1
+	}
+	ratio := float64(cfg.TargetTime) / (elapsed / float64(shares))
+	if ratio > 4 {
+		ratio = 4
+	} else if ratio < 0.25 {
+		ratio = 0.25
+	}
+	newDiff := ss.difficulty * ratio
+	if newDiff < cfg.MinDiff {
+		newDiff = cfg.MinDiff
+	} else if newDiff > cfg.MaxDiff {
+		newDiff = cfg.MaxDiff
+	}
+
+	ss.varDiffTime = time.Now()
+	ss.varDiffShares = 0
+
+	if newDiff == ss.difficulty || (newDiff > ss.difficulty*0.8 && newDiff < ss.difficulty*1.2) {
+		ss.mux.Unlock()
+		return
+	}
+	ss.difficulty = newDiff
+
+	// old jobs keep old difficulty for shares in flight
+	lastJob := ss.jobs[ss.jobIDs[len(ss.jobIDs)-1]]
+	job := ss.addJob(lastJob.mineBlock, false)
+	ss.mux.Unlock()
+
+	for _, worker := range ss.getWorkerNames() {
+		ss.server.onWorkerDifficulty(worker, newDiff)
+	}
+	ss.notify("mining.set_difficulty", newDiff)
+	ss.notifyJob(job, false)
+}
+
+func stratumParamString(params []json.RawMessage, index int) (string, error) {
+	if index >= len(params) {
+		return "", fmt.Errorf("missing param %d", index)
+	}
+	var s string
+	if err := json.Unmarshal(params[index], &s); err != nil {
+		return "", errors.New("invalid param type")
+	}
+	return s, nil
+}
+
+// stratumSwap32 swaps byte order of every 4 bytes, which stratum uses for previous hash
+func stratumSwap32(data []byte) []byte {
+	ret := make([]byte, len(data))
+	for i := 0; i+4 <= len(data); i += 4 {
+		ret[i] = data[i+3]
+		ret[i+1] = data[i+2]
+		ret[i+2] = data[i+1]
+		ret[i+3] = data[i]
+	}
+	return ret
+}
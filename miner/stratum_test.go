@@ -0,0 +1,296 @@
+package miner
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+type stratumTestClient struct {
+	t     *testing.T
+	conn  net.Conn
+	enc   *json.Encoder
+	msgCh chan map[string]interface{}
+	reqID int
+}
+
+func newStratumTestClient(t *testing.T, conn net.Conn) *stratumTestClient {
+	c := &stratumTestClient{t: t, conn: conn, enc: json.NewEncoder(conn), msgCh: make(chan map[string]interface{}, 100)}
+	go func() {
+		scanner := bufio.NewScanner(conn)
+		for scanner.Scan() {
+			msg := make(map[string]interface{})
+			if err := json.Unmarshal(scanner.Bytes(), &msg); err == nil {
+				c.msgCh <- msg
+			}
+		}
+	}()
+	return c
+}
+
+func (c *stratumTestClient) call(method string, params ...interface{}) map[string]interface{} {
+	c.reqID++
+	if err := c.enc.Encode(map[string]interface{}{"id": c.reqID, "method": method, "params": params}); err != nil {
+		c.t.Fatal(err)
+	}
+	return c.read()
+}
+
+func (c *stratumTestClient) read() map[string]interface{} {
+	select {
+	case msg := <-c.msgCh:
+		return msg
+	case <-time.After(5 * time.Second):
+		c.t.Fatal("read stratum message timeout")
+	}
+	return nil
+}
+
+// solve builds header like pool software and searches a nonce under target
+func (c *stratumTestClient) solve(notify []interface{}, extraNonce1, extraNonce2 string, target *big.Int) (string, string) {
+	hexDecode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		return b
+	}
+	hexUint32 := func(s string) uint32 {
+		v, err := strconv.ParseUint(s, 16, 32)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		return uint32(v)
+	}
+
+	coinbase := hexDecode(notify[2].(string))
+	coinbase = append(coinbase, hexDecode(extraNonce1)...)
+	coinbase = append(coinbase, hexDecode(extraNonce2)...)
+	coinbase = append(coinbase, hexDecode(notify[3].(string))...)
+	root := types.Sha256DHashData(coinbase)
+	for _, branch := range notify[4].([]interface{}) {
+		root = types.Sha256DHashData(append(root.Bytes(), hexDecode(branch.(string))...))
+	}
+
+	nTime := notify[7].(string)
+	buf := new(bytes.Buffer)
+	buf.Write(util.LE_Uint32ToBytes(hexUint32(notify[5].(string))))
+	buf.Write(stratumSwap32(hexDecode(notify[1].(string))))
+	buf.Write(root.Bytes())
+	buf.Write(util.LE_Uint32ToBytes(hexUint32(nTime)))
+	buf.Write(util.LE_Uint32ToBytes(hexUint32(notify[6].(string))))
+	hdr := buf.Bytes()
+
+	for nonce := uint32(0); nonce < 10000; nonce++ {
+		powHash := types.Sha256DHashData(append(hdr, util.LE_Uint32ToBytes(nonce)...))
+		if powHash.ToBigInt().Cmp(target) <= 0 {
+			return nTime, strconv.FormatUint(uint64(nonce), 16)
+		}
+	}
+	c.t.Fatal("failed to solve share")
+	return "", ""
+}
+
+func setupStratumTestCase(t *testing.T) (func(t *testing.T), *mockDataTestMiner, *StratumServer) {
+	tearDone, md := setupTestCasePov(t)
+
+	_ = md.cc.Start()
+	md.eb.Publish(topic.EventPovSyncState, topic.SyncDone)
+	time.Sleep(10 * time.Millisecond)
+
+	allPovBlks, err := mockMinerGeneratePovBlocksToLedger(md.l, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md.ch.mockPovBlocks["LatestHeader"] = allPovBlks[0]
+	md.ch.mockPovBlocks["LatestBlock"] = allPovBlks[0]
+
+	_ = md.m.povWorker.Init()
+
+	cfg := &config.StratumConfig{
+		Enable: true,
+		Ports: []*config.StratumPortConfig{
+			{Listen: "tcp4://127.0.0.1:0", AlgoName: "SHA256D", StartDiff: 1e-9, MinDiff: 1e-12, MaxDiff: 1, TargetTime: 10, RetargetTime: 60},
+		},
+	}
+	server := NewStratumServer(md.m.povWorker, cfg)
+	if err := server.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	return func(t *testing.T) {
+		_ = md.cc.Stop()
+		tearDone(t)
+	}, md, server
+}
+
+func TestStratumServer_Init(t *testing.T) {
+	cfg, _ := config.DefaultConfig(config.QlcTestDataDir())
+	if cfg.Stratum == nil || cfg.Stratum.Enable || len(cfg.Stratum.Ports) != 3 {
+		t.Fatal("invalid default stratum config")
+	}
+	if err := NewStratumServer(nil, cfg.Stratum).Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.Stratum.Ports[0].AlgoName = "NIST5"
+	if err := NewStratumServer(nil, cfg.Stratum).Init(); err == nil {
+		t.Fatal("unsupported algo should be rejected")
+	}
+	cfg.Stratum.Ports[0].AlgoName = "SHA256D"
+	cfg.Stratum.Ports[0].MinDiff = 0
+	if err := NewStratumServer(nil, cfg.Stratum).Init(); err == nil {
+		t.Fatal("invalid difficulty should be rejected")
+	}
+
+	if stratumShareTarget(types.ALGO_SHA256D, 1).Cmp(stratumDiff1Sha256d) != 0 {
+		t.Fatal("invalid share target")
+	}
+	if stratumShareTarget(types.ALGO_SCRYPT, 65536).Cmp(stratumDiff1Sha256d) != 0 {
+		t.Fatal("invalid scrypt share target")
+	}
+}
+
+func TestStratumServer_StartStop(t *testing.T) {
+	tearDone, _, server := setupStratumTestCase(t)
+	defer tearDone(t)
+
+	if err := server.Start(); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := net.Dial("tcp", server.ports[0].listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newStratumTestClient(t, conn)
+	rsp := client.call("mining.subscribe", "test/1.0")
+	if rsp["error"] != nil {
+		t.Fatal(rsp)
+	}
+	if err := server.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.Close()
+}
+
+func TestStratumServer_Share(t *testing.T) {
+	tearDone, md, server := setupStratumTestCase(t)
+	defer tearDone(t)
+
+	srvConn, cliConn := net.Pipe()
+	ss := server.newSession(server.ports[0], srvConn)
+	go ss.serve()
+	defer ss.close()
+	client := newStratumTestClient(t, cliConn)
+
+	worker := mock.Address().String() + ".rig1"
+
+	// authorize before subscribe
+	if rsp := client.call("mining.authorize", worker, "x"); rsp["error"] == nil {
+		t.Fatal("authorize should require subscribe")
+	}
+
+	rsp := client.call("mining.subscribe", "test/1.0")
+	result := rsp["result"].([]interface{})
+	extraNonce1 := result[1].(string)
+	if len(extraNonce1) != stratumExtraNonce1Size*2 || result[2].(float64) != stratumExtraNonce2Size {
+		t.Fatal(rsp)
+	}
+
+	if rsp := client.call("mining.authorize", "invalid", "x"); rsp["result"] != false {
+		t.Fatal("invalid address should be rejected")
+	}
+	if rsp := client.call("mining.authorize", worker, "x"); rsp["result"] != true {
+		t.Fatal(rsp)
+	}
+	if msg := client.read(); msg["method"] != "mining.set_difficulty" {
+		t.Fatal(msg)
+	}
+	notifyMsg := client.read()
+	if notifyMsg["method"] != "mining.notify" {
+		t.Fatal(notifyMsg)
+	}
+	notify := notifyMsg["params"].([]interface{})
+	jobID := notify[0].(string)
+
+	// accepted share
+	shareTarget := stratumShareTarget(types.ALGO_SHA256D, server.ports[0].cfg.StartDiff)
+	nTime, nonce := client.solve(notify, extraNonce1, "00000001", shareTarget)
+	if rsp := client.call("mining.submit", worker, jobID, "00000001", nTime, nonce); rsp["result"] != true {
+		t.Fatal(rsp)
+	}
+
+	// duplicate share
+	if rsp := client.call("mining.submit", worker, jobID, "00000001", nTime, nonce); rsp["result"] != false {
+		t.Fatal("duplicate share should be rejected")
+	}
+
+	// unknown job
+	if rsp := client.call("mining.submit", worker, "ffff", "00000002", nTime, nonce); rsp["result"] != false {
+		t.Fatal("stale share should be rejected")
+	}
+
+	// unauthorized worker
+	if rsp := client.call("mining.submit", "other", jobID, "00000003", nTime, nonce); rsp["result"] != false {
+		t.Fatal("unauthorized share should be rejected")
+	}
+
+	// low difficulty share
+	ss.mux.Lock()
+	ss.jobs[jobID].difficulty = 1e12
+	ss.mux.Unlock()
+	if rsp := client.call("mining.submit", worker, jobID, "00000004", nTime, nonce); rsp["result"] != false {
+		t.Fatal("low difficulty share should be rejected")
+	}
+
+	// block found with easy block target
+	md.cs.bits = types.BigToCompact(new(big.Int).Lsh(big.NewInt(1), 255))
+	ss.sendJob(true)
+	notify = client.read()["params"].([]interface{})
+	nTime, nonce = client.solve(notify, extraNonce1, "00000005", new(big.Int).Lsh(big.NewInt(1), 255))
+	if rsp := client.call("mining.submit", worker, notify[0].(string), "00000005", nTime, nonce); rsp["result"] != true {
+		t.Fatal(rsp)
+	}
+
+	stats := server.WorkerStats()
+	if len(stats) != 1 {
+		t.Fatal(stats)
+	}
+	stat := stats[0]
+	if stat.Worker != worker || stat.Accepted != 2 || stat.Blocks != 1 || stat.Stale != 1 || stat.Rejected != 2 || stat.Connections != 1 {
+		t.Fatalf("invalid worker stat %+v", stat)
+	}
+
+	// vardiff raises difficulty for fast shares
+	ss.mux.Lock()
+	ss.varDiffTime = time.Now().Add(-time.Minute)
+	ss.varDiffShares = 60
+	oldDiff := ss.difficulty
+	ss.mux.Unlock()
+	ss.checkVarDiff()
+	diffMsg := client.read()
+	if diffMsg["method"] != "mining.set_difficulty" || diffMsg["params"].([]interface{})[0].(float64) <= oldDiff {
+		t.Fatal(diffMsg)
+	}
+	if msg := client.read(); msg["method"] != "mining.notify" {
+		t.Fatal(msg)
+	}
+
+	_ = cliConn.Close()
+	server.removeSession(ss)
+	if stats := server.WorkerStats(); stats[0].Connections != 0 {
+		t.Fatal("connection not released")
+	}
+}
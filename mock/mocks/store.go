@@ -22,6 +22,36 @@ type Store struct {
 	mock.Mock
 }
 
+// AccountHistory provides a mock function with given fields: address, filter, cursor, limit
+func (_m *Store) AccountHistory(address types.Address, filter *ledger.HistoryFilter, cursor string, limit int) ([]*ledger.HistoryEntry, string, error) {
+	ret := _m.Called(address, filter, cursor, limit)
+
+	var r0 []*ledger.HistoryEntry
+	if rf, ok := ret.Get(0).(func(types.Address, *ledger.HistoryFilter, string, int) []*ledger.HistoryEntry); ok {
+		r0 = rf(address, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledger.HistoryEntry)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(types.Address, *ledger.HistoryFilter, string, int) string); ok {
+		r1 = rf(address, filter, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Address, *ledger.HistoryFilter, string, int) error); ok {
+		r2 = rf(address, filter, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Action provides a mock function with given fields: at, t
 func (_m *Store) Action(at storage.ActionType, t int) (interface{}, error) {
 	ret := _m.Called(at, t)
@@ -129,6 +159,62 @@ func (_m *Store) AddBlockPrivatePayload(hash types.Hash, payload []byte) error {
 	return r0
 }
 
+// AddBlockVmLogs provides a mock function with given fields: block, logs, c
+func (_m *Store) AddBlockVmLogs(block *types.StateBlock, logs *types.VmLogs, c storage.Cache) error {
+	ret := _m.Called(block, logs, c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StateBlock, *types.VmLogs, storage.Cache) error); ok {
+		r0 = rf(block, logs, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddCDRBatch provides a mock function with given fields: contract, party, seq, batch, records
+func (_m *Store) AddCDRBatch(contract types.Address, party types.Address, seq uint64, batch []byte, records map[types.Hash][]byte) error {
+	ret := _m.Called(contract, party, seq, batch, records)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, uint64, []byte, map[types.Hash][]byte) error); ok {
+		r0 = rf(contract, party, seq, batch, records)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddCDRRecord provides a mock function with given fields: contract, party, hash, record
+func (_m *Store) AddCDRRecord(contract types.Address, party types.Address, hash types.Hash, record []byte) error {
+	ret := _m.Called(contract, party, hash, record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, types.Hash, []byte) error); ok {
+		r0 = rf(contract, party, hash, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddEquivocation provides a mock function with given fields: value
+func (_m *Store) AddEquivocation(value *types.Equivocation) error {
+	ret := _m.Called(value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.Equivocation) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddFrontier provides a mock function with given fields: frontier, c
 func (_m *Store) AddFrontier(frontier *types.Frontier, c storage.Cache) error {
 	ret := _m.Called(frontier, c)
@@ -206,6 +292,20 @@ func (_m *Store) AddOrUpdateAccountMetaCache(value *types.AccountMeta, batch ...
 	return r0
 }
 
+// AddOrUpdatePeerBan provides a mock function with given fields: value
+func (_m *Store) AddOrUpdatePeerBan(value *types.PeerBan) error {
+	ret := _m.Called(value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.PeerBan) error); ok {
+		r0 = rf(value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddOrUpdatePeerInfo provides a mock function with given fields: value
 func (_m *Store) AddOrUpdatePeerInfo(value *types.PeerInfo) error {
 	ret := _m.Called(value)
@@ -423,6 +523,20 @@ func (_m *Store) AddPovTxLookupInBatch(txHash types.Hash, txLookup *types.PovTxL
 	return r0
 }
 
+// AddPrivatePayloadEnvelope provides a mock function with given fields: enclaveKey, envelope
+func (_m *Store) AddPrivatePayloadEnvelope(enclaveKey []byte, envelope []byte) error {
+	ret := _m.Called(enclaveKey, envelope)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, []byte) error); ok {
+		r0 = rf(enclaveKey, envelope)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddRepresentation provides a mock function with given fields: address, diff, c
 func (_m *Store) AddRepresentation(address types.Address, diff *types.Benefit, c storage.Cache) error {
 	ret := _m.Called(address, diff, c)
@@ -825,6 +939,27 @@ func (_m *Store) CountBlocksCache() (uint64, error) {
 	return r0, r1
 }
 
+// CountCDRPendings provides a mock function with given fields: contract, party
+func (_m *Store) CountCDRPendings(contract types.Address, party types.Address) (uint64, error) {
+	ret := _m.Called(contract, party)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address) uint64); ok {
+		r0 = rf(contract, party)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Address) error); ok {
+		r1 = rf(contract, party)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountFrontiers provides a mock function with given fields:
 func (_m *Store) CountFrontiers() (uint64, error) {
 	ret := _m.Called()
@@ -1189,6 +1324,34 @@ func (_m *Store) DeleteBlockPrivatePayload(hash types.Hash) error {
 	return r0
 }
 
+// DeleteBlockVmLogs provides a mock function with given fields: hash, c
+func (_m *Store) DeleteBlockVmLogs(hash types.Hash, c storage.Cache) error {
+	ret := _m.Called(hash, c)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Hash, storage.Cache) error); ok {
+		r0 = rf(hash, c)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEquivocation provides a mock function with given fields: account, key
+func (_m *Store) DeleteEquivocation(account types.Address, key types.Hash) error {
+	ret := _m.Called(account, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, types.Hash) error); ok {
+		r0 = rf(account, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteFrontier provides a mock function with given fields: key, c
 func (_m *Store) DeleteFrontier(key types.Hash, c storage.Cache) error {
 	ret := _m.Called(key, c)
@@ -1245,6 +1408,20 @@ func (_m *Store) DeleteGapPublishBlock(key types.Hash, blkHash types.Hash) error
 	return r0
 }
 
+// DeletePeerBan provides a mock function with given fields: peerID
+func (_m *Store) DeletePeerBan(peerID string) error {
+	ret := _m.Called(peerID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(peerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePending provides a mock function with given fields: key, c
 func (_m *Store) DeletePending(key *types.PendingKey, c storage.Cache) error {
 	ret := _m.Called(key, c)
@@ -2057,6 +2234,110 @@ func (_m *Store) GetBlockPrivatePayload(hash types.Hash) ([]byte, error) {
 	return r0, r1
 }
 
+// GetBlockVmLogs provides a mock function with given fields: hash, c
+func (_m *Store) GetBlockVmLogs(hash types.Hash, c ...storage.Cache) (*types.VmLogs, error) {
+	_va := make([]interface{}, len(c))
+	for _i := range c {
+		_va[_i] = c[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, hash)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.VmLogs
+	if rf, ok := ret.Get(0).(func(types.Hash, ...storage.Cache) *types.VmLogs); ok {
+		r0 = rf(hash, c...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.VmLogs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Hash, ...storage.Cache) error); ok {
+		r1 = rf(hash, c...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCDRBatch provides a mock function with given fields: contract, party, seq
+func (_m *Store) GetCDRBatch(contract types.Address, party types.Address, seq uint64) ([]byte, error) {
+	ret := _m.Called(contract, party, seq)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, uint64) []byte); ok {
+		r0 = rf(contract, party, seq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Address, uint64) error); ok {
+		r1 = rf(contract, party, seq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCDRBatches provides a mock function with given fields: contract, party, fn
+func (_m *Store) GetCDRBatches(contract types.Address, party types.Address, fn func(uint64, []byte) error) error {
+	ret := _m.Called(contract, party, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, func(uint64, []byte) error) error); ok {
+		r0 = rf(contract, party, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCDRPendings provides a mock function with given fields: contract, party, fn
+func (_m *Store) GetCDRPendings(contract types.Address, party types.Address, fn func(types.Hash, []byte) error) error {
+	ret := _m.Called(contract, party, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, func(types.Hash, []byte) error) error); ok {
+		r0 = rf(contract, party, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCDRRecord provides a mock function with given fields: contract, party, hash
+func (_m *Store) GetCDRRecord(contract types.Address, party types.Address, hash types.Hash) ([]byte, error) {
+	ret := _m.Called(contract, party, hash)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, types.Hash) []byte); ok {
+		r0 = rf(contract, party, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Address, types.Hash) error); ok {
+		r1 = rf(contract, party, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCacheStat provides a mock function with given fields:
 func (_m *Store) GetCacheStat() []*ledger.CacheStat {
 	ret := _m.Called()
@@ -2089,6 +2370,62 @@ func (_m *Store) GetCacheStatue() map[string]string {
 	return r0
 }
 
+// GetEquivocations provides a mock function with given fields: fn
+func (_m *Store) GetEquivocations(fn func(*types.Equivocation) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*types.Equivocation) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetEquivocationsByAccount provides a mock function with given fields: account, fn
+func (_m *Store) GetEquivocationsByAccount(account types.Address, fn func(*types.Equivocation) error) error {
+	ret := _m.Called(account, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Address, func(*types.Equivocation) error) error); ok {
+		r0 = rf(account, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFeeCredit provides a mock function with given fields: address, c
+func (_m *Store) GetFeeCredit(address types.Address, c ...storage.Cache) (types.Balance, error) {
+	_va := make([]interface{}, len(c))
+	for _i := range c {
+		_va[_i] = c[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, address)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Balance
+	if rf, ok := ret.Get(0).(func(types.Address, ...storage.Cache) types.Balance); ok {
+		r0 = rf(address, c...)
+	} else {
+		r0 = ret.Get(0).(types.Balance)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, ...storage.Cache) error); ok {
+		r1 = rf(address, c...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFrontier provides a mock function with given fields: hash, cache
 func (_m *Store) GetFrontier(hash types.Hash, cache ...storage.Cache) (*types.Frontier, error) {
 	_va := make([]interface{}, len(cache))
@@ -2305,6 +2642,29 @@ func (_m *Store) GetLatestPovMinerStat(batch ...storage.Batch) (*types.PovMinerD
 	return r0, r1
 }
 
+// GetLogs provides a mock function with given fields: filter
+func (_m *Store) GetLogs(filter *ledger.VmLogFilter) ([]*ledger.VmLogEntry, error) {
+	ret := _m.Called(filter)
+
+	var r0 []*ledger.VmLogEntry
+	if rf, ok := ret.Get(0).(func(*ledger.VmLogFilter) []*ledger.VmLogEntry); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledger.VmLogEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ledger.VmLogFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetObject provides a mock function with given fields: k, c
 func (_m *Store) GetObject(k []byte, c ...storage.Cache) (interface{}, []byte, error) {
 	_va := make([]interface{}, len(c))
@@ -2367,6 +2727,43 @@ func (_m *Store) GetOnlineRepresentations() ([]types.Address, error) {
 	return r0, r1
 }
 
+// GetPeerBan provides a mock function with given fields: peerID
+func (_m *Store) GetPeerBan(peerID string) (*types.PeerBan, error) {
+	ret := _m.Called(peerID)
+
+	var r0 *types.PeerBan
+	if rf, ok := ret.Get(0).(func(string) *types.PeerBan); ok {
+		r0 = rf(peerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.PeerBan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(peerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPeerBans provides a mock function with given fields: fn
+func (_m *Store) GetPeerBans(fn func(*types.PeerBan) error) error {
+	ret := _m.Called(fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*types.PeerBan) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetPeerInfo provides a mock function with given fields: peerID
 func (_m *Store) GetPeerInfo(peerID string) (*types.PeerInfo, error) {
 	ret := _m.Called(peerID)
@@ -2829,6 +3226,29 @@ func (_m *Store) GetPovTxlScanCursor() (uint64, error) {
 	return r0, r1
 }
 
+// GetPrivatePayloadEnvelope provides a mock function with given fields: enclaveKey
+func (_m *Store) GetPrivatePayloadEnvelope(enclaveKey []byte) ([]byte, error) {
+	ret := _m.Called(enclaveKey)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(enclaveKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(enclaveKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRandomStateBlock provides a mock function with given fields:
 func (_m *Store) GetRandomStateBlock() (*types.StateBlock, error) {
 	ret := _m.Called()
@@ -2866,6 +3286,27 @@ func (_m *Store) GetRelation(dest interface{}, query string) error {
 	return r0
 }
 
+// GetReservedFee provides a mock function with given fields: address
+func (_m *Store) GetReservedFee(address types.Address) (types.Balance, error) {
+	ret := _m.Called(address)
+
+	var r0 types.Balance
+	if rf, ok := ret.Get(0).(func(types.Address) types.Balance); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(types.Balance)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRepresentation provides a mock function with given fields: key, c
 func (_m *Store) GetRepresentation(key types.Address, c ...storage.Cache) (*types.Benefit, error) {
 	_va := make([]interface{}, len(c))
@@ -3358,6 +3799,48 @@ func (_m *Store) HasBlockCache(key types.Hash) (bool, error) {
 	return r0, r1
 }
 
+// HasCDRRecord provides a mock function with given fields: contract, party, hash
+func (_m *Store) HasCDRRecord(contract types.Address, party types.Address, hash types.Hash) (bool, error) {
+	ret := _m.Called(contract, party, hash)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Address, types.Address, types.Hash) bool); ok {
+		r0 = rf(contract, party, hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Address, types.Hash) error); ok {
+		r1 = rf(contract, party, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasEquivocation provides a mock function with given fields: account, key
+func (_m *Store) HasEquivocation(account types.Address, key types.Hash) (bool, error) {
+	ret := _m.Called(account, key)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Address, types.Hash) bool); ok {
+		r0 = rf(account, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Address, types.Hash) error); ok {
+		r1 = rf(account, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HasPovBlock provides a mock function with given fields: height, hash, batch
 func (_m *Store) HasPovBlock(height uint64, hash types.Hash, batch ...storage.Batch) bool {
 	_va := make([]interface{}, len(batch))
@@ -3964,6 +4447,36 @@ func (_m *Store) SubRepresentation(address types.Address, diff *types.Benefit, c
 	return r0
 }
 
+// TokenTransfers provides a mock function with given fields: token, filter, cursor, limit
+func (_m *Store) TokenTransfers(token types.Hash, filter *ledger.HistoryFilter, cursor string, limit int) ([]*ledger.HistoryEntry, string, error) {
+	ret := _m.Called(token, filter, cursor, limit)
+
+	var r0 []*ledger.HistoryEntry
+	if rf, ok := ret.Get(0).(func(types.Hash, *ledger.HistoryFilter, string, int) []*ledger.HistoryEntry); ok {
+		r0 = rf(token, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ledger.HistoryEntry)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(types.Hash, *ledger.HistoryFilter, string, int) string); ok {
+		r1 = rf(token, filter, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(types.Hash, *ledger.HistoryFilter, string, int) error); ok {
+		r2 = rf(token, filter, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // UpdateAccountMeta provides a mock function with given fields: value, c
 func (_m *Store) UpdateAccountMeta(value *types.AccountMeta, c storage.Cache) error {
 	ret := _m.Called(value, c)
@@ -0,0 +1,136 @@
+package p2p
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync/atomic"
+
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
+)
+
+// compression is the payload codec of qlc message, it is carried in the high bits of the version byte
+type compression byte
+
+const (
+	compressionNone compression = iota
+	compressionSnappy
+	compressionZstd
+)
+
+// MaxDecompressedDataLength limits the payload size after decompression
+const MaxDecompressedDataLength = 64 * 1024 * 1024
+
+var (
+	ErrInvalidCompression    = errors.New("invalid compression")
+	ErrDecompressedTooLarge  = errors.New("decompressed data is too large")
+	ErrInvalidCompressedData = errors.New("invalid compressed data")
+)
+
+var compressionNames = map[compression]string{
+	compressionNone:   "none",
+	compressionSnappy: "snappy",
+	compressionZstd:   "zstd",
+}
+
+func (c compression) String() string {
+	if name, ok := compressionNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", c)
+}
+
+func parseCompression(name string) (compression, error) {
+	for c, n := range compressionNames {
+		if n == strings.ToLower(name) {
+			return c, nil
+		}
+	}
+	return compressionNone, fmt.Errorf("unsupported compression %s", name)
+}
+
+func compress(c compression, data []byte) ([]byte, error) {
+	switch c {
+	case compressionNone:
+		return data, nil
+	case compressionSnappy:
+		return snappy.Encode(nil, data), nil
+	case compressionZstd:
+		return zstd.Compress(nil, data)
+	default:
+		return nil, ErrInvalidCompression
+	}
+}
+
+func decompress(c compression, data []byte) ([]byte, error) {
+	switch c {
+	case compressionNone:
+		return data, nil
+	case compressionSnappy:
+		n, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, err
+		}
+		if n > MaxDecompressedDataLength {
+			return nil, ErrDecompressedTooLarge
+		}
+		return snappy.Decode(nil, data)
+	case compressionZstd:
+		r := zstd.NewReader(bytes.NewReader(data))
+		defer r.Close()
+		out, err := ioutil.ReadAll(io.LimitReader(r, MaxDecompressedDataLength+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(out) > MaxDecompressedDataLength {
+			return nil, ErrDecompressedTooLarge
+		}
+		// the reader returns nothing without error for data which is not a zstd frame,
+		// and empty payload is never sent compressed
+		if len(out) == 0 {
+			return nil, ErrInvalidCompressedData
+		}
+		return out, nil
+	default:
+		return nil, ErrInvalidCompression
+	}
+}
+
+// negotiateCompression returns the first local preferred compression which is supported by remote
+func negotiateCompression(local []compression, remote []string) compression {
+	for _, c := range local {
+		for _, name := range remote {
+			if rc, err := parseCompression(name); err == nil && rc == c {
+				return c
+			}
+		}
+	}
+	return compressionNone
+}
+
+// compressionStats counts the payload bytes of compressed messages on wire and after decompression
+type compressionStats struct {
+	compressedIn    int64
+	compressedOut   int64
+	uncompressedIn  int64
+	uncompressedOut int64
+}
+
+func (cs *compressionStats) addIn(compressed, uncompressed int) {
+	atomic.AddInt64(&cs.compressedIn, int64(compressed))
+	atomic.AddInt64(&cs.uncompressedIn, int64(uncompressed))
+}
+
+func (cs *compressionStats) addOut(compressed, uncompressed int) {
+	atomic.AddInt64(&cs.compressedOut, int64(compressed))
+	atomic.AddInt64(&cs.uncompressedOut, int64(uncompressed))
+}
+
+func (cs *compressionStats) load() (compressedIn, compressedOut, uncompressedIn, uncompressedOut int64) {
+	return atomic.LoadInt64(&cs.compressedIn), atomic.LoadInt64(&cs.compressedOut),
+		atomic.LoadInt64(&cs.uncompressedIn), atomic.LoadInt64(&cs.uncompressedOut)
+}
@@ -0,0 +1,148 @@
+package p2p
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+func TestCompression(t *testing.T) {
+	data := bytes.Repeat([]byte("qlc chain block data "), 100)
+	for _, c := range []compression{compressionNone, compressionSnappy, compressionZstd} {
+		compressed, err := compress(c, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c != compressionNone && len(compressed) >= len(data) {
+			t.Fatalf("%s is not compressed", c)
+		}
+		decompressed, err := decompress(c, compressed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, decompressed) {
+			t.Fatalf("%s decompress error", c)
+		}
+		if c != compressionNone {
+			if _, err := decompress(c, []byte{0xff, 0xff, 0xff, 0xff}); err == nil {
+				t.Fatalf("%s invalid data should fail", c)
+			}
+		}
+	}
+	if _, err := compress(compression(7), data); err != ErrInvalidCompression {
+		t.Fatal(err)
+	}
+}
+
+func TestNegotiateCompression(t *testing.T) {
+	if c, err := parseCompression("ZSTD"); err != nil || c != compressionZstd {
+		t.Fatal(c, err)
+	}
+	if _, err := parseCompression("lz4"); err == nil {
+		t.Fatal("lz4 should not be supported")
+	}
+
+	local := []compression{compressionZstd, compressionSnappy}
+	if c := negotiateCompression(local, []string{"snappy", "zstd"}); c != compressionZstd {
+		t.Fatal(c)
+	}
+	if c := negotiateCompression(local, []string{"lz4", "snappy"}); c != compressionSnappy {
+		t.Fatal(c)
+	}
+	if c := negotiateCompression(local, nil); c != compressionNone {
+		t.Fatal(c)
+	}
+	if c := negotiateCompression(nil, []string{"zstd"}); c != compressionNone {
+		t.Fatal(c)
+	}
+}
+
+func TestQlcMessage_Compression(t *testing.T) {
+	data := bytes.Repeat([]byte("bulk pull blocks "), 100)
+	plain := NewQlcMessage(data, p2pVersion, BulkPullRsp)
+
+	for _, c := range []compression{compressionSnappy, compressionZstd} {
+		compressed, _ := compress(c, data)
+		content := newQlcMessage(compressed, p2pVersion, BulkPullRsp, c)
+
+		message, err := ParseQlcMessage(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if message.Version() != p2pVersion || message.compression() != c || message.MessageType() != BulkPullRsp {
+			t.Fatal("invalid message header")
+		}
+		if err := message.ParseMessageData(content[QlcMessageHeaderLength:]); err != nil {
+			t.Fatal(err)
+		}
+		// decompressed message is same as the uncompressed one, so the message hash does not change
+		if !bytes.Equal(message.content, plain) || !bytes.Equal(message.MessageData(), data) {
+			t.Fatalf("%s message content error", c)
+		}
+	}
+
+	content := NewQlcMessage(data, p2pVersion, BulkPullRsp)
+	content[QlcMessageMagicNumberEndIdx] |= 7 << QlcMessageCompressionShift
+	if _, err := ParseQlcMessage(content); err != ErrInvalidCompression {
+		t.Fatal(err)
+	}
+}
+
+func TestStream_Handshake(t *testing.T) {
+	node := &QlcNode{
+		logger:          log.NewLogger("p2p_test"),
+		compressions:    []compression{compressionZstd, compressionSnappy},
+		compressMinSize: 256,
+	}
+	s := newStreamInstance("", nil, nil, node)
+
+	data := bytes.Repeat([]byte("bulk pull blocks "), 100)
+
+	// messages to peer without handshake are not compressed
+	content := s.newQlcMessage(data, BulkPullRsp)
+	if !bytes.Equal(content, NewQlcMessage(data, p2pVersion, BulkPullRsp)) {
+		t.Fatal("message should not be compressed")
+	}
+
+	hs, _ := protos.HandshakeToProto(&protos.HandshakePacket{
+		Version:      uint32(p2pVersion),
+		MinVersion:   uint32(p2pMinVersion),
+		Compressions: []string{"snappy"},
+	})
+	s.onHandshake(hs)
+	if s.version != p2pVersion || s.getCompression() != compressionSnappy {
+		t.Fatal("handshake error")
+	}
+
+	content = s.newQlcMessage(data, BulkPullRsp)
+	message, err := ParseQlcMessage(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.compression() != compressionSnappy || len(content) >= QlcMessageHeaderLength+len(data) {
+		t.Fatal("message should be compressed")
+	}
+	compressedIn, compressedOut, uncompressedIn, uncompressedOut := node.compressStats.load()
+	if compressedIn != 0 || uncompressedIn != 0 || compressedOut != int64(message.DataLength()) || uncompressedOut != int64(len(data)) {
+		t.Fatal("compression stats error")
+	}
+
+	// small message is not compressed
+	content = s.newQlcMessage([]byte("ping"), PublishReq)
+	if message, _ := ParseQlcMessage(content); message.compression() != compressionNone {
+		t.Fatal("small message should not be compressed")
+	}
+
+	// old peer does not support compression
+	hs, _ = protos.HandshakeToProto(&protos.HandshakePacket{
+		Version:      uint32(p2pMinVersion),
+		MinVersion:   uint32(p2pMinVersion),
+		Compressions: []string{"zstd"},
+	})
+	s.onHandshake(hs)
+	if s.version != p2pMinVersion || s.getCompression() != compressionNone {
+		t.Fatal("compression should be disabled for old version")
+	}
+}
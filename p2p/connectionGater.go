@@ -13,18 +13,30 @@ type WhiteList struct {
 }
 
 type ConnectionGater struct {
-	whiteList []WhiteList
+	whiteListEnable bool
+	whiteList       []WhiteList
+	scorer          *PeerScorer
 }
 
-func NewConnectionGater() *ConnectionGater {
-	return &ConnectionGater{}
+func NewConnectionGater(whiteListEnable bool) *ConnectionGater {
+	return &ConnectionGater{whiteListEnable: whiteListEnable}
 }
 
-func (cg *ConnectionGater) InterceptPeerDial(peer.ID) bool {
-	return true
+func (cg *ConnectionGater) isBanned(p peer.ID) bool {
+	return cg.scorer != nil && cg.scorer.IsBanned(p.Pretty())
+}
+
+func (cg *ConnectionGater) InterceptPeerDial(p peer.ID) bool {
+	return !cg.isBanned(p)
 }
 
 func (cg *ConnectionGater) InterceptAddrDial(p peer.ID, addr ma.Multiaddr) bool {
+	if cg.isBanned(p) {
+		return false
+	}
+	if !cg.whiteListEnable {
+		return true
+	}
 	var allow bool
 	for _, v := range cg.whiteList {
 		if p == v.id {
@@ -46,10 +58,10 @@ func (cg *ConnectionGater) InterceptAccept(network.ConnMultiaddrs) bool {
 	return true
 }
 
-func (cg *ConnectionGater) InterceptSecured(network.Direction, peer.ID, network.ConnMultiaddrs) bool {
-	return true
+func (cg *ConnectionGater) InterceptSecured(_ network.Direction, p peer.ID, _ network.ConnMultiaddrs) bool {
+	return !cg.isBanned(p)
 }
 
-func (cg *ConnectionGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
-	return true, 0
+func (cg *ConnectionGater) InterceptUpgraded(c network.Conn) (bool, control.DisconnectReason) {
+	return !cg.isBanned(c.RemotePeer()), 0
 }
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"sync"
+	"sync/atomic"
 
 	lru "github.com/hashicorp/golang-lru"
 	"go.uber.org/zap"
@@ -18,6 +19,7 @@ type Dispatcher struct {
 	syncMessageCh      chan *Message
 	dispatchedMessages *lru.Cache
 	logger             *zap.SugaredLogger
+	dropped            int64
 }
 
 // NewDispatcher create Dispatcher instance.
@@ -75,6 +77,7 @@ func (dp *Dispatcher) loop() {
 			select {
 			case v.(*Subscriber).msgChan <- msg:
 			default:
+				atomic.AddInt64(&dp.dropped, 1)
 				dp.logger.Debug("timeout to dispatch message.")
 			}
 		case msg := <-dp.syncMessageCh:
@@ -88,6 +91,7 @@ func (dp *Dispatcher) loop() {
 			select {
 			case v.(*Subscriber).msgChan <- msg:
 			default:
+				atomic.AddInt64(&dp.dropped, 1)
 				dp.logger.Debug("timeout to dispatch message.")
 			}
 		}
@@ -106,6 +110,7 @@ func (dp *Dispatcher) PutMessage(msg *Message) {
 	select {
 	case dp.receivedMessageCh <- msg:
 	default:
+		atomic.AddInt64(&dp.dropped, 1)
 		dp.logger.Debugf("dispatcher receive message chan expire")
 	}
 }
@@ -115,6 +120,12 @@ func (dp *Dispatcher) PutSyncMessage(msg *Message) {
 	select {
 	case dp.syncMessageCh <- msg:
 	default:
+		atomic.AddInt64(&dp.dropped, 1)
 		dp.logger.Debugf("dispatcher sync message chan expire")
 	}
 }
+
+// Dropped returns the number of messages dropped because the queues are full
+func (dp *Dispatcher) Dropped() int64 {
+	return atomic.LoadInt64(&dp.dropped)
+}
@@ -0,0 +1,62 @@
+package p2p
+
+import (
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+var lightMessageTypes = []MessageType{
+	LightHeaderReq, LightHeaderRsp, LightAccountReq, LightAccountRsp, LightBlocksReq, LightBlocksRsp,
+}
+
+// onLightMessage decodes messages between light node and full node, requests are served by full node,
+// responses are consumed by light node, both are dispatched by event bus
+func (ms *MessageService) onLightMessage(message *Message) {
+	var t topic.TopicType
+	var msg interface{}
+	var err error
+
+	from := message.MessageFrom()
+	switch message.MessageType() {
+	case LightHeaderReq:
+		var req *protos.LightHeaderReq
+		if req, err = protos.LightHeaderReqFromProto(message.Data()); err == nil {
+			t, msg = topic.EventLightHeaderReq, &EventLightHeaderReqMsg{Req: req, From: from}
+		}
+	case LightHeaderRsp:
+		var rsp *protos.LightHeaderRsp
+		if rsp, err = protos.LightHeaderRspFromProto(message.Data()); err == nil {
+			t, msg = topic.EventLightHeaderRsp, &EventLightHeaderRspMsg{Rsp: rsp, From: from}
+		}
+	case LightAccountReq:
+		var req *protos.LightAccountReq
+		if req, err = protos.LightAccountReqFromProto(message.Data()); err == nil {
+			t, msg = topic.EventLightAccountReq, &EventLightAccountReqMsg{Req: req, From: from}
+		}
+	case LightAccountRsp:
+		var rsp *protos.LightAccountRsp
+		if rsp, err = protos.LightAccountRspFromProto(message.Data()); err == nil {
+			t, msg = topic.EventLightAccountRsp, &EventLightAccountRspMsg{Rsp: rsp, From: from}
+		}
+	case LightBlocksReq:
+		var req *protos.LightBlocksReq
+		if req, err = protos.LightBlocksReqFromProto(message.Data()); err == nil {
+			t, msg = topic.EventLightBlocksReq, &EventLightBlocksReqMsg{Req: req, From: from}
+		}
+	case LightBlocksRsp:
+		var rsp *protos.LightBlocksRsp
+		if rsp, err = protos.LightBlocksRspFromProto(message.Data()); err == nil {
+			t, msg = topic.EventLightBlocksRsp, &EventLightBlocksRspMsg{Rsp: rsp, From: from}
+		}
+	default:
+		ms.netService.node.logger.Warn("Received unknown light message.")
+		return
+	}
+
+	if err != nil {
+		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
+		return
+	}
+	ms.netService.msgEvent.Publish(t, msg)
+}
@@ -17,6 +17,10 @@ const (
 	QlcMessageTypeEndIdx         = 5
 	QlcMessageDataLengthEndIdx   = 9
 	QlcMessageDataCheckSumEndIdx = 13
+
+	// the version byte keeps protocol version in low bits and payload compression in high bits
+	QlcMessageVersionMask      = 0x1f
+	QlcMessageCompressionShift = 5
 )
 
 // Error types
@@ -38,7 +42,11 @@ func (message *QlcMessage) MagicNumber() []byte {
 }
 
 func (message *QlcMessage) Version() byte {
-	return message.content[QlcMessageMagicNumberEndIdx]
+	return message.content[QlcMessageMagicNumberEndIdx] & QlcMessageVersionMask
+}
+
+func (message *QlcMessage) compression() compression {
+	return compression(message.content[QlcMessageMagicNumberEndIdx] >> QlcMessageCompressionShift)
 }
 
 func (message *QlcMessage) MessageType() MessageType {
@@ -66,12 +74,17 @@ func (message *QlcMessage) HeaderData() []byte {
 
 // NewQlcMessage new qlc message
 func NewQlcMessage(data []byte, currentVersion byte, messageType MessageType) []byte {
+	return newQlcMessage(data, currentVersion, messageType, compressionNone)
+}
+
+// newQlcMessage frames data which is already compressed by c
+func newQlcMessage(data []byte, currentVersion byte, messageType MessageType, c compression) []byte {
 	message := &QlcMessage{
 		content: make([]byte, QlcMessageHeaderLength+len(data)),
 	}
 	// copy header.
 	copy(message.content[0:QlcMessageMagicNumberEndIdx], MagicNumber)
-	message.content[QlcMessageMagicNumberEndIdx] = currentVersion
+	message.content[QlcMessageMagicNumberEndIdx] = currentVersion&QlcMessageVersionMask | byte(c)<<QlcMessageCompressionShift
 	message.content[QlcMessageVersionEndIdx] = byte(messageType)
 
 	//copy datalength
@@ -109,7 +122,25 @@ func (message *QlcMessage) ParseMessageData(data []byte) error {
 		return ErrInvalidMessageDataLength
 	}
 	message.content = append(message.content, data[:message.DataLength()]...)
-	return message.VerifyData()
+	if err := message.VerifyData(); err != nil {
+		return err
+	}
+	return message.decompress()
+}
+
+// decompress replaces the compressed payload with the original one, so the message content
+// is the same as the content sent without compression
+func (message *QlcMessage) decompress() error {
+	c := message.compression()
+	if c == compressionNone {
+		return nil
+	}
+	data, err := decompress(c, message.MessageData())
+	if err != nil {
+		return err
+	}
+	message.content = NewQlcMessage(data, message.Version(), message.MessageType())
+	return nil
 }
 
 //VerifyHeader verify qlc message header
@@ -117,6 +148,9 @@ func (message *QlcMessage) VerifyHeader() error {
 	if !Equal(MagicNumber, message.MagicNumber()) {
 		return ErrInvalidMagicNumber
 	}
+	if _, ok := compressionNames[message.compression()]; !ok {
+		return ErrInvalidCompression
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -42,8 +43,52 @@ const (
 	PovPublishReq
 	PovBulkPullReq
 	PovBulkPullRsp
+	LightHeaderReq
+	LightHeaderRsp
+	LightAccountReq
+	LightAccountRsp
+	LightBlocksReq
+	LightBlocksRsp
+	Handshake
+	PrivacyPayloadPush
+	PrivacyPayloadReq
+	PrivacyKeyAnnounce
 )
 
+var messageTypeNames = map[MessageType]string{
+	PublishReq:      "PublishReq",
+	ConfirmReq:      "ConfirmReq",
+	ConfirmAck:      "ConfirmAck",
+	FrontierRequest: "FrontierRequest",
+	FrontierRsp:     "FrontierRsp",
+	BulkPullRequest: "BulkPullRequest",
+	BulkPullRsp:     "BulkPullRsp",
+	BulkPushBlock:   "BulkPushBlock",
+	MessageResponse: "MessageResponse",
+	PovStatus:       "PovStatus",
+	PovPublishReq:   "PovPublishReq",
+	PovBulkPullReq:  "PovBulkPullReq",
+	PovBulkPullRsp:  "PovBulkPullRsp",
+	LightHeaderReq:  "LightHeaderReq",
+	LightHeaderRsp:  "LightHeaderRsp",
+	LightAccountReq: "LightAccountReq",
+	LightAccountRsp: "LightAccountRsp",
+	LightBlocksReq:  "LightBlocksReq",
+	LightBlocksRsp:  "LightBlocksRsp",
+	Handshake:       "Handshake",
+
+	PrivacyPayloadPush: "PrivacyPayloadPush",
+	PrivacyPayloadReq:  "PrivacyPayloadReq",
+	PrivacyKeyAnnounce: "PrivacyKeyAnnounce",
+}
+
+func (t MessageType) String() string {
+	if name, ok := messageTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", byte(t))
+}
+
 type MessageService struct {
 	netService          *QlcService
 	ctx                 context.Context
@@ -54,6 +99,8 @@ type MessageService struct {
 	confirmAckMessageCh chan *Message
 	rspMessageCh        chan *Message
 	povMessageCh        chan *Message
+	lightMessageCh      chan *Message
+	privacyMessageCh    chan *Message
 	ledger              ledger.Store
 	syncService         *ServiceSync
 	pullRspMap          *sync.Map
@@ -71,6 +118,8 @@ func NewMessageService(netService *QlcService, ledger ledger.Store) *MessageServ
 		confirmAckMessageCh: make(chan *Message, common.P2PMonitorMsgChanSize),
 		rspMessageCh:        make(chan *Message, common.P2PMonitorMsgChanSize),
 		povMessageCh:        make(chan *Message, common.P2PMonitorMsgChanSize),
+		lightMessageCh:      make(chan *Message, common.P2PMonitorMsgChanSize),
+		privacyMessageCh:    make(chan *Message, common.P2PMonitorMsgChanSize),
 		ledger:              ledger,
 		netService:          netService,
 		pullRspMap:          new(sync.Map),
@@ -97,6 +146,14 @@ func (ms *MessageService) Start() {
 	netService.Register(NewSubscriber(ms.povMessageCh, PovPublishReq))
 	netService.Register(NewSubscriber(ms.povMessageCh, PovBulkPullReq))
 	netService.Register(NewSubscriber(ms.povMessageCh, PovBulkPullRsp))
+	// light client message handlers
+	for _, t := range lightMessageTypes {
+		netService.Register(NewSubscriber(ms.lightMessageCh, t))
+	}
+	// private payload message handlers
+	netService.Register(NewSubscriber(ms.privacyMessageCh, PrivacyPayloadPush))
+	netService.Register(NewSubscriber(ms.privacyMessageCh, PrivacyPayloadReq))
+	netService.Register(NewSubscriber(ms.privacyMessageCh, PrivacyKeyAnnounce))
 	// start loop().
 	go ms.startLoop()
 	go ms.syncService.Start()
@@ -104,6 +161,8 @@ func (ms *MessageService) Start() {
 	go ms.confirmReqLoop()
 	go ms.confirmAckLoop()
 	go ms.povMessageLoop()
+	go ms.lightMessageLoop()
+	go ms.privacyMessageLoop()
 	//	go ms.processBlockCacheLoop()
 	go ms.messageResponseLoop()
 }
@@ -115,40 +174,44 @@ func (ms *MessageService) startLoop() {
 		case <-ms.ctx.Done():
 			return
 		case message := <-ms.messageCh:
-			switch message.MessageType() {
-			case FrontierRequest:
-				go func() {
-					if err := ms.syncService.onFrontierReq(message); err != nil {
-						ms.netService.node.logger.Error(err)
-					}
-				}()
-			case FrontierRsp:
-				go ms.syncService.checkFrontier(message)
-			case BulkPullRequest:
-				go func() {
-					if err := ms.syncService.onBulkPullRequest(message); err != nil {
-						ms.netService.node.logger.Error(err)
-					}
-				}()
-			case BulkPullRsp:
-				go func() {
-					if err := ms.syncService.onBulkPullRsp(message); err != nil {
-						ms.netService.node.logger.Error(err)
-					}
-				}()
-			case BulkPushBlock:
-				go func() {
-					if err := ms.syncService.onBulkPushBlock(message); err != nil {
-						ms.netService.node.logger.Error(err)
-					}
-				}()
-			default:
-				ms.netService.node.logger.Error("Received unknown message.")
+			limiter := ms.netService.node.limiter
+			if !limiter.acquire() {
+				ms.netService.node.logger.Debugf("inbound queue is full, drop %s from %s", message.MessageType(), message.MessageFrom())
+				continue
 			}
+			go func() {
+				defer limiter.release()
+				ms.processSyncMessage(message)
+			}()
 		}
 	}
 }
 
+func (ms *MessageService) processSyncMessage(message *Message) {
+	switch message.MessageType() {
+	case FrontierRequest:
+		if err := ms.syncService.onFrontierReq(message); err != nil {
+			ms.netService.node.logger.Error(err)
+		}
+	case FrontierRsp:
+		ms.syncService.checkFrontier(message)
+	case BulkPullRequest:
+		if err := ms.syncService.onBulkPullRequest(message); err != nil {
+			ms.netService.node.logger.Error(err)
+		}
+	case BulkPullRsp:
+		if err := ms.syncService.onBulkPullRsp(message); err != nil {
+			ms.netService.node.logger.Error(err)
+		}
+	case BulkPushBlock:
+		if err := ms.syncService.onBulkPushBlock(message); err != nil {
+			ms.netService.node.logger.Error(err)
+		}
+	default:
+		ms.netService.node.logger.Error("Received unknown message.")
+	}
+}
+
 func (ms *MessageService) messageResponseLoop() {
 	for {
 		select {
@@ -227,10 +290,33 @@ func (ms *MessageService) povMessageLoop() {
 	}
 }
 
+func (ms *MessageService) lightMessageLoop() {
+	for {
+		select {
+		case <-ms.ctx.Done():
+			return
+		case message := <-ms.lightMessageCh:
+			ms.onLightMessage(message)
+		}
+	}
+}
+
+func (ms *MessageService) privacyMessageLoop() {
+	for {
+		select {
+		case <-ms.ctx.Done():
+			return
+		case message := <-ms.privacyMessageCh:
+			ms.onPrivacyMessage(message)
+		}
+	}
+}
+
 func (ms *MessageService) onMessageResponse(message *Message) {
 	ma, err := protos.MessageAckFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 	if v, ok := ms.pullRspMap.Load(message.from); ok {
@@ -248,6 +334,7 @@ func (ms *MessageService) onPublishReq(message *Message) {
 	p, err := protos.PublishBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventPublish, &topic.EventPublishMsg{Block: p.Blk, From: message.MessageFrom()})
@@ -257,6 +344,7 @@ func (ms *MessageService) onConfirmReq(message *Message) {
 	r, err := protos.ConfirmReqBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Error(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventConfirmReq, &topic.EventConfirmReqMsg{Blocks: r.Blk, From: message.MessageFrom()})
@@ -266,6 +354,7 @@ func (ms *MessageService) onConfirmAck(message *Message) {
 	ack, err := protos.ConfirmAckBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventConfirmAck, &EventConfirmAckMsg{ack, message.MessageFrom()})
@@ -275,6 +364,7 @@ func (ms *MessageService) onPovStatus(message *Message) {
 	status, err := protos.PovStatusFromProto(message.data)
 	if err != nil {
 		ms.netService.node.logger.Errorf("failed to decode PovStatus from peer %s", message.from)
+		ms.penalizeSender(message, err)
 		return
 	}
 	ms.netService.msgEvent.Publish(topic.EventPovPeerStatus,
@@ -285,6 +375,7 @@ func (ms *MessageService) onPovPublishReq(message *Message) {
 	p, err := protos.PovPublishBlockFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 
@@ -299,6 +390,7 @@ func (ms *MessageService) onPovBulkPullReq(message *Message) {
 	req, err := protos.PovBulkPullReqFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 
@@ -310,13 +402,46 @@ func (ms *MessageService) onPovBulkPullRsp(message *Message) {
 	rsp, err := protos.PovBulkPullRspFromProto(message.Data())
 	if err != nil {
 		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
 		return
 	}
 
+	if !ms.netService.node.cfg.IsDevnet() {
+		for _, blk := range rsp.Blocks {
+			if err := verifyPovBlock(blk); err != nil {
+				ms.netService.node.logger.Info(err)
+				ms.netService.node.penalizeSender(message, MisbehaviorInvalidBlock, err.Error())
+				return
+			}
+		}
+	}
+
 	ms.netService.msgEvent.Publish(topic.EventPovBulkPullRsp,
 		&EventPovBulkPullRspMsg{Resp: rsp, From: message.MessageFrom()})
 }
 
+// verifyPovBlock checks the hash and the work of block against its own target, devnet is mined by fake pow,
+// so callers skip it there. The target itself depends on the chain, it is checked by pov verifier.
+func verifyPovBlock(blk *types.PovBlock) error {
+	if blk.GetHeight() == common.PovChainGenesisBlockHeight {
+		return nil
+	}
+	hash := blk.GetHash()
+	if computed := blk.ComputeHash(); hash != computed {
+		return fmt.Errorf("pov block %s has bad hash %s", computed, hash)
+	}
+	powHash := blk.Header.ComputePowHash()
+	if powHash.ToBigInt().Cmp(blk.Header.GetAlgoTargetInt()) > 0 {
+		return fmt.Errorf("pov block %s has bad work", hash)
+	}
+	return nil
+}
+
+// penalizeSender penalizes the peer which sent a malformed message on its stream
+func (ms *MessageService) penalizeSender(message *Message, err error) {
+	ms.netService.node.penalizeSender(message, MisbehaviorMalformedMessage, err.Error())
+}
+
 func (ms *MessageService) Stop() {
 	//ms.netService.node.logger.VInfo("stopped message monitor")
 	// quit.
@@ -335,6 +460,12 @@ func (ms *MessageService) Stop() {
 	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovPublishReq))
 	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovBulkPullReq))
 	ms.netService.Deregister(NewSubscriber(ms.povMessageCh, PovBulkPullRsp))
+	for _, t := range lightMessageTypes {
+		ms.netService.Deregister(NewSubscriber(ms.lightMessageCh, t))
+	}
+	ms.netService.Deregister(NewSubscriber(ms.privacyMessageCh, PrivacyPayloadPush))
+	ms.netService.Deregister(NewSubscriber(ms.privacyMessageCh, PrivacyPayloadReq))
+	ms.netService.Deregister(NewSubscriber(ms.privacyMessageCh, PrivacyKeyAnnounce))
 }
 
 func marshalMessage(messageName MessageType, value interface{}) ([]byte, error) {
@@ -427,6 +558,26 @@ func marshalMessage(messageName MessageType, value interface{}) ([]byte, error)
 			return nil, err
 		}
 		return data, nil
+	case LightHeaderReq:
+		return protos.LightHeaderReqToProto(value.(*protos.LightHeaderReq))
+	case LightHeaderRsp:
+		return protos.LightHeaderRspToProto(value.(*protos.LightHeaderRsp))
+	case LightAccountReq:
+		return protos.LightAccountReqToProto(value.(*protos.LightAccountReq))
+	case LightAccountRsp:
+		return protos.LightAccountRspToProto(value.(*protos.LightAccountRsp))
+	case LightBlocksReq:
+		return protos.LightBlocksReqToProto(value.(*protos.LightBlocksReq))
+	case LightBlocksRsp:
+		return protos.LightBlocksRspToProto(value.(*protos.LightBlocksRsp))
+	case Handshake:
+		return protos.HandshakeToProto(value.(*protos.HandshakePacket))
+	case PrivacyPayloadPush:
+		return protos.PrivacyPayloadPushToProto(value.(*protos.PrivacyPayloadPush))
+	case PrivacyPayloadReq:
+		return protos.PrivacyPayloadReqToProto(value.(*protos.PrivacyPayloadReq))
+	case PrivacyKeyAnnounce:
+		return protos.PrivacyKeyAnnounceToProto(value.(*protos.PrivacyKeyAnnounce))
 	case MessageResponse:
 		rsp := &protos.MessageAckPacket{
 			MessageHash: value.(types.Hash),
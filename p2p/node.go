@@ -40,7 +40,13 @@ var (
 )
 
 // p2p protocol version
-var p2pVersion byte = 8
+var p2pVersion byte = 9
+
+// messages of peers older than p2pMinVersion are dropped
+var p2pMinVersion byte = 8
+
+// p2pCompressionVersion is the first protocol version supports payload compression
+const p2pCompressionVersion byte = 9
 
 type netAttribute byte
 
@@ -89,6 +95,11 @@ type QlcNode struct {
 	reporter         p2pmetrics.Reporter
 	ping             *ping.Pinger
 	connectionGater  *ConnectionGater
+	peerScorer       *PeerScorer
+	compressions     []compression
+	compressMinSize  int
+	compressStats    compressionStats
+	limiter          *RateLimiter
 }
 
 // NewNode return new QlcNode according to the config.
@@ -101,7 +112,7 @@ func NewNode(config *config.Config) (*QlcNode, error) {
 		streamManager:   NewStreamManager(),
 		logger:          log.NewLogger("p2p"),
 		isMiner:         config.PoV.PovEnabled,
-		connectionGater: NewConnectionGater(),
+		connectionGater: NewConnectionGater(config.WhiteList.Enable),
 	}
 	privateKey, err := config.DecodePrivateKey()
 	if err != nil {
@@ -114,6 +125,18 @@ func NewNode(config *config.Config) (*QlcNode, error) {
 		return nil, err
 	}
 	node.reporter = p2pmetrics.NewBandwidthCounter()
+	node.limiter = NewRateLimiter(config.P2PLimit)
+	if codec := config.P2PCodec; codec != nil && codec.Enable {
+		for _, name := range codec.Compressions {
+			c, err := parseCompression(name)
+			if err != nil || c == compressionNone {
+				node.logger.Warnf("ignore p2p compression %s", name)
+				continue
+			}
+			node.compressions = append(node.compressions, c)
+		}
+		node.compressMinSize = codec.MinSize
+	}
 	return node, nil
 }
 
@@ -121,6 +144,44 @@ func (node *QlcNode) setRepresentativeNode(isRepresentative bool) {
 	node.isRepresentative = isRepresentative
 }
 
+func (node *QlcNode) setPeerScorer(scorer *PeerScorer) {
+	node.peerScorer = scorer
+	node.connectionGater.scorer = scorer
+}
+
+// penalizePeer adds the misbehavior to the peer score, and disconnects the peer if it is banned
+func (node *QlcNode) penalizePeer(peerID string, behavior PeerMisbehavior, reason string) {
+	if node.peerScorer == nil {
+		return
+	}
+	if ban := node.peerScorer.Penalize(peerID, behavior, reason); ban != nil {
+		node.disconnectPeer(peerID)
+	}
+}
+
+// penalizeSender penalizes the peer which sent the message on its stream, messages relayed by pubsub
+// are not penalized since the neighbour is not the origin of them
+func (node *QlcNode) penalizeSender(message *Message, behavior PeerMisbehavior, reason string) {
+	if message.relayed {
+		return
+	}
+	node.penalizePeer(message.MessageFrom(), behavior, reason)
+}
+
+func (node *QlcNode) disconnectPeer(peerID string) {
+	if err := node.streamManager.CloseStream(peerID); err != nil {
+		node.logger.Error(err)
+	}
+	if node.host == nil {
+		return
+	}
+	if pid, err := peer.Decode(peerID); err == nil {
+		if err := node.host.Network().ClosePeer(pid); err != nil {
+			node.logger.Debugf("close peer %s: %s", peerID, err)
+		}
+	}
+}
+
 func (node *QlcNode) updateWhiteList(id string, url string) {
 	if node.cfg.WhiteList.Enable {
 		if node.connectionGater != nil {
@@ -158,35 +219,19 @@ func (node *QlcNode) buildHost() error {
 	go node.getBootNode(node.cfg.P2P.BootNodes)
 	node.logger.Info("Start Qlc Host...")
 	sourceMultiAddr, _ := ma.NewMultiaddr(node.cfg.P2P.Listen)
-	if node.cfg.WhiteList.Enable {
-		node.host, err = libp2p.New(
-			node.ctx,
-			libp2p.ListenAddrs(sourceMultiAddr),
-			libp2p.Identity(node.privateKey),
-			//libp2p.NATPortMap(),
-			libp2p.BandwidthReporter(node.reporter),
-			libp2p.Ping(false),
-			libp2p.ConnectionGater(node.connectionGater),
-			// libp2p.NoSecurity,
-			// libp2p.DefaultMuxers,
-		)
-		if err != nil {
-			return err
-		}
-	} else {
-		node.host, err = libp2p.New(
-			node.ctx,
-			libp2p.ListenAddrs(sourceMultiAddr),
-			libp2p.Identity(node.privateKey),
-			//libp2p.NATPortMap(),
-			libp2p.BandwidthReporter(node.reporter),
-			libp2p.Ping(false),
-			// libp2p.NoSecurity,
-			// libp2p.DefaultMuxers,
-		)
-		if err != nil {
-			return err
-		}
+	node.host, err = libp2p.New(
+		node.ctx,
+		libp2p.ListenAddrs(sourceMultiAddr),
+		libp2p.Identity(node.privateKey),
+		//libp2p.NATPortMap(),
+		libp2p.BandwidthReporter(node.reporter),
+		libp2p.Ping(false),
+		libp2p.ConnectionGater(node.connectionGater),
+		// libp2p.NoSecurity,
+		// libp2p.DefaultMuxers,
+	)
+	if err != nil {
+		return err
 	}
 	node.host.SetStreamHandler(QlcProtocolID, node.handleStream)
 	node.kadDht, err = dht.New(node.ctx, node.host, dht.Mode(dht.ModeServer))
@@ -203,10 +248,14 @@ func (node *QlcNode) buildHost() error {
 	}
 	node.streamManager.SetQlcNodeAndMaxStreamNum(node)
 	// Set up libp2p pubsub
-	node.pubSub, err = libp2pps.NewGossipSub(node.ctx, node.host, libp2pps.WithMessageSigning(false))
+	node.pubSub, err = libp2pps.NewGossipSub(node.ctx, node.host, libp2pps.WithMessageSigning(false),
+		libp2pps.WithPeerScore(gossipScoreParams()))
 	if err != nil {
 		return errors.New("failed to set up pubsub")
 	}
+	if err := node.pubSub.RegisterTopicValidator(MsgTopic, node.validateMessage); err != nil {
+		return err
+	}
 	tp, err := node.pubSub.Join(MsgTopic)
 	if err != nil {
 		return err
@@ -405,6 +454,9 @@ func (node *QlcNode) publishPeersInfoToChainContext() {
 				RateIn:   stats.RateIn,
 				RateOut:  stats.RateOut,
 			}
+			bwState.CompressedIn, bwState.CompressedOut, bwState.UncompressedIn, bwState.UncompressedOut = node.compressStats.load()
+			bwState.Throttled, bwState.ThrottledBytes, bwState.Dropped = node.limiter.Counters()
+			bwState.Dropped += node.netService.dispatcher.Dropped()
 			node.netService.msgEvent.Publish(topic.EventGetBandwidthStats, bwState)
 		}
 	}
@@ -605,32 +657,50 @@ func (node *QlcNode) handleSubscription(ctx context.Context, sub pubsub.Subscrip
 	}
 }
 
+// validateMessage validates the framing of gossip messages before they are delivered and relayed, invalid
+// messages are dropped and gossipsub scores the peers which forwarded them, the parsed message is kept in
+// ValidatorData for processMessage
+func (node *QlcNode) validateMessage(ctx context.Context, pid peer.ID, msg *libp2pps.Message) libp2pps.ValidationResult {
+	message, err := ParseQlcMessage(msg.GetData())
+	if err != nil {
+		node.logger.Debugf("reject message from [%s]: %s", pid.Pretty(), err)
+		return libp2pps.ValidationReject
+	}
+	messageBuffer := msg.GetData()[QlcMessageHeaderLength:]
+	if len(messageBuffer) < int(message.DataLength()) {
+		node.logger.Debugf("reject message from [%s]: data length error", pid.Pretty())
+		return libp2pps.ValidationReject
+	}
+	if err := message.ParseMessageData(messageBuffer); err != nil {
+		node.logger.Debugf("reject message from [%s]: %s", pid.Pretty(), err)
+		return libp2pps.ValidationReject
+	}
+	if message.Version() < p2pMinVersion {
+		node.logger.Debugf("message Version [%d] is less then p2pMinVersion [%d]", message.Version(), p2pMinVersion)
+		return libp2pps.ValidationIgnore
+	}
+	msg.ValidatorData = message
+	return libp2pps.ValidationAccept
+}
+
+// processMessage delivers gossip messages validated by validateMessage, the sender is the neighbour which
+// relayed the message, so it is never penalized for the content of the message
 func (node *QlcNode) processMessage(ctx context.Context, pubSubMsg pubsub.Message) error {
-	var message *QlcMessage
-	var err error
-	data := pubSubMsg.GetData()
 	peerID := pubSubMsg.GetSender().Pretty()
 	if peerID == node.ID.Pretty() {
 		return nil
 	}
 	node.logger.Debugf("node [%s] receive topic from [%s]", node.ID.Pretty(), peerID)
-	message, err = ParseQlcMessage(data)
-	if err != nil {
-		return err
+	message, ok := pubSubMsg.GetValidatorData().(*QlcMessage)
+	if !ok {
+		return errors.New("message is not validated")
 	}
 	node.logger.Debug("message Type is :", message.messageType)
-	messageBuffer := data[QlcMessageHeaderLength:]
-	if len(messageBuffer) < int(message.DataLength()) {
-		return errors.New("data length error")
-	}
-	if err := message.ParseMessageData(messageBuffer); err != nil {
-		return err
-	}
-	if message.Version() < p2pVersion {
-		node.logger.Debugf("message Version [%d] is less then p2pVersion [%d]", message.Version(), p2pVersion)
+	if !node.limiter.Allow(peerID, message.MessageType(), len(message.content)) {
 		return nil
 	}
 	m := NewMessage(message.MessageType(), peerID, message.MessageData(), message.content)
+	m.relayed = true
 	node.netService.PutMessage(m)
 	return nil
 }
@@ -45,3 +45,48 @@ type EventBroadcastMsg struct {
 type EventFrontiersReqMsg struct {
 	PeerID string
 }
+
+type EventLightHeaderReqMsg struct {
+	Req  *protos.LightHeaderReq
+	From string
+}
+
+type EventLightHeaderRspMsg struct {
+	Rsp  *protos.LightHeaderRsp
+	From string
+}
+
+type EventLightAccountReqMsg struct {
+	Req  *protos.LightAccountReq
+	From string
+}
+
+type EventLightAccountRspMsg struct {
+	Rsp  *protos.LightAccountRsp
+	From string
+}
+
+type EventLightBlocksReqMsg struct {
+	Req  *protos.LightBlocksReq
+	From string
+}
+
+type EventLightBlocksRspMsg struct {
+	Rsp  *protos.LightBlocksRsp
+	From string
+}
+
+type EventPrivacyPayloadPushMsg struct {
+	Push *protos.PrivacyPayloadPush
+	From string
+}
+
+type EventPrivacyPayloadReqMsg struct {
+	Req  *protos.PrivacyPayloadReq
+	From string
+}
+
+type EventPrivacyKeyAnnounceMsg struct {
+	Announce *protos.PrivacyKeyAnnounce
+	From     string
+}
@@ -0,0 +1,240 @@
+package p2p
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pps "github.com/libp2p/go-libp2p-pubsub"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	peerScoreHalfLife  = 10 * time.Minute
+	peerBanThreshold   = 100
+	peerBanDuration    = 24 * time.Hour
+	peerScoreMinRecord = 0.1
+)
+
+// PeerMisbehavior is the kind of protocol violation of a remote peer
+type PeerMisbehavior byte
+
+const (
+	MisbehaviorMalformedMessage PeerMisbehavior = iota
+	MisbehaviorInvalidChecksum
+	MisbehaviorInvalidBlock
+)
+
+func (m PeerMisbehavior) String() string {
+	switch m {
+	case MisbehaviorMalformedMessage:
+		return "malformed message"
+	case MisbehaviorInvalidChecksum:
+		return "invalid checksum"
+	case MisbehaviorInvalidBlock:
+		return "invalid block"
+	default:
+		return "unknown"
+	}
+}
+
+// misbehaviorPenalty is the score added for each misbehavior, a peer is banned when its score reaches
+// peerBanThreshold. Only violations seen directly on the stream of the peer are penalized, content of
+// gossip messages may be relayed by honest peers, so it is validated and scored by gossipsub instead.
+var misbehaviorPenalty = map[PeerMisbehavior]float64{
+	MisbehaviorMalformedMessage: 25,
+	MisbehaviorInvalidChecksum:  25,
+	MisbehaviorInvalidBlock:     50,
+}
+
+type peerScore struct {
+	score   float64
+	updated time.Time
+}
+
+// PeerScorer tracks misbehavior score of remote peers, the score decays exponentially with time,
+// a peer is banned for a while when its score exceeds the threshold, bans are persisted in ledger.
+type PeerScorer struct {
+	mu     sync.RWMutex
+	scores map[string]*peerScore
+	bans   map[string]*types.PeerBan
+	store  ledger.PeerInfoStore
+	logger *zap.SugaredLogger
+	now    func() time.Time
+}
+
+func NewPeerScorer(store ledger.PeerInfoStore) *PeerScorer {
+	return &PeerScorer{
+		scores: make(map[string]*peerScore),
+		bans:   make(map[string]*types.PeerBan),
+		store:  store,
+		logger: log.NewLogger("p2p_score"),
+		now:    time.Now,
+	}
+}
+
+// Load loads the unexpired bans from ledger and removes the expired ones
+func (ps *PeerScorer) Load() error {
+	now := ps.now().Unix()
+	expired := make([]string, 0)
+	bans := make(map[string]*types.PeerBan)
+	err := ps.store.GetPeerBans(func(ban *types.PeerBan) error {
+		if ban.IsExpired(now) {
+			expired = append(expired, ban.PeerID)
+		} else {
+			bans[ban.PeerID] = ban
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range expired {
+		if err := ps.store.DeletePeerBan(id); err != nil {
+			ps.logger.Errorf("delete expired ban of %s: %s", id, err)
+		}
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.bans = bans
+	return nil
+}
+
+// Score returns the current decayed score of the peer
+func (ps *PeerScorer) Score(peerID string) float64 {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	if s, ok := ps.scores[peerID]; ok {
+		return decayScore(s.score, ps.now().Sub(s.updated))
+	}
+	return 0
+}
+
+// Penalize adds the penalty of the misbehavior to the peer score, returns the ban if the peer is banned by this call
+func (ps *PeerScorer) Penalize(peerID string, behavior PeerMisbehavior, reason string) *types.PeerBan {
+	if peerID == "" {
+		return nil
+	}
+	penalty, ok := misbehaviorPenalty[behavior]
+	if !ok {
+		return nil
+	}
+
+	ps.mu.Lock()
+	if ps.isBanned(peerID) {
+		ps.mu.Unlock()
+		return nil
+	}
+	now := ps.now()
+	s, ok := ps.scores[peerID]
+	if !ok {
+		s = &peerScore{}
+		ps.scores[peerID] = s
+	}
+	s.score = decayScore(s.score, now.Sub(s.updated)) + penalty
+	s.updated = now
+	ps.logger.Debugf("peer %s misbehaved (%s: %s), score %.2f", peerID, behavior, reason, s.score)
+	if s.score < peerBanThreshold {
+		ps.cleanScores(now)
+		ps.mu.Unlock()
+		return nil
+	}
+
+	ban := &types.PeerBan{
+		PeerID:     peerID,
+		Score:      s.score,
+		Reason:     behavior.String() + ": " + reason,
+		BanTime:    now.Unix(),
+		ExpireTime: now.Add(peerBanDuration).Unix(),
+	}
+	ps.bans[peerID] = ban
+	delete(ps.scores, peerID)
+	ps.mu.Unlock()
+
+	if err := ps.store.AddOrUpdatePeerBan(ban); err != nil {
+		ps.logger.Errorf("save ban of %s: %s", peerID, err)
+	}
+	ps.logger.Warnf("peer %s is banned until %s, score %.2f, %s", peerID,
+		time.Unix(ban.ExpireTime, 0).Format(time.RFC3339), ban.Score, ban.Reason)
+	return ban
+}
+
+// IsBanned checks whether the peer is banned now
+func (ps *PeerScorer) IsBanned(peerID string) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	return ps.isBanned(peerID)
+}
+
+func (ps *PeerScorer) isBanned(peerID string) bool {
+	if ban, ok := ps.bans[peerID]; ok {
+		return !ban.IsExpired(ps.now().Unix())
+	}
+	return false
+}
+
+// SetBan updates the ban of the peer in memory, the ban is removed if ban is nil
+func (ps *PeerScorer) SetBan(peerID string, ban *types.PeerBan) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ban == nil {
+		delete(ps.bans, peerID)
+	} else {
+		ps.bans[peerID] = ban
+	}
+	delete(ps.scores, peerID)
+}
+
+// cleanScores removes the records whose score has decayed to almost zero
+func (ps *PeerScorer) cleanScores(now time.Time) {
+	for id, s := range ps.scores {
+		if decayScore(s.score, now.Sub(s.updated)) < peerScoreMinRecord {
+			delete(ps.scores, id)
+		}
+	}
+}
+
+func decayScore(score float64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return score
+	}
+	return score * math.Pow(0.5, float64(elapsed)/float64(peerScoreHalfLife))
+}
+
+func misbehaviorOfParseError(err error) PeerMisbehavior {
+	if err == ErrInvalidDataCheckSum {
+		return MisbehaviorInvalidChecksum
+	}
+	return MisbehaviorMalformedMessage
+}
+
+// gossipScoreParams scores the peers which forward invalid gossip messages, gossip from a peer is suppressed
+// when its score drops below the thresholds, the score is kept only by gossipsub and never causes a ban
+func gossipScoreParams() (*libp2pps.PeerScoreParams, *libp2pps.PeerScoreThresholds) {
+	params := &libp2pps.PeerScoreParams{
+		Topics: map[string]*libp2pps.TopicScoreParams{
+			MsgTopic: {
+				TopicWeight:                    1,
+				TimeInMeshQuantum:              time.Second,
+				InvalidMessageDeliveriesWeight: -10,
+				InvalidMessageDeliveriesDecay:  libp2pps.ScoreParameterDecay(peerScoreHalfLife),
+			},
+		},
+		AppSpecificScore: func(peer.ID) float64 { return 0 },
+		DecayInterval:    libp2pps.DefaultDecayInterval,
+		DecayToZero:      libp2pps.DefaultDecayToZero,
+		RetainScore:      peerScoreHalfLife,
+	}
+	thresholds := &libp2pps.PeerScoreThresholds{
+		GossipThreshold:   -10,
+		PublishThreshold:  -50,
+		GraylistThreshold: -80,
+	}
+	return params, thresholds
+}
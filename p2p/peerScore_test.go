@@ -0,0 +1,233 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pps "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func setupPeerScorer(t *testing.T) (func(t *testing.T), *ledger.Ledger, *PeerScorer) {
+	dir := filepath.Join(config.QlcTestDataDir(), "peerScore", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	l := ledger.NewLedger(cm.ConfigFile)
+	return func(t *testing.T) {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, l, NewPeerScorer(l)
+}
+
+func TestPeerScorer_Penalize(t *testing.T) {
+	teardown, l, ps := setupPeerScorer(t)
+	defer teardown(t)
+
+	now := time.Now()
+	ps.now = func() time.Time { return now }
+	peerID := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+
+	for i := 0; i < 3; i++ {
+		if ban := ps.Penalize(peerID, MisbehaviorInvalidChecksum, "checksum"); ban != nil {
+			t.Fatal("peer should not be banned")
+		}
+	}
+	if s := ps.Score(peerID); s != 75 {
+		t.Fatal("score error", s)
+	}
+
+	// score decays by half after one half-life
+	now = now.Add(peerScoreHalfLife)
+	if s := ps.Score(peerID); s < 37.4 || s > 37.6 {
+		t.Fatal("decay error", s)
+	}
+	ps.Penalize(peerID, MisbehaviorMalformedMessage, "malformed")
+	if ps.IsBanned(peerID) {
+		t.Fatal("peer should not be banned")
+	}
+	for i := 0; i < 2; i++ {
+		ps.Penalize(peerID, MisbehaviorMalformedMessage, "malformed")
+	}
+	if !ps.IsBanned(peerID) {
+		t.Fatal("peer should be banned")
+	}
+	if _, err := l.GetPeerBan(peerID); err != nil {
+		t.Fatal(err)
+	}
+
+	// bans are reloaded from ledger
+	ps2 := NewPeerScorer(l)
+	ps2.now = ps.now
+	if err := ps2.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if !ps2.IsBanned(peerID) {
+		t.Fatal("ban should be loaded")
+	}
+
+	// expired bans are removed when loading
+	now = now.Add(peerBanDuration)
+	if ps2.IsBanned(peerID) {
+		t.Fatal("ban should be expired")
+	}
+	if err := ps2.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := l.GetPeerBan(peerID); err != ledger.ErrPeerBanNotFound {
+		t.Fatal(err)
+	}
+}
+
+func TestPeerScorer_SetBan(t *testing.T) {
+	teardown, _, ps := setupPeerScorer(t)
+	defer teardown(t)
+
+	peerID := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+	ps.SetBan(peerID, &types.PeerBan{PeerID: peerID, BanTime: time.Now().Unix()})
+	if !ps.IsBanned(peerID) {
+		t.Fatal("permanent ban error")
+	}
+	cg := NewConnectionGater(false)
+	cg.scorer = ps
+	pid, _ := peer.Decode(peerID)
+	if cg.InterceptPeerDial(pid) {
+		t.Fatal("banned peer should not be dialed")
+	}
+	ps.SetBan(peerID, nil)
+	if ps.IsBanned(peerID) || !cg.InterceptPeerDial(pid) {
+		t.Fatal("unban error")
+	}
+}
+
+func TestQlcNode_validateMessage(t *testing.T) {
+	node := &QlcNode{logger: log.NewLogger("p2p_test")}
+	pid, _ := peer.Decode("QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f")
+
+	content := NewQlcMessage([]byte("message"), p2pVersion, PublishReq)
+	msg := &libp2pps.Message{Message: &pb.Message{Data: content}}
+	if r := node.validateMessage(context.Background(), pid, msg); r != libp2pps.ValidationAccept {
+		t.Fatal("message should be accepted", r)
+	}
+	if m, ok := msg.ValidatorData.(*QlcMessage); !ok || m.MessageType() != PublishReq {
+		t.Fatal("invalid validator data", msg.ValidatorData)
+	}
+
+	broken := make([]byte, len(content))
+	copy(broken, content)
+	broken[len(broken)-1]++
+	for _, data := range [][]byte{broken, content[:QlcMessageHeaderLength-1]} {
+		msg := &libp2pps.Message{Message: &pb.Message{Data: data}}
+		if r := node.validateMessage(context.Background(), pid, msg); r != libp2pps.ValidationReject {
+			t.Fatal("message should be rejected", r)
+		}
+	}
+}
+
+func TestMessageService_penalizeSender(t *testing.T) {
+	teardown, _, ps := setupPeerScorer(t)
+	defer teardown(t)
+
+	node := &QlcNode{streamManager: NewStreamManager(), logger: log.NewLogger("p2p_test")}
+	node.connectionGater = NewConnectionGater(false)
+	node.setPeerScorer(ps)
+	ms := &MessageService{netService: &QlcService{node: node}}
+
+	peerID := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+	relayed := NewMessage(PublishReq, peerID, nil, nil)
+	relayed.relayed = true
+	ms.penalizeSender(relayed, errors.New("malformed"))
+	if s := ps.Score(peerID); s != 0 {
+		t.Fatal("relaying peer should not be penalized", s)
+	}
+	ms.penalizeSender(NewMessage(PublishReq, peerID, nil, nil), errors.New("malformed"))
+	if s := ps.Score(peerID); s == 0 {
+		t.Fatal("sending peer should be penalized")
+	}
+}
+
+func TestServiceSync_verifyBlocks(t *testing.T) {
+	teardown, _, ps := setupPeerScorer(t)
+	defer teardown(t)
+
+	node := &QlcNode{streamManager: NewStreamManager(), logger: log.NewLogger("p2p_test")}
+	node.connectionGater = NewConnectionGater(false)
+	node.setPeerScorer(ps)
+	ss := &ServiceSync{netService: &QlcService{node: node}}
+
+	peerID := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+	blk := mock.StateBlock()
+	blk.Type = types.Send
+	blk.Signature = mock.Account().Sign(blk.GetHash())
+	forged := NewMessage(BulkPushBlock, peerID, nil, nil)
+	relayed := NewMessage(BulkPushBlock, peerID, nil, nil)
+	relayed.relayed = true
+	if err := ss.verifyBlocks(relayed, types.StateBlockList{blk}); err == nil {
+		t.Fatal("block with bad signature should be dropped")
+	}
+	if s := ps.Score(peerID); s != 0 {
+		t.Fatal("relaying peer should not be penalized", s)
+	}
+	if err := ss.verifyBlocks(forged, types.StateBlockList{blk}); err == nil {
+		t.Fatal("block with bad signature should be dropped")
+	}
+	if s := ps.Score(peerID); s == 0 {
+		t.Fatal("sending peer should be penalized")
+	}
+
+	a := mock.Account()
+	blk.Address = a.Address()
+	blk.Signature = a.Sign(blk.GetHash())
+	if err := verifySyncBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	blk.Previous = mock.Hash()
+	blk.Signature = a.Sign(blk.GetHash())
+	if err := verifySyncBlock(blk); err == nil && !blk.IsValid() {
+		t.Fatal("block with bad work should be invalid")
+	}
+	blk.Type = types.ContractReward
+	blk.Signature = types.Signature{}
+	if err := verifySyncBlock(blk); err != nil {
+		t.Fatal("contract block depends on ledger state", err)
+	}
+}
+
+func TestMessageService_verifyPovBlock(t *testing.T) {
+	blk, _ := mock.GeneratePovBlock(nil, 0)
+	blk.Header.BasHdr.Bits = 0x207fffff
+	blk.Header.BasHdr.AlgoTargetInt = nil
+	for {
+		blk.Header.BasHdr.Hash = blk.ComputeHash()
+		if verifyPovBlock(blk) == nil {
+			break
+		}
+		blk.Header.BasHdr.Nonce++
+	}
+
+	blk.Header.BasHdr.Nonce++
+	if err := verifyPovBlock(blk); err == nil {
+		t.Fatal("block with bad hash should be invalid")
+	}
+	blk.Header.BasHdr.Bits = 0x03000001
+	blk.Header.BasHdr.AlgoTargetInt = nil
+	blk.Header.BasHdr.Hash = blk.ComputeHash()
+	if err := verifyPovBlock(blk); err == nil {
+		t.Fatal("block with bad work should be invalid")
+	}
+}
@@ -0,0 +1,43 @@
+package p2p
+
+import (
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+)
+
+// onPrivacyMessage decodes encrypted private payloads exchanged between transaction managers,
+// they are dispatched to the privacy controller by event bus
+func (ms *MessageService) onPrivacyMessage(message *Message) {
+	var t topic.TopicType
+	var msg interface{}
+	var err error
+
+	from := message.MessageFrom()
+	switch message.MessageType() {
+	case PrivacyPayloadPush:
+		var push *protos.PrivacyPayloadPush
+		if push, err = protos.PrivacyPayloadPushFromProto(message.Data()); err == nil {
+			t, msg = topic.EventPrivacyPayloadPush, &EventPrivacyPayloadPushMsg{Push: push, From: from}
+		}
+	case PrivacyPayloadReq:
+		var req *protos.PrivacyPayloadReq
+		if req, err = protos.PrivacyPayloadReqFromProto(message.Data()); err == nil {
+			t, msg = topic.EventPrivacyPayloadReq, &EventPrivacyPayloadReqMsg{Req: req, From: from}
+		}
+	case PrivacyKeyAnnounce:
+		var announce *protos.PrivacyKeyAnnounce
+		if announce, err = protos.PrivacyKeyAnnounceFromProto(message.Data()); err == nil {
+			t, msg = topic.EventPrivacyKeyAnnounce, &EventPrivacyKeyAnnounceMsg{Announce: announce, From: from}
+		}
+	default:
+		ms.netService.node.logger.Warn("Received unknown privacy message.")
+		return
+	}
+
+	if err != nil {
+		ms.netService.node.logger.Info(err)
+		ms.penalizeSender(message, err)
+		return
+	}
+	ms.netService.msgEvent.Publish(t, msg)
+}
@@ -0,0 +1,37 @@
+package protos
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/qlcchain/go-qlc/p2p/protos/pb"
+)
+
+// HandshakePacket announces the protocol versions and payload compressions supported by the sender,
+// it is the first message of a stream
+type HandshakePacket struct {
+	Version      uint32
+	MinVersion   uint32
+	Compressions []string
+}
+
+func HandshakeToProto(hs *HandshakePacket) ([]byte, error) {
+	hsPb := &pb.Handshake{
+		Version:      hs.Version,
+		MinVersion:   hs.MinVersion,
+		Compressions: hs.Compressions,
+	}
+	return proto.Marshal(hsPb)
+}
+
+func HandshakeFromProto(data []byte) (*HandshakePacket, error) {
+	hsPb := new(pb.Handshake)
+	if err := proto.Unmarshal(data, hsPb); err != nil {
+		return nil, err
+	}
+	hs := &HandshakePacket{
+		Version:      hsPb.Version,
+		MinVersion:   hsPb.MinVersion,
+		Compressions: hsPb.Compressions,
+	}
+	return hs, nil
+}
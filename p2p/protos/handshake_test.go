@@ -0,0 +1,28 @@
+package protos
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHandshake(t *testing.T) {
+	hs := &HandshakePacket{
+		Version:      9,
+		MinVersion:   8,
+		Compressions: []string{"zstd", "snappy"},
+	}
+	b, err := HandshakeToProto(hs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hs2, err := HandshakeFromProto(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hs, hs2) {
+		t.Fatal("handshake error")
+	}
+	if _, err := HandshakeFromProto([]byte{0xff}); err == nil {
+		t.Fatal("invalid data should fail")
+	}
+}
@@ -0,0 +1,237 @@
+package protos
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/p2p/protos/pb"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+// LightHeaderReq requests pov headers from StartHeight in the best chain of peer
+type LightHeaderReq struct {
+	StartHeight uint64
+	Count       uint32
+}
+
+type LightHeaderRsp struct {
+	Headers []*types.PovHeader
+}
+
+// LightAccountReq requests account states with merkle proofs against the state hash of pov header at Height
+type LightAccountReq struct {
+	Height    uint64
+	Addresses []types.Address
+}
+
+type LightStateProof struct {
+	Address types.Address
+	Proof   *trie.Proof
+}
+
+type LightAccountRsp struct {
+	Height uint64
+	Hash   types.Hash
+	Proofs []*LightStateProof
+}
+
+// LightBlocksReq requests at most Count blocks of an account chain, from StartHash back to the open block
+type LightBlocksReq struct {
+	StartHash types.Hash
+	Count     uint32
+}
+
+type LightBlocksRsp struct {
+	StartHash types.Hash
+	Blocks    types.StateBlockList
+}
+
+func LightHeaderReqToProto(req *LightHeaderReq) ([]byte, error) {
+	pbReq := &pb.LightHeaderReq{
+		StartHeight: req.StartHeight,
+		Count:       req.Count,
+	}
+	return proto.Marshal(pbReq)
+}
+
+func LightHeaderReqFromProto(data []byte) (*LightHeaderReq, error) {
+	pbReq := new(pb.LightHeaderReq)
+	if err := proto.Unmarshal(data, pbReq); err != nil {
+		return nil, err
+	}
+	return &LightHeaderReq{
+		StartHeight: pbReq.StartHeight,
+		Count:       pbReq.Count,
+	}, nil
+}
+
+func LightHeaderRspToProto(rsp *LightHeaderRsp) ([]byte, error) {
+	pbRsp := &pb.LightHeaderRsp{
+		Headers: make([][]byte, 0, len(rsp.Headers)),
+	}
+	for _, header := range rsp.Headers {
+		data, err := header.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		pbRsp.Headers = append(pbRsp.Headers, data)
+	}
+	return proto.Marshal(pbRsp)
+}
+
+func LightHeaderRspFromProto(data []byte) (*LightHeaderRsp, error) {
+	pbRsp := new(pb.LightHeaderRsp)
+	if err := proto.Unmarshal(data, pbRsp); err != nil {
+		return nil, err
+	}
+	rsp := &LightHeaderRsp{
+		Headers: make([]*types.PovHeader, 0, len(pbRsp.Headers)),
+	}
+	for _, data := range pbRsp.Headers {
+		header := new(types.PovHeader)
+		if err := header.Deserialize(data); err != nil {
+			return nil, err
+		}
+		rsp.Headers = append(rsp.Headers, header)
+	}
+	return rsp, nil
+}
+
+func LightAccountReqToProto(req *LightAccountReq) ([]byte, error) {
+	addrBytes := make([]byte, 0, len(req.Addresses)*types.AddressSize)
+	for _, addr := range req.Addresses {
+		addrBytes = append(addrBytes, addr[:]...)
+	}
+	pbReq := &pb.LightAccountReq{
+		Height:    req.Height,
+		Addresses: addrBytes,
+	}
+	return proto.Marshal(pbReq)
+}
+
+func LightAccountReqFromProto(data []byte) (*LightAccountReq, error) {
+	pbReq := new(pb.LightAccountReq)
+	if err := proto.Unmarshal(data, pbReq); err != nil {
+		return nil, err
+	}
+	if len(pbReq.Addresses)%types.AddressSize != 0 {
+		return nil, fmt.Errorf("invalid addresses field length %d", len(pbReq.Addresses))
+	}
+	req := &LightAccountReq{
+		Height:    pbReq.Height,
+		Addresses: make([]types.Address, 0, len(pbReq.Addresses)/types.AddressSize),
+	}
+	for i := 0; i < len(pbReq.Addresses); i += types.AddressSize {
+		addr, err := types.BytesToAddress(pbReq.Addresses[i : i+types.AddressSize])
+		if err != nil {
+			return nil, err
+		}
+		req.Addresses = append(req.Addresses, addr)
+	}
+	return req, nil
+}
+
+func LightAccountRspToProto(rsp *LightAccountRsp) ([]byte, error) {
+	pbRsp := &pb.LightAccountRsp{
+		Height: rsp.Height,
+		Hash:   rsp.Hash[:],
+		Proofs: make([]*pb.LightStateProof, 0, len(rsp.Proofs)),
+	}
+	for _, p := range rsp.Proofs {
+		pbProof := &pb.LightStateProof{
+			Address: p.Address[:],
+		}
+		if p.Proof != nil {
+			pbProof.Nodes = p.Proof.Nodes
+			pbProof.Value = p.Proof.Value
+		}
+		pbRsp.Proofs = append(pbRsp.Proofs, pbProof)
+	}
+	return proto.Marshal(pbRsp)
+}
+
+func LightAccountRspFromProto(data []byte) (*LightAccountRsp, error) {
+	pbRsp := new(pb.LightAccountRsp)
+	if err := proto.Unmarshal(data, pbRsp); err != nil {
+		return nil, err
+	}
+	hash, err := types.BytesToHash(pbRsp.Hash)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &LightAccountRsp{
+		Height: pbRsp.Height,
+		Hash:   hash,
+		Proofs: make([]*LightStateProof, 0, len(pbRsp.Proofs)),
+	}
+	for _, pbProof := range pbRsp.Proofs {
+		addr, err := types.BytesToAddress(pbProof.Address)
+		if err != nil {
+			return nil, err
+		}
+		p := &LightStateProof{Address: addr}
+		if len(pbProof.Nodes) > 0 {
+			p.Proof = &trie.Proof{Nodes: pbProof.Nodes, Value: pbProof.Value}
+		}
+		rsp.Proofs = append(rsp.Proofs, p)
+	}
+	return rsp, nil
+}
+
+func LightBlocksReqToProto(req *LightBlocksReq) ([]byte, error) {
+	pbReq := &pb.LightBlocksReq{
+		StartHash: req.StartHash[:],
+		Count:     req.Count,
+	}
+	return proto.Marshal(pbReq)
+}
+
+func LightBlocksReqFromProto(data []byte) (*LightBlocksReq, error) {
+	pbReq := new(pb.LightBlocksReq)
+	if err := proto.Unmarshal(data, pbReq); err != nil {
+		return nil, err
+	}
+	hash, err := types.BytesToHash(pbReq.StartHash)
+	if err != nil {
+		return nil, err
+	}
+	return &LightBlocksReq{
+		StartHash: hash,
+		Count:     pbReq.Count,
+	}, nil
+}
+
+func LightBlocksRspToProto(rsp *LightBlocksRsp) ([]byte, error) {
+	blockBytes, err := rsp.Blocks.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	pbRsp := &pb.LightBlocksRsp{
+		StartHash: rsp.StartHash[:],
+		Blocks:    blockBytes,
+	}
+	return proto.Marshal(pbRsp)
+}
+
+func LightBlocksRspFromProto(data []byte) (*LightBlocksRsp, error) {
+	pbRsp := new(pb.LightBlocksRsp)
+	if err := proto.Unmarshal(data, pbRsp); err != nil {
+		return nil, err
+	}
+	hash, err := types.BytesToHash(pbRsp.StartHash)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &LightBlocksRsp{
+		StartHash: hash,
+		Blocks:    make(types.StateBlockList, 0),
+	}
+	if len(pbRsp.Blocks) > 0 {
+		if err := rsp.Blocks.Deserialize(pbRsp.Blocks); err != nil {
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
@@ -0,0 +1,112 @@
+package protos
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/trie"
+)
+
+func TestLightHeader(t *testing.T) {
+	req := &LightHeaderReq{StartHeight: 100, Count: 20}
+	data, err := LightHeaderReqToProto(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := LightHeaderReqFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StartHeight != req.StartHeight || r.Count != req.Count {
+		t.Fatal(r)
+	}
+
+	blk1, _ := mock.GeneratePovBlock(nil, 0)
+	blk2, _ := mock.GeneratePovBlock(blk1, 0)
+	rsp := &LightHeaderRsp{Headers: []*types.PovHeader{blk1.GetHeader(), blk2.GetHeader()}}
+	data, err = LightHeaderRspToProto(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := LightHeaderRspFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r2.Headers) != 2 || r2.Headers[0].GetHash() != blk1.GetHash() || r2.Headers[1].GetHash() != blk2.GetHash() {
+		t.Fatal("invalid headers")
+	}
+}
+
+func TestLightAccount(t *testing.T) {
+	req := &LightAccountReq{Height: 10, Addresses: []types.Address{mock.Address(), mock.Address()}}
+	data, err := LightAccountReqToProto(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := LightAccountReqFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Height != req.Height || len(r.Addresses) != 2 || r.Addresses[1] != req.Addresses[1] {
+		t.Fatal(r)
+	}
+
+	rsp := &LightAccountRsp{
+		Height: 10,
+		Hash:   mock.Hash(),
+		Proofs: []*LightStateProof{
+			{Address: mock.Address(), Proof: &trie.Proof{Nodes: [][]byte{{1, 2}, {3}}, Value: []byte{4}}},
+			{Address: mock.Address()},
+		},
+	}
+	data, err = LightAccountRspToProto(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := LightAccountRspFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r2.Height != rsp.Height || r2.Hash != rsp.Hash || len(r2.Proofs) != 2 {
+		t.Fatal(r2)
+	}
+	p := r2.Proofs[0]
+	if p.Address != rsp.Proofs[0].Address || len(p.Proof.Nodes) != 2 || !bytes.Equal(p.Proof.Value, []byte{4}) {
+		t.Fatal(p)
+	}
+	if r2.Proofs[1].Proof != nil {
+		t.Fatal("proof should be nil")
+	}
+}
+
+func TestLightBlocks(t *testing.T) {
+	req := &LightBlocksReq{StartHash: mock.Hash(), Count: 50}
+	data, err := LightBlocksReqToProto(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := LightBlocksReqFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.StartHash != req.StartHash || r.Count != req.Count {
+		t.Fatal(r)
+	}
+
+	blk1 := mock.StateBlockWithoutWork()
+	blk2 := mock.StateBlockWithoutWork()
+	rsp := &LightBlocksRsp{StartHash: blk1.GetHash(), Blocks: types.StateBlockList{blk1, blk2}}
+	data, err = LightBlocksRspToProto(rsp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := LightBlocksRspFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r2.StartHash != rsp.StartHash || len(r2.Blocks) != 2 || r2.Blocks[1].GetHash() != blk2.GetHash() {
+		t.Fatal(r2)
+	}
+}
@@ -6,14 +6,13 @@ package pb
 import (
 	bytes "bytes"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
-
-	_ "github.com/gogo/protobuf/gogoproto"
-	proto "github.com/gogo/protobuf/proto"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -793,208 +792,679 @@ func (m *MessageAck) GetMessageHash() []byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*FrontierReq)(nil), "pb.FrontierReq")
-	proto.RegisterType((*FrontierRsp)(nil), "pb.FrontierRsp")
-	proto.RegisterType((*BulkPullReq)(nil), "pb.BulkPullReq")
-	proto.RegisterType((*BulkPullRsp)(nil), "pb.BulkPullRsp")
-	proto.RegisterType((*BulkPushBlock)(nil), "pb.BulkPushBlock")
-	proto.RegisterType((*PublishBlock)(nil), "pb.PublishBlock")
-	proto.RegisterType((*ConfirmReq)(nil), "pb.ConfirmReq")
-	proto.RegisterType((*ConfirmAck)(nil), "pb.ConfirmAck")
-	proto.RegisterType((*PovStatus)(nil), "pb.PovStatus")
-	proto.RegisterType((*PovPublishBlock)(nil), "pb.PovPublishBlock")
-	proto.RegisterType((*PovPullBlockReq)(nil), "pb.PovPullBlockReq")
-	proto.RegisterType((*PovPullBlockRsp)(nil), "pb.PovPullBlockRsp")
-	proto.RegisterType((*MessageAck)(nil), "pb.MessageAck")
+type LightHeaderReq struct {
+	StartHeight          uint64   `protobuf:"varint,1,opt,name=StartHeight,proto3" json:"StartHeight,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
-
-var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbd, 0x8e, 0xd3, 0x4c,
-	0x14, 0xfd, 0x26, 0x4e, 0xf2, 0x91, 0x9b, 0x58, 0x20, 0x6b, 0xb5, 0xb2, 0x56, 0x2b, 0x2b, 0x1a,
-	0xad, 0xb4, 0x91, 0x80, 0x6c, 0xc1, 0x13, 0x24, 0xe1, 0x67, 0x0b, 0x10, 0x91, 0x13, 0x1e, 0xc0,
-	0x76, 0x66, 0x1d, 0x2b, 0x8e, 0xc7, 0xeb, 0x19, 0xaf, 0x44, 0xc7, 0x1b, 0xf0, 0x1a, 0xb4, 0x54,
-	0x50, 0x52, 0x52, 0x52, 0x52, 0x6e, 0xfc, 0x04, 0x94, 0x94, 0x68, 0x7e, 0x1c, 0x4f, 0x16, 0x24,
-	0xe8, 0x7c, 0xce, 0x9c, 0xb9, 0xf7, 0xdc, 0x1f, 0x0f, 0xd8, 0x5b, 0xc2, 0x58, 0x10, 0x93, 0x71,
-	0x5e, 0x50, 0x4e, 0x9d, 0x56, 0x1e, 0x9e, 0x3c, 0x8e, 0x13, 0xbe, 0x2e, 0xc3, 0x71, 0x44, 0xb7,
-	0x17, 0x31, 0x8d, 0xe9, 0x85, 0x3c, 0x0a, 0xcb, 0x2b, 0x89, 0x24, 0x90, 0x5f, 0xea, 0x0a, 0x7e,
-	0x0d, 0xfd, 0xe7, 0x05, 0xcd, 0x78, 0x42, 0x0a, 0x9f, 0x5c, 0x3b, 0x2e, 0xfc, 0x3f, 0x59, 0xad,
-	0x0a, 0xc2, 0x98, 0x8b, 0x86, 0x68, 0x34, 0xf0, 0x6b, 0xe8, 0x3c, 0x00, 0x6b, 0x12, 0x13, 0xb7,
-	0x35, 0x44, 0x23, 0xdb, 0x17, 0x9f, 0xce, 0x11, 0x74, 0x66, 0xb4, 0xcc, 0xb8, 0x6b, 0x49, 0x4e,
-	0x01, 0xfc, 0xd0, 0x08, 0xc8, 0x72, 0xe7, 0x14, 0x7a, 0x35, 0x14, 0x21, 0xad, 0xd1, 0xc0, 0x6f,
-	0x08, 0xfc, 0x1e, 0x41, 0x7f, 0x5a, 0xa6, 0x9b, 0x79, 0x99, 0xa6, 0x22, 0xfd, 0x29, 0xf4, 0x16,
-	0x3c, 0x28, 0xf8, 0x65, 0xc0, 0xd6, 0xda, 0x40, 0x43, 0x08, 0x73, 0xcf, 0xb2, 0x95, 0x3c, 0x6b,
-	0x29, 0x73, 0x1a, 0x3a, 0x27, 0x70, 0x4f, 0x84, 0x58, 0xbe, 0xcd, 0x89, 0x76, 0xb3, 0xc7, 0x8d,
-	0xcd, 0xb6, 0x61, 0xd3, 0x39, 0x86, 0xae, 0xb8, 0x49, 0x98, 0xdb, 0x91, 0xa1, 0x34, 0xc2, 0x13,
-	0xc3, 0x10, 0xcb, 0x0f, 0x02, 0xa3, 0x3b, 0x81, 0x8f, 0xa1, 0x1b, 0xa6, 0x34, 0xda, 0x30, 0xed,
-	0x46, 0x23, 0x7c, 0x0e, 0xb6, 0x0a, 0xc1, 0xd6, 0x53, 0xc1, 0x18, 0x42, 0x74, 0x20, 0x3c, 0x83,
-	0xc1, 0xbc, 0x0c, 0xd3, 0xa4, 0xd6, 0x1d, 0x41, 0x47, 0x9e, 0x68, 0x99, 0x02, 0x18, 0x03, 0xcc,
-	0x68, 0x76, 0x95, 0x14, 0x5b, 0xd1, 0x21, 0x43, 0x63, 0x35, 0x1a, 0xbe, 0xd7, 0x4c, 0xa2, 0x8d,
-	0x1c, 0x62, 0x14, 0xc9, 0x9a, 0xeb, 0x21, 0x2a, 0x28, 0xfb, 0x9b, 0xc4, 0x59, 0xc0, 0xcb, 0x82,
-	0x68, 0xd7, 0x0d, 0x21, 0x8a, 0x5d, 0x90, 0xeb, 0x92, 0x64, 0xd1, 0xbe, 0x8b, 0x35, 0x76, 0x1c,
-	0x68, 0xcb, 0xc6, 0xb7, 0x65, 0x5a, 0xf9, 0x8d, 0x3f, 0x22, 0xe8, 0xcd, 0xe9, 0xcd, 0x82, 0x07,
-	0xbc, 0x64, 0xce, 0x19, 0xd8, 0xb3, 0xb2, 0x28, 0x48, 0xc6, 0x2f, 0x49, 0x12, 0xaf, 0x55, 0xee,
-	0xb6, 0x7f, 0x48, 0x3a, 0x43, 0xe8, 0xd7, 0x44, 0x33, 0x47, 0x93, 0x12, 0x8a, 0x17, 0x24, 0x23,
-	0x2c, 0x61, 0x52, 0x61, 0x29, 0x85, 0x41, 0x89, 0x2a, 0xf4, 0x85, 0xe5, 0x53, 0x39, 0xd5, 0x81,
-	0xdf, 0x10, 0xe2, 0x74, 0x99, 0x6c, 0x09, 0xe3, 0xc1, 0x36, 0x97, 0xc3, 0xb5, 0xfc, 0x86, 0xc0,
-	0xe7, 0x70, 0x7f, 0x4e, 0x6f, 0xfe, 0xa1, 0xed, 0x9f, 0x90, 0x56, 0xa6, 0xa9, 0x94, 0xfd, 0x7d,
-	0x3d, 0x87, 0xd0, 0x57, 0x40, 0x95, 0xdf, 0x92, 0xe5, 0x9b, 0xd4, 0x9f, 0xff, 0x98, 0x83, 0x1d,
-	0x6b, 0xff, 0xbe, 0x63, 0x3e, 0x09, 0x18, 0xcd, 0x64, 0x25, 0xb6, 0xaf, 0x91, 0xb8, 0xf3, 0x92,
-	0x46, 0x01, 0xa7, 0x05, 0x73, 0xbb, 0xd2, 0xc8, 0x1e, 0xe3, 0x37, 0x77, 0x8c, 0xb3, 0x5c, 0x24,
-	0x6e, 0xf6, 0xc1, 0xf6, 0x15, 0x68, 0x0a, 0x6f, 0x19, 0x85, 0x1b, 0x29, 0x2d, 0x33, 0x25, 0x1e,
-	0x03, 0xbc, 0x52, 0xaf, 0x8d, 0xd8, 0xb1, 0x21, 0xf4, 0xf5, 0xdb, 0x63, 0x34, 0xc3, 0xa4, 0xa6,
-	0x8f, 0xbe, 0xef, 0xbc, 0xff, 0x6e, 0x77, 0x1e, 0xfa, 0xb1, 0xf3, 0xd0, 0xcf, 0x9d, 0x87, 0xde,
-	0x55, 0x1e, 0xfa, 0x50, 0x79, 0xe8, 0x73, 0xe5, 0xa1, 0x2f, 0x95, 0x87, 0xbe, 0x56, 0x1e, 0xfa,
-	0x56, 0x79, 0xe8, 0xb6, 0xf2, 0x50, 0xd8, 0x95, 0xcf, 0xd1, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x61, 0xae, 0x70, 0xf8, 0xd2, 0x04, 0x00, 0x00,
+func (m *LightHeaderReq) Reset()      { *m = LightHeaderReq{} }
+func (*LightHeaderReq) ProtoMessage() {}
+func (*LightHeaderReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{13}
 }
-
-func (this *FrontierReq) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+func (m *LightHeaderReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightHeaderReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightHeaderReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *LightHeaderReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightHeaderReq.Merge(m, src)
+}
+func (m *LightHeaderReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightHeaderReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightHeaderReq.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*FrontierReq)
-	if !ok {
-		that2, ok := that.(FrontierReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *FrontierReq")
-		}
-	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *FrontierReq but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *FrontierReq but is not nil && this == nil")
-	}
-	if !bytes.Equal(this.Address, that1.Address) {
-		return fmt.Errorf("Address this(%v) Not Equal that(%v)", this.Address, that1.Address)
+var xxx_messageInfo_LightHeaderReq proto.InternalMessageInfo
+
+func (m *LightHeaderReq) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if this.Age != that1.Age {
-		return fmt.Errorf("Age this(%v) Not Equal that(%v)", this.Age, that1.Age)
+	return 0
+}
+
+func (m *LightHeaderReq) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if this.Count != that1.Count {
-		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
+	return 0
+}
+
+type LightHeaderRsp struct {
+	Headers              [][]byte `protobuf:"bytes,1,rep,name=Headers,proto3" json:"Headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LightHeaderRsp) Reset()      { *m = LightHeaderRsp{} }
+func (*LightHeaderRsp) ProtoMessage() {}
+func (*LightHeaderRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{14}
+}
+func (m *LightHeaderRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightHeaderRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightHeaderRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+}
+func (m *LightHeaderRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightHeaderRsp.Merge(m, src)
+}
+func (m *LightHeaderRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightHeaderRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightHeaderRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightHeaderRsp proto.InternalMessageInfo
+
+func (m *LightHeaderRsp) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
 	}
 	return nil
 }
-func (this *FrontierReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*FrontierReq)
-	if !ok {
-		that2, ok := that.(FrontierReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type LightAccountReq struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Addresses            []byte   `protobuf:"bytes,2,opt,name=Addresses,proto3" json:"Addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LightAccountReq) Reset()      { *m = LightAccountReq{} }
+func (*LightAccountReq) ProtoMessage() {}
+func (*LightAccountReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{15}
+}
+func (m *LightAccountReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightAccountReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightAccountReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Address, that1.Address) {
-		return false
-	}
-	if this.Age != that1.Age {
-		return false
-	}
-	if this.Count != that1.Count {
-		return false
+}
+func (m *LightAccountReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightAccountReq.Merge(m, src)
+}
+func (m *LightAccountReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightAccountReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightAccountReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightAccountReq proto.InternalMessageInfo
+
+func (m *LightAccountReq) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+	return 0
+}
+
+func (m *LightAccountReq) GetAddresses() []byte {
+	if m != nil {
+		return m.Addresses
 	}
-	return true
+	return nil
 }
-func (this *FrontierRsp) VerboseEqual(that interface{}) error {
-	if that == nil {
-		if this == nil {
-			return nil
+
+type LightStateProof struct {
+	Address              []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nodes                [][]byte `protobuf:"bytes,2,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Value                []byte   `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LightStateProof) Reset()      { *m = LightStateProof{} }
+func (*LightStateProof) ProtoMessage() {}
+func (*LightStateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{16}
+}
+func (m *LightStateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightStateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightStateProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		return fmt.Errorf("that == nil && this != nil")
+		return b[:n], nil
 	}
+}
+func (m *LightStateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightStateProof.Merge(m, src)
+}
+func (m *LightStateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightStateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightStateProof.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*FrontierRsp)
-	if !ok {
-		that2, ok := that.(FrontierRsp)
-		if ok {
-			that1 = &that2
-		} else {
-			return fmt.Errorf("that is not of type *FrontierRsp")
-		}
+var xxx_messageInfo_LightStateProof proto.InternalMessageInfo
+
+func (m *LightStateProof) GetAddress() []byte {
+	if m != nil {
+		return m.Address
 	}
-	if that1 == nil {
-		if this == nil {
-			return nil
-		}
-		return fmt.Errorf("that is type *FrontierRsp but is nil && this != nil")
-	} else if this == nil {
-		return fmt.Errorf("that is type *FrontierRsp but is not nil && this == nil")
+	return nil
+}
+
+func (m *LightStateProof) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
 	}
-	if len(this.Frontiers) != len(that1.Frontiers) {
-		return fmt.Errorf("Frontiers this(%v) Not Equal that(%v)", len(this.Frontiers), len(that1.Frontiers))
+	return nil
+}
+
+func (m *LightStateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	for i := range this.Frontiers {
-		if !bytes.Equal(this.Frontiers[i], that1.Frontiers[i]) {
-			return fmt.Errorf("Frontiers this[%v](%v) Not Equal that[%v](%v)", i, this.Frontiers[i], i, that1.Frontiers[i])
+	return nil
+}
+
+type LightAccountRsp struct {
+	Height               uint64             `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+	Hash                 []byte             `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Proofs               []*LightStateProof `protobuf:"bytes,3,rep,name=Proofs,proto3" json:"Proofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LightAccountRsp) Reset()      { *m = LightAccountRsp{} }
+func (*LightAccountRsp) ProtoMessage() {}
+func (*LightAccountRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{17}
+}
+func (m *LightAccountRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightAccountRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightAccountRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+}
+func (m *LightAccountRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightAccountRsp.Merge(m, src)
+}
+func (m *LightAccountRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightAccountRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightAccountRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightAccountRsp proto.InternalMessageInfo
+
+func (m *LightAccountRsp) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LightAccountRsp) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
 	return nil
 }
-func (this *FrontierRsp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *LightAccountRsp) GetProofs() []*LightStateProof {
+	if m != nil {
+		return m.Proofs
 	}
+	return nil
+}
 
-	that1, ok := that.(*FrontierRsp)
-	if !ok {
-		that2, ok := that.(FrontierRsp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type LightBlocksReq struct {
+	StartHash            []byte   `protobuf:"bytes,1,opt,name=StartHash,proto3" json:"StartHash,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LightBlocksReq) Reset()      { *m = LightBlocksReq{} }
+func (*LightBlocksReq) ProtoMessage() {}
+func (*LightBlocksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{18}
+}
+func (m *LightBlocksReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightBlocksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightBlocksReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *LightBlocksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightBlocksReq.Merge(m, src)
+}
+func (m *LightBlocksReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightBlocksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightBlocksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightBlocksReq proto.InternalMessageInfo
+
+func (m *LightBlocksReq) GetStartHash() []byte {
+	if m != nil {
+		return m.StartHash
 	}
-	if len(this.Frontiers) != len(that1.Frontiers) {
-		return false
+	return nil
+}
+
+func (m *LightBlocksReq) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	for i := range this.Frontiers {
-		if !bytes.Equal(this.Frontiers[i], that1.Frontiers[i]) {
-			return false
+	return 0
+}
+
+type LightBlocksRsp struct {
+	StartHash            []byte   `protobuf:"bytes,1,opt,name=StartHash,proto3" json:"StartHash,omitempty"`
+	Blocks               []byte   `protobuf:"bytes,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LightBlocksRsp) Reset()      { *m = LightBlocksRsp{} }
+func (*LightBlocksRsp) ProtoMessage() {}
+func (*LightBlocksRsp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{19}
+}
+func (m *LightBlocksRsp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightBlocksRsp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightBlocksRsp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
-		return false
+}
+func (m *LightBlocksRsp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightBlocksRsp.Merge(m, src)
+}
+func (m *LightBlocksRsp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightBlocksRsp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightBlocksRsp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightBlocksRsp proto.InternalMessageInfo
+
+func (m *LightBlocksRsp) GetStartHash() []byte {
+	if m != nil {
+		return m.StartHash
 	}
-	return true
+	return nil
 }
-func (this *BulkPullReq) VerboseEqual(that interface{}) error {
+
+func (m *LightBlocksRsp) GetBlocks() []byte {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type Handshake struct {
+	Version              uint32   `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	MinVersion           uint32   `protobuf:"varint,2,opt,name=MinVersion,proto3" json:"MinVersion,omitempty"`
+	Compressions         []string `protobuf:"bytes,3,rep,name=Compressions,proto3" json:"Compressions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Handshake) Reset()      { *m = Handshake{} }
+func (*Handshake) ProtoMessage() {}
+func (*Handshake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{20}
+}
+func (m *Handshake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Handshake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Handshake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Handshake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Handshake.Merge(m, src)
+}
+func (m *Handshake) XXX_Size() int {
+	return m.Size()
+}
+func (m *Handshake) XXX_DiscardUnknown() {
+	xxx_messageInfo_Handshake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Handshake proto.InternalMessageInfo
+
+func (m *Handshake) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Handshake) GetMinVersion() uint32 {
+	if m != nil {
+		return m.MinVersion
+	}
+	return 0
+}
+
+func (m *Handshake) GetCompressions() []string {
+	if m != nil {
+		return m.Compressions
+	}
+	return nil
+}
+
+type PrivacyPayloadPush struct {
+	Envelope             []byte   `protobuf:"bytes,1,opt,name=Envelope,proto3" json:"Envelope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrivacyPayloadPush) Reset()      { *m = PrivacyPayloadPush{} }
+func (*PrivacyPayloadPush) ProtoMessage() {}
+func (*PrivacyPayloadPush) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{21}
+}
+func (m *PrivacyPayloadPush) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivacyPayloadPush) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivacyPayloadPush.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivacyPayloadPush) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivacyPayloadPush.Merge(m, src)
+}
+func (m *PrivacyPayloadPush) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivacyPayloadPush) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivacyPayloadPush.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivacyPayloadPush proto.InternalMessageInfo
+
+func (m *PrivacyPayloadPush) GetEnvelope() []byte {
+	if m != nil {
+		return m.Envelope
+	}
+	return nil
+}
+
+type PrivacyPayloadReq struct {
+	EnclaveKey           []byte   `protobuf:"bytes,1,opt,name=EnclaveKey,proto3" json:"EnclaveKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrivacyPayloadReq) Reset()      { *m = PrivacyPayloadReq{} }
+func (*PrivacyPayloadReq) ProtoMessage() {}
+func (*PrivacyPayloadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{22}
+}
+func (m *PrivacyPayloadReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivacyPayloadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivacyPayloadReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivacyPayloadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivacyPayloadReq.Merge(m, src)
+}
+func (m *PrivacyPayloadReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivacyPayloadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivacyPayloadReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivacyPayloadReq proto.InternalMessageInfo
+
+func (m *PrivacyPayloadReq) GetEnclaveKey() []byte {
+	if m != nil {
+		return m.EnclaveKey
+	}
+	return nil
+}
+
+type PrivacyKeyAnnounce struct {
+	PubKey               []byte   `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrivacyKeyAnnounce) Reset()      { *m = PrivacyKeyAnnounce{} }
+func (*PrivacyKeyAnnounce) ProtoMessage() {}
+func (*PrivacyKeyAnnounce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{23}
+}
+func (m *PrivacyKeyAnnounce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrivacyKeyAnnounce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrivacyKeyAnnounce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrivacyKeyAnnounce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivacyKeyAnnounce.Merge(m, src)
+}
+func (m *PrivacyKeyAnnounce) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrivacyKeyAnnounce) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivacyKeyAnnounce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivacyKeyAnnounce proto.InternalMessageInfo
+
+func (m *PrivacyKeyAnnounce) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FrontierReq)(nil), "pb.FrontierReq")
+	proto.RegisterType((*FrontierRsp)(nil), "pb.FrontierRsp")
+	proto.RegisterType((*BulkPullReq)(nil), "pb.BulkPullReq")
+	proto.RegisterType((*BulkPullRsp)(nil), "pb.BulkPullRsp")
+	proto.RegisterType((*BulkPushBlock)(nil), "pb.BulkPushBlock")
+	proto.RegisterType((*PublishBlock)(nil), "pb.PublishBlock")
+	proto.RegisterType((*ConfirmReq)(nil), "pb.ConfirmReq")
+	proto.RegisterType((*ConfirmAck)(nil), "pb.ConfirmAck")
+	proto.RegisterType((*PovStatus)(nil), "pb.PovStatus")
+	proto.RegisterType((*PovPublishBlock)(nil), "pb.PovPublishBlock")
+	proto.RegisterType((*PovPullBlockReq)(nil), "pb.PovPullBlockReq")
+	proto.RegisterType((*PovPullBlockRsp)(nil), "pb.PovPullBlockRsp")
+	proto.RegisterType((*MessageAck)(nil), "pb.MessageAck")
+	proto.RegisterType((*LightHeaderReq)(nil), "pb.LightHeaderReq")
+	proto.RegisterType((*LightHeaderRsp)(nil), "pb.LightHeaderRsp")
+	proto.RegisterType((*LightAccountReq)(nil), "pb.LightAccountReq")
+	proto.RegisterType((*LightStateProof)(nil), "pb.LightStateProof")
+	proto.RegisterType((*LightAccountRsp)(nil), "pb.LightAccountRsp")
+	proto.RegisterType((*LightBlocksReq)(nil), "pb.LightBlocksReq")
+	proto.RegisterType((*LightBlocksRsp)(nil), "pb.LightBlocksRsp")
+	proto.RegisterType((*Handshake)(nil), "pb.Handshake")
+	proto.RegisterType((*PrivacyPayloadPush)(nil), "pb.PrivacyPayloadPush")
+	proto.RegisterType((*PrivacyPayloadReq)(nil), "pb.PrivacyPayloadReq")
+	proto.RegisterType((*PrivacyKeyAnnounce)(nil), "pb.PrivacyKeyAnnounce")
+}
+
+func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
+
+var fileDescriptor_33c57e4bae7b9afd = []byte{
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0xae, 0xdb, 0x44,
+	0x14, 0x66, 0xe2, 0x24, 0x90, 0x93, 0x84, 0x82, 0xb9, 0xba, 0x8a, 0xaa, 0xca, 0x8a, 0x46, 0x95,
+	0x1a, 0xd1, 0x92, 0x22, 0xfa, 0x04, 0xb9, 0xe9, 0x6d, 0x23, 0xb5, 0x05, 0xcb, 0xf7, 0x52, 0xd6,
+	0x63, 0x67, 0x6e, 0x62, 0xe2, 0xcc, 0xb8, 0x1e, 0x3b, 0x52, 0x76, 0xbc, 0x01, 0xaf, 0xc1, 0x96,
+	0x15, 0x2c, 0x59, 0xb2, 0x64, 0xc9, 0xb2, 0xc9, 0x13, 0xb0, 0x64, 0x89, 0xe6, 0xc7, 0xf1, 0x38,
+	0xdc, 0x9f, 0xee, 0xfc, 0x7d, 0x73, 0x7e, 0xbe, 0x73, 0xe6, 0xcc, 0x31, 0xf4, 0xd7, 0x54, 0x08,
+	0xb2, 0xa0, 0xe3, 0x34, 0xe3, 0x39, 0x77, 0x1b, 0x69, 0x78, 0xff, 0xab, 0x45, 0x9c, 0x2f, 0x8b,
+	0x70, 0x1c, 0xf1, 0xf5, 0xd3, 0x05, 0x5f, 0xf0, 0xa7, 0xea, 0x28, 0x2c, 0xae, 0x14, 0x52, 0x40,
+	0x7d, 0x69, 0x17, 0xfc, 0x1d, 0x74, 0x5f, 0x64, 0x9c, 0xe5, 0x31, 0xcd, 0x02, 0xfa, 0xce, 0x1d,
+	0xc0, 0xc7, 0x93, 0xf9, 0x3c, 0xa3, 0x42, 0x0c, 0xd0, 0x10, 0x8d, 0x7a, 0x41, 0x09, 0xdd, 0xcf,
+	0xc0, 0x99, 0x2c, 0xe8, 0xa0, 0x31, 0x44, 0xa3, 0x7e, 0x20, 0x3f, 0xdd, 0x13, 0x68, 0x4d, 0x79,
+	0xc1, 0xf2, 0x81, 0xa3, 0x38, 0x0d, 0xf0, 0x63, 0x2b, 0xa0, 0x48, 0xdd, 0x07, 0xd0, 0x29, 0xa1,
+	0x0c, 0xe9, 0x8c, 0x7a, 0x41, 0x45, 0xe0, 0x9f, 0x11, 0x74, 0xcf, 0x8a, 0x64, 0xe5, 0x17, 0x49,
+	0x22, 0xd3, 0x3f, 0x80, 0xce, 0x45, 0x4e, 0xb2, 0x7c, 0x46, 0xc4, 0xd2, 0x08, 0xa8, 0x08, 0x29,
+	0xee, 0x9c, 0xcd, 0xd5, 0x59, 0x43, 0x8b, 0x33, 0xd0, 0xbd, 0x0f, 0x9f, 0xc8, 0x10, 0x97, 0xdb,
+	0x94, 0x1a, 0x35, 0x07, 0x5c, 0xc9, 0x6c, 0x5a, 0x32, 0xdd, 0x53, 0x68, 0x4b, 0x4f, 0x2a, 0x06,
+	0x2d, 0x15, 0xca, 0x20, 0x3c, 0xb1, 0x04, 0x89, 0xb4, 0x16, 0x18, 0x1d, 0x05, 0x3e, 0x85, 0x76,
+	0x98, 0xf0, 0x68, 0x25, 0x8c, 0x1a, 0x83, 0xf0, 0x23, 0xe8, 0xeb, 0x10, 0x62, 0x79, 0x26, 0x19,
+	0xcb, 0x10, 0xd5, 0x0c, 0x1f, 0x42, 0xcf, 0x2f, 0xc2, 0x24, 0x2e, 0xed, 0x4e, 0xa0, 0xa5, 0x4e,
+	0x8c, 0x99, 0x06, 0x18, 0x03, 0x4c, 0x39, 0xbb, 0x8a, 0xb3, 0xb5, 0xec, 0x90, 0x65, 0xe3, 0x54,
+	0x36, 0xf9, 0xc1, 0x66, 0x12, 0xad, 0xd4, 0x25, 0x46, 0x91, 0xaa, 0xb9, 0xbc, 0x44, 0x0d, 0x55,
+	0x7f, 0xe3, 0x05, 0x23, 0x79, 0x91, 0x51, 0xa3, 0xba, 0x22, 0x64, 0xb1, 0x17, 0xf4, 0x5d, 0x41,
+	0x59, 0x74, 0xe8, 0x62, 0x89, 0x5d, 0x17, 0x9a, 0xaa, 0xf1, 0x4d, 0x95, 0x56, 0x7d, 0xe3, 0x5f,
+	0x11, 0x74, 0x7c, 0xbe, 0xb9, 0xc8, 0x49, 0x5e, 0x08, 0xf7, 0x21, 0xf4, 0xa7, 0x45, 0x96, 0x51,
+	0x96, 0xcf, 0x68, 0xbc, 0x58, 0xea, 0xdc, 0xcd, 0xa0, 0x4e, 0xba, 0x43, 0xe8, 0x96, 0x44, 0x75,
+	0x8f, 0x36, 0x25, 0x2d, 0x5e, 0x52, 0x46, 0x45, 0x2c, 0x94, 0x85, 0xa3, 0x2d, 0x2c, 0x4a, 0x56,
+	0x61, 0x1c, 0x2e, 0x9f, 0xab, 0x5b, 0xed, 0x05, 0x15, 0x21, 0x4f, 0x2f, 0xe3, 0x35, 0x15, 0x39,
+	0x59, 0xa7, 0xea, 0x72, 0x9d, 0xa0, 0x22, 0xf0, 0x23, 0xb8, 0xe7, 0xf3, 0xcd, 0x07, 0xb4, 0xfd,
+	0x37, 0x64, 0x2c, 0x93, 0x44, 0x99, 0xdd, 0x3d, 0x9e, 0x43, 0xe8, 0x6a, 0xa0, 0xcb, 0x6f, 0xa8,
+	0xf2, 0x6d, 0xea, 0xfa, 0x17, 0x53, 0x9b, 0xb1, 0xe6, 0xff, 0x67, 0x2c, 0xa0, 0x44, 0x70, 0xa6,
+	0x2a, 0xe9, 0x07, 0x06, 0x49, 0x9f, 0xd7, 0x3c, 0x22, 0x39, 0xcf, 0xc4, 0xa0, 0xad, 0x84, 0x1c,
+	0x30, 0xfe, 0xfe, 0x48, 0xb8, 0x48, 0x65, 0xe2, 0x6a, 0x1e, 0xfa, 0x81, 0x06, 0x55, 0xe1, 0x0d,
+	0xab, 0x70, 0x2b, 0xa5, 0x63, 0xa7, 0xc4, 0x63, 0x80, 0x37, 0x7a, 0xdb, 0xc8, 0x19, 0x1b, 0x42,
+	0xd7, 0xec, 0x1e, 0xab, 0x19, 0x36, 0x85, 0x67, 0xf0, 0xe9, 0x6b, 0x59, 0xf5, 0x8c, 0x92, 0xb9,
+	0x5e, 0x2e, 0x47, 0x0d, 0x42, 0xb7, 0x34, 0xa8, 0x61, 0xaf, 0x94, 0x2f, 0xeb, 0x91, 0x44, 0x2a,
+	0x27, 0x5c, 0x83, 0x72, 0xa7, 0x94, 0x10, 0xbf, 0x84, 0x7b, 0xca, 0xd6, 0x4c, 0xbc, 0x4c, 0x2b,
+	0x9f, 0xba, 0x9d, 0xd1, 0x20, 0x79, 0x9b, 0x66, 0xb9, 0xd1, 0xf2, 0x09, 0x57, 0x04, 0xfe, 0xc1,
+	0x04, 0x92, 0xd3, 0x4d, 0xfd, 0x8c, 0xf3, 0xab, 0x5b, 0x96, 0xe3, 0x09, 0xb4, 0xbe, 0xe5, 0x73,
+	0x15, 0x46, 0xbd, 0x4a, 0x05, 0x24, 0xfb, 0x96, 0x24, 0x05, 0x35, 0x33, 0xac, 0x01, 0xfe, 0xf1,
+	0x48, 0xa1, 0x48, 0x6f, 0x54, 0x58, 0x3e, 0x3a, 0x2d, 0x4e, 0x7d, 0xbb, 0x8f, 0xa1, 0xad, 0xd4,
+	0x88, 0x81, 0x33, 0x74, 0x46, 0xdd, 0x6f, 0xbe, 0x18, 0xa7, 0xe1, 0xf8, 0x48, 0x69, 0x60, 0x4c,
+	0xf0, 0x73, 0xd3, 0x39, 0x35, 0x08, 0xe2, 0xee, 0x11, 0xbe, 0xbe, 0xff, 0x2f, 0xea, 0x51, 0xf4,
+	0x56, 0xbf, 0x25, 0xca, 0x4d, 0x8b, 0x31, 0x86, 0xce, 0x8c, 0xb0, 0xb9, 0x58, 0x92, 0x15, 0x95,
+	0xcd, 0x7c, 0x4b, 0x33, 0x11, 0x73, 0x66, 0x86, 0xb2, 0x84, 0xae, 0x07, 0xf0, 0x26, 0x66, 0xe5,
+	0xa1, 0x56, 0x62, 0x31, 0x2e, 0x86, 0xde, 0x94, 0xaf, 0x53, 0xd9, 0xf8, 0x98, 0x33, 0xdd, 0x87,
+	0x4e, 0x50, 0xe3, 0xf0, 0xd7, 0xe0, 0xfa, 0x59, 0xbc, 0x21, 0xd1, 0xd6, 0x27, 0xdb, 0x84, 0x93,
+	0xb9, 0xdc, 0xc6, 0xf2, 0xd5, 0x9c, 0xb3, 0x0d, 0x4d, 0xb8, 0xd9, 0xe6, 0xbd, 0xe0, 0x80, 0xf1,
+	0x33, 0xf8, 0xbc, 0xee, 0x21, 0xbb, 0xe5, 0x01, 0x9c, 0xb3, 0x28, 0x21, 0x1b, 0xfa, 0x8a, 0x6e,
+	0x8d, 0x8b, 0xc5, 0xe0, 0x27, 0x87, 0x34, 0xaf, 0xe8, 0x76, 0xc2, 0x18, 0x2f, 0xe4, 0xae, 0x3c,
+	0x85, 0xb6, 0x5f, 0x84, 0x95, 0x87, 0x41, 0x67, 0x4f, 0xfe, 0xde, 0x79, 0x1f, 0xbd, 0xdf, 0x79,
+	0xe8, 0x9f, 0x9d, 0x87, 0xfe, 0xdd, 0x79, 0xe8, 0xa7, 0xbd, 0x87, 0x7e, 0xd9, 0x7b, 0xe8, 0xf7,
+	0xbd, 0x87, 0xfe, 0xd8, 0x7b, 0xe8, 0xcf, 0xbd, 0x87, 0xfe, 0xda, 0x7b, 0xe8, 0xfd, 0xde, 0x43,
+	0x61, 0x5b, 0xfd, 0xa0, 0x9f, 0xfd, 0x37, 0x00, 0xae, 0x0b, 0x80, 0x60, 0xe4, 0x07, 0x00, 0x00,
+}
+
+func (this *FrontierReq) VerboseEqual(that interface{}) error {
 	if that == nil {
 		if this == nil {
 			return nil
@@ -1002,31 +1472,173 @@ func (this *BulkPullReq) VerboseEqual(that interface{}) error {
 		return fmt.Errorf("that == nil && this != nil")
 	}
 
-	that1, ok := that.(*BulkPullReq)
+	that1, ok := that.(*FrontierReq)
 	if !ok {
-		that2, ok := that.(BulkPullReq)
+		that2, ok := that.(FrontierReq)
 		if ok {
 			that1 = &that2
 		} else {
-			return fmt.Errorf("that is not of type *BulkPullReq")
+			return fmt.Errorf("that is not of type *FrontierReq")
 		}
 	}
 	if that1 == nil {
 		if this == nil {
 			return nil
 		}
-		return fmt.Errorf("that is type *BulkPullReq but is nil && this != nil")
+		return fmt.Errorf("that is type *FrontierReq but is nil && this != nil")
 	} else if this == nil {
-		return fmt.Errorf("that is type *BulkPullReq but is not nil && this == nil")
-	}
-	if !bytes.Equal(this.StartHash, that1.StartHash) {
-		return fmt.Errorf("StartHash this(%v) Not Equal that(%v)", this.StartHash, that1.StartHash)
+		return fmt.Errorf("that is type *FrontierReq but is not nil && this == nil")
 	}
-	if !bytes.Equal(this.EndHash, that1.EndHash) {
-		return fmt.Errorf("EndHash this(%v) Not Equal that(%v)", this.EndHash, that1.EndHash)
+	if !bytes.Equal(this.Address, that1.Address) {
+		return fmt.Errorf("Address this(%v) Not Equal that(%v)", this.Address, that1.Address)
 	}
-	if this.PullType != that1.PullType {
-		return fmt.Errorf("PullType this(%v) Not Equal that(%v)", this.PullType, that1.PullType)
+	if this.Age != that1.Age {
+		return fmt.Errorf("Age this(%v) Not Equal that(%v)", this.Age, that1.Age)
+	}
+	if this.Count != that1.Count {
+		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *FrontierReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FrontierReq)
+	if !ok {
+		that2, ok := that.(FrontierReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if this.Age != that1.Age {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FrontierRsp) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*FrontierRsp)
+	if !ok {
+		that2, ok := that.(FrontierRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *FrontierRsp")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *FrontierRsp but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *FrontierRsp but is not nil && this == nil")
+	}
+	if len(this.Frontiers) != len(that1.Frontiers) {
+		return fmt.Errorf("Frontiers this(%v) Not Equal that(%v)", len(this.Frontiers), len(that1.Frontiers))
+	}
+	for i := range this.Frontiers {
+		if !bytes.Equal(this.Frontiers[i], that1.Frontiers[i]) {
+			return fmt.Errorf("Frontiers this[%v](%v) Not Equal that[%v](%v)", i, this.Frontiers[i], i, that1.Frontiers[i])
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
+}
+func (this *FrontierRsp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FrontierRsp)
+	if !ok {
+		that2, ok := that.(FrontierRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Frontiers) != len(that1.Frontiers) {
+		return false
+	}
+	for i := range this.Frontiers {
+		if !bytes.Equal(this.Frontiers[i], that1.Frontiers[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *BulkPullReq) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
+
+	that1, ok := that.(*BulkPullReq)
+	if !ok {
+		that2, ok := that.(BulkPullReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *BulkPullReq")
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *BulkPullReq but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *BulkPullReq but is not nil && this == nil")
+	}
+	if !bytes.Equal(this.StartHash, that1.StartHash) {
+		return fmt.Errorf("StartHash this(%v) Not Equal that(%v)", this.StartHash, that1.StartHash)
+	}
+	if !bytes.Equal(this.EndHash, that1.EndHash) {
+		return fmt.Errorf("EndHash this(%v) Not Equal that(%v)", this.EndHash, that1.EndHash)
+	}
+	if this.PullType != that1.PullType {
+		return fmt.Errorf("PullType this(%v) Not Equal that(%v)", this.PullType, that1.PullType)
 	}
 	if this.Count != that1.Count {
 		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
@@ -1788,1581 +2400,4468 @@ func (this *MessageAck) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *FrontierReq) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *LightHeaderReq) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.FrontierReq{")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "Age: "+fmt.Sprintf("%#v", this.Age)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*LightHeaderReq)
+	if !ok {
+		that2, ok := that.(LightHeaderReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightHeaderReq")
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *FrontierRsp) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LightHeaderReq but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightHeaderReq but is not nil && this == nil")
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.FrontierRsp{")
-	s = append(s, "Frontiers: "+fmt.Sprintf("%#v", this.Frontiers)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.StartHeight != that1.StartHeight {
+		return fmt.Errorf("StartHeight this(%v) Not Equal that(%v)", this.StartHeight, that1.StartHeight)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BulkPullReq) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Count != that1.Count {
+		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&pb.BulkPullReq{")
-	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
-	s = append(s, "EndHash: "+fmt.Sprintf("%#v", this.EndHash)+",\n")
-	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	s = append(s, "Hashes: "+fmt.Sprintf("%#v", this.Hashes)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *BulkPullRsp) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *LightHeaderReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&pb.BulkPullRsp{")
-	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
-	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*LightHeaderReq)
+	if !ok {
+		that2, ok := that.(LightHeaderReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *BulkPushBlock) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.BulkPushBlock{")
-	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if this.StartHeight != that1.StartHeight {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PublishBlock) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Count != that1.Count {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.PublishBlock{")
-	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ConfirmReq) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *LightHeaderRsp) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.ConfirmReq{")
-	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*LightHeaderRsp)
+	if !ok {
+		that2, ok := that.(LightHeaderRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightHeaderRsp")
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ConfirmAck) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LightHeaderRsp but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightHeaderRsp but is not nil && this == nil")
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&pb.ConfirmAck{")
-	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
-	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
-	s = append(s, "Sequence: "+fmt.Sprintf("%#v", this.Sequence)+",\n")
-	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if len(this.Headers) != len(that1.Headers) {
+		return fmt.Errorf("Headers this(%v) Not Equal that(%v)", len(this.Headers), len(that1.Headers))
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PovStatus) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Headers {
+		if !bytes.Equal(this.Headers[i], that1.Headers[i]) {
+			return fmt.Errorf("Headers this[%v](%v) Not Equal that[%v](%v)", i, this.Headers[i], i, that1.Headers[i])
+		}
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&pb.PovStatus{")
-	s = append(s, "CurrentHeight: "+fmt.Sprintf("%#v", this.CurrentHeight)+",\n")
-	s = append(s, "CurrentHash: "+fmt.Sprintf("%#v", this.CurrentHash)+",\n")
-	s = append(s, "GenesisHash: "+fmt.Sprintf("%#v", this.GenesisHash)+",\n")
-	s = append(s, "CurrentTD: "+fmt.Sprintf("%#v", this.CurrentTD)+",\n")
-	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *PovPublishBlock) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *LightHeaderRsp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.PovPublishBlock{")
-	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*LightHeaderRsp)
+	if !ok {
+		that2, ok := that.(LightHeaderRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PovPullBlockReq) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&pb.PovPullBlockReq{")
-	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
-	s = append(s, "StartHeight: "+fmt.Sprintf("%#v", this.StartHeight)+",\n")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
-	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
-	s = append(s, "Locators: "+fmt.Sprintf("%#v", this.Locators)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if len(this.Headers) != len(that1.Headers) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PovPullBlockRsp) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Headers {
+		if !bytes.Equal(this.Headers[i], that1.Headers[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&pb.PovPullBlockRsp{")
-	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
-	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
-	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *MessageAck) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *LightAccountReq) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&pb.MessageAck{")
-	s = append(s, "MessageHash: "+fmt.Sprintf("%#v", this.MessageHash)+",\n")
-	if this.XXX_unrecognized != nil {
-		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+
+	that1, ok := that.(*LightAccountReq)
+	if !ok {
+		that2, ok := that.(LightAccountReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightAccountReq")
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringMessage(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LightAccountReq but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightAccountReq but is not nil && this == nil")
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *FrontierReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Height != that1.Height {
+		return fmt.Errorf("Height this(%v) Not Equal that(%v)", this.Height, that1.Height)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FrontierReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.Addresses, that1.Addresses) {
+		return fmt.Errorf("Addresses this(%v) Not Equal that(%v)", this.Addresses, that1.Addresses)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *LightAccountReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *FrontierReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightAccountReq)
+	if !ok {
+		that2, ok := that.(LightAccountReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Count != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Age != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Age))
-		i--
-		dAtA[i] = 0x10
+	if this.Height != that1.Height {
+		return false
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.Addresses, that1.Addresses) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FrontierRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FrontierRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *LightStateProof) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *FrontierRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightStateProof)
+	if !ok {
+		that2, ok := that.(LightStateProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightStateProof")
+		}
 	}
-	if len(m.Frontiers) > 0 {
-		for iNdEx := len(m.Frontiers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Frontiers[iNdEx])
-			copy(dAtA[i:], m.Frontiers[iNdEx])
-			i = encodeVarintMessage(dAtA, i, uint64(len(m.Frontiers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *LightStateProof but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightStateProof but is not nil && this == nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BulkPullReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Address, that1.Address) {
+		return fmt.Errorf("Address this(%v) Not Equal that(%v)", this.Address, that1.Address)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BulkPullReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if len(this.Nodes) != len(that1.Nodes) {
+		return fmt.Errorf("Nodes this(%v) Not Equal that(%v)", len(this.Nodes), len(that1.Nodes))
+	}
+	for i := range this.Nodes {
+		if !bytes.Equal(this.Nodes[i], that1.Nodes[i]) {
+			return fmt.Errorf("Nodes this[%v](%v) Not Equal that[%v](%v)", i, this.Nodes[i], i, that1.Nodes[i])
+		}
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return fmt.Errorf("Value this(%v) Not Equal that(%v)", this.Value, that1.Value)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *LightStateProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BulkPullReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightStateProof)
+	if !ok {
+		that2, ok := that.(LightStateProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Hashes) > 0 {
-		i -= len(m.Hashes)
-		copy(dAtA[i:], m.Hashes)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Hashes)))
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Count != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x20
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
 	}
-	if m.PullType != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.PullType))
-		i--
-		dAtA[i] = 0x18
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
 	}
-	if len(m.EndHash) > 0 {
-		i -= len(m.EndHash)
-		copy(dAtA[i:], m.EndHash)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.EndHash)))
-		i--
-		dAtA[i] = 0x12
+	for i := range this.Nodes {
+		if !bytes.Equal(this.Nodes[i], that1.Nodes[i]) {
+			return false
+		}
 	}
-	if len(m.StartHash) > 0 {
-		i -= len(m.StartHash)
-		copy(dAtA[i:], m.StartHash)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartHash)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BulkPullRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BulkPullRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *LightAccountRsp) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *BulkPullRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightAccountRsp)
+	if !ok {
+		that2, ok := that.(LightAccountRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightAccountRsp")
+		}
 	}
-	if len(m.Blocks) > 0 {
-		i -= len(m.Blocks)
-		copy(dAtA[i:], m.Blocks)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Blocks)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LightAccountRsp but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightAccountRsp but is not nil && this == nil")
 	}
-	if m.PullType != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.PullType))
-		i--
-		dAtA[i] = 0x8
+	if this.Height != that1.Height {
+		return fmt.Errorf("Height this(%v) Not Equal that(%v)", this.Height, that1.Height)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BulkPushBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return fmt.Errorf("Hash this(%v) Not Equal that(%v)", this.Hash, that1.Hash)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BulkPushBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BulkPushBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(this.Proofs) != len(that1.Proofs) {
+		return fmt.Errorf("Proofs this(%v) Not Equal that(%v)", len(this.Proofs), len(that1.Proofs))
 	}
-	if len(m.Blocks) > 0 {
-		i -= len(m.Blocks)
-		copy(dAtA[i:], m.Blocks)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Blocks)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.Proofs {
+		if !this.Proofs[i].Equal(that1.Proofs[i]) {
+			return fmt.Errorf("Proofs this[%v](%v) Not Equal that[%v](%v)", i, this.Proofs[i], i, that1.Proofs[i])
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PublishBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PublishBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
+func (this *LightAccountRsp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PublishBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightAccountRsp)
+	if !ok {
+		that2, ok := that.(LightAccountRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Block) > 0 {
-		i -= len(m.Block)
-		copy(dAtA[i:], m.Block)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Block)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConfirmReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Height != that1.Height {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfirmReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConfirmReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
 	}
-	if len(m.Block) > 0 {
-		for iNdEx := len(m.Block) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Block[iNdEx])
-			copy(dAtA[i:], m.Block[iNdEx])
-			i = encodeVarintMessage(dAtA, i, uint64(len(m.Block[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	if len(this.Proofs) != len(that1.Proofs) {
+		return false
+	}
+	for i := range this.Proofs {
+		if !this.Proofs[i].Equal(that1.Proofs[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
+func (this *LightBlocksReq) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func (m *ConfirmAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ConfirmAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConfirmAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightBlocksReq)
+	if !ok {
+		that2, ok := that.(LightBlocksReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightBlocksReq")
+		}
 	}
-	if len(m.Hash) > 0 {
-		for iNdEx := len(m.Hash) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hash[iNdEx])
-			copy(dAtA[i:], m.Hash[iNdEx])
-			i = encodeVarintMessage(dAtA, i, uint64(len(m.Hash[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *LightBlocksReq but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightBlocksReq but is not nil && this == nil")
 	}
-	if m.Sequence != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+	if !bytes.Equal(this.StartHash, that1.StartHash) {
+		return fmt.Errorf("StartHash this(%v) Not Equal that(%v)", this.StartHash, that1.StartHash)
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
+	if this.Count != that1.Count {
+		return fmt.Errorf("Count this(%v) Not Equal that(%v)", this.Count, that1.Count)
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-
-func (m *PovStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *LightBlocksReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PovStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *PovStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x28
+	that1, ok := that.(*LightBlocksReq)
+	if !ok {
+		that2, ok := that.(LightBlocksReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.CurrentTD) > 0 {
-		i -= len(m.CurrentTD)
-		copy(dAtA[i:], m.CurrentTD)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.CurrentTD)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x1a
+	if !bytes.Equal(this.StartHash, that1.StartHash) {
+		return false
 	}
-	if len(m.CurrentHash) > 0 {
-		i -= len(m.CurrentHash)
-		copy(dAtA[i:], m.CurrentHash)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.CurrentHash)))
-		i--
-		dAtA[i] = 0x12
+	if this.Count != that1.Count {
+		return false
 	}
-	if m.CurrentHeight != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.CurrentHeight))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *PovPublishBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *LightBlocksRsp) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PovPublishBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *PovPublishBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightBlocksRsp)
+	if !ok {
+		that2, ok := that.(LightBlocksRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *LightBlocksRsp")
+		}
 	}
-	if len(m.Block) > 0 {
-		i -= len(m.Block)
-		copy(dAtA[i:], m.Block)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Block)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *LightBlocksRsp but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *LightBlocksRsp but is not nil && this == nil")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PovPullBlockReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.StartHash, that1.StartHash) {
+		return fmt.Errorf("StartHash this(%v) Not Equal that(%v)", this.StartHash, that1.StartHash)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PovPullBlockReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.Blocks, that1.Blocks) {
+		return fmt.Errorf("Blocks this(%v) Not Equal that(%v)", this.Blocks, that1.Blocks)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *LightBlocksRsp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PovPullBlockReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*LightBlocksRsp)
+	if !ok {
+		that2, ok := that.(LightBlocksRsp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Locators) > 0 {
-		i -= len(m.Locators)
-		copy(dAtA[i:], m.Locators)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Locators)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Reason != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Reason))
-		i--
-		dAtA[i] = 0x28
+	if !bytes.Equal(this.StartHash, that1.StartHash) {
+		return false
 	}
-	if m.PullType != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.PullType))
-		i--
-		dAtA[i] = 0x20
+	if !bytes.Equal(this.Blocks, that1.Blocks) {
+		return false
 	}
-	if m.Count != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x18
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x10
+	return true
+}
+func (this *Handshake) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	if len(m.StartHash) > 0 {
-		i -= len(m.StartHash)
-		copy(dAtA[i:], m.StartHash)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartHash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func (m *PovPullBlockRsp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Handshake)
+	if !ok {
+		that2, ok := that.(Handshake)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *Handshake")
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PovPullBlockRsp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PovPullBlockRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *Handshake but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *Handshake but is not nil && this == nil")
 	}
-	if m.Reason != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Reason))
-		i--
-		dAtA[i] = 0x18
+	if this.Version != that1.Version {
+		return fmt.Errorf("Version this(%v) Not Equal that(%v)", this.Version, that1.Version)
 	}
-	if len(m.Block) > 0 {
-		i -= len(m.Block)
-		copy(dAtA[i:], m.Block)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Block)))
-		i--
-		dAtA[i] = 0x12
+	if this.MinVersion != that1.MinVersion {
+		return fmt.Errorf("MinVersion this(%v) Not Equal that(%v)", this.MinVersion, that1.MinVersion)
 	}
-	if m.Count != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+	if len(this.Compressions) != len(that1.Compressions) {
+		return fmt.Errorf("Compressions this(%v) Not Equal that(%v)", len(this.Compressions), len(that1.Compressions))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MessageAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.Compressions {
+		if this.Compressions[i] != that1.Compressions[i] {
+			return fmt.Errorf("Compressions this[%v](%v) Not Equal that[%v](%v)", i, this.Compressions[i], i, that1.Compressions[i])
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MessageAck) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *Handshake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MessageAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	that1, ok := that.(*Handshake)
+	if !ok {
+		that2, ok := that.(Handshake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.MessageHash) > 0 {
-		i -= len(m.MessageHash)
-		copy(dAtA[i:], m.MessageHash)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.MessageHash)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.Version != that1.Version {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedFrontierReq(r randyMessage, easy bool) *FrontierReq {
-	this := &FrontierReq{}
-	v1 := r.Intn(100)
-	this.Address = make([]byte, v1)
-	for i := 0; i < v1; i++ {
-		this.Address[i] = byte(r.Intn(256))
+	if this.MinVersion != that1.MinVersion {
+		return false
 	}
-	this.Age = uint32(r.Uint32())
-	this.Count = uint32(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	if len(this.Compressions) != len(that1.Compressions) {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedFrontierRsp(r randyMessage, easy bool) *FrontierRsp {
-	this := &FrontierRsp{}
-	v2 := r.Intn(10)
-	this.Frontiers = make([][]byte, v2)
-	for i := 0; i < v2; i++ {
-		v3 := r.Intn(100)
-		this.Frontiers[i] = make([]byte, v3)
-		for j := 0; j < v3; j++ {
-			this.Frontiers[i][j] = byte(r.Intn(256))
+	for i := range this.Compressions {
+		if this.Compressions[i] != that1.Compressions[i] {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
+func (this *PrivacyPayloadPush) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func NewPopulatedBulkPullReq(r randyMessage, easy bool) *BulkPullReq {
-	this := &BulkPullReq{}
-	v4 := r.Intn(100)
-	this.StartHash = make([]byte, v4)
-	for i := 0; i < v4; i++ {
-		this.StartHash[i] = byte(r.Intn(256))
+	that1, ok := that.(*PrivacyPayloadPush)
+	if !ok {
+		that2, ok := that.(PrivacyPayloadPush)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PrivacyPayloadPush")
+		}
 	}
-	v5 := r.Intn(100)
-	this.EndHash = make([]byte, v5)
-	for i := 0; i < v5; i++ {
-		this.EndHash[i] = byte(r.Intn(256))
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PrivacyPayloadPush but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PrivacyPayloadPush but is not nil && this == nil")
 	}
-	this.PullType = uint32(r.Uint32())
-	this.Count = uint32(r.Uint32())
-	v6 := r.Intn(100)
-	this.Hashes = make([]byte, v6)
-	for i := 0; i < v6; i++ {
-		this.Hashes[i] = byte(r.Intn(256))
+	if !bytes.Equal(this.Envelope, that1.Envelope) {
+		return fmt.Errorf("Envelope this(%v) Not Equal that(%v)", this.Envelope, that1.Envelope)
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 6)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
-	return this
+	return nil
 }
+func (this *PrivacyPayloadPush) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedBulkPullRsp(r randyMessage, easy bool) *BulkPullRsp {
-	this := &BulkPullRsp{}
-	this.PullType = uint32(r.Uint32())
-	v7 := r.Intn(100)
-	this.Blocks = make([]byte, v7)
-	for i := 0; i < v7; i++ {
-		this.Blocks[i] = byte(r.Intn(256))
+	that1, ok := that.(*PrivacyPayloadPush)
+	if !ok {
+		that2, ok := that.(PrivacyPayloadPush)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 3)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-func NewPopulatedBulkPushBlock(r randyMessage, easy bool) *BulkPushBlock {
-	this := &BulkPushBlock{}
-	v8 := r.Intn(100)
-	this.Blocks = make([]byte, v8)
-	for i := 0; i < v8; i++ {
-		this.Blocks[i] = byte(r.Intn(256))
+	if !bytes.Equal(this.Envelope, that1.Envelope) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
+func (this *PrivacyPayloadReq) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
+	}
 
-func NewPopulatedPublishBlock(r randyMessage, easy bool) *PublishBlock {
-	this := &PublishBlock{}
-	v9 := r.Intn(100)
-	this.Block = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.Block[i] = byte(r.Intn(256))
+	that1, ok := that.(*PrivacyPayloadReq)
+	if !ok {
+		that2, ok := that.(PrivacyPayloadReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PrivacyPayloadReq")
+		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	if that1 == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that is type *PrivacyPayloadReq but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PrivacyPayloadReq but is not nil && this == nil")
 	}
-	return this
+	if !bytes.Equal(this.EnclaveKey, that1.EnclaveKey) {
+		return fmt.Errorf("EnclaveKey this(%v) Not Equal that(%v)", this.EnclaveKey, that1.EnclaveKey)
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
+func (this *PrivacyPayloadReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewPopulatedConfirmReq(r randyMessage, easy bool) *ConfirmReq {
-	this := &ConfirmReq{}
-	v10 := r.Intn(10)
-	this.Block = make([][]byte, v10)
-	for i := 0; i < v10; i++ {
-		v11 := r.Intn(100)
-		this.Block[i] = make([]byte, v11)
-		for j := 0; j < v11; j++ {
-			this.Block[i][j] = byte(r.Intn(256))
+	that1, ok := that.(*PrivacyPayloadReq)
+	if !ok {
+		that2, ok := that.(PrivacyPayloadReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
+	if !bytes.Equal(this.EnclaveKey, that1.EnclaveKey) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
 }
-
-func NewPopulatedConfirmAck(r randyMessage, easy bool) *ConfirmAck {
-	this := &ConfirmAck{}
-	v12 := r.Intn(100)
-	this.Account = make([]byte, v12)
-	for i := 0; i < v12; i++ {
-		this.Account[i] = byte(r.Intn(256))
+func (this *PrivacyKeyAnnounce) VerboseEqual(that interface{}) error {
+	if that == nil {
+		if this == nil {
+			return nil
+		}
+		return fmt.Errorf("that == nil && this != nil")
 	}
-	v13 := r.Intn(100)
-	this.Signature = make([]byte, v13)
-	for i := 0; i < v13; i++ {
-		this.Signature[i] = byte(r.Intn(256))
+
+	that1, ok := that.(*PrivacyKeyAnnounce)
+	if !ok {
+		that2, ok := that.(PrivacyKeyAnnounce)
+		if ok {
+			that1 = &that2
+		} else {
+			return fmt.Errorf("that is not of type *PrivacyKeyAnnounce")
+		}
 	}
-	this.Sequence = uint32(r.Uint32())
-	v14 := r.Intn(10)
-	this.Hash = make([][]byte, v14)
-	for i := 0; i < v14; i++ {
-		v15 := r.Intn(100)
-		this.Hash[i] = make([]byte, v15)
-		for j := 0; j < v15; j++ {
-			this.Hash[i][j] = byte(r.Intn(256))
+	if that1 == nil {
+		if this == nil {
+			return nil
 		}
+		return fmt.Errorf("that is type *PrivacyKeyAnnounce but is nil && this != nil")
+	} else if this == nil {
+		return fmt.Errorf("that is type *PrivacyKeyAnnounce but is not nil && this == nil")
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 5)
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return fmt.Errorf("PubKey this(%v) Not Equal that(%v)", this.PubKey, that1.PubKey)
 	}
-	return this
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
+	}
+	return nil
 }
-
-func NewPopulatedPovStatus(r randyMessage, easy bool) *PovStatus {
-	this := &PovStatus{}
-	this.CurrentHeight = uint64(uint64(r.Uint32()))
-	v16 := r.Intn(100)
-	this.CurrentHash = make([]byte, v16)
-	for i := 0; i < v16; i++ {
-		this.CurrentHash[i] = byte(r.Intn(256))
+func (this *PrivacyKeyAnnounce) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	v17 := r.Intn(100)
-	this.GenesisHash = make([]byte, v17)
-	for i := 0; i < v17; i++ {
-		this.GenesisHash[i] = byte(r.Intn(256))
+
+	that1, ok := that.(*PrivacyKeyAnnounce)
+	if !ok {
+		that2, ok := that.(PrivacyKeyAnnounce)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	v18 := r.Intn(100)
-	this.CurrentTD = make([]byte, v18)
-	for i := 0; i < v18; i++ {
-		this.CurrentTD[i] = byte(r.Intn(256))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	this.Timestamp = int64(r.Int63())
-	if r.Intn(2) == 0 {
-		this.Timestamp *= -1
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 6)
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedPovPublishBlock(r randyMessage, easy bool) *PovPublishBlock {
-	this := &PovPublishBlock{}
-	v19 := r.Intn(100)
-	this.Block = make([]byte, v19)
-	for i := 0; i < v19; i++ {
-		this.Block[i] = byte(r.Intn(256))
+func (this *FrontierReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.FrontierReq{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Age: "+fmt.Sprintf("%#v", this.Age)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return this
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedPovPullBlockReq(r randyMessage, easy bool) *PovPullBlockReq {
-	this := &PovPullBlockReq{}
-	v20 := r.Intn(100)
-	this.StartHash = make([]byte, v20)
-	for i := 0; i < v20; i++ {
-		this.StartHash[i] = byte(r.Intn(256))
-	}
-	this.StartHeight = uint64(uint64(r.Uint32()))
-	this.Count = uint32(r.Uint32())
-	this.PullType = uint32(r.Uint32())
-	this.Reason = uint32(r.Uint32())
-	v21 := r.Intn(100)
-	this.Locators = make([]byte, v21)
-	for i := 0; i < v21; i++ {
-		this.Locators[i] = byte(r.Intn(256))
+func (this *FrontierRsp) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 7)
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.FrontierRsp{")
+	s = append(s, "Frontiers: "+fmt.Sprintf("%#v", this.Frontiers)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return this
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedPovPullBlockRsp(r randyMessage, easy bool) *PovPullBlockRsp {
-	this := &PovPullBlockRsp{}
-	this.Count = uint32(r.Uint32())
-	v22 := r.Intn(100)
-	this.Block = make([]byte, v22)
-	for i := 0; i < v22; i++ {
-		this.Block[i] = byte(r.Intn(256))
+func (this *BulkPullReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	this.Reason = uint32(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	s := make([]string, 0, 9)
+	s = append(s, "&pb.BulkPullReq{")
+	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
+	s = append(s, "EndHash: "+fmt.Sprintf("%#v", this.EndHash)+",\n")
+	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Hashes: "+fmt.Sprintf("%#v", this.Hashes)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return this
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func NewPopulatedMessageAck(r randyMessage, easy bool) *MessageAck {
-	this := &MessageAck{}
-	v23 := r.Intn(100)
-	this.MessageHash = make([]byte, v23)
-	for i := 0; i < v23; i++ {
-		this.MessageHash[i] = byte(r.Intn(256))
+func (this *BulkPullRsp) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.BulkPullRsp{")
+	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
+	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return this
-}
-
-type randyMessage interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func randUTF8RuneMessage(r randyMessage) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func (this *BulkPushBlock) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return rune(ru + 61)
-}
-func randStringMessage(r randyMessage) string {
-	v24 := r.Intn(100)
-	tmps := make([]rune, v24)
-	for i := 0; i < v24; i++ {
-		tmps[i] = randUTF8RuneMessage(r)
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.BulkPushBlock{")
+	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return string(tmps)
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func randUnrecognizedMessage(r randyMessage, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldMessage(dAtA, r, fieldNumber, wire)
+func (this *PublishBlock) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.PublishBlock{")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func randFieldMessage(dAtA []byte, r randyMessage, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
-		v25 := r.Int63()
-		if r.Intn(2) == 0 {
-			v25 *= -1
-		}
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(v25))
-	case 1:
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+func (this *ConfirmReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA
-}
-func encodeVarintPopulateMessage(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.ConfirmReq{")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *FrontierReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+func (this *ConfirmAck) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Age != 0 {
-		n += 1 + sovMessage(uint64(m.Age))
+	s := make([]string, 0, 8)
+	s = append(s, "&pb.ConfirmAck{")
+	s = append(s, "Account: "+fmt.Sprintf("%#v", this.Account)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Sequence: "+fmt.Sprintf("%#v", this.Sequence)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Count != 0 {
-		n += 1 + sovMessage(uint64(m.Count))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PovStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 9)
+	s = append(s, "&pb.PovStatus{")
+	s = append(s, "CurrentHeight: "+fmt.Sprintf("%#v", this.CurrentHeight)+",\n")
+	s = append(s, "CurrentHash: "+fmt.Sprintf("%#v", this.CurrentHash)+",\n")
+	s = append(s, "GenesisHash: "+fmt.Sprintf("%#v", this.GenesisHash)+",\n")
+	s = append(s, "CurrentTD: "+fmt.Sprintf("%#v", this.CurrentTD)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *FrontierRsp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Frontiers) > 0 {
-		for _, b := range m.Frontiers {
-			l = len(b)
-			n += 1 + l + sovMessage(uint64(l))
-		}
+func (this *PovPublishBlock) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.PovPublishBlock{")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *BulkPullReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StartHash)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+func (this *PovPullBlockReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.EndHash)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&pb.PovPullBlockReq{")
+	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
+	s = append(s, "StartHeight: "+fmt.Sprintf("%#v", this.StartHeight)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "PullType: "+fmt.Sprintf("%#v", this.PullType)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Locators: "+fmt.Sprintf("%#v", this.Locators)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.PullType != 0 {
-		n += 1 + sovMessage(uint64(m.PullType))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PovPullBlockRsp) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Count != 0 {
-		n += 1 + sovMessage(uint64(m.Count))
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.PovPullBlockRsp{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Block: "+fmt.Sprintf("%#v", this.Block)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	l = len(m.Hashes)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MessageAck) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.MessageAck{")
+	s = append(s, "MessageHash: "+fmt.Sprintf("%#v", this.MessageHash)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *BulkPullRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *LightHeaderReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.PullType != 0 {
-		n += 1 + sovMessage(uint64(m.PullType))
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.LightHeaderReq{")
+	s = append(s, "StartHeight: "+fmt.Sprintf("%#v", this.StartHeight)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	l = len(m.Blocks)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LightHeaderRsp) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.LightHeaderRsp{")
+	s = append(s, "Headers: "+fmt.Sprintf("%#v", this.Headers)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *BulkPushBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *LightAccountReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Blocks)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.LightAccountReq{")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LightStateProof) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.LightStateProof{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nodes: "+fmt.Sprintf("%#v", this.Nodes)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PublishBlock) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *LightAccountRsp) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Block)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.LightAccountRsp{")
+	s = append(s, "Height: "+fmt.Sprintf("%#v", this.Height)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.Proofs != nil {
+		s = append(s, "Proofs: "+fmt.Sprintf("%#v", this.Proofs)+",\n")
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ConfirmReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *LightBlocksReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.Block) > 0 {
-		for _, b := range m.Block {
-			l = len(b)
-			n += 1 + l + sovMessage(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.LightBlocksReq{")
+	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ConfirmAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *LightBlocksRsp) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&pb.LightBlocksRsp{")
+	s = append(s, "StartHash: "+fmt.Sprintf("%#v", this.StartHash)+",\n")
+	s = append(s, "Blocks: "+fmt.Sprintf("%#v", this.Blocks)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Handshake) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovMessage(uint64(m.Sequence))
+	s := make([]string, 0, 7)
+	s = append(s, "&pb.Handshake{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "MinVersion: "+fmt.Sprintf("%#v", this.MinVersion)+",\n")
+	s = append(s, "Compressions: "+fmt.Sprintf("%#v", this.Compressions)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if len(m.Hash) > 0 {
-		for _, b := range m.Hash {
-			l = len(b)
-			n += 1 + l + sovMessage(uint64(l))
-		}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PrivacyPayloadPush) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.PrivacyPayloadPush{")
+	s = append(s, "Envelope: "+fmt.Sprintf("%#v", this.Envelope)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PovStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CurrentHeight != 0 {
-		n += 1 + sovMessage(uint64(m.CurrentHeight))
+func (this *PrivacyPayloadReq) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.CurrentHash)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.PrivacyPayloadReq{")
+	s = append(s, "EnclaveKey: "+fmt.Sprintf("%#v", this.EnclaveKey)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PrivacyKeyAnnounce) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.CurrentTD)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&pb.PrivacyKeyAnnounce{")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	if this.XXX_unrecognized != nil {
+		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovMessage(uint64(m.Timestamp))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringMessage(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *FrontierReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PovPublishBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Block)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *FrontierReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PovPullBlockReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FrontierReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StartHash)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovMessage(uint64(m.StartHeight))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Count != 0 {
-		n += 1 + sovMessage(uint64(m.Count))
-	}
-	if m.PullType != 0 {
-		n += 1 + sovMessage(uint64(m.PullType))
-	}
-	if m.Reason != 0 {
-		n += 1 + sovMessage(uint64(m.Reason))
+		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Locators)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.Age != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PovPullBlockRsp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FrontierRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FrontierRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrontierRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		n += 1 + sovMessage(uint64(m.Count))
-	}
-	l = len(m.Block)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
-	if m.Reason != 0 {
-		n += 1 + sovMessage(uint64(m.Reason))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Frontiers) > 0 {
+		for iNdEx := len(m.Frontiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frontiers[iNdEx])
+			copy(dAtA[i:], m.Frontiers[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Frontiers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MessageAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BulkPullReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkPullReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkPullReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MessageHash)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *FrontierReq) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Hashes) > 0 {
+		i -= len(m.Hashes)
+		copy(dAtA[i:], m.Hashes)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Hashes)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	s := strings.Join([]string{`&FrontierReq{`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`Age:` + fmt.Sprintf("%v", this.Age) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FrontierRsp) String() string {
-	if this == nil {
-		return "nil"
+	if m.Count != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
 	}
-	s := strings.Join([]string{`&FrontierRsp{`,
-		`Frontiers:` + fmt.Sprintf("%v", this.Frontiers) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BulkPullReq) String() string {
-	if this == nil {
-		return "nil"
+	if m.PullType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PullType))
+		i--
+		dAtA[i] = 0x18
 	}
-	s := strings.Join([]string{`&BulkPullReq{`,
-		`StartHash:` + fmt.Sprintf("%v", this.StartHash) + `,`,
-		`EndHash:` + fmt.Sprintf("%v", this.EndHash) + `,`,
-		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`Hashes:` + fmt.Sprintf("%v", this.Hashes) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BulkPullRsp) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.EndHash) > 0 {
+		i -= len(m.EndHash)
+		copy(dAtA[i:], m.EndHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.EndHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&BulkPullRsp{`,
-		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
-		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *BulkPushBlock) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.StartHash) > 0 {
+		i -= len(m.StartHash)
+		copy(dAtA[i:], m.StartHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&BulkPushBlock{`,
-		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *PublishBlock) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BulkPullRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PublishBlock{`,
-		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ConfirmReq) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ConfirmReq{`,
-		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *BulkPullRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ConfirmAck) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BulkPullRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&ConfirmAck{`,
-		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
-		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
-		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
-		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PovStatus) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Blocks) > 0 {
+		i -= len(m.Blocks)
+		copy(dAtA[i:], m.Blocks)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Blocks)))
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&PovStatus{`,
-		`CurrentHeight:` + fmt.Sprintf("%v", this.CurrentHeight) + `,`,
-		`CurrentHash:` + fmt.Sprintf("%v", this.CurrentHash) + `,`,
-		`GenesisHash:` + fmt.Sprintf("%v", this.GenesisHash) + `,`,
-		`CurrentTD:` + fmt.Sprintf("%v", this.CurrentTD) + `,`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PovPublishBlock) String() string {
-	if this == nil {
-		return "nil"
+	if m.PullType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PullType))
+		i--
+		dAtA[i] = 0x8
 	}
-	s := strings.Join([]string{`&PovPublishBlock{`,
-		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *PovPullBlockReq) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BulkPushBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PovPullBlockReq{`,
-		`StartHash:` + fmt.Sprintf("%v", this.StartHash) + `,`,
-		`StartHeight:` + fmt.Sprintf("%v", this.StartHeight) + `,`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Locators:` + fmt.Sprintf("%v", this.Locators) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *PovPullBlockRsp) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PovPullBlockRsp{`,
-		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
-		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *BulkPushBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *MessageAck) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *BulkPushBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	s := strings.Join([]string{`&MessageAck{`,
-		`MessageHash:` + fmt.Sprintf("%v", this.MessageHash) + `,`,
-		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Blocks) > 0 {
+		i -= len(m.Blocks)
+		copy(dAtA[i:], m.Blocks)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Blocks)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func valueToStringMessage(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *PublishBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return dAtA[:n], nil
 }
-func (m *FrontierReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *PublishBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Block) > 0 {
+		for iNdEx := len(m.Block) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Block[iNdEx])
+			copy(dAtA[i:], m.Block[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Block[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Hash) > 0 {
+		for iNdEx := len(m.Hash) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hash[iNdEx])
+			copy(dAtA[i:], m.Hash[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Hash[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PovStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PovStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PovStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CurrentTD) > 0 {
+		i -= len(m.CurrentTD)
+		copy(dAtA[i:], m.CurrentTD)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CurrentTD)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentHash) > 0 {
+		i -= len(m.CurrentHash)
+		copy(dAtA[i:], m.CurrentHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.CurrentHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PovPublishBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PovPublishBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PovPublishBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PovPullBlockReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PovPullBlockReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PovPullBlockReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Locators) > 0 {
+		i -= len(m.Locators)
+		copy(dAtA[i:], m.Locators)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Locators)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PullType != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PullType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StartHash) > 0 {
+		i -= len(m.StartHash)
+		copy(dAtA[i:], m.StartHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PovPullBlockRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PovPullBlockRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PovPullBlockRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reason != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Block) > 0 {
+		i -= len(m.Block)
+		copy(dAtA[i:], m.Block)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Block)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Count != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightHeaderReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightHeaderReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightHeaderReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightHeaderRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightHeaderRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightHeaderRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightAccountReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightAccountReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightAccountReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Addresses) > 0 {
+		i -= len(m.Addresses)
+		copy(dAtA[i:], m.Addresses)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Addresses)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightStateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightStateProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightStateProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nodes[iNdEx])
+			copy(dAtA[i:], m.Nodes[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Nodes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightAccountRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightAccountRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightAccountRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightBlocksReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightBlocksReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightBlocksReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Count != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StartHash) > 0 {
+		i -= len(m.StartHash)
+		copy(dAtA[i:], m.StartHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightBlocksRsp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightBlocksRsp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightBlocksRsp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Blocks) > 0 {
+		i -= len(m.Blocks)
+		copy(dAtA[i:], m.Blocks)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Blocks)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartHash) > 0 {
+		i -= len(m.StartHash)
+		copy(dAtA[i:], m.StartHash)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.StartHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Handshake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Handshake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Handshake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Compressions) > 0 {
+		for iNdEx := len(m.Compressions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Compressions[iNdEx])
+			copy(dAtA[i:], m.Compressions[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.Compressions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MinVersion != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.MinVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivacyPayloadPush) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivacyPayloadPush) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivacyPayloadPush) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Envelope) > 0 {
+		i -= len(m.Envelope)
+		copy(dAtA[i:], m.Envelope)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Envelope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivacyPayloadReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivacyPayloadReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivacyPayloadReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EnclaveKey) > 0 {
+		i -= len(m.EnclaveKey)
+		copy(dAtA[i:], m.EnclaveKey)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.EnclaveKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrivacyKeyAnnounce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrivacyKeyAnnounce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrivacyKeyAnnounce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedFrontierReq(r randyMessage, easy bool) *FrontierReq {
+	this := &FrontierReq{}
+	v1 := r.Intn(100)
+	this.Address = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.Address[i] = byte(r.Intn(256))
+	}
+	this.Age = uint32(r.Uint32())
+	this.Count = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedFrontierRsp(r randyMessage, easy bool) *FrontierRsp {
+	this := &FrontierRsp{}
+	v2 := r.Intn(10)
+	this.Frontiers = make([][]byte, v2)
+	for i := 0; i < v2; i++ {
+		v3 := r.Intn(100)
+		this.Frontiers[i] = make([]byte, v3)
+		for j := 0; j < v3; j++ {
+			this.Frontiers[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedBulkPullReq(r randyMessage, easy bool) *BulkPullReq {
+	this := &BulkPullReq{}
+	v4 := r.Intn(100)
+	this.StartHash = make([]byte, v4)
+	for i := 0; i < v4; i++ {
+		this.StartHash[i] = byte(r.Intn(256))
+	}
+	v5 := r.Intn(100)
+	this.EndHash = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.EndHash[i] = byte(r.Intn(256))
+	}
+	this.PullType = uint32(r.Uint32())
+	this.Count = uint32(r.Uint32())
+	v6 := r.Intn(100)
+	this.Hashes = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.Hashes[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedBulkPullRsp(r randyMessage, easy bool) *BulkPullRsp {
+	this := &BulkPullRsp{}
+	this.PullType = uint32(r.Uint32())
+	v7 := r.Intn(100)
+	this.Blocks = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.Blocks[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedBulkPushBlock(r randyMessage, easy bool) *BulkPushBlock {
+	this := &BulkPushBlock{}
+	v8 := r.Intn(100)
+	this.Blocks = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.Blocks[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedPublishBlock(r randyMessage, easy bool) *PublishBlock {
+	this := &PublishBlock{}
+	v9 := r.Intn(100)
+	this.Block = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.Block[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedConfirmReq(r randyMessage, easy bool) *ConfirmReq {
+	this := &ConfirmReq{}
+	v10 := r.Intn(10)
+	this.Block = make([][]byte, v10)
+	for i := 0; i < v10; i++ {
+		v11 := r.Intn(100)
+		this.Block[i] = make([]byte, v11)
+		for j := 0; j < v11; j++ {
+			this.Block[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedConfirmAck(r randyMessage, easy bool) *ConfirmAck {
+	this := &ConfirmAck{}
+	v12 := r.Intn(100)
+	this.Account = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.Account[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.Signature = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.Signature[i] = byte(r.Intn(256))
+	}
+	this.Sequence = uint32(r.Uint32())
+	v14 := r.Intn(10)
+	this.Hash = make([][]byte, v14)
+	for i := 0; i < v14; i++ {
+		v15 := r.Intn(100)
+		this.Hash[i] = make([]byte, v15)
+		for j := 0; j < v15; j++ {
+			this.Hash[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 5)
+	}
+	return this
+}
+
+func NewPopulatedPovStatus(r randyMessage, easy bool) *PovStatus {
+	this := &PovStatus{}
+	this.CurrentHeight = uint64(uint64(r.Uint32()))
+	v16 := r.Intn(100)
+	this.CurrentHash = make([]byte, v16)
+	for i := 0; i < v16; i++ {
+		this.CurrentHash[i] = byte(r.Intn(256))
+	}
+	v17 := r.Intn(100)
+	this.GenesisHash = make([]byte, v17)
+	for i := 0; i < v17; i++ {
+		this.GenesisHash[i] = byte(r.Intn(256))
+	}
+	v18 := r.Intn(100)
+	this.CurrentTD = make([]byte, v18)
+	for i := 0; i < v18; i++ {
+		this.CurrentTD[i] = byte(r.Intn(256))
+	}
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 6)
+	}
+	return this
+}
+
+func NewPopulatedPovPublishBlock(r randyMessage, easy bool) *PovPublishBlock {
+	this := &PovPublishBlock{}
+	v19 := r.Intn(100)
+	this.Block = make([]byte, v19)
+	for i := 0; i < v19; i++ {
+		this.Block[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedPovPullBlockReq(r randyMessage, easy bool) *PovPullBlockReq {
+	this := &PovPullBlockReq{}
+	v20 := r.Intn(100)
+	this.StartHash = make([]byte, v20)
+	for i := 0; i < v20; i++ {
+		this.StartHash[i] = byte(r.Intn(256))
+	}
+	this.StartHeight = uint64(uint64(r.Uint32()))
+	this.Count = uint32(r.Uint32())
+	this.PullType = uint32(r.Uint32())
+	this.Reason = uint32(r.Uint32())
+	v21 := r.Intn(100)
+	this.Locators = make([]byte, v21)
+	for i := 0; i < v21; i++ {
+		this.Locators[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 7)
+	}
+	return this
+}
+
+func NewPopulatedPovPullBlockRsp(r randyMessage, easy bool) *PovPullBlockRsp {
+	this := &PovPullBlockRsp{}
+	this.Count = uint32(r.Uint32())
+	v22 := r.Intn(100)
+	this.Block = make([]byte, v22)
+	for i := 0; i < v22; i++ {
+		this.Block[i] = byte(r.Intn(256))
+	}
+	this.Reason = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedMessageAck(r randyMessage, easy bool) *MessageAck {
+	this := &MessageAck{}
+	v23 := r.Intn(100)
+	this.MessageHash = make([]byte, v23)
+	for i := 0; i < v23; i++ {
+		this.MessageHash[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLightHeaderReq(r randyMessage, easy bool) *LightHeaderReq {
+	this := &LightHeaderReq{}
+	this.StartHeight = uint64(uint64(r.Uint32()))
+	this.Count = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLightHeaderRsp(r randyMessage, easy bool) *LightHeaderRsp {
+	this := &LightHeaderRsp{}
+	v24 := r.Intn(10)
+	this.Headers = make([][]byte, v24)
+	for i := 0; i < v24; i++ {
+		v25 := r.Intn(100)
+		this.Headers[i] = make([]byte, v25)
+		for j := 0; j < v25; j++ {
+			this.Headers[i][j] = byte(r.Intn(256))
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedLightAccountReq(r randyMessage, easy bool) *LightAccountReq {
+	this := &LightAccountReq{}
+	this.Height = uint64(uint64(r.Uint32()))
+	v26 := r.Intn(100)
+	this.Addresses = make([]byte, v26)
+	for i := 0; i < v26; i++ {
+		this.Addresses[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLightStateProof(r randyMessage, easy bool) *LightStateProof {
+	this := &LightStateProof{}
+	v27 := r.Intn(100)
+	this.Address = make([]byte, v27)
+	for i := 0; i < v27; i++ {
+		this.Address[i] = byte(r.Intn(256))
+	}
+	v28 := r.Intn(10)
+	this.Nodes = make([][]byte, v28)
+	for i := 0; i < v28; i++ {
+		v29 := r.Intn(100)
+		this.Nodes[i] = make([]byte, v29)
+		for j := 0; j < v29; j++ {
+			this.Nodes[i][j] = byte(r.Intn(256))
+		}
+	}
+	v30 := r.Intn(100)
+	this.Value = make([]byte, v30)
+	for i := 0; i < v30; i++ {
+		this.Value[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedLightAccountRsp(r randyMessage, easy bool) *LightAccountRsp {
+	this := &LightAccountRsp{}
+	this.Height = uint64(uint64(r.Uint32()))
+	v31 := r.Intn(100)
+	this.Hash = make([]byte, v31)
+	for i := 0; i < v31; i++ {
+		this.Hash[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		v32 := r.Intn(5)
+		this.Proofs = make([]*LightStateProof, v32)
+		for i := 0; i < v32; i++ {
+			this.Proofs[i] = NewPopulatedLightStateProof(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedLightBlocksReq(r randyMessage, easy bool) *LightBlocksReq {
+	this := &LightBlocksReq{}
+	v33 := r.Intn(100)
+	this.StartHash = make([]byte, v33)
+	for i := 0; i < v33; i++ {
+		this.StartHash[i] = byte(r.Intn(256))
+	}
+	this.Count = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedLightBlocksRsp(r randyMessage, easy bool) *LightBlocksRsp {
+	this := &LightBlocksRsp{}
+	v34 := r.Intn(100)
+	this.StartHash = make([]byte, v34)
+	for i := 0; i < v34; i++ {
+		this.StartHash[i] = byte(r.Intn(256))
+	}
+	v35 := r.Intn(100)
+	this.Blocks = make([]byte, v35)
+	for i := 0; i < v35; i++ {
+		this.Blocks[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 3)
+	}
+	return this
+}
+
+func NewPopulatedHandshake(r randyMessage, easy bool) *Handshake {
+	this := &Handshake{}
+	this.Version = uint32(r.Uint32())
+	this.MinVersion = uint32(r.Uint32())
+	v36 := r.Intn(10)
+	this.Compressions = make([]string, v36)
+	for i := 0; i < v36; i++ {
+		this.Compressions[i] = string(randStringMessage(r))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 4)
+	}
+	return this
+}
+
+func NewPopulatedPrivacyPayloadPush(r randyMessage, easy bool) *PrivacyPayloadPush {
+	this := &PrivacyPayloadPush{}
+	v37 := r.Intn(100)
+	this.Envelope = make([]byte, v37)
+	for i := 0; i < v37; i++ {
+		this.Envelope[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedPrivacyPayloadReq(r randyMessage, easy bool) *PrivacyPayloadReq {
+	this := &PrivacyPayloadReq{}
+	v38 := r.Intn(100)
+	this.EnclaveKey = make([]byte, v38)
+	for i := 0; i < v38; i++ {
+		this.EnclaveKey[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+func NewPopulatedPrivacyKeyAnnounce(r randyMessage, easy bool) *PrivacyKeyAnnounce {
+	this := &PrivacyKeyAnnounce{}
+	v39 := r.Intn(100)
+	this.PubKey = make([]byte, v39)
+	for i := 0; i < v39; i++ {
+		this.PubKey[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+		this.XXX_unrecognized = randUnrecognizedMessage(r, 2)
+	}
+	return this
+}
+
+type randyMessage interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneMessage(r randyMessage) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringMessage(r randyMessage) string {
+	v40 := r.Intn(100)
+	tmps := make([]rune, v40)
+	for i := 0; i < v40; i++ {
+		tmps[i] = randUTF8RuneMessage(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedMessage(r randyMessage, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldMessage(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldMessage(dAtA []byte, r randyMessage, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
+		v41 := r.Int63()
+		if r.Intn(2) == 0 {
+			v41 *= -1
+		}
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(v41))
+	case 1:
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateMessage(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateMessage(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *FrontierReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Age != 0 {
+		n += 1 + sovMessage(uint64(m.Age))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMessage(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrontierRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Frontiers) > 0 {
+		for _, b := range m.Frontiers {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkPullReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.EndHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PullType != 0 {
+		n += 1 + sovMessage(uint64(m.PullType))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMessage(uint64(m.Count))
+	}
+	l = len(m.Hashes)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkPullRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PullType != 0 {
+		n += 1 + sovMessage(uint64(m.PullType))
+	}
+	l = len(m.Blocks)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BulkPushBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Blocks)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfirmReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Block) > 0 {
+		for _, b := range m.Block {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConfirmAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMessage(uint64(m.Sequence))
+	}
+	if len(m.Hash) > 0 {
+		for _, b := range m.Hash {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PovStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovMessage(uint64(m.CurrentHeight))
+	}
+	l = len(m.CurrentHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.CurrentTD)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovMessage(uint64(m.Timestamp))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PovPublishBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PovPullBlockReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMessage(uint64(m.StartHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMessage(uint64(m.Count))
+	}
+	if m.PullType != 0 {
+		n += 1 + sovMessage(uint64(m.PullType))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovMessage(uint64(m.Reason))
+	}
+	l = len(m.Locators)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PovPullBlockRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovMessage(uint64(m.Count))
+	}
+	l = len(m.Block)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovMessage(uint64(m.Reason))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessageAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightHeaderReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMessage(uint64(m.StartHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMessage(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightHeaderRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, b := range m.Headers {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightAccountReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMessage(uint64(m.Height))
+	}
+	l = len(m.Addresses)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightStateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			l = len(b)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightAccountRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMessage(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightBlocksReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovMessage(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LightBlocksRsp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartHash)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Blocks)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Handshake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMessage(uint64(m.Version))
+	}
+	if m.MinVersion != 0 {
+		n += 1 + sovMessage(uint64(m.MinVersion))
+	}
+	if len(m.Compressions) > 0 {
+		for _, s := range m.Compressions {
+			l = len(s)
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrivacyPayloadPush) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Envelope)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrivacyPayloadReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnclaveKey)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrivacyKeyAnnounce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *FrontierReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FrontierReq{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Age:` + fmt.Sprintf("%v", this.Age) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FrontierRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FrontierRsp{`,
+		`Frontiers:` + fmt.Sprintf("%v", this.Frontiers) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkPullReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkPullReq{`,
+		`StartHash:` + fmt.Sprintf("%v", this.StartHash) + `,`,
+		`EndHash:` + fmt.Sprintf("%v", this.EndHash) + `,`,
+		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Hashes:` + fmt.Sprintf("%v", this.Hashes) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkPullRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkPullRsp{`,
+		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
+		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BulkPushBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BulkPushBlock{`,
+		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublishBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublishBlock{`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmReq{`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfirmAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfirmAck{`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PovStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PovStatus{`,
+		`CurrentHeight:` + fmt.Sprintf("%v", this.CurrentHeight) + `,`,
+		`CurrentHash:` + fmt.Sprintf("%v", this.CurrentHash) + `,`,
+		`GenesisHash:` + fmt.Sprintf("%v", this.GenesisHash) + `,`,
+		`CurrentTD:` + fmt.Sprintf("%v", this.CurrentTD) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PovPublishBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PovPublishBlock{`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PovPullBlockReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PovPullBlockReq{`,
+		`StartHash:` + fmt.Sprintf("%v", this.StartHash) + `,`,
+		`StartHeight:` + fmt.Sprintf("%v", this.StartHeight) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`PullType:` + fmt.Sprintf("%v", this.PullType) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Locators:` + fmt.Sprintf("%v", this.Locators) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PovPullBlockRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PovPullBlockRsp{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Block:` + fmt.Sprintf("%v", this.Block) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageAck{`,
+		`MessageHash:` + fmt.Sprintf("%v", this.MessageHash) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightHeaderReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightHeaderReq{`,
+		`StartHeight:` + fmt.Sprintf("%v", this.StartHeight) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightHeaderRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightHeaderRsp{`,
+		`Headers:` + fmt.Sprintf("%v", this.Headers) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightAccountReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightAccountReq{`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightStateProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightStateProof{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightAccountRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProofs := "[]*LightStateProof{"
+	for _, f := range this.Proofs {
+		repeatedStringForProofs += strings.Replace(f.String(), "LightStateProof", "LightStateProof", 1) + ","
+	}
+	repeatedStringForProofs += "}"
+	s := strings.Join([]string{`&LightAccountRsp{`,
+		`Height:` + fmt.Sprintf("%v", this.Height) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Proofs:` + repeatedStringForProofs + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightBlocksReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightBlocksReq{`,
+		`StartHash:` + fmt.Sprintf("%v", this.StartHash) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightBlocksRsp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightBlocksRsp{`,
+		`StartHash:` + fmt.Sprintf("%v", this.StartHash) + `,`,
+		`Blocks:` + fmt.Sprintf("%v", this.Blocks) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Handshake) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Handshake{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`MinVersion:` + fmt.Sprintf("%v", this.MinVersion) + `,`,
+		`Compressions:` + fmt.Sprintf("%v", this.Compressions) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrivacyPayloadPush) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrivacyPayloadPush{`,
+		`Envelope:` + fmt.Sprintf("%v", this.Envelope) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrivacyPayloadReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrivacyPayloadReq{`,
+		`EnclaveKey:` + fmt.Sprintf("%v", this.EnclaveKey) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrivacyKeyAnnounce) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrivacyKeyAnnounce{`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMessage(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *FrontierReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrontierReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrontierReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrontierRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrontierRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrontierRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frontiers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frontiers = append(m.Frontiers, make([]byte, postIndex-iNdEx))
+			copy(m.Frontiers[len(m.Frontiers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPullReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPullReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartHash = append(m.StartHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartHash == nil {
+				m.StartHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndHash = append(m.EndHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndHash == nil {
+				m.EndHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullType", wireType)
+			}
+			m.PullType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hashes == nil {
+				m.Hashes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPullRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPullRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPullRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullType", wireType)
+			}
+			m.PullType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PullType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks[:0], dAtA[iNdEx:postIndex]...)
+			if m.Blocks == nil {
+				m.Blocks = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BulkPushBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BulkPushBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BulkPushBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks[:0], dAtA[iNdEx:postIndex]...)
+			if m.Blocks == nil {
+				m.Blocks = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
+			if m.Block == nil {
+				m.Block = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block, make([]byte, postIndex-iNdEx))
+			copy(m.Block[len(m.Block)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
+			if m.Account == nil {
+				m.Account = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash, make([]byte, postIndex-iNdEx))
+			copy(m.Hash[len(m.Hash)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PovStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowMessage
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PovStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PovStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CurrentHash = append(m.CurrentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentHash == nil {
+				m.CurrentHash = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FrontierReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FrontierReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3389,16 +6888,16 @@ func (m *FrontierReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTD", wireType)
 			}
-			m.Age = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3408,16 +6907,31 @@ func (m *FrontierReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Age |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentTD = append(m.CurrentTD[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentTD == nil {
+				m.CurrentTD = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Count = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3427,7 +6941,7 @@ func (m *FrontierReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3457,7 +6971,7 @@ func (m *FrontierReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FrontierRsp) Unmarshal(dAtA []byte) error {
+func (m *PovPublishBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3480,15 +6994,15 @@ func (m *FrontierRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FrontierRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PovPublishBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FrontierRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PovPublishBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frontiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3515,8 +7029,10 @@ func (m *FrontierRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frontiers = append(m.Frontiers, make([]byte, postIndex-iNdEx))
-			copy(m.Frontiers[len(m.Frontiers)-1], dAtA[iNdEx:postIndex])
+			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
+			if m.Block == nil {
+				m.Block = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3543,7 +7059,7 @@ func (m *FrontierRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
+func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3566,10 +7082,10 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkPullReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PovPullBlockReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkPullReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PovPullBlockReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3607,10 +7123,10 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var byteLen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3620,27 +7136,31 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.EndHash = append(m.EndHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndHash == nil {
-				m.EndHash = []byte{}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PullType", wireType)
 			}
@@ -3659,7 +7179,114 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locators = append(m.Locators[:0], dAtA[iNdEx:postIndex]...)
+			if m.Locators == nil {
+				m.Locators = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PovPullBlockRsp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PovPullBlockRsp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PovPullBlockRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
@@ -3678,9 +7305,9 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3707,11 +7334,30 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hashes = append(m.Hashes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hashes == nil {
-				m.Hashes = []byte{}
+			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
+			if m.Block == nil {
+				m.Block = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3737,7 +7383,7 @@ func (m *BulkPullReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkPullRsp) Unmarshal(dAtA []byte) error {
+func (m *MessageAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,34 +7406,15 @@ func (m *BulkPullRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkPullRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MessageAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkPullRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MessageAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullType", wireType)
-			}
-			m.PullType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PullType |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3814,9 +7441,9 @@ func (m *BulkPullRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks[:0], dAtA[iNdEx:postIndex]...)
-			if m.Blocks == nil {
-				m.Blocks = []byte{}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3844,7 +7471,7 @@ func (m *BulkPullRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkPushBlock) Unmarshal(dAtA []byte) error {
+func (m *LightHeaderReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3867,17 +7494,17 @@ func (m *BulkPushBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkPushBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightHeaderReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkPushBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightHeaderReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var byteLen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -3887,26 +7514,30 @@ func (m *BulkPushBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Blocks = append(m.Blocks[:0], dAtA[iNdEx:postIndex]...)
-			if m.Blocks == nil {
-				m.Blocks = []byte{}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -3932,7 +7563,7 @@ func (m *BulkPushBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishBlock) Unmarshal(dAtA []byte) error {
+func (m *LightHeaderRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3955,15 +7586,15 @@ func (m *PublishBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightHeaderRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightHeaderRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3990,10 +7621,8 @@ func (m *PublishBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
-			if m.Block == nil {
-				m.Block = []byte{}
-			}
+			m.Headers = append(m.Headers, make([]byte, postIndex-iNdEx))
+			copy(m.Headers[len(m.Headers)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4020,7 +7649,7 @@ func (m *PublishBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfirmReq) Unmarshal(dAtA []byte) error {
+func (m *LightAccountReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4043,15 +7672,34 @@ func (m *ConfirmReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfirmReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightAccountReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfirmReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightAccountReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4078,8 +7726,10 @@ func (m *ConfirmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Block = append(m.Block, make([]byte, postIndex-iNdEx))
-			copy(m.Block[len(m.Block)-1], dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses[:0], dAtA[iNdEx:postIndex]...)
+			if m.Addresses == nil {
+				m.Addresses = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4106,7 +7756,7 @@ func (m *ConfirmReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
+func (m *LightStateProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4129,15 +7779,15 @@ func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfirmAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightStateProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfirmAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightStateProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4164,14 +7814,14 @@ func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
-			if m.Account == nil {
-				m.Account = []byte{}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4198,33 +7848,12 @@ func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
+			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4251,8 +7880,10 @@ func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash, make([]byte, postIndex-iNdEx))
-			copy(m.Hash[len(m.Hash)-1], dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4279,7 +7910,7 @@ func (m *ConfirmAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PovStatus) Unmarshal(dAtA []byte) error {
+func (m *LightAccountRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4302,17 +7933,17 @@ func (m *PovStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PovStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightAccountRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PovStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightAccountRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.CurrentHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4322,14 +7953,14 @@ func (m *PovStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentHeight |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4356,84 +7987,16 @@ func (m *PovStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentHash = append(m.CurrentHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentHash == nil {
-				m.CurrentHash = []byte{}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTD", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentTD = append(m.CurrentTD[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentTD == nil {
-				m.CurrentTD = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
-			m.Timestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4443,11 +8006,26 @@ func (m *PovStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &LightStateProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4473,7 +8051,7 @@ func (m *PovStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PovPublishBlock) Unmarshal(dAtA []byte) error {
+func (m *LightBlocksReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4496,15 +8074,15 @@ func (m *PovPublishBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PovPublishBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightBlocksReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PovPublishBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightBlocksReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4531,11 +8109,30 @@ func (m *PovPublishBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
-			if m.Block == nil {
-				m.Block = []byte{}
+			m.StartHash = append(m.StartHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartHash == nil {
+				m.StartHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4561,7 +8158,7 @@ func (m *PovPublishBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
+func (m *LightBlocksRsp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4584,10 +8181,10 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PovPullBlockReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightBlocksRsp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PovPullBlockReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightBlocksRsp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4625,10 +8222,10 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			m.StartHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4638,16 +8235,85 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks[:0], dAtA[iNdEx:postIndex]...)
+			if m.Blocks == nil {
+				m.Blocks = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Handshake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Handshake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Handshake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Count = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4657,16 +8323,16 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVersion", wireType)
 			}
-			m.PullType = 0
+			m.MinVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4676,16 +8342,16 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PullType |= uint32(b&0x7F) << shift
+				m.MinVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressions", wireType)
 			}
-			m.Reason = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4695,14 +8361,81 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Compressions = append(m.Compressions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrivacyPayloadPush) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrivacyPayloadPush: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrivacyPayloadPush: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4729,9 +8462,9 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Locators = append(m.Locators[:0], dAtA[iNdEx:postIndex]...)
-			if m.Locators == nil {
-				m.Locators = []byte{}
+			m.Envelope = append(m.Envelope[:0], dAtA[iNdEx:postIndex]...)
+			if m.Envelope == nil {
+				m.Envelope = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4759,7 +8492,7 @@ func (m *PovPullBlockReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PovPullBlockRsp) Unmarshal(dAtA []byte) error {
+func (m *PrivacyPayloadReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4782,34 +8515,15 @@ func (m *PovPullBlockRsp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PovPullBlockRsp: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrivacyPayloadReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PovPullBlockRsp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrivacyPayloadReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4836,30 +8550,11 @@ func (m *PovPullBlockRsp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Block = append(m.Block[:0], dAtA[iNdEx:postIndex]...)
-			if m.Block == nil {
-				m.Block = []byte{}
+			m.EnclaveKey = append(m.EnclaveKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EnclaveKey == nil {
+				m.EnclaveKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			m.Reason = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Reason |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4885,7 +8580,7 @@ func (m *PovPullBlockRsp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageAck) Unmarshal(dAtA []byte) error {
+func (m *PrivacyKeyAnnounce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4908,15 +8603,15 @@ func (m *MessageAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrivacyKeyAnnounce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrivacyKeyAnnounce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4943,9 +8638,9 @@ func (m *MessageAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageHash == nil {
-				m.MessageHash = []byte{}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -0,0 +1,84 @@
+package protos
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/qlcchain/go-qlc/p2p/protos/pb"
+)
+
+// PrivacyPayloadPush carries an encrypted private payload, only recipients of the payload keep it
+type PrivacyPayloadPush struct {
+	Envelope []byte
+}
+
+// PrivacyPayloadReq asks nodes which keep the payload of EnclaveKey to push it again
+type PrivacyPayloadReq struct {
+	EnclaveKey []byte
+}
+
+// PrivacyKeyAnnounce tells the peer the ptm key of this node, payloads for the key are pushed to this node
+type PrivacyKeyAnnounce struct {
+	PubKey []byte
+}
+
+func PrivacyPayloadPushToProto(push *PrivacyPayloadPush) ([]byte, error) {
+	pbPush := &pb.PrivacyPayloadPush{
+		Envelope: push.Envelope,
+	}
+	return proto.Marshal(pbPush)
+}
+
+func PrivacyPayloadPushFromProto(data []byte) (*PrivacyPayloadPush, error) {
+	pbPush := new(pb.PrivacyPayloadPush)
+	if err := proto.Unmarshal(data, pbPush); err != nil {
+		return nil, err
+	}
+	if len(pbPush.Envelope) == 0 {
+		return nil, errors.New("empty privacy envelope")
+	}
+	return &PrivacyPayloadPush{
+		Envelope: pbPush.Envelope,
+	}, nil
+}
+
+func PrivacyPayloadReqToProto(req *PrivacyPayloadReq) ([]byte, error) {
+	pbReq := &pb.PrivacyPayloadReq{
+		EnclaveKey: req.EnclaveKey,
+	}
+	return proto.Marshal(pbReq)
+}
+
+func PrivacyPayloadReqFromProto(data []byte) (*PrivacyPayloadReq, error) {
+	pbReq := new(pb.PrivacyPayloadReq)
+	if err := proto.Unmarshal(data, pbReq); err != nil {
+		return nil, err
+	}
+	if len(pbReq.EnclaveKey) == 0 {
+		return nil, errors.New("empty privacy enclave key")
+	}
+	return &PrivacyPayloadReq{
+		EnclaveKey: pbReq.EnclaveKey,
+	}, nil
+}
+
+func PrivacyKeyAnnounceToProto(announce *PrivacyKeyAnnounce) ([]byte, error) {
+	pbAnnounce := &pb.PrivacyKeyAnnounce{
+		PubKey: announce.PubKey,
+	}
+	return proto.Marshal(pbAnnounce)
+}
+
+func PrivacyKeyAnnounceFromProto(data []byte) (*PrivacyKeyAnnounce, error) {
+	pbAnnounce := new(pb.PrivacyKeyAnnounce)
+	if err := proto.Unmarshal(data, pbAnnounce); err != nil {
+		return nil, err
+	}
+	if len(pbAnnounce.PubKey) == 0 {
+		return nil, errors.New("empty privacy key")
+	}
+	return &PrivacyKeyAnnounce{
+		PubKey: pbAnnounce.PubKey,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package protos
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+func TestPrivacyPayloadPush(t *testing.T) {
+	push := &PrivacyPayloadPush{Envelope: util.RandomFixedBytes(256)}
+	data, err := PrivacyPayloadPushToProto(push)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := PrivacyPayloadPushFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(p.Envelope, push.Envelope) {
+		t.Fatal("invalid envelope")
+	}
+
+	data, _ = PrivacyPayloadPushToProto(&PrivacyPayloadPush{})
+	if _, err := PrivacyPayloadPushFromProto(data); err == nil {
+		t.Fatal("empty envelope should be rejected")
+	}
+}
+
+func TestPrivacyPayloadReq(t *testing.T) {
+	req := &PrivacyPayloadReq{EnclaveKey: util.RandomFixedBytes(64)}
+	data, err := PrivacyPayloadReqToProto(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := PrivacyPayloadReqFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(r.EnclaveKey, req.EnclaveKey) {
+		t.Fatal("invalid enclave key")
+	}
+
+	if _, err := PrivacyPayloadReqFromProto([]byte{0xff}); err == nil {
+		t.Fatal("invalid data should be rejected")
+	}
+}
+
+func TestPrivacyKeyAnnounce(t *testing.T) {
+	announce := &PrivacyKeyAnnounce{PubKey: util.RandomFixedBytes(32)}
+	data, err := PrivacyKeyAnnounceToProto(announce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := PrivacyKeyAnnounceFromProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(a.PubKey, announce.PubKey) {
+		t.Fatal("invalid key")
+	}
+
+	data, _ = PrivacyKeyAnnounceToProto(&PrivacyKeyAnnounce{})
+	if _, err := PrivacyKeyAnnounceFromProto(data); err == nil {
+		t.Fatal("empty key should be rejected")
+	}
+}
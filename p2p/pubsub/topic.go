@@ -18,6 +18,7 @@ type Message interface {
 	GetSource() peer.ID
 	GetSender() peer.ID
 	GetData() []byte
+	GetValidatorData() interface{}
 }
 
 type message struct {
@@ -80,3 +81,7 @@ func (m message) GetSource() peer.ID {
 func (m message) GetData() []byte {
 	return m.inner.GetData()
 }
+
+func (m message) GetValidatorData() interface{} {
+	return m.inner.ValidatorData
+}
@@ -40,6 +40,7 @@ func NewQlcService(cfgFile string) (*QlcService, error) {
 	l := ledger.NewLedger(cfgFile)
 	msgService := NewMessageService(ns, l)
 	ns.msgService = msgService
+	node.setPeerScorer(NewPeerScorer(l))
 	return ns, nil
 }
 
@@ -68,6 +69,10 @@ func (ns *QlcService) Start() error {
 	if err := ns.setWhiteList(); err != nil {
 		return err
 	}
+	// load peer bans
+	if err := ns.node.peerScorer.Load(); err != nil {
+		ns.node.logger.Errorf("load peer bans: %s", err)
+	}
 
 	// start node.
 	if err := ns.node.StartServices(); err != nil {
@@ -124,11 +129,17 @@ func (ns *QlcService) setEvent() error {
 			if len(msg.NodeId) != 0 {
 				ns.node.updateWhiteList(msg.NodeId, msg.NodeUrl)
 			}
+		case *topic.EventPeerBanUpdateMsg:
+			ns.node.peerScorer.SetBan(msg.PeerID, msg.Ban)
+			if msg.Ban != nil {
+				ns.node.disconnectPeer(msg.PeerID)
+			}
 		}
 	}), ns.msgEvent)
 
 	if err := ns.subscriber.Subscribe(topic.EventBroadcast, topic.EventSendMsgToSingle, topic.EventFrontiersReq,
-		topic.EventRepresentativeNode, topic.EventConsensusSyncFinished, topic.EventPermissionNodeUpdate); err != nil {
+		topic.EventRepresentativeNode, topic.EventConsensusSyncFinished, topic.EventPermissionNodeUpdate,
+		topic.EventPeerBanUpdate); err != nil {
 		ns.node.logger.Error(err)
 		return err
 	}
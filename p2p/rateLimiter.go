@@ -0,0 +1,201 @@
+package p2p
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+const (
+	peerLimiterIdleTime      = 10 * time.Minute
+	peerLimiterCleanInterval = time.Minute
+)
+
+// tokenBucket refills rate tokens per second up to burst
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate, burst float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+func (b *tokenBucket) allow(n float64, now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	if b.tokens < n {
+		return false
+	}
+	b.tokens -= n
+	return true
+}
+
+type messageLimit struct {
+	rate  float64
+	burst float64
+}
+
+type peerLimiter struct {
+	messages map[MessageType]*tokenBucket
+	bytes    *tokenBucket
+	lastSeen time.Time
+}
+
+// RateLimiter limits inbound messages of every peer by message type and by bytes,
+// and caps the number of inbound messages being processed, a nil limiter limits nothing
+type RateLimiter struct {
+	mu         sync.Mutex
+	limits     map[MessageType]*messageLimit
+	bytesRate  float64
+	bytesBurst float64
+	peers      map[string]*peerLimiter
+	lastClean  time.Time
+	inbound    chan struct{}
+	logger     *zap.SugaredLogger
+	now        func() time.Time
+
+	throttled      int64
+	throttledBytes int64
+	dropped        int64
+}
+
+// NewRateLimiter returns a limiter by config, nothing is limited if the config is not enabled
+func NewRateLimiter(cfg *config.P2PLimitConfig) *RateLimiter {
+	rl := &RateLimiter{
+		limits: make(map[MessageType]*messageLimit),
+		peers:  make(map[string]*peerLimiter),
+		logger: log.NewLogger("p2p_limiter"),
+		now:    time.Now,
+	}
+	rl.lastClean = rl.now()
+	if cfg == nil || !cfg.Enable {
+		return rl
+	}
+
+	for _, l := range cfg.Messages {
+		found := false
+		for t, name := range messageTypeNames {
+			if name == l.Type {
+				rl.limits[t] = &messageLimit{rate: l.Rate, burst: float64(l.Burst)}
+				found = true
+				break
+			}
+		}
+		if !found {
+			rl.logger.Warnf("ignore limit of unknown message type %s", l.Type)
+		}
+	}
+	if cfg.PeerBytesRate > 0 {
+		rl.bytesRate = float64(cfg.PeerBytesRate)
+		rl.bytesBurst = float64(cfg.PeerBytesBurst)
+		if rl.bytesBurst < rl.bytesRate {
+			rl.bytesBurst = rl.bytesRate
+		}
+	}
+	if cfg.MaxInboundQueue > 0 {
+		rl.inbound = make(chan struct{}, cfg.MaxInboundQueue)
+	}
+	return rl
+}
+
+// Allow returns false if the message of size bytes from the peer exceeds its limits, the message should be dropped
+func (rl *RateLimiter) Allow(peerID string, t MessageType, size int) bool {
+	if rl == nil {
+		return true
+	}
+	limit := rl.limits[t]
+	if limit == nil && rl.bytesRate == 0 {
+		return true
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.cleanIdlePeers(now)
+
+	pl, ok := rl.peers[peerID]
+	if !ok {
+		pl = &peerLimiter{messages: make(map[MessageType]*tokenBucket)}
+		if rl.bytesRate > 0 {
+			pl.bytes = newTokenBucket(rl.bytesRate, rl.bytesBurst, now)
+		}
+		rl.peers[peerID] = pl
+	}
+	pl.lastSeen = now
+
+	if pl.bytes != nil && !pl.bytes.allow(float64(size), now) {
+		atomic.AddInt64(&rl.throttledBytes, int64(size))
+		atomic.AddInt64(&rl.throttled, 1)
+		rl.logger.Debugf("peer %s exceeds bytes quota, drop %s of %d bytes", peerID, t, size)
+		return false
+	}
+
+	if limit != nil {
+		b, ok := pl.messages[t]
+		if !ok {
+			b = newTokenBucket(limit.rate, limit.burst, now)
+			pl.messages[t] = b
+		}
+		if !b.allow(1, now) {
+			atomic.AddInt64(&rl.throttled, 1)
+			rl.logger.Debugf("peer %s exceeds %s rate limit", peerID, t)
+			return false
+		}
+	}
+	return true
+}
+
+func (rl *RateLimiter) cleanIdlePeers(now time.Time) {
+	if now.Sub(rl.lastClean) < peerLimiterCleanInterval {
+		return
+	}
+	rl.lastClean = now
+	for id, pl := range rl.peers {
+		if now.Sub(pl.lastSeen) > peerLimiterIdleTime {
+			delete(rl.peers, id)
+		}
+	}
+}
+
+// acquire takes a slot of the inbound queue, returns false and counts the drop if the queue is full
+func (rl *RateLimiter) acquire() bool {
+	if rl == nil || rl.inbound == nil {
+		return true
+	}
+	select {
+	case rl.inbound <- struct{}{}:
+		return true
+	default:
+		atomic.AddInt64(&rl.dropped, 1)
+		return false
+	}
+}
+
+func (rl *RateLimiter) release() {
+	if rl == nil || rl.inbound == nil {
+		return
+	}
+	<-rl.inbound
+}
+
+// Counters returns the number of throttled messages, throttled bytes and messages dropped by full inbound queue
+func (rl *RateLimiter) Counters() (throttled, throttledBytes, dropped int64) {
+	if rl == nil {
+		return 0, 0, 0
+	}
+	return atomic.LoadInt64(&rl.throttled), atomic.LoadInt64(&rl.throttledBytes), atomic.LoadInt64(&rl.dropped)
+}
@@ -0,0 +1,111 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/config"
+)
+
+func newTestRateLimiter(now *time.Time) *RateLimiter {
+	rl := NewRateLimiter(&config.P2PLimitConfig{
+		Enable:          true,
+		MaxInboundQueue: 2,
+		PeerBytesRate:   1000,
+		PeerBytesBurst:  2000,
+		Messages: []*config.P2PMessageLimit{
+			{Type: "PublishReq", Rate: 1, Burst: 2},
+			{Type: "UnknownReq", Rate: 1, Burst: 2},
+		},
+	})
+	rl.now = func() time.Time { return *now }
+	return rl
+}
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimiter(&now)
+
+	// burst of 2 messages
+	if !rl.Allow("peer1", PublishReq, 10) || !rl.Allow("peer1", PublishReq, 10) {
+		t.Fatal("burst should be allowed")
+	}
+	if rl.Allow("peer1", PublishReq, 10) {
+		t.Fatal("message over rate should be throttled")
+	}
+	// other peers and unlimited types are not affected
+	if !rl.Allow("peer2", PublishReq, 10) || !rl.Allow("peer1", ConfirmAck, 10) {
+		t.Fatal("message should be allowed")
+	}
+
+	now = now.Add(time.Second)
+	if !rl.Allow("peer1", PublishReq, 10) {
+		t.Fatal("token should be refilled")
+	}
+
+	// bytes quota
+	if rl.Allow("peer3", ConfirmAck, 3000) {
+		t.Fatal("message over bytes burst should be throttled")
+	}
+	if !rl.Allow("peer3", ConfirmAck, 2000) || rl.Allow("peer3", ConfirmAck, 100) {
+		t.Fatal("bytes quota error")
+	}
+
+	throttled, throttledBytes, dropped := rl.Counters()
+	if throttled != 3 || throttledBytes != 3100 || dropped != 0 {
+		t.Fatal(throttled, throttledBytes, dropped)
+	}
+
+	// idle peers are removed
+	now = now.Add(peerLimiterIdleTime + time.Second)
+	rl.Allow("peer4", PublishReq, 10)
+	if len(rl.peers) != 1 {
+		t.Fatal("idle peers should be removed", len(rl.peers))
+	}
+}
+
+func TestRateLimiter_Inbound(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimiter(&now)
+
+	if !rl.acquire() || !rl.acquire() {
+		t.Fatal("acquire error")
+	}
+	if rl.acquire() {
+		t.Fatal("inbound queue should be full")
+	}
+	rl.release()
+	if !rl.acquire() {
+		t.Fatal("acquire after release error")
+	}
+	if _, _, dropped := rl.Counters(); dropped != 1 {
+		t.Fatal(dropped)
+	}
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	var rl *RateLimiter
+	if !rl.Allow("peer1", PublishReq, 1<<30) || !rl.acquire() {
+		t.Fatal("nil limiter should limit nothing")
+	}
+	rl.release()
+
+	rl = NewRateLimiter(&config.P2PLimitConfig{Enable: false, MaxInboundQueue: 1, PeerBytesRate: 1})
+	for i := 0; i < 10; i++ {
+		if !rl.Allow("peer1", PublishReq, 100) || !rl.acquire() {
+			t.Fatal("disabled limiter should limit nothing")
+		}
+	}
+
+	cfg, _ := config.DefaultConfig(config.QlcTestDataDir())
+	rl = NewRateLimiter(cfg.P2PLimit)
+	if len(rl.limits) != len(cfg.P2PLimit.Messages) {
+		t.Fatal("invalid default message limits")
+	}
+}
+
+func TestMessageType_String(t *testing.T) {
+	if PovBulkPullReq.String() != "PovBulkPullReq" || MessageType(200).String() != "Unknown(200)" {
+		t.Fatal("message type name error")
+	}
+}
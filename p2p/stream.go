@@ -13,6 +13,7 @@ import (
 
 	"github.com/qlcchain/go-qlc/common/topic"
 	ping "github.com/qlcchain/go-qlc/p2p/pinger"
+	"github.com/qlcchain/go-qlc/p2p/protos"
 )
 
 // Stream Errors
@@ -49,6 +50,9 @@ type Stream struct {
 	globalVersion             string
 	p2pVersion                byte
 	lastUpdateTime            string
+	capMutex                  sync.RWMutex
+	version                   byte
+	compression               compression
 }
 
 // NewStream return a new Stream
@@ -117,6 +121,15 @@ func (s *Stream) StartLoop() {
 	go s.readLoop()
 }
 
+// onParseError penalizes the peer which sends a broken message and closes the stream
+func (s *Stream) onParseError(err error) {
+	s.node.logger.Debugf("parse message from %s: %s", s.pid.Pretty(), err)
+	s.node.penalizePeer(s.pid.Pretty(), misbehaviorOfParseError(err), err.Error())
+	if err := s.close(); err != nil {
+		s.node.logger.Error(err)
+	}
+}
+
 func (s *Stream) readLoop() {
 	if !s.IsConnected() {
 		if err := s.Connect(); err != nil {
@@ -132,6 +145,10 @@ func (s *Stream) readLoop() {
 
 	s.node.netService.MessageEvent().Publish(topic.EventAddP2PStream, &topic.EventAddP2PStreamMsg{PeerID: s.pid.Pretty(), PeerInfo: s.addr.String()})
 
+	if err := s.sendHandshake(); err != nil {
+		s.node.logger.Errorf("send handshake to %s: %s", s.pid.Pretty(), err)
+	}
+
 	// loop.
 	buf := make([]byte, 1024*4)
 	messageBuffer := make([]byte, 0)
@@ -161,20 +178,27 @@ func (s *Stream) readLoop() {
 				}
 				message, err = ParseQlcMessage(messageBuffer)
 				if err != nil {
+					s.onParseError(err)
 					return
 				}
 				messageBuffer = messageBuffer[QlcMessageHeaderLength:]
 			}
 			// waiting for data.
-			if len(messageBuffer) < int(message.DataLength()) {
+			dataLength := message.DataLength()
+			if len(messageBuffer) < int(dataLength) {
 				// continue reading.
 				break
 			}
+			c := message.compression()
 			if err := message.ParseMessageData(messageBuffer); err != nil {
+				s.onParseError(err)
 				return
 			}
+			if c != compressionNone {
+				s.node.compressStats.addIn(int(dataLength), int(message.DataLength()))
+			}
 			// remove data from buffer.
-			messageBuffer = messageBuffer[message.DataLength():]
+			messageBuffer = messageBuffer[dataLength:]
 
 			// handle message.
 			s.handleMessage(message)
@@ -244,8 +268,7 @@ func (s *Stream) close() error {
 
 // SendMessage send msg to peer
 func (s *Stream) SendMessageToPeer(messageType MessageType, data []byte) error {
-	version := p2pVersion
-	message := NewQlcMessage(data, byte(version), messageType)
+	message := s.newQlcMessage(data, messageType)
 	qlcMessage := &QlcMessage{
 		messageType: messageType,
 		content:     message,
@@ -256,6 +279,74 @@ func (s *Stream) SendMessageToPeer(messageType MessageType, data []byte) error {
 	return err
 }
 
+// newQlcMessage frames data with the compression negotiated with peer,
+// the data is sent uncompressed if it is small or can not be compressed
+func (s *Stream) newQlcMessage(data []byte, messageType MessageType) []byte {
+	c := s.getCompression()
+	if c == compressionNone || messageType == Handshake || len(data) < s.node.compressMinSize {
+		return NewQlcMessage(data, p2pVersion, messageType)
+	}
+
+	compressed, err := compress(c, data)
+	if err != nil || len(compressed) >= len(data) {
+		return NewQlcMessage(data, p2pVersion, messageType)
+	}
+	s.node.compressStats.addOut(len(compressed), len(data))
+	return newQlcMessage(compressed, p2pVersion, messageType, c)
+}
+
+func (s *Stream) sendHandshake() error {
+	hs := &protos.HandshakePacket{
+		Version:    uint32(p2pVersion),
+		MinVersion: uint32(p2pMinVersion),
+	}
+	for _, c := range s.node.compressions {
+		hs.Compressions = append(hs.Compressions, c.String())
+	}
+	data, err := protos.HandshakeToProto(hs)
+	if err != nil {
+		return err
+	}
+	return s.SendMessageToPeer(Handshake, data)
+}
+
+// onHandshake agrees on the protocol version and compression with peer,
+// peers without handshake are old version and get uncompressed messages only
+func (s *Stream) onHandshake(data []byte) {
+	hs, err := protos.HandshakeFromProto(data)
+	if err != nil {
+		s.onParseError(err)
+		return
+	}
+
+	version := p2pVersion
+	if hs.Version < uint32(version) {
+		version = byte(hs.Version)
+	}
+	if version < p2pMinVersion || uint32(version) < hs.MinVersion {
+		s.node.logger.Infof("peer %s version [%d-%d] is incompatible with [%d-%d]", s.pid.Pretty(),
+			hs.MinVersion, hs.Version, p2pMinVersion, p2pVersion)
+		return
+	}
+
+	c := compressionNone
+	if version >= p2pCompressionVersion {
+		c = negotiateCompression(s.node.compressions, hs.Compressions)
+	}
+
+	s.capMutex.Lock()
+	s.version = version
+	s.compression = c
+	s.capMutex.Unlock()
+	s.node.logger.Debugf("handshake with %s, version %d, compression %s", s.pid.Pretty(), version, c)
+}
+
+func (s *Stream) getCompression() compression {
+	s.capMutex.RLock()
+	defer s.capMutex.RUnlock()
+	return s.compression
+}
+
 // WriteQlcMessage write qlc msg in the stream
 func (s *Stream) WriteQlcMessage(message *QlcMessage) error {
 	err := s.Write(message.content)
@@ -290,8 +381,15 @@ func (s *Stream) Write(data []byte) error {
 
 func (s *Stream) handleMessage(message *QlcMessage) {
 	s.p2pVersion = message.Version()
-	if message.Version() < byte(p2pVersion) {
-		s.node.logger.Debugf("message Version [%d] is less then p2pVersion [%d]", message.Version(), p2pVersion)
+	if message.MessageType() == Handshake {
+		s.onHandshake(message.MessageData())
+		return
+	}
+	if message.Version() < p2pMinVersion {
+		s.node.logger.Debugf("message Version [%d] is less then p2pMinVersion [%d]", message.Version(), p2pMinVersion)
+		return
+	}
+	if !s.node.limiter.Allow(s.pid.Pretty(), message.MessageType(), len(message.content)) {
 		return
 	}
 	m := NewMessage(message.MessageType(), s.pid.Pretty(), message.MessageData(), message.content)
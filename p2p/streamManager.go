@@ -174,7 +174,7 @@ func (sm *StreamManager) randomLowerLatencyPeer() (string, error) {
 	var ps []*Stream
 	if version2.Version == "" {
 		for i := 0; i < len(allPeers); i++ {
-			if allPeers[i].p2pVersion >= p2pVersion {
+			if allPeers[i].p2pVersion >= p2pMinVersion {
 				ps = append(ps, allPeers[i])
 			}
 		}
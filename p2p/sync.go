@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"sort"
 	"sync"
@@ -13,6 +14,7 @@ import (
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
 	"github.com/qlcchain/go-qlc/p2p/protos"
@@ -61,6 +63,12 @@ func NewSyncService(netService *QlcService, ledger ledger.Store) *ServiceSync {
 }
 
 func (ss *ServiceSync) Start() {
+	// light node only syncs chains of tracked accounts by pov light syncer
+	if ss.netService.node.cfg.IsLightNode() {
+		ss.logger.Info("light node, frontier sync is disabled")
+		return
+	}
+
 	ss.logger.Info("started sync loop")
 	address := types.Address{}
 	Req := protos.NewFrontierReq(address, math.MaxUint32, math.MaxUint32)
@@ -612,6 +620,9 @@ func (ss *ServiceSync) onBulkPullRsp(message *Message) error {
 	for i, b := range blocks {
 		ss.logger.Debugf("sync block acc[%s]-index[%d]-hash[%s]-prev[%s]", b.Address, i, b.GetHash(), b.Previous)
 	}
+	if err := ss.verifyBlocks(message, blocks); err != nil {
+		return err
+	}
 
 	if blkPacket.PullType == protos.PullTypeSegment {
 		ss.pullTimer.Stop()
@@ -667,6 +678,9 @@ func (ss *ServiceSync) onBulkPushBlock(message *Message) error {
 		return err
 	}
 	blocks := blkPacket.Blocks
+	if err := ss.verifyBlocks(message, blocks); err != nil {
+		return err
+	}
 
 	//if ss.netService.node.cfg.PerformanceEnabled {
 	//	for _, b := range blocks {
@@ -678,6 +692,41 @@ func (ss *ServiceSync) onBulkPushBlock(message *Message) error {
 	return nil
 }
 
+// verifyBlocks drops the blocks sent on stream if any of them has bad work or signature, and penalizes the sender
+func (ss *ServiceSync) verifyBlocks(message *Message, blocks types.StateBlockList) error {
+	for _, blk := range blocks {
+		if err := verifySyncBlock(blk); err != nil {
+			ss.netService.node.penalizeSender(message, MisbehaviorInvalidBlock, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// verifySyncBlock checks the work and signature of block which can be verified without ledger, blocks of
+// contracts and multisig accounts depend on ledger state, they are left to ledger verifier
+func verifySyncBlock(blk *types.StateBlock) error {
+	switch blk.GetType() {
+	case types.Send, types.Receive, types.Change, types.Open, types.Online:
+	default:
+		return nil
+	}
+	if config.IsGenesisBlock(blk) {
+		return nil
+	}
+	hash := blk.GetHash()
+	if !blk.IsValid() {
+		return fmt.Errorf("block %s has bad work", hash)
+	}
+	if blk.IsMultiSig() {
+		return nil
+	}
+	if !blk.Address.Verify(hash[:], blk.Signature[:]) {
+		return fmt.Errorf("block %s has bad signature", hash)
+	}
+	return nil
+}
+
 func (ss *ServiceSync) next() {
 	if len(ss.frontiers) > 0 {
 		ss.openBlockHash = ss.frontiers[0].OpenBlock
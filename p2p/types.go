@@ -62,6 +62,7 @@ type Message struct {
 	from        string
 	data        []byte //removed the header
 	content     []byte //complete message data
+	relayed     bool   //received from pubsub, from is the relaying neighbour rather than the origin
 }
 
 // NewBaseMessage new base message
@@ -0,0 +1,196 @@
+package privacy
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	envelopeVersion = 1
+
+	ptmKeySize   = 32
+	ptmNonceSize = 24
+	// master key sealed by box for one recipient
+	sealedKeySize = ptmKeySize + box.Overhead
+	recipientSize = ptmKeySize + ptmNonceSize + sealedKeySize
+	// version + sender + nonce + recipient count
+	envelopeHeaderSize = 1 + ptmKeySize + ptmNonceSize + 2
+
+	maxEnvelopeRecipients = 1024
+)
+
+var (
+	ErrPtmNotRecipient   = errors.New("not a recipient of the payload")
+	ErrPtmInvalidPtmKey  = errors.New("invalid ptm key")
+	ErrPtmInvalidPayload = errors.New("invalid private payload")
+)
+
+type ptmKey = [ptmKeySize]byte
+
+type envelopeRecipient struct {
+	pubKey    ptmKey
+	nonce     [ptmNonceSize]byte
+	sealedKey []byte
+}
+
+// envelope is the encrypted private payload, payload is encrypted by a random master key,
+// the master key is sealed for every recipient by box of the sender and recipient keys
+type envelope struct {
+	sender     ptmKey
+	nonce      [ptmNonceSize]byte
+	recipients []*envelopeRecipient
+	cipherText []byte
+}
+
+// enclaveKeyOf returns the key which identifies the envelope in blocks and storage
+func enclaveKeyOf(data []byte) []byte {
+	h := blake2b.Sum512(data)
+	return h[:]
+}
+
+// parsePtmKey decodes base64 key, the same format which is published in PtmKey contract
+func parsePtmKey(s string) (*ptmKey, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil || len(data) != ptmKeySize {
+		return nil, fmt.Errorf("%s: %s", ErrPtmInvalidPtmKey, s)
+	}
+	key := new(ptmKey)
+	copy(key[:], data)
+	return key, nil
+}
+
+func formatPtmKey(key *ptmKey) string {
+	return base64.StdEncoding.EncodeToString(key[:])
+}
+
+// ptmKeyPairFromSeed derives box key pair of the node, seed is hashed so any secret can be used
+func ptmKeyPairFromSeed(seed []byte) (*ptmKey, *ptmKey) {
+	priv := ptmKey(sha256.Sum256(seed))
+	pub := new(ptmKey)
+	curve25519.ScalarBaseMult(pub, &priv)
+	return pub, &priv
+}
+
+func ptmPublicKey(priv *ptmKey) *ptmKey {
+	pub := new(ptmKey)
+	curve25519.ScalarBaseMult(pub, priv)
+	return pub
+}
+
+func randomBytes(b []byte) error {
+	_, err := io.ReadFull(rand.Reader, b)
+	return err
+}
+
+func sealEnvelope(data []byte, senderPub, senderPriv *ptmKey, recipients []*ptmKey) (*envelope, error) {
+	if len(recipients) == 0 || len(recipients) > maxEnvelopeRecipients {
+		return nil, fmt.Errorf("invalid recipient count %d", len(recipients))
+	}
+
+	var masterKey ptmKey
+	if err := randomBytes(masterKey[:]); err != nil {
+		return nil, err
+	}
+	e := &envelope{sender: *senderPub}
+	if err := randomBytes(e.nonce[:]); err != nil {
+		return nil, err
+	}
+	e.cipherText = secretbox.Seal(nil, data, &e.nonce, &masterKey)
+
+	for _, pub := range recipients {
+		r := &envelopeRecipient{pubKey: *pub}
+		if err := randomBytes(r.nonce[:]); err != nil {
+			return nil, err
+		}
+		r.sealedKey = box.Seal(nil, masterKey[:], &r.nonce, pub, senderPriv)
+		e.recipients = append(e.recipients, r)
+	}
+	return e, nil
+}
+
+func (e *envelope) recipient(pub *ptmKey) *envelopeRecipient {
+	for _, r := range e.recipients {
+		if r.pubKey == *pub {
+			return r
+		}
+	}
+	return nil
+}
+
+func (e *envelope) isRecipient(pub *ptmKey) bool {
+	return e.recipient(pub) != nil
+}
+
+// open decrypts the payload by the key pair of a recipient
+func (e *envelope) open(pub, priv *ptmKey) ([]byte, error) {
+	r := e.recipient(pub)
+	if r == nil {
+		return nil, ErrPtmNotRecipient
+	}
+	mk, ok := box.Open(nil, r.sealedKey, &r.nonce, &e.sender, priv)
+	if !ok || len(mk) != ptmKeySize {
+		return nil, ErrPtmInvalidPayload
+	}
+	var masterKey ptmKey
+	copy(masterKey[:], mk)
+	data, ok := secretbox.Open(nil, e.cipherText, &e.nonce, &masterKey)
+	if !ok {
+		return nil, ErrPtmInvalidPayload
+	}
+	return data, nil
+}
+
+func (e *envelope) Serialize() []byte {
+	buf := new(bytes.Buffer)
+	buf.Grow(envelopeHeaderSize + len(e.recipients)*recipientSize + len(e.cipherText))
+	buf.WriteByte(envelopeVersion)
+	buf.Write(e.sender[:])
+	buf.Write(e.nonce[:])
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(e.recipients)))
+	for _, r := range e.recipients {
+		buf.Write(r.pubKey[:])
+		buf.Write(r.nonce[:])
+		buf.Write(r.sealedKey)
+	}
+	buf.Write(e.cipherText)
+	return buf.Bytes()
+}
+
+func (e *envelope) Deserialize(data []byte) error {
+	if len(data) < envelopeHeaderSize || data[0] != envelopeVersion {
+		return ErrPtmInvalidPayload
+	}
+	data = data[1:]
+	copy(e.sender[:], data[:ptmKeySize])
+	data = data[ptmKeySize:]
+	copy(e.nonce[:], data[:ptmNonceSize])
+	data = data[ptmNonceSize:]
+	count := int(binary.BigEndian.Uint16(data[:2]))
+	data = data[2:]
+	if count == 0 || count > maxEnvelopeRecipients || len(data) < count*recipientSize+secretbox.Overhead {
+		return ErrPtmInvalidPayload
+	}
+
+	e.recipients = make([]*envelopeRecipient, 0, count)
+	for i := 0; i < count; i++ {
+		r := &envelopeRecipient{sealedKey: make([]byte, sealedKeySize)}
+		copy(r.pubKey[:], data[:ptmKeySize])
+		copy(r.nonce[:], data[ptmKeySize:ptmKeySize+ptmNonceSize])
+		copy(r.sealedKey, data[ptmKeySize+ptmNonceSize:recipientSize])
+		e.recipients = append(e.recipients, r)
+		data = data[recipientSize:]
+	}
+	e.cipherText = append([]byte(nil), data...)
+	return nil
+}
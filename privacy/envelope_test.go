@@ -0,0 +1,68 @@
+package privacy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/util"
+)
+
+func TestEnvelope_SealOpen(t *testing.T) {
+	senderPub, senderPriv := ptmKeyPairFromSeed(util.RandomFixedBytes(32))
+	recvPub, recvPriv := ptmKeyPairFromSeed(util.RandomFixedBytes(32))
+	otherPub, otherPriv := ptmKeyPairFromSeed(util.RandomFixedBytes(32))
+
+	data := util.RandomFixedBytes(300)
+	e, err := sealEnvelope(data, senderPub, senderPriv, []*ptmKey{senderPub, recvPub})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := e.Serialize()
+	if len(enclaveKeyOf(raw)) != 64 {
+		t.Fatal("invalid enclave key length")
+	}
+	e2 := new(envelope)
+	if err := e2.Deserialize(raw); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(e2.Serialize(), raw) {
+		t.Fatal("envelope not equal")
+	}
+
+	for _, kp := range [][2]*ptmKey{{senderPub, senderPriv}, {recvPub, recvPriv}} {
+		pl, err := e2.open(kp[0], kp[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(pl, data) {
+			t.Fatal("payload not equal")
+		}
+	}
+	if _, err := e2.open(otherPub, otherPriv); err != ErrPtmNotRecipient {
+		t.Fatal("should not be recipient", err)
+	}
+
+	// the sealed key can not be opened by a wrong private key
+	if _, err := e2.open(recvPub, otherPriv); err != ErrPtmInvalidPayload {
+		t.Fatal("should be invalid payload", err)
+	}
+	if err := e2.Deserialize(raw[:envelopeHeaderSize+10]); err != ErrPtmInvalidPayload {
+		t.Fatal("truncated envelope should be invalid", err)
+	}
+}
+
+func TestParsePtmKey(t *testing.T) {
+	pub, _ := ptmKeyPairFromSeed([]byte("seed"))
+	s := formatPtmKey(pub)
+	if len(s) != 44 {
+		t.Fatal("invalid key string", s)
+	}
+	key, err := parsePtmKey(s)
+	if err != nil || *key != *pub {
+		t.Fatal("invalid key", err)
+	}
+	if _, err := parsePtmKey("/vkgO5TfnsvKZGDc2KT1yxD5fx"); err == nil {
+		t.Fatal("short key should be invalid")
+	}
+}
@@ -0,0 +1,407 @@
+package privacy
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/bluele/gcache"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+const (
+	// time to wait for peers to push the envelope which is not stored locally
+	nativePtmFetchTimeout = 5 * time.Second
+	// requests of the same enclave key from a peer are answered once in the period,
+	// and at most nativePtmReqMaxReplies peers are answered in the period
+	nativePtmReqInterval   = 10 * time.Second
+	nativePtmReqMaxReplies = 16
+
+	nativePtmKeySeedPrefix = "qlc-ptm-key"
+)
+
+var ErrPtmInvalidFrom = errors.New("private from is not the key of this node")
+
+// NativePTM is the in-process private transaction manager, payloads are encrypted for the keys of recipients,
+// envelopes are pushed by p2p to the peers which announced keys of recipients, other recipients request them
+// from peers when the payload is received
+type NativePTM struct {
+	cfg        *config.Config
+	eb         event.EventBus
+	l          ledger.Store
+	logger     *zap.SugaredLogger
+	subscriber *event.ActorSubscriber
+	quitCh     chan struct{}
+
+	pubKey  *ptmKey
+	privKey *ptmKey
+
+	fetchTimeout time.Duration
+	fetchMu      sync.Mutex
+	fetchWaiters map[string]chan struct{}
+	reqMu        sync.Mutex
+	reqCache     gcache.Cache
+
+	// ptm keys announced by connected peers
+	peerMu   sync.RWMutex
+	peerKeys map[string]ptmKey
+
+	statSend  atomic.Uint64
+	statRecv  atomic.Uint64
+	statPush  atomic.Uint64
+	statReq   atomic.Uint64
+	statFetch atomic.Uint64
+}
+
+func NewNativePTM(cfg *config.Config, eb event.EventBus, l ledger.Store) *NativePTM {
+	return &NativePTM{
+		cfg:          cfg,
+		eb:           eb,
+		l:            l,
+		fetchTimeout: nativePtmFetchTimeout,
+		fetchWaiters: make(map[string]chan struct{}),
+		peerKeys:     make(map[string]ptmKey),
+	}
+}
+
+func (m *NativePTM) Init() error {
+	m.logger = log.NewLogger("privacy_native_ptm")
+	m.reqCache = gcache.New(common.DPoSMaxBlocks).LRU().Expiration(nativePtmReqInterval).Build()
+
+	if m.cfg.Privacy.PtmKey != "" {
+		priv, err := parsePtmKey(m.cfg.Privacy.PtmKey)
+		if err != nil {
+			return err
+		}
+		m.pubKey, m.privKey = ptmPublicKey(priv), priv
+	} else {
+		if m.cfg.P2P == nil || m.cfg.P2P.ID == nil || m.cfg.P2P.ID.PrivKey == "" {
+			return errors.New("ptm key and p2p identity are both empty")
+		}
+		m.pubKey, m.privKey = ptmKeyPairFromSeed([]byte(nativePtmKeySeedPrefix + m.cfg.P2P.ID.PrivKey))
+	}
+
+	m.subscriber = event.NewActorSubscriber(event.SpawnWithPool(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *p2p.EventPrivacyPayloadPushMsg:
+			m.onPayloadPush(msg.Push, msg.From)
+		case *p2p.EventPrivacyPayloadReqMsg:
+			m.onPayloadReq(msg.Req, msg.From)
+		case *p2p.EventPrivacyKeyAnnounceMsg:
+			m.onKeyAnnounce(msg.Announce, msg.From)
+		case *topic.EventAddP2PStreamMsg:
+			m.announceKey(msg.PeerID)
+		case *topic.EventDeleteP2PStreamMsg:
+			m.peerMu.Lock()
+			delete(m.peerKeys, msg.PeerID)
+			m.peerMu.Unlock()
+		}
+	}), m.eb)
+
+	return m.subscriber.Subscribe(topic.EventPrivacyPayloadPush, topic.EventPrivacyPayloadReq,
+		topic.EventPrivacyKeyAnnounce, topic.EventAddP2PStream, topic.EventDeleteP2PStream)
+}
+
+func (m *NativePTM) Start() error {
+	m.quitCh = make(chan struct{})
+	m.logger.Infof("native ptm public key %s", m.PublicKey())
+
+	// peers connected before start do not know the key yet
+	m.eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{
+		Type:    p2p.PrivacyKeyAnnounce,
+		Message: &protos.PrivacyKeyAnnounce{PubKey: m.pubKey[:]},
+	})
+	return nil
+}
+
+func (m *NativePTM) Stop() error {
+	close(m.quitCh)
+	return m.subscriber.UnsubscribeAll()
+}
+
+// PublicKey returns the key which should be published in PtmKey contract by accounts of this node
+func (m *NativePTM) PublicKey() string {
+	return formatPtmKey(m.pubKey)
+}
+
+// Send encrypts data for the recipients and pushes the envelope to their peers, recipients are
+// base64 keys or accounts whose keys are looked up in PtmKey contract, the sender is always a recipient
+func (m *NativePTM) Send(data []byte, from string, to []string) ([]byte, error) {
+	if from != "" && from != m.PublicKey() {
+		return nil, fmt.Errorf("%s: %s", ErrPtmInvalidFrom, from)
+	}
+
+	recipients, err := m.resolveRecipients(to)
+	if err != nil {
+		return nil, err
+	}
+	e, err := sealEnvelope(data, m.pubKey, m.privKey, recipients)
+	if err != nil {
+		return nil, err
+	}
+	raw := e.Serialize()
+	enclaveKey := enclaveKeyOf(raw)
+	if err := m.l.AddPrivatePayloadEnvelope(enclaveKey, raw); err != nil {
+		return nil, err
+	}
+	m.statSend.Inc()
+
+	m.pushEnvelope(raw, recipients)
+	return enclaveKey, nil
+}
+
+// pushEnvelope sends the envelope to the peers which announced keys of recipients
+func (m *NativePTM) pushEnvelope(raw []byte, recipients []*ptmKey) {
+	keys := make(map[ptmKey]struct{}, len(recipients))
+	for _, key := range recipients {
+		keys[*key] = struct{}{}
+	}
+
+	peers := make([]string, 0)
+	m.peerMu.RLock()
+	for peerID, key := range m.peerKeys {
+		if _, ok := keys[key]; ok {
+			peers = append(peers, peerID)
+		}
+	}
+	m.peerMu.RUnlock()
+
+	for _, peerID := range peers {
+		m.sendPush(raw, peerID)
+	}
+}
+
+func (m *NativePTM) sendPush(raw []byte, peerID string) {
+	m.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.PrivacyPayloadPush,
+		Message: &protos.PrivacyPayloadPush{Envelope: raw},
+		PeerID:  peerID,
+	})
+}
+
+func (m *NativePTM) announceKey(peerID string) {
+	m.eb.Publish(topic.EventSendMsgToSingle, &p2p.EventSendMsgToSingleMsg{
+		Type:    p2p.PrivacyKeyAnnounce,
+		Message: &protos.PrivacyKeyAnnounce{PubKey: m.pubKey[:]},
+		PeerID:  peerID,
+	})
+}
+
+// Receive decrypts the payload of the enclave key, envelope which is not stored is requested from peers,
+// nil is returned if this node is not a recipient
+func (m *NativePTM) Receive(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+
+	raw, err := m.l.GetPrivatePayloadEnvelope(data)
+	if err == storage.KeyNotFound {
+		raw, err = m.fetchEnvelope(data)
+	}
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	e := new(envelope)
+	if err := e.Deserialize(raw); err != nil {
+		return nil, err
+	}
+	pl, err := e.open(m.pubKey, m.privKey)
+	if err != nil {
+		if err == ErrPtmNotRecipient {
+			return nil, nil
+		}
+		return nil, err
+	}
+	m.statRecv.Inc()
+	return pl, nil
+}
+
+func (m *NativePTM) fetchEnvelope(enclaveKey []byte) ([]byte, error) {
+	key := string(enclaveKey)
+	m.fetchMu.Lock()
+	waitCh, ok := m.fetchWaiters[key]
+	if !ok {
+		waitCh = make(chan struct{})
+		m.fetchWaiters[key] = waitCh
+	}
+	m.fetchMu.Unlock()
+
+	if !ok {
+		m.statFetch.Inc()
+		m.eb.Publish(topic.EventBroadcast, &p2p.EventBroadcastMsg{
+			Type:    p2p.PrivacyPayloadReq,
+			Message: &protos.PrivacyPayloadReq{EnclaveKey: enclaveKey},
+		})
+	}
+
+	timer := time.NewTimer(m.fetchTimeout)
+	defer timer.Stop()
+	select {
+	case <-waitCh:
+	case <-timer.C:
+		m.fetchMu.Lock()
+		if m.fetchWaiters[key] == waitCh {
+			delete(m.fetchWaiters, key)
+		}
+		m.fetchMu.Unlock()
+	case <-m.quitCh:
+	}
+	return m.l.GetPrivatePayloadEnvelope(enclaveKey)
+}
+
+func (m *NativePTM) resolveRecipients(to []string) ([]*ptmKey, error) {
+	recipients := []*ptmKey{m.pubKey}
+	seen := map[ptmKey]struct{}{*m.pubKey: {}}
+	add := func(key *ptmKey) {
+		if _, ok := seen[*key]; !ok {
+			seen[*key] = struct{}{}
+			recipients = append(recipients, key)
+		}
+	}
+
+	for _, s := range to {
+		if addr, err := types.HexToAddress(s); err == nil {
+			ctx := vmstore.NewVMContext(m.l, &contractaddress.PtmKeyKVAddress)
+			pks, err := abi.GetPtmKeyByAccount(ctx, addr)
+			if err != nil {
+				return nil, fmt.Errorf("get ptm key of %s: %s", addr, err)
+			}
+			for _, pk := range pks {
+				key, err := parsePtmKey(pk.Pubkey)
+				if err != nil {
+					return nil, err
+				}
+				add(key)
+			}
+			continue
+		}
+
+		key, err := parsePtmKey(s)
+		if err != nil {
+			return nil, err
+		}
+		add(key)
+	}
+	return recipients, nil
+}
+
+func (m *NativePTM) onPayloadPush(push *protos.PrivacyPayloadPush, from string) {
+	e := new(envelope)
+	if err := e.Deserialize(push.Envelope); err != nil {
+		m.logger.Debugf("invalid envelope from %s, %s", from, err)
+		return
+	}
+	if !e.isRecipient(m.pubKey) {
+		return
+	}
+	m.statPush.Inc()
+
+	enclaveKey := enclaveKeyOf(push.Envelope)
+	if _, err := m.l.GetPrivatePayloadEnvelope(enclaveKey); err == storage.KeyNotFound {
+		if err := m.l.AddPrivatePayloadEnvelope(enclaveKey, push.Envelope); err != nil {
+			m.logger.Errorf("save envelope %s err %s", formatBytesPrefix(enclaveKey), err)
+			return
+		}
+	}
+	m.fetchMu.Lock()
+	if waitCh, ok := m.fetchWaiters[string(enclaveKey)]; ok {
+		delete(m.fetchWaiters, string(enclaveKey))
+		close(waitCh)
+	}
+	m.fetchMu.Unlock()
+}
+
+func (m *NativePTM) onKeyAnnounce(announce *protos.PrivacyKeyAnnounce, from string) {
+	if len(announce.PubKey) != ptmKeySize {
+		m.logger.Debugf("invalid ptm key from %s", from)
+		return
+	}
+	var key ptmKey
+	copy(key[:], announce.PubKey)
+
+	m.peerMu.Lock()
+	m.peerKeys[from] = key
+	m.peerMu.Unlock()
+}
+
+// onPayloadReq pushes the envelope back to the requester only if the key announced by it is a recipient
+func (m *NativePTM) onPayloadReq(req *protos.PrivacyPayloadReq, from string) {
+	m.statReq.Inc()
+
+	m.peerMu.RLock()
+	key, ok := m.peerKeys[from]
+	m.peerMu.RUnlock()
+	if !ok {
+		return
+	}
+
+	raw, err := m.l.GetPrivatePayloadEnvelope(req.EnclaveKey)
+	if err != nil {
+		return
+	}
+	e := new(envelope)
+	if err := e.Deserialize(raw); err != nil || !e.isRecipient(&key) {
+		return
+	}
+	if !m.allowReply(req.EnclaveKey, from) {
+		return
+	}
+
+	m.logger.Debugf("push envelope %s requested by %s", formatBytesPrefix(req.EnclaveKey), from)
+	m.sendPush(raw, from)
+}
+
+// allowReply limits the pushes of an envelope for requests, a peer is answered once and at most
+// nativePtmReqMaxReplies peers are answered in nativePtmReqInterval
+func (m *NativePTM) allowReply(enclaveKey []byte, from string) bool {
+	m.reqMu.Lock()
+	defer m.reqMu.Unlock()
+
+	key := string(enclaveKey)
+	peers := make(map[string]struct{})
+	if val, err := m.reqCache.Get(key); err == nil {
+		peers = val.(map[string]struct{})
+	}
+	if _, ok := peers[from]; ok || len(peers) >= nativePtmReqMaxReplies {
+		return false
+	}
+
+	peers[from] = struct{}{}
+	if len(peers) == 1 {
+		_ = m.reqCache.Set(key, peers)
+	}
+	return true
+}
+
+func (m *NativePTM) GetDebugInfo() map[string]interface{} {
+	info := make(map[string]interface{})
+	info["mode"] = config.PtmNodeNative
+	info["pubKey"] = m.PublicKey()
+	info["statSend"] = m.statSend.Load()
+	info["statRecv"] = m.statRecv.Load()
+	info["statPush"] = m.statPush.Load()
+	info["statReq"] = m.statReq.Load()
+	info["statFetch"] = m.statFetch.Load()
+	return info
+}
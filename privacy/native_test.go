@@ -0,0 +1,268 @@
+package privacy
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/p2p"
+	"github.com/qlcchain/go-qlc/p2p/protos"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// mockPtmNetwork forwards messages of connected nodes to each other like p2p
+type mockPtmNetwork struct {
+	mu        sync.Mutex
+	nodes     map[string]*NativePTM
+	connected map[string]bool
+	pushes    map[string]int
+}
+
+func newMockPtmNetwork() *mockPtmNetwork {
+	return &mockPtmNetwork{
+		nodes:     make(map[string]*NativePTM),
+		connected: make(map[string]bool),
+		pushes:    make(map[string]int),
+	}
+}
+
+func (n *mockPtmNetwork) forward(from string, msgType p2p.MessageType, message interface{}, to string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.connected[from] {
+		return
+	}
+	for id, node := range n.nodes {
+		if id == from || !n.connected[id] || (to != "" && to != id) {
+			continue
+		}
+		switch msgType {
+		case p2p.PrivacyPayloadPush:
+			n.pushes[id]++
+			node.eb.Publish(topic.EventPrivacyPayloadPush, &p2p.EventPrivacyPayloadPushMsg{Push: message.(*protos.PrivacyPayloadPush), From: from})
+		case p2p.PrivacyPayloadReq:
+			node.eb.Publish(topic.EventPrivacyPayloadReq, &p2p.EventPrivacyPayloadReqMsg{Req: message.(*protos.PrivacyPayloadReq), From: from})
+		case p2p.PrivacyKeyAnnounce:
+			node.eb.Publish(topic.EventPrivacyKeyAnnounce, &p2p.EventPrivacyKeyAnnounceMsg{Announce: message.(*protos.PrivacyKeyAnnounce), From: from})
+		}
+	}
+}
+
+// setConnected adds or deletes streams between the node and other connected nodes
+func (n *mockPtmNetwork) setConnected(id string, connected bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.connected[id] = connected
+	for other, node := range n.nodes {
+		if other == id || !n.connected[other] {
+			continue
+		}
+		if connected {
+			node.eb.Publish(topic.EventAddP2PStream, &topic.EventAddP2PStreamMsg{PeerID: id})
+			n.nodes[id].eb.Publish(topic.EventAddP2PStream, &topic.EventAddP2PStreamMsg{PeerID: other})
+		} else {
+			node.eb.Publish(topic.EventDeleteP2PStream, &topic.EventDeleteP2PStreamMsg{PeerID: id})
+			n.nodes[id].eb.Publish(topic.EventDeleteP2PStream, &topic.EventDeleteP2PStreamMsg{PeerID: other})
+		}
+	}
+}
+
+func (n *mockPtmNetwork) pushCount(id string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.pushes[id]
+}
+
+func (n *mockPtmNetwork) addNode(t *testing.T, id string) *NativePTM {
+	dir := filepath.Join(config.QlcTestDataDir(), "ptm", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	cfg, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := ledger.NewLedger(cm.ConfigFile)
+	eb := event.NewActorEventBus()
+
+	m := NewNativePTM(cfg, eb, l)
+	m.fetchTimeout = 300 * time.Millisecond
+	if err := m.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	sub := event.NewActorSubscriber(event.Spawn(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *p2p.EventBroadcastMsg:
+			n.forward(id, msg.Type, msg.Message, "")
+		case *p2p.EventSendMsgToSingleMsg:
+			n.forward(id, msg.Type, msg.Message, msg.PeerID)
+		}
+	}), eb)
+	if err := sub.Subscribe(topic.EventBroadcast, topic.EventSendMsgToSingle); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = sub.UnsubscribeAll()
+		_ = m.Stop()
+		_ = l.Close()
+		_ = os.RemoveAll(dir)
+	})
+
+	n.mu.Lock()
+	n.nodes[id] = m
+	n.mu.Unlock()
+	n.setConnected(id, true)
+	return m
+}
+
+// waitPeerKey waits until m knows the key of peer
+func waitPeerKey(t *testing.T, m *NativePTM, peerID string, peer *NativePTM) {
+	for i := 0; i < 100; i++ {
+		m.peerMu.RLock()
+		key, ok := m.peerKeys[peerID]
+		m.peerMu.RUnlock()
+		if ok && key == *peer.pubKey {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("key of peer is not announced")
+}
+
+func waitEnvelope(t *testing.T, m *NativePTM, enclaveKey []byte) {
+	for i := 0; i < 100; i++ {
+		if _, err := m.l.GetPrivatePayloadEnvelope(enclaveKey); err == nil {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("envelope is not pushed")
+}
+
+func checkReceive(t *testing.T, m *NativePTM, enclaveKey []byte, data []byte) {
+	pl, err := m.Receive(enclaveKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pl, data) {
+		t.Fatal("invalid payload", len(pl), len(data))
+	}
+}
+
+func TestNativePTM_SendReceive(t *testing.T) {
+	network := newMockPtmNetwork()
+	a := network.addNode(t, "a")
+	b := network.addNode(t, "b")
+	c := network.addNode(t, "c")
+	d := network.addNode(t, "d")
+	if a.PublicKey() == b.PublicKey() {
+		t.Fatal("nodes should have different keys")
+	}
+
+	if _, err := a.Send(util.RandomFixedBytes(32), b.PublicKey(), []string{b.PublicKey()}); err == nil {
+		t.Fatal("private from should be the key of node")
+	}
+	if _, err := a.Send(util.RandomFixedBytes(32), "", []string{"invalid"}); err == nil {
+		t.Fatal("invalid recipient should be rejected")
+	}
+
+	// d is offline when the payload is sent, it fetches the envelope from peers later
+	waitPeerKey(t, a, "b", b)
+	network.setConnected("d", false)
+	data := util.RandomFixedBytes(128)
+	enclaveKey, err := a.Send(data, a.PublicKey(), []string{b.PublicKey(), d.PublicKey()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitEnvelope(t, b, enclaveKey)
+	checkReceive(t, a, enclaveKey, data)
+	checkReceive(t, b, enclaveKey, data)
+
+	// envelope is neither pushed nor answered to the peer which is not a recipient
+	checkReceive(t, c, enclaveKey, nil)
+	if network.pushCount("c") != 0 {
+		t.Fatal("envelope should not be pushed to c")
+	}
+
+	network.setConnected("d", true)
+	waitPeerKey(t, a, "d", d)
+	waitPeerKey(t, b, "d", d)
+	checkReceive(t, d, enclaveKey, data)
+	if info := d.GetDebugInfo(); info["statFetch"].(uint64) != 1 {
+		t.Fatal("envelope should be fetched", info)
+	}
+	checkReceive(t, c, []byte{}, []byte{})
+}
+
+func TestNativePTM_allowReply(t *testing.T) {
+	m := newMockPtmNetwork().addNode(t, "a")
+	enclaveKey := util.RandomFixedBytes(64)
+	if !m.allowReply(enclaveKey, "b") || m.allowReply(enclaveKey, "b") {
+		t.Fatal("peer should be answered once")
+	}
+	for i := 1; i < nativePtmReqMaxReplies; i++ {
+		if !m.allowReply(enclaveKey, fmt.Sprintf("peer%d", i)) {
+			t.Fatal("peer should be answered")
+		}
+	}
+	if m.allowReply(enclaveKey, "c") {
+		t.Fatal("replies of the key should be limited")
+	}
+	if !m.allowReply(util.RandomFixedBytes(64), "c") {
+		t.Fatal("replies of other key should not be limited")
+	}
+}
+
+func TestNativePTM_SendToAccount(t *testing.T) {
+	network := newMockPtmNetwork()
+	a := network.addNode(t, "a")
+	b := network.addNode(t, "b")
+
+	// account of node b publishes its key in PtmKey contract
+	account := mock.Address()
+	ctx := vmstore.NewVMContext(a.l, &contractaddress.PtmKeyKVAddress)
+	data, err := abi.PtmKeyABI.PackVariable(abi.VariableNamePtmKeyStorageVar, b.PublicKey(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := append(account.Bytes(), util.BE_Uint16ToBytes(common.PtmKeyVBtypeDefault)...)
+	if err := ctx.SetStorage(contractaddress.PtmKeyKVAddress[:], key, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	pl := util.RandomFixedBytes(64)
+	waitPeerKey(t, a, "b", b)
+	enclaveKey, err := a.Send(pl, "", []string{account.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitEnvelope(t, b, enclaveKey)
+	checkReceive(t, b, enclaveKey, pl)
+
+	if _, err := a.Send(pl, "", []string{mock.Address().String()}); err == nil {
+		t.Fatal("account without ptm key should be rejected")
+	}
+}
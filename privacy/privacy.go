@@ -11,15 +11,26 @@ import (
 	"github.com/qlcchain/go-qlc/common/event"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
 )
 
+// transactionManager distributes and receives private payloads, it is the external PTM node or the native one
+type transactionManager interface {
+	Init() error
+	Start() error
+	Stop() error
+	Send(data []byte, from string, to []string) ([]byte, error)
+	Receive(data []byte) ([]byte, error)
+	GetDebugInfo() map[string]interface{}
+}
+
 type Controller struct {
 	logger     *zap.SugaredLogger
 	cfg        *config.Config
 	eb         event.EventBus
 	subscriber *event.ActorSubscriber
-	ptm        *PTM
+	ptm        transactionManager
 	quitCh     chan struct{}
 
 	feb            *event.FeedEventBus
@@ -32,7 +43,11 @@ func NewController(cc *context.ChainContext) *Controller {
 	c.logger = log.NewLogger("privacy")
 	c.cfg, _ = cc.Config()
 	c.eb = cc.EventBus()
-	c.ptm = NewPTM(c.cfg)
+	if c.cfg.IsNativePtm() {
+		c.ptm = NewNativePTM(c.cfg, c.eb, ledger.NewLedger(cc.ConfigFile()))
+	} else {
+		c.ptm = NewPTM(c.cfg)
+	}
 	c.quitCh = make(chan struct{})
 
 	c.feb = cc.FeedEventBus()
@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/bip39"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -19,6 +20,7 @@ func NewAccountApi() *AccountApi {
 	return &AccountApi{logger: log.NewLogger("rpc/account")}
 }
 
+// Create derives account of index from seed, BIP39 seed returned by NewSeed derives the account of HD path
 func (a *AccountApi) Create(seedStr string, i *uint32) (map[string]string, error) {
 	var index uint32
 	b, err := hex.DecodeString(seedStr)
@@ -30,11 +32,15 @@ func (a *AccountApi) Create(seedStr string, i *uint32) (map[string]string, error
 	} else {
 		index = *i
 	}
-	seed, err := types.BytesToSeed(b)
-	if err != nil {
-		return nil, err
+	var acc *types.Account
+	if len(b) == bip39.SeedSize {
+		acc, err = types.NewAccountByPath(b, types.HDPath(index))
+	} else {
+		var seed *types.Seed
+		if seed, err = types.BytesToSeed(b); err == nil {
+			acc, err = seed.Account(index)
+		}
 	}
-	acc, err := seed.Account(index)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +60,27 @@ func (a *AccountApi) ForPublicKey(pubStr string) (types.Address, error) {
 	return addr, nil
 }
 
-func (a *AccountApi) NewSeed() (string, error) {
+// NewMnemonic generates BIP39 mnemonic of 12/15/18/21/24 words, default is 24 words
+func (a *AccountApi) NewMnemonic(words *int) (string, error) {
+	size := bip39.DefaultEntropySize
+	if words != nil {
+		if *words < 12 || *words > 24 || *words%3 != 0 {
+			return "", fmt.Errorf("invalid mnemonic words %d, should be 12/15/18/21/24", *words)
+		}
+		size = *words / 3 * 32
+	}
+	return bip39.NewRandomMnemonic(size)
+}
+
+// NewSeed generates random seed, or BIP39 seed if mnemonic is provided, both of them can be used by Create
+func (a *AccountApi) NewSeed(mnemonic *string, passphrase *string) (string, error) {
+	if mnemonic != nil && *mnemonic != "" {
+		seed, err := bip39.NewSeed(*mnemonic, toPassphrase(passphrase))
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(seed), nil
+	}
 	seed, err := types.NewSeed()
 	if err != nil {
 		return "", err
@@ -63,13 +89,17 @@ func (a *AccountApi) NewSeed() (string, error) {
 }
 
 type Accounts struct {
-	Seed       string `json:"seed"`
+	Seed       string `json:"seed,omitempty"`
 	PrivateKey string `json:"privateKey"`
 	PublicKey  string `json:"publicKey"`
 	Address    string `json:"address"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Bip39Seed  string `json:"bip39Seed,omitempty"`
 }
 
-func (a *AccountApi) NewAccounts(count *uint32) ([]*Accounts, error) {
+// NewAccounts generates accounts of random seeds, or derives accounts of default paths if mnemonic is provided
+func (a *AccountApi) NewAccounts(count *uint32, mnemonic *string, passphrase *string) ([]*Accounts, error) {
 	var count1 uint32
 	var acs []*Accounts
 	if count == nil {
@@ -77,6 +107,9 @@ func (a *AccountApi) NewAccounts(count *uint32) ([]*Accounts, error) {
 	} else {
 		count1 = *count
 	}
+	if mnemonic != nil && *mnemonic != "" {
+		return a.newHDAccounts(count1, *mnemonic, toPassphrase(passphrase))
+	}
 	for i := 0; uint32(i) < count1; i++ {
 		seed, err := types.NewSeed()
 		if err == nil {
@@ -101,6 +134,37 @@ func (a *AccountApi) NewAccounts(count *uint32) ([]*Accounts, error) {
 	return acs, nil
 }
 
+func (a *AccountApi) newHDAccounts(count uint32, mnemonic, passphrase string) ([]*Accounts, error) {
+	seed, err := bip39.NewSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	var acs []*Accounts
+	for i := uint32(0); i < count; i++ {
+		path := types.HDPath(i)
+		acc, err := types.NewAccountByPath(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		acs = append(acs, &Accounts{
+			PrivateKey: hex.EncodeToString(acc.PrivateKey()),
+			PublicKey:  hex.EncodeToString(acc.Address().Bytes()),
+			Address:    acc.Address().String(),
+			Mnemonic:   mnemonic,
+			Path:       path,
+			Bip39Seed:  hex.EncodeToString(seed),
+		})
+	}
+	return acs, nil
+}
+
+func toPassphrase(passphrase *string) string {
+	if passphrase == nil {
+		return ""
+	}
+	return *passphrase
+}
+
 func (a *AccountApi) PublicKey(addr types.Address) string {
 	pub := hex.EncodeToString(addr.Bytes())
 	return pub
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/mock"
 )
 
@@ -40,7 +42,7 @@ func TestAccountApi_PublicKey(t *testing.T) {
 
 func TestAccountApi_NewSeed(t *testing.T) {
 	api := NewAccountApi()
-	s, err := api.NewSeed()
+	s, err := api.NewSeed(nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -49,7 +51,7 @@ func TestAccountApi_NewSeed(t *testing.T) {
 
 func TestAccountApi_NewAccounts(t *testing.T) {
 	api := NewAccountApi()
-	r, err := api.NewAccounts(nil)
+	r, err := api.NewAccounts(nil, nil, nil)
 	if err != nil || len(r) != 10 {
 		t.Fatal(err, len(r))
 	}
@@ -62,3 +64,48 @@ func TestAccountApi_Validate(t *testing.T) {
 		t.Fatal(b)
 	}
 }
+
+func TestAccountApi_Mnemonic(t *testing.T) {
+	api := NewAccountApi()
+	words := 12
+	m, err := api.NewMnemonic(&words)
+	if err != nil || len(strings.Fields(m)) != 12 {
+		t.Fatal(m, err)
+	}
+	if m, err := api.NewMnemonic(nil); err != nil || len(strings.Fields(m)) != 24 {
+		t.Fatal(m, err)
+	}
+	for _, words := range []int{0, 11, 13, 27} {
+		if m, err := api.NewMnemonic(&words); err == nil {
+			t.Fatal("invalid words should be rejected", words, m)
+		}
+	}
+
+	m = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	pass := "TREZOR"
+	s, err := api.NewSeed(&m, &pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04" {
+		t.Fatal(s)
+	}
+
+	count := uint32(3)
+	r, err := api.NewAccounts(&count, &m, &pass)
+	if err != nil || len(r) != 3 {
+		t.Fatal(err, len(r))
+	}
+	if r[2].Path != types.HDPath(2) || r[2].Bip39Seed != s || r[2].Seed != "" {
+		t.Fatal(r[2])
+	}
+	index := uint32(2)
+	if acc, err := api.Create(s, &index); err != nil || acc["privKey"] != r[2].PrivateKey {
+		t.Fatal(acc, err)
+	}
+
+	bad := "abandon about"
+	if _, err := api.NewAccounts(&count, &bad, nil); err == nil {
+		t.Fatal("invalid mnemonic should be rejected")
+	}
+}
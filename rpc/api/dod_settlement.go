@@ -806,3 +806,169 @@ func (d *DoDSettlementAPI) GenerateInvoiceByProductId(seller types.Address, prod
 func (d *DoDSettlementAPI) GetInternalIdByOrderId(seller types.Address, orderId string) (types.Hash, error) {
 	return abi.DoDSettleGetInternalIdByOrderId(d.ctx, seller, orderId)
 }
+
+type DoDSettleIssueInvoiceParam struct {
+	ContractPrivacyParam
+	abi.DoDSettleIssueInvoiceParam
+}
+
+type DoDSettlePayInvoiceParam struct {
+	ContractPrivacyParam
+	abi.DoDSettlePayInvoiceParam
+	Amount types.Balance `json:"amount"`
+}
+
+type DoDSettleRefundInvoiceParam struct {
+	ContractPrivacyParam
+	abi.DoDSettleRefundInvoiceParam
+}
+
+// GetIssueInvoiceBlock returns the block of seller which records the invoice of order on chain
+func (d *DoDSettlementAPI) GetIssueInvoiceBlock(param *DoDSettleIssueInvoiceParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+
+	data, err := param.ToABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ContractSendBlockPara{
+		Address:        param.Seller,
+		TokenName:      "QGAS",
+		To:             contractaddress.DoDSettlementAddress,
+		Amount:         types.NewBalance(0),
+		Data:           data,
+		PrivateFrom:    param.PrivateFrom,
+		PrivateFor:     param.PrivateFor,
+		PrivateGroupID: param.PrivateGroupID,
+	}
+
+	return d.ca.GenerateSendBlock(p)
+}
+
+// GetPayInvoiceBlock returns the block of buyer which pays the invoice by its token, the outstanding
+// amount is paid if amount is not set
+func (d *DoDSettlementAPI) GetPayInvoiceBlock(param *DoDSettlePayInvoiceParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+
+	invoice, err := abi.DoDSettleGetInvoice(d.ctx, param.InvoiceId)
+	if err != nil {
+		return nil, fmt.Errorf("get invoice %s err %s", param.InvoiceId, err)
+	}
+
+	token, err := d.l.GetTokenById(invoice.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := param.Amount
+	if amount.Int == nil || amount.IsZero() {
+		paid, err := abi.DoDSettleGetInvoicePaid(d.ctx, invoice.InvoiceId)
+		if err != nil {
+			return nil, err
+		}
+		amount = invoice.TokenAmount.Sub(paid)
+		if amount.Int.Sign() <= 0 {
+			return nil, fmt.Errorf("invoice %s is already paid", invoice.InvoiceId)
+		}
+	}
+
+	data, err := param.ToABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ContractSendBlockPara{
+		Address:        invoice.Buyer.Address,
+		TokenName:      token.TokenName,
+		To:             contractaddress.DoDSettlementAddress,
+		Amount:         amount,
+		Data:           data,
+		PrivateFrom:    param.PrivateFrom,
+		PrivateFor:     param.PrivateFor,
+		PrivateGroupID: param.PrivateGroupID,
+	}
+
+	return d.ca.GenerateSendBlock(p)
+}
+
+// GetRefundInvoiceBlock returns the block of payer which takes back the overpaid part of a payment
+func (d *DoDSettlementAPI) GetRefundInvoiceBlock(param *DoDSettleRefundInvoiceParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+
+	invoice, err := abi.DoDSettleGetInvoice(d.ctx, param.InvoiceId)
+	if err != nil {
+		return nil, fmt.Errorf("get invoice %s err %s", param.InvoiceId, err)
+	}
+
+	payment, err := abi.DoDSettleGetInvoicePayment(d.ctx, param.InvoiceId, param.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := d.l.GetTokenById(invoice.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := param.ToABI()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ContractSendBlockPara{
+		Address:        payment.Payer,
+		TokenName:      token.TokenName,
+		To:             contractaddress.DoDSettlementAddress,
+		Amount:         types.NewBalance(0),
+		Data:           data,
+		PrivateFrom:    param.PrivateFrom,
+		PrivateFor:     param.PrivateFor,
+		PrivateGroupID: param.PrivateGroupID,
+	}
+
+	return d.ca.GenerateSendBlock(p)
+}
+
+// GetInvoiceRewardBlock returns the block which receives the funds of invoice payment by seller or refund by payer
+func (d *DoDSettlementAPI) GetInvoiceRewardBlock(sendHash types.Hash) (*types.StateBlock, error) {
+	p := &ContractRewardBlockPara{
+		SendHash: sendHash,
+	}
+
+	return d.ca.GenerateRewardBlock(p)
+}
+
+func (d *DoDSettlementAPI) GetInvoicePaymentStatus(invoiceId types.Hash) (*abi.DoDSettleInvoicePaymentStatus, error) {
+	now, err := d.povTime()
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DoDSettleGetInvoicePaymentStatus(d.ctx, invoiceId, now)
+}
+
+func (d *DoDSettlementAPI) GetOrderPaymentStatus(seller types.Address, orderId string) ([]*abi.DoDSettleInvoicePaymentStatus, error) {
+	now, err := d.povTime()
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.DoDSettleGetOrderPaymentStatus(d.ctx, seller, orderId, now)
+}
+
+// povTime returns time of the latest pov block, dunning state of invoice is evaluated by it
+func (d *DoDSettlementAPI) povTime() (int64, error) {
+	header, err := d.l.GetLatestPovHeader()
+	if err != nil {
+		return 0, fmt.Errorf("get pov header error: %s", err)
+	}
+
+	return int64(header.GetTimestamp()), nil
+}
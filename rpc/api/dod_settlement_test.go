@@ -960,3 +960,114 @@ func TestDoDSettlementAPI_GetInternalIdByOrderId(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestDoDSettlementAPI_GetIssueInvoiceBlock(t *testing.T) {
+	ds, clear := DoDSettleAPITestInit(t)
+	defer clear()
+
+	param := new(DoDSettleIssueInvoiceParam)
+	param.Seller = mock.Address()
+
+	_, err := ds.GetIssueInvoiceBlock(nil)
+	if err == nil {
+		t.Fatal()
+	}
+
+	_, _ = ds.GetIssueInvoiceBlock(param)
+}
+
+func TestDoDSettlementAPI_GetPayInvoiceBlock(t *testing.T) {
+	ds, clear := DoDSettleAPITestInit(t)
+	defer clear()
+
+	_, err := ds.GetPayInvoiceBlock(nil)
+	if err == nil {
+		t.Fatal()
+	}
+
+	param := new(DoDSettlePayInvoiceParam)
+	param.InvoiceId = mock.Hash()
+	_, err = ds.GetPayInvoiceBlock(param)
+	if err == nil {
+		t.Fatal()
+	}
+
+	invoice := &abi.DoDSettleInvoiceInfo{
+		InvoiceId:   param.InvoiceId,
+		InternalId:  mock.Hash(),
+		Buyer:       &abi.DoDSettleUser{Address: mock.Address()},
+		Seller:      &abi.DoDSettleUser{Address: mock.Address()},
+		Token:       mock.Hash(),
+		TokenAmount: types.NewBalance(100),
+	}
+	err = abi.DoDSettleSetInvoice(ds.ctx, invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ds.GetPayInvoiceBlock(param)
+	if err == nil {
+		t.Fatal()
+	}
+}
+
+func TestDoDSettlementAPI_GetRefundInvoiceBlock(t *testing.T) {
+	ds, clear := DoDSettleAPITestInit(t)
+	defer clear()
+
+	_, err := ds.GetRefundInvoiceBlock(nil)
+	if err == nil {
+		t.Fatal()
+	}
+
+	param := new(DoDSettleRefundInvoiceParam)
+	param.InvoiceId = mock.Hash()
+	param.PaymentId = mock.Hash()
+	_, err = ds.GetRefundInvoiceBlock(param)
+	if err == nil {
+		t.Fatal()
+	}
+
+	invoice := &abi.DoDSettleInvoiceInfo{
+		InvoiceId:   param.InvoiceId,
+		InternalId:  mock.Hash(),
+		Buyer:       &abi.DoDSettleUser{Address: mock.Address()},
+		Seller:      &abi.DoDSettleUser{Address: mock.Address()},
+		Token:       mock.Hash(),
+		TokenAmount: types.NewBalance(100),
+	}
+	err = abi.DoDSettleSetInvoice(ds.ctx, invoice)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ds.GetRefundInvoiceBlock(param)
+	if err == nil {
+		t.Fatal()
+	}
+}
+
+func TestDoDSettlementAPI_GetInvoiceRewardBlock(t *testing.T) {
+	ds, clear := DoDSettleAPITestInit(t)
+	defer clear()
+
+	_, err := ds.GetInvoiceRewardBlock(types.ZeroHash)
+	if err == nil {
+		t.Fatal()
+	}
+}
+
+func TestDoDSettlementAPI_GetInvoicePaymentStatus(t *testing.T) {
+	ds, clear := DoDSettleAPITestInit(t)
+	defer clear()
+
+	_, err := ds.GetInvoicePaymentStatus(mock.Hash())
+	if err == nil {
+		t.Fatal()
+	}
+
+	_, err = ds.GetOrderPaymentStatus(mock.Address(), "order1")
+	if err == nil {
+		t.Fatal()
+	}
+}
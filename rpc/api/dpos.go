@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package api
+
+import (
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+)
+
+type DPoSApi struct {
+	logger *zap.SugaredLogger
+	l      ledger.Store
+}
+
+func NewDPoSApi(l ledger.Store) *DPoSApi {
+	return &DPoSApi{
+		l:      l,
+		logger: log.NewLogger("api dpos"),
+	}
+}
+
+// GetEquivocations returns equivocation evidences of representatives, newest first,
+// only evidences of the account are returned if account is set
+func (d *DPoSApi) GetEquivocations(account *types.Address, count int, offset *int) ([]*types.Equivocation, error) {
+	eqs := make([]*types.Equivocation, 0)
+	fn := func(value *types.Equivocation) error {
+		eqs = append(eqs, value)
+		return nil
+	}
+
+	var err error
+	if account != nil {
+		err = d.l.GetEquivocationsByAccount(*account, fn)
+	} else {
+		err = d.l.GetEquivocations(fn)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(eqs, func(i, j int) bool {
+		return eqs[i].Timestamp > eqs[j].Timestamp
+	})
+
+	start, end, err := calculateRange(len(eqs), count, offset)
+	if err != nil {
+		return nil, err
+	}
+	return eqs[start:end], nil
+}
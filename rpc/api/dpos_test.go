@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package api
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestDPoSApi_GetEquivocations(t *testing.T) {
+	clear, l, _ := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	d := NewDPoSApi(l)
+	if _, err := d.GetEquivocations(nil, 10, nil); err == nil {
+		t.Fatal("no equivocation should be found")
+	}
+
+	rep := mock.Address()
+	for i := 0; i < 3; i++ {
+		blkA := mock.StateBlockWithoutWork()
+		blkB := blkA.Clone()
+		blkB.Timestamp++
+		account := rep
+		if i == 2 {
+			account = mock.Address()
+		}
+		eq := &types.Equivocation{Account: account, BlockA: blkA, BlockB: blkB, Timestamp: int64(i)}
+		if err := l.AddEquivocation(eq); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	eqs, err := d.GetEquivocations(nil, 10, nil)
+	if err != nil || len(eqs) != 3 || eqs[0].Timestamp != 2 {
+		t.Fatal(err, eqs)
+	}
+
+	offset := 1
+	eqs, err = d.GetEquivocations(&rep, 1, &offset)
+	if err != nil || len(eqs) != 1 || eqs[0].Account != rep || eqs[0].Timestamp != 0 {
+		t.Fatal(err, eqs)
+	}
+
+	if _, err := d.GetEquivocations(nil, 0, nil); err == nil {
+		t.Fatal("invalid count")
+	}
+}
@@ -15,8 +15,10 @@ import (
 
 	chainctx "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/ledger/process"
@@ -40,6 +42,9 @@ const (
 
 const lockTimeout = 60 * time.Second
 
+// timeout of querying pending txs of PoV tx pool, the pool is treated as empty if PoV engine does not respond
+const feePendingTimeout = 3 * time.Second
+
 type lockValue struct {
 	lockStatus atomic.Value
 	mutex      *sync.Mutex
@@ -52,6 +57,7 @@ type LedgerAPI struct {
 	eb                event.EventBus
 	logger            *zap.SugaredLogger
 	blockSubscription *BlockSubscription
+	vmLogSubscription *VmLogSubscription
 	processLock       *sync.Map
 	cc                *chainctx.ChainContext
 	ctx               context.Context
@@ -112,6 +118,7 @@ func NewLedgerApi(ctx context.Context, l ledger.Store, eb event.EventBus, cc *ch
 		eb:                eb,
 		logger:            log.NewLogger("api_ledger"),
 		blockSubscription: NewBlockSubscription(ctx, eb),
+		vmLogSubscription: NewVmLogSubscription(ctx, eb),
 		processLock:       new(sync.Map),
 		cc:                cc,
 		ctx:               ctx,
@@ -177,6 +184,50 @@ func (l *LedgerAPI) AccountHistoryTopn(address types.Address, count int, offset
 	return bs, nil
 }
 
+// APIHistoryPage is a page of history blocks, Cursor is passed to get the next page and is empty on the last page
+type APIHistoryPage struct {
+	Blocks []*APIBlock `json:"blocks"`
+	Cursor string      `json:"cursor"`
+}
+
+// AccountHistory returns the confirmed blocks of the account matching the filter, newest first
+func (l *LedgerAPI) AccountHistory(address types.Address, filter *ledger.HistoryFilter, cursor string, limit int) (*APIHistoryPage, error) {
+	entries, next, err := l.ledger.AccountHistory(address, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return l.historyPage(entries, next)
+}
+
+// TokenTransfers returns the confirmed blocks of the token matching the filter, newest first
+func (l *LedgerAPI) TokenTransfers(token types.Hash, filter *ledger.HistoryFilter, cursor string, limit int) (*APIHistoryPage, error) {
+	entries, next, err := l.ledger.TokenTransfers(token, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return l.historyPage(entries, next)
+}
+
+func (l *LedgerAPI) historyPage(entries []*ledger.HistoryEntry, cursor string) (*APIHistoryPage, error) {
+	latestPov, _ := l.ledger.GetLatestPovHeader()
+	page := &APIHistoryPage{
+		Blocks: make([]*APIBlock, 0, len(entries)),
+		Cursor: cursor,
+	}
+	for _, entry := range entries {
+		block, err := l.ledger.GetStateBlockConfirmed(entry.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("can not get block %s", entry.Hash.String())
+		}
+		b, err := GenerateAPIBlock(l.ledger, block, latestPov)
+		if err != nil {
+			return nil, err
+		}
+		page.Blocks = append(page.Blocks, b)
+	}
+	return page, nil
+}
+
 func (l *LedgerAPI) AccountInfo(address types.Address) (*APIAccount, error) {
 	am, err := l.ledger.GetAccountMeta(address)
 	if err != nil {
@@ -601,6 +652,9 @@ func (l *LedgerAPI) GenerateSendBlock(para *APISendBlockPara, prkStr *string) (*
 		Link:    para.To.ToHash(),
 		Message: para.Message,
 	}
+	if !ledger.IsFeeDeposit(&sb) {
+		sb.Fee = l.blockFee()
+	}
 	block, err := l.ledger.GenerateSendBlock(&sb, para.Amount, prk)
 	if err != nil {
 		return nil, err
@@ -662,14 +716,96 @@ func (l *LedgerAPI) GenerateChangeBlock(account types.Address, representative ty
 		}
 	}
 
-	block, err := l.ledger.GenerateChangeBlock(account, representative, prk)
+	fee := l.blockFee()
+	if fee == nil {
+		return l.ledger.GenerateChangeBlock(account, representative, prk)
+	}
+
+	// fee is part of block hash, so the block is signed after the fee is set
+	block, err := l.ledger.GenerateChangeBlock(account, representative, nil)
 	if err != nil {
 		return nil, err
 	}
-
+	block.Fee = fee
+	if prk != nil {
+		acc := types.NewAccount(prk)
+		if acc.Address() != account {
+			return nil, fmt.Errorf("change address (%s) is mismatch privateKey (%s)", account.String(), acc.Address().String())
+		}
+		block.Signature = acc.Sign(block.GetHash())
+		var w types.Work
+		worker, err := types.NewWorker(w, block.Root())
+		if err != nil {
+			return nil, err
+		}
+		block.Work = worker.NewWork()
+	}
 	return block, nil
 }
 
+type APIFeeEstimate struct {
+	Enable bool `json:"enable"`
+	// suggested fee, it is advisory only and not enforced by ledger
+	Fee types.Balance `json:"fee"`
+	// blocks with fee less than it are rejected
+	MinFee       types.Balance `json:"minFee"`
+	MaxFee       types.Balance `json:"maxFee"`
+	Mode         string        `json:"mode"`
+	PendingTxNum uint32        `json:"pendingTxNum"`
+	FeeAddress   types.Address `json:"feeAddress"`
+	FeeCredit    types.Balance `json:"feeCredit"`
+}
+
+// EstimateFee returns the suggested QGAS fee of a block by recent pressure of PoV tx pool,
+// and the fee credit of the account if it is set, QGAS sent to the fee address is added to the credit.
+// The suggested fee is advisory only, ledger only rejects blocks with fee less than the min fee
+func (l *LedgerAPI) EstimateFee(address *types.Address) (*APIFeeEstimate, error) {
+	cfg := config.GenesisFee()
+	r := &APIFeeEstimate{
+		Enable:     cfg.Enable,
+		Fee:        types.ZeroBalance,
+		MinFee:     cfg.MinFee,
+		MaxFee:     cfg.MaxFee,
+		Mode:       cfg.Mode,
+		FeeAddress: contractaddress.FeeAddress,
+		FeeCredit:  types.ZeroBalance,
+	}
+	if cfg.Enable {
+		r.PendingTxNum = l.pendingTxNum()
+		r.Fee = cfg.Price(r.PendingTxNum)
+	}
+	if address != nil {
+		credit, err := l.ledger.GetFeeCredit(*address)
+		if err != nil {
+			return nil, err
+		}
+		r.FeeCredit = credit
+	}
+	return r, nil
+}
+
+// blockFee returns the estimated fee of generated block, it is nil if fee is disabled
+func (l *LedgerAPI) blockFee() *types.Balance {
+	cfg := config.GenesisFee()
+	if !cfg.Enable {
+		return nil
+	}
+	fee := cfg.Price(l.pendingTxNum())
+	return &fee
+}
+
+func (l *LedgerAPI) pendingTxNum() uint32 {
+	outArgs := make(map[string]interface{})
+	rsp := l.cc.FeedEventBus().RpcSyncCallWithTime(&topic.EventRPCSyncCallMsg{Name: "Pov.PendingTxNum", In: make(map[string]interface{}), Out: outArgs}, feePendingTimeout)
+	if rsp == nil {
+		return 0
+	}
+	if n, ok := outArgs["pendingTxNum"].(uint32); ok {
+		return n
+	}
+	return 0
+}
+
 func (l *LedgerAPI) Pendings() ([]*APIPending, error) {
 	aps := make([]*APIPending, 0)
 	err := l.ledger.GetPendings(func(pendingKey *types.PendingKey, pendingInfo *types.PendingInfo) error {
@@ -1112,6 +1248,40 @@ func (l *LedgerAPI) NewPending(ctx context.Context, address types.Address) (*rpc
 	})
 }
 
+// GetLogs returns the contract logs matching the filter
+func (l *LedgerAPI) GetLogs(filter *ledger.VmLogFilter) ([]*ledger.VmLogEntry, error) {
+	return l.ledger.GetLogs(filter)
+}
+
+// NewLogs subscribes the contract logs matching the filter of newly confirmed blocks
+func (l *LedgerAPI) NewLogs(ctx context.Context, filter *ledger.VmLogFilter) (*rpc.Subscription, error) {
+	sub, err := createSubscription(ctx, func(notifier *rpc.Notifier, subscription *rpc.Subscription) {
+		go func() {
+			ch := l.vmLogSubscription.AddChan(string(subscription.ID), filter)
+			defer l.vmLogSubscription.RemoveChan(string(subscription.ID))
+
+			for {
+				select {
+				case entry := <-ch:
+					if err := notifier.Notify(subscription.ID, entry); err != nil {
+						l.logger.Errorf("notify error: %s", err)
+						return
+					}
+				case err := <-subscription.Err():
+					l.logger.Infof("subscription exception %s", err)
+					return
+				}
+			}
+		}()
+	})
+	if err != nil || sub == nil {
+		l.logger.Errorf("create subscription error, %s", err)
+		return nil, err
+	}
+	l.logger.Infof("logs subscription: %s", sub.ID)
+	return sub, nil
+}
+
 func (l *LedgerAPI) GenesisAddress() types.Address {
 	return config.GenesisAddress()
 }
@@ -19,6 +19,8 @@ import (
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/ledger/process"
@@ -275,6 +277,55 @@ func TestLedgerAPI_AccountHistoryTopn(t *testing.T) {
 	}
 }
 
+func TestLedgerAPI_AccountHistory(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+	if err := l.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks := make([]*APIBlock, 0)
+	cursor := ""
+	for {
+		r, err := ledgerApi.AccountHistory(account1.Address(), nil, cursor, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, r.Blocks...)
+		if r.Cursor == "" {
+			break
+		}
+		cursor = r.Cursor
+	}
+	if len(blocks) != 4 {
+		t.Fatalf("exp: 4, act: %d", len(blocks))
+	}
+
+	r, err := ledgerApi.AccountHistory(account1.Address(), &ledger.HistoryFilter{Types: []types.BlockType{types.Open}}, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Blocks) != 2 || r.Cursor != "" {
+		t.Fatal("invalid open blocks", r)
+	}
+	for _, b := range r.Blocks {
+		if b.Type != types.Open {
+			t.Fatal("invalid block type", b.Type)
+		}
+	}
+
+	tr, err := ledgerApi.TokenTransfers(r.Blocks[0].Token, nil, "", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tr.Blocks) != 1 || tr.Cursor == "" {
+		t.Fatal("invalid token transfers", tr)
+	}
+	if _, err := ledgerApi.TokenTransfers(r.Blocks[0].Token, nil, "zz", 1); err == nil {
+		t.Fatal("invalid cursor should be rejected")
+	}
+}
+
 func TestLedgerAPI_AccountInfo(t *testing.T) {
 	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
 	defer teardownTestCase(t)
@@ -915,3 +966,109 @@ func TestLedgerAPI_PubSub(t *testing.T) {
 	ledgerApi.blockSubscription.setBlocks(blk1)
 	time.Sleep(10 * time.Millisecond)
 }
+
+func TestLedgerAPI_GetLogs(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupMockLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	filter := &ledger.VmLogFilter{Addresses: []types.Address{mock.Address()}}
+	entries := []*ledger.VmLogEntry{{Address: filter.Addresses[0], BlockHash: mock.Hash()}}
+	l.On("GetLogs", filter).Return(entries, nil)
+	r, err := ledgerApi.GetLogs(filter)
+	if err != nil || len(r) != 1 || r[0].BlockHash != entries[0].BlockHash {
+		t.Fatal(r, err)
+	}
+}
+
+func TestLedgerAPI_NewLogs(t *testing.T) {
+	teardownTestCase, _, ledgerApi := setupDefaultLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	contract := mock.Address()
+	sub, err := ledgerApi.NewLogs(rpc.SubscriptionContextRandom(), &ledger.VmLogFilter{Addresses: []types.Address{contract}})
+	if err != nil || sub == nil {
+		t.Fatal(sub, err)
+	}
+
+	filter := &ledger.VmLogFilter{Topics: [][]types.Hash{{}, {mock.Hash()}}}
+	ch := ledgerApi.vmLogSubscription.AddChan("test", filter)
+	defer ledgerApi.vmLogSubscription.RemoveChan("test")
+	matched := &ledger.VmLogEntry{Address: contract, Topics: []types.Hash{mock.Hash(), filter.Topics[1][0]}}
+	ledgerApi.vmLogSubscription.notifyAllSubs([]*ledger.VmLogEntry{
+		{Address: contract, Topics: []types.Hash{mock.Hash()}},
+		matched,
+	})
+	select {
+	case entry := <-ch:
+		if entry != matched {
+			t.Fatal("invalid log", entry)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("log not received")
+	}
+	if len(ch) != 0 {
+		t.Fatal("unmatched log received")
+	}
+}
+
+func TestLedgerAPI_EstimateFee(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupMockLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	addr := mock.Address()
+	l.On("GetFeeCredit", addr).Return(types.NewBalance(300), nil)
+
+	r, err := ledgerApi.EstimateFee(&addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Enable || !r.Fee.IsZero() || !r.FeeCredit.Equal(types.NewBalance(300)) || r.FeeAddress != contractaddress.FeeAddress {
+		t.Fatal("invalid fee estimate", util.ToString(r))
+	}
+	if ledgerApi.blockFee() != nil {
+		t.Fatal("block fee should be nil if fee is disabled")
+	}
+
+	dir := filepath.Join(config.QlcTestDataDir(), "api", uuid.New().String())
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := config.NewCfgManager(dir)
+	cfg, _ := cm.Load()
+	loadFee := func(fee *config.FeeConfig) {
+		cfg.Genesis.Fee = fee
+		if err := cm.Save(cfg); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := cm.Load(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	loadFee(&config.FeeConfig{Enable: true, MinFee: types.NewBalance(100), MaxFee: types.NewBalance(1000), Mode: config.FeeModeBurn, TargetTxs: 10})
+	defer loadFee(nil)
+
+	// respond pending txs of PoV tx pool
+	feb := ledgerApi.cc.FeedEventBus()
+	ch := make(chan *topic.EventRPCSyncCallMsg, 1)
+	sub := feb.Subscribe(topic.EventRpcSyncCall, ch)
+	defer feb.Unsubscribe(sub)
+	go func() {
+		for msg := range ch {
+			if msg.Name == "Pov.PendingTxNum" {
+				msg.Out.(map[string]interface{})["pendingTxNum"] = uint32(5)
+				msg.ResponseChan <- msg.Out
+			}
+		}
+	}()
+
+	r, err = ledgerApi.EstimateFee(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Enable || r.PendingTxNum != 5 || !r.Fee.Equal(types.NewBalance(150)) || !r.FeeCredit.IsZero() {
+		t.Fatal("invalid fee estimate", util.ToString(r))
+	}
+	if fee := ledgerApi.blockFee(); fee == nil || !fee.Equal(types.NewBalance(150)) {
+		t.Fatal("invalid block fee", fee)
+	}
+}
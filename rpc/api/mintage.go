@@ -10,6 +10,7 @@ package api
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"go.uber.org/zap"
 
@@ -31,6 +32,7 @@ type MintageAPI struct {
 	l        ledger.Store
 	mintage  *contract.Mintage
 	withdraw *contract.WithdrawMintage
+	issue    *contract.IssueMintage
 	cc       *chainctx.ChainContext
 }
 
@@ -39,6 +41,7 @@ func NewMintageApi(cfgFile string, l ledger.Store) *MintageAPI {
 		l:        l,
 		mintage:  &contract.Mintage{},
 		withdraw: &contract.WithdrawMintage{},
+		issue:    &contract.IssueMintage{},
 		logger:   log.NewLogger("api_mintage"),
 		cc:       chainctx.NewChainContext(cfgFile),
 	}
@@ -54,19 +57,49 @@ type MintageParams struct {
 	Decimals    uint8         `json:"decimals"`
 	Beneficial  types.Address `json:"beneficial"`
 	NEP5TxId    string        `json:"nep5TxId"`
+	// optional issuer authority, issuer is selfAddr if not set
+	Issuer      types.Address `json:"issuer,omitempty"`
+	Authorities []string      `json:"authorities,omitempty"`
+}
+
+func (m *MintageAPI) mintageParam(param *MintageParams) (*mintage.ParamMintage, error) {
+	totalSupply, err := util.StringToBigInt(&param.TotalSupply)
+	if err != nil {
+		return nil, err
+	}
+	flags, err := mintage.ParseAuthorityFlags(param.Authorities)
+	if err != nil {
+		return nil, err
+	}
+	issuer := param.Issuer
+	if issuer.IsZero() {
+		issuer = param.SelfAddr
+	}
+	p := &mintage.ParamMintage{
+		TokenId:     mintage.NewTokenHash(param.SelfAddr, param.PrevHash, param.TokenName),
+		TokenName:   param.TokenName,
+		TokenSymbol: param.TokenSymbol,
+		TotalSupply: totalSupply,
+		Decimals:    param.Decimals,
+		Beneficial:  param.Beneficial,
+		NEP5TxId:    param.NEP5TxId,
+	}
+	if flags != 0 {
+		p.Issuer = issuer
+		p.Flags = flags
+	}
+	return p, nil
 }
 
 func (m *MintageAPI) GetMintageData(param *MintageParams) ([]byte, error) {
 	if param == nil {
 		return nil, ErrParameterNil
 	}
-	tokenId := mintage.NewTokenHash(param.SelfAddr, param.PrevHash, param.TokenName)
-	totalSupply, err := util.StringToBigInt(&param.TotalSupply)
+	p, err := m.mintageParam(param)
 	if err != nil {
 		return nil, err
 	}
-	return mintage.MintageABI.PackMethod(mintage.MethodNameMintage, tokenId, param.TokenName, param.TokenSymbol,
-		totalSupply, param.Decimals, param.Beneficial, param.NEP5TxId)
+	return mintage.PackMintageParam(p)
 }
 
 func (m *MintageAPI) GetMintageBlock(param *MintageParams) (*types.StateBlock, error) {
@@ -77,13 +110,11 @@ func (m *MintageAPI) GetMintageBlock(param *MintageParams) (*types.StateBlock, e
 		return nil, chainctx.ErrPoVNotFinish
 	}
 
-	tokenId := mintage.NewTokenHash(param.SelfAddr, param.PrevHash, param.TokenName)
-	totalSupply, err := util.StringToBigInt(&param.TotalSupply)
+	p, err := m.mintageParam(param)
 	if err != nil {
 		return nil, err
 	}
-	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintage, tokenId, param.TokenName, param.TokenSymbol,
-		totalSupply, param.Decimals, param.Beneficial, param.NEP5TxId)
+	data, err := mintage.PackMintageParam(p)
 	if err != nil {
 		return nil, err
 	}
@@ -246,3 +277,202 @@ func (m *MintageAPI) GetWithdrawRewardBlock(input *types.StateBlock) (*types.Sta
 
 	return nil, errors.New("can not generate withdraw reward block")
 }
+
+type IssueParams struct {
+	Issuer     types.Address `json:"issuer"`
+	TokenId    types.Hash    `json:"tokenId"`
+	Beneficial types.Address `json:"beneficial"`
+	Amount     string        `json:"amount"`
+}
+
+type BurnParams struct {
+	Issuer  types.Address `json:"issuer"`
+	TokenId types.Hash    `json:"tokenId"`
+	Amount  string        `json:"amount"`
+}
+
+type FreezeParams struct {
+	Issuer  types.Address `json:"issuer"`
+	TokenId types.Hash    `json:"tokenId"`
+	Account types.Address `json:"account"`
+}
+
+type TokenAuthorityInfo struct {
+	*mintage.TokenAuthority
+	Authorities []string `json:"authorities"`
+	// current supply in minimal unit, including issued and excluding burned
+	TotalSupply *big.Int `json:"totalSupply"`
+}
+
+// authoritySendBlock returns the contract send block of issuer on token chain, and verifies it by the contract
+func (m *MintageAPI) authoritySendBlock(issuer types.Address, token types.Hash, amount types.Balance, data []byte) (*types.StateBlock, error) {
+	if !m.cc.IsPoVDone() {
+		return nil, chainctx.ErrPoVNotFinish
+	}
+	am, err := m.l.GetAccountMeta(issuer)
+	if err != nil {
+		return nil, err
+	}
+	tm := am.Token(token)
+	if tm == nil {
+		return nil, fmt.Errorf("%s do not have token %s", issuer, token)
+	}
+	if tm.Balance.Compare(amount) == types.BalanceCompSmaller {
+		return nil, fmt.Errorf("%s have no enough balance %s, expect %s", issuer, tm.Balance, amount)
+	}
+	povHeader, err := m.l.GetLatestPovHeader()
+	if err != nil {
+		return nil, fmt.Errorf("get pov header error: %s", err)
+	}
+
+	send := &types.StateBlock{
+		Type:           types.ContractSend,
+		Token:          tm.Type,
+		Address:        issuer,
+		Balance:        tm.Balance.Sub(amount),
+		Vote:           types.ZeroBalance,
+		Network:        types.ZeroBalance,
+		Storage:        types.ZeroBalance,
+		Oracle:         types.ZeroBalance,
+		Previous:       tm.Header,
+		Link:           types.Hash(contractaddress.MintageAddress),
+		Representative: tm.Representative,
+		Data:           data,
+		PoVHeight:      povHeader.GetHeight(),
+		Timestamp:      common.TimeNow().Unix(),
+	}
+	if token == config.ChainToken() {
+		send.Vote = am.CoinVote
+		send.Network = am.CoinNetwork
+		send.Storage = am.CoinStorage
+		send.Oracle = am.CoinOracle
+	}
+
+	c, ok, err := contract.GetChainContract(contractaddress.MintageAddress, data)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("can not find mintage contract: %v", err)
+	}
+	vmContext := vmstore.NewVMContext(m.l, &contractaddress.MintageAddress)
+	switch c.GetDescribe().GetVersion() {
+	case contract.SpecVer1:
+		if err := c.DoSend(vmContext, send); err != nil {
+			return nil, err
+		}
+	default:
+		if _, _, err := c.ProcessSend(vmContext, send); err != nil {
+			return nil, err
+		}
+		if h := vmstore.TrieHash(vmContext); h != nil {
+			send.Extra = *h
+		}
+	}
+	return send, nil
+}
+
+// GetIssueBlock returns the block of issuer to issue more token to beneficial
+func (m *MintageAPI) GetIssueBlock(param *IssueParams) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+	amount, err := util.StringToBigInt(&param.Amount)
+	if err != nil {
+		return nil, err
+	}
+	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintageIssue, param.TokenId, param.Beneficial, amount)
+	if err != nil {
+		return nil, err
+	}
+	return m.authoritySendBlock(param.Issuer, config.ChainToken(), types.ZeroBalance, data)
+}
+
+// GetIssueRewardBlock returns the block of beneficial to receive issued token
+func (m *MintageAPI) GetIssueRewardBlock(input *types.StateBlock) (*types.StateBlock, error) {
+	if input == nil {
+		return nil, ErrParameterNil
+	}
+	if !m.cc.IsPoVDone() {
+		return nil, chainctx.ErrPoVNotFinish
+	}
+	reward := &types.StateBlock{}
+	vmContext := vmstore.NewVMContext(m.l, &contractaddress.MintageAddress)
+	blocks, err := m.issue.DoReceive(vmContext, reward, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) > 0 {
+		povHeader, err := m.l.GetLatestPovHeader()
+		if err != nil {
+			return nil, fmt.Errorf("get pov header error: %s", err)
+		}
+		reward.PoVHeight = povHeader.GetHeight()
+		reward.Timestamp = common.TimeNow().Unix()
+		h := vmstore.TrieHash(blocks[0].VMContext)
+		if h == nil {
+			return nil, errors.New("trie hash is nil")
+		}
+		reward.Extra = *h
+		return reward, nil
+	}
+	return nil, errors.New("can not generate issue reward block")
+}
+
+// GetBurnBlock returns the block of issuer to destroy its token
+func (m *MintageAPI) GetBurnBlock(param *BurnParams) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+	amount, err := util.StringToBigInt(&param.Amount)
+	if err != nil {
+		return nil, err
+	}
+	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintageBurn, param.TokenId, amount)
+	if err != nil {
+		return nil, err
+	}
+	return m.authoritySendBlock(param.Issuer, param.TokenId, types.Balance{Int: amount}, data)
+}
+
+// GetFreezeBlock returns the block of issuer to freeze the account, which can not send or receive the token
+func (m *MintageAPI) GetFreezeBlock(param *FreezeParams) (*types.StateBlock, error) {
+	return m.freezeBlock(mintage.MethodNameMintageFreeze, param)
+}
+
+// GetUnfreezeBlock returns the block of issuer to unfreeze the account
+func (m *MintageAPI) GetUnfreezeBlock(param *FreezeParams) (*types.StateBlock, error) {
+	return m.freezeBlock(mintage.MethodNameMintageUnfreeze, param)
+}
+
+func (m *MintageAPI) freezeBlock(method string, param *FreezeParams) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+	data, err := mintage.MintageABI.PackMethod(method, param.TokenId, param.Account)
+	if err != nil {
+		return nil, err
+	}
+	return m.authoritySendBlock(param.Issuer, config.ChainToken(), types.ZeroBalance, data)
+}
+
+// GetTokenAuthority returns the issuer authority and current supply of token
+func (m *MintageAPI) GetTokenAuthority(tokenId types.Hash) (*TokenAuthorityInfo, error) {
+	ctx := vmstore.NewVMContext(m.l, &contractaddress.MintageAddress)
+	authority, err := contract.GetTokenAuthority(ctx, tokenId)
+	if err != nil {
+		return nil, fmt.Errorf("token %s has no issuer authority", tokenId)
+	}
+	info, err := m.l.GetTokenById(tokenId)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenAuthorityInfo{
+		TokenAuthority: authority,
+		Authorities:    mintage.AuthorityFlagNames(authority.Flags),
+		TotalSupply:    info.TotalSupply,
+	}, nil
+}
+
+// GetFrozenAccounts returns all accounts frozen by issuer of token
+func (m *MintageAPI) GetFrozenAccounts(tokenId types.Hash) ([]types.Address, error) {
+	ctx := vmstore.NewVMContext(m.l, &contractaddress.MintageAddress)
+	return contract.ListFrozenAccounts(ctx, tokenId)
+}
@@ -9,6 +9,7 @@ package api
 
 import (
 	"encoding/json"
+	"math/big"
 	"os"
 	"path/filepath"
 	"testing"
@@ -149,3 +150,143 @@ func TestNewMintageApi(t *testing.T) {
 		}
 	}
 }
+
+func TestMintageAPI_Authority(t *testing.T) {
+	testcase, verifier, api := setupMintageAPI(t)
+	defer testcase(t)
+
+	a := account1.Address()
+	b := account2.Address()
+
+	tm, err := api.l.GetTokenMeta(a, config.ChainToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	param := &MintageParams{
+		SelfAddr:    a,
+		PrevHash:    tm.Header,
+		TokenName:   "Auth",
+		TokenSymbol: "AT",
+		TotalSupply: "100",
+		Decimals:    8,
+		Beneficial:  a,
+		NEP5TxId:    mock.Hash().String(),
+		Authorities: []string{"issue", "burn", "freeze"},
+	}
+	if _, err := api.GetMintageBlock(&MintageParams{SelfAddr: a, PrevHash: tm.Header, TokenName: "Auth",
+		TokenSymbol: "AT", TotalSupply: "100", Decimals: 8, Beneficial: a, NEP5TxId: mock.Hash().String(),
+		Authorities: []string{"mint"}}); err == nil {
+		t.Fatal("invalid authority should return error")
+	}
+
+	processBlock := func(blk *types.StateBlock, acc *types.Account) {
+		blk.Signature = acc.Sign(blk.GetHash())
+		if err := verifier.BlockProcess(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	blk, err := api.GetMintageBlock(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk, account1)
+	rxBlk, err := api.GetRewardBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(rxBlk, account1)
+	ti, err := api.ParseTokenInfo(rxBlk.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// issue
+	if _, err := api.GetIssueBlock(&IssueParams{Issuer: b, TokenId: ti.TokenId, Beneficial: a, Amount: "50"}); err == nil {
+		t.Fatal("only issuer can issue token")
+	}
+	blk, err = api.GetIssueBlock(&IssueParams{Issuer: a, TokenId: ti.TokenId, Beneficial: a, Amount: "50"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk, account1)
+	rxBlk, err = api.GetIssueRewardBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(rxBlk, account1)
+
+	// burn
+	blk, err = api.GetBurnBlock(&BurnParams{Issuer: a, TokenId: ti.TokenId, Amount: "30"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk, account1)
+
+	// supply of mintage is 100 * 10^8, plus issued and minus burned
+	supply := big.NewInt(100*1e8 + 20)
+	token, err := api.l.GetTokenById(ti.TokenId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.TotalSupply.Cmp(supply) != 0 {
+		t.Fatal(token.TotalSupply)
+	}
+	if token, err := api.l.GetTokenByName(ti.TokenName); err != nil || token.TotalSupply.Cmp(supply) != 0 {
+		t.Fatal(token, err)
+	}
+	if info, err := api.GetTokenAuthority(ti.TokenId); err != nil {
+		t.Fatal(err)
+	} else if info.Issued.Int64() != 50 || info.Burned.Int64() != 30 || info.TotalSupply.Cmp(supply) != 0 || len(info.Authorities) != 3 {
+		t.Fatal(info)
+	}
+
+	// freeze
+	blk, err = api.GetFreezeBlock(&FreezeParams{Issuer: a, TokenId: ti.TokenId, Account: b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk, account1)
+	if accounts, err := api.GetFrozenAccounts(ti.TokenId); err != nil {
+		t.Fatal(err)
+	} else if len(accounts) != 1 || accounts[0] != b {
+		t.Fatal(accounts)
+	}
+
+	tokenMeta, err := api.l.GetTokenMeta(a, ti.TokenId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := &types.StateBlock{
+		Type:           types.Send,
+		Token:          ti.TokenId,
+		Address:        a,
+		Balance:        tokenMeta.Balance.Sub(types.Balance{Int: big.NewInt(1)}),
+		Vote:           types.ZeroBalance,
+		Network:        types.ZeroBalance,
+		Storage:        types.ZeroBalance,
+		Oracle:         types.ZeroBalance,
+		Previous:       tokenMeta.Header,
+		Link:           b.ToHash(),
+		Representative: tokenMeta.Representative,
+		Timestamp:      time.Now().Unix(),
+	}
+	var w types.Work
+	worker, _ := types.NewWorker(w, send.Root())
+	send.Work = worker.NewWork()
+	send.Signature = account1.Sign(send.GetHash())
+	if r, _ := verifier.BlockCheck(send); r != process.Other {
+		t.Fatal("frozen account should not receive token")
+	}
+
+	// unfreeze
+	blk, err = api.GetUnfreezeBlock(&FreezeParams{Issuer: a, TokenId: ti.TokenId, Account: b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk, account1)
+	if r, err := verifier.BlockCheck(send); r != process.Progress {
+		t.Fatal(r, err)
+	}
+}
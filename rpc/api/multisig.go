@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/vm/contract"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+type MultiSigApi struct {
+	logger *zap.SugaredLogger
+	l      ledger.Store
+	cc     *chainctx.ChainContext
+	create *contract.MultiSigCreate
+}
+
+func NewMultiSigApi(cfgFile string, l ledger.Store) *MultiSigApi {
+	return &MultiSigApi{
+		l:      l,
+		logger: log.NewLogger("api multisig"),
+		cc:     chainctx.NewChainContext(cfgFile),
+		create: &contract.MultiSigCreate{},
+	}
+}
+
+type MultiSigCreateParam struct {
+	Creator   types.Address   `json:"creator"`
+	Keys      []types.Address `json:"keys"`
+	Threshold uint8           `json:"threshold"`
+}
+
+// GetCreateBlock returns the contract send block to register a multisig account of keys and threshold
+func (m *MultiSigApi) GetCreateBlock(param *MultiSigCreateParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+
+	if !m.cc.IsPoVDone() {
+		return nil, chainctx.ErrPoVNotFinish
+	}
+
+	msp := &abi.MultiSigParam{Keys: param.Keys, Threshold: param.Threshold}
+	if err := msp.Verify(); err != nil {
+		return nil, err
+	}
+
+	povHeader, err := m.l.GetLatestPovHeader()
+	if err != nil {
+		return nil, fmt.Errorf("get pov header error: %s", err)
+	}
+
+	ac, err := m.l.GetAccountMeta(param.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	tm := ac.Token(config.ChainToken())
+	if tm == nil {
+		return nil, fmt.Errorf("%s do not have qlc token", param.Creator)
+	}
+
+	data, err := abi.MultiSigABI.PackMethod(abi.MethodNameMultiSigCreate, msp.SortedKeys(), msp.Threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	send := &types.StateBlock{
+		Type:           types.ContractSend,
+		Token:          tm.Type,
+		Address:        param.Creator,
+		Balance:        tm.Balance,
+		Previous:       tm.Header,
+		Vote:           ac.CoinVote,
+		Network:        ac.CoinNetwork,
+		Oracle:         ac.CoinOracle,
+		Storage:        ac.CoinStorage,
+		Link:           types.Hash(contractaddress.MultiSigAddress),
+		Representative: tm.Representative,
+		Data:           data,
+		PoVHeight:      povHeader.GetHeight(),
+		Timestamp:      common.TimeNow().Unix(),
+	}
+
+	vmContext := vmstore.NewVMContext(m.l, &contractaddress.MultiSigAddress)
+	_, _, err = m.create.ProcessSend(vmContext, send)
+	if err != nil {
+		return nil, err
+	}
+
+	h := vmstore.TrieHash(vmContext)
+	if h != nil {
+		send.Extra = *h
+	}
+	return send, nil
+}
+
+// GetAccount returns the multisig account of keys and threshold, it may not be registered yet
+func (m *MultiSigApi) GetAccount(param *abi.MultiSigParam) (types.Address, error) {
+	if param == nil {
+		return types.ZeroAddress, ErrParameterNil
+	}
+	return param.Account()
+}
+
+// GetInfo returns the registered keys and threshold of multisig account
+func (m *MultiSigApi) GetInfo(account types.Address) (*abi.MultiSigInfo, error) {
+	ctx := vmstore.NewVMContext(m.l, &contractaddress.MultiSigAddress)
+	info, err := abi.GetMultiSigInfo(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a multisig account", account)
+	}
+	return info, nil
+}
+
+type MultiSigSignParam struct {
+	Block     *types.StateBlock `json:"block"`
+	Signer    types.Address     `json:"signer"`
+	Signature types.Signature   `json:"signature"`
+}
+
+type MultiSigBlockStatus struct {
+	Block     *types.StateBlock `json:"block"`
+	Hash      types.Hash        `json:"hash"`
+	Signers   []types.Address   `json:"signers"`
+	Threshold uint8             `json:"threshold"`
+	Completed bool              `json:"completed"`
+}
+
+func (m *MultiSigApi) blockInfo(block *types.StateBlock) (*abi.MultiSigInfo, []*types.MultiSigItem, error) {
+	if block == nil {
+		return nil, nil, ErrParameterNil
+	}
+	info, err := m.GetInfo(block.Address)
+	if err != nil {
+		return nil, nil, err
+	}
+	items, err := block.GetMultiSig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return info, items, nil
+}
+
+// AddSignature verifies partial signature of a key and collects it into the block
+func (m *MultiSigApi) AddSignature(param *MultiSigSignParam) (*MultiSigBlockStatus, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+	info, _, err := m.blockInfo(param.Block)
+	if err != nil {
+		return nil, err
+	}
+	if !info.HasKey(param.Signer) {
+		return nil, fmt.Errorf("%s is not a key of multisig account %s", param.Signer, info.Account)
+	}
+	hash := param.Block.GetHash()
+	if !param.Signer.Verify(hash[:], param.Signature[:]) {
+		return nil, fmt.Errorf("invalid signature of %s", param.Signer)
+	}
+	if err := param.Block.AddMultiSig(param.Signer, param.Signature); err != nil {
+		return nil, err
+	}
+	return m.GetStatus(param.Block)
+}
+
+// GetStatus returns the collected signers of the block
+func (m *MultiSigApi) GetStatus(block *types.StateBlock) (*MultiSigBlockStatus, error) {
+	info, items, err := m.blockInfo(block)
+	if err != nil {
+		return nil, err
+	}
+	hash := block.GetHash()
+	signers := make([]types.Address, 0, len(items))
+	for _, item := range items {
+		if info.HasKey(item.Signer) && item.Signer.Verify(hash[:], item.Signature[:]) {
+			signers = append(signers, item.Signer)
+		}
+	}
+	return &MultiSigBlockStatus{
+		Block:     block,
+		Hash:      hash,
+		Signers:   signers,
+		Threshold: info.Threshold,
+		Completed: len(signers) >= int(info.Threshold),
+	}, nil
+}
+
+// Assemble checks the collected signatures reach the threshold, and generates work of the final block
+func (m *MultiSigApi) Assemble(block *types.StateBlock) (*types.StateBlock, error) {
+	info, items, err := m.blockInfo(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no signature collected")
+	}
+
+	// keep signatures in order of keys
+	sorted := make([]*types.MultiSigItem, 0, len(items))
+	for _, k := range info.Keys {
+		for _, item := range items {
+			if item.Signer == k {
+				sorted = append(sorted, item)
+				break
+			}
+		}
+	}
+	if err := info.VerifySignatures(block.GetHash(), sorted); err != nil {
+		return nil, err
+	}
+	block.SetMultiSig(sorted)
+	block.Signature = types.ZeroSignature
+
+	if !block.IsValid() {
+		var w types.Work
+		worker, err := types.NewWorker(w, block.Root())
+		if err != nil {
+			return nil, err
+		}
+		block.Work = worker.NewWork()
+	}
+	return block, nil
+}
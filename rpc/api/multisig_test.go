@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/topic"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestMultiSigApi_GetCreateBlock(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	m := NewMultiSigApi(cfgFile, l)
+	creator := mock.Address()
+	param := &MultiSigCreateParam{
+		Creator:   creator,
+		Keys:      []types.Address{mock.Address(), mock.Address()},
+		Threshold: 2,
+	}
+
+	if _, err := m.GetCreateBlock(nil); err != ErrParameterNil {
+		t.Fatal(err)
+	}
+	if _, err := m.GetCreateBlock(param); err == nil {
+		t.Fatal("pov sync should not be finished")
+	}
+
+	m.cc.Init(nil)
+	m.cc.EventBus().Publish(topic.EventPovSyncState, topic.SyncDone)
+	time.Sleep(time.Second)
+
+	pb, td := mock.GeneratePovBlock(nil, 0)
+	_ = l.AddPovBlock(pb, td)
+	_ = l.SetPovLatestHeight(pb.Header.BasHdr.Height)
+	_ = l.AddPovBestHash(pb.Header.BasHdr.Height, pb.GetHash())
+
+	if _, err := m.GetCreateBlock(param); err == nil {
+		t.Fatal("creator account should not be found")
+	}
+
+	am := mock.AccountMeta(creator)
+	am.Tokens[0].Type = config.ChainToken()
+	_ = l.AddAccountMeta(am, l.Cache().GetCache())
+
+	blk, err := m.GetCreateBlock(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if blk.Type != types.ContractSend || blk.Link != types.Hash(contractaddress.MultiSigAddress) || blk.Extra.IsZero() {
+		t.Fatal(blk)
+	}
+
+	param.Threshold = 3
+	if _, err := m.GetCreateBlock(param); err == nil {
+		t.Fatal("invalid threshold should be rejected")
+	}
+}
+
+func TestMultiSigApi_Assemble(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	m := NewMultiSigApi(cfgFile, l)
+	k1 := mock.Account()
+	k2 := mock.Account()
+	k3 := mock.Account()
+	param := &abi.MultiSigParam{Keys: []types.Address{k1.Address(), k2.Address(), k3.Address()}, Threshold: 2}
+	account, err := m.GetAccount(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blk := mock.StateBlockWithoutWork()
+	blk.Address = account
+	if _, err := m.GetStatus(blk); err == nil {
+		t.Fatal("account should not be registered")
+	}
+
+	// register the multisig account
+	ctx := vmstore.NewVMContext(l, &contractaddress.MultiSigAddress)
+	data, _ := abi.MultiSigABI.PackVariable(abi.VariableNameMultiSigInfo, param.SortedKeys(), param.Threshold, mock.Address())
+	_ = ctx.SetStorage(contractaddress.MultiSigAddress[:], account[:], data)
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := m.GetInfo(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Threshold != 2 || len(info.Keys) != 3 {
+		t.Fatal(info)
+	}
+
+	hash := blk.GetHash()
+	if _, err := m.AddSignature(&MultiSigSignParam{Block: blk, Signer: mock.Address(), Signature: k1.Sign(hash)}); err == nil {
+		t.Fatal("signer should be a key")
+	}
+	if _, err := m.AddSignature(&MultiSigSignParam{Block: blk, Signer: k1.Address(), Signature: k2.Sign(hash)}); err == nil {
+		t.Fatal("invalid signature should be rejected")
+	}
+
+	status, err := m.AddSignature(&MultiSigSignParam{Block: blk, Signer: k3.Address(), Signature: k3.Sign(hash)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Completed || len(status.Signers) != 1 {
+		t.Fatal(status)
+	}
+	if _, err := m.Assemble(blk); err == nil {
+		t.Fatal("signatures less than threshold should be rejected")
+	}
+
+	status, err = m.AddSignature(&MultiSigSignParam{Block: blk, Signer: k1.Address(), Signature: k1.Sign(hash)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.Completed || status.Hash != hash {
+		t.Fatal(status)
+	}
+
+	blk, err = m.Assemble(status.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !blk.Signature.IsZero() || !blk.IsValid() || blk.GetHash() != hash {
+		t.Fatal(blk)
+	}
+	items, _ := blk.GetMultiSig()
+	if err := info.VerifySignatures(hash, items); err != nil {
+		t.Fatal(err)
+	}
+}
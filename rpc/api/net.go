@@ -1,8 +1,11 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/libp2p/go-libp2p-core/peer"
 	"go.uber.org/zap"
 
 	chainctx "github.com/qlcchain/go-qlc/chain/context"
@@ -152,3 +155,59 @@ func (q *NetApi) GetPeerId() string {
 	cfg, _ := q.cc.Config()
 	return cfg.P2P.ID.PeerID
 }
+
+// BannedPeers returns all peers which are banned now
+func (q *NetApi) BannedPeers() ([]*types.PeerBan, error) {
+	now := time.Now().Unix()
+	bans := make([]*types.PeerBan, 0)
+	err := q.ledger.GetPeerBans(func(ban *types.PeerBan) error {
+		if !ban.IsExpired(now) {
+			bans = append(bans, ban)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bans, nil
+}
+
+// BanPeer bans the peer for `duration` seconds, the ban is permanent if duration is 0
+func (q *NetApi) BanPeer(peerID string, duration int64, reason string) error {
+	if _, err := peer.Decode(peerID); err != nil {
+		return fmt.Errorf("invalid peer id: %s", err)
+	}
+	if duration < 0 {
+		return errors.New("invalid ban duration")
+	}
+	if peerID == q.GetPeerId() {
+		return errors.New("can not ban self")
+	}
+
+	now := time.Now().Unix()
+	ban := &types.PeerBan{
+		PeerID:  peerID,
+		Reason:  reason,
+		BanTime: now,
+	}
+	if duration > 0 {
+		ban.ExpireTime = now + duration
+	}
+	if err := q.ledger.AddOrUpdatePeerBan(ban); err != nil {
+		return err
+	}
+	q.eb.Publish(topic.EventPeerBanUpdate, &topic.EventPeerBanUpdateMsg{PeerID: peerID, Ban: ban})
+	return nil
+}
+
+// UnbanPeer removes the ban of the peer
+func (q *NetApi) UnbanPeer(peerID string) error {
+	if _, err := q.ledger.GetPeerBan(peerID); err != nil {
+		return err
+	}
+	if err := q.ledger.DeletePeerBan(peerID); err != nil {
+		return err
+	}
+	q.eb.Publish(topic.EventPeerBanUpdate, &topic.EventPeerBanUpdateMsg{PeerID: peerID})
+	return nil
+}
@@ -261,3 +261,37 @@ func TestNetApi_GetPeerId(t *testing.T) {
 		t.Fatal("get peer id error")
 	}
 }
+
+func TestNetApi_BanPeer(t *testing.T) {
+	teardownTestCase, _, netApi := setupTestCaseNet(t)
+	defer teardownTestCase(t)
+
+	peerID := "QmdFSukPUMF3t1JxjvTo14SEEb5JV9JBT6PukGRo6A2g4f"
+	if err := netApi.BanPeer("invalid", 0, ""); err == nil {
+		t.Fatal("invalid peer id should be rejected")
+	}
+	if err := netApi.BanPeer(peerID, -1, ""); err == nil {
+		t.Fatal("invalid duration should be rejected")
+	}
+	if err := netApi.BanPeer(peerID, 3600, "spam"); err != nil {
+		t.Fatal(err)
+	}
+	bans, err := netApi.BannedPeers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 1 || bans[0].PeerID != peerID || bans[0].Reason != "spam" || bans[0].ExpireTime != bans[0].BanTime+3600 {
+		t.Fatal("banned peers error", bans)
+	}
+
+	if err := netApi.UnbanPeer(peerID); err != nil {
+		t.Fatal(err)
+	}
+	if err := netApi.UnbanPeer(peerID); err != ledger.ErrPeerBanNotFound {
+		t.Fatal(err)
+	}
+	bans, err = netApi.BannedPeers()
+	if err != nil || len(bans) != 0 {
+		t.Fatal(bans, err)
+	}
+}
@@ -69,6 +69,18 @@ type PovApiState struct {
 	ContractState *types.PovContractState `json:"contractState"`
 }
 
+type PovApiStateProof struct {
+	Height        uint64                  `json:"height"`
+	BlockHash     types.Hash              `json:"blockHash"`
+	StateHash     types.Hash              `json:"stateHash"`
+	AccountState  *types.PovAccountState  `json:"accountState"`
+	AccountProof  *trie.Proof             `json:"accountProof"`
+	RepState      *types.PovRepState      `json:"repState"`
+	RepProof      *trie.Proof             `json:"repProof"`
+	ContractState *types.PovContractState `json:"contractState"`
+	ContractProof *trie.Proof             `json:"contractProof"`
+}
+
 type PovApiDumpState struct {
 	StateHash types.Hash                                `json:"stateHash"`
 	Accounts  map[types.Address]*types.PovAccountState  `json:"accounts"`
@@ -492,6 +504,60 @@ func (api *PovApi) GetAccountStateByBlockHeight(address types.Address, height ui
 	return api.GetAccountState(address, header.GetStateHash())
 }
 
+// GetAccountStateProof returns account/rep/contract states of the address with merkle proofs against
+// the state hash of the pov header at height, which can be checked by statedb.VerifyPov*StateProof
+func (api *PovApi) GetAccountStateProof(address types.Address, height uint64) (*PovApiStateProof, error) {
+	header, err := api.l.GetPovHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	apiProof := &PovApiStateProof{
+		Height:    header.GetHeight(),
+		BlockHash: header.GetHash(),
+		StateHash: header.GetStateHash(),
+	}
+	stateExist := false
+
+	gsdb := statedb.NewPovGlobalStateDB(api.l.DBStore(), header.GetStateHash())
+
+	if as, _ := gsdb.GetAccountState(address); as != nil {
+		proof, err := gsdb.GetAccountStateProof(address)
+		if err != nil {
+			return nil, fmt.Errorf("get account state proof: %s", err)
+		}
+		stateExist = true
+		apiProof.AccountState = as
+		apiProof.AccountProof = proof
+	}
+
+	if rs, _ := gsdb.GetRepState(address); rs != nil {
+		proof, err := gsdb.GetRepStateProof(address)
+		if err != nil {
+			return nil, fmt.Errorf("get rep state proof: %s", err)
+		}
+		stateExist = true
+		apiProof.RepState = rs
+		apiProof.RepProof = proof
+	}
+
+	if cs, _ := gsdb.GetContractState(address); cs != nil {
+		proof, err := gsdb.GetContractStateProof(address)
+		if err != nil {
+			return nil, fmt.Errorf("get contract state proof: %s", err)
+		}
+		stateExist = true
+		apiProof.ContractState = cs
+		apiProof.ContractProof = proof
+	}
+
+	if !stateExist {
+		return nil, errors.New("account state value not exist")
+	}
+
+	return apiProof, nil
+}
+
 func (api *PovApi) DumpBlockState(blockHash types.Hash) (*PovApiDumpState, error) {
 	block, err := api.l.GetPovBlockByHash(blockHash)
 	if err != nil {
@@ -1339,6 +1405,29 @@ func (api *PovApi) GetMiningInfo() (*PovApiGetMiningInfo, error) {
 	return apiRsp, nil
 }
 
+// GetStratumInfo returns share statistics of workers connected to stratum server
+func (api *PovApi) GetStratumInfo() ([]*types.PovStratumWorkerStat, error) {
+	if !api.cfg.PoV.PovEnabled {
+		return nil, errors.New("pov service is disabled")
+	}
+
+	inArgs := make(map[interface{}]interface{})
+
+	outArgs := make(map[interface{}]interface{})
+	api.feb.RpcSyncCall(&topic.EventRPCSyncCallMsg{Name: "Miner.GetStratumInfo", In: inArgs, Out: outArgs})
+
+	err, ok := outArgs["err"]
+	if !ok {
+		return nil, errors.New("api not support")
+	}
+	if err != nil {
+		err := outArgs["err"].(error)
+		return nil, err
+	}
+
+	return outArgs["workers"].([]*types.PovStratumWorkerStat), nil
+}
+
 func (api *PovApi) GetWork(minerAddr types.Address, algoName string) (*PovApiGetWork, error) {
 	if !api.cfg.PoV.PovEnabled {
 		return nil, errors.New("pov service is disabled")
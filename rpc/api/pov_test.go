@@ -101,6 +101,13 @@ func setupTestCasePov(t *testing.T) (func(t *testing.T), *mockDataTestPovApi) {
 					outArgs := msg.Out.(map[interface{}]interface{})
 					outArgs["err"] = nil
 
+					msg.ResponseChan <- msg.Out
+					t.Log("febRpcMsgCh", "in", msg.In, "out", msg.Out)
+				} else if msg.Name == "Miner.GetStratumInfo" {
+					outArgs := msg.Out.(map[interface{}]interface{})
+					outArgs["err"] = nil
+					outArgs["workers"] = []*types.PovStratumWorkerStat{{Worker: "miner1", Accepted: 1}}
+
 					msg.ResponseChan <- msg.Out
 					t.Log("febRpcMsgCh", "in", msg.In, "out", msg.Out)
 				} else {
@@ -307,6 +314,14 @@ func TestPovAPI_Mining(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	rspWorkers, err := md.api.GetStratumInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rspWorkers) != 1 || rspWorkers[0].Accepted != 1 {
+		t.Fatalf("failed to GetStratumInfo")
+	}
+
 	mds0 := types.NewPovMinerDayStat()
 	mds0.DayIndex = 1
 	mds0.MinerStats = make(map[string]*types.PovMinerStatItem)
@@ -471,6 +486,17 @@ func TestPovAPI_ManyBlocks(t *testing.T) {
 	_, err = md.api.GetAccountStateByBlockHash(minerAcc.Address(), latestHdr.GetHash())
 	_, err = md.api.GetAccountStateByBlockHeight(minerAcc.Address(), latestHdr.GetHeight())
 
+	sp, err := md.api.GetAccountStateProof(minerAcc.Address(), latestHdr.GetHeight())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := statedb.VerifyPovAccountStateProof(latestHdr.GetStateHash(), minerAcc.Address(), sp.AccountProof); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := statedb.VerifyPovRepStateProof(latestHdr.GetStateHash(), minerAcc.Address(), sp.RepProof); err != nil {
+		t.Fatal(err)
+	}
+
 	_, err = md.api.DumpBlockState(latestHdr.GetHash())
 	_, err = md.api.DumpContractState(latestHdr.GetStateHash(), contractaddress.PubKeyDistributionAddress)
 
@@ -1,3 +1,4 @@
+//go:build testnet
 // +build testnet
 
 /*
@@ -14,10 +15,12 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/qlcchain/go-qlc/cdr"
 	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/types"
@@ -49,6 +52,8 @@ type SettlementAPI struct {
 	updateNextStop    *contract.UpdateNextStop
 	terminateContract *contract.TerminateContract
 	registerAsset     *contract.RegisterAsset
+	commitCDRBatch    *contract.CommitCDRBatch
+	ingester          *cdr.Ingester
 	cc                *context.ChainContext
 }
 
@@ -72,6 +77,8 @@ func NewSettlement(l ledger.Store, cc *context.ChainContext) *SettlementAPI {
 		removeNextStop: &contract.RemoveNextStop{},
 		updateNextStop: &contract.UpdateNextStop{},
 		registerAsset:  &contract.RegisterAsset{},
+		commitCDRBatch: &contract.CommitCDRBatch{},
+		ingester:       cdr.NewIngester(l),
 		cc:             cc,
 	}
 }
@@ -681,6 +688,177 @@ func (s *SettlementAPI) GetTerminateContractBlock(param *TerminateParam) (*types
 	}
 }
 
+// IngestCDRParam ingests CDR records of a party of settlement contract off the ledger, records are in Params or
+// in Data of file Format, csv or json
+type IngestCDRParam struct {
+	ContractAddress types.Address    `json:"contractAddress"`
+	Address         types.Address    `json:"address"`
+	Params          []*cabi.CDRParam `json:"params"`
+	Format          string           `json:"format"`
+	Data            string           `json:"data"`
+}
+
+// IngestCDRs saves CDR records off the ledger until they are sealed into a batch, invalid records are reported
+// in the result and records which are ingested already are skipped
+func (s *SettlementAPI) IngestCDRs(param *IngestCDRParam) (*cdr.IngestResult, error) {
+	if param == nil {
+		return nil, errInvalidParam
+	}
+	params := param.Params
+	if len(param.Data) > 0 {
+		var err error
+		if params, err = cdr.Parse(strings.NewReader(param.Data), param.Format); err != nil {
+			return nil, err
+		}
+	}
+	if len(params) == 0 {
+		return nil, errors.New("empty CDR params")
+	}
+	return s.ingester.Ingest(param.ContractAddress, param.Address, params)
+}
+
+// SealCDRBatch seals pending CDR records of address into the next batch
+func (s *SettlementAPI) SealCDRBatch(contractAddress, addr types.Address) (*cdr.Batch, error) {
+	maxSize := 0
+	if cfg, err := s.cc.Config(); err == nil && cfg.CDRIngest != nil {
+		maxSize = cfg.CDRIngest.MaxBatchSize
+	}
+	return s.ingester.Seal(contractAddress, addr, maxSize)
+}
+
+// CDRBatch is a sealed batch of party, Block is the block hash which commits the batch to contract
+type CDRBatch struct {
+	*cdr.Batch
+	Committed bool       `json:"committed"`
+	Block     types.Hash `json:"block"`
+}
+
+// GetCDRBatches returns sealed batches of address and whether they are committed
+func (s *SettlementAPI) GetCDRBatches(contractAddress, addr types.Address) ([]*CDRBatch, error) {
+	batches, err := s.ingester.Batches(contractAddress, addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx := vmstore.NewVMContext(s.l, &contractaddress.SettlementAddress)
+	result := make([]*CDRBatch, 0, len(batches))
+	for _, b := range batches {
+		batch := &CDRBatch{Batch: b}
+		if committed, err := cabi.GetCDRBatch(ctx, contractAddress, addr, b.Seq); err == nil {
+			batch.Committed = true
+			batch.Block = committed.Block
+		}
+		result = append(result, batch)
+	}
+	return result, nil
+}
+
+// GetCommittedCDRBatches returns batches committed to contract by all parties
+func (s *SettlementAPI) GetCommittedCDRBatches(contractAddress types.Address) ([]*cabi.CDRBatch, error) {
+	return cabi.GetCDRBatches(s.l, &contractAddress)
+}
+
+type CommitCDRBatchParam struct {
+	ContractAddress types.Address `json:"contractAddress"`
+	Address         types.Address `json:"address"`
+	Seq             uint64        `json:"seq"`
+}
+
+// GetCommitCDRBatchBlock
+// generate ContractSend block to commit the merkle root and counters of a sealed batch to settlement contract
+// @param param contract address, party address and seq of batch
+// @return state block(without signature) to be processed
+func (s *SettlementAPI) GetCommitCDRBatchBlock(param *CommitCDRBatchParam) (*types.StateBlock, error) {
+	if !s.cc.IsPoVDone() {
+		return nil, context.ErrPoVNotFinish
+	}
+
+	if param == nil {
+		return nil, errInvalidParam
+	}
+
+	batch, err := s.ingester.Batch(param.ContractAddress, param.Address, param.Seq)
+	if err != nil {
+		return nil, err
+	}
+	batchParam := batch.ToParam(param.ContractAddress)
+	if err := batchParam.Verify(); err != nil {
+		return nil, err
+	}
+
+	ctx := vmstore.NewVMContext(s.l, &contractaddress.SettlementAddress)
+	c, err := cabi.GetSettlementContract(ctx, &param.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !c.IsAvailable() {
+		return nil, fmt.Errorf("contract %s is invalid, please check contract status and start/end date",
+			param.ContractAddress.String())
+	}
+
+	if tm, err := ctx.GetTokenMeta(param.Address, config.GasToken()); err != nil {
+		return nil, err
+	} else {
+		if singedData, err := batchParam.ToABI(); err == nil {
+			povHeader, err := s.l.GetLatestPovHeader()
+			if err != nil {
+				return nil, fmt.Errorf("get pov header error: %s", err)
+			}
+
+			sb := &types.StateBlock{
+				Type:           types.ContractSend,
+				Token:          tm.Type,
+				Address:        param.Address,
+				Balance:        tm.Balance,
+				Vote:           types.ZeroBalance,
+				Network:        types.ZeroBalance,
+				Oracle:         types.ZeroBalance,
+				Storage:        types.ZeroBalance,
+				Previous:       tm.Header,
+				Link:           types.Hash(contractaddress.SettlementAddress),
+				Representative: tm.Representative,
+				Data:           singedData,
+				PoVHeight:      povHeader.GetHeight(),
+				Timestamp:      common.TimeNow().Unix(),
+			}
+
+			if _, _, err := s.commitCDRBatch.ProcessSend(ctx, sb); err != nil {
+				return nil, err
+			}
+
+			h := vmstore.TrieHash(ctx)
+			if h != nil {
+				sb.Extra = *h
+			}
+
+			return sb, nil
+		} else {
+			return nil, err
+		}
+	}
+}
+
+// GetCDRProof returns the merkle proof of CDR record of address, the record is identified by its hash
+func (s *SettlementAPI) GetCDRProof(contractAddress, addr types.Address, hash types.Hash) (*cdr.Proof, error) {
+	return s.ingester.Proof(contractAddress, addr, hash)
+}
+
+// VerifyCDRProof verifies the merkle proof of CDR record, the root of proof should be committed to contract
+func (s *SettlementAPI) VerifyCDRProof(proof *cdr.Proof) (bool, error) {
+	if err := cdr.VerifyProof(proof); err != nil {
+		return false, err
+	}
+	ctx := vmstore.NewVMContext(s.l, &contractaddress.SettlementAddress)
+	batch, err := cabi.GetCDRBatch(ctx, proof.ContractAddress, proof.Party, proof.Seq)
+	if err != nil {
+		return false, fmt.Errorf("batch %d of %s is not committed", proof.Seq, proof.Party.String())
+	}
+	if batch.Root != proof.Root {
+		return false, fmt.Errorf("invalid root of batch %d, exp: %s, act: %s", proof.Seq, batch.Root.String(),
+			proof.Root.String())
+	}
+	return true, nil
+}
+
 type CDRStatus struct {
 	Address *types.Address `json:"contractAddress"`
 	*cabi.CDRStatus
@@ -11,6 +11,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
@@ -125,14 +126,14 @@ func buildContactParam(addr1, addr2 types.Address, name1, name2 string) *CreateC
 			Mcc:         1,
 			Mnc:         2,
 			TotalAmount: 10,
-			UnitPrice:   2,
+			UnitPrice:   types.NewMoney(2, 0, ""),
 			Currency:    "USD",
 		}, {
 			ServiceId:   mock.Hash().String(),
 			Mcc:         22,
 			Mnc:         1,
 			TotalAmount: 30,
-			UnitPrice:   4,
+			UnitPrice:   types.NewMoney(4, 0, ""),
 			Currency:    "USD",
 		}},
 		StartDate: time.Now().AddDate(0, 0, -1).Unix(),
@@ -699,14 +700,14 @@ func TestSettlementAPI_GetTerminateContractBlock(t *testing.T) {
 			Mcc:         1,
 			Mnc:         2,
 			TotalAmount: 10,
-			UnitPrice:   2,
+			UnitPrice:   types.NewMoney(2, 0, ""),
 			Currency:    "USD",
 		}, {
 			ServiceId:   mock.Hash().String(),
 			Mcc:         22,
 			Mnc:         1,
 			TotalAmount: 30,
-			UnitPrice:   4,
+			UnitPrice:   types.NewMoney(4, 0, ""),
 			Currency:    "USD",
 		}},
 		StartDate: time.Now().AddDate(0, 0, -1).Unix(),
@@ -769,14 +770,14 @@ func TestSettlementAPI_GetExpiredContracts(t *testing.T) {
 			Mcc:         1,
 			Mnc:         2,
 			TotalAmount: 10,
-			UnitPrice:   2,
+			UnitPrice:   types.NewMoney(2, 0, ""),
 			Currency:    "USD",
 		}, {
 			ServiceId:   mock.Hash().String(),
 			Mcc:         22,
 			Mnc:         1,
 			TotalAmount: 30,
-			UnitPrice:   4,
+			UnitPrice:   types.NewMoney(4, 0, ""),
 			Currency:    "USD",
 		}},
 		StartDate: time.Now().AddDate(0, 0, -30).Unix(),
@@ -1115,3 +1116,122 @@ func TestSettlementAPI_GetRegisterAssetBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestSettlementAPI_CommitCDRBatch(t *testing.T) {
+	testcase, verifier, api := setupSettlementAPI(t)
+	defer testcase(t)
+
+	pccwAddr := account1.Address()
+	cslAddr := account2.Address()
+
+	param := &CreateContractParam{
+		PartyA: cabi.Contractor{
+			Address: pccwAddr,
+			Name:    "PCCWG",
+		},
+		PartyB: cabi.Contractor{
+			Address: cslAddr,
+			Name:    "HTK-CSL",
+		},
+		Services: []cabi.ContractService{{
+			ServiceId:   mock.Hash().String(),
+			Mcc:         1,
+			Mnc:         2,
+			TotalAmount: 10,
+			UnitPrice:   types.NewMoney(2, 0, ""),
+			Currency:    "USD",
+		}},
+		StartDate: time.Now().AddDate(0, 0, -1).Unix(),
+		EndDate:   time.Now().AddDate(1, 0, 1).Unix(),
+	}
+
+	blk, err := api.GetCreateContractBlock(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk.Signature = account1.Sign(blk.GetHash())
+	if err := verifier.BlockProcess(blk); err != nil {
+		t.Fatal(err)
+	}
+	contracts, err := api.GetContractsAsPartyB(&cslAddr, 1, offset(0))
+	if err != nil || len(contracts) != 1 {
+		t.Fatal("invalid contracts", contracts, err)
+	}
+	contractAddr := contracts[0].Address
+	if blk, err := api.GetSignContractBlock(&SignContractParam{
+		ContractAddress: contractAddr,
+		Address:         cslAddr,
+	}); err != nil {
+		t.Fatal(err)
+	} else {
+		blk.Signature = account2.Sign(blk.GetHash())
+		if err := verifier.BlockProcess(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now().Unix()
+	data := "index,smsDt,sender,destination,sendingStatus,dlrStatus\n"
+	for i := 1; i <= 5; i++ {
+		data += fmt.Sprintf("%d,%d,WeChat,85257***34%d,Sent,Delivered\n", i, now, i)
+	}
+	if result, err := api.IngestCDRs(&IngestCDRParam{
+		ContractAddress: contractAddr,
+		Address:         pccwAddr,
+		Format:          "csv",
+		Data:            data,
+	}); err != nil {
+		t.Fatal(err)
+	} else if result.Accepted != 5 {
+		t.Fatal("invalid result", result)
+	}
+	if _, err := api.IngestCDRs(&IngestCDRParam{ContractAddress: contractAddr, Address: pccwAddr}); err == nil {
+		t.Fatal("empty records should be rejected")
+	}
+
+	batch, err := api.SealCDRBatch(contractAddr, pccwAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.GetCommitCDRBatchBlock(&CommitCDRBatchParam{
+		ContractAddress: contractAddr,
+		Address:         pccwAddr,
+		Seq:             batch.Seq + 1,
+	}); err == nil {
+		t.Fatal("batch is not sealed")
+	}
+	if blk, err := api.GetCommitCDRBatchBlock(&CommitCDRBatchParam{
+		ContractAddress: contractAddr,
+		Address:         pccwAddr,
+		Seq:             batch.Seq,
+	}); err != nil {
+		t.Fatal(err)
+	} else {
+		blk.Signature = account1.Sign(blk.GetHash())
+		if err := verifier.BlockProcess(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if batches, err := api.GetCDRBatches(contractAddr, pccwAddr); err != nil || len(batches) != 1 ||
+		!batches[0].Committed {
+		t.Fatal("batch should be committed", batches, err)
+	}
+	if batches, err := api.GetCommittedCDRBatches(contractAddr); err != nil || len(batches) != 1 ||
+		batches[0].Root != batch.Root {
+		t.Fatal("invalid committed batches", batches, err)
+	}
+
+	h, _ := (&cabi.CDRParam{Index: 3, Sender: "WeChat", Destination: "85257***343"}).ToHash()
+	proof, err := api.GetCDRProof(contractAddr, pccwAddr, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := api.VerifyCDRProof(proof); err != nil || !ok {
+		t.Fatal("proof should be verified", err)
+	}
+	proof.Seq = 2
+	if ok, _ := api.VerifyCDRProof(proof); ok {
+		t.Fatal("batch 2 is not committed")
+	}
+}
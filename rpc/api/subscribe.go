@@ -11,6 +11,7 @@ import (
 	"github.com/qlcchain/go-qlc/common/event"
 	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -222,3 +223,119 @@ func (r *BlockSubscription) notifyAllSubs(block *types.StateBlock) {
 		}
 	}
 }
+
+// VmLogSubscription subscript contract logs from chain, and deliver the matched ones to every subscriber
+type VmLogSubscription struct {
+	mu         *sync.Mutex
+	eb         event.EventBus
+	subscriber *event.ActorSubscriber
+	allSubs    map[string]*VmLogSubscriber
+	logsCh     chan []*ledger.VmLogEntry
+	ctx        context.Context
+	logger     *zap.SugaredLogger
+}
+
+type VmLogSubscriber struct {
+	filter *ledger.VmLogFilter
+	ch     chan *ledger.VmLogEntry
+}
+
+func NewVmLogSubscription(ctx context.Context, eb event.EventBus) *VmLogSubscription {
+	vs := &VmLogSubscription{
+		eb:      eb,
+		mu:      &sync.Mutex{},
+		allSubs: make(map[string]*VmLogSubscriber),
+		logsCh:  make(chan []*ledger.VmLogEntry, MaxNotifyBlocks),
+		ctx:     ctx,
+		logger:  log.NewLogger("api_vmlog_sub"),
+	}
+	vs.subscribeEvent()
+	go vs.notifyLoop()
+	return vs
+}
+
+func (r *VmLogSubscription) subscribeEvent() {
+	r.subscriber = event.NewActorSubscriber(event.Spawn(func(c actor.Context) {
+		switch msg := c.Message().(type) {
+		case []*ledger.VmLogEntry:
+			r.setLogs(msg)
+		}
+	}), r.eb)
+
+	if err := r.subscriber.Subscribe(topic.EventNewVmLogs); err != nil {
+		r.logger.Error(err)
+	}
+}
+
+func (r *VmLogSubscription) setLogs(entries []*ledger.VmLogEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.allSubs) == 0 || len(entries) == 0 {
+		return
+	}
+
+	select {
+	case r.logsCh <- entries:
+	default:
+	}
+}
+
+// AddChan registers a subscriber, logs matching the filter will be sent to the returned channel
+func (r *VmLogSubscription) AddChan(subID string, filter *ledger.VmLogFilter) chan *ledger.VmLogEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if filter == nil {
+		filter = new(ledger.VmLogFilter)
+	}
+	ch := make(chan *ledger.VmLogEntry, MaxNotifyBlocks)
+	r.allSubs[subID] = &VmLogSubscriber{
+		filter: filter,
+		ch:     ch,
+	}
+	return ch
+}
+
+func (r *VmLogSubscription) RemoveChan(subID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.allSubs, subID)
+}
+
+func (r *VmLogSubscription) notifyLoop() {
+	defer func() {
+		if err := r.subscriber.UnsubscribeAll(); err != nil {
+			r.logger.Error(err)
+		}
+	}()
+
+	for {
+		select {
+		case entries := <-r.logsCh:
+			r.notifyAllSubs(entries)
+		case <-r.ctx.Done():
+			r.logger.Info("vm log subscription stopped")
+			return
+		}
+	}
+}
+
+func (r *VmLogSubscription) notifyAllSubs(entries []*ledger.VmLogEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, sub := range r.allSubs {
+		for _, entry := range entries {
+			if !sub.filter.Match(entry) {
+				continue
+			}
+			select {
+			case sub.ch <- entry:
+			default:
+				r.logger.Warnf("subscriber %s is too slow, drop log of block %s", id, entry.BlockHash)
+			}
+		}
+	}
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	chainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/vm/contract"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+type TimeLockApi struct {
+	logger *zap.SugaredLogger
+	l      ledger.Store
+	cc     *chainctx.ChainContext
+	lock   *contract.TimeLock
+}
+
+func NewTimeLockApi(cfgFile string, l ledger.Store) *TimeLockApi {
+	return &TimeLockApi{
+		l:      l,
+		logger: log.NewLogger("api timelock"),
+		cc:     chainctx.NewChainContext(cfgFile),
+		lock:   &contract.TimeLock{},
+	}
+}
+
+type TimeLockParam struct {
+	Sender   types.Address `json:"sender"`
+	Receiver types.Address `json:"receiver"`
+	Token    types.Hash    `json:"token"`
+	Amount   types.Balance `json:"amount"`
+	// pov height and unix time to release, at least one should be set
+	ReleaseHeight uint64 `json:"releaseHeight"`
+	ReleaseTime   int64  `json:"releaseTime"`
+	// optional, sender can refund after refund time if the lock is not released
+	RefundTime int64 `json:"refundTime"`
+	// optional, sender can cancel before cancel time
+	CancelTime int64 `json:"cancelTime"`
+}
+
+type TimeLockSettleParam struct {
+	Address types.Address `json:"address"`
+	LockId  types.Hash    `json:"lockId"`
+}
+
+type TimeLockInfo struct {
+	*abi.TimeLockInfo
+	StatusName string `json:"statusName"`
+}
+
+// GetLockBlock returns the block of sender to lock amount of token in contract until release
+func (t *TimeLockApi) GetLockBlock(param *TimeLockParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+	if !t.cc.IsPoVDone() {
+		return nil, chainctx.ErrPoVNotFinish
+	}
+
+	lp := &abi.TimeLockParam{
+		Receiver:      param.Receiver,
+		ReleaseHeight: param.ReleaseHeight,
+		ReleaseTime:   param.ReleaseTime,
+		RefundTime:    param.RefundTime,
+		CancelTime:    param.CancelTime,
+	}
+	if err := lp.Verify(); err != nil {
+		return nil, err
+	}
+	if param.Amount.Int == nil || param.Amount.Compare(types.ZeroBalance) != types.BalanceCompBigger {
+		return nil, errors.New("invalid lock amount")
+	}
+
+	data, err := abi.TimeLockABI.PackMethod(abi.MethodNameTimeLock, lp.Receiver, lp.ReleaseHeight, lp.ReleaseTime,
+		lp.RefundTime, lp.CancelTime)
+	if err != nil {
+		return nil, err
+	}
+
+	send, err := t.sendBlock(param.Sender, param.Token, param.Amount, data)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContext := vmstore.NewVMContext(t.l, &contractaddress.TimeLockAddress)
+	if _, _, err := t.lock.ProcessSend(vmContext, send); err != nil {
+		return nil, err
+	}
+	if h := vmstore.TrieHash(vmContext); h != nil {
+		send.Extra = *h
+	}
+	return send, nil
+}
+
+// GetReleaseBlock returns the block of receiver to release the lock after its release height and time
+func (t *TimeLockApi) GetReleaseBlock(param *TimeLockSettleParam) (*types.StateBlock, error) {
+	return t.settleBlock(abi.MethodNameTimeLockRelease, param)
+}
+
+// GetRefundBlock returns the block of sender to take back the lock after its refund time
+func (t *TimeLockApi) GetRefundBlock(param *TimeLockSettleParam) (*types.StateBlock, error) {
+	return t.settleBlock(abi.MethodNameTimeLockRefund, param)
+}
+
+// GetCancelBlock returns the block of sender to cancel the lock before its cancel time
+func (t *TimeLockApi) GetCancelBlock(param *TimeLockSettleParam) (*types.StateBlock, error) {
+	return t.settleBlock(abi.MethodNameTimeLockCancel, param)
+}
+
+func (t *TimeLockApi) settleBlock(method string, param *TimeLockSettleParam) (*types.StateBlock, error) {
+	if param == nil {
+		return nil, ErrParameterNil
+	}
+	if !t.cc.IsPoVDone() {
+		return nil, chainctx.ErrPoVNotFinish
+	}
+
+	data, err := abi.TimeLockABI.PackMethod(method, param.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the settle block carries no amount, it is on chain token if the account has, otherwise on the locked token
+	token := config.ChainToken()
+	if am, err := t.l.GetAccountMeta(param.Address); err == nil && am.Token(token) == nil {
+		ctx := vmstore.NewVMContext(t.l, &contractaddress.TimeLockAddress)
+		if lock, err := abi.GetTimeLockInfo(ctx, param.LockId); err == nil {
+			token = lock.Token
+		}
+	}
+
+	send, err := t.sendBlock(param.Address, token, types.ZeroBalance, data)
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok, err := contract.GetChainContract(contractaddress.TimeLockAddress, data)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("can not find time lock contract: %v", err)
+	}
+	vmContext := vmstore.NewVMContext(t.l, &contractaddress.TimeLockAddress)
+	if _, _, err := c.ProcessSend(vmContext, send); err != nil {
+		return nil, err
+	}
+	if h := vmstore.TrieHash(vmContext); h != nil {
+		send.Extra = *h
+	}
+	return send, nil
+}
+
+// sendBlock returns the contract send block of address on token chain
+func (t *TimeLockApi) sendBlock(address types.Address, token types.Hash, amount types.Balance, data []byte) (*types.StateBlock, error) {
+	am, err := t.l.GetAccountMeta(address)
+	if err != nil {
+		return nil, err
+	}
+	tm := am.Token(token)
+	if tm == nil {
+		return nil, fmt.Errorf("%s do not have token %s", address, token)
+	}
+	if tm.Balance.Compare(amount) == types.BalanceCompSmaller {
+		return nil, fmt.Errorf("%s have no enough balance %s, expect %s", address, tm.Balance, amount)
+	}
+	povHeader, err := t.l.GetLatestPovHeader()
+	if err != nil {
+		return nil, fmt.Errorf("get pov header error: %s", err)
+	}
+
+	send := &types.StateBlock{
+		Type:           types.ContractSend,
+		Token:          tm.Type,
+		Address:        address,
+		Balance:        tm.Balance.Sub(amount),
+		Vote:           types.ZeroBalance,
+		Network:        types.ZeroBalance,
+		Storage:        types.ZeroBalance,
+		Oracle:         types.ZeroBalance,
+		Previous:       tm.Header,
+		Link:           types.Hash(contractaddress.TimeLockAddress),
+		Representative: tm.Representative,
+		Data:           data,
+		PoVHeight:      povHeader.GetHeight(),
+		Timestamp:      common.TimeNow().Unix(),
+	}
+	if token == config.ChainToken() {
+		send.Vote = am.CoinVote
+		send.Network = am.CoinNetwork
+		send.Storage = am.CoinStorage
+		send.Oracle = am.CoinOracle
+	}
+	return send, nil
+}
+
+// GetRewardBlock returns the block to receive the funds of a release, refund or cancel block
+func (t *TimeLockApi) GetRewardBlock(input *types.StateBlock) (*types.StateBlock, error) {
+	if input == nil {
+		return nil, ErrParameterNil
+	}
+	if !t.cc.IsPoVDone() {
+		return nil, chainctx.ErrPoVNotFinish
+	}
+
+	c, ok, err := contract.GetChainContract(contractaddress.TimeLockAddress, input.GetPayload())
+	if !ok || err != nil {
+		return nil, fmt.Errorf("can not find time lock contract: %v", err)
+	}
+	reward := &types.StateBlock{}
+	vmContext := vmstore.NewVMContext(t.l, &contractaddress.TimeLockAddress)
+	blocks, err := c.DoReceive(vmContext, reward, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, errors.New("can not generate time lock reward block")
+	}
+
+	povHeader, err := t.l.GetLatestPovHeader()
+	if err != nil {
+		return nil, fmt.Errorf("get pov header error: %s", err)
+	}
+	reward.PoVHeight = povHeader.GetHeight()
+	reward.Timestamp = common.TimeNow().Unix()
+	if h := vmstore.TrieHash(blocks[0].VMContext); h != nil {
+		reward.Extra = *h
+	}
+	return reward, nil
+}
+
+// GetLockInfo returns the lock and its status
+func (t *TimeLockApi) GetLockInfo(lockId types.Hash) (*TimeLockInfo, error) {
+	ctx := vmstore.NewVMContext(t.l, &contractaddress.TimeLockAddress)
+	lock, err := abi.GetTimeLockInfo(ctx, lockId)
+	if err != nil {
+		return nil, fmt.Errorf("can not find time lock %s", lockId)
+	}
+	return &TimeLockInfo{TimeLockInfo: lock, StatusName: lock.Status.String()}, nil
+}
+
+// ListLocksByAddress returns all locks sent or received by address
+func (t *TimeLockApi) ListLocksByAddress(address types.Address) ([]*TimeLockInfo, error) {
+	ctx := vmstore.NewVMContext(t.l, &contractaddress.TimeLockAddress)
+	locks, err := abi.ListTimeLocksByAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*TimeLockInfo, 0, len(locks))
+	for _, lock := range locks {
+		infos = append(infos, &TimeLockInfo{TimeLockInfo: lock, StatusName: lock.Status.String()})
+	}
+	return infos, nil
+}
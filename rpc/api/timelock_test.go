@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	qlcchainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/ledger/process"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+func setupTimeLockAPI(t *testing.T) (func(t *testing.T), *process.LedgerVerifier, *TimeLockApi) {
+	dir := filepath.Join(config.QlcTestDataDir(), "api", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	cc := qlcchainctx.NewChainContext(cm.ConfigFile)
+	l := ledger.NewLedger(cm.ConfigFile)
+
+	verifier := process.NewLedgerVerifier(l)
+	setPovStatus(l, cc, t)
+	setLedgerStatus(l, t)
+
+	api := NewTimeLockApi(cc.ConfigFile(), l)
+
+	var blocks []*types.StateBlock
+	if err := json.Unmarshal([]byte(mock.MockBlocks), &blocks); err != nil {
+		t.Fatal(err)
+	}
+	for i := range blocks {
+		if err := verifier.BlockProcess(blocks[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return func(t *testing.T) {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+		_ = cc.Stop()
+	}, verifier, api
+}
+
+func TestTimeLockApi(t *testing.T) {
+	teardownTestCase, verifier, api := setupTimeLockAPI(t)
+	defer teardownTestCase(t)
+
+	sender := account1.Address()
+	receiver := mock.Address()
+	povHeader, err := api.l.GetLatestPovHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	processBlock := func(blk *types.StateBlock) {
+		blk.Signature = account1.Sign(blk.GetHash())
+		if err := verifier.BlockProcess(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lock := func(param *TimeLockParam) types.Hash {
+		blk, err := api.GetLockBlock(param)
+		if err != nil {
+			t.Fatal(err)
+		}
+		processBlock(blk)
+		return blk.Previous
+	}
+	amount := types.Balance{Int: big.NewInt(1000)}
+
+	if _, err := api.GetLockBlock(&TimeLockParam{Sender: sender, Receiver: receiver, Token: config.ChainToken(),
+		Amount: amount}); err == nil {
+		t.Fatal("release condition should be set")
+	}
+
+	// released by height, refundable at once
+	id1 := lock(&TimeLockParam{Sender: sender, Receiver: receiver, Token: config.ChainToken(), Amount: amount,
+		ReleaseHeight: povHeader.GetHeight(), RefundTime: 1})
+	// released in future, cancelable before release
+	releaseTime := int64(povHeader.GetTimestamp()) + 3600
+	id2 := lock(&TimeLockParam{Sender: sender, Receiver: sender, Token: config.ChainToken(), Amount: amount,
+		ReleaseTime: releaseTime, CancelTime: releaseTime})
+
+	if locks, err := api.ListLocksByAddress(sender); err != nil || len(locks) != 2 {
+		t.Fatal(locks, err)
+	}
+	if locks, err := api.ListLocksByAddress(receiver); err != nil || len(locks) != 1 || locks[0].LockId != id1 {
+		t.Fatal(locks, err)
+	}
+
+	// receiver has no account to send release block
+	if _, err := api.GetReleaseBlock(&TimeLockSettleParam{Address: receiver, LockId: id1}); err == nil {
+		t.Fatal("receiver without account should not release")
+	}
+	if _, err := api.GetReleaseBlock(&TimeLockSettleParam{Address: sender, LockId: id2}); err == nil {
+		t.Fatal("lock should not be released before release time")
+	}
+	if _, err := api.GetCancelBlock(&TimeLockSettleParam{Address: sender, LockId: id1}); err == nil {
+		t.Fatal("lock without cancel time should not be canceled")
+	}
+
+	// refund
+	blk, err := api.GetRefundBlock(&TimeLockSettleParam{Address: sender, LockId: id1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk)
+	reward, err := api.GetRewardBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(reward)
+	if info, err := api.GetLockInfo(id1); err != nil || info.Status != abi.TimeLockStatusRefunded {
+		t.Fatal(info, err)
+	}
+	if _, err := api.GetReleaseBlock(&TimeLockSettleParam{Address: receiver, LockId: id1}); err == nil {
+		t.Fatal("refunded lock should not be released")
+	}
+
+	// cancel
+	blk, err = api.GetCancelBlock(&TimeLockSettleParam{Address: sender, LockId: id2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk)
+	reward, err = api.GetRewardBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(reward)
+	if info, err := api.GetLockInfo(id2); err != nil || info.Status != abi.TimeLockStatusCanceled || info.StatusName != "canceled" {
+		t.Fatal(info, err)
+	}
+	if reward, err = api.GetRewardBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	var w types.Work
+	worker, _ := types.NewWorker(w, reward.Root())
+	reward.Work = worker.NewWork()
+	reward.Signature = account1.Sign(reward.GetHash())
+	if r, _ := verifier.BlockCheck(reward); r != process.UnReceivable {
+		t.Fatal("canceled lock should be received only once", r)
+	}
+
+	// release
+	id3 := lock(&TimeLockParam{Sender: sender, Receiver: sender, Token: config.ChainToken(), Amount: amount,
+		ReleaseHeight: povHeader.GetHeight()})
+	blk, err = api.GetReleaseBlock(&TimeLockSettleParam{Address: sender, LockId: id3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(blk)
+	reward, err = api.GetRewardBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processBlock(reward)
+	if info, err := api.GetLockInfo(id3); err != nil || info.Status != abi.TimeLockStatusReleased {
+		t.Fatal(info, err)
+	}
+	if _, err := api.GetLockInfo(mock.Hash()); err == nil {
+		t.Fatal("lock should not be found")
+	}
+}
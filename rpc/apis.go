@@ -150,6 +150,27 @@ func (r *RPC) getApi(apiModule string) rpc.API {
 			Service:   api.NewPtmKeyApi(r.cfgFile, r.ledger),
 			Public:    true,
 		}
+	case "multisig":
+		return rpc.API{
+			Namespace: "multisig",
+			Version:   "1.0",
+			Service:   api.NewMultiSigApi(r.cfgFile, r.ledger),
+			Public:    true,
+		}
+	case "timelock":
+		return rpc.API{
+			Namespace: "timelock",
+			Version:   "1.0",
+			Service:   api.NewTimeLockApi(r.cfgFile, r.ledger),
+			Public:    true,
+		}
+	case "dpos":
+		return rpc.API{
+			Namespace: "dpos",
+			Version:   "1.0",
+			Service:   api.NewDPoSApi(r.ledger),
+			Public:    true,
+		}
 	default:
 		return rpc.API{}
 	}
@@ -3,7 +3,6 @@ package apis
 import (
 	"context"
 
-	"github.com/golang/protobuf/ptypes/empty"
 	"go.uber.org/zap"
 
 	"github.com/qlcchain/go-qlc/log"
@@ -45,17 +44,20 @@ func (a *AccountApi) ForPublicKey(ctx context.Context, str *pb.String) (*pbtypes
 	return toAddress(r), nil
 }
 
-func (a *AccountApi) NewSeed(context.Context, *empty.Empty) (*pb.String, error) {
-	r, err := a.account.NewSeed()
+func (a *AccountApi) NewSeed(ctx context.Context, param *pb.NewSeedRequest) (*pb.String, error) {
+	r, err := a.account.NewSeed(toStringPoint(param.GetMnemonic()), toStringPoint(param.GetPassphrase()))
 	if err != nil {
 		return nil, err
 	}
 	return toString(r), nil
 }
 
-func (a *AccountApi) NewAccounts(ctx context.Context, count *pb.UInt32) (*pb.AccountsResponse, error) {
-	c := toUInt32PointByProto(count)
-	r, err := a.account.NewAccounts(c)
+func (a *AccountApi) NewAccounts(ctx context.Context, param *pb.NewAccountsRequest) (*pb.AccountsResponse, error) {
+	var c *uint32
+	if count := param.GetCount(); count > 0 {
+		c = &count
+	}
+	r, err := a.account.NewAccounts(c, toStringPoint(param.GetMnemonic()), toStringPoint(param.GetPassphrase()))
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +87,9 @@ func toAccounts(accs []*api.Accounts) *pb.AccountsResponse {
 			PrivateKey: a.PrivateKey,
 			PublicKey:  a.PublicKey,
 			Address:    a.Address,
+			Mnemonic:   a.Mnemonic,
+			Path:       a.Path,
+			Bip39Seed:  a.Bip39Seed,
 		}
 		as = append(as, at)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/mock"
 	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
 	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
@@ -70,6 +71,31 @@ func TestAccountApi_NewAccounts(t *testing.T) {
 	}
 }
 
+func TestAccountApi_Mnemonic(t *testing.T) {
+	api := newTestAccountApi()
+	m := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	s, err := api.NewSeed(context.Background(), &pb.NewSeedRequest{Mnemonic: m, Passphrase: "TREZOR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetValue() != "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04" {
+		t.Fatal(s.GetValue())
+	}
+
+	r, err := api.NewAccounts(context.Background(), &pb.NewAccountsRequest{Count: 3, Mnemonic: m, Passphrase: "TREZOR"})
+	if err != nil || len(r.GetAccounts()) != 3 {
+		t.Fatal(err, len(r.GetAccounts()))
+	}
+	acc := r.GetAccounts()[2]
+	if acc.GetPath() != types.HDPath(2) || acc.GetBip39Seed() != s.GetValue() || acc.GetSeed() != "" || acc.GetMnemonic() != m {
+		t.Fatal(acc)
+	}
+	c, err := api.Create(context.Background(), &pb.CreateRequest{SeedStr: s.GetValue(), Index: 2})
+	if err != nil || c.GetValue()["privKey"] != acc.GetPrivateKey() {
+		t.Fatal(c, err)
+	}
+}
+
 func TestAccountApi_Validate(t *testing.T) {
 	api := newTestAccountApi()
 	addr := "qlc_17mppebrj5kocjtr9i1wfxa1ooc9xqtgyqbemgr8wsjxck1xmcppp9abciaf"
@@ -62,6 +62,8 @@ func toStateBlock(blk *types.StateBlock) *pbtypes.StateBlock {
 		PrivateGroupID: blk.PrivateGroupID,
 		Work:           toWorkValue(blk.GetWork()),
 		Signature:      toSignatureValue(blk.GetSignature()),
+		Fee:            toBalanceValue(blk.GetFee()),
+		MultiSig:       blk.MultiSig,
 		//Flag:           blk.Flag,
 		//PrivateRecvRsp: blk.PrivateRecvRsp,
 		//PrivatePayload: blk.PrivatePayload,
@@ -109,6 +111,11 @@ func toOriginStateBlock(blk *pbtypes.StateBlock) (*types.StateBlock, error) {
 	if err != nil {
 		return nil, err
 	}
+	var fee *types.Balance
+	if blk.GetFee() > 0 {
+		b := toOriginBalanceByValue(blk.GetFee())
+		fee = &b
+	}
 	return &types.StateBlock{
 		Type:           toOriginBlockValue(blk.GetType()),
 		Token:          token,
@@ -133,6 +140,8 @@ func toOriginStateBlock(blk *pbtypes.StateBlock) (*types.StateBlock, error) {
 		PrivateGroupID: blk.GetPrivateGroupID(),
 		Work:           toOriginWorkByValue(blk.GetWork()),
 		Signature:      sign,
+		Fee:            fee,
+		MultiSig:       blk.GetMultiSig(),
 		//Flag:           blk.GetFlag(),
 		//PrivateRecvRsp: blk.GetPrivateRecvRsp(),
 		//PrivatePayload: blk.GetPrivatePayload(),
@@ -329,6 +338,19 @@ func toOriginBalanceByValue(b int64) types.Balance {
 	return types.Balance{Int: big.NewInt(b)}
 }
 
+// money, exact decimal amount like "12.30", its currency is carried by the currency field of message
+
+func toMoneyValue(m types.Money) string {
+	return m.Decimal()
+}
+
+func toOriginMoneyByValue(m string) (types.Money, error) {
+	if m == "" {
+		return types.ZeroMoney(""), nil
+	}
+	return types.ParseMoney(m)
+}
+
 // signature
 
 func toSignatureValue(b types.Signature) string {
@@ -1 +1,41 @@
 package apis
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestToOriginStateBlock(t *testing.T) {
+	blk := mock.StateBlockWithoutWork()
+	blk.Balance = types.NewBalance(1000)
+	blk.Vote = types.NewBalance(0)
+	blk.Network = types.NewBalance(0)
+	blk.Storage = types.NewBalance(0)
+	blk.Oracle = types.NewBalance(0)
+	fee := types.NewBalance(100)
+	blk.Fee = &fee
+
+	r, err := toOriginStateBlock(toStateBlock(blk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.GetFee().Equal(fee) {
+		t.Fatal("invalid fee", r.GetFee())
+	}
+	if r.GetHash() != blk.GetHash() {
+		t.Fatal("hash should not be changed", r.GetHash(), blk.GetHash())
+	}
+
+	blk.Fee = nil
+	if r, err := toOriginStateBlock(toStateBlock(blk)); err != nil || r.Fee != nil || r.GetHash() != blk.GetHash() {
+		t.Fatal("block without fee should keep its hash", err)
+	}
+
+	blk.MultiSig = []byte{1, 2, 3}
+	if r, err := toOriginStateBlock(toStateBlock(blk)); err != nil || !bytes.Equal(r.MultiSig, blk.MultiSig) {
+		t.Fatal("multisig should be kept", err)
+	}
+}
@@ -0,0 +1,75 @@
+package apis
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+)
+
+type ConfigAPI struct {
+	config *api.ConfigApi
+	logger *zap.SugaredLogger
+}
+
+func NewConfigAPI(cfgFile string) *ConfigAPI {
+	return &ConfigAPI{
+		config: api.NewConfigApi(cfgFile),
+		logger: log.NewLogger("grpc_config"),
+	}
+}
+
+func (c *ConfigAPI) CurrentConfig(ctx context.Context, param *pb.ConfigTokenRequest) (*pb.ConfigResponse, error) {
+	r, err := c.config.CurrentConfig(param.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return toConfigResponse(r)
+}
+
+func (c *ConfigAPI) Update(ctx context.Context, param *pb.ConfigUpdateRequest) (*pb.ConfigResponse, error) {
+	r, err := c.config.Update(param.GetParams(), param.GetToken(), param.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toConfigResponse(r)
+}
+
+func (c *ConfigAPI) Difference(ctx context.Context, param *pb.ConfigRequest) (*pb.String, error) {
+	r, err := c.config.Difference(param.GetToken(), param.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toString(r), nil
+}
+
+func (c *ConfigAPI) Commit(ctx context.Context, param *pb.ConfigRequest) (*pb.Boolean, error) {
+	r, err := c.config.Commit(param.GetToken(), param.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toBoolean(r), nil
+}
+
+func (c *ConfigAPI) Save(ctx context.Context, param *pb.ConfigRequest) (*pb.Boolean, error) {
+	r, err := c.config.Save(param.GetToken(), param.GetMark())
+	if err != nil {
+		return nil, err
+	}
+	return toBoolean(r), nil
+}
+
+func toConfigResponse(cfg *config.Config) (*pb.ConfigResponse, error) {
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfigResponse{
+		Config: string(bs),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package apis
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/qlcchain/go-qlc/config"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+)
+
+func TestConfigAPI(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "config", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	cm := config.NewCfgManager(dir)
+	cfg, err := cm.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := cfg.Manager.AdminToken
+	c := NewConfigAPI(cm.ConfigFile)
+
+	if _, err := c.CurrentConfig(context.Background(), &pb.ConfigTokenRequest{Token: "invalid"}); err == nil {
+		t.Fatal("invalid token should be rejected")
+	}
+	r, err := c.CurrentConfig(context.Background(), &pb.ConfigTokenRequest{Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := new(config.Config)
+	if err := json.Unmarshal([]byte(r.GetConfig()), current); err != nil {
+		t.Fatal(err)
+	}
+	if current.Manager.AdminToken != token {
+		t.Fatal("invalid config")
+	}
+
+	mark := "abc"
+	r, err = c.Update(context.Background(), &pb.ConfigUpdateRequest{
+		Params: []string{"pov.povEnabled=false"},
+		Token:  token,
+		Mark:   mark,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := new(config.Config)
+	if err := json.Unmarshal([]byte(r.GetConfig()), updated); err != nil {
+		t.Fatal(err)
+	}
+	if updated.PoV.PovEnabled {
+		t.Fatal("config should be updated")
+	}
+
+	if diff, err := c.Difference(context.Background(), &pb.ConfigRequest{Token: token, Mark: mark}); err != nil || diff.GetValue() == "" {
+		t.Fatal(err, diff)
+	}
+	if b, err := c.Commit(context.Background(), &pb.ConfigRequest{Token: token, Mark: mark}); err != nil || !b.GetValue() {
+		t.Fatal(err)
+	}
+	if _, err := c.Save(context.Background(), &pb.ConfigRequest{Token: token, Mark: "abcd"}); err == nil {
+		t.Fatal("invalid mark should be rejected")
+	}
+}
@@ -0,0 +1,411 @@
+package apis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/event"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/consensus/dpos"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+)
+
+type DebugAPI struct {
+	debug  *api.DebugApi
+	logger *zap.SugaredLogger
+}
+
+func NewDebugAPI(cfgFile string, eb event.EventBus) *DebugAPI {
+	return &DebugAPI{
+		debug:  api.NewDebugApi(cfgFile, eb),
+		logger: log.NewLogger("grpc_debug"),
+	}
+}
+
+func (d *DebugAPI) BlockCacheCount(context.Context, *empty.Empty) (*pb.DebugCount, error) {
+	r, err := d.debug.BlockCacheCount()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DebugCount{
+		Count: r,
+	}, nil
+}
+
+func (d *DebugAPI) BlockCaches(context.Context, *empty.Empty) (*pbtypes.Hashes, error) {
+	r, err := d.debug.BlockCaches()
+	if err != nil {
+		return nil, err
+	}
+	return toHashes(r), nil
+}
+
+func (d *DebugAPI) Action(ctx context.Context, param *pb.ActionRequest) (*pb.String, error) {
+	r, err := d.debug.Action(storage.ActionType(param.GetActionType()), int(param.GetT()))
+	if err != nil {
+		return nil, err
+	}
+	return toString(r), nil
+}
+
+func (d *DebugAPI) BlockLink(ctx context.Context, param *pbtypes.Hash) (*pb.BlockLinkRsp, error) {
+	hash, err := toOriginHash(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.BlockLink(hash)
+	if err != nil {
+		return nil, err
+	}
+	links := make(map[string]string)
+	for k, v := range r {
+		links[k] = toHashValue(v)
+	}
+	return &pb.BlockLinkRsp{
+		Links: links,
+	}, nil
+}
+
+func (d *DebugAPI) BlockLinks(ctx context.Context, param *pbtypes.Hash) (*pb.BlockLinksRsp, error) {
+	hash, err := toOriginHash(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.BlockLinks(hash)
+	if err != nil {
+		return nil, err
+	}
+	links := make(map[string]*pbtypes.Hashes)
+	for k, v := range r {
+		links[k] = toHashes(v)
+	}
+	return &pb.BlockLinksRsp{
+		Links: links,
+	}, nil
+}
+
+func (d *DebugAPI) BlocksCountByType(ctx context.Context, param *pb.String) (*pb.BlocksCountByTypeRsp, error) {
+	r, err := d.debug.BlocksCountByType(toOriginString(param))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BlocksCountByTypeRsp{
+		Count: r,
+	}, nil
+}
+
+func (d *DebugAPI) GetSyncBlockNum(context.Context, *empty.Empty) (*pb.DebugCount, error) {
+	r, err := d.debug.GetSyncBlockNum()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DebugCount{
+		Count: r,
+	}, nil
+}
+
+func (d *DebugAPI) Representative(ctx context.Context, param *pbtypes.Address) (*pb.APIRepresentative, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.Representative(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.APIRepresentative{
+		Address: toAddressValue(r.Address),
+		Balance: toBalanceValue(r.Balance),
+		Vote:    toBalanceValue(r.Vote),
+		Network: toBalanceValue(r.Network),
+		Storage: toBalanceValue(r.Storage),
+		Oracle:  toBalanceValue(r.Oracle),
+		Total:   toBalanceValue(r.Total),
+	}, nil
+}
+
+func (d *DebugAPI) AccountPending(ctx context.Context, param *pbtypes.PendingKey) (*pb.APIPendingInfo, error) {
+	addr, err := toOriginAddressByValue(param.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	hash, err := toOriginHashByValue(param.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.AccountPending(addr, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.APIPendingInfo{
+		Address:   toAddressValue(r.Address),
+		Hash:      toHashValue(r.Hash),
+		Source:    toAddressValue(r.Source),
+		Amount:    toBalanceValue(r.Amount),
+		Type:      toHashValue(r.Type),
+		TokenName: r.TokenName,
+		Timestamp: r.Timestamp,
+		Used:      r.Used,
+	}, nil
+}
+
+func (d *DebugAPI) PendingsAmount(context.Context, *empty.Empty) (*pb.PendingsAmountRsp, error) {
+	r, err := d.debug.PendingsAmount()
+	if err != nil {
+		return nil, err
+	}
+	pendings := make(map[string]*pb.TokenPendingsAmount)
+	for addr, tokens := range r {
+		amounts := make(map[string]int64)
+		for token, amount := range tokens {
+			amounts[token] = toBalanceValue(amount)
+		}
+		pendings[toAddressValue(addr)] = &pb.TokenPendingsAmount{
+			Amounts: amounts,
+		}
+	}
+	return &pb.PendingsAmountRsp{
+		Pendings: pendings,
+	}, nil
+}
+
+func (d *DebugAPI) PendingsCount(context.Context, *empty.Empty) (*pb.Int64, error) {
+	r, err := d.debug.PendingsCount()
+	if err != nil {
+		return nil, err
+	}
+	return toInt64(int64(r)), nil
+}
+
+func (d *DebugAPI) GetOnlineInfo(context.Context, *empty.Empty) (*pb.RepOnlinePeriods, error) {
+	r, err := d.debug.GetOnlineInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toRepOnlinePeriods(r), nil
+}
+
+func (d *DebugAPI) GetPovInfo(context.Context, *empty.Empty) (*pb.DebugInfo, error) {
+	r, err := d.debug.GetPovInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toDebugInfo(r)
+}
+
+func (d *DebugAPI) NewBlock(param *empty.Empty, srv pb.DebugAPI_NewBlockServer) error {
+	t := time.NewTicker(30 * time.Second)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			if err := srv.Send(toStateBlock(mock.StateBlock())); err != nil {
+				d.logger.Errorf("notify error: %s", err)
+				return err
+			}
+		case <-srv.Context().Done():
+			d.logger.Info("subscription block finished")
+			return nil
+		}
+	}
+}
+
+func (d *DebugAPI) ContractCount(context.Context, *empty.Empty) (*pb.BlocksCountByTypeRsp, error) {
+	r, err := d.debug.ContractCount()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BlocksCountByTypeRsp{
+		Count: r,
+	}, nil
+}
+
+func (d *DebugAPI) GetConsInfo(context.Context, *empty.Empty) (*pb.DebugInfo, error) {
+	r, err := d.debug.GetConsInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toDebugInfo(r)
+}
+
+func (d *DebugAPI) SetConsPerf(ctx context.Context, param *pb.Int32) (*pb.DebugInfo, error) {
+	r, err := d.debug.SetConsPerf(int(param.GetValue()))
+	if err != nil {
+		return nil, err
+	}
+	return toDebugInfo(r)
+}
+
+func (d *DebugAPI) GetConsPerf(context.Context, *empty.Empty) (*pb.DebugInfo, error) {
+	r, err := d.debug.GetConsPerf()
+	if err != nil {
+		return nil, err
+	}
+	return toDebugInfo(r)
+}
+
+func (d *DebugAPI) GetCache(context.Context, *empty.Empty) (*empty.Empty, error) {
+	if err := d.debug.GetCache(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (d *DebugAPI) GetCacheStat(context.Context, *empty.Empty) (*pb.CacheStats, error) {
+	r := d.debug.GetCacheStat()
+	stats := make([]*pb.CacheStat, 0)
+	for _, c := range r {
+		stats = append(stats, &pb.CacheStat{
+			Index: int32(c.Index),
+			Key:   int32(c.Key),
+			Block: int32(c.Block),
+			Start: c.Start,
+			Span:  c.Span,
+		})
+	}
+	return &pb.CacheStats{
+		Stats: stats,
+	}, nil
+}
+
+func (d *DebugAPI) GetCacheStatus(context.Context, *empty.Empty) (*pb.CacheStatus, error) {
+	return &pb.CacheStatus{
+		Status: d.debug.GetCacheStatus(),
+	}, nil
+}
+
+func (d *DebugAPI) UncheckAnalysis(context.Context, *empty.Empty) (*pb.UncheckInfos, error) {
+	r, err := d.debug.UncheckAnalysis()
+	if err != nil {
+		return nil, err
+	}
+	return toUncheckInfos(r), nil
+}
+
+func (d *DebugAPI) UncheckBlock(ctx context.Context, param *pbtypes.Hash) (*pb.UncheckInfos, error) {
+	hash, err := toOriginHash(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.debug.UncheckBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+	return toUncheckInfos(r), nil
+}
+
+func (d *DebugAPI) UncheckBlocks(context.Context, *empty.Empty) (*pb.APIUncheckBlocks, error) {
+	r, err := d.debug.UncheckBlocks()
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*pb.APIUncheckBlock, 0)
+	for _, b := range r {
+		blocks = append(blocks, &pb.APIUncheckBlock{
+			Block:       toStateBlock(b.Block),
+			Hash:        toHashValue(b.Hash),
+			Link:        toHashValue(b.Link),
+			UncheckType: b.UnCheckType,
+			SyncType:    int32(b.SyncType),
+			PovHeight:   b.Height,
+		})
+	}
+	return &pb.APIUncheckBlocks{
+		Blocks: blocks,
+	}, nil
+}
+
+func (d *DebugAPI) UncheckBlocksCount(context.Context, *empty.Empty) (*pb.UncheckBlocksCountRsp, error) {
+	r, err := d.debug.UncheckBlocksCount()
+	if err != nil {
+		return nil, err
+	}
+	count := make(map[string]int64)
+	for k, v := range r {
+		count[k] = int64(v)
+	}
+	return &pb.UncheckBlocksCountRsp{
+		Count: count,
+	}, nil
+}
+
+func (d *DebugAPI) FeedConsensus(context.Context, *empty.Empty) (*empty.Empty, error) {
+	if err := d.debug.FeedConsensus(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (d *DebugAPI) DebugConsensus(context.Context, *empty.Empty) (*empty.Empty, error) {
+	if err := d.debug.DebugConsensus(); err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (d *DebugAPI) GetPrivacyInfo(context.Context, *empty.Empty) (*pb.DebugInfo, error) {
+	r, err := d.debug.GetPrivacyInfo()
+	if err != nil {
+		return nil, err
+	}
+	return toDebugInfo(r)
+}
+
+// toDebugInfo encodes every value of the info as json
+func toDebugInfo(r map[string]interface{}) (*pb.DebugInfo, error) {
+	info := make(map[string]string)
+	for k, v := range r {
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		info[k] = string(bs)
+	}
+	return &pb.DebugInfo{
+		Info: info,
+	}, nil
+}
+
+func toRepOnlinePeriods(r map[uint64]*dpos.RepOnlinePeriod) *pb.RepOnlinePeriods {
+	periods := make(map[uint64]*pb.RepOnlinePeriod)
+	for k, v := range r {
+		stat := make(map[string]*pb.RepAckStatistics)
+		for addr, s := range v.Stat {
+			stat[toAddressValue(addr)] = &pb.RepAckStatistics{
+				HeartCount: s.HeartCount,
+				VoteCount:  s.VoteCount,
+			}
+		}
+		periods[k] = &pb.RepOnlinePeriod{
+			Period:     v.Period,
+			Statistics: stat,
+			BlockCount: v.BlockCount,
+		}
+	}
+	return &pb.RepOnlinePeriods{
+		Periods: periods,
+	}
+}
+
+func toUncheckInfos(r []*api.UncheckInfo) *pb.UncheckInfos {
+	infos := make([]*pb.UncheckInfo, 0)
+	for _, u := range r {
+		infos = append(infos, &pb.UncheckInfo{
+			Hash:      toHashValue(u.Hash),
+			GapType:   u.GapType,
+			GapHash:   toHashValue(u.GapHash),
+			GapHeight: u.GapHeight,
+		})
+	}
+	return &pb.UncheckInfos{
+		Infos: infos,
+	}
+}
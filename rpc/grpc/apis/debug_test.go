@@ -0,0 +1,118 @@
+package apis
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	qlcchainctx "github.com/qlcchain/go-qlc/chain/context"
+	"github.com/qlcchain/go-qlc/common/storage"
+	"github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func setupDebugAPI(t *testing.T) (func(t *testing.T), ledger.Store, *DebugAPI) {
+	dir := filepath.Join(config.QlcTestDataDir(), "debug", uuid.New().String())
+	_ = os.RemoveAll(dir)
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+
+	l := ledger.NewLedger(cm.ConfigFile)
+	cc := qlcchainctx.NewChainContext(cm.ConfigFile)
+	debugApi := NewDebugAPI(cm.ConfigFile, cc.EventBus())
+
+	return func(t *testing.T) {
+		if err := l.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}, l, debugApi
+}
+
+func TestDebugAPI_BlockCaches(t *testing.T) {
+	teardownTestCase, l, debugApi := setupDebugAPI(t)
+	defer teardownTestCase(t)
+
+	for i := 0; i < 3; i++ {
+		if err := l.AddBlockCache(mock.StateBlockWithoutWork()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if r, err := debugApi.BlockCacheCount(context.Background(), nil); err != nil || r.GetCount()["blockCache"] != 3 {
+		t.Fatal(err, r)
+	}
+	if r, err := debugApi.BlockCaches(context.Background(), nil); err != nil || len(r.GetHashes()) != 3 {
+		t.Fatal(err, r)
+	}
+}
+
+func TestDebugAPI_BlockLink(t *testing.T) {
+	teardownTestCase, l, debugApi := setupDebugAPI(t)
+	defer teardownTestCase(t)
+
+	blk := mock.StateBlockWithoutWork()
+	child := mock.Hash()
+	key, _ := storage.GetKeyOfParts(storage.KeyPrefixChild, blk.GetHash())
+	val, _ := child.Serialize()
+	if err := l.DBStore().Put(key, val); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := debugApi.BlockLink(context.Background(), toHash(blk.GetHash()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetLinks()["child"] != child.String() {
+		t.Fatal(r)
+	}
+
+	if r, err := debugApi.BlockLinks(context.Background(), toHash(blk.GetHash())); err != nil || len(r.GetLinks()) != 2 {
+		t.Fatal(err, r)
+	}
+}
+
+func TestDebugAPI_Uncheck(t *testing.T) {
+	teardownTestCase, _, debugApi := setupDebugAPI(t)
+	defer teardownTestCase(t)
+
+	if r, err := debugApi.UncheckBlocks(context.Background(), nil); err != nil || len(r.GetBlocks()) != 0 {
+		t.Fatal(err, r)
+	}
+	if r, err := debugApi.UncheckAnalysis(context.Background(), nil); err != nil || len(r.GetInfos()) != 0 {
+		t.Fatal(err, r)
+	}
+	if r, err := debugApi.UncheckBlocksCount(context.Background(), nil); err != nil || r.GetCount()["Total"] != 0 {
+		t.Fatal(err, r)
+	}
+	if _, err := debugApi.UncheckBlock(context.Background(), toHash(mock.Hash())); err == nil {
+		t.Fatal("uncheck block should not be found")
+	}
+	if r, err := debugApi.PendingsCount(context.Background(), nil); err != nil || r.GetValue() != 0 {
+		t.Fatal(err, r)
+	}
+	if r, err := debugApi.GetCacheStat(context.Background(), nil); err != nil {
+		t.Fatal(err, r)
+	}
+}
+
+func Test_toDebugInfo(t *testing.T) {
+	r, err := toDebugInfo(map[string]interface{}{
+		"height": 10,
+		"name":   "pov",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetInfo()["height"] != "10" || r.GetInfo()["name"] != `"pov"` {
+		t.Fatal(r)
+	}
+	if _, err := toDebugInfo(map[string]interface{}{"ch": make(chan int)}); err == nil {
+		t.Fatal("should not encode chan")
+	}
+}
@@ -2,4 +2,1177 @@
 
 package apis
 
-//TODO: implement dod settlement apis
+import (
+	"context"
+	"encoding"
+
+	"go.uber.org/zap"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/rpc/api"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	pbtypes "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+type DoDSettlementAPI struct {
+	dod    *api.DoDSettlementAPI
+	logger *zap.SugaredLogger
+}
+
+func NewDoDSettlementAPI(cfgFile string, l ledger.Store) *DoDSettlementAPI {
+	return &DoDSettlementAPI{
+		dod:    api.NewDoDSettlementAPI(cfgFile, l),
+		logger: log.NewLogger("grpc_dod_settlement"),
+	}
+}
+
+func (d *DoDSettlementAPI) GetCreateOrderBlock(ctx context.Context, param *pb.DoDSettleCreateOrderParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleCreateOrderParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetCreateOrderBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetCreateOrderRewardBlock(ctx context.Context, param *pb.DoDSettleResponseParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleResponseParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetCreateOrderRewardBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetUpdateOrderInfoBlock(ctx context.Context, param *pb.DoDSettleUpdateOrderInfoParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleUpdateOrderInfoParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetUpdateOrderInfoBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetUpdateOrderInfoRewardBlock(ctx context.Context, param *pb.DoDSettleResponseParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleResponseParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetUpdateOrderInfoRewardBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetChangeOrderBlock(ctx context.Context, param *pb.DoDSettleChangeOrderParam) (*pbtypes.StateBlock, error) {
+	buyer, seller, connections, err := toOriginDoDSettleChangeParam(param.GetBuyer(), param.GetSeller(), param.GetConnections())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetChangeOrderBlock(&api.DoDSettleChangeOrderParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleChangeOrderParam: abi.DoDSettleChangeOrderParam{
+			Buyer:       buyer,
+			Seller:      seller,
+			Connections: connections,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetChangeOrderRewardBlock(ctx context.Context, param *pb.DoDSettleResponseParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleResponseParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetChangeOrderRewardBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetTerminateOrderBlock(ctx context.Context, param *pb.DoDSettleTerminateOrderParam) (*pbtypes.StateBlock, error) {
+	buyer, seller, connections, err := toOriginDoDSettleChangeParam(param.GetBuyer(), param.GetSeller(), param.GetConnections())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetTerminateOrderBlock(&api.DoDSettleTerminateOrderParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleTerminateOrderParam: abi.DoDSettleTerminateOrderParam{
+			Buyer:       buyer,
+			Seller:      seller,
+			Connections: connections,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetTerminateOrderRewardBlock(ctx context.Context, param *pb.DoDSettleResponseParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleResponseParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetTerminateOrderRewardBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetUpdateProductInfoBlock(ctx context.Context, param *pb.DoDSettleUpdateProductInfoParam) (*pbtypes.StateBlock, error) {
+	addr, err := toOriginAddressByValue(param.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	products := make([]*abi.DoDSettleProductInfo, 0)
+	for _, p := range param.GetProductInfo() {
+		products = append(products, &abi.DoDSettleProductInfo{
+			OrderItemId: p.GetOrderItemId(),
+			ProductId:   p.GetProductId(),
+			Active:      p.GetActive(),
+		})
+	}
+	r, err := d.dod.GetUpdateProductInfoBlock(&api.DoDSettleUpdateProductInfoParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleUpdateProductInfoParam: abi.DoDSettleUpdateProductInfoParam{
+			Address:     addr,
+			OrderId:     param.GetOrderId(),
+			ProductInfo: products,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetUpdateProductInfoRewardBlock(ctx context.Context, param *pb.DoDSettleResponseParam) (*pbtypes.StateBlock, error) {
+	p, err := toOriginDoDSettleResponseParam(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetUpdateProductInfoRewardBlock(p)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderInfoBySellerAndOrderId(ctx context.Context, param *pb.DoDOrderIdRequest) (*pb.DoDSettleOrderInfo, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetOrderInfoBySellerAndOrderId(seller, param.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleOrderInfo(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderInfoByInternalId(ctx context.Context, param *pb.String) (*pb.DoDSettleOrderInfo, error) {
+	r, err := d.dod.GetOrderInfoByInternalId(toOriginString(param))
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleOrderInfo(r), nil
+}
+
+func (d *DoDSettlementAPI) GetProductInfoBySellerAndProductId(ctx context.Context, param *pb.DoDProductIdRequest) (*pb.DoDSettleConnectionInfo, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetProductInfoBySellerAndProductId(seller, param.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleConnectionInfo(r), nil
+}
+
+func (d *DoDSettlementAPI) GetPendingRequest(ctx context.Context, param *pbtypes.Address) (*pb.DoDPendingRequestRsps, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetPendingRequest(addr)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*pb.DoDPendingRequestRsp, 0)
+	for _, info := range r {
+		infos = append(infos, &pb.DoDPendingRequestRsp{
+			Hash:  toHashValue(info.Hash),
+			Order: toDoDSettleOrderInfo(info.Order),
+		})
+	}
+	return &pb.DoDPendingRequestRsps{
+		Infos: infos,
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetPendingResourceCheck(ctx context.Context, param *pbtypes.Address) (*pb.DoDPendingResourceCheckInfos, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetPendingResourceCheck(addr)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*pb.DoDPendingResourceCheckInfo, 0)
+	for _, info := range r {
+		infos = append(infos, &pb.DoDPendingResourceCheckInfo{
+			SendHash:   toHashValue(info.SendHash),
+			OrderId:    info.OrderId,
+			InternalId: toHashValue(info.InternalId),
+			Products:   toDoDSettleProductInfos(info.Products),
+		})
+	}
+	return &pb.DoDPendingResourceCheckInfos{
+		Infos: infos,
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetPlacingOrder(ctx context.Context, param *pb.DoDPlacingOrderRequest) (*pb.DoDPlacingOrderResp, error) {
+	buyer, err := toOriginAddressByValue(param.GetBuyer())
+	if err != nil {
+		return nil, err
+	}
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetPlacingOrder(buyer, seller, int(param.GetCount()), int(param.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*pb.DoDPlacingOrderInfo, 0)
+	for _, o := range r.OrderList {
+		orders = append(orders, &pb.DoDPlacingOrderInfo{
+			InternalId: toHashValue(o.InternalId),
+			OrderInfo:  toDoDSettleOrderInfo(o.OrderInfo),
+		})
+	}
+	return &pb.DoDPlacingOrderResp{
+		TotalOrders: int32(r.TotalOrders),
+		OrderList:   orders,
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetProductIdListByAddress(ctx context.Context, param *pbtypes.Address) (*pb.DoDSettleProducts, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetProductIdListByAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleProducts(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderIdListByAddress(ctx context.Context, param *pbtypes.Address) (*pb.DoDSettleOrders, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetOrderIdListByAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleOrders(r), nil
+}
+
+func (d *DoDSettlementAPI) GetProductIdListByAddressAndSeller(ctx context.Context, param *pb.DoDAddressAndSellerRequest) (*pb.DoDSettleProducts, error) {
+	addr, seller, err := toOriginDoDAddressAndSeller(param.GetAddress(), param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetProductIdListByAddressAndSeller(addr, seller)
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleProducts(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderIdListByAddressAndSeller(ctx context.Context, param *pb.DoDAddressAndSellerRequest) (*pb.DoDSettleOrders, error) {
+	addr, seller, err := toOriginDoDAddressAndSeller(param.GetAddress(), param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetOrderIdListByAddressAndSeller(addr, seller)
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleOrders(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderCountByAddress(ctx context.Context, param *pbtypes.Address) (*pb.Int32, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Int32{
+		Value: int32(d.dod.GetOrderCountByAddress(addr)),
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetOrderInfoByAddress(ctx context.Context, param *pb.DoDAddressOffsetRequest) (*pb.DoDSettlementOrderInfoResp, error) {
+	addr, err := toOriginAddressByValue(param.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetOrderInfoByAddress(addr, int(param.GetCount()), int(param.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettlementOrderInfoResp(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderCountByAddressAndSeller(ctx context.Context, param *pb.DoDAddressAndSellerRequest) (*pb.Int32, error) {
+	addr, seller, err := toOriginDoDAddressAndSeller(param.GetAddress(), param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Int32{
+		Value: int32(d.dod.GetOrderCountByAddressAndSeller(addr, seller)),
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetOrderInfoByAddressAndSeller(ctx context.Context, param *pb.DoDAddressAndSellerOffsetRequest) (*pb.DoDSettlementOrderInfoResp, error) {
+	addr, seller, err := toOriginDoDAddressAndSeller(param.GetAddress(), param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetOrderInfoByAddressAndSeller(addr, seller, int(param.GetCount()), int(param.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettlementOrderInfoResp(r), nil
+}
+
+func (d *DoDSettlementAPI) GetProductCountByAddress(ctx context.Context, param *pbtypes.Address) (*pb.Int32, error) {
+	addr, err := toOriginAddress(param)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Int32{
+		Value: int32(d.dod.GetProductCountByAddress(addr)),
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetProductInfoByAddress(ctx context.Context, param *pb.DoDAddressOffsetRequest) (*pb.DoDSettlementProductInfoResp, error) {
+	addr, err := toOriginAddressByValue(param.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetProductInfoByAddress(addr, int(param.GetCount()), int(param.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettlementProductInfoResp(r), nil
+}
+
+func (d *DoDSettlementAPI) GetProductCountByAddressAndSeller(ctx context.Context, param *pb.DoDAddressAndSellerRequest) (*pb.Int32, error) {
+	addr, seller, err := toOriginDoDAddressAndSeller(param.GetAddress(), param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Int32{
+		Value: int32(d.dod.GetProductCountByAddressAndSeller(addr, seller)),
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetProductInfoByAddressAndSeller(ctx context.Context, param *pb.DoDAddressAndSellerOffsetRequest) (*pb.DoDSettlementProductInfoResp, error) {
+	addr, seller, err := toOriginDoDAddressAndSeller(param.GetAddress(), param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetProductInfoByAddressAndSeller(addr, seller, int(param.GetCount()), int(param.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettlementProductInfoResp(r), nil
+}
+
+func (d *DoDSettlementAPI) GenerateInvoiceByOrderId(ctx context.Context, param *pb.DoDInvoiceByOrderIdRequest) (*pb.DoDSettleOrderInvoice, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GenerateInvoiceByOrderId(seller, param.GetOrderId(), param.GetStart(), param.GetEnd(), param.GetFlight(), param.GetSplit())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DoDSettleOrderInvoice{
+		InvoiceId:            toHashValue(r.InvoiceId),
+		TotalConnectionCount: int32(r.TotalConnectionCount),
+		TotalAmount:          toMoneyValue(r.TotalAmount),
+		Currency:             r.Currency,
+		StartTime:            r.StartTime,
+		EndTime:              r.EndTime,
+		Flight:               r.Flight,
+		Split:                r.Split,
+		Buyer:                toDoDSettleUser(r.Buyer),
+		Seller:               toDoDSettleUser(r.Seller),
+		Order:                toDoDSettleInvoiceOrderDetail(r.Order),
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GenerateInvoiceByBuyer(ctx context.Context, param *pb.DoDInvoiceByBuyerRequest) (*pb.DoDSettleBuyerInvoice, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	buyer, err := toOriginAddressByValue(param.GetBuyer())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GenerateInvoiceByBuyer(seller, buyer, param.GetStart(), param.GetEnd(), param.GetFlight(), param.GetSplit())
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*pb.DoDSettleInvoiceOrderDetail, 0)
+	for _, o := range r.Orders {
+		orders = append(orders, toDoDSettleInvoiceOrderDetail(o))
+	}
+	return &pb.DoDSettleBuyerInvoice{
+		InvoiceId:            toHashValue(r.InvoiceId),
+		OrderCount:           int32(r.OrderCount),
+		TotalConnectionCount: int32(r.TotalConnectionCount),
+		TotalAmount:          toMoneyValue(r.TotalAmount),
+		Currency:             r.Currency,
+		StartTime:            r.StartTime,
+		EndTime:              r.EndTime,
+		Flight:               r.Flight,
+		Split:                r.Split,
+		Buyer:                toDoDSettleUser(r.Buyer),
+		Seller:               toDoDSettleUser(r.Seller),
+		Orders:               orders,
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GenerateInvoiceByProductId(ctx context.Context, param *pb.DoDInvoiceByProductIdRequest) (*pb.DoDSettleProductInvoice, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GenerateInvoiceByProductId(seller, param.GetProductId(), param.GetStart(), param.GetEnd(), param.GetFlight(), param.GetSplit())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DoDSettleProductInvoice{
+		InvoiceId:   toHashValue(r.InvoiceId),
+		TotalAmount: toMoneyValue(r.TotalAmount),
+		Currency:    r.Currency,
+		StartTime:   r.StartTime,
+		EndTime:     r.EndTime,
+		Flight:      r.Flight,
+		Split:       r.Split,
+		Buyer:       toDoDSettleUser(r.Buyer),
+		Seller:      toDoDSettleUser(r.Seller),
+		Connection:  toDoDSettleInvoiceConnDetail(r.Connection),
+	}, nil
+}
+
+func (d *DoDSettlementAPI) GetInternalIdByOrderId(ctx context.Context, param *pb.DoDOrderIdRequest) (*pbtypes.Hash, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetInternalIdByOrderId(seller, param.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+	return toHash(r), nil
+}
+
+func (d *DoDSettlementAPI) GetIssueInvoiceBlock(ctx context.Context, param *pb.DoDSettleIssueInvoiceParam) (*pbtypes.StateBlock, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	token, err := toOriginHashByValue(param.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetIssueInvoiceBlock(&api.DoDSettleIssueInvoiceParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleIssueInvoiceParam: abi.DoDSettleIssueInvoiceParam{
+			Seller:    seller,
+			OrderId:   param.GetOrderId(),
+			Token:     token,
+			StartTime: param.GetStartTime(),
+			EndTime:   param.GetEndTime(),
+			DueTime:   param.GetDueTime(),
+			Flight:    param.GetFlight(),
+			Split:     param.GetSplit(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetPayInvoiceBlock(ctx context.Context, param *pb.DoDSettlePayInvoiceParam) (*pbtypes.StateBlock, error) {
+	invoiceId, err := toOriginHashByValue(param.GetInvoiceId())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetPayInvoiceBlock(&api.DoDSettlePayInvoiceParam{
+		ContractPrivacyParam:     toOriginContractPrivacyParam(param),
+		DoDSettlePayInvoiceParam: abi.DoDSettlePayInvoiceParam{InvoiceId: invoiceId},
+		Amount:                   toOriginBalanceByValue(param.GetAmount()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetRefundInvoiceBlock(ctx context.Context, param *pb.DoDSettleRefundInvoiceParam) (*pbtypes.StateBlock, error) {
+	invoiceId, err := toOriginHashByValue(param.GetInvoiceId())
+	if err != nil {
+		return nil, err
+	}
+	paymentId, err := toOriginHashByValue(param.GetPaymentId())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetRefundInvoiceBlock(&api.DoDSettleRefundInvoiceParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleRefundInvoiceParam: abi.DoDSettleRefundInvoiceParam{
+			InvoiceId: invoiceId,
+			PaymentId: paymentId,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetInvoiceRewardBlock(ctx context.Context, param *pbtypes.Hash) (*pbtypes.StateBlock, error) {
+	hash, err := toOriginHash(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetInvoiceRewardBlock(hash)
+	if err != nil {
+		return nil, err
+	}
+	return toStateBlock(r), nil
+}
+
+func (d *DoDSettlementAPI) GetInvoicePaymentStatus(ctx context.Context, param *pbtypes.Hash) (*pb.DoDSettleInvoicePaymentStatus, error) {
+	invoiceId, err := toOriginHash(param)
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetInvoicePaymentStatus(invoiceId)
+	if err != nil {
+		return nil, err
+	}
+	return toDoDSettleInvoicePaymentStatus(r), nil
+}
+
+func (d *DoDSettlementAPI) GetOrderPaymentStatus(ctx context.Context, param *pb.DoDOrderIdRequest) (*pb.DoDSettleInvoicePaymentStatuses, error) {
+	seller, err := toOriginAddressByValue(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	r, err := d.dod.GetOrderPaymentStatus(seller, param.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*pb.DoDSettleInvoicePaymentStatus, 0)
+	for _, st := range r {
+		statuses = append(statuses, toDoDSettleInvoicePaymentStatus(st))
+	}
+	return &pb.DoDSettleInvoicePaymentStatuses{
+		Statuses: statuses,
+	}, nil
+}
+
+// dod settlement enums are transported by name, an empty name is the null value
+func toOriginDoDEnum(name string, v encoding.TextUnmarshaler) error {
+	if name == "" {
+		return nil
+	}
+	return v.UnmarshalText([]byte(name))
+}
+
+type contractPrivacyParam interface {
+	GetPrivateFrom() string
+	GetPrivateFor() []string
+	GetPrivateGroupID() string
+}
+
+func toOriginContractPrivacyParam(param contractPrivacyParam) api.ContractPrivacyParam {
+	return api.ContractPrivacyParam{
+		PrivateFrom:    param.GetPrivateFrom(),
+		PrivateFor:     param.GetPrivateFor(),
+		PrivateGroupID: param.GetPrivateGroupID(),
+	}
+}
+
+func toOriginDoDAddressAndSeller(address, seller string) (types.Address, types.Address, error) {
+	addr, err := toOriginAddressByValue(address)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroAddress, err
+	}
+	s, err := toOriginAddressByValue(seller)
+	if err != nil {
+		return types.ZeroAddress, types.ZeroAddress, err
+	}
+	return addr, s, nil
+}
+
+func toOriginDoDSettleUser(user *pb.DoDSettleUser) (*abi.DoDSettleUser, error) {
+	if user == nil {
+		return nil, api.ErrParameterNil
+	}
+	addr, err := toOriginAddressByValue(user.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &abi.DoDSettleUser{
+		Address: addr,
+		Name:    user.GetName(),
+	}, nil
+}
+
+// dodDynamicParam is implemented by all messages which carry a connection dynamic param
+type dodDynamicParam interface {
+	GetOrderId() string
+	GetInternalId() string
+	GetItemId() string
+	GetOrderItemId() string
+	GetQuoteId() string
+	GetQuoteItemId() string
+	GetConnectionName() string
+	GetPaymentType() string
+	GetBillingType() string
+	GetCurrency() string
+	GetServiceClass() string
+	GetBandwidth() string
+	GetBillingUnit() string
+	GetPrice() string
+	GetAddition() string
+	GetStartTime() int64
+	GetStartTimeStr() string
+	GetEndTime() int64
+	GetEndTimeStr() string
+}
+
+func toOriginDoDSettleConnectionDynamicParam(param dodDynamicParam) (abi.DoDSettleConnectionDynamicParam, error) {
+	p := abi.DoDSettleConnectionDynamicParam{
+		OrderId:        param.GetOrderId(),
+		InternalId:     param.GetInternalId(),
+		ItemId:         param.GetItemId(),
+		OrderItemId:    param.GetOrderItemId(),
+		QuoteId:        param.GetQuoteId(),
+		QuoteItemId:    param.GetQuoteItemId(),
+		ConnectionName: param.GetConnectionName(),
+		Currency:       param.GetCurrency(),
+		Bandwidth:      param.GetBandwidth(),
+		StartTime:      param.GetStartTime(),
+		StartTimeStr:   param.GetStartTimeStr(),
+		EndTime:        param.GetEndTime(),
+		EndTimeStr:     param.GetEndTimeStr(),
+	}
+	var err error
+	if p.Price, err = toOriginMoneyByValue(param.GetPrice()); err != nil {
+		return p, err
+	}
+	if p.Addition, err = toOriginMoneyByValue(param.GetAddition()); err != nil {
+		return p, err
+	}
+	if err := toOriginDoDEnum(param.GetPaymentType(), &p.PaymentType); err != nil {
+		return p, err
+	}
+	if err := toOriginDoDEnum(param.GetBillingType(), &p.BillingType); err != nil {
+		return p, err
+	}
+	if err := toOriginDoDEnum(param.GetServiceClass(), &p.ServiceClass); err != nil {
+		return p, err
+	}
+	if err := toOriginDoDEnum(param.GetBillingUnit(), &p.BillingUnit); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+func toOriginDoDSettleCreateOrderParam(param *pb.DoDSettleCreateOrderParam) (*api.DoDSettleCreateOrderParam, error) {
+	buyer, err := toOriginDoDSettleUser(param.GetBuyer())
+	if err != nil {
+		return nil, err
+	}
+	seller, err := toOriginDoDSettleUser(param.GetSeller())
+	if err != nil {
+		return nil, err
+	}
+	connections := make([]*abi.DoDSettleConnectionParam, 0)
+	for _, c := range param.GetConnections() {
+		dynamic, err := toOriginDoDSettleConnectionDynamicParam(c)
+		if err != nil {
+			return nil, err
+		}
+		connections = append(connections, &abi.DoDSettleConnectionParam{
+			DoDSettleConnectionStaticParam: abi.DoDSettleConnectionStaticParam{
+				BuyerProductId:    c.GetBuyerProductId(),
+				ProductOfferingId: c.GetProductOfferingId(),
+				ProductId:         c.GetProductId(),
+				SrcCompanyName:    c.GetSrcCompanyName(),
+				SrcRegion:         c.GetSrcRegion(),
+				SrcCity:           c.GetSrcCity(),
+				SrcDataCenter:     c.GetSrcDataCenter(),
+				SrcPort:           c.GetSrcPort(),
+				DstCompanyName:    c.GetDstCompanyName(),
+				DstRegion:         c.GetDstRegion(),
+				DstCity:           c.GetDstCity(),
+				DstDataCenter:     c.GetDstDataCenter(),
+				DstPort:           c.GetDstPort(),
+			},
+			DoDSettleConnectionDynamicParam: dynamic,
+		})
+	}
+	return &api.DoDSettleCreateOrderParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleCreateOrderParam: abi.DoDSettleCreateOrderParam{
+			Buyer:       buyer,
+			Seller:      seller,
+			Connections: connections,
+		},
+	}, nil
+}
+
+func toOriginDoDSettleResponseParam(param *pb.DoDSettleResponseParam) (*api.DoDSettleResponseParam, error) {
+	hash, err := toOriginHashByValue(param.GetRequestHash())
+	if err != nil {
+		return nil, err
+	}
+	p := &api.DoDSettleResponseParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleResponseParam: abi.DoDSettleResponseParam{
+			RequestHash: hash,
+		},
+	}
+	if err := toOriginDoDEnum(param.GetAction(), &p.Action); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func toOriginDoDSettleUpdateOrderInfoParam(param *pb.DoDSettleUpdateOrderInfoParam) (*api.DoDSettleUpdateOrderInfoParam, error) {
+	buyer, err := toOriginAddressByValue(param.GetBuyer())
+	if err != nil {
+		return nil, err
+	}
+	var internalId types.Hash
+	if param.GetInternalId() != "" {
+		if internalId, err = toOriginHashByValue(param.GetInternalId()); err != nil {
+			return nil, err
+		}
+	}
+	items := make([]*abi.DoDSettleOrderItem, 0)
+	for _, item := range param.GetOrderItemId() {
+		items = append(items, &abi.DoDSettleOrderItem{
+			ItemId:      item.GetItemId(),
+			OrderItemId: item.GetOrderItemId(),
+		})
+	}
+	p := &api.DoDSettleUpdateOrderInfoParam{
+		ContractPrivacyParam: toOriginContractPrivacyParam(param),
+		DoDSettleUpdateOrderInfoParam: abi.DoDSettleUpdateOrderInfoParam{
+			Buyer:       buyer,
+			InternalId:  internalId,
+			OrderId:     param.GetOrderId(),
+			OrderItemId: items,
+			FailReason:  param.GetFailReason(),
+		},
+	}
+	if err := toOriginDoDEnum(param.GetStatus(), &p.Status); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func toOriginDoDSettleChangeParam(b, s *pb.DoDSettleUser, cs []*pb.DoDSettleChangeConnectionParam) (*abi.DoDSettleUser,
+	*abi.DoDSettleUser, []*abi.DoDSettleChangeConnectionParam, error) {
+	buyer, err := toOriginDoDSettleUser(b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	seller, err := toOriginDoDSettleUser(s)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	connections := make([]*abi.DoDSettleChangeConnectionParam, 0)
+	for _, c := range cs {
+		dynamic, err := toOriginDoDSettleConnectionDynamicParam(c)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		connections = append(connections, &abi.DoDSettleChangeConnectionParam{
+			ProductId:                       c.GetProductId(),
+			DoDSettleConnectionDynamicParam: dynamic,
+		})
+	}
+	return buyer, seller, connections, nil
+}
+
+func toDoDSettleUser(user *abi.DoDSettleUser) *pb.DoDSettleUser {
+	if user == nil {
+		return nil
+	}
+	return &pb.DoDSettleUser{
+		Address: toAddressValue(user.Address),
+		Name:    user.Name,
+	}
+}
+
+func toDoDSettleConnectionDynamicParam(param *abi.DoDSettleConnectionDynamicParam) *pb.DoDSettleConnectionDynamicParam {
+	if param == nil {
+		return nil
+	}
+	return &pb.DoDSettleConnectionDynamicParam{
+		OrderId:        param.OrderId,
+		InternalId:     param.InternalId,
+		ItemId:         param.ItemId,
+		OrderItemId:    param.OrderItemId,
+		QuoteId:        param.QuoteId,
+		QuoteItemId:    param.QuoteItemId,
+		ConnectionName: param.ConnectionName,
+		PaymentType:    param.PaymentType.String(),
+		BillingType:    param.BillingType.String(),
+		Currency:       param.Currency,
+		ServiceClass:   param.ServiceClass.String(),
+		Bandwidth:      param.Bandwidth,
+		BillingUnit:    param.BillingUnit.String(),
+		Price:          toMoneyValue(param.Price),
+		Addition:       toMoneyValue(param.Addition),
+		StartTime:      param.StartTime,
+		StartTimeStr:   param.StartTimeStr,
+		EndTime:        param.EndTime,
+		EndTimeStr:     param.EndTimeStr,
+	}
+}
+
+func toDoDSettleConnectionParam(param *abi.DoDSettleConnectionParam) *pb.DoDSettleConnectionParam {
+	return &pb.DoDSettleConnectionParam{
+		BuyerProductId:    param.BuyerProductId,
+		ProductOfferingId: param.ProductOfferingId,
+		ProductId:         param.ProductId,
+		SrcCompanyName:    param.SrcCompanyName,
+		SrcRegion:         param.SrcRegion,
+		SrcCity:           param.SrcCity,
+		SrcDataCenter:     param.SrcDataCenter,
+		SrcPort:           param.SrcPort,
+		DstCompanyName:    param.DstCompanyName,
+		DstRegion:         param.DstRegion,
+		DstCity:           param.DstCity,
+		DstDataCenter:     param.DstDataCenter,
+		DstPort:           param.DstPort,
+		OrderId:           param.OrderId,
+		InternalId:        param.InternalId,
+		ItemId:            param.ItemId,
+		OrderItemId:       param.OrderItemId,
+		QuoteId:           param.QuoteId,
+		QuoteItemId:       param.QuoteItemId,
+		ConnectionName:    param.ConnectionName,
+		PaymentType:       param.PaymentType.String(),
+		BillingType:       param.BillingType.String(),
+		Currency:          param.Currency,
+		ServiceClass:      param.ServiceClass.String(),
+		Bandwidth:         param.Bandwidth,
+		BillingUnit:       param.BillingUnit.String(),
+		Price:             toMoneyValue(param.Price),
+		Addition:          toMoneyValue(param.Addition),
+		StartTime:         param.StartTime,
+		StartTimeStr:      param.StartTimeStr,
+		EndTime:           param.EndTime,
+		EndTimeStr:        param.EndTimeStr,
+	}
+}
+
+func toDoDSettleOrderInfo(order *abi.DoDSettleOrderInfo) *pb.DoDSettleOrderInfo {
+	if order == nil {
+		return nil
+	}
+	connections := make([]*pb.DoDSettleConnectionParam, 0)
+	for _, c := range order.Connections {
+		connections = append(connections, toDoDSettleConnectionParam(c))
+	}
+	track := make([]*pb.DoDSettleOrderLifeTrack, 0)
+	for _, t := range order.Track {
+		track = append(track, &pb.DoDSettleOrderLifeTrack{
+			ContractState: t.ContractState.String(),
+			OrderState:    t.OrderState.String(),
+			Reason:        t.Reason,
+			Time:          t.Time,
+			Hash:          toHashValue(t.Hash),
+		})
+	}
+	return &pb.DoDSettleOrderInfo{
+		Buyer:         toDoDSettleUser(order.Buyer),
+		Seller:        toDoDSettleUser(order.Seller),
+		OrderId:       order.OrderId,
+		InternalId:    order.InternalId,
+		OrderType:     order.OrderType.String(),
+		OrderState:    order.OrderState.String(),
+		ContractState: order.ContractState.String(),
+		Connections:   connections,
+		Track:         track,
+	}
+}
+
+func toDoDSettleConnectionInfo(info *abi.DoDSettleConnectionInfo) *pb.DoDSettleConnectionInfo {
+	if info == nil {
+		return nil
+	}
+	done := make([]*pb.DoDSettleConnectionDynamicParam, 0)
+	for _, d := range info.Done {
+		done = append(done, toDoDSettleConnectionDynamicParam(d))
+	}
+	track := make([]*pb.DoDSettleConnectionLifeTrack, 0)
+	for _, t := range info.Track {
+		track = append(track, &pb.DoDSettleConnectionLifeTrack{
+			OrderType: t.OrderType.String(),
+			OrderId:   t.OrderId,
+			Time:      t.Time,
+			Changed:   toDoDSettleConnectionDynamicParam(t.Changed),
+		})
+	}
+	var disconnect *pb.DoDSettleDisconnectInfo
+	if info.Disconnect != nil {
+		disconnect = &pb.DoDSettleDisconnectInfo{
+			OrderId:      info.Disconnect.OrderId,
+			OrderItemId:  info.Disconnect.OrderItemId,
+			QuoteId:      info.Disconnect.QuoteId,
+			QuoteItemId:  info.Disconnect.QuoteItemId,
+			Price:        toMoneyValue(info.Disconnect.Price),
+			Currency:     info.Disconnect.Currency,
+			DisconnectAt: info.Disconnect.DisconnectAt,
+		}
+	}
+	return &pb.DoDSettleConnectionInfo{
+		BuyerProductId:    info.BuyerProductId,
+		ProductOfferingId: info.ProductOfferingId,
+		ProductId:         info.ProductId,
+		SrcCompanyName:    info.SrcCompanyName,
+		SrcRegion:         info.SrcRegion,
+		SrcCity:           info.SrcCity,
+		SrcDataCenter:     info.SrcDataCenter,
+		SrcPort:           info.SrcPort,
+		DstCompanyName:    info.DstCompanyName,
+		DstRegion:         info.DstRegion,
+		DstCity:           info.DstCity,
+		DstDataCenter:     info.DstDataCenter,
+		DstPort:           info.DstPort,
+		Active:            toDoDSettleConnectionDynamicParam(info.Active),
+		Done:              done,
+		Disconnect:        disconnect,
+		Track:             track,
+	}
+}
+
+func toDoDSettleProductInfos(products []*abi.DoDSettleProductInfo) []*pb.DoDSettleProductInfo {
+	r := make([]*pb.DoDSettleProductInfo, 0)
+	for _, p := range products {
+		r = append(r, &pb.DoDSettleProductInfo{
+			OrderItemId: p.OrderItemId,
+			ProductId:   p.ProductId,
+			Active:      p.Active,
+		})
+	}
+	return r
+}
+
+func toDoDSettleProducts(products []*abi.DoDSettleProduct) *pb.DoDSettleProducts {
+	r := make([]*pb.DoDSettleProduct, 0)
+	for _, p := range products {
+		r = append(r, &pb.DoDSettleProduct{
+			Seller:    toAddressValue(p.Seller),
+			ProductId: p.ProductId,
+		})
+	}
+	return &pb.DoDSettleProducts{
+		Products: r,
+	}
+}
+
+func toDoDSettleOrders(orders []*abi.DoDSettleOrder) *pb.DoDSettleOrders {
+	r := make([]*pb.DoDSettleOrder, 0)
+	for _, o := range orders {
+		r = append(r, &pb.DoDSettleOrder{
+			Seller:  toAddressValue(o.Seller),
+			OrderId: o.OrderId,
+		})
+	}
+	return &pb.DoDSettleOrders{
+		Orders: r,
+	}
+}
+
+func toDoDSettlementOrderInfoResp(resp *api.DoDSettlementOrderInfoResp) *pb.DoDSettlementOrderInfoResp {
+	orders := make([]*pb.DoDSettleOrderInfo, 0)
+	for _, o := range resp.OrderInfo {
+		orders = append(orders, toDoDSettleOrderInfo(o))
+	}
+	return &pb.DoDSettlementOrderInfoResp{
+		OrderInfo:   orders,
+		TotalOrders: int32(resp.TotalOrders),
+	}
+}
+
+func toDoDSettlementProductInfoResp(resp *api.DoDSettlementProductInfoResp) *pb.DoDSettlementProductInfoResp {
+	products := make([]*pb.DoDSettleConnectionInfo, 0)
+	for _, p := range resp.ProductInfo {
+		products = append(products, toDoDSettleConnectionInfo(p))
+	}
+	return &pb.DoDSettlementProductInfoResp{
+		ProductInfo:   products,
+		TotalProducts: int32(resp.TotalProducts),
+	}
+}
+
+func toDoDSettleInvoiceConnDetail(detail *abi.DoDSettleInvoiceConnDetail) *pb.DoDSettleInvoiceConnDetail {
+	if detail == nil {
+		return nil
+	}
+	usage := make([]*pb.DoDSettleInvoiceConnDynamic, 0)
+	for _, u := range detail.Usage {
+		usage = append(usage, &pb.DoDSettleInvoiceConnDynamic{
+			OrderId:             u.OrderId,
+			InternalId:          u.InternalId,
+			ItemId:              u.ItemId,
+			OrderItemId:         u.OrderItemId,
+			QuoteId:             u.QuoteId,
+			QuoteItemId:         u.QuoteItemId,
+			ConnectionName:      u.ConnectionName,
+			PaymentType:         u.PaymentType.String(),
+			BillingType:         u.BillingType.String(),
+			Currency:            u.Currency,
+			ServiceClass:        u.ServiceClass.String(),
+			Bandwidth:           u.Bandwidth,
+			BillingUnit:         u.BillingUnit.String(),
+			Price:               toMoneyValue(u.Price),
+			Addition:            toMoneyValue(u.Addition),
+			StartTime:           u.StartTime,
+			StartTimeStr:        u.StartTimeStr,
+			EndTime:             u.EndTime,
+			EndTimeStr:          u.EndTimeStr,
+			InvoiceStartTime:    u.InvoiceStartTime,
+			InvoiceStartTimeStr: u.InvoiceStartTimeStr,
+			InvoiceEndTime:      u.InvoiceEndTime,
+			InvoiceEndTimeStr:   u.InvoiceEndTimeStr,
+			InvoiceUnitCount:    int32(u.InvoiceUnitCount),
+			OrderType:           u.OrderType.String(),
+			Amount:              toMoneyValue(u.Amount),
+		})
+	}
+	return &pb.DoDSettleInvoiceConnDetail{
+		ConnectionAmount:  toMoneyValue(detail.ConnectionAmount),
+		BuyerProductId:    detail.BuyerProductId,
+		ProductOfferingId: detail.ProductOfferingId,
+		ProductId:         detail.ProductId,
+		SrcCompanyName:    detail.SrcCompanyName,
+		SrcRegion:         detail.SrcRegion,
+		SrcCity:           detail.SrcCity,
+		SrcDataCenter:     detail.SrcDataCenter,
+		SrcPort:           detail.SrcPort,
+		DstCompanyName:    detail.DstCompanyName,
+		DstRegion:         detail.DstRegion,
+		DstCity:           detail.DstCity,
+		DstDataCenter:     detail.DstDataCenter,
+		DstPort:           detail.DstPort,
+		Usage:             usage,
+		Currency:          detail.ConnectionAmount.Currency(),
+	}
+}
+
+func toDoDSettleInvoiceOrderDetail(detail *abi.DoDSettleInvoiceOrderDetail) *pb.DoDSettleInvoiceOrderDetail {
+	if detail == nil {
+		return nil
+	}
+	connections := make([]*pb.DoDSettleInvoiceConnDetail, 0)
+	for _, c := range detail.Connections {
+		connections = append(connections, toDoDSettleInvoiceConnDetail(c))
+	}
+	return &pb.DoDSettleInvoiceOrderDetail{
+		OrderId:         detail.OrderId,
+		InternalId:      toHashValue(detail.InternalId),
+		ConnectionCount: int32(detail.ConnectionCount),
+		OrderAmount:     toMoneyValue(detail.OrderAmount),
+		Connections:     connections,
+		Currency:        detail.OrderAmount.Currency(),
+	}
+}
+
+func toDoDSettleInvoicePaymentStatus(st *abi.DoDSettleInvoicePaymentStatus) *pb.DoDSettleInvoicePaymentStatus {
+	if st == nil {
+		return nil
+	}
+	payments := make([]*pb.DoDSettleInvoicePaymentDetail, 0)
+	for _, p := range st.Payments {
+		payments = append(payments, &pb.DoDSettleInvoicePaymentDetail{
+			PaymentId: toHashValue(p.PaymentId),
+			Payer:     toAddressValue(p.Payer),
+			Amount:    toBalanceValue(p.Amount),
+			Applied:   toBalanceValue(p.Applied),
+			Refund:    toBalanceValue(p.Refund),
+			PaidAt:    p.PaidAt,
+			Refunded:  p.Refunded,
+			RefundAt:  p.RefundAt,
+		})
+	}
+	r := &pb.DoDSettleInvoicePaymentStatus{
+		Status:      st.Status.String(),
+		Dunning:     st.Dunning.String(),
+		Paid:        toBalanceValue(st.Paid),
+		Outstanding: toBalanceValue(st.Outstanding),
+		PaidAmount:  toMoneyValue(st.PaidAmount),
+		Refundable:  toBalanceValue(st.Refundable),
+		PaidAt:      st.PaidAt,
+		Payments:    payments,
+	}
+	if invoice := st.DoDSettleInvoiceInfo; invoice != nil {
+		r.InvoiceId = toHashValue(invoice.InvoiceId)
+		r.InternalId = toHashValue(invoice.InternalId)
+		r.OrderId = invoice.OrderId
+		r.Buyer = toDoDSettleUser(invoice.Buyer)
+		r.Seller = toDoDSettleUser(invoice.Seller)
+		r.Currency = invoice.Currency
+		r.Amount = toMoneyValue(invoice.Amount)
+		r.Token = toHashValue(invoice.Token)
+		r.TokenAmount = toBalanceValue(invoice.TokenAmount)
+		r.StartTime = invoice.StartTime
+		r.EndTime = invoice.EndTime
+		r.DueTime = invoice.DueTime
+		r.IssuedAt = invoice.IssuedAt
+	}
+	return r
+}
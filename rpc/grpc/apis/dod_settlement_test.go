@@ -2,4 +2,205 @@
 
 package apis
 
-//TODO: implement dod settlement apis test cases
+import (
+	"context"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func addDoDSettleTestOrder(t *testing.T, l *ledger.Ledger, buyer, seller types.Address, orderId string) types.Hash {
+	ctx := vmstore.NewVMContext(l, &contractaddress.DoDSettlementAddress)
+	order := abi.NewOrderInfo()
+	order.OrderId = orderId
+	order.Seller = &abi.DoDSettleUser{Address: seller, Name: "seller"}
+	order.Buyer = &abi.DoDSettleUser{Address: buyer, Name: "buyer"}
+	order.OrderState = abi.DoDSettleOrderStateSuccess
+	order.ContractState = abi.DoDSettleContractStateConfirmed
+	order.Connections = []*abi.DoDSettleConnectionParam{{
+		DoDSettleConnectionStaticParam: abi.DoDSettleConnectionStaticParam{ProductId: "product001"},
+		DoDSettleConnectionDynamicParam: abi.DoDSettleConnectionDynamicParam{
+			PaymentType: abi.DoDSettlePaymentTypeInvoice,
+			BillingType: abi.DoDSettleBillingTypeDOD,
+			Price:       types.NewMoney(10, 0, ""),
+		},
+	}}
+	internalId := mock.Hash()
+
+	data, err := order.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var key []byte
+	key = append(key, abi.DoDSettleDBTableOrder)
+	key = append(key, internalId.Bytes()...)
+	if err := ctx.SetStorage(nil, key, data); err != nil {
+		t.Fatal(err)
+	}
+
+	orderKey := &abi.DoDSettleOrder{Seller: seller, OrderId: orderId}
+	key = key[0:0]
+	key = append(key, abi.DoDSettleDBTableOrderIdMap)
+	key = append(key, orderKey.Hash().Bytes()...)
+	if err := ctx.SetStorage(nil, key, internalId.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	return internalId
+}
+
+func TestDoDSettlementAPI_GetOrderInfo(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	d := NewDoDSettlementAPI(cfgFile, l)
+	buyer := mock.Address()
+	seller := mock.Address()
+	internalId := addDoDSettleTestOrder(t, l, buyer, seller, "order001")
+
+	r, err := d.GetOrderInfoBySellerAndOrderId(context.Background(), &pb.DoDOrderIdRequest{
+		Seller:  seller.String(),
+		OrderId: "order001",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.GetBuyer().GetAddress() != buyer.String() || r.GetOrderState() != "success" || r.GetContractState() != "confirmed" ||
+		len(r.GetConnections()) != 1 || r.GetConnections()[0].GetPaymentType() != "invoice" || r.GetConnections()[0].GetBillingType() != "DOD" {
+		t.Fatal(r)
+	}
+
+	id, err := d.GetInternalIdByOrderId(context.Background(), &pb.DoDOrderIdRequest{
+		Seller:  seller.String(),
+		OrderId: "order001",
+	})
+	if err != nil || id.GetHash() != internalId.String() {
+		t.Fatal(err, id)
+	}
+
+	if r, err := d.GetOrderInfoByInternalId(context.Background(), toString(internalId.String())); err != nil || r.GetOrderId() != "order001" {
+		t.Fatal(err, r)
+	}
+	if _, err := d.GetOrderInfoByInternalId(context.Background(), toString("123")); err == nil {
+		t.Fatal()
+	}
+	if _, err := d.GetOrderInfoBySellerAndOrderId(context.Background(), &pb.DoDOrderIdRequest{Seller: "invalid"}); err == nil {
+		t.Fatal()
+	}
+	if r, err := d.GetProductCountByAddress(context.Background(), toAddress(buyer)); err != nil || r.GetValue() != 0 {
+		t.Fatal(err, r)
+	}
+}
+
+func Test_toOriginDoDSettleCreateOrderParam(t *testing.T) {
+	buyer := mock.Address()
+	param := &pb.DoDSettleCreateOrderParam{
+		Buyer:  &pb.DoDSettleUser{Address: buyer.String(), Name: "buyer"},
+		Seller: &pb.DoDSettleUser{Address: mock.Address().String(), Name: "seller"},
+		Connections: []*pb.DoDSettleConnectionParam{{
+			BuyerProductId: "bp001",
+			ItemId:         "item001",
+			PaymentType:    "invoice",
+			BillingType:    "PAYG",
+			ServiceClass:   "gold",
+			BillingUnit:    "second",
+			Price:          "1.5",
+		}},
+		PrivateFrom: "from",
+		PrivateFor:  []string{"for"},
+	}
+
+	p, err := toOriginDoDSettleCreateOrderParam(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := p.Connections[0]
+	if p.Buyer.Address != buyer || p.PrivateFrom != "from" || c.BuyerProductId != "bp001" || c.ItemId != "item001" ||
+		c.PaymentType != abi.DoDSettlePaymentTypeInvoice || c.BillingType != abi.DoDSettleBillingTypePAYG ||
+		c.ServiceClass != abi.DoDSettleServiceClassGold || c.BillingUnit != abi.DoDSettleBillingUnitSecond || c.Price.Decimal() != "1.5" {
+		t.Fatal(p)
+	}
+	if pc := toDoDSettleConnectionParam(c); pc.GetPaymentType() != "invoice" || pc.GetBillingUnit() != "second" {
+		t.Fatal(pc)
+	}
+
+	param.Connections[0].BillingUnit = "invalid"
+	if _, err := toOriginDoDSettleCreateOrderParam(param); err == nil {
+		t.Fatal("invalid billing unit should be rejected")
+	}
+	param.Buyer = nil
+	if _, err := toOriginDoDSettleCreateOrderParam(param); err == nil {
+		t.Fatal("nil buyer should be rejected")
+	}
+}
+
+func Test_toOriginDoDSettleResponseParam(t *testing.T) {
+	hash := mock.Hash()
+	p, err := toOriginDoDSettleResponseParam(&pb.DoDSettleResponseParam{
+		RequestHash: hash.String(),
+		Action:      "confirm",
+	})
+	if err != nil || p.RequestHash != hash || p.Action != abi.DoDSettleResponseActionConfirm {
+		t.Fatal(err, p)
+	}
+	if p, err := toOriginDoDSettleResponseParam(&pb.DoDSettleResponseParam{RequestHash: hash.String()}); err != nil ||
+		p.Action != abi.DoDSettleResponseActionNull {
+		t.Fatal(err, p)
+	}
+
+	u, err := toOriginDoDSettleUpdateOrderInfoParam(&pb.DoDSettleUpdateOrderInfoParam{
+		Buyer:       mock.Address().String(),
+		OrderId:     "order001",
+		OrderItemId: []*pb.DoDSettleOrderItem{{ItemId: "i1", OrderItemId: "o1"}},
+		Status:      "fail",
+	})
+	if err != nil || !u.InternalId.IsZero() || u.Status != abi.DoDSettleOrderStateFail || u.OrderItemId[0].OrderItemId != "o1" {
+		t.Fatal(err, u)
+	}
+}
+
+func Test_toDoDSettleInvoicePaymentStatus(t *testing.T) {
+	payer := mock.Address()
+	st := &abi.DoDSettleInvoicePaymentStatus{
+		DoDSettleInvoiceInfo: &abi.DoDSettleInvoiceInfo{
+			InvoiceId:   mock.Hash(),
+			OrderId:     "order001",
+			Seller:      &abi.DoDSettleUser{Address: mock.Address(), Name: "seller"},
+			Amount:      types.NewMoney(15, 1, ""),
+			TokenAmount: types.NewBalance(100),
+		},
+		Status:      abi.DoDSettleInvoiceStatusPartial,
+		Paid:        types.NewBalance(40),
+		Outstanding: types.NewBalance(60),
+		Payments: []*abi.DoDSettleInvoicePaymentDetail{{
+			DoDSettleInvoicePayment: &abi.DoDSettleInvoicePayment{
+				PaymentId: mock.Hash(),
+				Payer:     payer,
+				Amount:    types.NewBalance(40),
+				Applied:   types.NewBalance(40),
+			},
+		}},
+	}
+
+	r := toDoDSettleInvoicePaymentStatus(st)
+	if r.GetInvoiceId() != st.InvoiceId.String() || r.GetOrderId() != "order001" || r.GetAmount() != "1.5" ||
+		r.GetTokenAmount() != 100 || r.GetStatus() != st.Status.String() || r.GetPaid() != 40 || r.GetOutstanding() != 60 ||
+		r.GetRefundable() != 0 || len(r.GetPayments()) != 1 || r.GetPayments()[0].GetPayer() != payer.String() {
+		t.Fatal(r)
+	}
+	if toDoDSettleInvoicePaymentStatus(nil) != nil {
+		t.Fatal("nil status should be converted to nil")
+	}
+}
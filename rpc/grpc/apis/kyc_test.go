@@ -0,0 +1,156 @@
+package apis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/statedb"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	pb "github.com/qlcchain/go-qlc/rpc/grpc/proto"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+)
+
+func addKYCTestData(t *testing.T, l *ledger.Ledger, keys [][]byte, values [][]byte, povHeight uint64) {
+	povBlk, povTd := mock.GeneratePovBlockByFakePow(nil, 0)
+	povBlk.Header.BasHdr.Height = povHeight
+
+	gsdb := statedb.NewPovGlobalStateDB(l.DBStore(), types.ZeroHash)
+	csdb, err := gsdb.LookupContractStateDB(contractaddress.KYCAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, key := range keys {
+		if err := csdb.SetValue(key, values[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := gsdb.CommitToTrie(); err != nil {
+		t.Fatal(err)
+	}
+	txn := l.DBStore().Batch(true)
+	if err := gsdb.CommitToDB(txn); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.DBStore().PutBatch(txn); err != nil {
+		t.Fatal(err)
+	}
+
+	povBlk.Header.CbTx.StateHash = gsdb.GetCurHash()
+	mock.UpdatePovHash(povBlk)
+
+	if err := l.AddPovBlock(povBlk, povTd); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.AddPovBestHash(povBlk.GetHeight(), povBlk.GetHash()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetPovLatestHeight(povBlk.GetHeight()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKYCAPI_GetBlock(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	k := NewKYCAPI(cfgFile, l)
+	if _, err := k.GetAdminHandoverBlock(context.Background(), &pb.KYCAdminUpdateParam{
+		Admin:     "invalid",
+		Successor: mock.Address().String(),
+	}); err == nil {
+		t.Fatal()
+	}
+	if _, err := k.GetUpdateStatusBlock(context.Background(), &pb.KYCUpdateStatusParam{
+		Operator:     mock.Address().String(),
+		ChainAddress: "invalid",
+	}); err == nil {
+		t.Fatal()
+	}
+	if _, err := k.GetUpdateTradeAddressBlock(context.Background(), &pb.KYCUpdateTradeAddressParam{
+		Operator:     mock.Address().String(),
+		ChainAddress: mock.Address().String(),
+		Action:       "invalid",
+	}); err == nil {
+		t.Fatal()
+	}
+	if _, err := k.GetUpdateOperatorBlock(context.Background(), &pb.KYCUpdateOperatorParam{
+		Admin:    mock.Address().String(),
+		Operator: mock.Address().String(),
+		Action:   "invalid",
+	}); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestKYCAPI_GetStatus(t *testing.T) {
+	clear, l, cfgFile := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	k := NewKYCAPI(cfgFile, l)
+	if _, err := k.GetAdmin(context.Background(), nil); err == nil {
+		t.Fatal()
+	}
+	if r, _ := k.GetStatusCount(context.Background(), nil); r.GetValue() != 0 {
+		t.Fatal()
+	}
+
+	admin := &abi.KYCAdminAccount{
+		Account: mock.Address(),
+		Comment: "admin",
+		Valid:   true,
+	}
+	adminData, err := admin.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := &abi.KYCStatus{
+		ChainAddress: mock.Address(),
+		Status:       "KYC_STATUS_APPROVED",
+		Valid:        true,
+	}
+	statusData, err := ks.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addKYCTestData(t, l, [][]byte{
+		statedb.PovCreateContractLocalStateKey(abi.KYCDataAdmin, admin.Account.Bytes()),
+		statedb.PovCreateContractLocalStateKey(abi.KYCDataStatus, ks.ChainAddress.Bytes()),
+	}, [][]byte{adminData, statusData}, 10)
+
+	if r, err := k.GetAdmin(context.Background(), nil); err != nil || r.GetAccount() != admin.Account.String() || r.GetComment() != admin.Comment {
+		t.Fatal(err, r)
+	}
+	if r, _ := k.GetStatusCount(context.Background(), nil); r.GetValue() != 1 {
+		t.Fatal()
+	}
+	if r, err := k.GetStatus(context.Background(), &pb.Offset{Count: 10}); err != nil || len(r.GetInfos()) != 1 ||
+		r.GetInfos()[0].GetChainAddress() != ks.ChainAddress.String() {
+		t.Fatal(err, r)
+	}
+	if r, err := k.GetStatusByChainAddress(context.Background(), toAddress(ks.ChainAddress)); err != nil || r.GetStatus() != ks.Status {
+		t.Fatal(err, r)
+	}
+	if _, err := k.GetStatusByTradeAddress(context.Background(), toString("invalid")); err == nil {
+		t.Fatal()
+	}
+	if r, err := k.GetTradeAddress(context.Background(), toAddress(ks.ChainAddress)); err == nil && len(r.GetTradeAddress()) != 0 {
+		t.Fatal(r)
+	}
+	if r, _ := k.GetOperatorCount(context.Background(), nil); r.GetValue() != 0 {
+		t.Fatal()
+	}
+	if r, err := k.GetOperator(context.Background(), &pb.Offset{Count: 10}); err == nil && len(r.GetOperators()) != 0 {
+		t.Fatal(r)
+	}
+}
@@ -30,6 +30,7 @@ type LedgerAPI struct {
 	ledger *api.LedgerAPI
 	store  ledger.Store
 	pubsub *api.BlockSubscription
+	vmLogs *api.VmLogSubscription
 	logger *zap.SugaredLogger
 }
 
@@ -38,6 +39,7 @@ func NewLedgerApi(ctx context.Context, l ledger.Store, eb event.EventBus, cc *ch
 		store:  l,
 		ledger: api.NewLedgerApi(ctx, l, eb, cc),
 		pubsub: api.NewBlockSubscription(ctx, eb),
+		vmLogs: api.NewVmLogSubscription(ctx, eb),
 		logger: log.NewLogger("grpc_ledger"),
 	}
 
@@ -608,6 +610,18 @@ func (l *LedgerAPI) Process(ctx context.Context, block *pbtypes.StateBlock) (*pb
 	return toHash(r), nil
 }
 
+func (l *LedgerAPI) GetLogs(ctx context.Context, para *pb.VmLogFilter) (*pb.VmLogEntries, error) {
+	filter, err := toOriginVmLogFilter(para)
+	if err != nil {
+		return nil, err
+	}
+	r, err := l.ledger.GetLogs(filter)
+	if err != nil {
+		return nil, err
+	}
+	return toVmLogEntries(r), nil
+}
+
 func randomIDGenerator() func() string {
 	seed, err := binary.ReadVarint(bufio.NewReader(crand.Reader))
 	if err != nil {
@@ -832,6 +846,30 @@ func (l *LedgerAPI) NewPending(addr *pbtypes.Address, srv pb.LedgerAPI_NewPendin
 	return nil
 }
 
+func (l *LedgerAPI) NewLogs(para *pb.VmLogFilter, srv pb.LedgerAPI_NewLogsServer) error {
+	filter, err := toOriginVmLogFilter(para)
+	if err != nil {
+		return err
+	}
+	id := getReqId()
+	l.logger.Infof("subscription logs done, %s", id)
+	ch := l.vmLogs.AddChan(id, filter)
+	defer l.vmLogs.RemoveChan(id)
+
+	for {
+		select {
+		case entry := <-ch:
+			if err := srv.Send(toVmLogEntry(entry)); err != nil {
+				l.logger.Errorf("notify logs error: %s", err)
+				return err
+			}
+		case <-srv.Context().Done():
+			l.logger.Infof("subscription logs finished, %s ", id)
+			return nil
+		}
+	}
+}
+
 func toAPIBlock(blk *api.APIBlock) *pb.APIBlock {
 	return &pb.APIBlock{
 		Type:           blk.GetType().String(),
@@ -865,6 +903,8 @@ func toAPIBlock(blk *api.APIBlock) *pb.APIBlock {
 		Hash:             toHashValue(blk.Hash),
 		PovConfirmHeight: blk.PovConfirmHeight,
 		PovConfirmCount:  blk.PovConfirmCount,
+		Fee:              toBalanceValue(blk.GetFee()),
+		MultiSig:         blk.MultiSig,
 	}
 }
 
@@ -985,3 +1025,69 @@ func toTokenInfos(tokens []*types.TokenInfo) *pbtypes.TokenInfos {
 	}
 	return &pbtypes.TokenInfos{TokenInfos: ts}
 }
+
+// Logs
+
+func toOriginVmLogFilter(para *pb.VmLogFilter) (*ledger.VmLogFilter, error) {
+	addresses, err := toOriginAddressesByValues(para.GetAddresses())
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := toOriginAddressesByValues(para.GetAccounts())
+	if err != nil {
+		return nil, err
+	}
+	filter := &ledger.VmLogFilter{
+		Addresses: addresses,
+		Accounts:  accounts,
+		Topics:    make([][]types.Hash, 0),
+	}
+	for _, hs := range para.GetTopics() {
+		topics, err := toOriginHashes(hs)
+		if err != nil {
+			return nil, err
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+	if filter.BlockHash, err = toOriginHashPointByValue(para.GetBlockHash()); err != nil {
+		return nil, err
+	}
+	if filter.FromBlock, err = toOriginHashPointByValue(para.GetFromBlock()); err != nil {
+		return nil, err
+	}
+	if filter.ToBlock, err = toOriginHashPointByValue(para.GetToBlock()); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func toOriginHashPointByValue(hash string) (*types.Hash, error) {
+	if hash == "" {
+		return nil, nil
+	}
+	h, err := toOriginHashByValue(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func toVmLogEntry(entry *ledger.VmLogEntry) *pb.VmLogEntry {
+	return &pb.VmLogEntry{
+		Address:   toAddressValue(entry.Address),
+		Topics:    toHashesValues(entry.Topics),
+		Data:      entry.Data,
+		Account:   toAddressValue(entry.Account),
+		BlockHash: toHashValue(entry.BlockHash),
+		Timestamp: entry.Timestamp,
+		LogIndex:  int32(entry.LogIndex),
+	}
+}
+
+func toVmLogEntries(entries []*ledger.VmLogEntry) *pb.VmLogEntries {
+	logs := make([]*pb.VmLogEntry, 0)
+	for _, entry := range entries {
+		logs = append(logs, toVmLogEntry(entry))
+	}
+	return &pb.VmLogEntries{Logs: logs}
+}
@@ -20,6 +20,7 @@ import (
 
 	qlcchainctx "github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/topic"
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/ledger"
@@ -986,3 +987,94 @@ func (s *baseStream) RecvMsg(m interface{}) error {
 //		cs[c] = i
 //	}
 //}
+
+func TestLedgerAPI_GetLogs(t *testing.T) {
+	teardownTestCase, l, ledgerApi := setupMockLedgerAPI(t)
+	defer teardownTestCase(t)
+
+	addr := mock.Address()
+	logTopic := mock.Hash()
+	blk := mock.Hash()
+	filter := &ledger.VmLogFilter{
+		Addresses: []types.Address{addr},
+		Accounts:  []types.Address{},
+		Topics:    [][]types.Hash{{logTopic}},
+		FromBlock: &blk,
+	}
+	entries := []*ledger.VmLogEntry{{Address: addr, Topics: []types.Hash{logTopic}, BlockHash: blk}}
+	l.On("GetLogs", filter).Return(entries, nil)
+
+	r, err := ledgerApi.GetLogs(context.Background(), &pb.VmLogFilter{
+		Addresses: []string{toAddressValue(addr)},
+		Topics:    []*pbtypes.Hashes{toHashes([]types.Hash{logTopic})},
+		FromBlock: toHashValue(blk),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.GetLogs()) != 1 || r.GetLogs()[0].GetBlockHash() != toHashValue(blk) || r.GetLogs()[0].GetTopics()[0] != toHashValue(logTopic) {
+		t.Fatal(r)
+	}
+
+	if _, err := ledgerApi.GetLogs(context.Background(), &pb.VmLogFilter{BlockHash: "invalid"}); err == nil {
+		t.Fatal("invalid block hash should fail")
+	}
+}
+
+func TestLedgerAPI_NewLogs(t *testing.T) {
+	dir := filepath.Join(config.QlcTestDataDir(), "api", uuid.New().String())
+	cm := config.NewCfgManager(dir)
+	_, _ = cm.Load()
+	cc := qlcchainctx.NewChainContext(cm.ConfigFile)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	ledgerApi := NewLedgerApi(context.Background(), new(mocks.Store), cc.EventBus(), cc)
+
+	addr := mock.Address()
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := &ledgerAPINewLogsServer{ServerStream: new(baseStream), ctx: ctx, logs: make(chan *pb.VmLogEntry, 1)}
+	done := make(chan error)
+	go func() {
+		done <- ledgerApi.NewLogs(&pb.VmLogFilter{Addresses: []string{toAddressValue(addr)}}, srv)
+	}()
+
+	entry := &ledger.VmLogEntry{Address: addr, BlockHash: mock.Hash()}
+	received := false
+	for i := 0; i < 50 && !received; i++ {
+		cc.EventBus().Publish(topic.EventNewVmLogs, []*ledger.VmLogEntry{{Address: mock.Address()}, entry})
+		select {
+		case r := <-srv.logs:
+			if r.GetBlockHash() != toHashValue(entry.BlockHash) {
+				t.Fatal(r)
+			}
+			received = true
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	if !received {
+		t.Fatal("log not received")
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+type ledgerAPINewLogsServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	logs chan *pb.VmLogEntry
+}
+
+func (x *ledgerAPINewLogsServer) Context() context.Context {
+	return x.ctx
+}
+
+func (x *ledgerAPINewLogsServer) Send(m *pb.VmLogEntry) error {
+	select {
+	case x.logs <- m:
+	default:
+	}
+	return nil
+}
@@ -78,10 +78,17 @@ func (n *NetAPI) PeersCount(context.Context, *empty.Empty) (*pb.PeersCountRespon
 func (n *NetAPI) GetBandwidthStats(context.Context, *empty.Empty) (*pb.EventBandwidthStats, error) {
 	r := n.net.GetBandwidthStats()
 	return &pb.EventBandwidthStats{
-		TotalIn:  r.TotalIn,
-		TotalOut: r.TotalOut,
-		RateIn:   r.RateIn,
-		RateOut:  r.RateOut,
+		TotalIn:         r.TotalIn,
+		TotalOut:        r.TotalOut,
+		RateIn:          r.RateIn,
+		RateOut:         r.RateOut,
+		CompressedIn:    r.CompressedIn,
+		CompressedOut:   r.CompressedOut,
+		UncompressedIn:  r.UncompressedIn,
+		UncompressedOut: r.UncompressedOut,
+		Throttled:       r.Throttled,
+		ThrottledBytes:  r.ThrottledBytes,
+		Dropped:         r.Dropped,
 	}, nil
 }
 
@@ -180,10 +180,17 @@ func TestNetApi_GetBandwidthStats(t *testing.T) {
 	teardownTestCase, _, eb, netApi := setupTestCaseNet(t)
 	defer teardownTestCase(t)
 	bwState := &topic.EventBandwidthStats{
-		TotalIn:  100000,
-		TotalOut: 200000,
-		RateIn:   10000,
-		RateOut:  20000,
+		TotalIn:         100000,
+		TotalOut:        200000,
+		RateIn:          10000,
+		RateOut:         20000,
+		CompressedIn:    3000,
+		CompressedOut:   4000,
+		UncompressedIn:  9000,
+		UncompressedOut: 12000,
+		Throttled:       30,
+		ThrottledBytes:  5000,
+		Dropped:         7,
 	}
 	eb.Publish(topic.EventGetBandwidthStats, bwState)
 	time.Sleep(100 * time.Millisecond)
@@ -194,6 +201,12 @@ func TestNetApi_GetBandwidthStats(t *testing.T) {
 	if bs.RateIn != 10000 || bs.RateOut != 20000 || bs.TotalIn != 100000 || bs.TotalOut != 200000 {
 		t.Fatal("bandWith stat error")
 	}
+	if bs.CompressedIn != 3000 || bs.CompressedOut != 4000 || bs.UncompressedIn != 9000 || bs.UncompressedOut != 12000 {
+		t.Fatal("compression stat error")
+	}
+	if bs.Throttled != 30 || bs.ThrottledBytes != 5000 || bs.Dropped != 7 {
+		t.Fatal("throttling stat error")
+	}
 }
 
 func TestNetApi_Syncing(t *testing.T) {
@@ -560,13 +560,18 @@ func (s *SettlementAPI) GetAsset(ctx context.Context, param *pbtypes.Address) (*
 func toOriginCreateContractParamOfAbi(param *pbtypes.CreateContractParam) (*cabi.CreateContractParam, error) {
 	services := make([]cabi.ContractService, 0)
 	for _, c := range param.GetServices() {
+		price, err := toOriginMoneyByValue(c.GetUnitPrice())
+		if err != nil {
+			return nil, err
+		}
 		ct := cabi.ContractService{
 			ServiceId:   c.GetServiceId(),
 			Mcc:         c.GetMcc(),
 			Mnc:         c.GetMnc(),
 			TotalAmount: c.GetTotalAmount(),
-			UnitPrice:   c.GetUnitPrice(),
+			UnitPrice:   price,
 			Currency:    c.GetCurrency(),
+			Kind:        cabi.CDRKind(c.GetKind()),
 		}
 		services = append(services, ct)
 	}
@@ -603,13 +608,18 @@ func toOriginCreateContractParamOfAbi(param *pbtypes.CreateContractParam) (*cabi
 func toOriginCreateContractParam(param *pb.CreateContractParam) (*api.CreateContractParam, error) {
 	services := make([]cabi.ContractService, 0)
 	for _, c := range param.GetServices() {
+		price, err := toOriginMoneyByValue(c.GetUnitPrice())
+		if err != nil {
+			return nil, err
+		}
 		ct := cabi.ContractService{
 			ServiceId:   c.GetServiceId(),
 			Mcc:         c.GetMcc(),
 			Mnc:         c.GetMnc(),
 			TotalAmount: c.GetTotalAmount(),
-			UnitPrice:   c.GetUnitPrice(),
+			UnitPrice:   price,
 			Currency:    c.GetCurrency(),
+			Kind:        cabi.CDRKind(c.GetKind()),
 		}
 		services = append(services, ct)
 	}
@@ -735,8 +745,9 @@ func toSettlementContract(cs *api.SettlementContract) *pb.SettlementContract {
 				Mcc:         s.Mcc,
 				Mnc:         s.Mnc,
 				TotalAmount: s.TotalAmount,
-				UnitPrice:   s.UnitPrice,
+				UnitPrice:   toMoneyValue(s.UnitPrice),
 				Currency:    s.Currency,
+				Kind:        int32(s.Kind),
 			}
 			services = append(services, st)
 		}
@@ -768,6 +779,21 @@ func toOriginCDRParams(params []*pbtypes.CDRParam) ([]*cabi.CDRParam, error) {
 			DlrStatus:     cabi.DLRStatus(p.GetDlrStatus()),
 			PreStop:       p.GetPreStop(),
 			NextStop:      p.GetNextStop(),
+			Kind:          cabi.CDRKind(p.GetKind()),
+		}
+		if v := p.GetVoice(); v != nil {
+			r.Voice = &cabi.VoiceCDR{
+				Duration:     v.GetDuration(),
+				AnswerStatus: cabi.AnswerStatus(v.GetAnswerStatus()),
+				Increment:    v.GetIncrement(),
+			}
+		}
+		if d := p.GetData(); d != nil {
+			r.Data = &cabi.DataCDR{
+				SessionId: d.GetSessionId(),
+				Volume:    d.GetVolume(),
+				Increment: d.GetIncrement(),
+			}
 		}
 		rs = append(rs, r)
 	}
@@ -815,6 +841,21 @@ func toCDRStatus(cs *api.CDRStatus) *pb.CDRStatus {
 					DlrStatus:     int32(param.DlrStatus),
 					PreStop:       param.PreStop,
 					NextStop:      param.NextStop,
+					Kind:          int32(param.Kind),
+				}
+				if param.Voice != nil {
+					pt.Voice = &pbtypes.VoiceCDR{
+						Duration:     param.Voice.Duration,
+						AnswerStatus: int32(param.Voice.AnswerStatus),
+						Increment:    param.Voice.Increment,
+					}
+				}
+				if param.Data != nil {
+					pt.Data = &pbtypes.DataCDR{
+						SessionId: param.Data.SessionId,
+						Volume:    param.Data.Volume,
+						Increment: param.Data.Increment,
+					}
 				}
 				pts = append(pts, pt)
 			}
@@ -842,6 +883,7 @@ func toSummaryRecord(s *cabi.SummaryRecord) *pbtypes.SummaryRecord {
 		Success: s.Success,
 		Fail:    s.Fail,
 		Result:  s.Result,
+		Units:   s.Units,
 	}
 }
 
@@ -872,9 +914,14 @@ func toInvoiceRecords(rs []*cabi.InvoiceRecord) *pb.InvoiceRecords {
 			MCC:                      r.MCC,
 			MNC:                      r.MNC,
 			Currency:                 r.Currency,
-			UnitPrice:                r.UnitPrice,
+			UnitPrice:                toMoneyValue(r.UnitPrice),
+			Kind:                     int32(r.Kind),
 			SumOfBillableSMSCustomer: r.SumOfBillableSMSCustomer,
-			SumOfTOTPrice:            r.SumOfTOTPrice,
+			SumOfBillableUnits:       r.SumOfBillableUnits,
+			SumOfTOTPrice:            toMoneyValue(r.SumOfTOTPrice),
+			SLAResults:               toSLAResults(r.SLAResults),
+			Compensation:             toMoneyValue(r.Compensation),
+			SumOfNetPrice:            toMoneyValue(r.SumOfNetPrice),
 		}
 		records = append(records, rt)
 	}
@@ -883,6 +930,23 @@ func toInvoiceRecords(rs []*cabi.InvoiceRecord) *pb.InvoiceRecords {
 	}
 }
 
+func toSLAResults(rs []*cabi.SLAResult) []*pbtypes.SLAResult {
+	results := make([]*pbtypes.SLAResult, 0)
+	for _, r := range rs {
+		results = append(results, &pbtypes.SLAResult{
+			Type:     int32(r.SLAType),
+			Priority: int32(r.Priority),
+			Target:   r.Target,
+			Achieved: r.Achieved,
+			Breached: r.Breached,
+			Rate:     r.Rate,
+			Credit:   toMoneyValue(r.Credit),
+			Currency: r.Credit.Currency(),
+		})
+	}
+	return results
+}
+
 func toMultiPartySummaryResult(r *cabi.MultiPartySummaryResult) *pbtypes.MultiPartySummaryResult {
 	return &pbtypes.MultiPartySummaryResult{
 		Contracts: nil,
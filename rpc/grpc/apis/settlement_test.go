@@ -119,14 +119,14 @@ func buildContactParam(addr1, addr2 types.Address, name1, name2 string) *api.Cre
 			Mcc:         1,
 			Mnc:         2,
 			TotalAmount: 10,
-			UnitPrice:   2,
+			UnitPrice:   types.NewMoney(2, 0, ""),
 			Currency:    "USD",
 		}, {
 			ServiceId:   mock.Hash().String(),
 			Mcc:         22,
 			Mnc:         1,
 			TotalAmount: 30,
-			UnitPrice:   4,
+			UnitPrice:   types.NewMoney(4, 0, ""),
 			Currency:    "USD",
 		}},
 		StartDate: time.Now().AddDate(0, 0, -1).Unix(),
@@ -142,7 +142,7 @@ func toCreateContractParamOfAbi(param *cabi.CreateContractParam) *pbtypes.Create
 			Mcc:         c.Mcc,
 			Mnc:         c.Mnc,
 			TotalAmount: c.TotalAmount,
-			UnitPrice:   c.UnitPrice,
+			UnitPrice:   toMoneyValue(c.UnitPrice),
 			Currency:    c.Currency,
 		}
 		services = append(services, ct)
@@ -173,7 +173,7 @@ func toCreateContractParam(param *api.CreateContractParam) *pb.CreateContractPar
 			Mcc:         c.Mcc,
 			Mnc:         c.Mnc,
 			TotalAmount: c.TotalAmount,
-			UnitPrice:   c.UnitPrice,
+			UnitPrice:   toMoneyValue(c.UnitPrice),
 			Currency:    c.Currency,
 		}
 		services = append(services, ct)
@@ -786,3 +786,28 @@ func TestSettlementAPI_GetRegisterAssetBlock(t *testing.T) {
 		}
 	}
 }
+
+func Test_toInvoiceRecords(t *testing.T) {
+	r := toInvoiceRecords([]*cabi.InvoiceRecord{
+		{
+			SumOfTOTPrice: types.NewMoney(10000, 2, "USD"),
+			SLAResults: []*cabi.SLAResult{
+				{SLAType: cabi.SLATypeDeliveredRate, Priority: 1, Target: 0.95, Achieved: 0.85, Breached: true, Rate: 5,
+					Credit: types.NewMoney(500, 2, "USD")},
+			},
+			Compensation:  types.NewMoney(500, 2, "USD"),
+			SumOfNetPrice: types.NewMoney(9500, 2, "USD"),
+		},
+	})
+	if len(r.GetRecords()) != 1 {
+		t.Fatal("invalid records")
+	}
+	record := r.GetRecords()[0]
+	if record.GetSumOfNetPrice() != "95.00" || record.GetCompensation() != "5.00" || len(record.GetSLAResults()) != 1 {
+		t.Fatal("invalid invoice", record)
+	}
+	if sla := record.GetSLAResults()[0]; sla.GetType() != int32(cabi.SLATypeDeliveredRate) || !sla.GetBreached() || sla.GetCredit() != "5.00" ||
+		sla.GetCurrency() != "USD" {
+		t.Fatal("invalid sla result", sla)
+	}
+}
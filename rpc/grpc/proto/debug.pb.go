@@ -0,0 +1,2981 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.7.1
+// source: debug.proto
+
+package proto
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	types "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type DebugCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count map[string]uint64 `protobuf:"bytes,1,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *DebugCount) Reset() {
+	*x = DebugCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugCount) ProtoMessage() {}
+
+func (x *DebugCount) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugCount.ProtoReflect.Descriptor instead.
+func (*DebugCount) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DebugCount) GetCount() map[string]uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type ActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionType int32 `protobuf:"varint,1,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	T          int32 `protobuf:"varint,2,opt,name=t,proto3" json:"t,omitempty"`
+}
+
+func (x *ActionRequest) Reset() {
+	*x = ActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionRequest) ProtoMessage() {}
+
+func (x *ActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionRequest.ProtoReflect.Descriptor instead.
+func (*ActionRequest) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActionRequest) GetActionType() int32 {
+	if x != nil {
+		return x.ActionType
+	}
+	return 0
+}
+
+func (x *ActionRequest) GetT() int32 {
+	if x != nil {
+		return x.T
+	}
+	return 0
+}
+
+type BlockLinkRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links map[string]string `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BlockLinkRsp) Reset() {
+	*x = BlockLinkRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockLinkRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockLinkRsp) ProtoMessage() {}
+
+func (x *BlockLinkRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockLinkRsp.ProtoReflect.Descriptor instead.
+func (*BlockLinkRsp) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockLinkRsp) GetLinks() map[string]string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type BlockLinksRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links map[string]*types.Hashes `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BlockLinksRsp) Reset() {
+	*x = BlockLinksRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockLinksRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockLinksRsp) ProtoMessage() {}
+
+func (x *BlockLinksRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockLinksRsp.ProtoReflect.Descriptor instead.
+func (*BlockLinksRsp) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockLinksRsp) GetLinks() map[string]*types.Hashes {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type BlocksCountByTypeRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count map[string]int64 `protobuf:"bytes,1,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *BlocksCountByTypeRsp) Reset() {
+	*x = BlocksCountByTypeRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlocksCountByTypeRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlocksCountByTypeRsp) ProtoMessage() {}
+
+func (x *BlocksCountByTypeRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlocksCountByTypeRsp.ProtoReflect.Descriptor instead.
+func (*BlocksCountByTypeRsp) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BlocksCountByTypeRsp) GetCount() map[string]int64 {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type APIPendingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Hash      string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Source    string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Amount    int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type      string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	TokenName string `protobuf:"bytes,6,opt,name=tokenName,proto3" json:"tokenName,omitempty"`
+	Timestamp int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Used      bool   `protobuf:"varint,8,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *APIPendingInfo) Reset() {
+	*x = APIPendingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIPendingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIPendingInfo) ProtoMessage() {}
+
+func (x *APIPendingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIPendingInfo.ProtoReflect.Descriptor instead.
+func (*APIPendingInfo) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *APIPendingInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *APIPendingInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *APIPendingInfo) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *APIPendingInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *APIPendingInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *APIPendingInfo) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
+func (x *APIPendingInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *APIPendingInfo) GetUsed() bool {
+	if x != nil {
+		return x.Used
+	}
+	return false
+}
+
+type TokenPendingsAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amounts map[string]int64 `protobuf:"bytes,1,rep,name=amounts,proto3" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *TokenPendingsAmount) Reset() {
+	*x = TokenPendingsAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenPendingsAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPendingsAmount) ProtoMessage() {}
+
+func (x *TokenPendingsAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenPendingsAmount.ProtoReflect.Descriptor instead.
+func (*TokenPendingsAmount) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TokenPendingsAmount) GetAmounts() map[string]int64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type PendingsAmountRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pendings map[string]*TokenPendingsAmount `protobuf:"bytes,1,rep,name=pendings,proto3" json:"pendings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PendingsAmountRsp) Reset() {
+	*x = PendingsAmountRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingsAmountRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingsAmountRsp) ProtoMessage() {}
+
+func (x *PendingsAmountRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingsAmountRsp.ProtoReflect.Descriptor instead.
+func (*PendingsAmountRsp) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PendingsAmountRsp) GetPendings() map[string]*TokenPendingsAmount {
+	if x != nil {
+		return x.Pendings
+	}
+	return nil
+}
+
+type RepAckStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeartCount uint64 `protobuf:"varint,1,opt,name=heartCount,proto3" json:"heartCount,omitempty"`
+	VoteCount  uint64 `protobuf:"varint,2,opt,name=voteCount,proto3" json:"voteCount,omitempty"`
+}
+
+func (x *RepAckStatistics) Reset() {
+	*x = RepAckStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepAckStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepAckStatistics) ProtoMessage() {}
+
+func (x *RepAckStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepAckStatistics.ProtoReflect.Descriptor instead.
+func (*RepAckStatistics) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RepAckStatistics) GetHeartCount() uint64 {
+	if x != nil {
+		return x.HeartCount
+	}
+	return 0
+}
+
+func (x *RepAckStatistics) GetVoteCount() uint64 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+type RepOnlinePeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period     uint64                       `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	Statistics map[string]*RepAckStatistics `protobuf:"bytes,2,rep,name=statistics,proto3" json:"statistics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BlockCount uint64                       `protobuf:"varint,3,opt,name=blockCount,proto3" json:"blockCount,omitempty"`
+}
+
+func (x *RepOnlinePeriod) Reset() {
+	*x = RepOnlinePeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepOnlinePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepOnlinePeriod) ProtoMessage() {}
+
+func (x *RepOnlinePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepOnlinePeriod.ProtoReflect.Descriptor instead.
+func (*RepOnlinePeriod) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RepOnlinePeriod) GetPeriod() uint64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *RepOnlinePeriod) GetStatistics() map[string]*RepAckStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *RepOnlinePeriod) GetBlockCount() uint64 {
+	if x != nil {
+		return x.BlockCount
+	}
+	return 0
+}
+
+type RepOnlinePeriods struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Periods map[uint64]*RepOnlinePeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RepOnlinePeriods) Reset() {
+	*x = RepOnlinePeriods{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepOnlinePeriods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepOnlinePeriods) ProtoMessage() {}
+
+func (x *RepOnlinePeriods) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepOnlinePeriods.ProtoReflect.Descriptor instead.
+func (*RepOnlinePeriods) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RepOnlinePeriods) GetPeriods() map[uint64]*RepOnlinePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type DebugInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info map[string]string `protobuf:"bytes,1,rep,name=info,proto3" json:"info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DebugInfo) Reset() {
+	*x = DebugInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugInfo) ProtoMessage() {}
+
+func (x *DebugInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugInfo.ProtoReflect.Descriptor instead.
+func (*DebugInfo) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DebugInfo) GetInfo() map[string]string {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type CacheStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Key   int32  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Block int32  `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Start string `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	Span  string `protobuf:"bytes,5,opt,name=span,proto3" json:"span,omitempty"`
+}
+
+func (x *CacheStat) Reset() {
+	*x = CacheStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStat) ProtoMessage() {}
+
+func (x *CacheStat) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStat.ProtoReflect.Descriptor instead.
+func (*CacheStat) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CacheStat) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CacheStat) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *CacheStat) GetBlock() int32 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *CacheStat) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CacheStat) GetSpan() string {
+	if x != nil {
+		return x.Span
+	}
+	return ""
+}
+
+type CacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*CacheStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CacheStats) GetStats() []*CacheStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type CacheStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status map[string]string `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *CacheStatus) Reset() {
+	*x = CacheStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStatus) ProtoMessage() {}
+
+func (x *CacheStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStatus.ProtoReflect.Descriptor instead.
+func (*CacheStatus) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CacheStatus) GetStatus() map[string]string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type UncheckInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	GapType   string `protobuf:"bytes,2,opt,name=gapType,proto3" json:"gapType,omitempty"`
+	GapHash   string `protobuf:"bytes,3,opt,name=gapHash,proto3" json:"gapHash,omitempty"`
+	GapHeight uint64 `protobuf:"varint,4,opt,name=gapHeight,proto3" json:"gapHeight,omitempty"`
+}
+
+func (x *UncheckInfo) Reset() {
+	*x = UncheckInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UncheckInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncheckInfo) ProtoMessage() {}
+
+func (x *UncheckInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncheckInfo.ProtoReflect.Descriptor instead.
+func (*UncheckInfo) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UncheckInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *UncheckInfo) GetGapType() string {
+	if x != nil {
+		return x.GapType
+	}
+	return ""
+}
+
+func (x *UncheckInfo) GetGapHash() string {
+	if x != nil {
+		return x.GapHash
+	}
+	return ""
+}
+
+func (x *UncheckInfo) GetGapHeight() uint64 {
+	if x != nil {
+		return x.GapHeight
+	}
+	return 0
+}
+
+type UncheckInfos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Infos []*UncheckInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+}
+
+func (x *UncheckInfos) Reset() {
+	*x = UncheckInfos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UncheckInfos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncheckInfos) ProtoMessage() {}
+
+func (x *UncheckInfos) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncheckInfos.ProtoReflect.Descriptor instead.
+func (*UncheckInfos) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UncheckInfos) GetInfos() []*UncheckInfo {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+type APIUncheckBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block       *types.StateBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Hash        string            `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Link        string            `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	UncheckType string            `protobuf:"bytes,4,opt,name=uncheckType,proto3" json:"uncheckType,omitempty"`
+	SyncType    int32             `protobuf:"varint,5,opt,name=syncType,proto3" json:"syncType,omitempty"`
+	PovHeight   uint64            `protobuf:"varint,6,opt,name=povHeight,proto3" json:"povHeight,omitempty"`
+}
+
+func (x *APIUncheckBlock) Reset() {
+	*x = APIUncheckBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIUncheckBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIUncheckBlock) ProtoMessage() {}
+
+func (x *APIUncheckBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIUncheckBlock.ProtoReflect.Descriptor instead.
+func (*APIUncheckBlock) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *APIUncheckBlock) GetBlock() *types.StateBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *APIUncheckBlock) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *APIUncheckBlock) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *APIUncheckBlock) GetUncheckType() string {
+	if x != nil {
+		return x.UncheckType
+	}
+	return ""
+}
+
+func (x *APIUncheckBlock) GetSyncType() int32 {
+	if x != nil {
+		return x.SyncType
+	}
+	return 0
+}
+
+func (x *APIUncheckBlock) GetPovHeight() uint64 {
+	if x != nil {
+		return x.PovHeight
+	}
+	return 0
+}
+
+type APIUncheckBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*APIUncheckBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *APIUncheckBlocks) Reset() {
+	*x = APIUncheckBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIUncheckBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIUncheckBlocks) ProtoMessage() {}
+
+func (x *APIUncheckBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIUncheckBlocks.ProtoReflect.Descriptor instead.
+func (*APIUncheckBlocks) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *APIUncheckBlocks) GetBlocks() []*APIUncheckBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type UncheckBlocksCountRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count map[string]int64 `protobuf:"bytes,1,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *UncheckBlocksCountRsp) Reset() {
+	*x = UncheckBlocksCountRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UncheckBlocksCountRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncheckBlocksCountRsp) ProtoMessage() {}
+
+func (x *UncheckBlocksCountRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncheckBlocksCountRsp.ProtoReflect.Descriptor instead.
+func (*UncheckBlocksCountRsp) Descriptor() ([]byte, []int) {
+	return file_debug_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UncheckBlocksCountRsp) GetCount() map[string]int64 {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+var File_debug_proto protoreflect.FileDescriptor
+
+var file_debug_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x11, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a,
+	0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x0d, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x74, 0x22, 0x7e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x73, 0x70, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x1a,
+	0x38, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x73, 0x70,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x69, 0x6e,
+	0x6b, 0x73, 0x1a, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x14,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x73, 0x70,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a,
+	0x0e, 0x41, 0x50, 0x49, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x64, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x11, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x12, 0x42,
+	0x0a, 0x08, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x1a, 0x57, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x10, 0x52,
+	0x65, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe9, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x70, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x56, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x70, 0x41, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x70, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x3e,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x1a, 0x52,
+	0x0a, 0x0c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x74, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a,
+	0x37, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x09, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x61,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6e, 0x22, 0x34, 0x0a,
+	0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73, 0x0a, 0x0b, 0x55, 0x6e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x70, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x70, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x61, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x67, 0x61, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x38, 0x0a, 0x0c, 0x55,
+	0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x69,
+	0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x69, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x55, 0x6e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x73, 0x79, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x76, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f, 0x76,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x41, 0x50, 0x49, 0x55, 0x6e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x50, 0x49, 0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x55,
+	0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73,
+	0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xc5, 0x13,
+	0x0a, 0x08, 0x44, 0x65, 0x62, 0x75, 0x67, 0x41, 0x50, 0x49, 0x12, 0x5c, 0x0a, 0x0f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x12, 0x0d, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x47, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x73, 0x70, 0x22,
+	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x4a, 0x0a, 0x0a, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x73, 0x70, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x13, 0x12, 0x11, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x61, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x73, 0x70, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x59, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x59, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x50, 0x49, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x61, 0x0a, 0x0e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70,
+	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x53, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x51, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x76, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65, 0x74,
+	0x50, 0x6f, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x50, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x6e,
+	0x65, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x73, 0x70, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72,
+	0x66, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x2f, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x66, 0x12, 0x53, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x66, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x50, 0x65,
+	0x72, 0x66, 0x12, 0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67,
+	0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12,
+	0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a, 0x0f,
+	0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x75, 0x6e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x4d, 0x0a, 0x0c,
+	0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x1b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x75,
+	0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5e, 0x0a, 0x0d, 0x55,
+	0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x50, 0x49,
+	0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x75, 0x6e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x6d, 0x0a, 0x12, 0x55,
+	0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
+	0x19, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x0d, 0x46, 0x65,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15,
+	0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_debug_proto_rawDescOnce sync.Once
+	file_debug_proto_rawDescData = file_debug_proto_rawDesc
+)
+
+func file_debug_proto_rawDescGZIP() []byte {
+	file_debug_proto_rawDescOnce.Do(func() {
+		file_debug_proto_rawDescData = protoimpl.X.CompressGZIP(file_debug_proto_rawDescData)
+	})
+	return file_debug_proto_rawDescData
+}
+
+var file_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_debug_proto_goTypes = []interface{}{
+	(*DebugCount)(nil),            // 0: proto.DebugCount
+	(*ActionRequest)(nil),         // 1: proto.ActionRequest
+	(*BlockLinkRsp)(nil),          // 2: proto.BlockLinkRsp
+	(*BlockLinksRsp)(nil),         // 3: proto.BlockLinksRsp
+	(*BlocksCountByTypeRsp)(nil),  // 4: proto.BlocksCountByTypeRsp
+	(*APIPendingInfo)(nil),        // 5: proto.APIPendingInfo
+	(*TokenPendingsAmount)(nil),   // 6: proto.TokenPendingsAmount
+	(*PendingsAmountRsp)(nil),     // 7: proto.PendingsAmountRsp
+	(*RepAckStatistics)(nil),      // 8: proto.RepAckStatistics
+	(*RepOnlinePeriod)(nil),       // 9: proto.RepOnlinePeriod
+	(*RepOnlinePeriods)(nil),      // 10: proto.RepOnlinePeriods
+	(*DebugInfo)(nil),             // 11: proto.DebugInfo
+	(*CacheStat)(nil),             // 12: proto.CacheStat
+	(*CacheStats)(nil),            // 13: proto.CacheStats
+	(*CacheStatus)(nil),           // 14: proto.CacheStatus
+	(*UncheckInfo)(nil),           // 15: proto.UncheckInfo
+	(*UncheckInfos)(nil),          // 16: proto.UncheckInfos
+	(*APIUncheckBlock)(nil),       // 17: proto.APIUncheckBlock
+	(*APIUncheckBlocks)(nil),      // 18: proto.APIUncheckBlocks
+	(*UncheckBlocksCountRsp)(nil), // 19: proto.UncheckBlocksCountRsp
+	nil,                           // 20: proto.DebugCount.CountEntry
+	nil,                           // 21: proto.BlockLinkRsp.LinksEntry
+	nil,                           // 22: proto.BlockLinksRsp.LinksEntry
+	nil,                           // 23: proto.BlocksCountByTypeRsp.CountEntry
+	nil,                           // 24: proto.TokenPendingsAmount.AmountsEntry
+	nil,                           // 25: proto.PendingsAmountRsp.PendingsEntry
+	nil,                           // 26: proto.RepOnlinePeriod.StatisticsEntry
+	nil,                           // 27: proto.RepOnlinePeriods.PeriodsEntry
+	nil,                           // 28: proto.DebugInfo.InfoEntry
+	nil,                           // 29: proto.CacheStatus.StatusEntry
+	nil,                           // 30: proto.UncheckBlocksCountRsp.CountEntry
+	(*types.StateBlock)(nil),      // 31: types.StateBlock
+	(*types.Hashes)(nil),          // 32: types.Hashes
+	(*empty.Empty)(nil),           // 33: google.protobuf.Empty
+	(*types.Hash)(nil),            // 34: types.Hash
+	(*String)(nil),                // 35: proto.String
+	(*types.Address)(nil),         // 36: types.Address
+	(*types.PendingKey)(nil),      // 37: types.PendingKey
+	(*Int32)(nil),                 // 38: proto.Int32
+	(*APIRepresentative)(nil),     // 39: proto.APIRepresentative
+	(*Int64)(nil),                 // 40: proto.Int64
+}
+var file_debug_proto_depIdxs = []int32{
+	20, // 0: proto.DebugCount.count:type_name -> proto.DebugCount.CountEntry
+	21, // 1: proto.BlockLinkRsp.links:type_name -> proto.BlockLinkRsp.LinksEntry
+	22, // 2: proto.BlockLinksRsp.links:type_name -> proto.BlockLinksRsp.LinksEntry
+	23, // 3: proto.BlocksCountByTypeRsp.count:type_name -> proto.BlocksCountByTypeRsp.CountEntry
+	24, // 4: proto.TokenPendingsAmount.amounts:type_name -> proto.TokenPendingsAmount.AmountsEntry
+	25, // 5: proto.PendingsAmountRsp.pendings:type_name -> proto.PendingsAmountRsp.PendingsEntry
+	26, // 6: proto.RepOnlinePeriod.statistics:type_name -> proto.RepOnlinePeriod.StatisticsEntry
+	27, // 7: proto.RepOnlinePeriods.periods:type_name -> proto.RepOnlinePeriods.PeriodsEntry
+	28, // 8: proto.DebugInfo.info:type_name -> proto.DebugInfo.InfoEntry
+	12, // 9: proto.CacheStats.stats:type_name -> proto.CacheStat
+	29, // 10: proto.CacheStatus.status:type_name -> proto.CacheStatus.StatusEntry
+	15, // 11: proto.UncheckInfos.infos:type_name -> proto.UncheckInfo
+	31, // 12: proto.APIUncheckBlock.block:type_name -> types.StateBlock
+	17, // 13: proto.APIUncheckBlocks.blocks:type_name -> proto.APIUncheckBlock
+	30, // 14: proto.UncheckBlocksCountRsp.count:type_name -> proto.UncheckBlocksCountRsp.CountEntry
+	32, // 15: proto.BlockLinksRsp.LinksEntry.value:type_name -> types.Hashes
+	6,  // 16: proto.PendingsAmountRsp.PendingsEntry.value:type_name -> proto.TokenPendingsAmount
+	8,  // 17: proto.RepOnlinePeriod.StatisticsEntry.value:type_name -> proto.RepAckStatistics
+	9,  // 18: proto.RepOnlinePeriods.PeriodsEntry.value:type_name -> proto.RepOnlinePeriod
+	33, // 19: proto.DebugAPI.BlockCacheCount:input_type -> google.protobuf.Empty
+	33, // 20: proto.DebugAPI.BlockCaches:input_type -> google.protobuf.Empty
+	1,  // 21: proto.DebugAPI.Action:input_type -> proto.ActionRequest
+	34, // 22: proto.DebugAPI.BlockLink:input_type -> types.Hash
+	34, // 23: proto.DebugAPI.BlockLinks:input_type -> types.Hash
+	35, // 24: proto.DebugAPI.BlocksCountByType:input_type -> proto.String
+	33, // 25: proto.DebugAPI.GetSyncBlockNum:input_type -> google.protobuf.Empty
+	36, // 26: proto.DebugAPI.Representative:input_type -> types.Address
+	37, // 27: proto.DebugAPI.AccountPending:input_type -> types.PendingKey
+	33, // 28: proto.DebugAPI.PendingsAmount:input_type -> google.protobuf.Empty
+	33, // 29: proto.DebugAPI.PendingsCount:input_type -> google.protobuf.Empty
+	33, // 30: proto.DebugAPI.GetOnlineInfo:input_type -> google.protobuf.Empty
+	33, // 31: proto.DebugAPI.GetPovInfo:input_type -> google.protobuf.Empty
+	33, // 32: proto.DebugAPI.NewBlock:input_type -> google.protobuf.Empty
+	33, // 33: proto.DebugAPI.ContractCount:input_type -> google.protobuf.Empty
+	33, // 34: proto.DebugAPI.GetConsInfo:input_type -> google.protobuf.Empty
+	38, // 35: proto.DebugAPI.SetConsPerf:input_type -> proto.Int32
+	33, // 36: proto.DebugAPI.GetConsPerf:input_type -> google.protobuf.Empty
+	33, // 37: proto.DebugAPI.GetCache:input_type -> google.protobuf.Empty
+	33, // 38: proto.DebugAPI.GetCacheStat:input_type -> google.protobuf.Empty
+	33, // 39: proto.DebugAPI.GetCacheStatus:input_type -> google.protobuf.Empty
+	33, // 40: proto.DebugAPI.UncheckAnalysis:input_type -> google.protobuf.Empty
+	34, // 41: proto.DebugAPI.UncheckBlock:input_type -> types.Hash
+	33, // 42: proto.DebugAPI.UncheckBlocks:input_type -> google.protobuf.Empty
+	33, // 43: proto.DebugAPI.UncheckBlocksCount:input_type -> google.protobuf.Empty
+	33, // 44: proto.DebugAPI.FeedConsensus:input_type -> google.protobuf.Empty
+	33, // 45: proto.DebugAPI.DebugConsensus:input_type -> google.protobuf.Empty
+	33, // 46: proto.DebugAPI.GetPrivacyInfo:input_type -> google.protobuf.Empty
+	0,  // 47: proto.DebugAPI.BlockCacheCount:output_type -> proto.DebugCount
+	32, // 48: proto.DebugAPI.BlockCaches:output_type -> types.Hashes
+	35, // 49: proto.DebugAPI.Action:output_type -> proto.String
+	2,  // 50: proto.DebugAPI.BlockLink:output_type -> proto.BlockLinkRsp
+	3,  // 51: proto.DebugAPI.BlockLinks:output_type -> proto.BlockLinksRsp
+	4,  // 52: proto.DebugAPI.BlocksCountByType:output_type -> proto.BlocksCountByTypeRsp
+	0,  // 53: proto.DebugAPI.GetSyncBlockNum:output_type -> proto.DebugCount
+	39, // 54: proto.DebugAPI.Representative:output_type -> proto.APIRepresentative
+	5,  // 55: proto.DebugAPI.AccountPending:output_type -> proto.APIPendingInfo
+	7,  // 56: proto.DebugAPI.PendingsAmount:output_type -> proto.PendingsAmountRsp
+	40, // 57: proto.DebugAPI.PendingsCount:output_type -> proto.Int64
+	10, // 58: proto.DebugAPI.GetOnlineInfo:output_type -> proto.RepOnlinePeriods
+	11, // 59: proto.DebugAPI.GetPovInfo:output_type -> proto.DebugInfo
+	31, // 60: proto.DebugAPI.NewBlock:output_type -> types.StateBlock
+	4,  // 61: proto.DebugAPI.ContractCount:output_type -> proto.BlocksCountByTypeRsp
+	11, // 62: proto.DebugAPI.GetConsInfo:output_type -> proto.DebugInfo
+	11, // 63: proto.DebugAPI.SetConsPerf:output_type -> proto.DebugInfo
+	11, // 64: proto.DebugAPI.GetConsPerf:output_type -> proto.DebugInfo
+	33, // 65: proto.DebugAPI.GetCache:output_type -> google.protobuf.Empty
+	13, // 66: proto.DebugAPI.GetCacheStat:output_type -> proto.CacheStats
+	14, // 67: proto.DebugAPI.GetCacheStatus:output_type -> proto.CacheStatus
+	16, // 68: proto.DebugAPI.UncheckAnalysis:output_type -> proto.UncheckInfos
+	16, // 69: proto.DebugAPI.UncheckBlock:output_type -> proto.UncheckInfos
+	18, // 70: proto.DebugAPI.UncheckBlocks:output_type -> proto.APIUncheckBlocks
+	19, // 71: proto.DebugAPI.UncheckBlocksCount:output_type -> proto.UncheckBlocksCountRsp
+	33, // 72: proto.DebugAPI.FeedConsensus:output_type -> google.protobuf.Empty
+	33, // 73: proto.DebugAPI.DebugConsensus:output_type -> google.protobuf.Empty
+	11, // 74: proto.DebugAPI.GetPrivacyInfo:output_type -> proto.DebugInfo
+	47, // [47:75] is the sub-list for method output_type
+	19, // [19:47] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_debug_proto_init() }
+func file_debug_proto_init() {
+	if File_debug_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_ledger_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_debug_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockLinkRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockLinksRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlocksCountByTypeRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIPendingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenPendingsAmount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingsAmountRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepAckStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepOnlinePeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepOnlinePeriods); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UncheckInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UncheckInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIUncheckBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIUncheckBlocks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UncheckBlocksCountRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_debug_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_debug_proto_goTypes,
+		DependencyIndexes: file_debug_proto_depIdxs,
+		MessageInfos:      file_debug_proto_msgTypes,
+	}.Build()
+	File_debug_proto = out.File
+	file_debug_proto_rawDesc = nil
+	file_debug_proto_goTypes = nil
+	file_debug_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DebugAPIClient is the client API for DebugAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugAPIClient interface {
+	BlockCacheCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugCount, error)
+	BlockCaches(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*types.Hashes, error)
+	Action(ctx context.Context, in *ActionRequest, opts ...grpc.CallOption) (*String, error)
+	BlockLink(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*BlockLinkRsp, error)
+	BlockLinks(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*BlockLinksRsp, error)
+	BlocksCountByType(ctx context.Context, in *String, opts ...grpc.CallOption) (*BlocksCountByTypeRsp, error)
+	GetSyncBlockNum(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugCount, error)
+	Representative(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*APIRepresentative, error)
+	AccountPending(ctx context.Context, in *types.PendingKey, opts ...grpc.CallOption) (*APIPendingInfo, error)
+	PendingsAmount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingsAmountRsp, error)
+	PendingsCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Int64, error)
+	GetOnlineInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RepOnlinePeriods, error)
+	GetPovInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error)
+	NewBlock(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (DebugAPI_NewBlockClient, error)
+	ContractCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlocksCountByTypeRsp, error)
+	GetConsInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error)
+	SetConsPerf(ctx context.Context, in *Int32, opts ...grpc.CallOption) (*DebugInfo, error)
+	GetConsPerf(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error)
+	GetCache(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetCacheStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CacheStats, error)
+	GetCacheStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CacheStatus, error)
+	UncheckAnalysis(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UncheckInfos, error)
+	UncheckBlock(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*UncheckInfos, error)
+	UncheckBlocks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*APIUncheckBlocks, error)
+	UncheckBlocksCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UncheckBlocksCountRsp, error)
+	FeedConsensus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	DebugConsensus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetPrivacyInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error)
+}
+
+type debugAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebugAPIClient(cc grpc.ClientConnInterface) DebugAPIClient {
+	return &debugAPIClient{cc}
+}
+
+func (c *debugAPIClient) BlockCacheCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugCount, error) {
+	out := new(DebugCount)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/BlockCacheCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) BlockCaches(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*types.Hashes, error) {
+	out := new(types.Hashes)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/BlockCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) Action(ctx context.Context, in *ActionRequest, opts ...grpc.CallOption) (*String, error) {
+	out := new(String)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/Action", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) BlockLink(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*BlockLinkRsp, error) {
+	out := new(BlockLinkRsp)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/BlockLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) BlockLinks(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*BlockLinksRsp, error) {
+	out := new(BlockLinksRsp)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/BlockLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) BlocksCountByType(ctx context.Context, in *String, opts ...grpc.CallOption) (*BlocksCountByTypeRsp, error) {
+	out := new(BlocksCountByTypeRsp)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/BlocksCountByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetSyncBlockNum(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugCount, error) {
+	out := new(DebugCount)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetSyncBlockNum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) Representative(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*APIRepresentative, error) {
+	out := new(APIRepresentative)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/Representative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) AccountPending(ctx context.Context, in *types.PendingKey, opts ...grpc.CallOption) (*APIPendingInfo, error) {
+	out := new(APIPendingInfo)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/AccountPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) PendingsAmount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingsAmountRsp, error) {
+	out := new(PendingsAmountRsp)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/PendingsAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) PendingsCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Int64, error) {
+	out := new(Int64)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/PendingsCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetOnlineInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RepOnlinePeriods, error) {
+	out := new(RepOnlinePeriods)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetOnlineInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetPovInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error) {
+	out := new(DebugInfo)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetPovInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) NewBlock(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (DebugAPI_NewBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DebugAPI_serviceDesc.Streams[0], "/proto.DebugAPI/NewBlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugAPINewBlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DebugAPI_NewBlockClient interface {
+	Recv() (*types.StateBlock, error)
+	grpc.ClientStream
+}
+
+type debugAPINewBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugAPINewBlockClient) Recv() (*types.StateBlock, error) {
+	m := new(types.StateBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugAPIClient) ContractCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlocksCountByTypeRsp, error) {
+	out := new(BlocksCountByTypeRsp)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/ContractCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetConsInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error) {
+	out := new(DebugInfo)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetConsInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) SetConsPerf(ctx context.Context, in *Int32, opts ...grpc.CallOption) (*DebugInfo, error) {
+	out := new(DebugInfo)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/SetConsPerf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetConsPerf(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error) {
+	out := new(DebugInfo)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetConsPerf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetCache(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetCacheStat(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CacheStats, error) {
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetCacheStat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetCacheStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CacheStatus, error) {
+	out := new(CacheStatus)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetCacheStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) UncheckAnalysis(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UncheckInfos, error) {
+	out := new(UncheckInfos)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/UncheckAnalysis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) UncheckBlock(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*UncheckInfos, error) {
+	out := new(UncheckInfos)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/UncheckBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) UncheckBlocks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*APIUncheckBlocks, error) {
+	out := new(APIUncheckBlocks)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/UncheckBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) UncheckBlocksCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UncheckBlocksCountRsp, error) {
+	out := new(UncheckBlocksCountRsp)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/UncheckBlocksCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) FeedConsensus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/FeedConsensus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) DebugConsensus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/DebugConsensus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugAPIClient) GetPrivacyInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugInfo, error) {
+	out := new(DebugInfo)
+	err := c.cc.Invoke(ctx, "/proto.DebugAPI/GetPrivacyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugAPIServer is the server API for DebugAPI service.
+type DebugAPIServer interface {
+	BlockCacheCount(context.Context, *empty.Empty) (*DebugCount, error)
+	BlockCaches(context.Context, *empty.Empty) (*types.Hashes, error)
+	Action(context.Context, *ActionRequest) (*String, error)
+	BlockLink(context.Context, *types.Hash) (*BlockLinkRsp, error)
+	BlockLinks(context.Context, *types.Hash) (*BlockLinksRsp, error)
+	BlocksCountByType(context.Context, *String) (*BlocksCountByTypeRsp, error)
+	GetSyncBlockNum(context.Context, *empty.Empty) (*DebugCount, error)
+	Representative(context.Context, *types.Address) (*APIRepresentative, error)
+	AccountPending(context.Context, *types.PendingKey) (*APIPendingInfo, error)
+	PendingsAmount(context.Context, *empty.Empty) (*PendingsAmountRsp, error)
+	PendingsCount(context.Context, *empty.Empty) (*Int64, error)
+	GetOnlineInfo(context.Context, *empty.Empty) (*RepOnlinePeriods, error)
+	GetPovInfo(context.Context, *empty.Empty) (*DebugInfo, error)
+	NewBlock(*empty.Empty, DebugAPI_NewBlockServer) error
+	ContractCount(context.Context, *empty.Empty) (*BlocksCountByTypeRsp, error)
+	GetConsInfo(context.Context, *empty.Empty) (*DebugInfo, error)
+	SetConsPerf(context.Context, *Int32) (*DebugInfo, error)
+	GetConsPerf(context.Context, *empty.Empty) (*DebugInfo, error)
+	GetCache(context.Context, *empty.Empty) (*empty.Empty, error)
+	GetCacheStat(context.Context, *empty.Empty) (*CacheStats, error)
+	GetCacheStatus(context.Context, *empty.Empty) (*CacheStatus, error)
+	UncheckAnalysis(context.Context, *empty.Empty) (*UncheckInfos, error)
+	UncheckBlock(context.Context, *types.Hash) (*UncheckInfos, error)
+	UncheckBlocks(context.Context, *empty.Empty) (*APIUncheckBlocks, error)
+	UncheckBlocksCount(context.Context, *empty.Empty) (*UncheckBlocksCountRsp, error)
+	FeedConsensus(context.Context, *empty.Empty) (*empty.Empty, error)
+	DebugConsensus(context.Context, *empty.Empty) (*empty.Empty, error)
+	GetPrivacyInfo(context.Context, *empty.Empty) (*DebugInfo, error)
+}
+
+// UnimplementedDebugAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugAPIServer struct {
+}
+
+func (*UnimplementedDebugAPIServer) BlockCacheCount(context.Context, *empty.Empty) (*DebugCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockCacheCount not implemented")
+}
+func (*UnimplementedDebugAPIServer) BlockCaches(context.Context, *empty.Empty) (*types.Hashes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockCaches not implemented")
+}
+func (*UnimplementedDebugAPIServer) Action(context.Context, *ActionRequest) (*String, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Action not implemented")
+}
+func (*UnimplementedDebugAPIServer) BlockLink(context.Context, *types.Hash) (*BlockLinkRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockLink not implemented")
+}
+func (*UnimplementedDebugAPIServer) BlockLinks(context.Context, *types.Hash) (*BlockLinksRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockLinks not implemented")
+}
+func (*UnimplementedDebugAPIServer) BlocksCountByType(context.Context, *String) (*BlocksCountByTypeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlocksCountByType not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetSyncBlockNum(context.Context, *empty.Empty) (*DebugCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncBlockNum not implemented")
+}
+func (*UnimplementedDebugAPIServer) Representative(context.Context, *types.Address) (*APIRepresentative, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Representative not implemented")
+}
+func (*UnimplementedDebugAPIServer) AccountPending(context.Context, *types.PendingKey) (*APIPendingInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountPending not implemented")
+}
+func (*UnimplementedDebugAPIServer) PendingsAmount(context.Context, *empty.Empty) (*PendingsAmountRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingsAmount not implemented")
+}
+func (*UnimplementedDebugAPIServer) PendingsCount(context.Context, *empty.Empty) (*Int64, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingsCount not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetOnlineInfo(context.Context, *empty.Empty) (*RepOnlinePeriods, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnlineInfo not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetPovInfo(context.Context, *empty.Empty) (*DebugInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPovInfo not implemented")
+}
+func (*UnimplementedDebugAPIServer) NewBlock(*empty.Empty, DebugAPI_NewBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method NewBlock not implemented")
+}
+func (*UnimplementedDebugAPIServer) ContractCount(context.Context, *empty.Empty) (*BlocksCountByTypeRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCount not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetConsInfo(context.Context, *empty.Empty) (*DebugInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsInfo not implemented")
+}
+func (*UnimplementedDebugAPIServer) SetConsPerf(context.Context, *Int32) (*DebugInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsPerf not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetConsPerf(context.Context, *empty.Empty) (*DebugInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsPerf not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetCache(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCache not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetCacheStat(context.Context, *empty.Empty) (*CacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStat not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetCacheStatus(context.Context, *empty.Empty) (*CacheStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStatus not implemented")
+}
+func (*UnimplementedDebugAPIServer) UncheckAnalysis(context.Context, *empty.Empty) (*UncheckInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncheckAnalysis not implemented")
+}
+func (*UnimplementedDebugAPIServer) UncheckBlock(context.Context, *types.Hash) (*UncheckInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncheckBlock not implemented")
+}
+func (*UnimplementedDebugAPIServer) UncheckBlocks(context.Context, *empty.Empty) (*APIUncheckBlocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncheckBlocks not implemented")
+}
+func (*UnimplementedDebugAPIServer) UncheckBlocksCount(context.Context, *empty.Empty) (*UncheckBlocksCountRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncheckBlocksCount not implemented")
+}
+func (*UnimplementedDebugAPIServer) FeedConsensus(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedConsensus not implemented")
+}
+func (*UnimplementedDebugAPIServer) DebugConsensus(context.Context, *empty.Empty) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugConsensus not implemented")
+}
+func (*UnimplementedDebugAPIServer) GetPrivacyInfo(context.Context, *empty.Empty) (*DebugInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacyInfo not implemented")
+}
+
+func RegisterDebugAPIServer(s *grpc.Server, srv DebugAPIServer) {
+	s.RegisterService(&_DebugAPI_serviceDesc, srv)
+}
+
+func _DebugAPI_BlockCacheCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).BlockCacheCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/BlockCacheCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).BlockCacheCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_BlockCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).BlockCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/BlockCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).BlockCaches(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_Action_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).Action(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/Action",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).Action(ctx, req.(*ActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_BlockLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).BlockLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/BlockLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).BlockLink(ctx, req.(*types.Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_BlockLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).BlockLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/BlockLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).BlockLinks(ctx, req.(*types.Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_BlocksCountByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).BlocksCountByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/BlocksCountByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).BlocksCountByType(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetSyncBlockNum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetSyncBlockNum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetSyncBlockNum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetSyncBlockNum(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_Representative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).Representative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/Representative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).Representative(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_AccountPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.PendingKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).AccountPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/AccountPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).AccountPending(ctx, req.(*types.PendingKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_PendingsAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).PendingsAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/PendingsAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).PendingsAmount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_PendingsCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).PendingsCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/PendingsCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).PendingsCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetOnlineInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetOnlineInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetOnlineInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetOnlineInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetPovInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetPovInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetPovInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetPovInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_NewBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugAPIServer).NewBlock(m, &debugAPINewBlockServer{stream})
+}
+
+type DebugAPI_NewBlockServer interface {
+	Send(*types.StateBlock) error
+	grpc.ServerStream
+}
+
+type debugAPINewBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugAPINewBlockServer) Send(m *types.StateBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DebugAPI_ContractCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).ContractCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/ContractCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).ContractCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetConsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetConsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetConsInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetConsInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_SetConsPerf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Int32)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).SetConsPerf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/SetConsPerf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).SetConsPerf(ctx, req.(*Int32))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetConsPerf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetConsPerf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetConsPerf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetConsPerf(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetCache(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetCacheStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetCacheStat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetCacheStat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetCacheStat(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetCacheStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetCacheStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetCacheStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetCacheStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_UncheckAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).UncheckAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/UncheckAnalysis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).UncheckAnalysis(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_UncheckBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).UncheckBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/UncheckBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).UncheckBlock(ctx, req.(*types.Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_UncheckBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).UncheckBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/UncheckBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).UncheckBlocks(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_UncheckBlocksCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).UncheckBlocksCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/UncheckBlocksCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).UncheckBlocksCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_FeedConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).FeedConsensus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/FeedConsensus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).FeedConsensus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_DebugConsensus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).DebugConsensus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/DebugConsensus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).DebugConsensus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DebugAPI_GetPrivacyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugAPIServer).GetPrivacyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DebugAPI/GetPrivacyInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugAPIServer).GetPrivacyInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DebugAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.DebugAPI",
+	HandlerType: (*DebugAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockCacheCount",
+			Handler:    _DebugAPI_BlockCacheCount_Handler,
+		},
+		{
+			MethodName: "BlockCaches",
+			Handler:    _DebugAPI_BlockCaches_Handler,
+		},
+		{
+			MethodName: "Action",
+			Handler:    _DebugAPI_Action_Handler,
+		},
+		{
+			MethodName: "BlockLink",
+			Handler:    _DebugAPI_BlockLink_Handler,
+		},
+		{
+			MethodName: "BlockLinks",
+			Handler:    _DebugAPI_BlockLinks_Handler,
+		},
+		{
+			MethodName: "BlocksCountByType",
+			Handler:    _DebugAPI_BlocksCountByType_Handler,
+		},
+		{
+			MethodName: "GetSyncBlockNum",
+			Handler:    _DebugAPI_GetSyncBlockNum_Handler,
+		},
+		{
+			MethodName: "Representative",
+			Handler:    _DebugAPI_Representative_Handler,
+		},
+		{
+			MethodName: "AccountPending",
+			Handler:    _DebugAPI_AccountPending_Handler,
+		},
+		{
+			MethodName: "PendingsAmount",
+			Handler:    _DebugAPI_PendingsAmount_Handler,
+		},
+		{
+			MethodName: "PendingsCount",
+			Handler:    _DebugAPI_PendingsCount_Handler,
+		},
+		{
+			MethodName: "GetOnlineInfo",
+			Handler:    _DebugAPI_GetOnlineInfo_Handler,
+		},
+		{
+			MethodName: "GetPovInfo",
+			Handler:    _DebugAPI_GetPovInfo_Handler,
+		},
+		{
+			MethodName: "ContractCount",
+			Handler:    _DebugAPI_ContractCount_Handler,
+		},
+		{
+			MethodName: "GetConsInfo",
+			Handler:    _DebugAPI_GetConsInfo_Handler,
+		},
+		{
+			MethodName: "SetConsPerf",
+			Handler:    _DebugAPI_SetConsPerf_Handler,
+		},
+		{
+			MethodName: "GetConsPerf",
+			Handler:    _DebugAPI_GetConsPerf_Handler,
+		},
+		{
+			MethodName: "GetCache",
+			Handler:    _DebugAPI_GetCache_Handler,
+		},
+		{
+			MethodName: "GetCacheStat",
+			Handler:    _DebugAPI_GetCacheStat_Handler,
+		},
+		{
+			MethodName: "GetCacheStatus",
+			Handler:    _DebugAPI_GetCacheStatus_Handler,
+		},
+		{
+			MethodName: "UncheckAnalysis",
+			Handler:    _DebugAPI_UncheckAnalysis_Handler,
+		},
+		{
+			MethodName: "UncheckBlock",
+			Handler:    _DebugAPI_UncheckBlock_Handler,
+		},
+		{
+			MethodName: "UncheckBlocks",
+			Handler:    _DebugAPI_UncheckBlocks_Handler,
+		},
+		{
+			MethodName: "UncheckBlocksCount",
+			Handler:    _DebugAPI_UncheckBlocksCount_Handler,
+		},
+		{
+			MethodName: "FeedConsensus",
+			Handler:    _DebugAPI_FeedConsensus_Handler,
+		},
+		{
+			MethodName: "DebugConsensus",
+			Handler:    _DebugAPI_DebugConsensus_Handler,
+		},
+		{
+			MethodName: "GetPrivacyInfo",
+			Handler:    _DebugAPI_GetPrivacyInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "NewBlock",
+			Handler:       _DebugAPI_NewBlock_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "debug.proto",
+}
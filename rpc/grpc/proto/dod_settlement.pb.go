@@ -0,0 +1,8114 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.7.1
+// source: dod_settlement.proto
+
+package proto
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	types "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type DoDSettleUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DoDSettleUser) Reset() {
+	*x = DoDSettleUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleUser) ProtoMessage() {}
+
+func (x *DoDSettleUser) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleUser.ProtoReflect.Descriptor instead.
+func (*DoDSettleUser) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DoDSettleUser) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DoDSettleUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DoDSettleConnectionDynamicParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId        string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId     string `protobuf:"bytes,2,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	ItemId         string `protobuf:"bytes,3,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	OrderItemId    string `protobuf:"bytes,4,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	QuoteId        string `protobuf:"bytes,5,opt,name=quoteId,proto3" json:"quoteId,omitempty"`
+	QuoteItemId    string `protobuf:"bytes,6,opt,name=quoteItemId,proto3" json:"quoteItemId,omitempty"`
+	ConnectionName string `protobuf:"bytes,7,opt,name=connectionName,proto3" json:"connectionName,omitempty"`
+	PaymentType    string `protobuf:"bytes,8,opt,name=paymentType,proto3" json:"paymentType,omitempty"`
+	BillingType    string `protobuf:"bytes,9,opt,name=billingType,proto3" json:"billingType,omitempty"`
+	Currency       string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	ServiceClass   string `protobuf:"bytes,11,opt,name=serviceClass,proto3" json:"serviceClass,omitempty"`
+	Bandwidth      string `protobuf:"bytes,12,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	BillingUnit    string `protobuf:"bytes,13,opt,name=billingUnit,proto3" json:"billingUnit,omitempty"`
+	Price          string `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`
+	Addition       string `protobuf:"bytes,15,opt,name=addition,proto3" json:"addition,omitempty"`
+	StartTime      int64  `protobuf:"varint,16,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StartTimeStr   string `protobuf:"bytes,17,opt,name=startTimeStr,proto3" json:"startTimeStr,omitempty"`
+	EndTime        int64  `protobuf:"varint,18,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	EndTimeStr     string `protobuf:"bytes,19,opt,name=endTimeStr,proto3" json:"endTimeStr,omitempty"`
+}
+
+func (x *DoDSettleConnectionDynamicParam) Reset() {
+	*x = DoDSettleConnectionDynamicParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleConnectionDynamicParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleConnectionDynamicParam) ProtoMessage() {}
+
+func (x *DoDSettleConnectionDynamicParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleConnectionDynamicParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleConnectionDynamicParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetQuoteItemId() string {
+	if x != nil {
+		return x.QuoteItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetServiceClass() string {
+	if x != nil {
+		return x.ServiceClass
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetBandwidth() string {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetBillingUnit() string {
+	if x != nil {
+		return x.BillingUnit
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetAddition() string {
+	if x != nil {
+		return x.Addition
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetStartTimeStr() string {
+	if x != nil {
+		return x.StartTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleConnectionDynamicParam) GetEndTimeStr() string {
+	if x != nil {
+		return x.EndTimeStr
+	}
+	return ""
+}
+
+type DoDSettleConnectionParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuyerProductId    string `protobuf:"bytes,1,opt,name=buyerProductId,proto3" json:"buyerProductId,omitempty"`
+	ProductOfferingId string `protobuf:"bytes,2,opt,name=productOfferingId,proto3" json:"productOfferingId,omitempty"`
+	ProductId         string `protobuf:"bytes,3,opt,name=productId,proto3" json:"productId,omitempty"`
+	SrcCompanyName    string `protobuf:"bytes,4,opt,name=srcCompanyName,proto3" json:"srcCompanyName,omitempty"`
+	SrcRegion         string `protobuf:"bytes,5,opt,name=srcRegion,proto3" json:"srcRegion,omitempty"`
+	SrcCity           string `protobuf:"bytes,6,opt,name=srcCity,proto3" json:"srcCity,omitempty"`
+	SrcDataCenter     string `protobuf:"bytes,7,opt,name=srcDataCenter,proto3" json:"srcDataCenter,omitempty"`
+	SrcPort           string `protobuf:"bytes,8,opt,name=srcPort,proto3" json:"srcPort,omitempty"`
+	DstCompanyName    string `protobuf:"bytes,9,opt,name=dstCompanyName,proto3" json:"dstCompanyName,omitempty"`
+	DstRegion         string `protobuf:"bytes,10,opt,name=dstRegion,proto3" json:"dstRegion,omitempty"`
+	DstCity           string `protobuf:"bytes,11,opt,name=dstCity,proto3" json:"dstCity,omitempty"`
+	DstDataCenter     string `protobuf:"bytes,12,opt,name=dstDataCenter,proto3" json:"dstDataCenter,omitempty"`
+	DstPort           string `protobuf:"bytes,13,opt,name=dstPort,proto3" json:"dstPort,omitempty"`
+	OrderId           string `protobuf:"bytes,14,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId        string `protobuf:"bytes,15,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	ItemId            string `protobuf:"bytes,16,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	OrderItemId       string `protobuf:"bytes,17,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	QuoteId           string `protobuf:"bytes,18,opt,name=quoteId,proto3" json:"quoteId,omitempty"`
+	QuoteItemId       string `protobuf:"bytes,19,opt,name=quoteItemId,proto3" json:"quoteItemId,omitempty"`
+	ConnectionName    string `protobuf:"bytes,20,opt,name=connectionName,proto3" json:"connectionName,omitempty"`
+	PaymentType       string `protobuf:"bytes,21,opt,name=paymentType,proto3" json:"paymentType,omitempty"`
+	BillingType       string `protobuf:"bytes,22,opt,name=billingType,proto3" json:"billingType,omitempty"`
+	Currency          string `protobuf:"bytes,23,opt,name=currency,proto3" json:"currency,omitempty"`
+	ServiceClass      string `protobuf:"bytes,24,opt,name=serviceClass,proto3" json:"serviceClass,omitempty"`
+	Bandwidth         string `protobuf:"bytes,25,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	BillingUnit       string `protobuf:"bytes,26,opt,name=billingUnit,proto3" json:"billingUnit,omitempty"`
+	Price             string `protobuf:"bytes,27,opt,name=price,proto3" json:"price,omitempty"`
+	Addition          string `protobuf:"bytes,28,opt,name=addition,proto3" json:"addition,omitempty"`
+	StartTime         int64  `protobuf:"varint,29,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StartTimeStr      string `protobuf:"bytes,30,opt,name=startTimeStr,proto3" json:"startTimeStr,omitempty"`
+	EndTime           int64  `protobuf:"varint,31,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	EndTimeStr        string `protobuf:"bytes,32,opt,name=endTimeStr,proto3" json:"endTimeStr,omitempty"`
+}
+
+func (x *DoDSettleConnectionParam) Reset() {
+	*x = DoDSettleConnectionParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleConnectionParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleConnectionParam) ProtoMessage() {}
+
+func (x *DoDSettleConnectionParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleConnectionParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleConnectionParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DoDSettleConnectionParam) GetBuyerProductId() string {
+	if x != nil {
+		return x.BuyerProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetProductOfferingId() string {
+	if x != nil {
+		return x.ProductOfferingId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetSrcCompanyName() string {
+	if x != nil {
+		return x.SrcCompanyName
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetSrcRegion() string {
+	if x != nil {
+		return x.SrcRegion
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetSrcCity() string {
+	if x != nil {
+		return x.SrcCity
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetSrcDataCenter() string {
+	if x != nil {
+		return x.SrcDataCenter
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetSrcPort() string {
+	if x != nil {
+		return x.SrcPort
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetDstCompanyName() string {
+	if x != nil {
+		return x.DstCompanyName
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetDstRegion() string {
+	if x != nil {
+		return x.DstRegion
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetDstCity() string {
+	if x != nil {
+		return x.DstCity
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetDstDataCenter() string {
+	if x != nil {
+		return x.DstDataCenter
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetDstPort() string {
+	if x != nil {
+		return x.DstPort
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetQuoteItemId() string {
+	if x != nil {
+		return x.QuoteItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetServiceClass() string {
+	if x != nil {
+		return x.ServiceClass
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetBandwidth() string {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetBillingUnit() string {
+	if x != nil {
+		return x.BillingUnit
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetAddition() string {
+	if x != nil {
+		return x.Addition
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleConnectionParam) GetStartTimeStr() string {
+	if x != nil {
+		return x.StartTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionParam) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleConnectionParam) GetEndTimeStr() string {
+	if x != nil {
+		return x.EndTimeStr
+	}
+	return ""
+}
+
+type DoDSettleChangeConnectionParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductId      string `protobuf:"bytes,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	OrderId        string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId     string `protobuf:"bytes,3,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	ItemId         string `protobuf:"bytes,4,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	OrderItemId    string `protobuf:"bytes,5,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	QuoteId        string `protobuf:"bytes,6,opt,name=quoteId,proto3" json:"quoteId,omitempty"`
+	QuoteItemId    string `protobuf:"bytes,7,opt,name=quoteItemId,proto3" json:"quoteItemId,omitempty"`
+	ConnectionName string `protobuf:"bytes,8,opt,name=connectionName,proto3" json:"connectionName,omitempty"`
+	PaymentType    string `protobuf:"bytes,9,opt,name=paymentType,proto3" json:"paymentType,omitempty"`
+	BillingType    string `protobuf:"bytes,10,opt,name=billingType,proto3" json:"billingType,omitempty"`
+	Currency       string `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	ServiceClass   string `protobuf:"bytes,12,opt,name=serviceClass,proto3" json:"serviceClass,omitempty"`
+	Bandwidth      string `protobuf:"bytes,13,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	BillingUnit    string `protobuf:"bytes,14,opt,name=billingUnit,proto3" json:"billingUnit,omitempty"`
+	Price          string `protobuf:"bytes,15,opt,name=price,proto3" json:"price,omitempty"`
+	Addition       string `protobuf:"bytes,16,opt,name=addition,proto3" json:"addition,omitempty"`
+	StartTime      int64  `protobuf:"varint,17,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StartTimeStr   string `protobuf:"bytes,18,opt,name=startTimeStr,proto3" json:"startTimeStr,omitempty"`
+	EndTime        int64  `protobuf:"varint,19,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	EndTimeStr     string `protobuf:"bytes,20,opt,name=endTimeStr,proto3" json:"endTimeStr,omitempty"`
+}
+
+func (x *DoDSettleChangeConnectionParam) Reset() {
+	*x = DoDSettleChangeConnectionParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleChangeConnectionParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleChangeConnectionParam) ProtoMessage() {}
+
+func (x *DoDSettleChangeConnectionParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleChangeConnectionParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleChangeConnectionParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DoDSettleChangeConnectionParam) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetQuoteItemId() string {
+	if x != nil {
+		return x.QuoteItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetServiceClass() string {
+	if x != nil {
+		return x.ServiceClass
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetBandwidth() string {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetBillingUnit() string {
+	if x != nil {
+		return x.BillingUnit
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetAddition() string {
+	if x != nil {
+		return x.Addition
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleChangeConnectionParam) GetStartTimeStr() string {
+	if x != nil {
+		return x.StartTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeConnectionParam) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleChangeConnectionParam) GetEndTimeStr() string {
+	if x != nil {
+		return x.EndTimeStr
+	}
+	return ""
+}
+
+type DoDSettleCreateOrderParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer          *DoDSettleUser              `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller         *DoDSettleUser              `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Connections    []*DoDSettleConnectionParam `protobuf:"bytes,3,rep,name=connections,proto3" json:"connections,omitempty"`
+	PrivateFrom    string                      `protobuf:"bytes,4,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string                    `protobuf:"bytes,5,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string                      `protobuf:"bytes,6,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleCreateOrderParam) Reset() {
+	*x = DoDSettleCreateOrderParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleCreateOrderParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleCreateOrderParam) ProtoMessage() {}
+
+func (x *DoDSettleCreateOrderParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleCreateOrderParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleCreateOrderParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DoDSettleCreateOrderParam) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleCreateOrderParam) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleCreateOrderParam) GetConnections() []*DoDSettleConnectionParam {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *DoDSettleCreateOrderParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleCreateOrderParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleCreateOrderParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleResponseParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestHash    string   `protobuf:"bytes,1,opt,name=requestHash,proto3" json:"requestHash,omitempty"`
+	Action         string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	PrivateFrom    string   `protobuf:"bytes,3,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string `protobuf:"bytes,4,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string   `protobuf:"bytes,5,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleResponseParam) Reset() {
+	*x = DoDSettleResponseParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleResponseParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleResponseParam) ProtoMessage() {}
+
+func (x *DoDSettleResponseParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleResponseParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleResponseParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DoDSettleResponseParam) GetRequestHash() string {
+	if x != nil {
+		return x.RequestHash
+	}
+	return ""
+}
+
+func (x *DoDSettleResponseParam) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DoDSettleResponseParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleResponseParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleResponseParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleOrderItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId      string `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	OrderItemId string `protobuf:"bytes,2,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+}
+
+func (x *DoDSettleOrderItem) Reset() {
+	*x = DoDSettleOrderItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleOrderItem) ProtoMessage() {}
+
+func (x *DoDSettleOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleOrderItem.ProtoReflect.Descriptor instead.
+func (*DoDSettleOrderItem) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DoDSettleOrderItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderItem) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+type DoDSettleUpdateOrderInfoParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer          string                `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	InternalId     string                `protobuf:"bytes,2,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	OrderId        string                `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderItemId    []*DoDSettleOrderItem `protobuf:"bytes,4,rep,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	Status         string                `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	FailReason     string                `protobuf:"bytes,6,opt,name=failReason,proto3" json:"failReason,omitempty"`
+	PrivateFrom    string                `protobuf:"bytes,7,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string              `protobuf:"bytes,8,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string                `protobuf:"bytes,9,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) Reset() {
+	*x = DoDSettleUpdateOrderInfoParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleUpdateOrderInfoParam) ProtoMessage() {}
+
+func (x *DoDSettleUpdateOrderInfoParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleUpdateOrderInfoParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleUpdateOrderInfoParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetOrderItemId() []*DoDSettleOrderItem {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return nil
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetFailReason() string {
+	if x != nil {
+		return x.FailReason
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleUpdateOrderInfoParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleChangeOrderParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer          *DoDSettleUser                    `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller         *DoDSettleUser                    `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Connections    []*DoDSettleChangeConnectionParam `protobuf:"bytes,3,rep,name=connections,proto3" json:"connections,omitempty"`
+	PrivateFrom    string                            `protobuf:"bytes,4,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string                          `protobuf:"bytes,5,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string                            `protobuf:"bytes,6,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleChangeOrderParam) Reset() {
+	*x = DoDSettleChangeOrderParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleChangeOrderParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleChangeOrderParam) ProtoMessage() {}
+
+func (x *DoDSettleChangeOrderParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleChangeOrderParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleChangeOrderParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DoDSettleChangeOrderParam) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleChangeOrderParam) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleChangeOrderParam) GetConnections() []*DoDSettleChangeConnectionParam {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *DoDSettleChangeOrderParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleChangeOrderParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleChangeOrderParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleTerminateOrderParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer          *DoDSettleUser                    `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller         *DoDSettleUser                    `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Connections    []*DoDSettleChangeConnectionParam `protobuf:"bytes,3,rep,name=connections,proto3" json:"connections,omitempty"`
+	PrivateFrom    string                            `protobuf:"bytes,4,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string                          `protobuf:"bytes,5,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string                            `protobuf:"bytes,6,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleTerminateOrderParam) Reset() {
+	*x = DoDSettleTerminateOrderParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleTerminateOrderParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleTerminateOrderParam) ProtoMessage() {}
+
+func (x *DoDSettleTerminateOrderParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleTerminateOrderParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleTerminateOrderParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DoDSettleTerminateOrderParam) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleTerminateOrderParam) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleTerminateOrderParam) GetConnections() []*DoDSettleChangeConnectionParam {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *DoDSettleTerminateOrderParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleTerminateOrderParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleTerminateOrderParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleProductInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderItemId string `protobuf:"bytes,1,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	ProductId   string `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Active      bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *DoDSettleProductInfo) Reset() {
+	*x = DoDSettleProductInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleProductInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleProductInfo) ProtoMessage() {}
+
+func (x *DoDSettleProductInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleProductInfo.ProtoReflect.Descriptor instead.
+func (*DoDSettleProductInfo) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DoDSettleProductInfo) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleProductInfo) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleProductInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type DoDSettleUpdateProductInfoParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address        string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OrderId        string                  `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	ProductInfo    []*DoDSettleProductInfo `protobuf:"bytes,3,rep,name=productInfo,proto3" json:"productInfo,omitempty"`
+	PrivateFrom    string                  `protobuf:"bytes,4,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string                `protobuf:"bytes,5,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string                  `protobuf:"bytes,6,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleUpdateProductInfoParam) Reset() {
+	*x = DoDSettleUpdateProductInfoParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleUpdateProductInfoParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleUpdateProductInfoParam) ProtoMessage() {}
+
+func (x *DoDSettleUpdateProductInfoParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleUpdateProductInfoParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleUpdateProductInfoParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DoDSettleUpdateProductInfoParam) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateProductInfoParam) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateProductInfoParam) GetProductInfo() []*DoDSettleProductInfo {
+	if x != nil {
+		return x.ProductInfo
+	}
+	return nil
+}
+
+func (x *DoDSettleUpdateProductInfoParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleUpdateProductInfoParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleUpdateProductInfoParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleOrderLifeTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractState string `protobuf:"bytes,1,opt,name=contractState,proto3" json:"contractState,omitempty"`
+	OrderState    string `protobuf:"bytes,2,opt,name=orderState,proto3" json:"orderState,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Time          int64  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Hash          string `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *DoDSettleOrderLifeTrack) Reset() {
+	*x = DoDSettleOrderLifeTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleOrderLifeTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleOrderLifeTrack) ProtoMessage() {}
+
+func (x *DoDSettleOrderLifeTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleOrderLifeTrack.ProtoReflect.Descriptor instead.
+func (*DoDSettleOrderLifeTrack) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DoDSettleOrderLifeTrack) GetContractState() string {
+	if x != nil {
+		return x.ContractState
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderLifeTrack) GetOrderState() string {
+	if x != nil {
+		return x.OrderState
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderLifeTrack) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderLifeTrack) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *DoDSettleOrderLifeTrack) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type DoDSettleOrderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer         *DoDSettleUser              `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller        *DoDSettleUser              `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId       string                      `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId    string                      `protobuf:"bytes,4,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	OrderType     string                      `protobuf:"bytes,5,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	OrderState    string                      `protobuf:"bytes,6,opt,name=orderState,proto3" json:"orderState,omitempty"`
+	ContractState string                      `protobuf:"bytes,7,opt,name=contractState,proto3" json:"contractState,omitempty"`
+	Connections   []*DoDSettleConnectionParam `protobuf:"bytes,8,rep,name=connections,proto3" json:"connections,omitempty"`
+	Track         []*DoDSettleOrderLifeTrack  `protobuf:"bytes,9,rep,name=track,proto3" json:"track,omitempty"`
+}
+
+func (x *DoDSettleOrderInfo) Reset() {
+	*x = DoDSettleOrderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleOrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleOrderInfo) ProtoMessage() {}
+
+func (x *DoDSettleOrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleOrderInfo.ProtoReflect.Descriptor instead.
+func (*DoDSettleOrderInfo) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DoDSettleOrderInfo) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleOrderInfo) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleOrderInfo) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInfo) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInfo) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInfo) GetOrderState() string {
+	if x != nil {
+		return x.OrderState
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInfo) GetContractState() string {
+	if x != nil {
+		return x.ContractState
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInfo) GetConnections() []*DoDSettleConnectionParam {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *DoDSettleOrderInfo) GetTrack() []*DoDSettleOrderLifeTrack {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+type DoDSettleConnectionLifeTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderType string                           `protobuf:"bytes,1,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	OrderId   string                           `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Time      int64                            `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Changed   *DoDSettleConnectionDynamicParam `protobuf:"bytes,4,opt,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (x *DoDSettleConnectionLifeTrack) Reset() {
+	*x = DoDSettleConnectionLifeTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleConnectionLifeTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleConnectionLifeTrack) ProtoMessage() {}
+
+func (x *DoDSettleConnectionLifeTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleConnectionLifeTrack.ProtoReflect.Descriptor instead.
+func (*DoDSettleConnectionLifeTrack) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DoDSettleConnectionLifeTrack) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionLifeTrack) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionLifeTrack) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *DoDSettleConnectionLifeTrack) GetChanged() *DoDSettleConnectionDynamicParam {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+type DoDSettleDisconnectInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId      string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	OrderItemId  string `protobuf:"bytes,2,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	QuoteId      string `protobuf:"bytes,3,opt,name=quoteId,proto3" json:"quoteId,omitempty"`
+	QuoteItemId  string `protobuf:"bytes,4,opt,name=quoteItemId,proto3" json:"quoteItemId,omitempty"`
+	Price        string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Currency     string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	DisconnectAt int64  `protobuf:"varint,7,opt,name=disconnectAt,proto3" json:"disconnectAt,omitempty"`
+}
+
+func (x *DoDSettleDisconnectInfo) Reset() {
+	*x = DoDSettleDisconnectInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleDisconnectInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleDisconnectInfo) ProtoMessage() {}
+
+func (x *DoDSettleDisconnectInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleDisconnectInfo.ProtoReflect.Descriptor instead.
+func (*DoDSettleDisconnectInfo) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DoDSettleDisconnectInfo) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleDisconnectInfo) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleDisconnectInfo) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *DoDSettleDisconnectInfo) GetQuoteItemId() string {
+	if x != nil {
+		return x.QuoteItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleDisconnectInfo) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *DoDSettleDisconnectInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleDisconnectInfo) GetDisconnectAt() int64 {
+	if x != nil {
+		return x.DisconnectAt
+	}
+	return 0
+}
+
+type DoDSettleConnectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuyerProductId    string                             `protobuf:"bytes,1,opt,name=buyerProductId,proto3" json:"buyerProductId,omitempty"`
+	ProductOfferingId string                             `protobuf:"bytes,2,opt,name=productOfferingId,proto3" json:"productOfferingId,omitempty"`
+	ProductId         string                             `protobuf:"bytes,3,opt,name=productId,proto3" json:"productId,omitempty"`
+	SrcCompanyName    string                             `protobuf:"bytes,4,opt,name=srcCompanyName,proto3" json:"srcCompanyName,omitempty"`
+	SrcRegion         string                             `protobuf:"bytes,5,opt,name=srcRegion,proto3" json:"srcRegion,omitempty"`
+	SrcCity           string                             `protobuf:"bytes,6,opt,name=srcCity,proto3" json:"srcCity,omitempty"`
+	SrcDataCenter     string                             `protobuf:"bytes,7,opt,name=srcDataCenter,proto3" json:"srcDataCenter,omitempty"`
+	SrcPort           string                             `protobuf:"bytes,8,opt,name=srcPort,proto3" json:"srcPort,omitempty"`
+	DstCompanyName    string                             `protobuf:"bytes,9,opt,name=dstCompanyName,proto3" json:"dstCompanyName,omitempty"`
+	DstRegion         string                             `protobuf:"bytes,10,opt,name=dstRegion,proto3" json:"dstRegion,omitempty"`
+	DstCity           string                             `protobuf:"bytes,11,opt,name=dstCity,proto3" json:"dstCity,omitempty"`
+	DstDataCenter     string                             `protobuf:"bytes,12,opt,name=dstDataCenter,proto3" json:"dstDataCenter,omitempty"`
+	DstPort           string                             `protobuf:"bytes,13,opt,name=dstPort,proto3" json:"dstPort,omitempty"`
+	Active            *DoDSettleConnectionDynamicParam   `protobuf:"bytes,14,opt,name=active,proto3" json:"active,omitempty"`
+	Done              []*DoDSettleConnectionDynamicParam `protobuf:"bytes,15,rep,name=done,proto3" json:"done,omitempty"`
+	Disconnect        *DoDSettleDisconnectInfo           `protobuf:"bytes,16,opt,name=disconnect,proto3" json:"disconnect,omitempty"`
+	Track             []*DoDSettleConnectionLifeTrack    `protobuf:"bytes,17,rep,name=track,proto3" json:"track,omitempty"`
+}
+
+func (x *DoDSettleConnectionInfo) Reset() {
+	*x = DoDSettleConnectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleConnectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleConnectionInfo) ProtoMessage() {}
+
+func (x *DoDSettleConnectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleConnectionInfo.ProtoReflect.Descriptor instead.
+func (*DoDSettleConnectionInfo) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DoDSettleConnectionInfo) GetBuyerProductId() string {
+	if x != nil {
+		return x.BuyerProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetProductOfferingId() string {
+	if x != nil {
+		return x.ProductOfferingId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetSrcCompanyName() string {
+	if x != nil {
+		return x.SrcCompanyName
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetSrcRegion() string {
+	if x != nil {
+		return x.SrcRegion
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetSrcCity() string {
+	if x != nil {
+		return x.SrcCity
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetSrcDataCenter() string {
+	if x != nil {
+		return x.SrcDataCenter
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetSrcPort() string {
+	if x != nil {
+		return x.SrcPort
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetDstCompanyName() string {
+	if x != nil {
+		return x.DstCompanyName
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetDstRegion() string {
+	if x != nil {
+		return x.DstRegion
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetDstCity() string {
+	if x != nil {
+		return x.DstCity
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetDstDataCenter() string {
+	if x != nil {
+		return x.DstDataCenter
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetDstPort() string {
+	if x != nil {
+		return x.DstPort
+	}
+	return ""
+}
+
+func (x *DoDSettleConnectionInfo) GetActive() *DoDSettleConnectionDynamicParam {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *DoDSettleConnectionInfo) GetDone() []*DoDSettleConnectionDynamicParam {
+	if x != nil {
+		return x.Done
+	}
+	return nil
+}
+
+func (x *DoDSettleConnectionInfo) GetDisconnect() *DoDSettleDisconnectInfo {
+	if x != nil {
+		return x.Disconnect
+	}
+	return nil
+}
+
+func (x *DoDSettleConnectionInfo) GetTrack() []*DoDSettleConnectionLifeTrack {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+type DoDPendingRequestRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash  string              `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Order *DoDSettleOrderInfo `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *DoDPendingRequestRsp) Reset() {
+	*x = DoDPendingRequestRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPendingRequestRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPendingRequestRsp) ProtoMessage() {}
+
+func (x *DoDPendingRequestRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPendingRequestRsp.ProtoReflect.Descriptor instead.
+func (*DoDPendingRequestRsp) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DoDPendingRequestRsp) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *DoDPendingRequestRsp) GetOrder() *DoDSettleOrderInfo {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type DoDPendingRequestRsps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Infos []*DoDPendingRequestRsp `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+}
+
+func (x *DoDPendingRequestRsps) Reset() {
+	*x = DoDPendingRequestRsps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPendingRequestRsps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPendingRequestRsps) ProtoMessage() {}
+
+func (x *DoDPendingRequestRsps) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPendingRequestRsps.ProtoReflect.Descriptor instead.
+func (*DoDPendingRequestRsps) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DoDPendingRequestRsps) GetInfos() []*DoDPendingRequestRsp {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+type DoDPendingResourceCheckInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendHash   string                  `protobuf:"bytes,1,opt,name=sendHash,proto3" json:"sendHash,omitempty"`
+	OrderId    string                  `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId string                  `protobuf:"bytes,3,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	Products   []*DoDSettleProductInfo `protobuf:"bytes,4,rep,name=products,proto3" json:"products,omitempty"`
+}
+
+func (x *DoDPendingResourceCheckInfo) Reset() {
+	*x = DoDPendingResourceCheckInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPendingResourceCheckInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPendingResourceCheckInfo) ProtoMessage() {}
+
+func (x *DoDPendingResourceCheckInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPendingResourceCheckInfo.ProtoReflect.Descriptor instead.
+func (*DoDPendingResourceCheckInfo) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DoDPendingResourceCheckInfo) GetSendHash() string {
+	if x != nil {
+		return x.SendHash
+	}
+	return ""
+}
+
+func (x *DoDPendingResourceCheckInfo) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDPendingResourceCheckInfo) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDPendingResourceCheckInfo) GetProducts() []*DoDSettleProductInfo {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type DoDPendingResourceCheckInfos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Infos []*DoDPendingResourceCheckInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+}
+
+func (x *DoDPendingResourceCheckInfos) Reset() {
+	*x = DoDPendingResourceCheckInfos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPendingResourceCheckInfos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPendingResourceCheckInfos) ProtoMessage() {}
+
+func (x *DoDPendingResourceCheckInfos) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPendingResourceCheckInfos.ProtoReflect.Descriptor instead.
+func (*DoDPendingResourceCheckInfos) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DoDPendingResourceCheckInfos) GetInfos() []*DoDPendingResourceCheckInfo {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+type DoDPlacingOrderInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InternalId string              `protobuf:"bytes,1,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	OrderInfo  *DoDSettleOrderInfo `protobuf:"bytes,2,opt,name=orderInfo,proto3" json:"orderInfo,omitempty"`
+}
+
+func (x *DoDPlacingOrderInfo) Reset() {
+	*x = DoDPlacingOrderInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPlacingOrderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPlacingOrderInfo) ProtoMessage() {}
+
+func (x *DoDPlacingOrderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPlacingOrderInfo.ProtoReflect.Descriptor instead.
+func (*DoDPlacingOrderInfo) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DoDPlacingOrderInfo) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDPlacingOrderInfo) GetOrderInfo() *DoDSettleOrderInfo {
+	if x != nil {
+		return x.OrderInfo
+	}
+	return nil
+}
+
+type DoDPlacingOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalOrders int32                  `protobuf:"varint,1,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`
+	OrderList   []*DoDPlacingOrderInfo `protobuf:"bytes,2,rep,name=orderList,proto3" json:"orderList,omitempty"`
+}
+
+func (x *DoDPlacingOrderResp) Reset() {
+	*x = DoDPlacingOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPlacingOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPlacingOrderResp) ProtoMessage() {}
+
+func (x *DoDPlacingOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPlacingOrderResp.ProtoReflect.Descriptor instead.
+func (*DoDPlacingOrderResp) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DoDPlacingOrderResp) GetTotalOrders() int32 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *DoDPlacingOrderResp) GetOrderList() []*DoDPlacingOrderInfo {
+	if x != nil {
+		return x.OrderList
+	}
+	return nil
+}
+
+type DoDSettleProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller    string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	ProductId string `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+}
+
+func (x *DoDSettleProduct) Reset() {
+	*x = DoDSettleProduct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleProduct) ProtoMessage() {}
+
+func (x *DoDSettleProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleProduct.ProtoReflect.Descriptor instead.
+func (*DoDSettleProduct) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DoDSettleProduct) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDSettleProduct) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DoDSettleProducts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products []*DoDSettleProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+}
+
+func (x *DoDSettleProducts) Reset() {
+	*x = DoDSettleProducts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleProducts) ProtoMessage() {}
+
+func (x *DoDSettleProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleProducts.ProtoReflect.Descriptor instead.
+func (*DoDSettleProducts) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DoDSettleProducts) GetProducts() []*DoDSettleProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type DoDSettleOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller  string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *DoDSettleOrder) Reset() {
+	*x = DoDSettleOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleOrder) ProtoMessage() {}
+
+func (x *DoDSettleOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleOrder.ProtoReflect.Descriptor instead.
+func (*DoDSettleOrder) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DoDSettleOrder) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDSettleOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type DoDSettleOrders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*DoDSettleOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *DoDSettleOrders) Reset() {
+	*x = DoDSettleOrders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleOrders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleOrders) ProtoMessage() {}
+
+func (x *DoDSettleOrders) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleOrders.ProtoReflect.Descriptor instead.
+func (*DoDSettleOrders) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DoDSettleOrders) GetOrders() []*DoDSettleOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type DoDSettlementOrderInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderInfo   []*DoDSettleOrderInfo `protobuf:"bytes,1,rep,name=orderInfo,proto3" json:"orderInfo,omitempty"`
+	TotalOrders int32                 `protobuf:"varint,2,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`
+}
+
+func (x *DoDSettlementOrderInfoResp) Reset() {
+	*x = DoDSettlementOrderInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettlementOrderInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettlementOrderInfoResp) ProtoMessage() {}
+
+func (x *DoDSettlementOrderInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettlementOrderInfoResp.ProtoReflect.Descriptor instead.
+func (*DoDSettlementOrderInfoResp) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DoDSettlementOrderInfoResp) GetOrderInfo() []*DoDSettleOrderInfo {
+	if x != nil {
+		return x.OrderInfo
+	}
+	return nil
+}
+
+func (x *DoDSettlementOrderInfoResp) GetTotalOrders() int32 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+type DoDSettlementProductInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductInfo   []*DoDSettleConnectionInfo `protobuf:"bytes,1,rep,name=productInfo,proto3" json:"productInfo,omitempty"`
+	TotalProducts int32                      `protobuf:"varint,2,opt,name=totalProducts,proto3" json:"totalProducts,omitempty"`
+}
+
+func (x *DoDSettlementProductInfoResp) Reset() {
+	*x = DoDSettlementProductInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettlementProductInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettlementProductInfoResp) ProtoMessage() {}
+
+func (x *DoDSettlementProductInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettlementProductInfoResp.ProtoReflect.Descriptor instead.
+func (*DoDSettlementProductInfoResp) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DoDSettlementProductInfoResp) GetProductInfo() []*DoDSettleConnectionInfo {
+	if x != nil {
+		return x.ProductInfo
+	}
+	return nil
+}
+
+func (x *DoDSettlementProductInfoResp) GetTotalProducts() int32 {
+	if x != nil {
+		return x.TotalProducts
+	}
+	return 0
+}
+
+type DoDSettleInvoiceConnDynamic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId             string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId          string `protobuf:"bytes,2,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	ItemId              string `protobuf:"bytes,3,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	OrderItemId         string `protobuf:"bytes,4,opt,name=orderItemId,proto3" json:"orderItemId,omitempty"`
+	QuoteId             string `protobuf:"bytes,5,opt,name=quoteId,proto3" json:"quoteId,omitempty"`
+	QuoteItemId         string `protobuf:"bytes,6,opt,name=quoteItemId,proto3" json:"quoteItemId,omitempty"`
+	ConnectionName      string `protobuf:"bytes,7,opt,name=connectionName,proto3" json:"connectionName,omitempty"`
+	PaymentType         string `protobuf:"bytes,8,opt,name=paymentType,proto3" json:"paymentType,omitempty"`
+	BillingType         string `protobuf:"bytes,9,opt,name=billingType,proto3" json:"billingType,omitempty"`
+	Currency            string `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	ServiceClass        string `protobuf:"bytes,11,opt,name=serviceClass,proto3" json:"serviceClass,omitempty"`
+	Bandwidth           string `protobuf:"bytes,12,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	BillingUnit         string `protobuf:"bytes,13,opt,name=billingUnit,proto3" json:"billingUnit,omitempty"`
+	Price               string `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`
+	Addition            string `protobuf:"bytes,15,opt,name=addition,proto3" json:"addition,omitempty"`
+	StartTime           int64  `protobuf:"varint,16,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StartTimeStr        string `protobuf:"bytes,17,opt,name=startTimeStr,proto3" json:"startTimeStr,omitempty"`
+	EndTime             int64  `protobuf:"varint,18,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	EndTimeStr          string `protobuf:"bytes,19,opt,name=endTimeStr,proto3" json:"endTimeStr,omitempty"`
+	InvoiceStartTime    int64  `protobuf:"varint,20,opt,name=invoiceStartTime,proto3" json:"invoiceStartTime,omitempty"`
+	InvoiceStartTimeStr string `protobuf:"bytes,21,opt,name=invoiceStartTimeStr,proto3" json:"invoiceStartTimeStr,omitempty"`
+	InvoiceEndTime      int64  `protobuf:"varint,22,opt,name=invoiceEndTime,proto3" json:"invoiceEndTime,omitempty"`
+	InvoiceEndTimeStr   string `protobuf:"bytes,23,opt,name=invoiceEndTimeStr,proto3" json:"invoiceEndTimeStr,omitempty"`
+	InvoiceUnitCount    int32  `protobuf:"varint,24,opt,name=invoiceUnitCount,proto3" json:"invoiceUnitCount,omitempty"`
+	OrderType           string `protobuf:"bytes,25,opt,name=orderType,proto3" json:"orderType,omitempty"`
+	Amount              string `protobuf:"bytes,26,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *DoDSettleInvoiceConnDynamic) Reset() {
+	*x = DoDSettleInvoiceConnDynamic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleInvoiceConnDynamic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleInvoiceConnDynamic) ProtoMessage() {}
+
+func (x *DoDSettleInvoiceConnDynamic) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleInvoiceConnDynamic.ProtoReflect.Descriptor instead.
+func (*DoDSettleInvoiceConnDynamic) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetOrderItemId() string {
+	if x != nil {
+		return x.OrderItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetQuoteItemId() string {
+	if x != nil {
+		return x.QuoteItemId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetConnectionName() string {
+	if x != nil {
+		return x.ConnectionName
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetBillingType() string {
+	if x != nil {
+		return x.BillingType
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetServiceClass() string {
+	if x != nil {
+		return x.ServiceClass
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetBandwidth() string {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetBillingUnit() string {
+	if x != nil {
+		return x.BillingUnit
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetAddition() string {
+	if x != nil {
+		return x.Addition
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetStartTimeStr() string {
+	if x != nil {
+		return x.StartTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetEndTimeStr() string {
+	if x != nil {
+		return x.EndTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetInvoiceStartTime() int64 {
+	if x != nil {
+		return x.InvoiceStartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetInvoiceStartTimeStr() string {
+	if x != nil {
+		return x.InvoiceStartTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetInvoiceEndTime() int64 {
+	if x != nil {
+		return x.InvoiceEndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetInvoiceEndTimeStr() string {
+	if x != nil {
+		return x.InvoiceEndTimeStr
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetInvoiceUnitCount() int32 {
+	if x != nil {
+		return x.InvoiceUnitCount
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDynamic) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type DoDSettleInvoiceConnDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConnectionAmount  string                         `protobuf:"bytes,1,opt,name=connectionAmount,proto3" json:"connectionAmount,omitempty"`
+	BuyerProductId    string                         `protobuf:"bytes,2,opt,name=buyerProductId,proto3" json:"buyerProductId,omitempty"`
+	ProductOfferingId string                         `protobuf:"bytes,3,opt,name=productOfferingId,proto3" json:"productOfferingId,omitempty"`
+	ProductId         string                         `protobuf:"bytes,4,opt,name=productId,proto3" json:"productId,omitempty"`
+	SrcCompanyName    string                         `protobuf:"bytes,5,opt,name=srcCompanyName,proto3" json:"srcCompanyName,omitempty"`
+	SrcRegion         string                         `protobuf:"bytes,6,opt,name=srcRegion,proto3" json:"srcRegion,omitempty"`
+	SrcCity           string                         `protobuf:"bytes,7,opt,name=srcCity,proto3" json:"srcCity,omitempty"`
+	SrcDataCenter     string                         `protobuf:"bytes,8,opt,name=srcDataCenter,proto3" json:"srcDataCenter,omitempty"`
+	SrcPort           string                         `protobuf:"bytes,9,opt,name=srcPort,proto3" json:"srcPort,omitempty"`
+	DstCompanyName    string                         `protobuf:"bytes,10,opt,name=dstCompanyName,proto3" json:"dstCompanyName,omitempty"`
+	DstRegion         string                         `protobuf:"bytes,11,opt,name=dstRegion,proto3" json:"dstRegion,omitempty"`
+	DstCity           string                         `protobuf:"bytes,12,opt,name=dstCity,proto3" json:"dstCity,omitempty"`
+	DstDataCenter     string                         `protobuf:"bytes,13,opt,name=dstDataCenter,proto3" json:"dstDataCenter,omitempty"`
+	DstPort           string                         `protobuf:"bytes,14,opt,name=dstPort,proto3" json:"dstPort,omitempty"`
+	Usage             []*DoDSettleInvoiceConnDynamic `protobuf:"bytes,15,rep,name=usage,proto3" json:"usage,omitempty"`
+	Currency          string                         `protobuf:"bytes,16,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *DoDSettleInvoiceConnDetail) Reset() {
+	*x = DoDSettleInvoiceConnDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleInvoiceConnDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleInvoiceConnDetail) ProtoMessage() {}
+
+func (x *DoDSettleInvoiceConnDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleInvoiceConnDetail.ProtoReflect.Descriptor instead.
+func (*DoDSettleInvoiceConnDetail) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetConnectionAmount() string {
+	if x != nil {
+		return x.ConnectionAmount
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetBuyerProductId() string {
+	if x != nil {
+		return x.BuyerProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetProductOfferingId() string {
+	if x != nil {
+		return x.ProductOfferingId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetSrcCompanyName() string {
+	if x != nil {
+		return x.SrcCompanyName
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetSrcRegion() string {
+	if x != nil {
+		return x.SrcRegion
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetSrcCity() string {
+	if x != nil {
+		return x.SrcCity
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetSrcDataCenter() string {
+	if x != nil {
+		return x.SrcDataCenter
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetSrcPort() string {
+	if x != nil {
+		return x.SrcPort
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetDstCompanyName() string {
+	if x != nil {
+		return x.DstCompanyName
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetDstRegion() string {
+	if x != nil {
+		return x.DstRegion
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetDstCity() string {
+	if x != nil {
+		return x.DstCity
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetDstDataCenter() string {
+	if x != nil {
+		return x.DstDataCenter
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetDstPort() string {
+	if x != nil {
+		return x.DstPort
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetUsage() []*DoDSettleInvoiceConnDynamic {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *DoDSettleInvoiceConnDetail) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type DoDSettleInvoiceOrderDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId         string                        `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	InternalId      string                        `protobuf:"bytes,2,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	ConnectionCount int32                         `protobuf:"varint,3,opt,name=connectionCount,proto3" json:"connectionCount,omitempty"`
+	OrderAmount     string                        `protobuf:"bytes,4,opt,name=orderAmount,proto3" json:"orderAmount,omitempty"`
+	Connections     []*DoDSettleInvoiceConnDetail `protobuf:"bytes,5,rep,name=connections,proto3" json:"connections,omitempty"`
+	Currency        string                        `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *DoDSettleInvoiceOrderDetail) Reset() {
+	*x = DoDSettleInvoiceOrderDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleInvoiceOrderDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleInvoiceOrderDetail) ProtoMessage() {}
+
+func (x *DoDSettleInvoiceOrderDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleInvoiceOrderDetail.ProtoReflect.Descriptor instead.
+func (*DoDSettleInvoiceOrderDetail) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DoDSettleInvoiceOrderDetail) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceOrderDetail) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceOrderDetail) GetConnectionCount() int32 {
+	if x != nil {
+		return x.ConnectionCount
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoiceOrderDetail) GetOrderAmount() string {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoiceOrderDetail) GetConnections() []*DoDSettleInvoiceConnDetail {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *DoDSettleInvoiceOrderDetail) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type DoDSettleOrderInvoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId            string                       `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	TotalConnectionCount int32                        `protobuf:"varint,2,opt,name=totalConnectionCount,proto3" json:"totalConnectionCount,omitempty"`
+	TotalAmount          string                       `protobuf:"bytes,3,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
+	Currency             string                       `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartTime            int64                        `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64                        `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Flight               bool                         `protobuf:"varint,7,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split                bool                         `protobuf:"varint,8,opt,name=split,proto3" json:"split,omitempty"`
+	Buyer                *DoDSettleUser               `protobuf:"bytes,9,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller               *DoDSettleUser               `protobuf:"bytes,10,opt,name=seller,proto3" json:"seller,omitempty"`
+	Order                *DoDSettleInvoiceOrderDetail `protobuf:"bytes,11,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *DoDSettleOrderInvoice) Reset() {
+	*x = DoDSettleOrderInvoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleOrderInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleOrderInvoice) ProtoMessage() {}
+
+func (x *DoDSettleOrderInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleOrderInvoice.ProtoReflect.Descriptor instead.
+func (*DoDSettleOrderInvoice) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DoDSettleOrderInvoice) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInvoice) GetTotalConnectionCount() int32 {
+	if x != nil {
+		return x.TotalConnectionCount
+	}
+	return 0
+}
+
+func (x *DoDSettleOrderInvoice) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInvoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleOrderInvoice) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleOrderInvoice) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleOrderInvoice) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDSettleOrderInvoice) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+func (x *DoDSettleOrderInvoice) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleOrderInvoice) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleOrderInvoice) GetOrder() *DoDSettleInvoiceOrderDetail {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type DoDSettleBuyerInvoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId            string                         `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	OrderCount           int32                          `protobuf:"varint,2,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	TotalConnectionCount int32                          `protobuf:"varint,3,opt,name=totalConnectionCount,proto3" json:"totalConnectionCount,omitempty"`
+	TotalAmount          string                         `protobuf:"bytes,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
+	Currency             string                         `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartTime            int64                          `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64                          `protobuf:"varint,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Flight               bool                           `protobuf:"varint,8,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split                bool                           `protobuf:"varint,9,opt,name=split,proto3" json:"split,omitempty"`
+	Buyer                *DoDSettleUser                 `protobuf:"bytes,10,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller               *DoDSettleUser                 `protobuf:"bytes,11,opt,name=seller,proto3" json:"seller,omitempty"`
+	Orders               []*DoDSettleInvoiceOrderDetail `protobuf:"bytes,12,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *DoDSettleBuyerInvoice) Reset() {
+	*x = DoDSettleBuyerInvoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleBuyerInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleBuyerInvoice) ProtoMessage() {}
+
+func (x *DoDSettleBuyerInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleBuyerInvoice.ProtoReflect.Descriptor instead.
+func (*DoDSettleBuyerInvoice) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DoDSettleBuyerInvoice) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DoDSettleBuyerInvoice) GetOrderCount() int32 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *DoDSettleBuyerInvoice) GetTotalConnectionCount() int32 {
+	if x != nil {
+		return x.TotalConnectionCount
+	}
+	return 0
+}
+
+func (x *DoDSettleBuyerInvoice) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *DoDSettleBuyerInvoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleBuyerInvoice) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleBuyerInvoice) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleBuyerInvoice) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDSettleBuyerInvoice) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+func (x *DoDSettleBuyerInvoice) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleBuyerInvoice) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleBuyerInvoice) GetOrders() []*DoDSettleInvoiceOrderDetail {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type DoDSettleProductInvoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId   string                      `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	TotalAmount string                      `protobuf:"bytes,2,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
+	Currency    string                      `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartTime   int64                       `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime     int64                       `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Flight      bool                        `protobuf:"varint,6,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split       bool                        `protobuf:"varint,7,opt,name=split,proto3" json:"split,omitempty"`
+	Buyer       *DoDSettleUser              `protobuf:"bytes,8,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller      *DoDSettleUser              `protobuf:"bytes,9,opt,name=seller,proto3" json:"seller,omitempty"`
+	Connection  *DoDSettleInvoiceConnDetail `protobuf:"bytes,10,opt,name=connection,proto3" json:"connection,omitempty"`
+}
+
+func (x *DoDSettleProductInvoice) Reset() {
+	*x = DoDSettleProductInvoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleProductInvoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleProductInvoice) ProtoMessage() {}
+
+func (x *DoDSettleProductInvoice) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleProductInvoice.ProtoReflect.Descriptor instead.
+func (*DoDSettleProductInvoice) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DoDSettleProductInvoice) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DoDSettleProductInvoice) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *DoDSettleProductInvoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleProductInvoice) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleProductInvoice) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleProductInvoice) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDSettleProductInvoice) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+func (x *DoDSettleProductInvoice) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleProductInvoice) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleProductInvoice) GetConnection() *DoDSettleInvoiceConnDetail {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type DoDOrderIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller  string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *DoDOrderIdRequest) Reset() {
+	*x = DoDOrderIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDOrderIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDOrderIdRequest) ProtoMessage() {}
+
+func (x *DoDOrderIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDOrderIdRequest.ProtoReflect.Descriptor instead.
+func (*DoDOrderIdRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DoDOrderIdRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDOrderIdRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type DoDProductIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller    string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	ProductId string `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+}
+
+func (x *DoDProductIdRequest) Reset() {
+	*x = DoDProductIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDProductIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDProductIdRequest) ProtoMessage() {}
+
+func (x *DoDProductIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDProductIdRequest.ProtoReflect.Descriptor instead.
+func (*DoDProductIdRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DoDProductIdRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDProductIdRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DoDPlacingOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buyer  string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Count  int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Offset int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *DoDPlacingOrderRequest) Reset() {
+	*x = DoDPlacingOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDPlacingOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDPlacingOrderRequest) ProtoMessage() {}
+
+func (x *DoDPlacingOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDPlacingOrderRequest.ProtoReflect.Descriptor instead.
+func (*DoDPlacingOrderRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DoDPlacingOrderRequest) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *DoDPlacingOrderRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDPlacingOrderRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DoDPlacingOrderRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DoDAddressAndSellerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Seller  string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+}
+
+func (x *DoDAddressAndSellerRequest) Reset() {
+	*x = DoDAddressAndSellerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDAddressAndSellerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDAddressAndSellerRequest) ProtoMessage() {}
+
+func (x *DoDAddressAndSellerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDAddressAndSellerRequest.ProtoReflect.Descriptor instead.
+func (*DoDAddressAndSellerRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DoDAddressAndSellerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DoDAddressAndSellerRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+type DoDAddressOffsetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Count   int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Offset  int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *DoDAddressOffsetRequest) Reset() {
+	*x = DoDAddressOffsetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDAddressOffsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDAddressOffsetRequest) ProtoMessage() {}
+
+func (x *DoDAddressOffsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDAddressOffsetRequest.ProtoReflect.Descriptor instead.
+func (*DoDAddressOffsetRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DoDAddressOffsetRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DoDAddressOffsetRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DoDAddressOffsetRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DoDAddressAndSellerOffsetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Seller  string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Count   int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Offset  int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *DoDAddressAndSellerOffsetRequest) Reset() {
+	*x = DoDAddressAndSellerOffsetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDAddressAndSellerOffsetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDAddressAndSellerOffsetRequest) ProtoMessage() {}
+
+func (x *DoDAddressAndSellerOffsetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDAddressAndSellerOffsetRequest.ProtoReflect.Descriptor instead.
+func (*DoDAddressAndSellerOffsetRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DoDAddressAndSellerOffsetRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DoDAddressAndSellerOffsetRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDAddressAndSellerOffsetRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DoDAddressAndSellerOffsetRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DoDInvoiceByOrderIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller  string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Start   int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End     int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Flight  bool   `protobuf:"varint,5,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split   bool   `protobuf:"varint,6,opt,name=split,proto3" json:"split,omitempty"`
+}
+
+func (x *DoDInvoiceByOrderIdRequest) Reset() {
+	*x = DoDInvoiceByOrderIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDInvoiceByOrderIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDInvoiceByOrderIdRequest) ProtoMessage() {}
+
+func (x *DoDInvoiceByOrderIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDInvoiceByOrderIdRequest.ProtoReflect.Descriptor instead.
+func (*DoDInvoiceByOrderIdRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DoDInvoiceByOrderIdRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDInvoiceByOrderIdRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDInvoiceByOrderIdRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *DoDInvoiceByOrderIdRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *DoDInvoiceByOrderIdRequest) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDInvoiceByOrderIdRequest) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+type DoDInvoiceByBuyerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer  string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Start  int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End    int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Flight bool   `protobuf:"varint,5,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split  bool   `protobuf:"varint,6,opt,name=split,proto3" json:"split,omitempty"`
+}
+
+func (x *DoDInvoiceByBuyerRequest) Reset() {
+	*x = DoDInvoiceByBuyerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDInvoiceByBuyerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDInvoiceByBuyerRequest) ProtoMessage() {}
+
+func (x *DoDInvoiceByBuyerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDInvoiceByBuyerRequest.ProtoReflect.Descriptor instead.
+func (*DoDInvoiceByBuyerRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DoDInvoiceByBuyerRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDInvoiceByBuyerRequest) GetBuyer() string {
+	if x != nil {
+		return x.Buyer
+	}
+	return ""
+}
+
+func (x *DoDInvoiceByBuyerRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *DoDInvoiceByBuyerRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *DoDInvoiceByBuyerRequest) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDInvoiceByBuyerRequest) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+type DoDInvoiceByProductIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller    string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	ProductId string `protobuf:"bytes,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Start     int64  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End       int64  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Flight    bool   `protobuf:"varint,5,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split     bool   `protobuf:"varint,6,opt,name=split,proto3" json:"split,omitempty"`
+}
+
+func (x *DoDInvoiceByProductIdRequest) Reset() {
+	*x = DoDInvoiceByProductIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDInvoiceByProductIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDInvoiceByProductIdRequest) ProtoMessage() {}
+
+func (x *DoDInvoiceByProductIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDInvoiceByProductIdRequest.ProtoReflect.Descriptor instead.
+func (*DoDInvoiceByProductIdRequest) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DoDInvoiceByProductIdRequest) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDInvoiceByProductIdRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DoDInvoiceByProductIdRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *DoDInvoiceByProductIdRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *DoDInvoiceByProductIdRequest) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDInvoiceByProductIdRequest) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+type DoDSettleIssueInvoiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seller         string   `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId        string   `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Token          string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	StartTime      int64    `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime        int64    `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	DueTime        int64    `protobuf:"varint,6,opt,name=dueTime,proto3" json:"dueTime,omitempty"`
+	Flight         bool     `protobuf:"varint,7,opt,name=flight,proto3" json:"flight,omitempty"`
+	Split          bool     `protobuf:"varint,8,opt,name=split,proto3" json:"split,omitempty"`
+	PrivateFrom    string   `protobuf:"bytes,9,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string `protobuf:"bytes,10,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string   `protobuf:"bytes,11,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleIssueInvoiceParam) Reset() {
+	*x = DoDSettleIssueInvoiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleIssueInvoiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleIssueInvoiceParam) ProtoMessage() {}
+
+func (x *DoDSettleIssueInvoiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleIssueInvoiceParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleIssueInvoiceParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetSeller() string {
+	if x != nil {
+		return x.Seller
+	}
+	return ""
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetDueTime() int64 {
+	if x != nil {
+		return x.DueTime
+	}
+	return 0
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetFlight() bool {
+	if x != nil {
+		return x.Flight
+	}
+	return false
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetSplit() bool {
+	if x != nil {
+		return x.Split
+	}
+	return false
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleIssueInvoiceParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettlePayInvoiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId      string   `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	Amount         int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PrivateFrom    string   `protobuf:"bytes,3,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string `protobuf:"bytes,4,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string   `protobuf:"bytes,5,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettlePayInvoiceParam) Reset() {
+	*x = DoDSettlePayInvoiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettlePayInvoiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettlePayInvoiceParam) ProtoMessage() {}
+
+func (x *DoDSettlePayInvoiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettlePayInvoiceParam.ProtoReflect.Descriptor instead.
+func (*DoDSettlePayInvoiceParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DoDSettlePayInvoiceParam) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DoDSettlePayInvoiceParam) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DoDSettlePayInvoiceParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettlePayInvoiceParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettlePayInvoiceParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleRefundInvoiceParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId      string   `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	PaymentId      string   `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	PrivateFrom    string   `protobuf:"bytes,3,opt,name=privateFrom,proto3" json:"privateFrom,omitempty"`
+	PrivateFor     []string `protobuf:"bytes,4,rep,name=privateFor,proto3" json:"privateFor,omitempty"`
+	PrivateGroupID string   `protobuf:"bytes,5,opt,name=privateGroupID,proto3" json:"privateGroupID,omitempty"`
+}
+
+func (x *DoDSettleRefundInvoiceParam) Reset() {
+	*x = DoDSettleRefundInvoiceParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleRefundInvoiceParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleRefundInvoiceParam) ProtoMessage() {}
+
+func (x *DoDSettleRefundInvoiceParam) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleRefundInvoiceParam.ProtoReflect.Descriptor instead.
+func (*DoDSettleRefundInvoiceParam) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DoDSettleRefundInvoiceParam) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DoDSettleRefundInvoiceParam) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *DoDSettleRefundInvoiceParam) GetPrivateFrom() string {
+	if x != nil {
+		return x.PrivateFrom
+	}
+	return ""
+}
+
+func (x *DoDSettleRefundInvoiceParam) GetPrivateFor() []string {
+	if x != nil {
+		return x.PrivateFor
+	}
+	return nil
+}
+
+func (x *DoDSettleRefundInvoiceParam) GetPrivateGroupID() string {
+	if x != nil {
+		return x.PrivateGroupID
+	}
+	return ""
+}
+
+type DoDSettleInvoicePaymentDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId string `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	Payer     string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Applied   int64  `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	Refund    int64  `protobuf:"varint,5,opt,name=refund,proto3" json:"refund,omitempty"`
+	PaidAt    int64  `protobuf:"varint,6,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	Refunded  bool   `protobuf:"varint,7,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	RefundAt  int64  `protobuf:"varint,8,opt,name=refundAt,proto3" json:"refundAt,omitempty"`
+}
+
+func (x *DoDSettleInvoicePaymentDetail) Reset() {
+	*x = DoDSettleInvoicePaymentDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleInvoicePaymentDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleInvoicePaymentDetail) ProtoMessage() {}
+
+func (x *DoDSettleInvoicePaymentDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleInvoicePaymentDetail.ProtoReflect.Descriptor instead.
+func (*DoDSettleInvoicePaymentDetail) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetPayer() string {
+	if x != nil {
+		return x.Payer
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetApplied() int64 {
+	if x != nil {
+		return x.Applied
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetRefund() int64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetRefunded() bool {
+	if x != nil {
+		return x.Refunded
+	}
+	return false
+}
+
+func (x *DoDSettleInvoicePaymentDetail) GetRefundAt() int64 {
+	if x != nil {
+		return x.RefundAt
+	}
+	return 0
+}
+
+type DoDSettleInvoicePaymentStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceId   string                           `protobuf:"bytes,1,opt,name=invoiceId,proto3" json:"invoiceId,omitempty"`
+	InternalId  string                           `protobuf:"bytes,2,opt,name=internalId,proto3" json:"internalId,omitempty"`
+	OrderId     string                           `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Buyer       *DoDSettleUser                   `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller      *DoDSettleUser                   `protobuf:"bytes,5,opt,name=seller,proto3" json:"seller,omitempty"`
+	Currency    string                           `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount      string                           `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Token       string                           `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	TokenAmount int64                            `protobuf:"varint,9,opt,name=tokenAmount,proto3" json:"tokenAmount,omitempty"`
+	StartTime   int64                            `protobuf:"varint,10,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime     int64                            `protobuf:"varint,11,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	DueTime     int64                            `protobuf:"varint,12,opt,name=dueTime,proto3" json:"dueTime,omitempty"`
+	IssuedAt    int64                            `protobuf:"varint,13,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	Status      string                           `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	Dunning     string                           `protobuf:"bytes,15,opt,name=dunning,proto3" json:"dunning,omitempty"`
+	Paid        int64                            `protobuf:"varint,16,opt,name=paid,proto3" json:"paid,omitempty"`
+	Outstanding int64                            `protobuf:"varint,17,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	PaidAmount  string                           `protobuf:"bytes,18,opt,name=paidAmount,proto3" json:"paidAmount,omitempty"`
+	Refundable  int64                            `protobuf:"varint,19,opt,name=refundable,proto3" json:"refundable,omitempty"`
+	PaidAt      int64                            `protobuf:"varint,20,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	Payments    []*DoDSettleInvoicePaymentDetail `protobuf:"bytes,21,rep,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (x *DoDSettleInvoicePaymentStatus) Reset() {
+	*x = DoDSettleInvoicePaymentStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleInvoicePaymentStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleInvoicePaymentStatus) ProtoMessage() {}
+
+func (x *DoDSettleInvoicePaymentStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleInvoicePaymentStatus.ProtoReflect.Descriptor instead.
+func (*DoDSettleInvoicePaymentStatus) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetInternalId() string {
+	if x != nil {
+		return x.InternalId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetBuyer() *DoDSettleUser {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetSeller() *DoDSettleUser {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetTokenAmount() int64 {
+	if x != nil {
+		return x.TokenAmount
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetDueTime() int64 {
+	if x != nil {
+		return x.DueTime
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetDunning() string {
+	if x != nil {
+		return x.Dunning
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetPaid() int64 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetOutstanding() int64 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetRefundable() int64 {
+	if x != nil {
+		return x.Refundable
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *DoDSettleInvoicePaymentStatus) GetPayments() []*DoDSettleInvoicePaymentDetail {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type DoDSettleInvoicePaymentStatuses struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses []*DoDSettleInvoicePaymentStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *DoDSettleInvoicePaymentStatuses) Reset() {
+	*x = DoDSettleInvoicePaymentStatuses{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dod_settlement_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoDSettleInvoicePaymentStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoDSettleInvoicePaymentStatuses) ProtoMessage() {}
+
+func (x *DoDSettleInvoicePaymentStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_dod_settlement_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoDSettleInvoicePaymentStatuses.ProtoReflect.Descriptor instead.
+func (*DoDSettleInvoicePaymentStatuses) Descriptor() ([]byte, []int) {
+	return file_dod_settlement_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DoDSettleInvoicePaymentStatuses) GetStatuses() []*DoDSettleInvoicePaymentStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+var File_dod_settlement_proto protoreflect.FileDescriptor
+
+var file_dod_settlement_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x64, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3d, 0x0a, 0x0d,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xeb, 0x04, 0x0a, 0x1f,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x22, 0x98, 0x08, 0x0a, 0x18, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x75, 0x79, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x62, 0x75, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x72,
+	0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x72, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x72, 0x63, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72, 0x63, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x43, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x43, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x72,
+	0x63, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x72, 0x63, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x43, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x43, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x64, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55,
+	0x6e, 0x69, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x72, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x72, 0x22, 0x88, 0x05, 0x0a, 0x1e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x22,
+	0xa2, 0x02, 0x0a, 0x19, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2a, 0x0a,
+	0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x12, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x22, 0xce, 0x02, 0x0a, 0x1d, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x22, 0xa8, 0x02, 0x0a, 0x19, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x2a, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22,
+	0xab, 0x02, 0x0a, 0x1c, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x2a, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x6e, 0x0a,
+	0x14, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xfe, 0x01,
+	0x0a, 0x1f, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x46, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x9f,
+	0x01, 0x0a, 0x17, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x85, 0x03, 0x0a, 0x12, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x41, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xac, 0x01, 0x0a, 0x1c, 0x44, 0x6f, 0x44,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x66, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x17, 0x44, 0x6f, 0x44, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41,
+	0x74, 0x22, 0xc4, 0x05, 0x0a, 0x17, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a,
+	0x0e, 0x62, 0x75, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x72, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x72, 0x63, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x72, 0x63,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72,
+	0x63, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x43, 0x69,
+	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x43, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x72, 0x63, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x72, 0x63, 0x44, 0x61, 0x74,
+	0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x73, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x73,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x43, 0x69,
+	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x43, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x3e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x79, 0x6e,
+	0x61, 0x6d, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d,
+	0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a,
+	0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x39, 0x0a,
+	0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x5b, 0x0a, 0x14, 0x44, 0x6f, 0x44, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x73, 0x70,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x15, 0x44, 0x6f, 0x44, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x73, 0x70, 0x73, 0x12, 0x31,
+	0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x73, 0x70, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f,
+	0x73, 0x22, 0xac, 0x01, 0x0a, 0x1b, 0x44, 0x6f, 0x44, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x22, 0x58, 0x0a, 0x1c, 0x44, 0x6f, 0x44, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x12, 0x38, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x44, 0x6f,
+	0x44, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x71, 0x0a, 0x13, 0x44, 0x6f,
+	0x44, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x44, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x48, 0x0a,
+	0x10, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x11, 0x44, 0x6f, 0x44, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x22, 0x42, 0x0a, 0x0e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0f, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x77, 0x0a, 0x1a, 0x44, 0x6f, 0x44, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x22, 0x86, 0x01, 0x0a, 0x1c, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0xfd, 0x06, 0x0a, 0x1b, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e,
+	0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x30, 0x0a, 0x13, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x72, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd2, 0x04, 0x0a, 0x1a, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x75, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x72, 0x63, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x72, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x72, 0x63, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x72, 0x63, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x72, 0x63, 0x43, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x72, 0x63, 0x43, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x72, 0x63, 0x44,
+	0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x72, 0x63, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x72, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x64, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x73, 0x74, 0x43, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x73, 0x74, 0x43, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x84,
+	0x02, 0x0a, 0x1b, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xa1, 0x03, 0x0a, 0x15, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xc3, 0x03, 0x0a, 0x15, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x42, 0x75, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x32, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x62, 0x75, 0x79,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
+	0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22,
+	0xf8, 0x02, 0x0a, 0x17, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x2a, 0x0a,
+	0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x11, 0x44, 0x6f,
+	0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x4b, 0x0a, 0x13, 0x44, 0x6f, 0x44, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x22, 0x74,
+	0x0a, 0x16, 0x44, 0x6f, 0x44, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x4e, 0x0a, 0x1a, 0x44, 0x6f, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x17, 0x44, 0x6f, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x20, 0x44, 0x6f, 0x44, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xa4, 0x01, 0x0a,
+	0x1a, 0x44, 0x6f, 0x44, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x79, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x44, 0x6f, 0x44, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x42, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x1c, 0x44, 0x6f, 0x44, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x22, 0xce, 0x02, 0x0a, 0x1a, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x64, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x44, 0x22, 0xba, 0x01, 0x0a, 0x18, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22,
+	0xc3, 0x01, 0x0a, 0x1b, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0xed, 0x01, 0x0a, 0x1d, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x41, 0x74, 0x22, 0xad, 0x05, 0x0a, 0x1d, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x64, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x69,
+	0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x08, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x63, 0x0a, 0x1f, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x32, 0xe0, 0x24, 0x0a, 0x10, 0x44,
+	0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x50, 0x49, 0x12,
+	0x6f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a,
+	0x12, 0x78, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x80, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18,
+	0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x23, 0x22, 0x1e, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22,
+	0x1b, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12,
+	0x7e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21, 0x2f, 0x64, 0x6f, 0x64, 0x2f,
+	0x67, 0x65, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12,
+	0x81, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x22, 0x1e, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x3a, 0x01, 0x2a, 0x12, 0x84, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x22, 0x24, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x64, 0x6f, 0x64,
+	0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x6b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x42, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x91, 0x01, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x53, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x41, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x61, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
+	0x44, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x73, 0x70, 0x73, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x64, 0x6f,
+	0x64, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x23,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x64, 0x6f,
+	0x64, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x69, 0x6e, 0x67,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0x26, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x67, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x92, 0x01,
+	0x0a, 0x22, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x73, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x64, 0x6f, 0x64, 0x2f,
+	0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e,
+	0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x64, 0x6f, 0x64,
+	0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x5b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x0c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7e,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x80,
+	0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x64, 0x6f,
+	0x64, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x99, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67,
+	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x5f, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x84,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e,
+	0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x9f, 0x01, 0x0a,
+	0x20, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x82,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x79,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x7c, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x42, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x42, 0x75, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x79, 0x42, 0x75, 0x79, 0x65,
+	0x72, 0x12, 0x8a, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
+	0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
+	0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x64,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x42,
+	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x42, 0x79, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x72, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a,
+	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x64, 0x6f, 0x64,
+	0x2f, 0x67, 0x65, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a,
+	0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x64, 0x6f, 0x64,
+	0x2f, 0x67, 0x65, 0x74, 0x50, 0x61, 0x79, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a,
+	0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x5b, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48,
+	0x61, 0x73, 0x68, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x72, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
+	0x12, 0x1c, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7d,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x44, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x12, 0x1a, 0x2f, 0x64, 0x6f, 0x64, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x5a,
+	0x07, 0x2e, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dod_settlement_proto_rawDescOnce sync.Once
+	file_dod_settlement_proto_rawDescData = file_dod_settlement_proto_rawDesc
+)
+
+func file_dod_settlement_proto_rawDescGZIP() []byte {
+	file_dod_settlement_proto_rawDescOnce.Do(func() {
+		file_dod_settlement_proto_rawDescData = protoimpl.X.CompressGZIP(file_dod_settlement_proto_rawDescData)
+	})
+	return file_dod_settlement_proto_rawDescData
+}
+
+var file_dod_settlement_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_dod_settlement_proto_goTypes = []interface{}{
+	(*DoDSettleUser)(nil),                    // 0: proto.DoDSettleUser
+	(*DoDSettleConnectionDynamicParam)(nil),  // 1: proto.DoDSettleConnectionDynamicParam
+	(*DoDSettleConnectionParam)(nil),         // 2: proto.DoDSettleConnectionParam
+	(*DoDSettleChangeConnectionParam)(nil),   // 3: proto.DoDSettleChangeConnectionParam
+	(*DoDSettleCreateOrderParam)(nil),        // 4: proto.DoDSettleCreateOrderParam
+	(*DoDSettleResponseParam)(nil),           // 5: proto.DoDSettleResponseParam
+	(*DoDSettleOrderItem)(nil),               // 6: proto.DoDSettleOrderItem
+	(*DoDSettleUpdateOrderInfoParam)(nil),    // 7: proto.DoDSettleUpdateOrderInfoParam
+	(*DoDSettleChangeOrderParam)(nil),        // 8: proto.DoDSettleChangeOrderParam
+	(*DoDSettleTerminateOrderParam)(nil),     // 9: proto.DoDSettleTerminateOrderParam
+	(*DoDSettleProductInfo)(nil),             // 10: proto.DoDSettleProductInfo
+	(*DoDSettleUpdateProductInfoParam)(nil),  // 11: proto.DoDSettleUpdateProductInfoParam
+	(*DoDSettleOrderLifeTrack)(nil),          // 12: proto.DoDSettleOrderLifeTrack
+	(*DoDSettleOrderInfo)(nil),               // 13: proto.DoDSettleOrderInfo
+	(*DoDSettleConnectionLifeTrack)(nil),     // 14: proto.DoDSettleConnectionLifeTrack
+	(*DoDSettleDisconnectInfo)(nil),          // 15: proto.DoDSettleDisconnectInfo
+	(*DoDSettleConnectionInfo)(nil),          // 16: proto.DoDSettleConnectionInfo
+	(*DoDPendingRequestRsp)(nil),             // 17: proto.DoDPendingRequestRsp
+	(*DoDPendingRequestRsps)(nil),            // 18: proto.DoDPendingRequestRsps
+	(*DoDPendingResourceCheckInfo)(nil),      // 19: proto.DoDPendingResourceCheckInfo
+	(*DoDPendingResourceCheckInfos)(nil),     // 20: proto.DoDPendingResourceCheckInfos
+	(*DoDPlacingOrderInfo)(nil),              // 21: proto.DoDPlacingOrderInfo
+	(*DoDPlacingOrderResp)(nil),              // 22: proto.DoDPlacingOrderResp
+	(*DoDSettleProduct)(nil),                 // 23: proto.DoDSettleProduct
+	(*DoDSettleProducts)(nil),                // 24: proto.DoDSettleProducts
+	(*DoDSettleOrder)(nil),                   // 25: proto.DoDSettleOrder
+	(*DoDSettleOrders)(nil),                  // 26: proto.DoDSettleOrders
+	(*DoDSettlementOrderInfoResp)(nil),       // 27: proto.DoDSettlementOrderInfoResp
+	(*DoDSettlementProductInfoResp)(nil),     // 28: proto.DoDSettlementProductInfoResp
+	(*DoDSettleInvoiceConnDynamic)(nil),      // 29: proto.DoDSettleInvoiceConnDynamic
+	(*DoDSettleInvoiceConnDetail)(nil),       // 30: proto.DoDSettleInvoiceConnDetail
+	(*DoDSettleInvoiceOrderDetail)(nil),      // 31: proto.DoDSettleInvoiceOrderDetail
+	(*DoDSettleOrderInvoice)(nil),            // 32: proto.DoDSettleOrderInvoice
+	(*DoDSettleBuyerInvoice)(nil),            // 33: proto.DoDSettleBuyerInvoice
+	(*DoDSettleProductInvoice)(nil),          // 34: proto.DoDSettleProductInvoice
+	(*DoDOrderIdRequest)(nil),                // 35: proto.DoDOrderIdRequest
+	(*DoDProductIdRequest)(nil),              // 36: proto.DoDProductIdRequest
+	(*DoDPlacingOrderRequest)(nil),           // 37: proto.DoDPlacingOrderRequest
+	(*DoDAddressAndSellerRequest)(nil),       // 38: proto.DoDAddressAndSellerRequest
+	(*DoDAddressOffsetRequest)(nil),          // 39: proto.DoDAddressOffsetRequest
+	(*DoDAddressAndSellerOffsetRequest)(nil), // 40: proto.DoDAddressAndSellerOffsetRequest
+	(*DoDInvoiceByOrderIdRequest)(nil),       // 41: proto.DoDInvoiceByOrderIdRequest
+	(*DoDInvoiceByBuyerRequest)(nil),         // 42: proto.DoDInvoiceByBuyerRequest
+	(*DoDInvoiceByProductIdRequest)(nil),     // 43: proto.DoDInvoiceByProductIdRequest
+	(*DoDSettleIssueInvoiceParam)(nil),       // 44: proto.DoDSettleIssueInvoiceParam
+	(*DoDSettlePayInvoiceParam)(nil),         // 45: proto.DoDSettlePayInvoiceParam
+	(*DoDSettleRefundInvoiceParam)(nil),      // 46: proto.DoDSettleRefundInvoiceParam
+	(*DoDSettleInvoicePaymentDetail)(nil),    // 47: proto.DoDSettleInvoicePaymentDetail
+	(*DoDSettleInvoicePaymentStatus)(nil),    // 48: proto.DoDSettleInvoicePaymentStatus
+	(*DoDSettleInvoicePaymentStatuses)(nil),  // 49: proto.DoDSettleInvoicePaymentStatuses
+	(*String)(nil),                           // 50: proto.String
+	(*types.Address)(nil),                    // 51: types.Address
+	(*types.Hash)(nil),                       // 52: types.Hash
+	(*types.StateBlock)(nil),                 // 53: types.StateBlock
+	(*Int32)(nil),                            // 54: proto.Int32
+}
+var file_dod_settlement_proto_depIdxs = []int32{
+	0,  // 0: proto.DoDSettleCreateOrderParam.buyer:type_name -> proto.DoDSettleUser
+	0,  // 1: proto.DoDSettleCreateOrderParam.seller:type_name -> proto.DoDSettleUser
+	2,  // 2: proto.DoDSettleCreateOrderParam.connections:type_name -> proto.DoDSettleConnectionParam
+	6,  // 3: proto.DoDSettleUpdateOrderInfoParam.orderItemId:type_name -> proto.DoDSettleOrderItem
+	0,  // 4: proto.DoDSettleChangeOrderParam.buyer:type_name -> proto.DoDSettleUser
+	0,  // 5: proto.DoDSettleChangeOrderParam.seller:type_name -> proto.DoDSettleUser
+	3,  // 6: proto.DoDSettleChangeOrderParam.connections:type_name -> proto.DoDSettleChangeConnectionParam
+	0,  // 7: proto.DoDSettleTerminateOrderParam.buyer:type_name -> proto.DoDSettleUser
+	0,  // 8: proto.DoDSettleTerminateOrderParam.seller:type_name -> proto.DoDSettleUser
+	3,  // 9: proto.DoDSettleTerminateOrderParam.connections:type_name -> proto.DoDSettleChangeConnectionParam
+	10, // 10: proto.DoDSettleUpdateProductInfoParam.productInfo:type_name -> proto.DoDSettleProductInfo
+	0,  // 11: proto.DoDSettleOrderInfo.buyer:type_name -> proto.DoDSettleUser
+	0,  // 12: proto.DoDSettleOrderInfo.seller:type_name -> proto.DoDSettleUser
+	2,  // 13: proto.DoDSettleOrderInfo.connections:type_name -> proto.DoDSettleConnectionParam
+	12, // 14: proto.DoDSettleOrderInfo.track:type_name -> proto.DoDSettleOrderLifeTrack
+	1,  // 15: proto.DoDSettleConnectionLifeTrack.changed:type_name -> proto.DoDSettleConnectionDynamicParam
+	1,  // 16: proto.DoDSettleConnectionInfo.active:type_name -> proto.DoDSettleConnectionDynamicParam
+	1,  // 17: proto.DoDSettleConnectionInfo.done:type_name -> proto.DoDSettleConnectionDynamicParam
+	15, // 18: proto.DoDSettleConnectionInfo.disconnect:type_name -> proto.DoDSettleDisconnectInfo
+	14, // 19: proto.DoDSettleConnectionInfo.track:type_name -> proto.DoDSettleConnectionLifeTrack
+	13, // 20: proto.DoDPendingRequestRsp.order:type_name -> proto.DoDSettleOrderInfo
+	17, // 21: proto.DoDPendingRequestRsps.infos:type_name -> proto.DoDPendingRequestRsp
+	10, // 22: proto.DoDPendingResourceCheckInfo.products:type_name -> proto.DoDSettleProductInfo
+	19, // 23: proto.DoDPendingResourceCheckInfos.infos:type_name -> proto.DoDPendingResourceCheckInfo
+	13, // 24: proto.DoDPlacingOrderInfo.orderInfo:type_name -> proto.DoDSettleOrderInfo
+	21, // 25: proto.DoDPlacingOrderResp.orderList:type_name -> proto.DoDPlacingOrderInfo
+	23, // 26: proto.DoDSettleProducts.products:type_name -> proto.DoDSettleProduct
+	25, // 27: proto.DoDSettleOrders.orders:type_name -> proto.DoDSettleOrder
+	13, // 28: proto.DoDSettlementOrderInfoResp.orderInfo:type_name -> proto.DoDSettleOrderInfo
+	16, // 29: proto.DoDSettlementProductInfoResp.productInfo:type_name -> proto.DoDSettleConnectionInfo
+	29, // 30: proto.DoDSettleInvoiceConnDetail.usage:type_name -> proto.DoDSettleInvoiceConnDynamic
+	30, // 31: proto.DoDSettleInvoiceOrderDetail.connections:type_name -> proto.DoDSettleInvoiceConnDetail
+	0,  // 32: proto.DoDSettleOrderInvoice.buyer:type_name -> proto.DoDSettleUser
+	0,  // 33: proto.DoDSettleOrderInvoice.seller:type_name -> proto.DoDSettleUser
+	31, // 34: proto.DoDSettleOrderInvoice.order:type_name -> proto.DoDSettleInvoiceOrderDetail
+	0,  // 35: proto.DoDSettleBuyerInvoice.buyer:type_name -> proto.DoDSettleUser
+	0,  // 36: proto.DoDSettleBuyerInvoice.seller:type_name -> proto.DoDSettleUser
+	31, // 37: proto.DoDSettleBuyerInvoice.orders:type_name -> proto.DoDSettleInvoiceOrderDetail
+	0,  // 38: proto.DoDSettleProductInvoice.buyer:type_name -> proto.DoDSettleUser
+	0,  // 39: proto.DoDSettleProductInvoice.seller:type_name -> proto.DoDSettleUser
+	30, // 40: proto.DoDSettleProductInvoice.connection:type_name -> proto.DoDSettleInvoiceConnDetail
+	0,  // 41: proto.DoDSettleInvoicePaymentStatus.buyer:type_name -> proto.DoDSettleUser
+	0,  // 42: proto.DoDSettleInvoicePaymentStatus.seller:type_name -> proto.DoDSettleUser
+	47, // 43: proto.DoDSettleInvoicePaymentStatus.payments:type_name -> proto.DoDSettleInvoicePaymentDetail
+	48, // 44: proto.DoDSettleInvoicePaymentStatuses.statuses:type_name -> proto.DoDSettleInvoicePaymentStatus
+	4,  // 45: proto.DoDSettlementAPI.GetCreateOrderBlock:input_type -> proto.DoDSettleCreateOrderParam
+	5,  // 46: proto.DoDSettlementAPI.GetCreateOrderRewardBlock:input_type -> proto.DoDSettleResponseParam
+	7,  // 47: proto.DoDSettlementAPI.GetUpdateOrderInfoBlock:input_type -> proto.DoDSettleUpdateOrderInfoParam
+	5,  // 48: proto.DoDSettlementAPI.GetUpdateOrderInfoRewardBlock:input_type -> proto.DoDSettleResponseParam
+	8,  // 49: proto.DoDSettlementAPI.GetChangeOrderBlock:input_type -> proto.DoDSettleChangeOrderParam
+	5,  // 50: proto.DoDSettlementAPI.GetChangeOrderRewardBlock:input_type -> proto.DoDSettleResponseParam
+	9,  // 51: proto.DoDSettlementAPI.GetTerminateOrderBlock:input_type -> proto.DoDSettleTerminateOrderParam
+	5,  // 52: proto.DoDSettlementAPI.GetTerminateOrderRewardBlock:input_type -> proto.DoDSettleResponseParam
+	11, // 53: proto.DoDSettlementAPI.GetUpdateProductInfoBlock:input_type -> proto.DoDSettleUpdateProductInfoParam
+	5,  // 54: proto.DoDSettlementAPI.GetUpdateProductInfoRewardBlock:input_type -> proto.DoDSettleResponseParam
+	35, // 55: proto.DoDSettlementAPI.GetOrderInfoBySellerAndOrderId:input_type -> proto.DoDOrderIdRequest
+	50, // 56: proto.DoDSettlementAPI.GetOrderInfoByInternalId:input_type -> proto.String
+	36, // 57: proto.DoDSettlementAPI.GetProductInfoBySellerAndProductId:input_type -> proto.DoDProductIdRequest
+	51, // 58: proto.DoDSettlementAPI.GetPendingRequest:input_type -> types.Address
+	51, // 59: proto.DoDSettlementAPI.GetPendingResourceCheck:input_type -> types.Address
+	37, // 60: proto.DoDSettlementAPI.GetPlacingOrder:input_type -> proto.DoDPlacingOrderRequest
+	51, // 61: proto.DoDSettlementAPI.GetProductIdListByAddress:input_type -> types.Address
+	51, // 62: proto.DoDSettlementAPI.GetOrderIdListByAddress:input_type -> types.Address
+	38, // 63: proto.DoDSettlementAPI.GetProductIdListByAddressAndSeller:input_type -> proto.DoDAddressAndSellerRequest
+	38, // 64: proto.DoDSettlementAPI.GetOrderIdListByAddressAndSeller:input_type -> proto.DoDAddressAndSellerRequest
+	51, // 65: proto.DoDSettlementAPI.GetOrderCountByAddress:input_type -> types.Address
+	39, // 66: proto.DoDSettlementAPI.GetOrderInfoByAddress:input_type -> proto.DoDAddressOffsetRequest
+	38, // 67: proto.DoDSettlementAPI.GetOrderCountByAddressAndSeller:input_type -> proto.DoDAddressAndSellerRequest
+	40, // 68: proto.DoDSettlementAPI.GetOrderInfoByAddressAndSeller:input_type -> proto.DoDAddressAndSellerOffsetRequest
+	51, // 69: proto.DoDSettlementAPI.GetProductCountByAddress:input_type -> types.Address
+	39, // 70: proto.DoDSettlementAPI.GetProductInfoByAddress:input_type -> proto.DoDAddressOffsetRequest
+	38, // 71: proto.DoDSettlementAPI.GetProductCountByAddressAndSeller:input_type -> proto.DoDAddressAndSellerRequest
+	40, // 72: proto.DoDSettlementAPI.GetProductInfoByAddressAndSeller:input_type -> proto.DoDAddressAndSellerOffsetRequest
+	41, // 73: proto.DoDSettlementAPI.GenerateInvoiceByOrderId:input_type -> proto.DoDInvoiceByOrderIdRequest
+	42, // 74: proto.DoDSettlementAPI.GenerateInvoiceByBuyer:input_type -> proto.DoDInvoiceByBuyerRequest
+	43, // 75: proto.DoDSettlementAPI.GenerateInvoiceByProductId:input_type -> proto.DoDInvoiceByProductIdRequest
+	35, // 76: proto.DoDSettlementAPI.GetInternalIdByOrderId:input_type -> proto.DoDOrderIdRequest
+	44, // 77: proto.DoDSettlementAPI.GetIssueInvoiceBlock:input_type -> proto.DoDSettleIssueInvoiceParam
+	45, // 78: proto.DoDSettlementAPI.GetPayInvoiceBlock:input_type -> proto.DoDSettlePayInvoiceParam
+	46, // 79: proto.DoDSettlementAPI.GetRefundInvoiceBlock:input_type -> proto.DoDSettleRefundInvoiceParam
+	52, // 80: proto.DoDSettlementAPI.GetInvoiceRewardBlock:input_type -> types.Hash
+	52, // 81: proto.DoDSettlementAPI.GetInvoicePaymentStatus:input_type -> types.Hash
+	35, // 82: proto.DoDSettlementAPI.GetOrderPaymentStatus:input_type -> proto.DoDOrderIdRequest
+	53, // 83: proto.DoDSettlementAPI.GetCreateOrderBlock:output_type -> types.StateBlock
+	53, // 84: proto.DoDSettlementAPI.GetCreateOrderRewardBlock:output_type -> types.StateBlock
+	53, // 85: proto.DoDSettlementAPI.GetUpdateOrderInfoBlock:output_type -> types.StateBlock
+	53, // 86: proto.DoDSettlementAPI.GetUpdateOrderInfoRewardBlock:output_type -> types.StateBlock
+	53, // 87: proto.DoDSettlementAPI.GetChangeOrderBlock:output_type -> types.StateBlock
+	53, // 88: proto.DoDSettlementAPI.GetChangeOrderRewardBlock:output_type -> types.StateBlock
+	53, // 89: proto.DoDSettlementAPI.GetTerminateOrderBlock:output_type -> types.StateBlock
+	53, // 90: proto.DoDSettlementAPI.GetTerminateOrderRewardBlock:output_type -> types.StateBlock
+	53, // 91: proto.DoDSettlementAPI.GetUpdateProductInfoBlock:output_type -> types.StateBlock
+	53, // 92: proto.DoDSettlementAPI.GetUpdateProductInfoRewardBlock:output_type -> types.StateBlock
+	13, // 93: proto.DoDSettlementAPI.GetOrderInfoBySellerAndOrderId:output_type -> proto.DoDSettleOrderInfo
+	13, // 94: proto.DoDSettlementAPI.GetOrderInfoByInternalId:output_type -> proto.DoDSettleOrderInfo
+	16, // 95: proto.DoDSettlementAPI.GetProductInfoBySellerAndProductId:output_type -> proto.DoDSettleConnectionInfo
+	18, // 96: proto.DoDSettlementAPI.GetPendingRequest:output_type -> proto.DoDPendingRequestRsps
+	20, // 97: proto.DoDSettlementAPI.GetPendingResourceCheck:output_type -> proto.DoDPendingResourceCheckInfos
+	22, // 98: proto.DoDSettlementAPI.GetPlacingOrder:output_type -> proto.DoDPlacingOrderResp
+	24, // 99: proto.DoDSettlementAPI.GetProductIdListByAddress:output_type -> proto.DoDSettleProducts
+	26, // 100: proto.DoDSettlementAPI.GetOrderIdListByAddress:output_type -> proto.DoDSettleOrders
+	24, // 101: proto.DoDSettlementAPI.GetProductIdListByAddressAndSeller:output_type -> proto.DoDSettleProducts
+	26, // 102: proto.DoDSettlementAPI.GetOrderIdListByAddressAndSeller:output_type -> proto.DoDSettleOrders
+	54, // 103: proto.DoDSettlementAPI.GetOrderCountByAddress:output_type -> proto.Int32
+	27, // 104: proto.DoDSettlementAPI.GetOrderInfoByAddress:output_type -> proto.DoDSettlementOrderInfoResp
+	54, // 105: proto.DoDSettlementAPI.GetOrderCountByAddressAndSeller:output_type -> proto.Int32
+	27, // 106: proto.DoDSettlementAPI.GetOrderInfoByAddressAndSeller:output_type -> proto.DoDSettlementOrderInfoResp
+	54, // 107: proto.DoDSettlementAPI.GetProductCountByAddress:output_type -> proto.Int32
+	28, // 108: proto.DoDSettlementAPI.GetProductInfoByAddress:output_type -> proto.DoDSettlementProductInfoResp
+	54, // 109: proto.DoDSettlementAPI.GetProductCountByAddressAndSeller:output_type -> proto.Int32
+	28, // 110: proto.DoDSettlementAPI.GetProductInfoByAddressAndSeller:output_type -> proto.DoDSettlementProductInfoResp
+	32, // 111: proto.DoDSettlementAPI.GenerateInvoiceByOrderId:output_type -> proto.DoDSettleOrderInvoice
+	33, // 112: proto.DoDSettlementAPI.GenerateInvoiceByBuyer:output_type -> proto.DoDSettleBuyerInvoice
+	34, // 113: proto.DoDSettlementAPI.GenerateInvoiceByProductId:output_type -> proto.DoDSettleProductInvoice
+	52, // 114: proto.DoDSettlementAPI.GetInternalIdByOrderId:output_type -> types.Hash
+	53, // 115: proto.DoDSettlementAPI.GetIssueInvoiceBlock:output_type -> types.StateBlock
+	53, // 116: proto.DoDSettlementAPI.GetPayInvoiceBlock:output_type -> types.StateBlock
+	53, // 117: proto.DoDSettlementAPI.GetRefundInvoiceBlock:output_type -> types.StateBlock
+	53, // 118: proto.DoDSettlementAPI.GetInvoiceRewardBlock:output_type -> types.StateBlock
+	48, // 119: proto.DoDSettlementAPI.GetInvoicePaymentStatus:output_type -> proto.DoDSettleInvoicePaymentStatus
+	49, // 120: proto.DoDSettlementAPI.GetOrderPaymentStatus:output_type -> proto.DoDSettleInvoicePaymentStatuses
+	83, // [83:121] is the sub-list for method output_type
+	45, // [45:83] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
+}
+
+func init() { file_dod_settlement_proto_init() }
+func file_dod_settlement_proto_init() {
+	if File_dod_settlement_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_dod_settlement_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleConnectionDynamicParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleConnectionParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleChangeConnectionParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleCreateOrderParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleResponseParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleOrderItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleUpdateOrderInfoParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleChangeOrderParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleTerminateOrderParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleProductInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleUpdateProductInfoParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleOrderLifeTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleOrderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleConnectionLifeTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleDisconnectInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleConnectionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPendingRequestRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPendingRequestRsps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPendingResourceCheckInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPendingResourceCheckInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPlacingOrderInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPlacingOrderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleProducts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleOrders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettlementOrderInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettlementProductInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleInvoiceConnDynamic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleInvoiceConnDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleInvoiceOrderDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleOrderInvoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleBuyerInvoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleProductInvoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDOrderIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDProductIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDPlacingOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDAddressAndSellerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDAddressOffsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDAddressAndSellerOffsetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDInvoiceByOrderIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDInvoiceByBuyerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDInvoiceByProductIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleIssueInvoiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettlePayInvoiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleRefundInvoiceParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleInvoicePaymentDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleInvoicePaymentStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dod_settlement_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoDSettleInvoicePaymentStatuses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dod_settlement_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   50,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dod_settlement_proto_goTypes,
+		DependencyIndexes: file_dod_settlement_proto_depIdxs,
+		MessageInfos:      file_dod_settlement_proto_msgTypes,
+	}.Build()
+	File_dod_settlement_proto = out.File
+	file_dod_settlement_proto_rawDesc = nil
+	file_dod_settlement_proto_goTypes = nil
+	file_dod_settlement_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DoDSettlementAPIClient is the client API for DoDSettlementAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DoDSettlementAPIClient interface {
+	GetCreateOrderBlock(ctx context.Context, in *DoDSettleCreateOrderParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetCreateOrderRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetUpdateOrderInfoBlock(ctx context.Context, in *DoDSettleUpdateOrderInfoParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetUpdateOrderInfoRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetChangeOrderBlock(ctx context.Context, in *DoDSettleChangeOrderParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetChangeOrderRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetTerminateOrderBlock(ctx context.Context, in *DoDSettleTerminateOrderParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetTerminateOrderRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetUpdateProductInfoBlock(ctx context.Context, in *DoDSettleUpdateProductInfoParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetUpdateProductInfoRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetOrderInfoBySellerAndOrderId(ctx context.Context, in *DoDOrderIdRequest, opts ...grpc.CallOption) (*DoDSettleOrderInfo, error)
+	GetOrderInfoByInternalId(ctx context.Context, in *String, opts ...grpc.CallOption) (*DoDSettleOrderInfo, error)
+	GetProductInfoBySellerAndProductId(ctx context.Context, in *DoDProductIdRequest, opts ...grpc.CallOption) (*DoDSettleConnectionInfo, error)
+	GetPendingRequest(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDPendingRequestRsps, error)
+	GetPendingResourceCheck(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDPendingResourceCheckInfos, error)
+	GetPlacingOrder(ctx context.Context, in *DoDPlacingOrderRequest, opts ...grpc.CallOption) (*DoDPlacingOrderResp, error)
+	GetProductIdListByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDSettleProducts, error)
+	GetOrderIdListByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDSettleOrders, error)
+	GetProductIdListByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*DoDSettleProducts, error)
+	GetOrderIdListByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*DoDSettleOrders, error)
+	GetOrderCountByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*Int32, error)
+	GetOrderInfoByAddress(ctx context.Context, in *DoDAddressOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementOrderInfoResp, error)
+	GetOrderCountByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*Int32, error)
+	GetOrderInfoByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementOrderInfoResp, error)
+	GetProductCountByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*Int32, error)
+	GetProductInfoByAddress(ctx context.Context, in *DoDAddressOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementProductInfoResp, error)
+	GetProductCountByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*Int32, error)
+	GetProductInfoByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementProductInfoResp, error)
+	GenerateInvoiceByOrderId(ctx context.Context, in *DoDInvoiceByOrderIdRequest, opts ...grpc.CallOption) (*DoDSettleOrderInvoice, error)
+	GenerateInvoiceByBuyer(ctx context.Context, in *DoDInvoiceByBuyerRequest, opts ...grpc.CallOption) (*DoDSettleBuyerInvoice, error)
+	GenerateInvoiceByProductId(ctx context.Context, in *DoDInvoiceByProductIdRequest, opts ...grpc.CallOption) (*DoDSettleProductInvoice, error)
+	GetInternalIdByOrderId(ctx context.Context, in *DoDOrderIdRequest, opts ...grpc.CallOption) (*types.Hash, error)
+	GetIssueInvoiceBlock(ctx context.Context, in *DoDSettleIssueInvoiceParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetPayInvoiceBlock(ctx context.Context, in *DoDSettlePayInvoiceParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetRefundInvoiceBlock(ctx context.Context, in *DoDSettleRefundInvoiceParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetInvoiceRewardBlock(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetInvoicePaymentStatus(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*DoDSettleInvoicePaymentStatus, error)
+	GetOrderPaymentStatus(ctx context.Context, in *DoDOrderIdRequest, opts ...grpc.CallOption) (*DoDSettleInvoicePaymentStatuses, error)
+}
+
+type doDSettlementAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDoDSettlementAPIClient(cc grpc.ClientConnInterface) DoDSettlementAPIClient {
+	return &doDSettlementAPIClient{cc}
+}
+
+func (c *doDSettlementAPIClient) GetCreateOrderBlock(ctx context.Context, in *DoDSettleCreateOrderParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetCreateOrderBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetCreateOrderRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetCreateOrderRewardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetUpdateOrderInfoBlock(ctx context.Context, in *DoDSettleUpdateOrderInfoParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetUpdateOrderInfoBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetUpdateOrderInfoRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetUpdateOrderInfoRewardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetChangeOrderBlock(ctx context.Context, in *DoDSettleChangeOrderParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetChangeOrderBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetChangeOrderRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetChangeOrderRewardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetTerminateOrderBlock(ctx context.Context, in *DoDSettleTerminateOrderParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetTerminateOrderBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetTerminateOrderRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetTerminateOrderRewardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetUpdateProductInfoBlock(ctx context.Context, in *DoDSettleUpdateProductInfoParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetUpdateProductInfoBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetUpdateProductInfoRewardBlock(ctx context.Context, in *DoDSettleResponseParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetUpdateProductInfoRewardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderInfoBySellerAndOrderId(ctx context.Context, in *DoDOrderIdRequest, opts ...grpc.CallOption) (*DoDSettleOrderInfo, error) {
+	out := new(DoDSettleOrderInfo)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderInfoBySellerAndOrderId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderInfoByInternalId(ctx context.Context, in *String, opts ...grpc.CallOption) (*DoDSettleOrderInfo, error) {
+	out := new(DoDSettleOrderInfo)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderInfoByInternalId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductInfoBySellerAndProductId(ctx context.Context, in *DoDProductIdRequest, opts ...grpc.CallOption) (*DoDSettleConnectionInfo, error) {
+	out := new(DoDSettleConnectionInfo)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductInfoBySellerAndProductId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetPendingRequest(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDPendingRequestRsps, error) {
+	out := new(DoDPendingRequestRsps)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetPendingRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetPendingResourceCheck(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDPendingResourceCheckInfos, error) {
+	out := new(DoDPendingResourceCheckInfos)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetPendingResourceCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetPlacingOrder(ctx context.Context, in *DoDPlacingOrderRequest, opts ...grpc.CallOption) (*DoDPlacingOrderResp, error) {
+	out := new(DoDPlacingOrderResp)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetPlacingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductIdListByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDSettleProducts, error) {
+	out := new(DoDSettleProducts)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductIdListByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderIdListByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*DoDSettleOrders, error) {
+	out := new(DoDSettleOrders)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderIdListByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductIdListByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*DoDSettleProducts, error) {
+	out := new(DoDSettleProducts)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductIdListByAddressAndSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderIdListByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*DoDSettleOrders, error) {
+	out := new(DoDSettleOrders)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderIdListByAddressAndSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderCountByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*Int32, error) {
+	out := new(Int32)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderCountByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderInfoByAddress(ctx context.Context, in *DoDAddressOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementOrderInfoResp, error) {
+	out := new(DoDSettlementOrderInfoResp)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderInfoByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderCountByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*Int32, error) {
+	out := new(Int32)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderCountByAddressAndSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderInfoByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementOrderInfoResp, error) {
+	out := new(DoDSettlementOrderInfoResp)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderInfoByAddressAndSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductCountByAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*Int32, error) {
+	out := new(Int32)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductCountByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductInfoByAddress(ctx context.Context, in *DoDAddressOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementProductInfoResp, error) {
+	out := new(DoDSettlementProductInfoResp)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductInfoByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductCountByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerRequest, opts ...grpc.CallOption) (*Int32, error) {
+	out := new(Int32)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductCountByAddressAndSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetProductInfoByAddressAndSeller(ctx context.Context, in *DoDAddressAndSellerOffsetRequest, opts ...grpc.CallOption) (*DoDSettlementProductInfoResp, error) {
+	out := new(DoDSettlementProductInfoResp)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetProductInfoByAddressAndSeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GenerateInvoiceByOrderId(ctx context.Context, in *DoDInvoiceByOrderIdRequest, opts ...grpc.CallOption) (*DoDSettleOrderInvoice, error) {
+	out := new(DoDSettleOrderInvoice)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GenerateInvoiceByOrderId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GenerateInvoiceByBuyer(ctx context.Context, in *DoDInvoiceByBuyerRequest, opts ...grpc.CallOption) (*DoDSettleBuyerInvoice, error) {
+	out := new(DoDSettleBuyerInvoice)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GenerateInvoiceByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GenerateInvoiceByProductId(ctx context.Context, in *DoDInvoiceByProductIdRequest, opts ...grpc.CallOption) (*DoDSettleProductInvoice, error) {
+	out := new(DoDSettleProductInvoice)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GenerateInvoiceByProductId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetInternalIdByOrderId(ctx context.Context, in *DoDOrderIdRequest, opts ...grpc.CallOption) (*types.Hash, error) {
+	out := new(types.Hash)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetInternalIdByOrderId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetIssueInvoiceBlock(ctx context.Context, in *DoDSettleIssueInvoiceParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetIssueInvoiceBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetPayInvoiceBlock(ctx context.Context, in *DoDSettlePayInvoiceParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetPayInvoiceBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetRefundInvoiceBlock(ctx context.Context, in *DoDSettleRefundInvoiceParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetRefundInvoiceBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetInvoiceRewardBlock(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetInvoiceRewardBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetInvoicePaymentStatus(ctx context.Context, in *types.Hash, opts ...grpc.CallOption) (*DoDSettleInvoicePaymentStatus, error) {
+	out := new(DoDSettleInvoicePaymentStatus)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetInvoicePaymentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doDSettlementAPIClient) GetOrderPaymentStatus(ctx context.Context, in *DoDOrderIdRequest, opts ...grpc.CallOption) (*DoDSettleInvoicePaymentStatuses, error) {
+	out := new(DoDSettleInvoicePaymentStatuses)
+	err := c.cc.Invoke(ctx, "/proto.DoDSettlementAPI/GetOrderPaymentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DoDSettlementAPIServer is the server API for DoDSettlementAPI service.
+type DoDSettlementAPIServer interface {
+	GetCreateOrderBlock(context.Context, *DoDSettleCreateOrderParam) (*types.StateBlock, error)
+	GetCreateOrderRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error)
+	GetUpdateOrderInfoBlock(context.Context, *DoDSettleUpdateOrderInfoParam) (*types.StateBlock, error)
+	GetUpdateOrderInfoRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error)
+	GetChangeOrderBlock(context.Context, *DoDSettleChangeOrderParam) (*types.StateBlock, error)
+	GetChangeOrderRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error)
+	GetTerminateOrderBlock(context.Context, *DoDSettleTerminateOrderParam) (*types.StateBlock, error)
+	GetTerminateOrderRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error)
+	GetUpdateProductInfoBlock(context.Context, *DoDSettleUpdateProductInfoParam) (*types.StateBlock, error)
+	GetUpdateProductInfoRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error)
+	GetOrderInfoBySellerAndOrderId(context.Context, *DoDOrderIdRequest) (*DoDSettleOrderInfo, error)
+	GetOrderInfoByInternalId(context.Context, *String) (*DoDSettleOrderInfo, error)
+	GetProductInfoBySellerAndProductId(context.Context, *DoDProductIdRequest) (*DoDSettleConnectionInfo, error)
+	GetPendingRequest(context.Context, *types.Address) (*DoDPendingRequestRsps, error)
+	GetPendingResourceCheck(context.Context, *types.Address) (*DoDPendingResourceCheckInfos, error)
+	GetPlacingOrder(context.Context, *DoDPlacingOrderRequest) (*DoDPlacingOrderResp, error)
+	GetProductIdListByAddress(context.Context, *types.Address) (*DoDSettleProducts, error)
+	GetOrderIdListByAddress(context.Context, *types.Address) (*DoDSettleOrders, error)
+	GetProductIdListByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*DoDSettleProducts, error)
+	GetOrderIdListByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*DoDSettleOrders, error)
+	GetOrderCountByAddress(context.Context, *types.Address) (*Int32, error)
+	GetOrderInfoByAddress(context.Context, *DoDAddressOffsetRequest) (*DoDSettlementOrderInfoResp, error)
+	GetOrderCountByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*Int32, error)
+	GetOrderInfoByAddressAndSeller(context.Context, *DoDAddressAndSellerOffsetRequest) (*DoDSettlementOrderInfoResp, error)
+	GetProductCountByAddress(context.Context, *types.Address) (*Int32, error)
+	GetProductInfoByAddress(context.Context, *DoDAddressOffsetRequest) (*DoDSettlementProductInfoResp, error)
+	GetProductCountByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*Int32, error)
+	GetProductInfoByAddressAndSeller(context.Context, *DoDAddressAndSellerOffsetRequest) (*DoDSettlementProductInfoResp, error)
+	GenerateInvoiceByOrderId(context.Context, *DoDInvoiceByOrderIdRequest) (*DoDSettleOrderInvoice, error)
+	GenerateInvoiceByBuyer(context.Context, *DoDInvoiceByBuyerRequest) (*DoDSettleBuyerInvoice, error)
+	GenerateInvoiceByProductId(context.Context, *DoDInvoiceByProductIdRequest) (*DoDSettleProductInvoice, error)
+	GetInternalIdByOrderId(context.Context, *DoDOrderIdRequest) (*types.Hash, error)
+	GetIssueInvoiceBlock(context.Context, *DoDSettleIssueInvoiceParam) (*types.StateBlock, error)
+	GetPayInvoiceBlock(context.Context, *DoDSettlePayInvoiceParam) (*types.StateBlock, error)
+	GetRefundInvoiceBlock(context.Context, *DoDSettleRefundInvoiceParam) (*types.StateBlock, error)
+	GetInvoiceRewardBlock(context.Context, *types.Hash) (*types.StateBlock, error)
+	GetInvoicePaymentStatus(context.Context, *types.Hash) (*DoDSettleInvoicePaymentStatus, error)
+	GetOrderPaymentStatus(context.Context, *DoDOrderIdRequest) (*DoDSettleInvoicePaymentStatuses, error)
+}
+
+// UnimplementedDoDSettlementAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedDoDSettlementAPIServer struct {
+}
+
+func (*UnimplementedDoDSettlementAPIServer) GetCreateOrderBlock(context.Context, *DoDSettleCreateOrderParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreateOrderBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetCreateOrderRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreateOrderRewardBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetUpdateOrderInfoBlock(context.Context, *DoDSettleUpdateOrderInfoParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateOrderInfoBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetUpdateOrderInfoRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateOrderInfoRewardBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetChangeOrderBlock(context.Context, *DoDSettleChangeOrderParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeOrderBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetChangeOrderRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeOrderRewardBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetTerminateOrderBlock(context.Context, *DoDSettleTerminateOrderParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerminateOrderBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetTerminateOrderRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerminateOrderRewardBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetUpdateProductInfoBlock(context.Context, *DoDSettleUpdateProductInfoParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateProductInfoBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetUpdateProductInfoRewardBlock(context.Context, *DoDSettleResponseParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateProductInfoRewardBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderInfoBySellerAndOrderId(context.Context, *DoDOrderIdRequest) (*DoDSettleOrderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderInfoBySellerAndOrderId not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderInfoByInternalId(context.Context, *String) (*DoDSettleOrderInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderInfoByInternalId not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductInfoBySellerAndProductId(context.Context, *DoDProductIdRequest) (*DoDSettleConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductInfoBySellerAndProductId not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetPendingRequest(context.Context, *types.Address) (*DoDPendingRequestRsps, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingRequest not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetPendingResourceCheck(context.Context, *types.Address) (*DoDPendingResourceCheckInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingResourceCheck not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetPlacingOrder(context.Context, *DoDPlacingOrderRequest) (*DoDPlacingOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlacingOrder not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductIdListByAddress(context.Context, *types.Address) (*DoDSettleProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductIdListByAddress not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderIdListByAddress(context.Context, *types.Address) (*DoDSettleOrders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderIdListByAddress not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductIdListByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*DoDSettleProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductIdListByAddressAndSeller not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderIdListByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*DoDSettleOrders, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderIdListByAddressAndSeller not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderCountByAddress(context.Context, *types.Address) (*Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderCountByAddress not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderInfoByAddress(context.Context, *DoDAddressOffsetRequest) (*DoDSettlementOrderInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderInfoByAddress not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderCountByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderCountByAddressAndSeller not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderInfoByAddressAndSeller(context.Context, *DoDAddressAndSellerOffsetRequest) (*DoDSettlementOrderInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderInfoByAddressAndSeller not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductCountByAddress(context.Context, *types.Address) (*Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductCountByAddress not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductInfoByAddress(context.Context, *DoDAddressOffsetRequest) (*DoDSettlementProductInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductInfoByAddress not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductCountByAddressAndSeller(context.Context, *DoDAddressAndSellerRequest) (*Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductCountByAddressAndSeller not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetProductInfoByAddressAndSeller(context.Context, *DoDAddressAndSellerOffsetRequest) (*DoDSettlementProductInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductInfoByAddressAndSeller not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GenerateInvoiceByOrderId(context.Context, *DoDInvoiceByOrderIdRequest) (*DoDSettleOrderInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInvoiceByOrderId not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GenerateInvoiceByBuyer(context.Context, *DoDInvoiceByBuyerRequest) (*DoDSettleBuyerInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInvoiceByBuyer not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GenerateInvoiceByProductId(context.Context, *DoDInvoiceByProductIdRequest) (*DoDSettleProductInvoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateInvoiceByProductId not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetInternalIdByOrderId(context.Context, *DoDOrderIdRequest) (*types.Hash, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInternalIdByOrderId not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetIssueInvoiceBlock(context.Context, *DoDSettleIssueInvoiceParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueInvoiceBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetPayInvoiceBlock(context.Context, *DoDSettlePayInvoiceParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayInvoiceBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetRefundInvoiceBlock(context.Context, *DoDSettleRefundInvoiceParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefundInvoiceBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetInvoiceRewardBlock(context.Context, *types.Hash) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceRewardBlock not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetInvoicePaymentStatus(context.Context, *types.Hash) (*DoDSettleInvoicePaymentStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoicePaymentStatus not implemented")
+}
+func (*UnimplementedDoDSettlementAPIServer) GetOrderPaymentStatus(context.Context, *DoDOrderIdRequest) (*DoDSettleInvoicePaymentStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderPaymentStatus not implemented")
+}
+
+func RegisterDoDSettlementAPIServer(s *grpc.Server, srv DoDSettlementAPIServer) {
+	s.RegisterService(&_DoDSettlementAPI_serviceDesc, srv)
+}
+
+func _DoDSettlementAPI_GetCreateOrderBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleCreateOrderParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetCreateOrderBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetCreateOrderBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetCreateOrderBlock(ctx, req.(*DoDSettleCreateOrderParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetCreateOrderRewardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleResponseParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetCreateOrderRewardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetCreateOrderRewardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetCreateOrderRewardBlock(ctx, req.(*DoDSettleResponseParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetUpdateOrderInfoBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleUpdateOrderInfoParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetUpdateOrderInfoBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetUpdateOrderInfoBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetUpdateOrderInfoBlock(ctx, req.(*DoDSettleUpdateOrderInfoParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetUpdateOrderInfoRewardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleResponseParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetUpdateOrderInfoRewardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetUpdateOrderInfoRewardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetUpdateOrderInfoRewardBlock(ctx, req.(*DoDSettleResponseParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetChangeOrderBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleChangeOrderParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetChangeOrderBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetChangeOrderBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetChangeOrderBlock(ctx, req.(*DoDSettleChangeOrderParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetChangeOrderRewardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleResponseParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetChangeOrderRewardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetChangeOrderRewardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetChangeOrderRewardBlock(ctx, req.(*DoDSettleResponseParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetTerminateOrderBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleTerminateOrderParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetTerminateOrderBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetTerminateOrderBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetTerminateOrderBlock(ctx, req.(*DoDSettleTerminateOrderParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetTerminateOrderRewardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleResponseParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetTerminateOrderRewardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetTerminateOrderRewardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetTerminateOrderRewardBlock(ctx, req.(*DoDSettleResponseParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetUpdateProductInfoBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleUpdateProductInfoParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetUpdateProductInfoBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetUpdateProductInfoBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetUpdateProductInfoBlock(ctx, req.(*DoDSettleUpdateProductInfoParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetUpdateProductInfoRewardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleResponseParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetUpdateProductInfoRewardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetUpdateProductInfoRewardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetUpdateProductInfoRewardBlock(ctx, req.(*DoDSettleResponseParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderInfoBySellerAndOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoBySellerAndOrderId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderInfoBySellerAndOrderId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoBySellerAndOrderId(ctx, req.(*DoDOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderInfoByInternalId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoByInternalId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderInfoByInternalId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoByInternalId(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductInfoBySellerAndProductId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDProductIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductInfoBySellerAndProductId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductInfoBySellerAndProductId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductInfoBySellerAndProductId(ctx, req.(*DoDProductIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetPendingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetPendingRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetPendingRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetPendingRequest(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetPendingResourceCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetPendingResourceCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetPendingResourceCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetPendingResourceCheck(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetPlacingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDPlacingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetPlacingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetPlacingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetPlacingOrder(ctx, req.(*DoDPlacingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductIdListByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductIdListByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductIdListByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductIdListByAddress(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderIdListByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderIdListByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderIdListByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderIdListByAddress(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductIdListByAddressAndSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressAndSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductIdListByAddressAndSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductIdListByAddressAndSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductIdListByAddressAndSeller(ctx, req.(*DoDAddressAndSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderIdListByAddressAndSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressAndSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderIdListByAddressAndSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderIdListByAddressAndSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderIdListByAddressAndSeller(ctx, req.(*DoDAddressAndSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderCountByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderCountByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderCountByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderCountByAddress(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderInfoByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderInfoByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoByAddress(ctx, req.(*DoDAddressOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderCountByAddressAndSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressAndSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderCountByAddressAndSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderCountByAddressAndSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderCountByAddressAndSeller(ctx, req.(*DoDAddressAndSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderInfoByAddressAndSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressAndSellerOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoByAddressAndSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderInfoByAddressAndSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderInfoByAddressAndSeller(ctx, req.(*DoDAddressAndSellerOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductCountByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductCountByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductCountByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductCountByAddress(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductInfoByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductInfoByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductInfoByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductInfoByAddress(ctx, req.(*DoDAddressOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductCountByAddressAndSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressAndSellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductCountByAddressAndSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductCountByAddressAndSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductCountByAddressAndSeller(ctx, req.(*DoDAddressAndSellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetProductInfoByAddressAndSeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDAddressAndSellerOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetProductInfoByAddressAndSeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetProductInfoByAddressAndSeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetProductInfoByAddressAndSeller(ctx, req.(*DoDAddressAndSellerOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GenerateInvoiceByOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDInvoiceByOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GenerateInvoiceByOrderId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GenerateInvoiceByOrderId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GenerateInvoiceByOrderId(ctx, req.(*DoDInvoiceByOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GenerateInvoiceByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDInvoiceByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GenerateInvoiceByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GenerateInvoiceByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GenerateInvoiceByBuyer(ctx, req.(*DoDInvoiceByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GenerateInvoiceByProductId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDInvoiceByProductIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GenerateInvoiceByProductId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GenerateInvoiceByProductId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GenerateInvoiceByProductId(ctx, req.(*DoDInvoiceByProductIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetInternalIdByOrderId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetInternalIdByOrderId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetInternalIdByOrderId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetInternalIdByOrderId(ctx, req.(*DoDOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetIssueInvoiceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleIssueInvoiceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetIssueInvoiceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetIssueInvoiceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetIssueInvoiceBlock(ctx, req.(*DoDSettleIssueInvoiceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetPayInvoiceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettlePayInvoiceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetPayInvoiceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetPayInvoiceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetPayInvoiceBlock(ctx, req.(*DoDSettlePayInvoiceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetRefundInvoiceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDSettleRefundInvoiceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetRefundInvoiceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetRefundInvoiceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetRefundInvoiceBlock(ctx, req.(*DoDSettleRefundInvoiceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetInvoiceRewardBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetInvoiceRewardBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetInvoiceRewardBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetInvoiceRewardBlock(ctx, req.(*types.Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetInvoicePaymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetInvoicePaymentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetInvoicePaymentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetInvoicePaymentStatus(ctx, req.(*types.Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoDSettlementAPI_GetOrderPaymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoDOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoDSettlementAPIServer).GetOrderPaymentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.DoDSettlementAPI/GetOrderPaymentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoDSettlementAPIServer).GetOrderPaymentStatus(ctx, req.(*DoDOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DoDSettlementAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.DoDSettlementAPI",
+	HandlerType: (*DoDSettlementAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCreateOrderBlock",
+			Handler:    _DoDSettlementAPI_GetCreateOrderBlock_Handler,
+		},
+		{
+			MethodName: "GetCreateOrderRewardBlock",
+			Handler:    _DoDSettlementAPI_GetCreateOrderRewardBlock_Handler,
+		},
+		{
+			MethodName: "GetUpdateOrderInfoBlock",
+			Handler:    _DoDSettlementAPI_GetUpdateOrderInfoBlock_Handler,
+		},
+		{
+			MethodName: "GetUpdateOrderInfoRewardBlock",
+			Handler:    _DoDSettlementAPI_GetUpdateOrderInfoRewardBlock_Handler,
+		},
+		{
+			MethodName: "GetChangeOrderBlock",
+			Handler:    _DoDSettlementAPI_GetChangeOrderBlock_Handler,
+		},
+		{
+			MethodName: "GetChangeOrderRewardBlock",
+			Handler:    _DoDSettlementAPI_GetChangeOrderRewardBlock_Handler,
+		},
+		{
+			MethodName: "GetTerminateOrderBlock",
+			Handler:    _DoDSettlementAPI_GetTerminateOrderBlock_Handler,
+		},
+		{
+			MethodName: "GetTerminateOrderRewardBlock",
+			Handler:    _DoDSettlementAPI_GetTerminateOrderRewardBlock_Handler,
+		},
+		{
+			MethodName: "GetUpdateProductInfoBlock",
+			Handler:    _DoDSettlementAPI_GetUpdateProductInfoBlock_Handler,
+		},
+		{
+			MethodName: "GetUpdateProductInfoRewardBlock",
+			Handler:    _DoDSettlementAPI_GetUpdateProductInfoRewardBlock_Handler,
+		},
+		{
+			MethodName: "GetOrderInfoBySellerAndOrderId",
+			Handler:    _DoDSettlementAPI_GetOrderInfoBySellerAndOrderId_Handler,
+		},
+		{
+			MethodName: "GetOrderInfoByInternalId",
+			Handler:    _DoDSettlementAPI_GetOrderInfoByInternalId_Handler,
+		},
+		{
+			MethodName: "GetProductInfoBySellerAndProductId",
+			Handler:    _DoDSettlementAPI_GetProductInfoBySellerAndProductId_Handler,
+		},
+		{
+			MethodName: "GetPendingRequest",
+			Handler:    _DoDSettlementAPI_GetPendingRequest_Handler,
+		},
+		{
+			MethodName: "GetPendingResourceCheck",
+			Handler:    _DoDSettlementAPI_GetPendingResourceCheck_Handler,
+		},
+		{
+			MethodName: "GetPlacingOrder",
+			Handler:    _DoDSettlementAPI_GetPlacingOrder_Handler,
+		},
+		{
+			MethodName: "GetProductIdListByAddress",
+			Handler:    _DoDSettlementAPI_GetProductIdListByAddress_Handler,
+		},
+		{
+			MethodName: "GetOrderIdListByAddress",
+			Handler:    _DoDSettlementAPI_GetOrderIdListByAddress_Handler,
+		},
+		{
+			MethodName: "GetProductIdListByAddressAndSeller",
+			Handler:    _DoDSettlementAPI_GetProductIdListByAddressAndSeller_Handler,
+		},
+		{
+			MethodName: "GetOrderIdListByAddressAndSeller",
+			Handler:    _DoDSettlementAPI_GetOrderIdListByAddressAndSeller_Handler,
+		},
+		{
+			MethodName: "GetOrderCountByAddress",
+			Handler:    _DoDSettlementAPI_GetOrderCountByAddress_Handler,
+		},
+		{
+			MethodName: "GetOrderInfoByAddress",
+			Handler:    _DoDSettlementAPI_GetOrderInfoByAddress_Handler,
+		},
+		{
+			MethodName: "GetOrderCountByAddressAndSeller",
+			Handler:    _DoDSettlementAPI_GetOrderCountByAddressAndSeller_Handler,
+		},
+		{
+			MethodName: "GetOrderInfoByAddressAndSeller",
+			Handler:    _DoDSettlementAPI_GetOrderInfoByAddressAndSeller_Handler,
+		},
+		{
+			MethodName: "GetProductCountByAddress",
+			Handler:    _DoDSettlementAPI_GetProductCountByAddress_Handler,
+		},
+		{
+			MethodName: "GetProductInfoByAddress",
+			Handler:    _DoDSettlementAPI_GetProductInfoByAddress_Handler,
+		},
+		{
+			MethodName: "GetProductCountByAddressAndSeller",
+			Handler:    _DoDSettlementAPI_GetProductCountByAddressAndSeller_Handler,
+		},
+		{
+			MethodName: "GetProductInfoByAddressAndSeller",
+			Handler:    _DoDSettlementAPI_GetProductInfoByAddressAndSeller_Handler,
+		},
+		{
+			MethodName: "GenerateInvoiceByOrderId",
+			Handler:    _DoDSettlementAPI_GenerateInvoiceByOrderId_Handler,
+		},
+		{
+			MethodName: "GenerateInvoiceByBuyer",
+			Handler:    _DoDSettlementAPI_GenerateInvoiceByBuyer_Handler,
+		},
+		{
+			MethodName: "GenerateInvoiceByProductId",
+			Handler:    _DoDSettlementAPI_GenerateInvoiceByProductId_Handler,
+		},
+		{
+			MethodName: "GetInternalIdByOrderId",
+			Handler:    _DoDSettlementAPI_GetInternalIdByOrderId_Handler,
+		},
+		{
+			MethodName: "GetIssueInvoiceBlock",
+			Handler:    _DoDSettlementAPI_GetIssueInvoiceBlock_Handler,
+		},
+		{
+			MethodName: "GetPayInvoiceBlock",
+			Handler:    _DoDSettlementAPI_GetPayInvoiceBlock_Handler,
+		},
+		{
+			MethodName: "GetRefundInvoiceBlock",
+			Handler:    _DoDSettlementAPI_GetRefundInvoiceBlock_Handler,
+		},
+		{
+			MethodName: "GetInvoiceRewardBlock",
+			Handler:    _DoDSettlementAPI_GetInvoiceRewardBlock_Handler,
+		},
+		{
+			MethodName: "GetInvoicePaymentStatus",
+			Handler:    _DoDSettlementAPI_GetInvoicePaymentStatus_Handler,
+		},
+		{
+			MethodName: "GetOrderPaymentStatus",
+			Handler:    _DoDSettlementAPI_GetOrderPaymentStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dod_settlement.proto",
+}
@@ -0,0 +1,1534 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.7.1
+// source: kyc.proto
+
+package proto
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	types "github.com/qlcchain/go-qlc/rpc/grpc/proto/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type KYCAdminUpdateParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Admin     string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Successor string `protobuf:"bytes,2,opt,name=successor,proto3" json:"successor,omitempty"`
+	Comment   string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *KYCAdminUpdateParam) Reset() {
+	*x = KYCAdminUpdateParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCAdminUpdateParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCAdminUpdateParam) ProtoMessage() {}
+
+func (x *KYCAdminUpdateParam) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCAdminUpdateParam.ProtoReflect.Descriptor instead.
+func (*KYCAdminUpdateParam) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KYCAdminUpdateParam) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *KYCAdminUpdateParam) GetSuccessor() string {
+	if x != nil {
+		return x.Successor
+	}
+	return ""
+}
+
+func (x *KYCAdminUpdateParam) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type KYCAdminUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *KYCAdminUser) Reset() {
+	*x = KYCAdminUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCAdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCAdminUser) ProtoMessage() {}
+
+func (x *KYCAdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCAdminUser.ProtoReflect.Descriptor instead.
+func (*KYCAdminUser) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KYCAdminUser) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *KYCAdminUser) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type KYCUpdateStatusParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator     string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ChainAddress string `protobuf:"bytes,2,opt,name=chainAddress,proto3" json:"chainAddress,omitempty"`
+	Status       string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *KYCUpdateStatusParam) Reset() {
+	*x = KYCUpdateStatusParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCUpdateStatusParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCUpdateStatusParam) ProtoMessage() {}
+
+func (x *KYCUpdateStatusParam) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCUpdateStatusParam.ProtoReflect.Descriptor instead.
+func (*KYCUpdateStatusParam) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KYCUpdateStatusParam) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *KYCUpdateStatusParam) GetChainAddress() string {
+	if x != nil {
+		return x.ChainAddress
+	}
+	return ""
+}
+
+func (x *KYCUpdateStatusParam) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type KYCStatusInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainAddress string `protobuf:"bytes,1,opt,name=chainAddress,proto3" json:"chainAddress,omitempty"`
+	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *KYCStatusInfo) Reset() {
+	*x = KYCStatusInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCStatusInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCStatusInfo) ProtoMessage() {}
+
+func (x *KYCStatusInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCStatusInfo.ProtoReflect.Descriptor instead.
+func (*KYCStatusInfo) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KYCStatusInfo) GetChainAddress() string {
+	if x != nil {
+		return x.ChainAddress
+	}
+	return ""
+}
+
+func (x *KYCStatusInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type KYCStatusInfos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Infos []*KYCStatusInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos,omitempty"`
+}
+
+func (x *KYCStatusInfos) Reset() {
+	*x = KYCStatusInfos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCStatusInfos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCStatusInfos) ProtoMessage() {}
+
+func (x *KYCStatusInfos) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCStatusInfos.ProtoReflect.Descriptor instead.
+func (*KYCStatusInfos) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KYCStatusInfos) GetInfos() []*KYCStatusInfo {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+type KYCUpdateTradeAddressParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator     string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ChainAddress string `protobuf:"bytes,2,opt,name=chainAddress,proto3" json:"chainAddress,omitempty"`
+	Action       string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TradeAddress string `protobuf:"bytes,4,opt,name=tradeAddress,proto3" json:"tradeAddress,omitempty"`
+	Comment      string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *KYCUpdateTradeAddressParam) Reset() {
+	*x = KYCUpdateTradeAddressParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCUpdateTradeAddressParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCUpdateTradeAddressParam) ProtoMessage() {}
+
+func (x *KYCUpdateTradeAddressParam) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCUpdateTradeAddressParam.ProtoReflect.Descriptor instead.
+func (*KYCUpdateTradeAddressParam) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KYCUpdateTradeAddressParam) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *KYCUpdateTradeAddressParam) GetChainAddress() string {
+	if x != nil {
+		return x.ChainAddress
+	}
+	return ""
+}
+
+func (x *KYCUpdateTradeAddressParam) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *KYCUpdateTradeAddressParam) GetTradeAddress() string {
+	if x != nil {
+		return x.TradeAddress
+	}
+	return ""
+}
+
+func (x *KYCUpdateTradeAddressParam) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type KYCTradeAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *KYCTradeAddress) Reset() {
+	*x = KYCTradeAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCTradeAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCTradeAddress) ProtoMessage() {}
+
+func (x *KYCTradeAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCTradeAddress.ProtoReflect.Descriptor instead.
+func (*KYCTradeAddress) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KYCTradeAddress) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *KYCTradeAddress) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type KYCTradeAddressPack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainAddress string             `protobuf:"bytes,1,opt,name=chainAddress,proto3" json:"chainAddress,omitempty"`
+	TradeAddress []*KYCTradeAddress `protobuf:"bytes,2,rep,name=tradeAddress,proto3" json:"tradeAddress,omitempty"`
+}
+
+func (x *KYCTradeAddressPack) Reset() {
+	*x = KYCTradeAddressPack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCTradeAddressPack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCTradeAddressPack) ProtoMessage() {}
+
+func (x *KYCTradeAddressPack) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCTradeAddressPack.ProtoReflect.Descriptor instead.
+func (*KYCTradeAddressPack) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KYCTradeAddressPack) GetChainAddress() string {
+	if x != nil {
+		return x.ChainAddress
+	}
+	return ""
+}
+
+func (x *KYCTradeAddressPack) GetTradeAddress() []*KYCTradeAddress {
+	if x != nil {
+		return x.TradeAddress
+	}
+	return nil
+}
+
+type KYCUpdateOperatorParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Admin    string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Action   string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Comment  string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *KYCUpdateOperatorParam) Reset() {
+	*x = KYCUpdateOperatorParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCUpdateOperatorParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCUpdateOperatorParam) ProtoMessage() {}
+
+func (x *KYCUpdateOperatorParam) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCUpdateOperatorParam.ProtoReflect.Descriptor instead.
+func (*KYCUpdateOperatorParam) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KYCUpdateOperatorParam) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *KYCUpdateOperatorParam) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *KYCUpdateOperatorParam) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *KYCUpdateOperatorParam) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type KYCOperatorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Comment  string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *KYCOperatorInfo) Reset() {
+	*x = KYCOperatorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCOperatorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCOperatorInfo) ProtoMessage() {}
+
+func (x *KYCOperatorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCOperatorInfo.ProtoReflect.Descriptor instead.
+func (*KYCOperatorInfo) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KYCOperatorInfo) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *KYCOperatorInfo) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type KYCOperatorInfos struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operators []*KYCOperatorInfo `protobuf:"bytes,1,rep,name=operators,proto3" json:"operators,omitempty"`
+}
+
+func (x *KYCOperatorInfos) Reset() {
+	*x = KYCOperatorInfos{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kyc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KYCOperatorInfos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KYCOperatorInfos) ProtoMessage() {}
+
+func (x *KYCOperatorInfos) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KYCOperatorInfos.ProtoReflect.Descriptor instead.
+func (*KYCOperatorInfos) Descriptor() ([]byte, []int) {
+	return file_kyc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KYCOperatorInfos) GetOperators() []*KYCOperatorInfo {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+var File_kyc_proto protoreflect.FileDescriptor
+
+var file_kyc_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x6b, 0x79, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63,
+	0x0a, 0x13, 0x4b, 0x59, 0x43, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x0c, 0x4b, 0x59, 0x43, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x14, 0x4b, 0x59, 0x43, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4b, 0x0a, 0x0d, 0x4b, 0x59, 0x43, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x4b, 0x59, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59,
+	0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66,
+	0x6f, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x1a, 0x4b, 0x59, 0x43, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x4b, 0x59, 0x43, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x75,
+	0x0a, 0x13, 0x4b, 0x59, 0x43, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x50, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x4b, 0x59, 0x43, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x0f, 0x4b, 0x59, 0x43, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x10,
+	0x4b, 0x59, 0x43, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x12, 0x34, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x32, 0x91, 0x09, 0x0a, 0x06, 0x4b, 0x59, 0x43, 0x41, 0x50,
+	0x49, 0x12, 0x6a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x48, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x12, 0x1a, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x4e, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d,
+	0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x69, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59,
+	0x43, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a,
+	0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x65, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7b, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4b, 0x59,
+	0x43, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x61, 0x63,
+	0x6b, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6b, 0x79, 0x63, 0x2f,
+	0x67, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x6f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4b, 0x59, 0x43, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x17, 0x12, 0x15, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4f, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4b, 0x59, 0x43, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x6b, 0x79, 0x63, 0x2f, 0x67,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kyc_proto_rawDescOnce sync.Once
+	file_kyc_proto_rawDescData = file_kyc_proto_rawDesc
+)
+
+func file_kyc_proto_rawDescGZIP() []byte {
+	file_kyc_proto_rawDescOnce.Do(func() {
+		file_kyc_proto_rawDescData = protoimpl.X.CompressGZIP(file_kyc_proto_rawDescData)
+	})
+	return file_kyc_proto_rawDescData
+}
+
+var file_kyc_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_kyc_proto_goTypes = []interface{}{
+	(*KYCAdminUpdateParam)(nil),        // 0: proto.KYCAdminUpdateParam
+	(*KYCAdminUser)(nil),               // 1: proto.KYCAdminUser
+	(*KYCUpdateStatusParam)(nil),       // 2: proto.KYCUpdateStatusParam
+	(*KYCStatusInfo)(nil),              // 3: proto.KYCStatusInfo
+	(*KYCStatusInfos)(nil),             // 4: proto.KYCStatusInfos
+	(*KYCUpdateTradeAddressParam)(nil), // 5: proto.KYCUpdateTradeAddressParam
+	(*KYCTradeAddress)(nil),            // 6: proto.KYCTradeAddress
+	(*KYCTradeAddressPack)(nil),        // 7: proto.KYCTradeAddressPack
+	(*KYCUpdateOperatorParam)(nil),     // 8: proto.KYCUpdateOperatorParam
+	(*KYCOperatorInfo)(nil),            // 9: proto.KYCOperatorInfo
+	(*KYCOperatorInfos)(nil),           // 10: proto.KYCOperatorInfos
+	(*empty.Empty)(nil),                // 11: google.protobuf.Empty
+	(*Offset)(nil),                     // 12: proto.Offset
+	(*types.Address)(nil),              // 13: types.Address
+	(*String)(nil),                     // 14: proto.String
+	(*types.StateBlock)(nil),           // 15: types.StateBlock
+	(*Int32)(nil),                      // 16: proto.Int32
+}
+var file_kyc_proto_depIdxs = []int32{
+	3,  // 0: proto.KYCStatusInfos.infos:type_name -> proto.KYCStatusInfo
+	6,  // 1: proto.KYCTradeAddressPack.tradeAddress:type_name -> proto.KYCTradeAddress
+	9,  // 2: proto.KYCOperatorInfos.operators:type_name -> proto.KYCOperatorInfo
+	0,  // 3: proto.KYCAPI.GetAdminHandoverBlock:input_type -> proto.KYCAdminUpdateParam
+	11, // 4: proto.KYCAPI.GetAdmin:input_type -> google.protobuf.Empty
+	2,  // 5: proto.KYCAPI.GetUpdateStatusBlock:input_type -> proto.KYCUpdateStatusParam
+	11, // 6: proto.KYCAPI.GetStatusCount:input_type -> google.protobuf.Empty
+	12, // 7: proto.KYCAPI.GetStatus:input_type -> proto.Offset
+	13, // 8: proto.KYCAPI.GetStatusByChainAddress:input_type -> types.Address
+	14, // 9: proto.KYCAPI.GetStatusByTradeAddress:input_type -> proto.String
+	5,  // 10: proto.KYCAPI.GetUpdateTradeAddressBlock:input_type -> proto.KYCUpdateTradeAddressParam
+	13, // 11: proto.KYCAPI.GetTradeAddress:input_type -> types.Address
+	8,  // 12: proto.KYCAPI.GetUpdateOperatorBlock:input_type -> proto.KYCUpdateOperatorParam
+	11, // 13: proto.KYCAPI.GetOperatorCount:input_type -> google.protobuf.Empty
+	12, // 14: proto.KYCAPI.GetOperator:input_type -> proto.Offset
+	15, // 15: proto.KYCAPI.GetAdminHandoverBlock:output_type -> types.StateBlock
+	1,  // 16: proto.KYCAPI.GetAdmin:output_type -> proto.KYCAdminUser
+	15, // 17: proto.KYCAPI.GetUpdateStatusBlock:output_type -> types.StateBlock
+	16, // 18: proto.KYCAPI.GetStatusCount:output_type -> proto.Int32
+	4,  // 19: proto.KYCAPI.GetStatus:output_type -> proto.KYCStatusInfos
+	3,  // 20: proto.KYCAPI.GetStatusByChainAddress:output_type -> proto.KYCStatusInfo
+	3,  // 21: proto.KYCAPI.GetStatusByTradeAddress:output_type -> proto.KYCStatusInfo
+	15, // 22: proto.KYCAPI.GetUpdateTradeAddressBlock:output_type -> types.StateBlock
+	7,  // 23: proto.KYCAPI.GetTradeAddress:output_type -> proto.KYCTradeAddressPack
+	15, // 24: proto.KYCAPI.GetUpdateOperatorBlock:output_type -> types.StateBlock
+	16, // 25: proto.KYCAPI.GetOperatorCount:output_type -> proto.Int32
+	10, // 26: proto.KYCAPI.GetOperator:output_type -> proto.KYCOperatorInfos
+	15, // [15:27] is the sub-list for method output_type
+	3,  // [3:15] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_kyc_proto_init() }
+func file_kyc_proto_init() {
+	if File_kyc_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_kyc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCAdminUpdateParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCAdminUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCUpdateStatusParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCStatusInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCStatusInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCUpdateTradeAddressParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCTradeAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCTradeAddressPack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCUpdateOperatorParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCOperatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kyc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KYCOperatorInfos); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kyc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kyc_proto_goTypes,
+		DependencyIndexes: file_kyc_proto_depIdxs,
+		MessageInfos:      file_kyc_proto_msgTypes,
+	}.Build()
+	File_kyc_proto = out.File
+	file_kyc_proto_rawDesc = nil
+	file_kyc_proto_goTypes = nil
+	file_kyc_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// KYCAPIClient is the client API for KYCAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KYCAPIClient interface {
+	GetAdminHandoverBlock(ctx context.Context, in *KYCAdminUpdateParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetAdmin(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*KYCAdminUser, error)
+	GetUpdateStatusBlock(ctx context.Context, in *KYCUpdateStatusParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetStatusCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Int32, error)
+	GetStatus(ctx context.Context, in *Offset, opts ...grpc.CallOption) (*KYCStatusInfos, error)
+	GetStatusByChainAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*KYCStatusInfo, error)
+	GetStatusByTradeAddress(ctx context.Context, in *String, opts ...grpc.CallOption) (*KYCStatusInfo, error)
+	GetUpdateTradeAddressBlock(ctx context.Context, in *KYCUpdateTradeAddressParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetTradeAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*KYCTradeAddressPack, error)
+	GetUpdateOperatorBlock(ctx context.Context, in *KYCUpdateOperatorParam, opts ...grpc.CallOption) (*types.StateBlock, error)
+	GetOperatorCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Int32, error)
+	GetOperator(ctx context.Context, in *Offset, opts ...grpc.CallOption) (*KYCOperatorInfos, error)
+}
+
+type kYCAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKYCAPIClient(cc grpc.ClientConnInterface) KYCAPIClient {
+	return &kYCAPIClient{cc}
+}
+
+func (c *kYCAPIClient) GetAdminHandoverBlock(ctx context.Context, in *KYCAdminUpdateParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetAdminHandoverBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetAdmin(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*KYCAdminUser, error) {
+	out := new(KYCAdminUser)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetUpdateStatusBlock(ctx context.Context, in *KYCUpdateStatusParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetUpdateStatusBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetStatusCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Int32, error) {
+	out := new(Int32)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetStatusCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetStatus(ctx context.Context, in *Offset, opts ...grpc.CallOption) (*KYCStatusInfos, error) {
+	out := new(KYCStatusInfos)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetStatusByChainAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*KYCStatusInfo, error) {
+	out := new(KYCStatusInfo)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetStatusByChainAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetStatusByTradeAddress(ctx context.Context, in *String, opts ...grpc.CallOption) (*KYCStatusInfo, error) {
+	out := new(KYCStatusInfo)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetStatusByTradeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetUpdateTradeAddressBlock(ctx context.Context, in *KYCUpdateTradeAddressParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetUpdateTradeAddressBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetTradeAddress(ctx context.Context, in *types.Address, opts ...grpc.CallOption) (*KYCTradeAddressPack, error) {
+	out := new(KYCTradeAddressPack)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetTradeAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetUpdateOperatorBlock(ctx context.Context, in *KYCUpdateOperatorParam, opts ...grpc.CallOption) (*types.StateBlock, error) {
+	out := new(types.StateBlock)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetUpdateOperatorBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetOperatorCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Int32, error) {
+	out := new(Int32)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetOperatorCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kYCAPIClient) GetOperator(ctx context.Context, in *Offset, opts ...grpc.CallOption) (*KYCOperatorInfos, error) {
+	out := new(KYCOperatorInfos)
+	err := c.cc.Invoke(ctx, "/proto.KYCAPI/GetOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KYCAPIServer is the server API for KYCAPI service.
+type KYCAPIServer interface {
+	GetAdminHandoverBlock(context.Context, *KYCAdminUpdateParam) (*types.StateBlock, error)
+	GetAdmin(context.Context, *empty.Empty) (*KYCAdminUser, error)
+	GetUpdateStatusBlock(context.Context, *KYCUpdateStatusParam) (*types.StateBlock, error)
+	GetStatusCount(context.Context, *empty.Empty) (*Int32, error)
+	GetStatus(context.Context, *Offset) (*KYCStatusInfos, error)
+	GetStatusByChainAddress(context.Context, *types.Address) (*KYCStatusInfo, error)
+	GetStatusByTradeAddress(context.Context, *String) (*KYCStatusInfo, error)
+	GetUpdateTradeAddressBlock(context.Context, *KYCUpdateTradeAddressParam) (*types.StateBlock, error)
+	GetTradeAddress(context.Context, *types.Address) (*KYCTradeAddressPack, error)
+	GetUpdateOperatorBlock(context.Context, *KYCUpdateOperatorParam) (*types.StateBlock, error)
+	GetOperatorCount(context.Context, *empty.Empty) (*Int32, error)
+	GetOperator(context.Context, *Offset) (*KYCOperatorInfos, error)
+}
+
+// UnimplementedKYCAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedKYCAPIServer struct {
+}
+
+func (*UnimplementedKYCAPIServer) GetAdminHandoverBlock(context.Context, *KYCAdminUpdateParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminHandoverBlock not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetAdmin(context.Context, *empty.Empty) (*KYCAdminUser, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdmin not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetUpdateStatusBlock(context.Context, *KYCUpdateStatusParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateStatusBlock not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetStatusCount(context.Context, *empty.Empty) (*Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusCount not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetStatus(context.Context, *Offset) (*KYCStatusInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetStatusByChainAddress(context.Context, *types.Address) (*KYCStatusInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusByChainAddress not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetStatusByTradeAddress(context.Context, *String) (*KYCStatusInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatusByTradeAddress not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetUpdateTradeAddressBlock(context.Context, *KYCUpdateTradeAddressParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateTradeAddressBlock not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetTradeAddress(context.Context, *types.Address) (*KYCTradeAddressPack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeAddress not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetUpdateOperatorBlock(context.Context, *KYCUpdateOperatorParam) (*types.StateBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpdateOperatorBlock not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetOperatorCount(context.Context, *empty.Empty) (*Int32, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperatorCount not implemented")
+}
+func (*UnimplementedKYCAPIServer) GetOperator(context.Context, *Offset) (*KYCOperatorInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperator not implemented")
+}
+
+func RegisterKYCAPIServer(s *grpc.Server, srv KYCAPIServer) {
+	s.RegisterService(&_KYCAPI_serviceDesc, srv)
+}
+
+func _KYCAPI_GetAdminHandoverBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KYCAdminUpdateParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetAdminHandoverBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetAdminHandoverBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetAdminHandoverBlock(ctx, req.(*KYCAdminUpdateParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetAdmin(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetUpdateStatusBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KYCUpdateStatusParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetUpdateStatusBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetUpdateStatusBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetUpdateStatusBlock(ctx, req.(*KYCUpdateStatusParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetStatusCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetStatusCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetStatusCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetStatusCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Offset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetStatus(ctx, req.(*Offset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetStatusByChainAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetStatusByChainAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetStatusByChainAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetStatusByChainAddress(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetStatusByTradeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetStatusByTradeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetStatusByTradeAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetStatusByTradeAddress(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetUpdateTradeAddressBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KYCUpdateTradeAddressParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetUpdateTradeAddressBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetUpdateTradeAddressBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetUpdateTradeAddressBlock(ctx, req.(*KYCUpdateTradeAddressParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetTradeAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetTradeAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetTradeAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetTradeAddress(ctx, req.(*types.Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetUpdateOperatorBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KYCUpdateOperatorParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetUpdateOperatorBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetUpdateOperatorBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetUpdateOperatorBlock(ctx, req.(*KYCUpdateOperatorParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetOperatorCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetOperatorCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetOperatorCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetOperatorCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KYCAPI_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Offset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KYCAPIServer).GetOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.KYCAPI/GetOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KYCAPIServer).GetOperator(ctx, req.(*Offset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KYCAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.KYCAPI",
+	HandlerType: (*KYCAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAdminHandoverBlock",
+			Handler:    _KYCAPI_GetAdminHandoverBlock_Handler,
+		},
+		{
+			MethodName: "GetAdmin",
+			Handler:    _KYCAPI_GetAdmin_Handler,
+		},
+		{
+			MethodName: "GetUpdateStatusBlock",
+			Handler:    _KYCAPI_GetUpdateStatusBlock_Handler,
+		},
+		{
+			MethodName: "GetStatusCount",
+			Handler:    _KYCAPI_GetStatusCount_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _KYCAPI_GetStatus_Handler,
+		},
+		{
+			MethodName: "GetStatusByChainAddress",
+			Handler:    _KYCAPI_GetStatusByChainAddress_Handler,
+		},
+		{
+			MethodName: "GetStatusByTradeAddress",
+			Handler:    _KYCAPI_GetStatusByTradeAddress_Handler,
+		},
+		{
+			MethodName: "GetUpdateTradeAddressBlock",
+			Handler:    _KYCAPI_GetUpdateTradeAddressBlock_Handler,
+		},
+		{
+			MethodName: "GetTradeAddress",
+			Handler:    _KYCAPI_GetTradeAddress_Handler,
+		},
+		{
+			MethodName: "GetUpdateOperatorBlock",
+			Handler:    _KYCAPI_GetUpdateOperatorBlock_Handler,
+		},
+		{
+			MethodName: "GetOperatorCount",
+			Handler:    _KYCAPI_GetOperatorCount_Handler,
+		},
+		{
+			MethodName: "GetOperator",
+			Handler:    _KYCAPI_GetOperator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kyc.proto",
+}
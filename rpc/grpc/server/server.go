@@ -125,7 +125,9 @@ func (r *GRPCServer) registerApi() {
 	pb.RegisterAccountAPIServer(r.rpc, apis.NewAccountApi())
 	pb.RegisterBlackHoleAPIServer(r.rpc, apis.NewBlackHoleAPI(r.ledger, r.cc))
 	pb.RegisterChainAPIServer(r.rpc, apis.NewChainAPI(r.ledger))
+	pb.RegisterConfigAPIServer(r.rpc, apis.NewConfigAPI(r.cfgFile))
 	pb.RegisterContractAPIServer(r.rpc, apis.NewContractAPI(r.cc, r.ledger))
+	pb.RegisterDebugAPIServer(r.rpc, apis.NewDebugAPI(r.cfgFile, r.eb))
 	pb.RegisterLedgerAPIServer(r.rpc, apis.NewLedgerApi(r.ctx, r.ledger, r.eb, r.cc))
 	pb.RegisterMetricsAPIServer(r.rpc, apis.NewMetricsAPI())
 	pb.RegisterMinerAPIServer(r.rpc, apis.NewMinerAPI(r.cfg, r.ledger))
@@ -152,9 +154,15 @@ func registerGWApi(ctx context.Context, gwmux *runtime.ServeMux, endpoint string
 	if err := pb.RegisterChainAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterConfigAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterContractAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
 		return err
 	}
+	if err := pb.RegisterDebugAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
+		return err
+	}
 	if err := pb.RegisterLedgerAPIHandlerFromEndpoint(ctx, gwmux, endpoint, opts); err != nil {
 		return err
 	}
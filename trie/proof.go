@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common/types"
+)
+
+var (
+	ErrProofKeyNotFound = errors.New("key not exist in trie")
+	ErrProofInvalid     = errors.New("invalid trie proof")
+)
+
+// Proof is a merkle proof of a key/value in trie, Nodes are serialized trie nodes
+// along the key path from root to leaf, Value is set when the leaf is a hash node
+type Proof struct {
+	Nodes [][]byte `json:"nodes"`
+	Value []byte   `json:"value,omitempty"`
+}
+
+// Prove returns the merkle proof of the key against current root hash
+func (trie *Trie) Prove(key []byte) (*Proof, error) {
+	proof := &Proof{
+		Nodes: make([][]byte, 0),
+	}
+
+	node := trie.Root
+	for {
+		if node == nil {
+			return nil, ErrProofKeyNotFound
+		}
+		data, err := node.Serialize()
+		if err != nil {
+			return nil, fmt.Errorf("serialize trie node failed, error is %s", err)
+		}
+		proof.Nodes = append(proof.Nodes, data)
+
+		switch node.NodeType() {
+		case FullNode:
+			if len(key) == 0 {
+				node = node.child
+			} else {
+				node = node.children[key[0]]
+				key = key[1:]
+			}
+		case ShortNode:
+			if !bytes.HasPrefix(key, node.key) {
+				return nil, ErrProofKeyNotFound
+			}
+			key = key[len(node.key):]
+			node = node.child
+		case ValueNode:
+			if len(key) != 0 {
+				return nil, ErrProofKeyNotFound
+			}
+			return proof, nil
+		case HashNode:
+			if len(key) != 0 {
+				return nil, ErrProofKeyNotFound
+			}
+			value, err := trie.getRefValue(node.value)
+			if err != nil || len(value) == 0 {
+				return nil, fmt.Errorf("get ref value failed, error is %v", err)
+			}
+			proof.Value = value
+			return proof, nil
+		default:
+			return nil, ErrProofInvalid
+		}
+	}
+}
+
+// VerifyProof checks the proof of the key against root hash without any store,
+// returns the value of the key if the proof is valid
+func VerifyProof(root types.Hash, key []byte, proof *Proof) ([]byte, error) {
+	if proof == nil || len(proof.Nodes) == 0 {
+		return nil, ErrProofInvalid
+	}
+
+	expected := root
+	for i, data := range proof.Nodes {
+		node := new(TrieNode)
+		if err := node.Deserialize(data); err != nil {
+			return nil, fmt.Errorf("deserialize proof node %d: %s", i, err)
+		}
+		// never trust the hash in serialized data
+		node.hash = nil
+		if h := node.Hash(); *h != expected {
+			return nil, fmt.Errorf("proof node %d hash mismatch, expect %s, got %s", i, expected, h)
+		}
+
+		last := i == len(proof.Nodes)-1
+		var next *TrieNode
+		switch node.NodeType() {
+		case FullNode:
+			if len(key) == 0 {
+				next = node.child
+			} else {
+				next = node.children[key[0]]
+				key = key[1:]
+			}
+		case ShortNode:
+			if !bytes.HasPrefix(key, node.key) {
+				return nil, ErrProofKeyNotFound
+			}
+			key = key[len(node.key):]
+			next = node.child
+		case ValueNode:
+			if !last || len(key) != 0 {
+				return nil, ErrProofInvalid
+			}
+			return node.value, nil
+		case HashNode:
+			if !last || len(key) != 0 {
+				return nil, ErrProofInvalid
+			}
+			valueHash, err := types.BytesToHash(node.value)
+			if err != nil || types.HashData(proof.Value) != valueHash {
+				return nil, errors.New("proof value hash mismatch")
+			}
+			return proof.Value, nil
+		default:
+			return nil, ErrProofInvalid
+		}
+
+		if next == nil {
+			return nil, ErrProofKeyNotFound
+		}
+		if last {
+			return nil, ErrProofInvalid
+		}
+		expected = *next.Hash()
+	}
+	return nil, ErrProofInvalid
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package trie
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func TestTrie_Prove(t *testing.T) {
+	teardownTestCase, trie := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	kvs := map[string][]byte{
+		"":           []byte("empty key"),
+		"tesab":      []byte("short value"),
+		"tesabcd":    bytes.Repeat([]byte("value.hash4"), 10),
+		"tesabcdefg": []byte("value"),
+	}
+	for i := 0; i < 100; i++ {
+		kvs["key"+strconv.Itoa(i)] = []byte(strconv.Itoa(i))
+	}
+	for k, v := range kvs {
+		trie.SetValue([]byte(k), v)
+	}
+	if _, err := trie.Save(); err != nil {
+		t.Fatal(err)
+	}
+	root := *trie.Hash()
+
+	for k, v := range kvs {
+		proof, err := trie.Prove([]byte(k))
+		if err != nil {
+			t.Fatal(k, err)
+		}
+		value, err := VerifyProof(root, []byte(k), proof)
+		if err != nil {
+			t.Fatal(k, err)
+		}
+		if !bytes.Equal(value, v) {
+			t.Fatal(k, value, v)
+		}
+		if _, err := VerifyProof(root, []byte(k+"x"), proof); err == nil {
+			t.Fatal("proof should not be valid for other key")
+		}
+	}
+
+	if _, err := trie.Prove([]byte("tesabc")); err != ErrProofKeyNotFound {
+		t.Fatal(err)
+	}
+
+	proof, _ := trie.Prove([]byte("tesabcd"))
+	proof.Value = []byte("fake value")
+	if _, err := VerifyProof(root, []byte("tesabcd"), proof); err == nil {
+		t.Fatal("fake value should not be verified")
+	}
+
+	proof, _ = trie.Prove([]byte("key1"))
+	last := len(proof.Nodes) - 1
+	proof.Nodes[last] = append([]byte{}, proof.Nodes[last]...)
+	proof.Nodes[last][len(proof.Nodes[last])-1] ^= 0xff
+	if _, err := VerifyProof(root, []byte("key1"), proof); err == nil {
+		t.Fatal("tampered proof should not be verified")
+	}
+}
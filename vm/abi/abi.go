@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+
+	"github.com/qlcchain/go-qlc/common/types"
 )
 
 // The ABIContract holds information about a contract's context and available
@@ -71,6 +73,30 @@ func (abi ABIContract) PackVariable(name string, args ...interface{}) ([]byte, e
 	return arguments, nil
 }
 
+// PackEvent packs the given event name to a vm log. Topics of the log will consist of
+// the event id (omitted for anonymous events) followed by the indexed values, data of
+// the log is the packed non-indexed arguments.
+func (abi ABIContract) PackEvent(name string, indexed []types.Hash, args ...interface{}) (*types.VmLog, error) {
+	event, exist := abi.Events[name]
+	if !exist {
+		return nil, fmt.Errorf("event '%s' not found", name)
+	}
+	if n := len(event.Inputs) - event.Inputs.LengthNonIndexed(); n != len(indexed) {
+		return nil, fmt.Errorf("indexed argument count mismatch: %d for %d", len(indexed), n)
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]types.Hash, 0, len(indexed)+1)
+	if !event.Anonymous {
+		topics = append(topics, event.Id())
+	}
+	topics = append(topics, indexed...)
+	return &types.VmLog{Topics: topics, Data: data}, nil
+}
+
 // UnpackMethod output in v according to the abi specification
 func (abi ABIContract) UnpackMethod(v interface{}, name string, output []byte) (err error) {
 	if len(output) <= 4 {
@@ -657,6 +657,49 @@ func TestBareEvents(t *testing.T) {
 	}
 }
 
+func TestABIContract_PackEvent(t *testing.T) {
+	const definition = `[
+	{ "type" : "event", "name" : "anon", "anonymous" : true, "inputs" : [{ "indexed":true, "name":"arg0", "type":"address" }] },
+	{ "type" : "event", "name" : "args", "inputs" : [{ "indexed":false, "name":"arg0", "type":"uint256" }, { "indexed":true, "name":"arg1", "type":"address" }] }
+	]`
+
+	abi, err := JSONToABIContract(strings.NewReader(definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := types.Address{1, 2, 3}
+	vmLog, err := abi.PackEvent("args", []types.Hash{types.Hash(addr)}, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vmLog.Topics) != 2 || vmLog.Topics[0] != abi.Events["args"].Id() || vmLog.Topics[1] != types.Hash(addr) {
+		t.Fatal("invalid topics", vmLog.Topics)
+	}
+	var v *big.Int
+	if err := abi.Events["args"].Inputs.NonIndexed().Unpack(&v, vmLog.Data); err != nil {
+		t.Fatal(err)
+	}
+	if v.Int64() != 100 {
+		t.Fatal("invalid data", v)
+	}
+
+	vmLog, err = abi.PackEvent("anon", []types.Hash{types.Hash(addr)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vmLog.Topics) != 1 || len(vmLog.Data) != 0 {
+		t.Fatal("invalid anonymous log", vmLog)
+	}
+
+	if _, err := abi.PackEvent("args", nil, big.NewInt(100)); err == nil {
+		t.Fatal("indexed count mismatch should fail")
+	}
+	if _, err := abi.PackEvent("none", nil); err == nil {
+		t.Fatal("unknown event should fail")
+	}
+}
+
 func TestBareVariables(t *testing.T) {
 	const definition1 = `[
 	{ "type" : "variable", "name" : "balance" },
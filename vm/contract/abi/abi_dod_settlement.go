@@ -3,10 +3,12 @@ package abi
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/vm/abi"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
 )
@@ -76,6 +78,21 @@ const (
 			{"name":"orderItemId","type":"string"},
 			{"name":"productId","type":"string"},
 			{"name":"productStatus","type":"string"}
+		]},
+		{"type":"function","name":"DoDSettleIssueInvoice","inputs":[
+			{"name":"seller","type":"address"},
+			{"name":"orderId","type":"string"},
+			{"name":"token","type":"hash"},
+			{"name":"startTime","type":"int64"},
+			{"name":"endTime","type":"int64"},
+			{"name":"dueTime","type":"int64"}
+		]},
+		{"type":"function","name":"DoDSettlePayInvoice","inputs":[
+			{"name":"invoiceId","type":"hash"}
+		]},
+		{"type":"function","name":"DoDSettleRefundInvoice","inputs":[
+			{"name":"invoiceId","type":"hash"},
+			{"name":"paymentId","type":"hash"}
 		]}
 	]`
 
@@ -84,6 +101,9 @@ const (
 	MethodNameDoDSettleChangeOrder       = "DoDSettleChangeOrder"
 	MethodNameDoDSettleTerminateOrder    = "DoDSettleTerminateOrder"
 	MethodNameDoDSettleUpdateProductInfo = "DoDSettleUpdateProductInfo"
+	MethodNameDoDSettleIssueInvoice      = "DoDSettleIssueInvoice"
+	MethodNameDoDSettlePayInvoice        = "DoDSettlePayInvoice"
+	MethodNameDoDSettleRefundInvoice     = "DoDSettleRefundInvoice"
 )
 
 var (
@@ -171,7 +191,8 @@ func DoDSettleCalcBillingUnit(unit DoDSettleBillingUnit, s, e int64) int {
 	}
 }
 
-func DoDSettleCalcAmount(bs, be, s, e int64, price float64, dc *DoDSettleInvoiceConnDynamic) float64 {
+// DoDSettleCalcAmount prorates price of [bs, be] to the part in [s, e], the amount is rounded to the currency of price
+func DoDSettleCalcAmount(bs, be, s, e int64, price types.Money, dc *DoDSettleInvoiceConnDynamic) types.Money {
 	var cs, ce int64
 
 	if s > bs {
@@ -189,16 +210,19 @@ func DoDSettleCalcAmount(bs, be, s, e int64, price float64, dc *DoDSettleInvoice
 	dc.InvoiceStartTime = cs
 	dc.InvoiceEndTime = ce
 
-	return float64(ce-cs) * price / float64(be-bs)
+	if be <= bs {
+		return types.ZeroMoney(price.Currency())
+	}
+	return price.MulRat(big.NewRat(ce-cs, be-bs), types.CurrencyPrecision(price.Currency()))
 }
 
-func DoDSettleCalcAdditionPrice(ns, ne int64, np float64, conn *DoDSettleConnectionInfo) (float64, error) {
+func DoDSettleCalcAdditionPrice(ns, ne int64, np types.Money, conn *DoDSettleConnectionInfo) (types.Money, error) {
 	invoice, err := DoDSettleGetProductInvoice(conn, ns, ne, true, true)
 	if err != nil {
-		return 0, err
+		return types.Money{}, err
 	}
 
-	return np - invoice.ConnectionAmount, nil
+	return np.Sub(invoice.ConnectionAmount), nil
 }
 
 func DoDSettleNeedInvoice(bs, be, s, e int64) bool {
@@ -481,7 +505,7 @@ func DoDSettleUpdateConnectionRawParam(ctx *vmstore.VMContext, param *DoDSettleC
 			cp.BillingUnit = param.BillingUnit
 		}
 
-		if param.Price > 0 {
+		if param.Price.Sign() > 0 {
 			cp.Price = param.Price
 		}
 
@@ -723,6 +747,7 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 					StartTime:      done.StartTime,
 					EndTime:        done.EndTime,
 				},
+				Amount: types.ZeroMoney(done.Currency),
 			}
 
 			for _, t := range conn.Track {
@@ -735,17 +760,17 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 			if done.BillingType == DoDSettleBillingTypeDOD {
 				if flight {
 					if split {
-						dc.Amount = DoDSettleCalcAmount(done.StartTime, done.EndTime, start, end, done.Addition, dc)
+						dc.Amount = DoDSettleCalcAmount(done.StartTime, done.EndTime, start, end, done.Addition.In(done.Currency), dc)
 					} else {
 						if done.StartTime >= start {
-							dc.Amount = done.Addition
+							dc.Amount = done.Addition.In(done.Currency).RoundCurrency()
 							dc.InvoiceStartTime = done.StartTime
 							dc.InvoiceEndTime = done.EndTime
 						}
 					}
 				} else {
 					if now >= done.EndTime {
-						dc.Amount = DoDSettleCalcAmount(done.StartTime, done.EndTime, start, end, done.Addition, dc)
+						dc.Amount = DoDSettleCalcAmount(done.StartTime, done.EndTime, start, end, done.Addition.In(done.Currency), dc)
 					}
 				}
 			} else {
@@ -774,14 +799,14 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 					}
 
 					dc.InvoiceUnitCount = DoDSettleCalcBillingUnit(done.BillingUnit, dc.InvoiceStartTime, dc.InvoiceEndTime)
-					dc.Amount = done.Price * float64(dc.InvoiceUnitCount)
+					dc.Amount = done.Price.In(done.Currency).Mul(int64(dc.InvoiceUnitCount)).RoundCurrency()
 				} else {
 					if done.StartTime >= start && done.StartTime < end {
 						dc.InvoiceStartTime = done.StartTime
 						dc.InvoiceEndTime = done.EndTime
 
 						dc.InvoiceUnitCount = DoDSettleCalcBillingUnit(done.BillingUnit, dc.InvoiceStartTime, dc.InvoiceEndTime)
-						dc.Amount = done.Price * float64(dc.InvoiceUnitCount)
+						dc.Amount = done.Price.In(done.Currency).Mul(int64(dc.InvoiceUnitCount)).RoundCurrency()
 					}
 				}
 			}
@@ -791,7 +816,7 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 			dc.InvoiceStartTimeStr = time.Unix(dc.InvoiceStartTime, 0).String()
 			dc.InvoiceEndTimeStr = time.Unix(dc.InvoiceEndTime, 0).String()
 
-			ic.ConnectionAmount += dc.Amount
+			ic.ConnectionAmount = ic.ConnectionAmount.Add(dc.Amount)
 			ic.Usage = append(ic.Usage, dc)
 		}
 	}
@@ -809,7 +834,7 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 				},
 				InvoiceStartTime: conn.Disconnect.DisconnectAt,
 				InvoiceEndTime:   conn.Disconnect.DisconnectAt,
-				Amount:           conn.Disconnect.Price,
+				Amount:           conn.Disconnect.Price.In(conn.Disconnect.Currency).RoundCurrency(),
 			}
 
 			for _, t := range conn.Track {
@@ -824,7 +849,7 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 			dc.InvoiceStartTimeStr = time.Unix(dc.InvoiceStartTime, 0).String()
 			dc.InvoiceEndTimeStr = time.Unix(dc.InvoiceEndTime, 0).String()
 
-			ic.ConnectionAmount += dc.Amount
+			ic.ConnectionAmount = ic.ConnectionAmount.Add(dc.Amount)
 			ic.Usage = append(ic.Usage, dc)
 		}
 	}
@@ -834,8 +859,13 @@ func DoDSettleCalcConnInvoice(conn *DoDSettleConnectionInfo, order *DoDSettleOrd
 
 func DoDSettleGetOrderInvoice(ctx *vmstore.VMContext, seller types.Address, order *DoDSettleOrderInfo, start, end int64,
 	flight, split bool) (*DoDSettleInvoiceOrderDetail, error) {
+	return DoDSettleCalcOrderInvoice(ctx, seller, order, start, end, time.Now().Unix(), flight, split)
+}
+
+// DoDSettleCalcOrderInvoice calculates the order invoice as it is at now
+func DoDSettleCalcOrderInvoice(ctx *vmstore.VMContext, seller types.Address, order *DoDSettleOrderInfo, start, end, now int64,
+	flight, split bool) (*DoDSettleInvoiceOrderDetail, error) {
 
-	now := time.Now().Unix()
 	invoiceOrder := new(DoDSettleInvoiceOrderDetail)
 	invoiceOrder.OrderId = order.OrderId
 	invoiceOrder.Connections = make([]*DoDSettleInvoiceConnDetail, 0)
@@ -868,7 +898,7 @@ func DoDSettleGetOrderInvoice(ctx *vmstore.VMContext, seller types.Address, orde
 
 		ic := DoDSettleCalcConnInvoice(conn, order, start, end, now, flight, split)
 
-		invoiceOrder.OrderAmount += ic.ConnectionAmount
+		invoiceOrder.OrderAmount = invoiceOrder.OrderAmount.Add(ic.ConnectionAmount)
 		invoiceOrder.ConnectionCount++
 		invoiceOrder.Connections = append(invoiceOrder.Connections, ic)
 	}
@@ -1004,7 +1034,7 @@ func DoDSettleGenerateInvoiceByBuyer(ctx *vmstore.VMContext, seller, buyer types
 			return nil, err
 		}
 
-		if invoiceOrder.OrderAmount == 0 {
+		if invoiceOrder.OrderAmount.IsZero() {
 			continue
 		}
 
@@ -1013,7 +1043,7 @@ func DoDSettleGenerateInvoiceByBuyer(ctx *vmstore.VMContext, seller, buyer types
 		}
 
 		invoice.OrderCount++
-		invoice.TotalAmount += invoiceOrder.OrderAmount
+		invoice.TotalAmount = invoice.TotalAmount.Add(invoiceOrder.OrderAmount)
 		invoice.Orders = append(invoice.Orders, invoiceOrder)
 	}
 
@@ -1160,3 +1190,328 @@ func DoDSettleUpdateUserProduct(ctx *vmstore.VMContext, buyer, seller types.Addr
 
 	return nil
 }
+
+const (
+	// invoice is in warning after overdue for the period, and is sent to collection after the next period
+	DoDSettleDunningWarningPeriod    = int64(7 * 24 * 3600)
+	DoDSettleDunningCollectionPeriod = int64(30 * 24 * 3600)
+)
+
+// DoDSettleCalcInvoice calculates the invoice of order in billing period, now is pov time of the issue block
+// so all nodes get the same amount
+func DoDSettleCalcInvoice(ctx *vmstore.VMContext, param *DoDSettleIssueInvoiceParam, now int64) (*DoDSettleInvoiceInfo, error) {
+	order, err := DoDSettleGetOrderInfoByOrderId(ctx, param.Seller, param.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceOrder, err := DoDSettleCalcOrderInvoice(ctx, param.Seller, order, param.StartTime, param.EndTime, now,
+		param.Flight, param.Split)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := order.Connections[0].Currency
+	amount := invoiceOrder.OrderAmount.In(currency)
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("nothing to invoice for order %s", param.OrderId)
+	}
+
+	token, err := ctx.GetTokenById(param.Token)
+	if err != nil {
+		return nil, fmt.Errorf("token %s not exist", param.Token)
+	}
+
+	tokenAmount := amount.Units(token.Decimals)
+	if tokenAmount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount %s is too small for token %s", amount, token.TokenSymbol)
+	}
+
+	return &DoDSettleInvoiceInfo{
+		InvoiceId:   param.InvoiceId(),
+		InternalId:  invoiceOrder.InternalId,
+		OrderId:     order.OrderId,
+		Buyer:       order.Buyer,
+		Seller:      order.Seller,
+		Currency:    currency,
+		Amount:      amount,
+		Token:       param.Token,
+		TokenAmount: types.Balance{Int: tokenAmount},
+		StartTime:   param.StartTime,
+		EndTime:     param.EndTime,
+		DueTime:     param.DueTime,
+		IssuedAt:    now,
+	}, nil
+}
+
+func dodSettleSeqKey(table uint8, id types.Hash, seq uint32) []byte {
+	var key []byte
+	key = append(key, table)
+	key = append(key, id.Bytes()...)
+	key = append(key, util.BE_Uint32ToBytes(seq)...)
+	return key
+}
+
+func DoDSettleSetInvoice(ctx *vmstore.VMContext, invoice *DoDSettleInvoiceInfo) error {
+	data, err := invoice.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+
+	var key []byte
+	key = append(key, DoDSettleDBTableInvoice)
+	key = append(key, invoice.InvoiceId.Bytes()...)
+	err = ctx.SetStorage(nil, key, data)
+	if err != nil {
+		return err
+	}
+
+	// invoices of order are indexed by sequence, so an index entry is only written once
+	ids, err := DoDSettleGetInvoiceIdsByInternalId(ctx, invoice.InternalId)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if id == invoice.InvoiceId {
+			return nil
+		}
+	}
+
+	return ctx.SetStorage(nil, dodSettleSeqKey(DoDSettleDBTableOrderInvoice, invoice.InternalId, uint32(len(ids))),
+		invoice.InvoiceId.Bytes())
+}
+
+func DoDSettleGetInvoice(ctx *vmstore.VMContext, invoiceId types.Hash) (*DoDSettleInvoiceInfo, error) {
+	var key []byte
+	key = append(key, DoDSettleDBTableInvoice)
+	key = append(key, invoiceId.Bytes()...)
+
+	data, err := ctx.GetStorage(nil, key)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := new(DoDSettleInvoiceInfo)
+	_, err = invoice.UnmarshalMsg(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+func DoDSettleGetInvoiceIdsByInternalId(ctx *vmstore.VMContext, internalId types.Hash) ([]types.Hash, error) {
+	ids := make([]types.Hash, 0)
+
+	for seq := uint32(0); ; seq++ {
+		data, err := ctx.GetStorage(nil, dodSettleSeqKey(DoDSettleDBTableOrderInvoice, internalId, seq))
+		if err != nil {
+			break
+		}
+
+		id, err := types.BytesToHash(data)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func DoDSettleAddInvoicePayment(ctx *vmstore.VMContext, invoiceId types.Hash, payment *DoDSettleInvoicePayment) error {
+	payments, err := DoDSettleGetInvoicePayments(ctx, invoiceId)
+	if err != nil {
+		return err
+	}
+
+	data, err := payment.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+
+	return ctx.SetStorage(nil, dodSettleSeqKey(DoDSettleDBTableInvoicePayment, invoiceId, uint32(len(payments))), data)
+}
+
+// DoDSettleGetInvoicePayments returns payments of invoice in the order they are paid
+func DoDSettleGetInvoicePayments(ctx *vmstore.VMContext, invoiceId types.Hash) ([]*DoDSettleInvoicePayment, error) {
+	payments := make([]*DoDSettleInvoicePayment, 0)
+
+	for seq := uint32(0); ; seq++ {
+		data, err := ctx.GetStorage(nil, dodSettleSeqKey(DoDSettleDBTableInvoicePayment, invoiceId, seq))
+		if err != nil {
+			break
+		}
+
+		payment := new(DoDSettleInvoicePayment)
+		_, err = payment.UnmarshalMsg(data)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+func DoDSettleGetInvoicePayment(ctx *vmstore.VMContext, invoiceId, paymentId types.Hash) (*DoDSettleInvoicePayment, error) {
+	payments, err := DoDSettleGetInvoicePayments(ctx, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range payments {
+		if p.PaymentId == paymentId {
+			return p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("payment %s not found", paymentId)
+}
+
+// DoDSettleGetInvoicePaid returns the token amount which has been applied to invoice
+func DoDSettleGetInvoicePaid(ctx *vmstore.VMContext, invoiceId types.Hash) (types.Balance, error) {
+	payments, err := DoDSettleGetInvoicePayments(ctx, invoiceId)
+	if err != nil {
+		return types.ZeroBalance, err
+	}
+
+	paid := types.ZeroBalance
+	for _, p := range payments {
+		paid = paid.Add(p.Applied)
+	}
+
+	return paid, nil
+}
+
+func DoDSettleSetInvoiceRefund(ctx *vmstore.VMContext, invoiceId, paymentId types.Hash, refund *DoDSettleInvoiceRefund) error {
+	data, err := refund.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+
+	var key []byte
+	key = append(key, DoDSettleDBTableInvoiceRefund)
+	key = append(key, invoiceId.Bytes()...)
+	key = append(key, paymentId.Bytes()...)
+	return ctx.SetStorage(nil, key, data)
+}
+
+func DoDSettleGetInvoiceRefund(ctx *vmstore.VMContext, invoiceId, paymentId types.Hash) (*DoDSettleInvoiceRefund, error) {
+	var key []byte
+	key = append(key, DoDSettleDBTableInvoiceRefund)
+	key = append(key, invoiceId.Bytes()...)
+	key = append(key, paymentId.Bytes()...)
+
+	data, err := ctx.GetStorage(nil, key)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := new(DoDSettleInvoiceRefund)
+	_, err = refund.UnmarshalMsg(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// DoDSettleDunning returns dunning state of invoice at now, invoice which is not paid off goes through
+// overdue, warning and collection after its due time
+func DoDSettleDunning(status DoDSettleInvoiceStatus, due, now int64) DoDSettleDunningState {
+	switch {
+	case status == DoDSettleInvoiceStatusPaid:
+		return DoDSettleDunningStateSettled
+	case now <= due:
+		return DoDSettleDunningStateCurrent
+	case now-due > DoDSettleDunningCollectionPeriod:
+		return DoDSettleDunningStateCollection
+	case now-due > DoDSettleDunningWarningPeriod:
+		return DoDSettleDunningStateWarning
+	default:
+		return DoDSettleDunningStateOverdue
+	}
+}
+
+func DoDSettleGetInvoicePaymentStatus(ctx *vmstore.VMContext, invoiceId types.Hash, now int64) (*DoDSettleInvoicePaymentStatus, error) {
+	invoice, err := DoDSettleGetInvoice(ctx, invoiceId)
+	if err != nil {
+		return nil, fmt.Errorf("get invoice %s err %s", invoiceId, err)
+	}
+
+	payments, err := DoDSettleGetInvoicePayments(ctx, invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := &DoDSettleInvoicePaymentStatus{
+		DoDSettleInvoiceInfo: invoice,
+		Status:               DoDSettleInvoiceStatusIssued,
+		Paid:                 types.ZeroBalance,
+		Refundable:           types.ZeroBalance,
+		Payments:             make([]*DoDSettleInvoicePaymentDetail, 0, len(payments)),
+	}
+
+	for _, p := range payments {
+		ps.Paid = ps.Paid.Add(p.Applied)
+		if ps.PaidAt == 0 && ps.Paid.Compare(invoice.TokenAmount) != types.BalanceCompSmaller {
+			ps.PaidAt = p.PaidAt
+		}
+
+		detail := &DoDSettleInvoicePaymentDetail{DoDSettleInvoicePayment: p}
+		if !p.Refund.IsZero() {
+			if refund, err := DoDSettleGetInvoiceRefund(ctx, invoiceId, p.PaymentId); err == nil {
+				detail.Refunded = true
+				detail.RefundAt = refund.RefundAt
+			} else {
+				ps.Refundable = ps.Refundable.Add(p.Refund)
+			}
+		}
+		ps.Payments = append(ps.Payments, detail)
+	}
+
+	ps.Outstanding = invoice.TokenAmount.Sub(ps.Paid)
+	if ps.Outstanding.Int.Sign() <= 0 {
+		ps.Outstanding = types.ZeroBalance
+		ps.Status = DoDSettleInvoiceStatusPaid
+	} else if !ps.Paid.IsZero() {
+		ps.Status = DoDSettleInvoiceStatusPartial
+	}
+
+	token, err := ctx.GetTokenById(invoice.Token)
+	if err != nil {
+		return nil, fmt.Errorf("token %s not exist", invoice.Token)
+	}
+	ps.PaidAmount = types.MoneyFromUnits(ps.Paid.Int, token.Decimals, invoice.Currency).RoundCurrency()
+	ps.Dunning = DoDSettleDunning(ps.Status, invoice.DueTime, now)
+
+	return ps, nil
+}
+
+// DoDSettleGetOrderPaymentStatus returns payment status of all invoices issued for order
+func DoDSettleGetOrderPaymentStatus(ctx *vmstore.VMContext, seller types.Address, orderId string, now int64) ([]*DoDSettleInvoicePaymentStatus, error) {
+	internalId, err := DoDSettleGetInternalIdByOrderId(ctx, seller, orderId)
+	if err != nil {
+		return nil, fmt.Errorf("get internal id err %s", err)
+	}
+
+	ids, err := DoDSettleGetInvoiceIdsByInternalId(ctx, internalId)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]*DoDSettleInvoicePaymentStatus, 0, len(ids))
+	for _, id := range ids {
+		ps, err := DoDSettleGetInvoicePaymentStatus(ctx, id, now)
+		if err != nil {
+			return nil, err
+		}
+		status = append(status, ps)
+	}
+
+	return status, nil
+}
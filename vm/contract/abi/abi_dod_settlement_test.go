@@ -1,12 +1,15 @@
 package abi
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
+	"github.com/qlcchain/go-qlc/ledger"
 	"github.com/qlcchain/go-qlc/mock"
 	"github.com/qlcchain/go-qlc/vm/abi"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
@@ -134,29 +137,29 @@ func TestDoDSettleBillingUnitRound(t *testing.T) {
 func TestDodSettleCalcAmount(t *testing.T) {
 	var bs, be, s, e int64
 	dc := new(DoDSettleInvoiceConnDynamic)
-	price := 8.0000
+	price := types.NewMoney(8, 0, "USD")
 
 	bs = 3600 * 2
 	be = 3600 * 10
 	s = 0
 	e = 3600 * 7
 	cp := DoDSettleCalcAmount(bs, be, s, e, price, dc)
-	if cp != 5 {
-		t.Fatal()
+	if cp.String() != "5.00 USD" {
+		t.Fatal(cp)
 	}
 
 	s = 3600 * 4
 	e = 3660 * 15
 	cp = DoDSettleCalcAmount(bs, be, s, e, price, dc)
-	if cp != 6 {
-		t.Fatal()
+	if cp.String() != "6.00 USD" {
+		t.Fatal(cp)
 	}
 
 	s = 3600 * 7
 	e = 3600 * 9
 	cp = DoDSettleCalcAmount(bs, be, s, e, price, dc)
-	if cp != 2 {
-		t.Fatal()
+	if cp.String() != "2.00 USD" {
+		t.Fatal(cp)
 	}
 }
 
@@ -164,16 +167,16 @@ func TestDoDSettleCalcAdditionPrice(t *testing.T) {
 	conn := &DoDSettleConnectionInfo{
 		Active: &DoDSettleConnectionDynamicParam{
 			BillingType: DoDSettleBillingTypeDOD,
-			Price:       200,
-			Addition:    200,
+			Price:       types.NewMoney(200, 0, ""),
+			Addition:    types.NewMoney(200, 0, ""),
 			StartTime:   0,
 			EndTime:     200,
 		},
 	}
 
-	add, err := DoDSettleCalcAdditionPrice(100, 150, 80, conn)
-	if err != nil || add != 30 {
-		t.Fatal()
+	add, err := DoDSettleCalcAdditionPrice(100, 150, types.NewMoney(80, 0, ""), conn)
+	if err != nil || add.Cmp(types.NewMoney(30, 0, "")) != 0 {
+		t.Fatal(add, err)
 	}
 }
 
@@ -297,7 +300,7 @@ func TestDoDSettleUpdateConnectionRawParam(t *testing.T) {
 	cp1.ServiceClass = DoDSettleServiceClassGold
 	cp1.Bandwidth = "100 Mbps"
 	cp1.BillingUnit = DoDSettleBillingUnitHour
-	cp1.Price = 10
+	cp1.Price = types.NewMoney(10, 0, "")
 	cp1.StartTime = 100
 	cp1.EndTime = 1000
 
@@ -313,7 +316,7 @@ func TestDoDSettleUpdateConnectionRawParam(t *testing.T) {
 
 	if crp1.ConnectionName != cp1.ConnectionName || crp1.PaymentType != cp1.PaymentType || crp1.BillingType != cp1.BillingType ||
 		crp1.Currency != cp1.Currency || crp1.ServiceClass != cp1.ServiceClass || crp1.Bandwidth != cp1.Bandwidth ||
-		crp1.Price != cp1.Price || crp1.StartTime != cp1.StartTime || crp1.EndTime != cp1.EndTime {
+		!crp1.Price.Equal(cp1.Price) || crp1.StartTime != cp1.StartTime || crp1.EndTime != cp1.EndTime {
 		t.Fatal()
 	}
 
@@ -326,7 +329,7 @@ func TestDoDSettleUpdateConnectionRawParam(t *testing.T) {
 	cp2.ServiceClass = DoDSettleServiceClassSilver
 	cp2.Bandwidth = "200 Mbps"
 	cp2.BillingUnit = DoDSettleBillingUnitSecond
-	cp2.Price = 100
+	cp2.Price = types.NewMoney(100, 0, "")
 	cp2.StartTime = 1000
 	cp2.EndTime = 10000
 
@@ -342,7 +345,7 @@ func TestDoDSettleUpdateConnectionRawParam(t *testing.T) {
 
 	if crp2.ConnectionName != cp2.ConnectionName || crp2.PaymentType != cp2.PaymentType || crp2.BillingType != cp2.BillingType ||
 		crp2.Currency != cp2.Currency || crp2.ServiceClass != cp2.ServiceClass || crp2.Bandwidth != cp2.Bandwidth ||
-		crp2.Price != cp2.Price || crp2.StartTime != cp2.StartTime || crp2.EndTime != cp2.EndTime {
+		!crp2.Price.Equal(cp2.Price) || crp2.StartTime != cp2.StartTime || crp2.EndTime != cp2.EndTime {
 		t.Fatal()
 	}
 }
@@ -499,7 +502,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			OrderId:     "order001",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       8.000,
+			Price:       types.NewMoney(8, 0, ""),
 			StartTime:   1000,
 			EndTime:     9000,
 		},
@@ -511,8 +514,8 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			OrderItemId: "oi1",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       8.000,
-			Addition:    8.000,
+			Price:       types.NewMoney(8, 0, ""),
+			Addition:    types.NewMoney(8, 0, ""),
 			StartTime:   1000,
 			EndTime:     9000,
 		},
@@ -523,7 +526,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			OrderId:     "order001",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       4.000,
+			Price:       types.NewMoney(4, 0, ""),
 			StartTime:   1000,
 			EndTime:     5000,
 		},
@@ -536,7 +539,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 		},
 		Disconnect: &DoDSettleDisconnectInfo{
 			OrderId:      "order001",
-			Price:        0,
+			Price:        types.NewMoney(0, 0, ""),
 			Currency:     "USD",
 			DisconnectAt: 3000,
 		},
@@ -548,7 +551,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			BillingType: DoDSettleBillingTypePAYG,
 			BillingUnit: DoDSettleBillingUnitHour,
 			Currency:    "USD",
-			Price:       4.000,
+			Price:       types.NewMoney(4, 0, ""),
 			StartTime:   1000,
 		},
 	}
@@ -560,7 +563,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			BillingType: DoDSettleBillingTypePAYG,
 			BillingUnit: DoDSettleBillingUnitHour,
 			Currency:    "USD",
-			Price:       4.000,
+			Price:       types.NewMoney(4, 0, ""),
 			StartTime:   1000,
 		},
 	}
@@ -571,7 +574,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			BillingType: DoDSettleBillingTypePAYG,
 			BillingUnit: DoDSettleBillingUnitHour,
 			Currency:    "USD",
-			Price:       4.000,
+			Price:       types.NewMoney(4, 0, ""),
 			StartTime:   3600,
 			EndTime:     7200,
 		},
@@ -588,7 +591,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 				BillingType: DoDSettleBillingTypePAYG,
 				BillingUnit: DoDSettleBillingUnitHour,
 				Currency:    "USD",
-				Price:       4.000,
+				Price:       types.NewMoney(4, 0, ""),
 				StartTime:   3600,
 				EndTime:     7200,
 			},
@@ -600,8 +603,8 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 			OrderId:     "order001",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       4.000,
-			Addition:    0,
+			Price:       types.NewMoney(4, 0, ""),
+			Addition:    types.NewMoney(0, 0, ""),
 			StartTime:   3600,
 			EndTime:     7200,
 		},
@@ -617,8 +620,8 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 				OrderId:     "order001",
 				BillingType: DoDSettleBillingTypeDOD,
 				Currency:    "USD",
-				Price:       4.000,
-				Addition:    0,
+				Price:       types.NewMoney(4, 0, ""),
+				Addition:    types.NewMoney(0, 0, ""),
 				StartTime:   3600,
 				EndTime:     7200,
 			},
@@ -642,7 +645,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if invoice.TotalAmount != float64(19) {
+	if invoice.TotalAmount.Cmp(types.NewMoney(19, 0, "")) != 0 {
 		t.Fatal()
 	}
 
@@ -653,7 +656,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if invoice.TotalAmount != float64(4) {
+	if invoice.TotalAmount.Cmp(types.NewMoney(4, 0, "")) != 0 {
 		t.Fatal()
 	}
 
@@ -664,7 +667,7 @@ func TestDodSettleGenerateInvoiceByOrder(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if invoice.TotalAmount != 13 {
+	if invoice.TotalAmount.Cmp(types.NewMoney(13, 0, "")) != 0 {
 		t.Fatal()
 	}
 
@@ -745,8 +748,8 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 			OrderItemId: "oi1",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       5.000,
-			Addition:    5.000,
+			Price:       types.NewMoney(5, 0, ""),
+			Addition:    types.NewMoney(5, 0, ""),
 			StartTime:   10000,
 			EndTime:     15000,
 		},
@@ -755,8 +758,8 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 				OrderId:     "order001",
 				BillingType: DoDSettleBillingTypeDOD,
 				Currency:    "USD",
-				Price:       4.000,
-				Addition:    4.000,
+				Price:       types.NewMoney(4, 0, ""),
+				Addition:    types.NewMoney(4, 0, ""),
 				StartTime:   1000,
 				EndTime:     5000,
 			},
@@ -764,15 +767,15 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 				OrderId:     "order002",
 				BillingType: DoDSettleBillingTypeDOD,
 				Currency:    "USD",
-				Price:       3.000,
-				Addition:    3.000,
+				Price:       types.NewMoney(3, 0, ""),
+				Addition:    types.NewMoney(3, 0, ""),
 				StartTime:   6000,
 				EndTime:     9000,
 			},
 		},
 		Disconnect: &DoDSettleDisconnectInfo{
 			OrderId:      "order004",
-			Price:        0,
+			Price:        types.NewMoney(0, 0, ""),
 			Currency:     "USD",
 			DisconnectAt: 10000,
 		},
@@ -793,7 +796,7 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 			BillingType: DoDSettleBillingTypePAYG,
 			BillingUnit: DoDSettleBillingUnitSecond,
 			Currency:    "USD",
-			Price:       5.000,
+			Price:       types.NewMoney(5, 0, ""),
 			StartTime:   10000,
 		},
 		Done: []*DoDSettleConnectionDynamicParam{
@@ -802,7 +805,7 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 				BillingType: DoDSettleBillingTypePAYG,
 				BillingUnit: DoDSettleBillingUnitSecond,
 				Currency:    "USD",
-				Price:       1.000,
+				Price:       types.NewMoney(1, 0, ""),
 				StartTime:   1000,
 				EndTime:     5000,
 			},
@@ -811,7 +814,7 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 				BillingType: DoDSettleBillingTypePAYG,
 				BillingUnit: DoDSettleBillingUnitSecond,
 				Currency:    "USD",
-				Price:       1.000,
+				Price:       types.NewMoney(1, 0, ""),
 				StartTime:   6000,
 				EndTime:     9000,
 			},
@@ -866,7 +869,7 @@ func TestDodSettleGenerateInvoiceByProduct(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if invoice.TotalAmount != float64(7) {
+	if invoice.TotalAmount.Cmp(types.NewMoney(7, 0, "")) != 0 {
 		t.Fatal()
 	}
 
@@ -931,7 +934,7 @@ func TestDodSettleGenerateInvoiceByBuyer(t *testing.T) {
 			OrderId:     "order001",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       8.000,
+			Price:       types.NewMoney(8, 0, ""),
 			StartTime:   1000,
 			EndTime:     9000,
 		},
@@ -942,7 +945,7 @@ func TestDodSettleGenerateInvoiceByBuyer(t *testing.T) {
 			OrderId:     "order001",
 			BillingType: DoDSettleBillingTypeDOD,
 			Currency:    "USD",
-			Price:       8.000,
+			Price:       types.NewMoney(8, 0, ""),
 			StartTime:   1000,
 			EndTime:     9000,
 		},
@@ -1121,3 +1124,242 @@ func TestDoDSettleNeedInvoice(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func addDoDSettleTestInvoiceOrder(t *testing.T, ctx *vmstore.VMContext, buyer, seller types.Address) *DoDSettleOrderInfo {
+	order := &DoDSettleOrderInfo{
+		Buyer:         &DoDSettleUser{Address: buyer, Name: "B1"},
+		Seller:        &DoDSettleUser{Address: seller, Name: "S1"},
+		OrderId:       "order001",
+		OrderType:     DoDSettleOrderTypeCreate,
+		OrderState:    DoDSettleOrderStateSuccess,
+		ContractState: DoDSettleContractStateConfirmed,
+		Connections: []*DoDSettleConnectionParam{
+			{
+				DoDSettleConnectionStaticParam: DoDSettleConnectionStaticParam{ProductId: "product001"},
+				DoDSettleConnectionDynamicParam: DoDSettleConnectionDynamicParam{
+					OrderId:     "order001",
+					BillingType: DoDSettleBillingTypeDOD,
+					PaymentType: DoDSettlePaymentTypeStableCoin,
+					Currency:    "USD",
+					Price:       types.NewMoney(8, 0, ""),
+					StartTime:   1000,
+					EndTime:     9000,
+				},
+			},
+		},
+	}
+	connInfo := &DoDSettleConnectionInfo{
+		DoDSettleConnectionStaticParam: DoDSettleConnectionStaticParam{ProductId: "product001"},
+		Active: &DoDSettleConnectionDynamicParam{
+			OrderId:     "order001",
+			OrderItemId: "oi1",
+			BillingType: DoDSettleBillingTypeDOD,
+			PaymentType: DoDSettlePaymentTypeStableCoin,
+			Currency:    "USD",
+			Price:       types.NewMoney(8, 0, ""),
+			Addition:    types.NewMoney(8, 0, ""),
+			StartTime:   1000,
+			EndTime:     9000,
+		},
+	}
+
+	addDoDSettleTestOrder(t, ctx, order, mock.Hash())
+	addDoDSettleTestConnection(t, ctx, connInfo, seller)
+	return order
+}
+
+func addDoDSettleTestToken(t *testing.T, l *ledger.Ledger, decimals uint8) types.Hash {
+	tokenId := mock.Hash()
+	data, err := mintage.MintageABI.PackVariable(mintage.VariableNameToken, tokenId, "USDT", "USDT",
+		big.NewInt(1e9), decimals, mock.Address(), big.NewInt(0), int64(0), mock.Address(), mock.Hash().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	if err := ctx.SetStorage(contractaddress.MintageAddress[:], tokenId[:], data); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	return tokenId
+}
+
+func TestDoDSettleIssueInvoiceParam_Verify(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.DoDSettlementAddress)
+	buyer := mock.Address()
+	seller := mock.Address()
+	order := addDoDSettleTestInvoiceOrder(t, ctx, buyer, seller)
+	token := addDoDSettleTestToken(t, l, 6)
+
+	param := &DoDSettleIssueInvoiceParam{
+		Seller:    seller,
+		OrderId:   order.OrderId,
+		Token:     token,
+		StartTime: 1000,
+		EndTime:   9000,
+		DueTime:   10000,
+	}
+	if err := param.Verify(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := param.ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2 := new(DoDSettleIssueInvoiceParam)
+	if err := p2.FromABI(data); err != nil {
+		t.Fatal(err)
+	}
+	p2.Seller = seller
+	if p2.InvoiceId() != param.InvoiceId() {
+		t.Fatal("invoice id changed")
+	}
+
+	p2.StartTime = 1100
+	if p2.InvoiceId() == param.InvoiceId() {
+		t.Fatal("invoice id should be unique for billing period")
+	}
+
+	p2.DueTime = 8000
+	if err := p2.Verify(ctx); err == nil {
+		t.Fatal("due time before end time")
+	}
+
+	p2.DueTime = 10000
+	p2.Token = mock.Hash()
+	if err := p2.Verify(ctx); err == nil {
+		t.Fatal("token not exist")
+	}
+
+	p2.Token = token
+	p2.Seller = mock.Address()
+	if err := p2.Verify(ctx); err == nil {
+		t.Fatal("order of other seller")
+	}
+}
+
+func TestDoDSettleInvoicePaymentStatus(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.DoDSettlementAddress)
+	buyer := mock.Address()
+	seller := mock.Address()
+	order := addDoDSettleTestInvoiceOrder(t, ctx, buyer, seller)
+	token := addDoDSettleTestToken(t, l, 6)
+
+	param := &DoDSettleIssueInvoiceParam{
+		Seller:    seller,
+		OrderId:   order.OrderId,
+		Token:     token,
+		StartTime: 1000,
+		EndTime:   9000,
+		DueTime:   10000,
+	}
+	invoice, err := DoDSettleCalcInvoice(ctx, param, 9500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Currency != "USD" || invoice.Buyer.Address != buyer || invoice.InvoiceId != param.InvoiceId() {
+		t.Fatal("invalid invoice", invoice)
+	}
+	if invoice.TokenAmount.Int.Cmp(invoice.Amount.Units(6)) != 0 {
+		t.Fatal("invalid token amount", invoice.TokenAmount, invoice.Amount)
+	}
+
+	if err := DoDSettleSetInvoice(ctx, invoice); err != nil {
+		t.Fatal(err)
+	}
+	if err := DoDSettleSetInvoice(ctx, invoice); err != nil {
+		t.Fatal(err)
+	}
+	if ids, err := DoDSettleGetInvoiceIdsByInternalId(ctx, invoice.InternalId); err != nil || len(ids) != 1 {
+		t.Fatal("invoice should be indexed once", ids, err)
+	}
+
+	ps, err := DoDSettleGetInvoicePaymentStatus(ctx, invoice.InvoiceId, 9500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.Status != DoDSettleInvoiceStatusIssued || ps.Dunning != DoDSettleDunningStateCurrent ||
+		ps.Outstanding.Compare(invoice.TokenAmount) != types.BalanceCompEqual {
+		t.Fatal("invalid status", ps)
+	}
+
+	half := types.Balance{Int: new(big.Int).Div(invoice.TokenAmount.Int, big.NewInt(2))}
+	p1 := &DoDSettleInvoicePayment{PaymentId: mock.Hash(), Payer: buyer, Amount: half, Applied: half,
+		Refund: types.ZeroBalance, PaidAt: 9500}
+	if err := DoDSettleAddInvoicePayment(ctx, invoice.InvoiceId, p1); err != nil {
+		t.Fatal(err)
+	}
+
+	ps, err = DoDSettleGetInvoicePaymentStatus(ctx, invoice.InvoiceId, 10000+DoDSettleDunningWarningPeriod+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.Status != DoDSettleInvoiceStatusPartial || ps.Dunning != DoDSettleDunningStateWarning || ps.PaidAt != 0 {
+		t.Fatal("invalid status", ps)
+	}
+
+	rest := invoice.TokenAmount.Sub(half)
+	extra := types.Balance{Int: big.NewInt(100)}
+	p2 := &DoDSettleInvoicePayment{PaymentId: mock.Hash(), Payer: buyer, Amount: rest.Add(extra), Applied: rest,
+		Refund: extra, PaidAt: 10500}
+	if err := DoDSettleAddInvoicePayment(ctx, invoice.InvoiceId, p2); err != nil {
+		t.Fatal(err)
+	}
+
+	ps, err = DoDSettleGetInvoicePaymentStatus(ctx, invoice.InvoiceId, 10000+DoDSettleDunningCollectionPeriod+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.Status != DoDSettleInvoiceStatusPaid || ps.Dunning != DoDSettleDunningStateSettled || ps.PaidAt != 10500 ||
+		!ps.Outstanding.IsZero() || ps.Refundable.Compare(extra) != types.BalanceCompEqual || len(ps.Payments) != 2 {
+		t.Fatal("invalid status", ps)
+	}
+	if ps.PaidAmount.Cmp(invoice.Amount) != 0 {
+		t.Fatal("invalid paid amount", ps.PaidAmount, invoice.Amount)
+	}
+
+	if err := DoDSettleSetInvoiceRefund(ctx, invoice.InvoiceId, p2.PaymentId, &DoDSettleInvoiceRefund{
+		Previous: mock.Hash(), RefundAt: 11000}); err != nil {
+		t.Fatal(err)
+	}
+	status, err := DoDSettleGetOrderPaymentStatus(ctx, seller, order.OrderId, 12000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status) != 1 || !status[0].Refundable.IsZero() || !status[0].Payments[1].Refunded ||
+		status[0].Payments[1].RefundAt != 11000 {
+		t.Fatal("invalid order status", status)
+	}
+
+	if _, err := DoDSettleGetInvoicePayment(ctx, invoice.InvoiceId, mock.Hash()); err == nil {
+		t.Fatal("payment should not exist")
+	}
+}
+
+func TestDoDSettleDunning(t *testing.T) {
+	due := int64(1000)
+	if DoDSettleDunning(DoDSettleInvoiceStatusIssued, due, due) != DoDSettleDunningStateCurrent {
+		t.Fatal()
+	}
+	if DoDSettleDunning(DoDSettleInvoiceStatusPartial, due, due+1) != DoDSettleDunningStateOverdue {
+		t.Fatal()
+	}
+	if DoDSettleDunning(DoDSettleInvoiceStatusIssued, due, due+DoDSettleDunningWarningPeriod+1) != DoDSettleDunningStateWarning {
+		t.Fatal()
+	}
+	if DoDSettleDunning(DoDSettleInvoiceStatusIssued, due, due+DoDSettleDunningCollectionPeriod+1) != DoDSettleDunningStateCollection {
+		t.Fatal()
+	}
+	if DoDSettleDunning(DoDSettleInvoiceStatusPaid, due, due+DoDSettleDunningCollectionPeriod+1) != DoDSettleDunningStateSettled {
+		t.Fatal()
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
 )
 
@@ -96,6 +97,30 @@ terminate
 */
 type DoDSettleOrderType int
 
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+null
+issued
+partial
+paid
+)
+*/
+type DoDSettleInvoiceStatus int
+
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+null
+current
+overdue
+warning
+collection
+settled
+)
+*/
+type DoDSettleDunningState int
+
 const (
 	DoDSettleDBTableOrder uint8 = iota
 	DoDSettleDBTableProduct
@@ -108,6 +133,10 @@ const (
 	DoDSettleDBTableProductToOrder
 	DoDSettleDBTableUserProduct
 	DoDSettleDBTableOrderToProduct
+	DoDSettleDBTableInvoice
+	DoDSettleDBTableInvoicePayment
+	DoDSettleDBTableInvoiceRefund
+	DoDSettleDBTableOrderInvoice
 )
 
 //go:generate msgp
@@ -288,7 +317,7 @@ type DoDSettleConnectionRawParam struct {
 	ServiceClass      DoDSettleServiceClass `json:"serviceClass,omitempty" msg:"scs"`
 	Bandwidth         string                `json:"bandwidth,omitempty" msg:"bw"`
 	BillingUnit       DoDSettleBillingUnit  `json:"billingUnit,omitempty" msg:"bu"`
-	Price             float64               `json:"price,omitempty" msg:"p"`
+	Price             types.Money           `json:"price,omitempty" msg:"p"`
 	StartTime         int64                 `json:"startTime" msg:"st"`
 	EndTime           int64                 `json:"endTime" msg:"et"`
 }
@@ -328,8 +357,8 @@ type DoDSettleConnectionDynamicParam struct {
 	ServiceClass   DoDSettleServiceClass `json:"serviceClass,omitempty" msg:"scs"`
 	Bandwidth      string                `json:"bandwidth,omitempty" msg:"bw"`
 	BillingUnit    DoDSettleBillingUnit  `json:"billingUnit,omitempty" msg:"bu"`
-	Price          float64               `json:"price,omitempty" msg:"p"`
-	Addition       float64               `json:"addition" msg:"ad"`
+	Price          types.Money           `json:"price,omitempty" msg:"p"`
+	Addition       types.Money           `json:"addition" msg:"ad"`
 	StartTime      int64                 `json:"startTime" msg:"st"`
 	StartTimeStr   string                `json:"startTimeStr,omitempty" msg:"-"`
 	EndTime        int64                 `json:"endTime" msg:"et"`
@@ -344,13 +373,13 @@ type DoDSettleConnectionLifeTrack struct {
 }
 
 type DoDSettleDisconnectInfo struct {
-	OrderId      string  `json:"orderId,omitempty" msg:"oi"`
-	OrderItemId  string  `json:"orderItemId,omitempty" msg:"oii"`
-	QuoteId      string  `json:"quoteId,omitempty" msg:"q"`
-	QuoteItemId  string  `json:"quoteItemId,omitempty" msg:"qi"`
-	Price        float64 `json:"price,omitempty" msg:"p"`
-	Currency     string  `json:"currency,omitempty" msg:"cr"`
-	DisconnectAt int64   `json:"disconnectAt,omitempty" msg:"d"`
+	OrderId      string      `json:"orderId,omitempty" msg:"oi"`
+	OrderItemId  string      `json:"orderItemId,omitempty" msg:"oii"`
+	QuoteId      string      `json:"quoteId,omitempty" msg:"q"`
+	QuoteItemId  string      `json:"quoteItemId,omitempty" msg:"qi"`
+	Price        types.Money `json:"price,omitempty" msg:"p"`
+	Currency     string      `json:"currency,omitempty" msg:"cr"`
+	DisconnectAt int64       `json:"disconnectAt,omitempty" msg:"d"`
 }
 
 type DoDSettleConnectionInfo struct {
@@ -735,11 +764,11 @@ type DoDSettleInvoiceConnDynamic struct {
 	InvoiceEndTimeStr   string             `json:"invoiceEndTimeStr,omitempty"`
 	InvoiceUnitCount    int                `json:"invoiceUnitCount,omitempty"`
 	OrderType           DoDSettleOrderType `json:"orderType,omitempty"`
-	Amount              float64            `json:"amount"`
+	Amount              types.Money        `json:"amount"`
 }
 
 type DoDSettleInvoiceConnDetail struct {
-	ConnectionAmount float64 `json:"connectionAmount"`
+	ConnectionAmount types.Money `json:"connectionAmount"`
 	DoDSettleConnectionStaticParam
 	Usage []*DoDSettleInvoiceConnDynamic `json:"usage"`
 }
@@ -748,14 +777,14 @@ type DoDSettleInvoiceOrderDetail struct {
 	OrderId         string                        `json:"orderId"`
 	InternalId      types.Hash                    `json:"internalId"`
 	ConnectionCount int                           `json:"connectionCount"`
-	OrderAmount     float64                       `json:"orderAmount"`
+	OrderAmount     types.Money                   `json:"orderAmount"`
 	Connections     []*DoDSettleInvoiceConnDetail `json:"connections"`
 }
 
 type DoDSettleOrderInvoice struct {
 	InvoiceId            types.Hash                   `json:"invoiceId"`
 	TotalConnectionCount int                          `json:"totalConnectionCount"`
-	TotalAmount          float64                      `json:"totalAmount"`
+	TotalAmount          types.Money                  `json:"totalAmount"`
 	Currency             string                       `json:"currency"`
 	StartTime            int64                        `json:"startTime"`
 	EndTime              int64                        `json:"endTime"`
@@ -770,7 +799,7 @@ type DoDSettleBuyerInvoice struct {
 	InvoiceId            types.Hash                     `json:"invoiceId"`
 	OrderCount           int                            `json:"orderCount"`
 	TotalConnectionCount int                            `json:"totalConnectionCount"`
-	TotalAmount          float64                        `json:"totalAmount"`
+	TotalAmount          types.Money                    `json:"totalAmount"`
 	Currency             string                         `json:"currency"`
 	StartTime            int64                          `json:"startTime"`
 	EndTime              int64                          `json:"endTime"`
@@ -783,7 +812,7 @@ type DoDSettleBuyerInvoice struct {
 
 type DoDSettleProductInvoice struct {
 	InvoiceId   types.Hash                  `json:"invoiceId"`
-	TotalAmount float64                     `json:"totalAmount"`
+	TotalAmount types.Money                 `json:"totalAmount"`
 	Currency    string                      `json:"currency"`
 	StartTime   int64                       `json:"startTime"`
 	EndTime     int64                       `json:"endTime"`
@@ -822,3 +851,175 @@ func (z *DoDSettlePAYGTimeSpanKey) Hash() types.Hash {
 	data := append([]byte(z.ProductId), []byte(z.OrderId)...)
 	return types.HashData(data)
 }
+
+type DoDSettleIssueInvoiceParam struct {
+	Seller    types.Address `json:"seller" msg:"-"`
+	OrderId   string        `json:"orderId" msg:"oi"`
+	Token     types.Hash    `json:"token" msg:"tk,extension"`
+	StartTime int64         `json:"startTime" msg:"st"`
+	EndTime   int64         `json:"endTime" msg:"et"`
+	DueTime   int64         `json:"dueTime" msg:"dt"`
+	Flight    bool          `json:"flight" msg:"f"`
+	Split     bool          `json:"split" msg:"sp"`
+}
+
+func (z *DoDSettleIssueInvoiceParam) ToABI() ([]byte, error) {
+	id := DoDSettlementABI.Methods[MethodNameDoDSettleIssueInvoice].Id()
+	if data, err := z.MarshalMsg(nil); err != nil {
+		return nil, err
+	} else {
+		id = append(id, data...)
+		return id, nil
+	}
+}
+
+func (z *DoDSettleIssueInvoiceParam) FromABI(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("data too short")
+	}
+
+	_, err := z.UnmarshalMsg(data[4:])
+	return err
+}
+
+func (z *DoDSettleIssueInvoiceParam) Verify(ctx *vmstore.VMContext) error {
+	if len(z.OrderId) == 0 {
+		return fmt.Errorf("order id needed")
+	}
+
+	if z.StartTime <= 0 || z.EndTime <= z.StartTime {
+		return fmt.Errorf("invalid start or end time")
+	}
+
+	if z.DueTime < z.EndTime {
+		return fmt.Errorf("due time should not be before end time")
+	}
+
+	order, err := DoDSettleGetOrderInfoByOrderId(ctx, z.Seller, z.OrderId)
+	if err != nil {
+		return fmt.Errorf("order %s not exist", z.OrderId)
+	}
+
+	if order.ContractState != DoDSettleContractStateConfirmed {
+		return fmt.Errorf("order %s is not confirmed", z.OrderId)
+	}
+
+	for _, c := range order.Connections {
+		if c.PaymentType != DoDSettlePaymentTypeStableCoin {
+			return fmt.Errorf("item %s is not paid by stable coin", c.ItemId)
+		}
+	}
+
+	if _, err := ctx.GetTokenById(z.Token); err != nil {
+		return fmt.Errorf("token %s not exist", z.Token)
+	}
+
+	return nil
+}
+
+// InvoiceId is unique for the order and billing period, so the same usage can not be invoiced twice
+func (z *DoDSettleIssueInvoiceParam) InvoiceId() types.Hash {
+	data := append(z.Seller.Bytes(), []byte(z.OrderId)...)
+	data = append(data, util.BE_Int2Bytes(z.StartTime)...)
+	data = append(data, util.BE_Int2Bytes(z.EndTime)...)
+	return types.HashData(data)
+}
+
+type DoDSettlePayInvoiceParam struct {
+	InvoiceId types.Hash `json:"invoiceId" msg:"i,extension"`
+}
+
+func (z *DoDSettlePayInvoiceParam) ToABI() ([]byte, error) {
+	id := DoDSettlementABI.Methods[MethodNameDoDSettlePayInvoice].Id()
+	if data, err := z.MarshalMsg(nil); err != nil {
+		return nil, err
+	} else {
+		id = append(id, data...)
+		return id, nil
+	}
+}
+
+func (z *DoDSettlePayInvoiceParam) FromABI(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("data too short")
+	}
+
+	_, err := z.UnmarshalMsg(data[4:])
+	return err
+}
+
+type DoDSettleRefundInvoiceParam struct {
+	InvoiceId types.Hash `json:"invoiceId" msg:"i,extension"`
+	PaymentId types.Hash `json:"paymentId" msg:"p,extension"`
+}
+
+func (z *DoDSettleRefundInvoiceParam) ToABI() ([]byte, error) {
+	id := DoDSettlementABI.Methods[MethodNameDoDSettleRefundInvoice].Id()
+	if data, err := z.MarshalMsg(nil); err != nil {
+		return nil, err
+	} else {
+		id = append(id, data...)
+		return id, nil
+	}
+}
+
+func (z *DoDSettleRefundInvoiceParam) FromABI(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("data too short")
+	}
+
+	_, err := z.UnmarshalMsg(data[4:])
+	return err
+}
+
+// DoDSettleInvoiceInfo is the invoice issued on chain, it is never changed after issued,
+// payments and refunds are saved in their own tables
+type DoDSettleInvoiceInfo struct {
+	InvoiceId   types.Hash     `json:"invoiceId" msg:"i,extension"`
+	InternalId  types.Hash     `json:"internalId" msg:"ii,extension"`
+	OrderId     string         `json:"orderId" msg:"oi"`
+	Buyer       *DoDSettleUser `json:"buyer" msg:"b"`
+	Seller      *DoDSettleUser `json:"seller" msg:"s"`
+	Currency    string         `json:"currency" msg:"cr"`
+	Amount      types.Money    `json:"amount" msg:"a"`
+	Token       types.Hash     `json:"token" msg:"tk,extension"`
+	TokenAmount types.Balance  `json:"tokenAmount" msg:"ta,extension"`
+	StartTime   int64          `json:"startTime" msg:"st"`
+	EndTime     int64          `json:"endTime" msg:"et"`
+	DueTime     int64          `json:"dueTime" msg:"dt"`
+	IssuedAt    int64          `json:"issuedAt" msg:"t"`
+}
+
+// DoDSettleInvoicePayment is a payment of token to invoice, the part which exceeds the outstanding
+// amount is refunded to payer
+type DoDSettleInvoicePayment struct {
+	PaymentId types.Hash    `json:"paymentId" msg:"i,extension"`
+	Payer     types.Address `json:"payer" msg:"p,extension"`
+	Amount    types.Balance `json:"amount" msg:"a,extension"`
+	Applied   types.Balance `json:"applied" msg:"ap,extension"`
+	Refund    types.Balance `json:"refund" msg:"r,extension"`
+	PaidAt    int64         `json:"paidAt" msg:"t"`
+}
+
+type DoDSettleInvoiceRefund struct {
+	Previous types.Hash `json:"previous" msg:"p,extension"`
+	RefundAt int64      `json:"refundAt" msg:"t"`
+}
+
+type DoDSettleInvoicePaymentDetail struct {
+	*DoDSettleInvoicePayment
+	Refunded bool  `json:"refunded" msg:"rf"`
+	RefundAt int64 `json:"refundAt,omitempty" msg:"rt"`
+}
+
+type DoDSettleInvoicePaymentStatus struct {
+	*DoDSettleInvoiceInfo
+	Status      DoDSettleInvoiceStatus           `json:"status" msg:"ps"`
+	Dunning     DoDSettleDunningState            `json:"dunning" msg:"du"`
+	Paid        types.Balance                    `json:"paid" msg:"pd,extension"`
+	Outstanding types.Balance                    `json:"outstanding" msg:"o,extension"`
+	PaidAmount  types.Money                      `json:"paidAmount" msg:"pa"`
+	Refundable  types.Balance                    `json:"refundable" msg:"rf,extension"`
+	PaidAt      int64                            `json:"paidAt,omitempty" msg:"pt"`
+	Payments    []*DoDSettleInvoicePaymentDetail `json:"payments" msg:"p"`
+}
@@ -242,6 +242,162 @@ func (x *DoDSettleContractState) UnmarshalText(text []byte) error {
 	return nil
 }
 
+const (
+	// DoDSettleDunningStateNull is a DoDSettleDunningState of type Null
+	DoDSettleDunningStateNull DoDSettleDunningState = iota
+	// DoDSettleDunningStateCurrent is a DoDSettleDunningState of type Current
+	DoDSettleDunningStateCurrent
+	// DoDSettleDunningStateOverdue is a DoDSettleDunningState of type Overdue
+	DoDSettleDunningStateOverdue
+	// DoDSettleDunningStateWarning is a DoDSettleDunningState of type Warning
+	DoDSettleDunningStateWarning
+	// DoDSettleDunningStateCollection is a DoDSettleDunningState of type Collection
+	DoDSettleDunningStateCollection
+	// DoDSettleDunningStateSettled is a DoDSettleDunningState of type Settled
+	DoDSettleDunningStateSettled
+)
+
+const _DoDSettleDunningStateName = "nullcurrentoverduewarningcollectionsettled"
+
+var _DoDSettleDunningStateNames = []string{
+	_DoDSettleDunningStateName[0:4],
+	_DoDSettleDunningStateName[4:11],
+	_DoDSettleDunningStateName[11:18],
+	_DoDSettleDunningStateName[18:25],
+	_DoDSettleDunningStateName[25:35],
+	_DoDSettleDunningStateName[35:42],
+}
+
+// DoDSettleDunningStateNames returns a list of possible string values of DoDSettleDunningState.
+func DoDSettleDunningStateNames() []string {
+	tmp := make([]string, len(_DoDSettleDunningStateNames))
+	copy(tmp, _DoDSettleDunningStateNames)
+	return tmp
+}
+
+var _DoDSettleDunningStateMap = map[DoDSettleDunningState]string{
+	0: _DoDSettleDunningStateName[0:4],
+	1: _DoDSettleDunningStateName[4:11],
+	2: _DoDSettleDunningStateName[11:18],
+	3: _DoDSettleDunningStateName[18:25],
+	4: _DoDSettleDunningStateName[25:35],
+	5: _DoDSettleDunningStateName[35:42],
+}
+
+// String implements the Stringer interface.
+func (x DoDSettleDunningState) String() string {
+	if str, ok := _DoDSettleDunningStateMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DoDSettleDunningState(%d)", x)
+}
+
+var _DoDSettleDunningStateValue = map[string]DoDSettleDunningState{
+	_DoDSettleDunningStateName[0:4]:   0,
+	_DoDSettleDunningStateName[4:11]:  1,
+	_DoDSettleDunningStateName[11:18]: 2,
+	_DoDSettleDunningStateName[18:25]: 3,
+	_DoDSettleDunningStateName[25:35]: 4,
+	_DoDSettleDunningStateName[35:42]: 5,
+}
+
+// ParseDoDSettleDunningState attempts to convert a string to a DoDSettleDunningState
+func ParseDoDSettleDunningState(name string) (DoDSettleDunningState, error) {
+	if x, ok := _DoDSettleDunningStateValue[name]; ok {
+		return x, nil
+	}
+	return DoDSettleDunningState(0), fmt.Errorf("%s is not a valid DoDSettleDunningState, try [%s]", name, strings.Join(_DoDSettleDunningStateNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x DoDSettleDunningState) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *DoDSettleDunningState) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDoDSettleDunningState(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// DoDSettleInvoiceStatusNull is a DoDSettleInvoiceStatus of type Null
+	DoDSettleInvoiceStatusNull DoDSettleInvoiceStatus = iota
+	// DoDSettleInvoiceStatusIssued is a DoDSettleInvoiceStatus of type Issued
+	DoDSettleInvoiceStatusIssued
+	// DoDSettleInvoiceStatusPartial is a DoDSettleInvoiceStatus of type Partial
+	DoDSettleInvoiceStatusPartial
+	// DoDSettleInvoiceStatusPaid is a DoDSettleInvoiceStatus of type Paid
+	DoDSettleInvoiceStatusPaid
+)
+
+const _DoDSettleInvoiceStatusName = "nullissuedpartialpaid"
+
+var _DoDSettleInvoiceStatusNames = []string{
+	_DoDSettleInvoiceStatusName[0:4],
+	_DoDSettleInvoiceStatusName[4:10],
+	_DoDSettleInvoiceStatusName[10:17],
+	_DoDSettleInvoiceStatusName[17:21],
+}
+
+// DoDSettleInvoiceStatusNames returns a list of possible string values of DoDSettleInvoiceStatus.
+func DoDSettleInvoiceStatusNames() []string {
+	tmp := make([]string, len(_DoDSettleInvoiceStatusNames))
+	copy(tmp, _DoDSettleInvoiceStatusNames)
+	return tmp
+}
+
+var _DoDSettleInvoiceStatusMap = map[DoDSettleInvoiceStatus]string{
+	0: _DoDSettleInvoiceStatusName[0:4],
+	1: _DoDSettleInvoiceStatusName[4:10],
+	2: _DoDSettleInvoiceStatusName[10:17],
+	3: _DoDSettleInvoiceStatusName[17:21],
+}
+
+// String implements the Stringer interface.
+func (x DoDSettleInvoiceStatus) String() string {
+	if str, ok := _DoDSettleInvoiceStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("DoDSettleInvoiceStatus(%d)", x)
+}
+
+var _DoDSettleInvoiceStatusValue = map[string]DoDSettleInvoiceStatus{
+	_DoDSettleInvoiceStatusName[0:4]:   0,
+	_DoDSettleInvoiceStatusName[4:10]:  1,
+	_DoDSettleInvoiceStatusName[10:17]: 2,
+	_DoDSettleInvoiceStatusName[17:21]: 3,
+}
+
+// ParseDoDSettleInvoiceStatus attempts to convert a string to a DoDSettleInvoiceStatus
+func ParseDoDSettleInvoiceStatus(name string) (DoDSettleInvoiceStatus, error) {
+	if x, ok := _DoDSettleInvoiceStatusValue[name]; ok {
+		return x, nil
+	}
+	return DoDSettleInvoiceStatus(0), fmt.Errorf("%s is not a valid DoDSettleInvoiceStatus, try [%s]", name, strings.Join(_DoDSettleInvoiceStatusNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x DoDSettleInvoiceStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *DoDSettleInvoiceStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseDoDSettleInvoiceStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
 const (
 	// DoDSettleOrderStateNull is a DoDSettleOrderState of type Null
 	DoDSettleOrderStateNull DoDSettleOrderState = iota
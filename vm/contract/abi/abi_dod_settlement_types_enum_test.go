@@ -100,6 +100,70 @@ func TestDoDSettleContractState(t *testing.T) {
 	}
 }
 
+func TestDoDSettleDunningState(t *testing.T) {
+	n := DoDSettleDunningStateNames()
+	t.Log(n)
+
+	ds := DoDSettleDunningStateWarning
+	if ds.String() != "warning" {
+		t.Fatal()
+	}
+
+	_ = DoDSettleDunningState(1000).String()
+
+	pds, _ := ParseDoDSettleDunningState("warning")
+	if pds != DoDSettleDunningStateWarning {
+		t.Fatal()
+	}
+
+	_, err := ParseDoDSettleDunningState("invalid")
+	if err == nil {
+		t.Fatal()
+	}
+
+	data, err := ds.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ds.UnmarshalText(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDoDSettleInvoiceStatus(t *testing.T) {
+	n := DoDSettleInvoiceStatusNames()
+	t.Log(n)
+
+	is := DoDSettleInvoiceStatusPartial
+	if is.String() != "partial" {
+		t.Fatal()
+	}
+
+	_ = DoDSettleInvoiceStatus(1000).String()
+
+	pis, _ := ParseDoDSettleInvoiceStatus("partial")
+	if pis != DoDSettleInvoiceStatusPartial {
+		t.Fatal()
+	}
+
+	_, err := ParseDoDSettleInvoiceStatus("invalid")
+	if err == nil {
+		t.Fatal()
+	}
+
+	data, err := is.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = is.UnmarshalText(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestDoDSettleOrderState(t *testing.T) {
 	n := DoDSettleOrderStateNames()
 	t.Log(n)
@@ -147,7 +147,7 @@ func (z *DoDSettleBuyerInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "TotalAmount":
-			z.TotalAmount, err = dc.ReadFloat64()
+			err = z.TotalAmount.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalAmount")
 				return
@@ -170,6 +170,18 @@ func (z *DoDSettleBuyerInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "EndTime")
 				return
 			}
+		case "Flight":
+			z.Flight, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "Split":
+			z.Split, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
 		case "Buyer":
 			if dc.IsNil() {
 				err = dc.ReadNil()
@@ -308,9 +320,9 @@ func (z *DoDSettleBuyerInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DoDSettleBuyerInvoice) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 10
+	// map header, size 12
 	// write "InvoiceId"
-	err = en.Append(0x8a, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
+	err = en.Append(0x8c, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
 	if err != nil {
 		return
 	}
@@ -344,7 +356,7 @@ func (z *DoDSettleBuyerInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.TotalAmount)
+	err = z.TotalAmount.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "TotalAmount")
 		return
@@ -379,6 +391,26 @@ func (z *DoDSettleBuyerInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "EndTime")
 		return
 	}
+	// write "Flight"
+	err = en.Append(0xa6, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Flight)
+	if err != nil {
+		err = msgp.WrapError(err, "Flight")
+		return
+	}
+	// write "Split"
+	err = en.Append(0xa5, 0x53, 0x70, 0x6c, 0x69, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Split)
+	if err != nil {
+		err = msgp.WrapError(err, "Split")
+		return
+	}
 	// write "Buyer"
 	err = en.Append(0xa5, 0x42, 0x75, 0x79, 0x65, 0x72)
 	if err != nil {
@@ -475,9 +507,9 @@ func (z *DoDSettleBuyerInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *DoDSettleBuyerInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 10
+	// map header, size 12
 	// string "InvoiceId"
-	o = append(o, 0x8a, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
+	o = append(o, 0x8c, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
 	o, err = z.InvoiceId.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "InvoiceId")
@@ -491,7 +523,11 @@ func (z *DoDSettleBuyerInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.AppendInt(o, z.TotalConnectionCount)
 	// string "TotalAmount"
 	o = append(o, 0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendFloat64(o, z.TotalAmount)
+	o, err = z.TotalAmount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "TotalAmount")
+		return
+	}
 	// string "Currency"
 	o = append(o, 0xa8, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79)
 	o = msgp.AppendString(o, z.Currency)
@@ -501,6 +537,12 @@ func (z *DoDSettleBuyerInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "EndTime"
 	o = append(o, 0xa7, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65)
 	o = msgp.AppendInt64(o, z.EndTime)
+	// string "Flight"
+	o = append(o, 0xa6, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74)
+	o = msgp.AppendBool(o, z.Flight)
+	// string "Split"
+	o = append(o, 0xa5, 0x53, 0x70, 0x6c, 0x69, 0x74)
+	o = msgp.AppendBool(o, z.Split)
 	// string "Buyer"
 	o = append(o, 0xa5, 0x42, 0x75, 0x79, 0x65, 0x72)
 	if z.Buyer == nil {
@@ -589,7 +631,7 @@ func (z *DoDSettleBuyerInvoice) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		case "TotalAmount":
-			z.TotalAmount, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.TotalAmount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalAmount")
 				return
@@ -612,6 +654,18 @@ func (z *DoDSettleBuyerInvoice) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "EndTime")
 				return
 			}
+		case "Flight":
+			z.Flight, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "Split":
+			z.Split, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
 		case "Buyer":
 			if msgp.IsNil(bts) {
 				bts, err = msgp.ReadNilBytes(bts)
@@ -748,7 +802,7 @@ func (z *DoDSettleBuyerInvoice) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleBuyerInvoice) Msgsize() (s int) {
-	s = 1 + 10 + z.InvoiceId.Msgsize() + 11 + msgp.IntSize + 21 + msgp.IntSize + 12 + msgp.Float64Size + 9 + msgp.StringPrefixSize + len(z.Currency) + 10 + msgp.Int64Size + 8 + msgp.Int64Size + 6
+	s = 1 + 10 + z.InvoiceId.Msgsize() + 11 + msgp.IntSize + 21 + msgp.IntSize + 12 + z.TotalAmount.Msgsize() + 9 + msgp.StringPrefixSize + len(z.Currency) + 10 + msgp.Int64Size + 8 + msgp.Int64Size + 7 + msgp.BoolSize + 6 + msgp.BoolSize + 6
 	if z.Buyer == nil {
 		s += msgp.NilSize
 	} else {
@@ -1807,13 +1861,13 @@ func (z *DoDSettleConnectionDynamicParam) DecodeMsg(dc *msgp.Reader) (err error)
 				z.BillingUnit = DoDSettleBillingUnit(zb0005)
 			}
 		case "p":
-			z.Price, err = dc.ReadFloat64()
+			err = z.Price.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Price")
 				return
 			}
 		case "ad":
-			z.Addition, err = dc.ReadFloat64()
+			err = z.Addition.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Addition")
 				return
@@ -1969,7 +2023,7 @@ func (z *DoDSettleConnectionDynamicParam) EncodeMsg(en *msgp.Writer) (err error)
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Price)
+	err = z.Price.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "Price")
 		return
@@ -1979,7 +2033,7 @@ func (z *DoDSettleConnectionDynamicParam) EncodeMsg(en *msgp.Writer) (err error)
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Addition)
+	err = z.Addition.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "Addition")
 		return
@@ -2049,10 +2103,18 @@ func (z *DoDSettleConnectionDynamicParam) MarshalMsg(b []byte) (o []byte, err er
 	o = msgp.AppendInt(o, int(z.BillingUnit))
 	// string "p"
 	o = append(o, 0xa1, 0x70)
-	o = msgp.AppendFloat64(o, z.Price)
+	o, err = z.Price.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Price")
+		return
+	}
 	// string "ad"
 	o = append(o, 0xa2, 0x61, 0x64)
-	o = msgp.AppendFloat64(o, z.Addition)
+	o, err = z.Addition.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Addition")
+		return
+	}
 	// string "st"
 	o = append(o, 0xa2, 0x73, 0x74)
 	o = msgp.AppendInt64(o, z.StartTime)
@@ -2169,13 +2231,13 @@ func (z *DoDSettleConnectionDynamicParam) UnmarshalMsg(bts []byte) (o []byte, er
 				z.BillingUnit = DoDSettleBillingUnit(zb0005)
 			}
 		case "p":
-			z.Price, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.Price.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Price")
 				return
 			}
 		case "ad":
-			z.Addition, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.Addition.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Addition")
 				return
@@ -2206,7 +2268,7 @@ func (z *DoDSettleConnectionDynamicParam) UnmarshalMsg(bts []byte) (o []byte, er
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleConnectionDynamicParam) Msgsize() (s int) {
-	s = 3 + 3 + msgp.StringPrefixSize + len(z.OrderId) + 3 + msgp.StringPrefixSize + len(z.ItemId) + 4 + msgp.StringPrefixSize + len(z.OrderItemId) + 2 + msgp.StringPrefixSize + len(z.QuoteId) + 3 + msgp.StringPrefixSize + len(z.QuoteItemId) + 3 + msgp.StringPrefixSize + len(z.ConnectionName) + 3 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Currency) + 4 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Bandwidth) + 3 + msgp.IntSize + 2 + msgp.Float64Size + 3 + msgp.Float64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
+	s = 3 + 3 + msgp.StringPrefixSize + len(z.OrderId) + 3 + msgp.StringPrefixSize + len(z.ItemId) + 4 + msgp.StringPrefixSize + len(z.OrderItemId) + 2 + msgp.StringPrefixSize + len(z.QuoteId) + 3 + msgp.StringPrefixSize + len(z.QuoteItemId) + 3 + msgp.StringPrefixSize + len(z.ConnectionName) + 3 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Currency) + 4 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Bandwidth) + 3 + msgp.IntSize + 2 + z.Price.Msgsize() + 3 + z.Addition.Msgsize() + 3 + msgp.Int64Size + 3 + msgp.Int64Size
 	return
 }
 
@@ -3188,7 +3250,7 @@ func (z *DoDSettleConnectionRawParam) DecodeMsg(dc *msgp.Reader) (err error) {
 				z.BillingUnit = DoDSettleBillingUnit(zb0005)
 			}
 		case "p":
-			z.Price, err = dc.ReadFloat64()
+			err = z.Price.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Price")
 				return
@@ -3424,7 +3486,7 @@ func (z *DoDSettleConnectionRawParam) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Price)
+	err = z.Price.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "Price")
 		return
@@ -3518,7 +3580,11 @@ func (z *DoDSettleConnectionRawParam) MarshalMsg(b []byte) (o []byte, err error)
 	o = msgp.AppendInt(o, int(z.BillingUnit))
 	// string "p"
 	o = append(o, 0xa1, 0x70)
-	o = msgp.AppendFloat64(o, z.Price)
+	o, err = z.Price.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Price")
+		return
+	}
 	// string "st"
 	o = append(o, 0xa2, 0x73, 0x74)
 	o = msgp.AppendInt64(o, z.StartTime)
@@ -3683,7 +3749,7 @@ func (z *DoDSettleConnectionRawParam) UnmarshalMsg(bts []byte) (o []byte, err er
 				z.BillingUnit = DoDSettleBillingUnit(zb0005)
 			}
 		case "p":
-			z.Price, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.Price.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Price")
 				return
@@ -3714,7 +3780,7 @@ func (z *DoDSettleConnectionRawParam) UnmarshalMsg(bts []byte) (o []byte, err er
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleConnectionRawParam) Msgsize() (s int) {
-	s = 3 + 3 + msgp.StringPrefixSize + len(z.ItemId) + 3 + msgp.StringPrefixSize + len(z.BuyerProductId) + 3 + msgp.StringPrefixSize + len(z.ProductOfferingId) + 4 + msgp.StringPrefixSize + len(z.SrcCompanyName) + 3 + msgp.StringPrefixSize + len(z.SrcRegion) + 3 + msgp.StringPrefixSize + len(z.SrcCity) + 4 + msgp.StringPrefixSize + len(z.SrcDataCenter) + 3 + msgp.StringPrefixSize + len(z.SrcPort) + 4 + msgp.StringPrefixSize + len(z.DstCompanyName) + 3 + msgp.StringPrefixSize + len(z.DstRegion) + 3 + msgp.StringPrefixSize + len(z.DstCity) + 4 + msgp.StringPrefixSize + len(z.DstDataCenter) + 3 + msgp.StringPrefixSize + len(z.DstPort) + 3 + msgp.StringPrefixSize + len(z.ConnectionName) + 3 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Currency) + 4 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Bandwidth) + 3 + msgp.IntSize + 2 + msgp.Float64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size
+	s = 3 + 3 + msgp.StringPrefixSize + len(z.ItemId) + 3 + msgp.StringPrefixSize + len(z.BuyerProductId) + 3 + msgp.StringPrefixSize + len(z.ProductOfferingId) + 4 + msgp.StringPrefixSize + len(z.SrcCompanyName) + 3 + msgp.StringPrefixSize + len(z.SrcRegion) + 3 + msgp.StringPrefixSize + len(z.SrcCity) + 4 + msgp.StringPrefixSize + len(z.SrcDataCenter) + 3 + msgp.StringPrefixSize + len(z.SrcPort) + 4 + msgp.StringPrefixSize + len(z.DstCompanyName) + 3 + msgp.StringPrefixSize + len(z.DstRegion) + 3 + msgp.StringPrefixSize + len(z.DstCity) + 4 + msgp.StringPrefixSize + len(z.DstDataCenter) + 3 + msgp.StringPrefixSize + len(z.DstPort) + 3 + msgp.StringPrefixSize + len(z.ConnectionName) + 3 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Currency) + 4 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.Bandwidth) + 3 + msgp.IntSize + 2 + z.Price.Msgsize() + 3 + msgp.Int64Size + 3 + msgp.Int64Size
 	return
 }
 
@@ -4782,7 +4848,7 @@ func (z *DoDSettleDisconnectInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "p":
-			z.Price, err = dc.ReadFloat64()
+			err = z.Price.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Price")
 				return
@@ -4858,7 +4924,7 @@ func (z *DoDSettleDisconnectInfo) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Price)
+	err = z.Price.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "Price")
 		return
@@ -4904,7 +4970,11 @@ func (z *DoDSettleDisconnectInfo) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.AppendString(o, z.QuoteItemId)
 	// string "p"
 	o = append(o, 0xa1, 0x70)
-	o = msgp.AppendFloat64(o, z.Price)
+	o, err = z.Price.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Price")
+		return
+	}
 	// string "cr"
 	o = append(o, 0xa2, 0x63, 0x72)
 	o = msgp.AppendString(o, z.Currency)
@@ -4957,7 +5027,7 @@ func (z *DoDSettleDisconnectInfo) UnmarshalMsg(bts []byte) (o []byte, err error)
 				return
 			}
 		case "p":
-			z.Price, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.Price.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Price")
 				return
@@ -4988,7 +5058,59 @@ func (z *DoDSettleDisconnectInfo) UnmarshalMsg(bts []byte) (o []byte, err error)
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleDisconnectInfo) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.OrderId) + 4 + msgp.StringPrefixSize + len(z.OrderItemId) + 2 + msgp.StringPrefixSize + len(z.QuoteId) + 3 + msgp.StringPrefixSize + len(z.QuoteItemId) + 2 + msgp.Float64Size + 3 + msgp.StringPrefixSize + len(z.Currency) + 2 + msgp.Int64Size
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.OrderId) + 4 + msgp.StringPrefixSize + len(z.OrderItemId) + 2 + msgp.StringPrefixSize + len(z.QuoteId) + 3 + msgp.StringPrefixSize + len(z.QuoteItemId) + 2 + z.Price.Msgsize() + 3 + msgp.StringPrefixSize + len(z.Currency) + 2 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleDunningState) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DoDSettleDunningState(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DoDSettleDunningState) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DoDSettleDunningState) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleDunningState) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DoDSettleDunningState(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DoDSettleDunningState) Msgsize() (s int) {
+	s = msgp.IntSize
 	return
 }
 
@@ -5118,7 +5240,7 @@ func (z *DoDSettleInvoiceConnDetail) DecodeMsg(dc *msgp.Reader) (err error) {
 		}
 		switch msgp.UnsafeString(field) {
 		case "ConnectionAmount":
-			z.ConnectionAmount, err = dc.ReadFloat64()
+			err = z.ConnectionAmount.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "ConnectionAmount")
 				return
@@ -5179,7 +5301,7 @@ func (z *DoDSettleInvoiceConnDetail) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.ConnectionAmount)
+	err = z.ConnectionAmount.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "ConnectionAmount")
 		return
@@ -5227,7 +5349,11 @@ func (z *DoDSettleInvoiceConnDetail) MarshalMsg(b []byte) (o []byte, err error)
 	// map header, size 3
 	// string "ConnectionAmount"
 	o = append(o, 0x83, 0xb0, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendFloat64(o, z.ConnectionAmount)
+	o, err = z.ConnectionAmount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ConnectionAmount")
+		return
+	}
 	// string "DoDSettleConnectionStaticParam"
 	o = append(o, 0xbe, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x50, 0x61, 0x72, 0x61, 0x6d)
 	o, err = z.DoDSettleConnectionStaticParam.MarshalMsg(o)
@@ -5271,7 +5397,7 @@ func (z *DoDSettleInvoiceConnDetail) UnmarshalMsg(bts []byte) (o []byte, err err
 		}
 		switch msgp.UnsafeString(field) {
 		case "ConnectionAmount":
-			z.ConnectionAmount, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.ConnectionAmount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "ConnectionAmount")
 				return
@@ -5326,7 +5452,7 @@ func (z *DoDSettleInvoiceConnDetail) UnmarshalMsg(bts []byte) (o []byte, err err
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleInvoiceConnDetail) Msgsize() (s int) {
-	s = 1 + 17 + msgp.Float64Size + 31 + z.DoDSettleConnectionStaticParam.Msgsize() + 6 + msgp.ArrayHeaderSize
+	s = 1 + 17 + z.ConnectionAmount.Msgsize() + 31 + z.DoDSettleConnectionStaticParam.Msgsize() + 6 + msgp.ArrayHeaderSize
 	for za0001 := range z.Usage {
 		if z.Usage[za0001] == nil {
 			s += msgp.NilSize
@@ -5402,7 +5528,7 @@ func (z *DoDSettleInvoiceConnDynamic) DecodeMsg(dc *msgp.Reader) (err error) {
 				z.OrderType = DoDSettleOrderType(zb0002)
 			}
 		case "Amount":
-			z.Amount, err = dc.ReadFloat64()
+			err = z.Amount.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Amount")
 				return
@@ -5496,7 +5622,7 @@ func (z *DoDSettleInvoiceConnDynamic) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.Amount)
+	err = z.Amount.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "Amount")
 		return
@@ -5535,7 +5661,11 @@ func (z *DoDSettleInvoiceConnDynamic) MarshalMsg(b []byte) (o []byte, err error)
 	o = msgp.AppendInt(o, int(z.OrderType))
 	// string "Amount"
 	o = append(o, 0xa6, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendFloat64(o, z.Amount)
+	o, err = z.Amount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Amount")
+		return
+	}
 	return
 }
 
@@ -5604,7 +5734,7 @@ func (z *DoDSettleInvoiceConnDynamic) UnmarshalMsg(bts []byte) (o []byte, err er
 				z.OrderType = DoDSettleOrderType(zb0002)
 			}
 		case "Amount":
-			z.Amount, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.Amount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Amount")
 				return
@@ -5623,12 +5753,12 @@ func (z *DoDSettleInvoiceConnDynamic) UnmarshalMsg(bts []byte) (o []byte, err er
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleInvoiceConnDynamic) Msgsize() (s int) {
-	s = 1 + 32 + z.DoDSettleConnectionDynamicParam.Msgsize() + 17 + msgp.Int64Size + 20 + msgp.StringPrefixSize + len(z.InvoiceStartTimeStr) + 15 + msgp.Int64Size + 18 + msgp.StringPrefixSize + len(z.InvoiceEndTimeStr) + 17 + msgp.IntSize + 10 + msgp.IntSize + 7 + msgp.Float64Size
+	s = 1 + 32 + z.DoDSettleConnectionDynamicParam.Msgsize() + 17 + msgp.Int64Size + 20 + msgp.StringPrefixSize + len(z.InvoiceStartTimeStr) + 15 + msgp.Int64Size + 18 + msgp.StringPrefixSize + len(z.InvoiceEndTimeStr) + 17 + msgp.IntSize + 10 + msgp.IntSize + 7 + z.Amount.Msgsize()
 	return
 }
 
 // DecodeMsg implements msgp.Decodable
-func (z *DoDSettleInvoiceOrderDetail) DecodeMsg(dc *msgp.Reader) (err error) {
+func (z *DoDSettleInvoiceInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -5645,181 +5775,2221 @@ func (z *DoDSettleInvoiceOrderDetail) DecodeMsg(dc *msgp.Reader) (err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "OrderId":
-			z.OrderId, err = dc.ReadString()
+		case "i":
+			err = dc.ReadExtension(&z.InvoiceId)
 			if err != nil {
-				err = msgp.WrapError(err, "OrderId")
+				err = msgp.WrapError(err, "InvoiceId")
 				return
 			}
-		case "InternalId":
-			err = z.InternalId.DecodeMsg(dc)
+		case "ii":
+			err = dc.ReadExtension(&z.InternalId)
 			if err != nil {
 				err = msgp.WrapError(err, "InternalId")
 				return
 			}
-		case "ConnectionCount":
-			z.ConnectionCount, err = dc.ReadInt()
-			if err != nil {
-				err = msgp.WrapError(err, "ConnectionCount")
-				return
-			}
-		case "OrderAmount":
-			z.OrderAmount, err = dc.ReadFloat64()
-			if err != nil {
-				err = msgp.WrapError(err, "OrderAmount")
-				return
-			}
-		case "Connections":
-			var zb0002 uint32
-			zb0002, err = dc.ReadArrayHeader()
+		case "oi":
+			z.OrderId, err = dc.ReadString()
 			if err != nil {
-				err = msgp.WrapError(err, "Connections")
+				err = msgp.WrapError(err, "OrderId")
 				return
 			}
-			if cap(z.Connections) >= int(zb0002) {
-				z.Connections = (z.Connections)[:zb0002]
+		case "b":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Buyer")
+					return
+				}
+				z.Buyer = nil
 			} else {
-				z.Connections = make([]*DoDSettleInvoiceConnDetail, zb0002)
-			}
-			for za0001 := range z.Connections {
-				if dc.IsNil() {
-					err = dc.ReadNil()
+				if z.Buyer == nil {
+					z.Buyer = new(DoDSettleUser)
+				}
+				var zb0002 uint32
+				zb0002, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Buyer")
+					return
+				}
+				for zb0002 > 0 {
+					zb0002--
+					field, err = dc.ReadMapKeyPtr()
 					if err != nil {
-						err = msgp.WrapError(err, "Connections", za0001)
+						err = msgp.WrapError(err, "Buyer")
 						return
 					}
-					z.Connections[za0001] = nil
-				} else {
-					if z.Connections[za0001] == nil {
-						z.Connections[za0001] = new(DoDSettleInvoiceConnDetail)
+					switch msgp.UnsafeString(field) {
+					case "a":
+						err = dc.ReadExtension(&z.Buyer.Address)
+						if err != nil {
+							err = msgp.WrapError(err, "Buyer", "Address")
+							return
+						}
+					case "n":
+						z.Buyer.Name, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Buyer", "Name")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Buyer")
+							return
+						}
 					}
-					err = z.Connections[za0001].DecodeMsg(dc)
+				}
+			}
+		case "s":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Seller")
+					return
+				}
+				z.Seller = nil
+			} else {
+				if z.Seller == nil {
+					z.Seller = new(DoDSettleUser)
+				}
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Seller")
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
 					if err != nil {
-						err = msgp.WrapError(err, "Connections", za0001)
+						err = msgp.WrapError(err, "Seller")
 						return
 					}
+					switch msgp.UnsafeString(field) {
+					case "a":
+						err = dc.ReadExtension(&z.Seller.Address)
+						if err != nil {
+							err = msgp.WrapError(err, "Seller", "Address")
+							return
+						}
+					case "n":
+						z.Seller.Name, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Seller", "Name")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Seller")
+							return
+						}
+					}
 				}
 			}
-		default:
-			err = dc.Skip()
+		case "cr":
+			z.Currency, err = dc.ReadString()
 			if err != nil {
-				err = msgp.WrapError(err)
+				err = msgp.WrapError(err, "Currency")
 				return
 			}
-		}
-	}
-	return
-}
-
-// EncodeMsg implements msgp.Encodable
-func (z *DoDSettleInvoiceOrderDetail) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 5
-	// write "OrderId"
-	err = en.Append(0x85, 0xa7, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64)
-	if err != nil {
-		return
-	}
-	err = en.WriteString(z.OrderId)
-	if err != nil {
-		err = msgp.WrapError(err, "OrderId")
-		return
-	}
-	// write "InternalId"
-	err = en.Append(0xaa, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64)
-	if err != nil {
-		return
-	}
+		case "a":
+			err = z.Amount.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Amount")
+				return
+			}
+		case "tk":
+			err = dc.ReadExtension(&z.Token)
+			if err != nil {
+				err = msgp.WrapError(err, "Token")
+				return
+			}
+		case "ta":
+			err = dc.ReadExtension(&z.TokenAmount)
+			if err != nil {
+				err = msgp.WrapError(err, "TokenAmount")
+				return
+			}
+		case "st":
+			z.StartTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "StartTime")
+				return
+			}
+		case "et":
+			z.EndTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "EndTime")
+				return
+			}
+		case "dt":
+			z.DueTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "DueTime")
+				return
+			}
+		case "t":
+			z.IssuedAt, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "IssuedAt")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DoDSettleInvoiceInfo) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 13
+	// write "i"
+	err = en.Append(0x8d, 0xa1, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.InvoiceId)
+	if err != nil {
+		err = msgp.WrapError(err, "InvoiceId")
+		return
+	}
+	// write "ii"
+	err = en.Append(0xa2, 0x69, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.InternalId)
+	if err != nil {
+		err = msgp.WrapError(err, "InternalId")
+		return
+	}
+	// write "oi"
+	err = en.Append(0xa2, 0x6f, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.OrderId)
+	if err != nil {
+		err = msgp.WrapError(err, "OrderId")
+		return
+	}
+	// write "b"
+	err = en.Append(0xa1, 0x62)
+	if err != nil {
+		return
+	}
+	if z.Buyer == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 2
+		// write "a"
+		err = en.Append(0x82, 0xa1, 0x61)
+		if err != nil {
+			return
+		}
+		err = en.WriteExtension(&z.Buyer.Address)
+		if err != nil {
+			err = msgp.WrapError(err, "Buyer", "Address")
+			return
+		}
+		// write "n"
+		err = en.Append(0xa1, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Buyer.Name)
+		if err != nil {
+			err = msgp.WrapError(err, "Buyer", "Name")
+			return
+		}
+	}
+	// write "s"
+	err = en.Append(0xa1, 0x73)
+	if err != nil {
+		return
+	}
+	if z.Seller == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 2
+		// write "a"
+		err = en.Append(0x82, 0xa1, 0x61)
+		if err != nil {
+			return
+		}
+		err = en.WriteExtension(&z.Seller.Address)
+		if err != nil {
+			err = msgp.WrapError(err, "Seller", "Address")
+			return
+		}
+		// write "n"
+		err = en.Append(0xa1, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Seller.Name)
+		if err != nil {
+			err = msgp.WrapError(err, "Seller", "Name")
+			return
+		}
+	}
+	// write "cr"
+	err = en.Append(0xa2, 0x63, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Currency)
+	if err != nil {
+		err = msgp.WrapError(err, "Currency")
+		return
+	}
+	// write "a"
+	err = en.Append(0xa1, 0x61)
+	if err != nil {
+		return
+	}
+	err = z.Amount.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Amount")
+		return
+	}
+	// write "tk"
+	err = en.Append(0xa2, 0x74, 0x6b)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Token)
+	if err != nil {
+		err = msgp.WrapError(err, "Token")
+		return
+	}
+	// write "ta"
+	err = en.Append(0xa2, 0x74, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.TokenAmount)
+	if err != nil {
+		err = msgp.WrapError(err, "TokenAmount")
+		return
+	}
+	// write "st"
+	err = en.Append(0xa2, 0x73, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.StartTime)
+	if err != nil {
+		err = msgp.WrapError(err, "StartTime")
+		return
+	}
+	// write "et"
+	err = en.Append(0xa2, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.EndTime)
+	if err != nil {
+		err = msgp.WrapError(err, "EndTime")
+		return
+	}
+	// write "dt"
+	err = en.Append(0xa2, 0x64, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.DueTime)
+	if err != nil {
+		err = msgp.WrapError(err, "DueTime")
+		return
+	}
+	// write "t"
+	err = en.Append(0xa1, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.IssuedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "IssuedAt")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DoDSettleInvoiceInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 13
+	// string "i"
+	o = append(o, 0x8d, 0xa1, 0x69)
+	o, err = msgp.AppendExtension(o, &z.InvoiceId)
+	if err != nil {
+		err = msgp.WrapError(err, "InvoiceId")
+		return
+	}
+	// string "ii"
+	o = append(o, 0xa2, 0x69, 0x69)
+	o, err = msgp.AppendExtension(o, &z.InternalId)
+	if err != nil {
+		err = msgp.WrapError(err, "InternalId")
+		return
+	}
+	// string "oi"
+	o = append(o, 0xa2, 0x6f, 0x69)
+	o = msgp.AppendString(o, z.OrderId)
+	// string "b"
+	o = append(o, 0xa1, 0x62)
+	if z.Buyer == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 2
+		// string "a"
+		o = append(o, 0x82, 0xa1, 0x61)
+		o, err = msgp.AppendExtension(o, &z.Buyer.Address)
+		if err != nil {
+			err = msgp.WrapError(err, "Buyer", "Address")
+			return
+		}
+		// string "n"
+		o = append(o, 0xa1, 0x6e)
+		o = msgp.AppendString(o, z.Buyer.Name)
+	}
+	// string "s"
+	o = append(o, 0xa1, 0x73)
+	if z.Seller == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 2
+		// string "a"
+		o = append(o, 0x82, 0xa1, 0x61)
+		o, err = msgp.AppendExtension(o, &z.Seller.Address)
+		if err != nil {
+			err = msgp.WrapError(err, "Seller", "Address")
+			return
+		}
+		// string "n"
+		o = append(o, 0xa1, 0x6e)
+		o = msgp.AppendString(o, z.Seller.Name)
+	}
+	// string "cr"
+	o = append(o, 0xa2, 0x63, 0x72)
+	o = msgp.AppendString(o, z.Currency)
+	// string "a"
+	o = append(o, 0xa1, 0x61)
+	o, err = z.Amount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Amount")
+		return
+	}
+	// string "tk"
+	o = append(o, 0xa2, 0x74, 0x6b)
+	o, err = msgp.AppendExtension(o, &z.Token)
+	if err != nil {
+		err = msgp.WrapError(err, "Token")
+		return
+	}
+	// string "ta"
+	o = append(o, 0xa2, 0x74, 0x61)
+	o, err = msgp.AppendExtension(o, &z.TokenAmount)
+	if err != nil {
+		err = msgp.WrapError(err, "TokenAmount")
+		return
+	}
+	// string "st"
+	o = append(o, 0xa2, 0x73, 0x74)
+	o = msgp.AppendInt64(o, z.StartTime)
+	// string "et"
+	o = append(o, 0xa2, 0x65, 0x74)
+	o = msgp.AppendInt64(o, z.EndTime)
+	// string "dt"
+	o = append(o, 0xa2, 0x64, 0x74)
+	o = msgp.AppendInt64(o, z.DueTime)
+	// string "t"
+	o = append(o, 0xa1, 0x74)
+	o = msgp.AppendInt64(o, z.IssuedAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoiceInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.InvoiceId)
+			if err != nil {
+				err = msgp.WrapError(err, "InvoiceId")
+				return
+			}
+		case "ii":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.InternalId)
+			if err != nil {
+				err = msgp.WrapError(err, "InternalId")
+				return
+			}
+		case "oi":
+			z.OrderId, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OrderId")
+				return
+			}
+		case "b":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Buyer = nil
+			} else {
+				if z.Buyer == nil {
+					z.Buyer = new(DoDSettleUser)
+				}
+				var zb0002 uint32
+				zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Buyer")
+					return
+				}
+				for zb0002 > 0 {
+					zb0002--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Buyer")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "a":
+						bts, err = msgp.ReadExtensionBytes(bts, &z.Buyer.Address)
+						if err != nil {
+							err = msgp.WrapError(err, "Buyer", "Address")
+							return
+						}
+					case "n":
+						z.Buyer.Name, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Buyer", "Name")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Buyer")
+							return
+						}
+					}
+				}
+			}
+		case "s":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Seller = nil
+			} else {
+				if z.Seller == nil {
+					z.Seller = new(DoDSettleUser)
+				}
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Seller")
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Seller")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "a":
+						bts, err = msgp.ReadExtensionBytes(bts, &z.Seller.Address)
+						if err != nil {
+							err = msgp.WrapError(err, "Seller", "Address")
+							return
+						}
+					case "n":
+						z.Seller.Name, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Seller", "Name")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Seller")
+							return
+						}
+					}
+				}
+			}
+		case "cr":
+			z.Currency, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Currency")
+				return
+			}
+		case "a":
+			bts, err = z.Amount.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Amount")
+				return
+			}
+		case "tk":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Token)
+			if err != nil {
+				err = msgp.WrapError(err, "Token")
+				return
+			}
+		case "ta":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.TokenAmount)
+			if err != nil {
+				err = msgp.WrapError(err, "TokenAmount")
+				return
+			}
+		case "st":
+			z.StartTime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "StartTime")
+				return
+			}
+		case "et":
+			z.EndTime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EndTime")
+				return
+			}
+		case "dt":
+			z.DueTime, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DueTime")
+				return
+			}
+		case "t":
+			z.IssuedAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "IssuedAt")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DoDSettleInvoiceInfo) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ExtensionPrefixSize + z.InvoiceId.Len() + 3 + msgp.ExtensionPrefixSize + z.InternalId.Len() + 3 + msgp.StringPrefixSize + len(z.OrderId) + 2
+	if z.Buyer == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 2 + msgp.ExtensionPrefixSize + z.Buyer.Address.Len() + 2 + msgp.StringPrefixSize + len(z.Buyer.Name)
+	}
+	s += 2
+	if z.Seller == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 2 + msgp.ExtensionPrefixSize + z.Seller.Address.Len() + 2 + msgp.StringPrefixSize + len(z.Seller.Name)
+	}
+	s += 3 + msgp.StringPrefixSize + len(z.Currency) + 2 + z.Amount.Msgsize() + 3 + msgp.ExtensionPrefixSize + z.Token.Len() + 3 + msgp.ExtensionPrefixSize + z.TokenAmount.Len() + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 2 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleInvoiceOrderDetail) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "OrderId":
+			z.OrderId, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "OrderId")
+				return
+			}
+		case "InternalId":
+			err = z.InternalId.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "InternalId")
+				return
+			}
+		case "ConnectionCount":
+			z.ConnectionCount, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "ConnectionCount")
+				return
+			}
+		case "OrderAmount":
+			err = z.OrderAmount.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "OrderAmount")
+				return
+			}
+		case "Connections":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Connections")
+				return
+			}
+			if cap(z.Connections) >= int(zb0002) {
+				z.Connections = (z.Connections)[:zb0002]
+			} else {
+				z.Connections = make([]*DoDSettleInvoiceConnDetail, zb0002)
+			}
+			for za0001 := range z.Connections {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Connections", za0001)
+						return
+					}
+					z.Connections[za0001] = nil
+				} else {
+					if z.Connections[za0001] == nil {
+						z.Connections[za0001] = new(DoDSettleInvoiceConnDetail)
+					}
+					err = z.Connections[za0001].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "Connections", za0001)
+						return
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DoDSettleInvoiceOrderDetail) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "OrderId"
+	err = en.Append(0x85, 0xa7, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.OrderId)
+	if err != nil {
+		err = msgp.WrapError(err, "OrderId")
+		return
+	}
+	// write "InternalId"
+	err = en.Append(0xaa, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64)
+	if err != nil {
+		return
+	}
 	err = z.InternalId.EncodeMsg(en)
 	if err != nil {
-		err = msgp.WrapError(err, "InternalId")
+		err = msgp.WrapError(err, "InternalId")
+		return
+	}
+	// write "ConnectionCount"
+	err = en.Append(0xaf, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.ConnectionCount)
+	if err != nil {
+		err = msgp.WrapError(err, "ConnectionCount")
+		return
+	}
+	// write "OrderAmount"
+	err = en.Append(0xab, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = z.OrderAmount.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "OrderAmount")
+		return
+	}
+	// write "Connections"
+	err = en.Append(0xab, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Connections)))
+	if err != nil {
+		err = msgp.WrapError(err, "Connections")
+		return
+	}
+	for za0001 := range z.Connections {
+		if z.Connections[za0001] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.Connections[za0001].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Connections", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DoDSettleInvoiceOrderDetail) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "OrderId"
+	o = append(o, 0x85, 0xa7, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64)
+	o = msgp.AppendString(o, z.OrderId)
+	// string "InternalId"
+	o = append(o, 0xaa, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64)
+	o, err = z.InternalId.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "InternalId")
+		return
+	}
+	// string "ConnectionCount"
+	o = append(o, 0xaf, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	o = msgp.AppendInt(o, z.ConnectionCount)
+	// string "OrderAmount"
+	o = append(o, 0xab, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
+	o, err = z.OrderAmount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "OrderAmount")
+		return
+	}
+	// string "Connections"
+	o = append(o, 0xab, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Connections)))
+	for za0001 := range z.Connections {
+		if z.Connections[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Connections[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Connections", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoiceOrderDetail) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "OrderId":
+			z.OrderId, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OrderId")
+				return
+			}
+		case "InternalId":
+			bts, err = z.InternalId.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "InternalId")
+				return
+			}
+		case "ConnectionCount":
+			z.ConnectionCount, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ConnectionCount")
+				return
+			}
+		case "OrderAmount":
+			bts, err = z.OrderAmount.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "OrderAmount")
+				return
+			}
+		case "Connections":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Connections")
+				return
+			}
+			if cap(z.Connections) >= int(zb0002) {
+				z.Connections = (z.Connections)[:zb0002]
+			} else {
+				z.Connections = make([]*DoDSettleInvoiceConnDetail, zb0002)
+			}
+			for za0001 := range z.Connections {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Connections[za0001] = nil
+				} else {
+					if z.Connections[za0001] == nil {
+						z.Connections[za0001] = new(DoDSettleInvoiceConnDetail)
+					}
+					bts, err = z.Connections[za0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Connections", za0001)
+						return
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DoDSettleInvoiceOrderDetail) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.OrderId) + 11 + z.InternalId.Msgsize() + 16 + msgp.IntSize + 12 + z.OrderAmount.Msgsize() + 12 + msgp.ArrayHeaderSize
+	for za0001 := range z.Connections {
+		if z.Connections[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Connections[za0001].Msgsize()
+		}
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleInvoicePayment) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			err = dc.ReadExtension(&z.PaymentId)
+			if err != nil {
+				err = msgp.WrapError(err, "PaymentId")
+				return
+			}
+		case "p":
+			err = dc.ReadExtension(&z.Payer)
+			if err != nil {
+				err = msgp.WrapError(err, "Payer")
+				return
+			}
+		case "a":
+			err = dc.ReadExtension(&z.Amount)
+			if err != nil {
+				err = msgp.WrapError(err, "Amount")
+				return
+			}
+		case "ap":
+			err = dc.ReadExtension(&z.Applied)
+			if err != nil {
+				err = msgp.WrapError(err, "Applied")
+				return
+			}
+		case "r":
+			err = dc.ReadExtension(&z.Refund)
+			if err != nil {
+				err = msgp.WrapError(err, "Refund")
+				return
+			}
+		case "t":
+			z.PaidAt, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "PaidAt")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DoDSettleInvoicePayment) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "i"
+	err = en.Append(0x86, 0xa1, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.PaymentId)
+	if err != nil {
+		err = msgp.WrapError(err, "PaymentId")
+		return
+	}
+	// write "p"
+	err = en.Append(0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Payer)
+	if err != nil {
+		err = msgp.WrapError(err, "Payer")
+		return
+	}
+	// write "a"
+	err = en.Append(0xa1, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Amount)
+	if err != nil {
+		err = msgp.WrapError(err, "Amount")
+		return
+	}
+	// write "ap"
+	err = en.Append(0xa2, 0x61, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Applied)
+	if err != nil {
+		err = msgp.WrapError(err, "Applied")
+		return
+	}
+	// write "r"
+	err = en.Append(0xa1, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Refund)
+	if err != nil {
+		err = msgp.WrapError(err, "Refund")
+		return
+	}
+	// write "t"
+	err = en.Append(0xa1, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.PaidAt)
+	if err != nil {
+		err = msgp.WrapError(err, "PaidAt")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DoDSettleInvoicePayment) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "i"
+	o = append(o, 0x86, 0xa1, 0x69)
+	o, err = msgp.AppendExtension(o, &z.PaymentId)
+	if err != nil {
+		err = msgp.WrapError(err, "PaymentId")
+		return
+	}
+	// string "p"
+	o = append(o, 0xa1, 0x70)
+	o, err = msgp.AppendExtension(o, &z.Payer)
+	if err != nil {
+		err = msgp.WrapError(err, "Payer")
+		return
+	}
+	// string "a"
+	o = append(o, 0xa1, 0x61)
+	o, err = msgp.AppendExtension(o, &z.Amount)
+	if err != nil {
+		err = msgp.WrapError(err, "Amount")
+		return
+	}
+	// string "ap"
+	o = append(o, 0xa2, 0x61, 0x70)
+	o, err = msgp.AppendExtension(o, &z.Applied)
+	if err != nil {
+		err = msgp.WrapError(err, "Applied")
+		return
+	}
+	// string "r"
+	o = append(o, 0xa1, 0x72)
+	o, err = msgp.AppendExtension(o, &z.Refund)
+	if err != nil {
+		err = msgp.WrapError(err, "Refund")
+		return
+	}
+	// string "t"
+	o = append(o, 0xa1, 0x74)
+	o = msgp.AppendInt64(o, z.PaidAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoicePayment) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.PaymentId)
+			if err != nil {
+				err = msgp.WrapError(err, "PaymentId")
+				return
+			}
+		case "p":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Payer)
+			if err != nil {
+				err = msgp.WrapError(err, "Payer")
+				return
+			}
+		case "a":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Amount)
+			if err != nil {
+				err = msgp.WrapError(err, "Amount")
+				return
+			}
+		case "ap":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Applied)
+			if err != nil {
+				err = msgp.WrapError(err, "Applied")
+				return
+			}
+		case "r":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Refund)
+			if err != nil {
+				err = msgp.WrapError(err, "Refund")
+				return
+			}
+		case "t":
+			z.PaidAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PaidAt")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DoDSettleInvoicePayment) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ExtensionPrefixSize + z.PaymentId.Len() + 2 + msgp.ExtensionPrefixSize + z.Payer.Len() + 2 + msgp.ExtensionPrefixSize + z.Amount.Len() + 3 + msgp.ExtensionPrefixSize + z.Applied.Len() + 2 + msgp.ExtensionPrefixSize + z.Refund.Len() + 2 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleInvoicePaymentDetail) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DoDSettleInvoicePayment":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "DoDSettleInvoicePayment")
+					return
+				}
+				z.DoDSettleInvoicePayment = nil
+			} else {
+				if z.DoDSettleInvoicePayment == nil {
+					z.DoDSettleInvoicePayment = new(DoDSettleInvoicePayment)
+				}
+				err = z.DoDSettleInvoicePayment.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "DoDSettleInvoicePayment")
+					return
+				}
+			}
+		case "rf":
+			z.Refunded, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Refunded")
+				return
+			}
+		case "rt":
+			z.RefundAt, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "RefundAt")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DoDSettleInvoicePaymentDetail) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "DoDSettleInvoicePayment"
+	err = en.Append(0x83, 0xb7, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	if z.DoDSettleInvoicePayment == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.DoDSettleInvoicePayment.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "DoDSettleInvoicePayment")
+			return
+		}
+	}
+	// write "rf"
+	err = en.Append(0xa2, 0x72, 0x66)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Refunded)
+	if err != nil {
+		err = msgp.WrapError(err, "Refunded")
+		return
+	}
+	// write "rt"
+	err = en.Append(0xa2, 0x72, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.RefundAt)
+	if err != nil {
+		err = msgp.WrapError(err, "RefundAt")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DoDSettleInvoicePaymentDetail) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "DoDSettleInvoicePayment"
+	o = append(o, 0x83, 0xb7, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74)
+	if z.DoDSettleInvoicePayment == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.DoDSettleInvoicePayment.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "DoDSettleInvoicePayment")
+			return
+		}
+	}
+	// string "rf"
+	o = append(o, 0xa2, 0x72, 0x66)
+	o = msgp.AppendBool(o, z.Refunded)
+	// string "rt"
+	o = append(o, 0xa2, 0x72, 0x74)
+	o = msgp.AppendInt64(o, z.RefundAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoicePaymentDetail) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DoDSettleInvoicePayment":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.DoDSettleInvoicePayment = nil
+			} else {
+				if z.DoDSettleInvoicePayment == nil {
+					z.DoDSettleInvoicePayment = new(DoDSettleInvoicePayment)
+				}
+				bts, err = z.DoDSettleInvoicePayment.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "DoDSettleInvoicePayment")
+					return
+				}
+			}
+		case "rf":
+			z.Refunded, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Refunded")
+				return
+			}
+		case "rt":
+			z.RefundAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RefundAt")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DoDSettleInvoicePaymentDetail) Msgsize() (s int) {
+	s = 1 + 24
+	if z.DoDSettleInvoicePayment == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.DoDSettleInvoicePayment.Msgsize()
+	}
+	s += 3 + msgp.BoolSize + 3 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleInvoicePaymentStatus) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DoDSettleInvoiceInfo":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "DoDSettleInvoiceInfo")
+					return
+				}
+				z.DoDSettleInvoiceInfo = nil
+			} else {
+				if z.DoDSettleInvoiceInfo == nil {
+					z.DoDSettleInvoiceInfo = new(DoDSettleInvoiceInfo)
+				}
+				err = z.DoDSettleInvoiceInfo.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "DoDSettleInvoiceInfo")
+					return
+				}
+			}
+		case "ps":
+			{
+				var zb0002 int
+				zb0002, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Status")
+					return
+				}
+				z.Status = DoDSettleInvoiceStatus(zb0002)
+			}
+		case "du":
+			{
+				var zb0003 int
+				zb0003, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Dunning")
+					return
+				}
+				z.Dunning = DoDSettleDunningState(zb0003)
+			}
+		case "pd":
+			err = dc.ReadExtension(&z.Paid)
+			if err != nil {
+				err = msgp.WrapError(err, "Paid")
+				return
+			}
+		case "o":
+			err = dc.ReadExtension(&z.Outstanding)
+			if err != nil {
+				err = msgp.WrapError(err, "Outstanding")
+				return
+			}
+		case "pa":
+			err = z.PaidAmount.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "PaidAmount")
+				return
+			}
+		case "rf":
+			err = dc.ReadExtension(&z.Refundable)
+			if err != nil {
+				err = msgp.WrapError(err, "Refundable")
+				return
+			}
+		case "pt":
+			z.PaidAt, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "PaidAt")
+				return
+			}
+		case "p":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Payments")
+				return
+			}
+			if cap(z.Payments) >= int(zb0004) {
+				z.Payments = (z.Payments)[:zb0004]
+			} else {
+				z.Payments = make([]*DoDSettleInvoicePaymentDetail, zb0004)
+			}
+			for za0001 := range z.Payments {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Payments", za0001)
+						return
+					}
+					z.Payments[za0001] = nil
+				} else {
+					if z.Payments[za0001] == nil {
+						z.Payments[za0001] = new(DoDSettleInvoicePaymentDetail)
+					}
+					err = z.Payments[za0001].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "Payments", za0001)
+						return
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DoDSettleInvoicePaymentStatus) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 9
+	// write "DoDSettleInvoiceInfo"
+	err = en.Append(0x89, 0xb4, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f)
+	if err != nil {
+		return
+	}
+	if z.DoDSettleInvoiceInfo == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.DoDSettleInvoiceInfo.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "DoDSettleInvoiceInfo")
+			return
+		}
+	}
+	// write "ps"
+	err = en.Append(0xa2, 0x70, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.Status))
+	if err != nil {
+		err = msgp.WrapError(err, "Status")
+		return
+	}
+	// write "du"
+	err = en.Append(0xa2, 0x64, 0x75)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.Dunning))
+	if err != nil {
+		err = msgp.WrapError(err, "Dunning")
+		return
+	}
+	// write "pd"
+	err = en.Append(0xa2, 0x70, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Paid)
+	if err != nil {
+		err = msgp.WrapError(err, "Paid")
+		return
+	}
+	// write "o"
+	err = en.Append(0xa1, 0x6f)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Outstanding)
+	if err != nil {
+		err = msgp.WrapError(err, "Outstanding")
+		return
+	}
+	// write "pa"
+	err = en.Append(0xa2, 0x70, 0x61)
+	if err != nil {
+		return
+	}
+	err = z.PaidAmount.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "PaidAmount")
+		return
+	}
+	// write "rf"
+	err = en.Append(0xa2, 0x72, 0x66)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Refundable)
+	if err != nil {
+		err = msgp.WrapError(err, "Refundable")
+		return
+	}
+	// write "pt"
+	err = en.Append(0xa2, 0x70, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.PaidAt)
+	if err != nil {
+		err = msgp.WrapError(err, "PaidAt")
+		return
+	}
+	// write "p"
+	err = en.Append(0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Payments)))
+	if err != nil {
+		err = msgp.WrapError(err, "Payments")
+		return
+	}
+	for za0001 := range z.Payments {
+		if z.Payments[za0001] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.Payments[za0001].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Payments", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *DoDSettleInvoicePaymentStatus) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 9
+	// string "DoDSettleInvoiceInfo"
+	o = append(o, 0x89, 0xb4, 0x44, 0x6f, 0x44, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f)
+	if z.DoDSettleInvoiceInfo == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.DoDSettleInvoiceInfo.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "DoDSettleInvoiceInfo")
+			return
+		}
+	}
+	// string "ps"
+	o = append(o, 0xa2, 0x70, 0x73)
+	o = msgp.AppendInt(o, int(z.Status))
+	// string "du"
+	o = append(o, 0xa2, 0x64, 0x75)
+	o = msgp.AppendInt(o, int(z.Dunning))
+	// string "pd"
+	o = append(o, 0xa2, 0x70, 0x64)
+	o, err = msgp.AppendExtension(o, &z.Paid)
+	if err != nil {
+		err = msgp.WrapError(err, "Paid")
+		return
+	}
+	// string "o"
+	o = append(o, 0xa1, 0x6f)
+	o, err = msgp.AppendExtension(o, &z.Outstanding)
+	if err != nil {
+		err = msgp.WrapError(err, "Outstanding")
+		return
+	}
+	// string "pa"
+	o = append(o, 0xa2, 0x70, 0x61)
+	o, err = z.PaidAmount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "PaidAmount")
+		return
+	}
+	// string "rf"
+	o = append(o, 0xa2, 0x72, 0x66)
+	o, err = msgp.AppendExtension(o, &z.Refundable)
+	if err != nil {
+		err = msgp.WrapError(err, "Refundable")
+		return
+	}
+	// string "pt"
+	o = append(o, 0xa2, 0x70, 0x74)
+	o = msgp.AppendInt64(o, z.PaidAt)
+	// string "p"
+	o = append(o, 0xa1, 0x70)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Payments)))
+	for za0001 := range z.Payments {
+		if z.Payments[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Payments[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Payments", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoicePaymentStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DoDSettleInvoiceInfo":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.DoDSettleInvoiceInfo = nil
+			} else {
+				if z.DoDSettleInvoiceInfo == nil {
+					z.DoDSettleInvoiceInfo = new(DoDSettleInvoiceInfo)
+				}
+				bts, err = z.DoDSettleInvoiceInfo.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "DoDSettleInvoiceInfo")
+					return
+				}
+			}
+		case "ps":
+			{
+				var zb0002 int
+				zb0002, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Status")
+					return
+				}
+				z.Status = DoDSettleInvoiceStatus(zb0002)
+			}
+		case "du":
+			{
+				var zb0003 int
+				zb0003, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Dunning")
+					return
+				}
+				z.Dunning = DoDSettleDunningState(zb0003)
+			}
+		case "pd":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Paid)
+			if err != nil {
+				err = msgp.WrapError(err, "Paid")
+				return
+			}
+		case "o":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Outstanding)
+			if err != nil {
+				err = msgp.WrapError(err, "Outstanding")
+				return
+			}
+		case "pa":
+			bts, err = z.PaidAmount.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PaidAmount")
+				return
+			}
+		case "rf":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Refundable)
+			if err != nil {
+				err = msgp.WrapError(err, "Refundable")
+				return
+			}
+		case "pt":
+			z.PaidAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PaidAt")
+				return
+			}
+		case "p":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Payments")
+				return
+			}
+			if cap(z.Payments) >= int(zb0004) {
+				z.Payments = (z.Payments)[:zb0004]
+			} else {
+				z.Payments = make([]*DoDSettleInvoicePaymentDetail, zb0004)
+			}
+			for za0001 := range z.Payments {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Payments[za0001] = nil
+				} else {
+					if z.Payments[za0001] == nil {
+						z.Payments[za0001] = new(DoDSettleInvoicePaymentDetail)
+					}
+					bts, err = z.Payments[za0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Payments", za0001)
+						return
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DoDSettleInvoicePaymentStatus) Msgsize() (s int) {
+	s = 1 + 21
+	if z.DoDSettleInvoiceInfo == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.DoDSettleInvoiceInfo.Msgsize()
+	}
+	s += 3 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.ExtensionPrefixSize + z.Paid.Len() + 2 + msgp.ExtensionPrefixSize + z.Outstanding.Len() + 3 + z.PaidAmount.Msgsize() + 3 + msgp.ExtensionPrefixSize + z.Refundable.Len() + 3 + msgp.Int64Size + 2 + msgp.ArrayHeaderSize
+	for za0001 := range z.Payments {
+		if z.Payments[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Payments[za0001].Msgsize()
+		}
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleInvoiceRefund) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "p":
+			err = dc.ReadExtension(&z.Previous)
+			if err != nil {
+				err = msgp.WrapError(err, "Previous")
+				return
+			}
+		case "t":
+			z.RefundAt, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "RefundAt")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DoDSettleInvoiceRefund) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "p"
+	err = en.Append(0x82, 0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Previous)
+	if err != nil {
+		err = msgp.WrapError(err, "Previous")
+		return
+	}
+	// write "t"
+	err = en.Append(0xa1, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.RefundAt)
+	if err != nil {
+		err = msgp.WrapError(err, "RefundAt")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DoDSettleInvoiceRefund) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "p"
+	o = append(o, 0x82, 0xa1, 0x70)
+	o, err = msgp.AppendExtension(o, &z.Previous)
+	if err != nil {
+		err = msgp.WrapError(err, "Previous")
+		return
+	}
+	// string "t"
+	o = append(o, 0xa1, 0x74)
+	o = msgp.AppendInt64(o, z.RefundAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoiceRefund) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "p":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Previous)
+			if err != nil {
+				err = msgp.WrapError(err, "Previous")
+				return
+			}
+		case "t":
+			z.RefundAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RefundAt")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DoDSettleInvoiceRefund) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ExtensionPrefixSize + z.Previous.Len() + 2 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleInvoiceStatus) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DoDSettleInvoiceStatus(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DoDSettleInvoiceStatus) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DoDSettleInvoiceStatus) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleInvoiceStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DoDSettleInvoiceStatus(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DoDSettleInvoiceStatus) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleIssueInvoiceParam) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
 		return
 	}
-	// write "ConnectionCount"
-	err = en.Append(0xaf, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "oi":
+			z.OrderId, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "OrderId")
+				return
+			}
+		case "tk":
+			err = dc.ReadExtension(&z.Token)
+			if err != nil {
+				err = msgp.WrapError(err, "Token")
+				return
+			}
+		case "st":
+			z.StartTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "StartTime")
+				return
+			}
+		case "et":
+			z.EndTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "EndTime")
+				return
+			}
+		case "dt":
+			z.DueTime, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "DueTime")
+				return
+			}
+		case "f":
+			z.Flight, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "sp":
+			z.Split, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *DoDSettleIssueInvoiceParam) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "oi"
+	err = en.Append(0x87, 0xa2, 0x6f, 0x69)
 	if err != nil {
 		return
 	}
-	err = en.WriteInt(z.ConnectionCount)
+	err = en.WriteString(z.OrderId)
 	if err != nil {
-		err = msgp.WrapError(err, "ConnectionCount")
+		err = msgp.WrapError(err, "OrderId")
 		return
 	}
-	// write "OrderAmount"
-	err = en.Append(0xab, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
+	// write "tk"
+	err = en.Append(0xa2, 0x74, 0x6b)
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.OrderAmount)
+	err = en.WriteExtension(&z.Token)
 	if err != nil {
-		err = msgp.WrapError(err, "OrderAmount")
+		err = msgp.WrapError(err, "Token")
 		return
 	}
-	// write "Connections"
-	err = en.Append(0xab, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73)
+	// write "st"
+	err = en.Append(0xa2, 0x73, 0x74)
 	if err != nil {
 		return
 	}
-	err = en.WriteArrayHeader(uint32(len(z.Connections)))
+	err = en.WriteInt64(z.StartTime)
 	if err != nil {
-		err = msgp.WrapError(err, "Connections")
+		err = msgp.WrapError(err, "StartTime")
 		return
 	}
-	for za0001 := range z.Connections {
-		if z.Connections[za0001] == nil {
-			err = en.WriteNil()
-			if err != nil {
-				return
-			}
-		} else {
-			err = z.Connections[za0001].EncodeMsg(en)
-			if err != nil {
-				err = msgp.WrapError(err, "Connections", za0001)
-				return
-			}
-		}
+	// write "et"
+	err = en.Append(0xa2, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.EndTime)
+	if err != nil {
+		err = msgp.WrapError(err, "EndTime")
+		return
+	}
+	// write "dt"
+	err = en.Append(0xa2, 0x64, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.DueTime)
+	if err != nil {
+		err = msgp.WrapError(err, "DueTime")
+		return
+	}
+	// write "f"
+	err = en.Append(0xa1, 0x66)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Flight)
+	if err != nil {
+		err = msgp.WrapError(err, "Flight")
+		return
+	}
+	// write "sp"
+	err = en.Append(0xa2, 0x73, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Split)
+	if err != nil {
+		err = msgp.WrapError(err, "Split")
+		return
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z *DoDSettleInvoiceOrderDetail) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *DoDSettleIssueInvoiceParam) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 5
-	// string "OrderId"
-	o = append(o, 0x85, 0xa7, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64)
+	// map header, size 7
+	// string "oi"
+	o = append(o, 0x87, 0xa2, 0x6f, 0x69)
 	o = msgp.AppendString(o, z.OrderId)
-	// string "InternalId"
-	o = append(o, 0xaa, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64)
-	o, err = z.InternalId.MarshalMsg(o)
+	// string "tk"
+	o = append(o, 0xa2, 0x74, 0x6b)
+	o, err = msgp.AppendExtension(o, &z.Token)
 	if err != nil {
-		err = msgp.WrapError(err, "InternalId")
+		err = msgp.WrapError(err, "Token")
 		return
 	}
-	// string "ConnectionCount"
-	o = append(o, 0xaf, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendInt(o, z.ConnectionCount)
-	// string "OrderAmount"
-	o = append(o, 0xab, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendFloat64(o, z.OrderAmount)
-	// string "Connections"
-	o = append(o, 0xab, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73)
-	o = msgp.AppendArrayHeader(o, uint32(len(z.Connections)))
-	for za0001 := range z.Connections {
-		if z.Connections[za0001] == nil {
-			o = msgp.AppendNil(o)
-		} else {
-			o, err = z.Connections[za0001].MarshalMsg(o)
-			if err != nil {
-				err = msgp.WrapError(err, "Connections", za0001)
-				return
-			}
-		}
-	}
+	// string "st"
+	o = append(o, 0xa2, 0x73, 0x74)
+	o = msgp.AppendInt64(o, z.StartTime)
+	// string "et"
+	o = append(o, 0xa2, 0x65, 0x74)
+	o = msgp.AppendInt64(o, z.EndTime)
+	// string "dt"
+	o = append(o, 0xa2, 0x64, 0x74)
+	o = msgp.AppendInt64(o, z.DueTime)
+	// string "f"
+	o = append(o, 0xa1, 0x66)
+	o = msgp.AppendBool(o, z.Flight)
+	// string "sp"
+	o = append(o, 0xa2, 0x73, 0x70)
+	o = msgp.AppendBool(o, z.Split)
 	return
 }
 
 // UnmarshalMsg implements msgp.Unmarshaler
-func (z *DoDSettleInvoiceOrderDetail) UnmarshalMsg(bts []byte) (o []byte, err error) {
+func (z *DoDSettleIssueInvoiceParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -5836,59 +8006,47 @@ func (z *DoDSettleInvoiceOrderDetail) UnmarshalMsg(bts []byte) (o []byte, err er
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "OrderId":
+		case "oi":
 			z.OrderId, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "OrderId")
 				return
 			}
-		case "InternalId":
-			bts, err = z.InternalId.UnmarshalMsg(bts)
+		case "tk":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Token)
 			if err != nil {
-				err = msgp.WrapError(err, "InternalId")
+				err = msgp.WrapError(err, "Token")
 				return
 			}
-		case "ConnectionCount":
-			z.ConnectionCount, bts, err = msgp.ReadIntBytes(bts)
+		case "st":
+			z.StartTime, bts, err = msgp.ReadInt64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "ConnectionCount")
+				err = msgp.WrapError(err, "StartTime")
 				return
 			}
-		case "OrderAmount":
-			z.OrderAmount, bts, err = msgp.ReadFloat64Bytes(bts)
+		case "et":
+			z.EndTime, bts, err = msgp.ReadInt64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "OrderAmount")
+				err = msgp.WrapError(err, "EndTime")
 				return
 			}
-		case "Connections":
-			var zb0002 uint32
-			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		case "dt":
+			z.DueTime, bts, err = msgp.ReadInt64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Connections")
+				err = msgp.WrapError(err, "DueTime")
 				return
 			}
-			if cap(z.Connections) >= int(zb0002) {
-				z.Connections = (z.Connections)[:zb0002]
-			} else {
-				z.Connections = make([]*DoDSettleInvoiceConnDetail, zb0002)
+		case "f":
+			z.Flight, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
 			}
-			for za0001 := range z.Connections {
-				if msgp.IsNil(bts) {
-					bts, err = msgp.ReadNilBytes(bts)
-					if err != nil {
-						return
-					}
-					z.Connections[za0001] = nil
-				} else {
-					if z.Connections[za0001] == nil {
-						z.Connections[za0001] = new(DoDSettleInvoiceConnDetail)
-					}
-					bts, err = z.Connections[za0001].UnmarshalMsg(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Connections", za0001)
-						return
-					}
-				}
+		case "sp":
+			z.Split, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
 			}
 		default:
 			bts, err = msgp.Skip(bts)
@@ -5903,15 +8061,8 @@ func (z *DoDSettleInvoiceOrderDetail) UnmarshalMsg(bts []byte) (o []byte, err er
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z *DoDSettleInvoiceOrderDetail) Msgsize() (s int) {
-	s = 1 + 8 + msgp.StringPrefixSize + len(z.OrderId) + 11 + z.InternalId.Msgsize() + 16 + msgp.IntSize + 12 + msgp.Float64Size + 12 + msgp.ArrayHeaderSize
-	for za0001 := range z.Connections {
-		if z.Connections[za0001] == nil {
-			s += msgp.NilSize
-		} else {
-			s += z.Connections[za0001].Msgsize()
-		}
-	}
+func (z *DoDSettleIssueInvoiceParam) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.OrderId) + 3 + msgp.ExtensionPrefixSize + z.Token.Len() + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 2 + msgp.BoolSize + 3 + msgp.BoolSize
 	return
 }
 
@@ -6900,7 +9051,7 @@ func (z *DoDSettleOrderInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "TotalAmount":
-			z.TotalAmount, err = dc.ReadFloat64()
+			err = z.TotalAmount.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalAmount")
 				return
@@ -6923,6 +9074,18 @@ func (z *DoDSettleOrderInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "EndTime")
 				return
 			}
+		case "Flight":
+			z.Flight, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "Split":
+			z.Split, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
 		case "Buyer":
 			if dc.IsNil() {
 				err = dc.ReadNil()
@@ -7048,9 +9211,9 @@ func (z *DoDSettleOrderInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DoDSettleOrderInvoice) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 9
+	// map header, size 11
 	// write "InvoiceId"
-	err = en.Append(0x89, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
+	err = en.Append(0x8b, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
 	if err != nil {
 		return
 	}
@@ -7074,7 +9237,7 @@ func (z *DoDSettleOrderInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.TotalAmount)
+	err = z.TotalAmount.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "TotalAmount")
 		return
@@ -7109,6 +9272,26 @@ func (z *DoDSettleOrderInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "EndTime")
 		return
 	}
+	// write "Flight"
+	err = en.Append(0xa6, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Flight)
+	if err != nil {
+		err = msgp.WrapError(err, "Flight")
+		return
+	}
+	// write "Split"
+	err = en.Append(0xa5, 0x53, 0x70, 0x6c, 0x69, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Split)
+	if err != nil {
+		err = msgp.WrapError(err, "Split")
+		return
+	}
 	// write "Buyer"
 	err = en.Append(0xa5, 0x42, 0x75, 0x79, 0x65, 0x72)
 	if err != nil {
@@ -7198,9 +9381,9 @@ func (z *DoDSettleOrderInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *DoDSettleOrderInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 9
+	// map header, size 11
 	// string "InvoiceId"
-	o = append(o, 0x89, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
+	o = append(o, 0x8b, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
 	o, err = z.InvoiceId.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "InvoiceId")
@@ -7211,7 +9394,11 @@ func (z *DoDSettleOrderInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.AppendInt(o, z.TotalConnectionCount)
 	// string "TotalAmount"
 	o = append(o, 0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendFloat64(o, z.TotalAmount)
+	o, err = z.TotalAmount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "TotalAmount")
+		return
+	}
 	// string "Currency"
 	o = append(o, 0xa8, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79)
 	o = msgp.AppendString(o, z.Currency)
@@ -7221,6 +9408,12 @@ func (z *DoDSettleOrderInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "EndTime"
 	o = append(o, 0xa7, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65)
 	o = msgp.AppendInt64(o, z.EndTime)
+	// string "Flight"
+	o = append(o, 0xa6, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74)
+	o = msgp.AppendBool(o, z.Flight)
+	// string "Split"
+	o = append(o, 0xa5, 0x53, 0x70, 0x6c, 0x69, 0x74)
+	o = msgp.AppendBool(o, z.Split)
 	// string "Buyer"
 	o = append(o, 0xa5, 0x42, 0x75, 0x79, 0x65, 0x72)
 	if z.Buyer == nil {
@@ -7300,7 +9493,7 @@ func (z *DoDSettleOrderInvoice) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		case "TotalAmount":
-			z.TotalAmount, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.TotalAmount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalAmount")
 				return
@@ -7323,6 +9516,18 @@ func (z *DoDSettleOrderInvoice) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "EndTime")
 				return
 			}
+		case "Flight":
+			z.Flight, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "Split":
+			z.Split, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
 		case "Buyer":
 			if msgp.IsNil(bts) {
 				bts, err = msgp.ReadNilBytes(bts)
@@ -7446,7 +9651,7 @@ func (z *DoDSettleOrderInvoice) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleOrderInvoice) Msgsize() (s int) {
-	s = 1 + 10 + z.InvoiceId.Msgsize() + 21 + msgp.IntSize + 12 + msgp.Float64Size + 9 + msgp.StringPrefixSize + len(z.Currency) + 10 + msgp.Int64Size + 8 + msgp.Int64Size + 6
+	s = 1 + 10 + z.InvoiceId.Msgsize() + 21 + msgp.IntSize + 12 + z.TotalAmount.Msgsize() + 9 + msgp.StringPrefixSize + len(z.Currency) + 10 + msgp.Int64Size + 8 + msgp.Int64Size + 7 + msgp.BoolSize + 6 + msgp.BoolSize + 6
 	if z.Buyer == nil {
 		s += msgp.NilSize
 	} else {
@@ -8335,6 +10540,113 @@ func (z DoDSettlePAYGTimeSpanKey) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettlePayInvoiceParam) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			err = dc.ReadExtension(&z.InvoiceId)
+			if err != nil {
+				err = msgp.WrapError(err, "InvoiceId")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DoDSettlePayInvoiceParam) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "i"
+	err = en.Append(0x81, 0xa1, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.InvoiceId)
+	if err != nil {
+		err = msgp.WrapError(err, "InvoiceId")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DoDSettlePayInvoiceParam) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "i"
+	o = append(o, 0x81, 0xa1, 0x69)
+	o, err = msgp.AppendExtension(o, &z.InvoiceId)
+	if err != nil {
+		err = msgp.WrapError(err, "InvoiceId")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettlePayInvoiceParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.InvoiceId)
+			if err != nil {
+				err = msgp.WrapError(err, "InvoiceId")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DoDSettlePayInvoiceParam) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ExtensionPrefixSize + z.InvoiceId.Len()
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *DoDSettlePaymentType) DecodeMsg(dc *msgp.Reader) (err error) {
 	{
@@ -8697,7 +11009,7 @@ func (z *DoDSettleProductInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "TotalAmount":
-			z.TotalAmount, err = dc.ReadFloat64()
+			err = z.TotalAmount.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalAmount")
 				return
@@ -8720,6 +11032,18 @@ func (z *DoDSettleProductInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "EndTime")
 				return
 			}
+		case "Flight":
+			z.Flight, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "Split":
+			z.Split, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
 		case "Buyer":
 			if dc.IsNil() {
 				err = dc.ReadNil()
@@ -8845,9 +11169,9 @@ func (z *DoDSettleProductInvoice) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DoDSettleProductInvoice) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 8
+	// map header, size 10
 	// write "InvoiceId"
-	err = en.Append(0x88, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
+	err = en.Append(0x8a, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
 	if err != nil {
 		return
 	}
@@ -8861,7 +11185,7 @@ func (z *DoDSettleProductInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.TotalAmount)
+	err = z.TotalAmount.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "TotalAmount")
 		return
@@ -8896,6 +11220,26 @@ func (z *DoDSettleProductInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "EndTime")
 		return
 	}
+	// write "Flight"
+	err = en.Append(0xa6, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Flight)
+	if err != nil {
+		err = msgp.WrapError(err, "Flight")
+		return
+	}
+	// write "Split"
+	err = en.Append(0xa5, 0x53, 0x70, 0x6c, 0x69, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Split)
+	if err != nil {
+		err = msgp.WrapError(err, "Split")
+		return
+	}
 	// write "Buyer"
 	err = en.Append(0xa5, 0x42, 0x75, 0x79, 0x65, 0x72)
 	if err != nil {
@@ -8985,9 +11329,9 @@ func (z *DoDSettleProductInvoice) EncodeMsg(en *msgp.Writer) (err error) {
 // MarshalMsg implements msgp.Marshaler
 func (z *DoDSettleProductInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 8
+	// map header, size 10
 	// string "InvoiceId"
-	o = append(o, 0x88, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
+	o = append(o, 0x8a, 0xa9, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64)
 	o, err = z.InvoiceId.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "InvoiceId")
@@ -8995,7 +11339,11 @@ func (z *DoDSettleProductInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	}
 	// string "TotalAmount"
 	o = append(o, 0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendFloat64(o, z.TotalAmount)
+	o, err = z.TotalAmount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "TotalAmount")
+		return
+	}
 	// string "Currency"
 	o = append(o, 0xa8, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79)
 	o = msgp.AppendString(o, z.Currency)
@@ -9005,6 +11353,12 @@ func (z *DoDSettleProductInvoice) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "EndTime"
 	o = append(o, 0xa7, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65)
 	o = msgp.AppendInt64(o, z.EndTime)
+	// string "Flight"
+	o = append(o, 0xa6, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74)
+	o = msgp.AppendBool(o, z.Flight)
+	// string "Split"
+	o = append(o, 0xa5, 0x53, 0x70, 0x6c, 0x69, 0x74)
+	o = msgp.AppendBool(o, z.Split)
 	// string "Buyer"
 	o = append(o, 0xa5, 0x42, 0x75, 0x79, 0x65, 0x72)
 	if z.Buyer == nil {
@@ -9078,7 +11432,7 @@ func (z *DoDSettleProductInvoice) UnmarshalMsg(bts []byte) (o []byte, err error)
 				return
 			}
 		case "TotalAmount":
-			z.TotalAmount, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.TotalAmount.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalAmount")
 				return
@@ -9101,6 +11455,18 @@ func (z *DoDSettleProductInvoice) UnmarshalMsg(bts []byte) (o []byte, err error)
 				err = msgp.WrapError(err, "EndTime")
 				return
 			}
+		case "Flight":
+			z.Flight, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Flight")
+				return
+			}
+		case "Split":
+			z.Split, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Split")
+				return
+			}
 		case "Buyer":
 			if msgp.IsNil(bts) {
 				bts, err = msgp.ReadNilBytes(bts)
@@ -9224,7 +11590,7 @@ func (z *DoDSettleProductInvoice) UnmarshalMsg(bts []byte) (o []byte, err error)
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DoDSettleProductInvoice) Msgsize() (s int) {
-	s = 1 + 10 + z.InvoiceId.Msgsize() + 12 + msgp.Float64Size + 9 + msgp.StringPrefixSize + len(z.Currency) + 10 + msgp.Int64Size + 8 + msgp.Int64Size + 6
+	s = 1 + 10 + z.InvoiceId.Msgsize() + 12 + z.TotalAmount.Msgsize() + 9 + msgp.StringPrefixSize + len(z.Currency) + 10 + msgp.Int64Size + 8 + msgp.Int64Size + 7 + msgp.BoolSize + 6 + msgp.BoolSize + 6
 	if z.Buyer == nil {
 		s += msgp.NilSize
 	} else {
@@ -9245,6 +11611,142 @@ func (z *DoDSettleProductInvoice) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *DoDSettleRefundInvoiceParam) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			err = dc.ReadExtension(&z.InvoiceId)
+			if err != nil {
+				err = msgp.WrapError(err, "InvoiceId")
+				return
+			}
+		case "p":
+			err = dc.ReadExtension(&z.PaymentId)
+			if err != nil {
+				err = msgp.WrapError(err, "PaymentId")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DoDSettleRefundInvoiceParam) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "i"
+	err = en.Append(0x82, 0xa1, 0x69)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.InvoiceId)
+	if err != nil {
+		err = msgp.WrapError(err, "InvoiceId")
+		return
+	}
+	// write "p"
+	err = en.Append(0xa1, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.PaymentId)
+	if err != nil {
+		err = msgp.WrapError(err, "PaymentId")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DoDSettleRefundInvoiceParam) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "i"
+	o = append(o, 0x82, 0xa1, 0x69)
+	o, err = msgp.AppendExtension(o, &z.InvoiceId)
+	if err != nil {
+		err = msgp.WrapError(err, "InvoiceId")
+		return
+	}
+	// string "p"
+	o = append(o, 0xa1, 0x70)
+	o, err = msgp.AppendExtension(o, &z.PaymentId)
+	if err != nil {
+		err = msgp.WrapError(err, "PaymentId")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DoDSettleRefundInvoiceParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "i":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.InvoiceId)
+			if err != nil {
+				err = msgp.WrapError(err, "InvoiceId")
+				return
+			}
+		case "p":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.PaymentId)
+			if err != nil {
+				err = msgp.WrapError(err, "PaymentId")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DoDSettleRefundInvoiceParam) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ExtensionPrefixSize + z.InvoiceId.Len() + 2 + msgp.ExtensionPrefixSize + z.PaymentId.Len()
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *DoDSettleResponseAction) DecodeMsg(dc *msgp.Reader) (err error) {
 	{
@@ -3,6 +3,7 @@ package abi
 import (
 	"github.com/qlcchain/go-qlc/common/statedb"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	cfg "github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/vm/abi"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
@@ -36,6 +37,8 @@ const (
 	MethodNameKYCStatusUpdate       = "KYCStatusUpdate"
 	MethodNameKYCTradeAddressUpdate = "KYCTradeAddressUpdate"
 	MethodNameKYCOperatorUpdate     = "KYCOperatorUpdate"
+
+	KYCStatusApproved = "KYC_STATUS_APPROVED"
 )
 
 var (
@@ -239,6 +242,30 @@ func KYCGetStatusByChainAddress(ctx *vmstore.VMContext, address types.Address) (
 	return nil, nil
 }
 
+// KYCIsApproved returns true if kyc of the chain address is approved, ctx can be of any contract
+func KYCIsApproved(ctx *vmstore.VMContext, address types.Address) bool {
+	gsdb := ctx.PovGlobalState()
+	if gsdb == nil {
+		return false
+	}
+	csdb, err := gsdb.LookupContractStateDB(contractaddress.KYCAddress)
+	if err != nil {
+		return false
+	}
+
+	trieKey := statedb.PovCreateContractLocalStateKey(KYCDataStatus, address.Bytes())
+	data, err := csdb.GetValue(trieKey)
+	if err != nil || len(data) == 0 {
+		return false
+	}
+
+	s := new(KYCStatus)
+	if _, err := s.UnmarshalMsg(data); err != nil {
+		return false
+	}
+	return s.Valid && s.Status == KYCStatusApproved
+}
+
 func KYCGetStatusByTradeAddress(ctx *vmstore.VMContext, address string) (*KYCStatus, error) {
 	csdb, err := ctx.PoVContractState()
 	if err != nil {
@@ -383,6 +383,36 @@ func TestKYCGetAllStatus(t *testing.T) {
 	}
 }
 
+func TestKYCIsApproved(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	gsdb := statedb.NewPovGlobalStateDB(l.DBStore(), types.ZeroHash)
+	csdb, err := gsdb.LookupContractStateDB(contractaddress.KYCAddress)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// any contract context can check kyc status
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	chainAddress := mock.Address()
+	if KYCIsApproved(ctx, chainAddress) {
+		t.Fatal()
+	}
+
+	ks := &KYCStatus{ChainAddress: chainAddress, Status: KYCStatusApproved, Valid: true}
+	updateKYCStatus(t, l, ks, csdb, gsdb)
+	if !KYCIsApproved(ctx, chainAddress) {
+		t.Fatal()
+	}
+
+	ks.Status = "KYC_STATUS_PENDING"
+	updateKYCStatus(t, l, ks, csdb, gsdb)
+	if KYCIsApproved(ctx, chainAddress) {
+		t.Fatal()
+	}
+}
+
 func TestKYCGetTradeAddress(t *testing.T) {
 	teardownTestCase, l := setupLedgerForTestCase(t)
 	defer teardownTestCase(t)
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/vm/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+const (
+	JsonMultiSig = `[
+		{"type":"function","name":"MultiSigCreate","inputs":[
+			{"name":"keys","type":"address[]"},
+			{"name":"threshold","type":"uint8"}
+		]},
+		{"type":"variable","name":"MultiSigInfo","inputs":[
+			{"name":"keys","type":"address[]"},
+			{"name":"threshold","type":"uint8"},
+			{"name":"creator","type":"address"}
+		]}
+	]`
+
+	MethodNameMultiSigCreate = "MultiSigCreate"
+	VariableNameMultiSigInfo = "MultiSigInfo"
+
+	MultiSigMinKeys = 2
+	MultiSigMaxKeys = 16
+)
+
+var (
+	MultiSigABI, _ = abi.JSONToABIContract(strings.NewReader(JsonMultiSig))
+)
+
+type MultiSigParam struct {
+	Keys      []types.Address `json:"keys"`
+	Threshold uint8           `json:"threshold"`
+}
+
+// MultiSigInfo is the key set and threshold of a registered multisig account
+type MultiSigInfo struct {
+	Account   types.Address   `json:"account"`
+	Keys      []types.Address `json:"keys"`
+	Threshold uint8           `json:"threshold"`
+	Creator   types.Address   `json:"creator"`
+}
+
+func (p *MultiSigParam) Verify() error {
+	if len(p.Keys) < MultiSigMinKeys || len(p.Keys) > MultiSigMaxKeys {
+		return fmt.Errorf("key count should be between %d and %d", MultiSigMinKeys, MultiSigMaxKeys)
+	}
+	if p.Threshold == 0 || int(p.Threshold) > len(p.Keys) {
+		return fmt.Errorf("invalid threshold %d of %d keys", p.Threshold, len(p.Keys))
+	}
+	keys := make(map[types.Address]bool)
+	for _, k := range p.Keys {
+		if k.IsZero() {
+			return errors.New("invalid zero key")
+		}
+		if keys[k] {
+			return fmt.Errorf("duplicate key %s", k)
+		}
+		keys[k] = true
+	}
+	return nil
+}
+
+// SortedKeys returns keys in canonical order, so the same key set always generates the same account
+func (p *MultiSigParam) SortedKeys() []types.Address {
+	keys := make([]types.Address, len(p.Keys))
+	copy(keys, p.Keys)
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i][:], keys[j][:]) < 0
+	})
+	return keys
+}
+
+// Account returns the multisig account of key set and threshold, nobody holds its private key
+func (p *MultiSigParam) Account() (types.Address, error) {
+	if err := p.Verify(); err != nil {
+		return types.ZeroAddress, err
+	}
+	data := [][]byte{[]byte("multisig")}
+	for _, k := range p.SortedKeys() {
+		data = append(data, k[:])
+	}
+	data = append(data, []byte{p.Threshold})
+	hash, err := types.HashBytes(data...)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	return types.BytesToAddress(hash[:])
+}
+
+func GetMultiSigInfo(ctx *vmstore.VMContext, account types.Address) (*MultiSigInfo, error) {
+	val, err := ctx.GetStorage(contractaddress.MultiSigAddress[:], account[:])
+	if err != nil {
+		return nil, err
+	}
+	info := new(MultiSigInfo)
+	if err := MultiSigABI.UnpackVariable(info, VariableNameMultiSigInfo, val); err != nil {
+		return nil, err
+	}
+	info.Account = account
+	return info, nil
+}
+
+func IsMultiSigAccount(ctx *vmstore.VMContext, account types.Address) bool {
+	_, err := GetMultiSigInfo(ctx, account)
+	return err == nil
+}
+
+func (m *MultiSigInfo) HasKey(key types.Address) bool {
+	for _, k := range m.Keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifySignatures checks there are enough valid signatures of distinct keys for the hash
+func (m *MultiSigInfo) VerifySignatures(hash types.Hash, items []*types.MultiSigItem) error {
+	signed := make(map[types.Address]bool)
+	for _, item := range items {
+		if !m.HasKey(item.Signer) {
+			return fmt.Errorf("%s is not a key of multisig account %s", item.Signer, m.Account)
+		}
+		if signed[item.Signer] {
+			return fmt.Errorf("duplicate signature of %s", item.Signer)
+		}
+		if !item.Signer.Verify(hash[:], item.Signature[:]) {
+			return fmt.Errorf("invalid signature of %s", item.Signer)
+		}
+		signed[item.Signer] = true
+	}
+	if len(signed) < int(m.Threshold) {
+		return fmt.Errorf("not enough signatures, exp: %d, act: %d", m.Threshold, len(signed))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/mock"
+)
+
+func TestMultiSigParam_Verify(t *testing.T) {
+	a1 := mock.Address()
+	a2 := mock.Address()
+	a3 := mock.Address()
+
+	tests := []struct {
+		name    string
+		param   *MultiSigParam
+		wantErr bool
+	}{
+		{"OK", &MultiSigParam{Keys: []types.Address{a1, a2, a3}, Threshold: 2}, false},
+		{"one key", &MultiSigParam{Keys: []types.Address{a1}, Threshold: 1}, true},
+		{"zero threshold", &MultiSigParam{Keys: []types.Address{a1, a2}, Threshold: 0}, true},
+		{"big threshold", &MultiSigParam{Keys: []types.Address{a1, a2}, Threshold: 3}, true},
+		{"duplicate", &MultiSigParam{Keys: []types.Address{a1, a1}, Threshold: 1}, true},
+		{"zero key", &MultiSigParam{Keys: []types.Address{a1, types.ZeroAddress}, Threshold: 1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.param.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMultiSigParam_Account(t *testing.T) {
+	a1 := mock.Address()
+	a2 := mock.Address()
+	a3 := mock.Address()
+
+	acc1, err := (&MultiSigParam{Keys: []types.Address{a1, a2, a3}, Threshold: 2}).Account()
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc2, err := (&MultiSigParam{Keys: []types.Address{a3, a1, a2}, Threshold: 2}).Account()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acc1 != acc2 {
+		t.Fatalf("account should not depend on key order, %s, %s", acc1, acc2)
+	}
+	acc3, _ := (&MultiSigParam{Keys: []types.Address{a1, a2, a3}, Threshold: 3}).Account()
+	if acc1 == acc3 {
+		t.Fatal("account should depend on threshold")
+	}
+	if _, err := (&MultiSigParam{Keys: []types.Address{a1}, Threshold: 1}).Account(); err == nil {
+		t.Fatal("invalid param should be rejected")
+	}
+}
+
+func TestMultiSigInfo_VerifySignatures(t *testing.T) {
+	k1 := mock.Account()
+	k2 := mock.Account()
+	k3 := mock.Account()
+	other := mock.Account()
+	info := &MultiSigInfo{
+		Keys:      []types.Address{k1.Address(), k2.Address(), k3.Address()},
+		Threshold: 2,
+	}
+	hash := mock.Hash()
+	item := func(a *types.Account) *types.MultiSigItem {
+		return &types.MultiSigItem{Signer: a.Address(), Signature: a.Sign(hash)}
+	}
+
+	if err := info.VerifySignatures(hash, []*types.MultiSigItem{item(k1), item(k3)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := info.VerifySignatures(hash, []*types.MultiSigItem{item(k1)}); err == nil {
+		t.Fatal("signatures less than threshold should be rejected")
+	}
+	if err := info.VerifySignatures(hash, []*types.MultiSigItem{item(k1), item(k1)}); err == nil {
+		t.Fatal("duplicate signatures should be rejected")
+	}
+	if err := info.VerifySignatures(hash, []*types.MultiSigItem{item(k1), item(other)}); err == nil {
+		t.Fatal("signature of other account should be rejected")
+	}
+	bad := item(k2)
+	bad.Signature = k2.Sign(mock.Hash())
+	if err := info.VerifySignatures(hash, []*types.MultiSigItem{item(k1), bad}); err == nil {
+		t.Fatal("invalid signature should be rejected")
+	}
+}
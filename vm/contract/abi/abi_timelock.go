@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/vm/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+const (
+	JsonTimeLock = `[
+		{"type":"function","name":"TimeLock","inputs":[
+			{"name":"receiver","type":"address"},
+			{"name":"releaseHeight","type":"uint64"},
+			{"name":"releaseTime","type":"int64"},
+			{"name":"refundTime","type":"int64"},
+			{"name":"cancelTime","type":"int64"}
+		]},
+		{"type":"function","name":"TimeLockRelease","inputs":[{"name":"lockId","type":"hash"}]},
+		{"type":"function","name":"TimeLockRefund","inputs":[{"name":"lockId","type":"hash"}]},
+		{"type":"function","name":"TimeLockCancel","inputs":[{"name":"lockId","type":"hash"}]},
+		{"type":"variable","name":"TimeLockInfo","inputs":[
+			{"name":"sender","type":"address"},
+			{"name":"receiver","type":"address"},
+			{"name":"token","type":"hash"},
+			{"name":"amount","type":"uint256"},
+			{"name":"releaseHeight","type":"uint64"},
+			{"name":"releaseTime","type":"int64"},
+			{"name":"refundTime","type":"int64"},
+			{"name":"cancelTime","type":"int64"},
+			{"name":"povHeight","type":"uint64"}
+		]},
+		{"type":"variable","name":"TimeLockSettle","inputs":[
+			{"name":"status","type":"uint8"},
+			{"name":"previous","type":"hash"}
+		]}
+	]`
+
+	MethodNameTimeLock         = "TimeLock"
+	MethodNameTimeLockRelease  = "TimeLockRelease"
+	MethodNameTimeLockRefund   = "TimeLockRefund"
+	MethodNameTimeLockCancel   = "TimeLockCancel"
+	VariableNameTimeLockInfo   = "TimeLockInfo"
+	VariableNameTimeLockSettle = "TimeLockSettle"
+)
+
+// lock info and settle record are saved separately, so rolling back a settle block only removes its record
+const (
+	TimeLockDBTableLock byte = iota + 1
+	TimeLockDBTableSettle
+)
+
+type TimeLockStatus uint8
+
+const (
+	TimeLockStatusLocked TimeLockStatus = iota
+	TimeLockStatusReleased
+	TimeLockStatusRefunded
+	TimeLockStatusCanceled
+)
+
+func (s TimeLockStatus) String() string {
+	switch s {
+	case TimeLockStatusLocked:
+		return "locked"
+	case TimeLockStatusReleased:
+		return "released"
+	case TimeLockStatusRefunded:
+		return "refunded"
+	case TimeLockStatusCanceled:
+		return "canceled"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	TimeLockABI, _ = abi.JSONToABIContract(strings.NewReader(JsonTimeLock))
+)
+
+// TimeLockParam is the condition of a lock, times are unix seconds compared with pov block time, zero means not set
+type TimeLockParam struct {
+	Receiver      types.Address `json:"receiver"`
+	ReleaseHeight uint64        `json:"releaseHeight"`
+	ReleaseTime   int64         `json:"releaseTime"`
+	RefundTime    int64         `json:"refundTime"`
+	CancelTime    int64         `json:"cancelTime"`
+}
+
+type TimeLockSettleParam struct {
+	LockId types.Hash `json:"lockId"`
+}
+
+// TimeLockInfo is the funds held by contract, lock id is the previous hash of the lock block,
+// because the block hash depends on the contract data, pov height is the one cited by the lock block
+type TimeLockInfo struct {
+	LockId        types.Hash     `json:"lockId"`
+	Sender        types.Address  `json:"sender"`
+	Receiver      types.Address  `json:"receiver"`
+	Token         types.Hash     `json:"token"`
+	Amount        *big.Int       `json:"amount"`
+	ReleaseHeight uint64         `json:"releaseHeight"`
+	ReleaseTime   int64          `json:"releaseTime"`
+	RefundTime    int64          `json:"refundTime"`
+	CancelTime    int64          `json:"cancelTime"`
+	PovHeight     uint64         `json:"povHeight"`
+	Status        TimeLockStatus `json:"status"`
+}
+
+type TimeLockSettle struct {
+	Status   uint8      `json:"status"`
+	Previous types.Hash `json:"previous"`
+}
+
+func (p *TimeLockParam) Verify() error {
+	if p.Receiver.IsZero() {
+		return errors.New("invalid receiver")
+	}
+	if p.ReleaseHeight == 0 && p.ReleaseTime <= 0 {
+		return errors.New("release height or release time should be set")
+	}
+	if p.ReleaseTime < 0 || p.RefundTime < 0 || p.CancelTime < 0 {
+		return errors.New("invalid negative time")
+	}
+	if p.RefundTime > 0 && p.RefundTime <= p.ReleaseTime {
+		return errors.New("refund time should be after release time")
+	}
+	if p.CancelTime > 0 && p.ReleaseTime > 0 && p.CancelTime > p.ReleaseTime {
+		return errors.New("cancel time should not be after release time")
+	}
+	return nil
+}
+
+// Releasable returns true if the lock can be released at pov height and time
+func (l *TimeLockInfo) Releasable(height uint64, time int64) bool {
+	return l.Status == TimeLockStatusLocked && height >= l.ReleaseHeight && time >= l.ReleaseTime
+}
+
+// Refundable returns true if the sender can take back the lock at pov time
+func (l *TimeLockInfo) Refundable(time int64) bool {
+	return l.Status == TimeLockStatusLocked && l.RefundTime > 0 && time >= l.RefundTime
+}
+
+// Cancelable returns true if the sender can still cancel the lock at pov time
+func (l *TimeLockInfo) Cancelable(time int64) bool {
+	return l.Status == TimeLockStatusLocked && l.CancelTime > 0 && time < l.CancelTime
+}
+
+func GetTimeLockKey(lockId types.Hash) []byte {
+	return append([]byte{TimeLockDBTableLock}, lockId[:]...)
+}
+
+func GetTimeLockSettleKey(lockId types.Hash) []byte {
+	return append([]byte{TimeLockDBTableSettle}, lockId[:]...)
+}
+
+func parseTimeLockInfo(lockId types.Hash, data []byte) (*TimeLockInfo, error) {
+	info := new(TimeLockInfo)
+	if err := TimeLockABI.UnpackVariable(info, VariableNameTimeLockInfo, data); err != nil {
+		return nil, err
+	}
+	info.LockId = lockId
+	return info, nil
+}
+
+// GetTimeLockSettle returns the settle record of lock, ErrStorageNotFound if the lock is not settled
+func GetTimeLockSettle(ctx *vmstore.VMContext, lockId types.Hash) (*TimeLockSettle, error) {
+	data, err := ctx.GetStorage(contractaddress.TimeLockAddress[:], GetTimeLockSettleKey(lockId))
+	if err != nil {
+		return nil, err
+	}
+	settle := new(TimeLockSettle)
+	if err := TimeLockABI.UnpackVariable(settle, VariableNameTimeLockSettle, data); err != nil {
+		return nil, err
+	}
+	return settle, nil
+}
+
+// GetTimeLockInfo returns the lock and its current status
+func GetTimeLockInfo(ctx *vmstore.VMContext, lockId types.Hash) (*TimeLockInfo, error) {
+	data, err := ctx.GetStorage(contractaddress.TimeLockAddress[:], GetTimeLockKey(lockId))
+	if err != nil {
+		return nil, err
+	}
+	info, err := parseTimeLockInfo(lockId, data)
+	if err != nil {
+		return nil, err
+	}
+	if settle, err := GetTimeLockSettle(ctx, lockId); err == nil {
+		info.Status = TimeLockStatus(settle.Status)
+	} else if err != vmstore.ErrStorageNotFound {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ListTimeLocksByAddress returns all locks sent or received by address
+func ListTimeLocksByAddress(ctx *vmstore.VMContext, address types.Address) ([]*TimeLockInfo, error) {
+	ids := make([]types.Hash, 0)
+	prefix := append(contractaddress.TimeLockAddress[:], TimeLockDBTableLock)
+	err := ctx.Iterator(prefix, func(key []byte, value []byte) error {
+		lockId, err := types.BytesToHash(key[len(prefix):])
+		if err != nil {
+			return err
+		}
+		info, err := parseTimeLockInfo(lockId, value)
+		if err != nil {
+			return err
+		}
+		if info.Sender == address || info.Receiver == address {
+			ids = append(ids, lockId)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locks := make([]*TimeLockInfo, 0, len(ids))
+	for _, id := range ids {
+		info, err := GetTimeLockInfo(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, info)
+	}
+	return locks, nil
+}
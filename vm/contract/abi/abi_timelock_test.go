@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestTimeLockParam_Verify(t *testing.T) {
+	receiver := mock.Address()
+
+	tests := []struct {
+		name    string
+		param   *TimeLockParam
+		wantErr bool
+	}{
+		{"height", &TimeLockParam{Receiver: receiver, ReleaseHeight: 10}, false},
+		{"time", &TimeLockParam{Receiver: receiver, ReleaseTime: 100, RefundTime: 200, CancelTime: 50}, false},
+		{"zero receiver", &TimeLockParam{ReleaseHeight: 10}, true},
+		{"no release", &TimeLockParam{Receiver: receiver, RefundTime: 200}, true},
+		{"negative", &TimeLockParam{Receiver: receiver, ReleaseHeight: 10, CancelTime: -1}, true},
+		{"early refund", &TimeLockParam{Receiver: receiver, ReleaseTime: 100, RefundTime: 100}, true},
+		{"late cancel", &TimeLockParam{Receiver: receiver, ReleaseTime: 100, CancelTime: 101}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.param.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTimeLockInfo_Condition(t *testing.T) {
+	lock := &TimeLockInfo{ReleaseHeight: 10, ReleaseTime: 100, RefundTime: 200, CancelTime: 50}
+
+	if lock.Releasable(9, 100) || lock.Releasable(10, 99) || !lock.Releasable(10, 100) {
+		t.Fatal("releasable")
+	}
+	if lock.Refundable(199) || !lock.Refundable(200) {
+		t.Fatal("refundable")
+	}
+	if !lock.Cancelable(49) || lock.Cancelable(50) {
+		t.Fatal("cancelable")
+	}
+
+	lock.Status = TimeLockStatusReleased
+	if lock.Releasable(10, 100) || lock.Refundable(200) || lock.Cancelable(49) {
+		t.Fatal("settled lock should not be settled again")
+	}
+	if lock.Status.String() != "released" || TimeLockStatus(10).String() != "unknown" {
+		t.Fatal(lock.Status)
+	}
+
+	if (&TimeLockInfo{ReleaseHeight: 10}).Refundable(200) || (&TimeLockInfo{ReleaseHeight: 10}).Cancelable(0) {
+		t.Fatal("refund and cancel are optional")
+	}
+}
+
+func TestTimeLockStorage(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.TimeLockAddress)
+	sender := mock.Address()
+	receiver := mock.Address()
+
+	setLock := func(sender, receiver types.Address) types.Hash {
+		id := mock.Hash()
+		data, err := TimeLockABI.PackVariable(VariableNameTimeLockInfo, sender, receiver, mock.Hash(), big.NewInt(100),
+			uint64(10), int64(0), int64(0), int64(0), uint64(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ctx.SetStorage(contractaddress.TimeLockAddress[:], GetTimeLockKey(id), data); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+	id1 := setLock(sender, receiver)
+	id2 := setLock(receiver, sender)
+	setLock(mock.Address(), mock.Address())
+
+	data, err := TimeLockABI.PackVariable(VariableNameTimeLockSettle, uint8(TimeLockStatusReleased), mock.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.SetStorage(contractaddress.TimeLockAddress[:], GetTimeLockSettleKey(id2), data); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = vmstore.NewVMContext(l, &contractaddress.TimeLockAddress)
+	if lock, err := GetTimeLockInfo(ctx, id1); err != nil || lock.Status != TimeLockStatusLocked ||
+		lock.Sender != sender || lock.Amount.Int64() != 100 {
+		t.Fatal(lock, err)
+	}
+	if lock, err := GetTimeLockInfo(ctx, id2); err != nil || lock.Status != TimeLockStatusReleased {
+		t.Fatal(lock, err)
+	}
+	if _, err := GetTimeLockSettle(ctx, id1); err != vmstore.ErrStorageNotFound {
+		t.Fatal(err)
+	}
+	if _, err := GetTimeLockInfo(ctx, mock.Hash()); err == nil {
+		t.Fatal("lock should not be found")
+	}
+
+	locks, err := ListTimeLocksByAddress(ctx, sender)
+	if err != nil || len(locks) != 2 {
+		t.Fatal(locks, err)
+	}
+	if locks, err := ListTimeLocksByAddress(ctx, mock.Address()); err != nil || len(locks) != 0 {
+		t.Fatal(locks, err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 	"sort"
 	"strings"
 
@@ -119,6 +120,49 @@ const (
     "inputs": [
         { "name": "asset", "type": "string" }
     ]
+  },{
+    "type": "function",
+    "name": "CommitCDRBatch",
+    "inputs": [
+        { "name": "contractAddress", "type": "address" },
+        { "name": "seq", "type": "uint64" },
+        { "name": "root", "type": "hash" },
+        { "name": "startDate", "type": "int64" },
+        { "name": "endDate", "type": "int64" }
+    ]
+  },{
+    "type": "event",
+    "name": "ContractCreated",
+    "inputs": [
+        { "name": "contractAddress", "type": "address", "indexed": true },
+        { "name": "partyA", "type": "address", "indexed": true },
+        { "name": "partyB", "type": "address", "indexed": true }
+    ]
+  },{
+    "type": "event",
+    "name": "ContractSigned",
+    "inputs": [
+        { "name": "contractAddress", "type": "address", "indexed": true },
+        { "name": "partyB", "type": "address", "indexed": true },
+        { "name": "confirmDate", "type": "int64" }
+    ]
+  },{
+    "type": "event",
+    "name": "ContractTerminated",
+    "inputs": [
+        { "name": "contractAddress", "type": "address", "indexed": true },
+        { "name": "terminator", "type": "address", "indexed": true },
+        { "name": "request", "type": "bool" }
+    ]
+  },{
+    "type": "event",
+    "name": "CDRBatchCommitted",
+    "inputs": [
+        { "name": "contractAddress", "type": "address", "indexed": true },
+        { "name": "party", "type": "address", "indexed": true },
+        { "name": "seq", "type": "uint64" },
+        { "name": "root", "type": "hash" }
+    ]
   }
 ]
 `
@@ -133,12 +177,19 @@ const (
 	MethodNameRemoveNextStop    = "RemoveNextStop"
 	MethodNameUpdateNextStop    = "UpdateNextStop"
 	MethodNameRegisterAsset     = "RegisterAsset"
+	MethodNameCommitCDRBatch    = "CommitCDRBatch"
+
+	EventNameContractCreated    = "ContractCreated"
+	EventNameContractSigned     = "ContractSigned"
+	EventNameContractTerminated = "ContractTerminated"
+	EventNameCDRBatchCommitted  = "CDRBatchCommitted"
 )
 
 const (
 	ContractFlag byte = iota
 	AssetFlag
 	AddressMappingFlag
+	CDRBatchFlag
 )
 
 var (
@@ -149,6 +200,7 @@ var (
 	contractPrefix   = append(contractaddress.SettlementAddress[:], ContractFlag)
 	assetKeyPrefix   = append(contractaddress.SettlementAddress[:], AssetFlag)
 	mappingPrefix    = append(contractaddress.SettlementAddress[:], AddressMappingFlag)
+	cdrBatchPrefix   = append(contractaddress.SettlementAddress[:], CDRBatchFlag)
 )
 
 func SaveContractParam(ctx *vmstore.VMContext, addr *types.Address, bts []byte) error {
@@ -166,6 +218,22 @@ func GetContractParam(ctx *vmstore.VMContext, addr *types.Address) ([]byte, erro
 	}
 }
 
+// EmitEvent packs the settlement event and appends it to the logs of the context,
+// indexed address arguments are used as topics
+func EmitEvent(ctx *vmstore.VMContext, name string, indexed []types.Address, args ...interface{}) error {
+	topics := make([]types.Hash, len(indexed))
+	for i, addr := range indexed {
+		topics[i] = types.Hash(addr)
+	}
+	vmLog, err := SettlementABI.PackEvent(name, topics, args...)
+	if err != nil {
+		return err
+	}
+	vmLog.Address = contractaddress.SettlementAddress
+	ctx.AppendLog(vmLog)
+	return nil
+}
+
 // GetCDRStatus
 // @param addr settlement contract address
 // @param CDR data hash
@@ -486,15 +554,16 @@ func generateSummaryReport(store ledger.Store, addr *types.Address, fn func(stat
 		isMatching := status.IsMatching(addrs)
 		//party A
 		if s1, b1, err := status.State(&partyA, fn); err == nil {
-			result.UpdateState(s1, "partyA", isMatching, b1)
+			result.UpdateState(s1, "partyA", status.Kind(), isMatching, b1, status.UnitsOf(&partyA))
 		}
 
 		//party B
 		if s2, b2, err := status.State(&partyB, fn); err == nil {
-			result.UpdateState(s2, "partyB", isMatching, b2)
+			result.UpdateState(s2, "partyB", status.Kind(), isMatching, b2, status.UnitsOf(&partyB))
 		}
 	}
 
+	result.SLAs = summarySLAs(store, c, records, fn, start, end)
 	result.DoCalculate()
 
 	return result, nil
@@ -579,16 +648,26 @@ func generateInvoices(store ledger.Store, addr *types.Address, fn func(*CDRStatu
 		logger.Error(err)
 	}
 
-	cache := make(map[string]int)
-	if cdrs, err := GetCDRStatusByDate(store, &contractAddr, start, end); err == nil {
+	type billable struct {
+		sender string
+		kind   CDRKind
+	}
+	type counter struct {
+		records uint64
+		units   *big.Rat
+	}
+	cache := make(map[billable]*counter)
+	cdrs, err := GetCDRStatusByDate(store, &contractAddr, start, end)
+	if err == nil {
 		for _, cdr := range cdrs {
 			if cdr.Status == SettlementStatusSuccess {
 				if sender, err := fn(cdr); err == nil {
-					if _, ok := cache[sender]; ok {
-						cache[sender]++
-					} else {
-						cache[sender] = 1
+					k := billable{sender: sender, kind: cdr.Kind()}
+					if _, ok := cache[k]; !ok {
+						cache[k] = &counter{units: new(big.Rat)}
 					}
+					cache[k].records++
+					cache[k].units.Add(cache[k].units, cdr.Units())
 				}
 			}
 		}
@@ -596,28 +675,47 @@ func generateInvoices(store ledger.Store, addr *types.Address, fn func(*CDRStatu
 		logger.Error(err)
 	}
 
-	// TODO: how to match service???
-	service := c.Services[0]
+	// SLA of service is measured by records of the same kind
+	metrics := make(map[CDRKind]*slaMetric)
+	assets := make(map[CDRKind]*Asset)
 	for k, v := range cache {
-		if v > 0 {
-			invoice := &InvoiceRecord{
-				Address:                  contractAddr,
-				StartDate:                c.StartDate,
-				EndDate:                  c.EndDate,
-				Customer:                 k,
-				CustomerSr:               "",
-				Country:                  "",
-				Operator:                 c.PartyB.Name,
-				ServiceId:                service.ServiceId,
-				MCC:                      service.Mcc,
-				MNC:                      service.Mnc,
-				Currency:                 service.Currency,
-				UnitPrice:                service.UnitPrice,
-				SumOfBillableSMSCustomer: uint64(v),
-				SumOfTOTPrice:            service.UnitPrice * float64(v),
+		service, err := c.ServiceByKind(k.kind)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+		if _, ok := assets[k.kind]; !ok {
+			asset, metric := serviceSLAMetric(store, c, service, cdrs, start, end)
+			assets[k.kind] = asset
+			if metric != nil {
+				metrics[k.kind] = metric
 			}
-			result = append(result, invoice)
 		}
+		units, _ := v.units.Float64()
+		invoice := &InvoiceRecord{
+			Address:                  contractAddr,
+			StartDate:                c.StartDate,
+			EndDate:                  c.EndDate,
+			Customer:                 k.sender,
+			CustomerSr:               "",
+			Country:                  "",
+			Operator:                 c.PartyB.Name,
+			ServiceId:                service.ServiceId,
+			MCC:                      service.Mcc,
+			MNC:                      service.Mnc,
+			Currency:                 service.Currency,
+			UnitPrice:                service.Price(),
+			Kind:                     k.kind,
+			SumOfBillableSMSCustomer: v.records,
+			SumOfBillableUnits:       units,
+			SumOfTOTPrice:            service.Price().MulRat(v.units, types.CurrencyPrecision(service.Currency)),
+			Compensation:             types.ZeroMoney(service.Currency),
+		}
+		if metric, ok := metrics[k.kind]; ok {
+			invoice.SLAResults, invoice.Compensation = applySLAs(assets[k.kind].SLAs, metric, invoice.SumOfTOTPrice)
+		}
+		invoice.SumOfNetPrice = invoice.SumOfTOTPrice.Sub(invoice.Compensation)
+		result = append(result, invoice)
 	}
 
 	if len(result) > 0 {
@@ -717,17 +815,17 @@ func GetMultiPartySummaryReport(store ledger.Store, firstAddr, secondAddr *types
 		isMatching := record.IsMatching(addrs)
 		// party A
 		if s1, b1, err := record.State(&partyA, customerFn); err == nil {
-			result.UpdateState(s1, "partyA", isMatching, b1)
+			result.UpdateState(s1, "partyA", record.Kind(), isMatching, b1, record.UnitsOf(&partyA))
 		}
 
 		// party B
 		if s2, b2, err := record.State(&partyB, customerFn); err == nil {
-			result.UpdateState(s2, "partyB", isMatching, b2)
+			result.UpdateState(s2, "partyB", record.Kind(), isMatching, b2, record.UnitsOf(&partyB))
 		}
 
 		// party C
 		if s3, b3, err := record.State(&partyC, customerFn); err == nil {
-			result.UpdateState(s3, "partyC", isMatching, b3)
+			result.UpdateState(s3, "partyC", record.Kind(), isMatching, b3, record.UnitsOf(&partyC))
 		}
 	}
 
@@ -784,6 +784,107 @@ func TestGetSummaryReport(t *testing.T) {
 	}
 }
 
+func TestGenerateInvoicesByContract_Kind(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	a1 := mock.Address()
+	a2 := mock.Address()
+	param := buildContractParam()
+	param.PartyA.Address = a1
+	param.PartyB.Address = a2
+	// SMS and voice services, there is no data service
+	param.Services = []ContractService{param.Services[0], param.Services[1]}
+	param.Services[1].Kind = CDRKindVoice
+	param.Services[1].UnitPrice, _ = types.ParseMoney("0.05")
+
+	contractAddr, _ := param.Address()
+	abi, _ := param.ToABI()
+	if err := SaveContractParam(ctx, &contractAddr, abi[:]); err != nil {
+		t.Fatal(err)
+	}
+
+	save := func(p1, p2 CDRParam) {
+		s := &CDRStatus{}
+		if err := s.DoSettlement(SettlementCDR{CDRParam: p1, From: a1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.DoSettlement(SettlementCDR{CDRParam: p2, From: a2}); err != nil {
+			t.Fatal(err)
+		}
+		h, err := s.ToHash()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := SaveCDRStatus(ctx, &contractAddr, &h, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		sms := cdrParam
+		sms.Index = uint64(i + 1)
+		save(sms, sms)
+	}
+
+	// billable durations are 2 and 3 minutes, the shorter one of both parties is billed
+	for i, d := range [][2]uint64{{61, 61}, {125, 185}} {
+		v1 := cdrParam
+		v1.Index = uint64(i + 10)
+		v1.Kind = CDRKindVoice
+		v1.Voice = &VoiceCDR{Duration: d[0], AnswerStatus: AnswerStatusAnswered}
+		v2 := v1
+		v2.Voice = &VoiceCDR{Duration: d[1], AnswerStatus: AnswerStatusAnswered}
+		save(v1, v2)
+	}
+
+	data := cdrParam
+	data.Index = 20
+	data.Kind = CDRKindData
+	data.Data = &DataCDR{SessionId: "s1", Volume: 1 << 20}
+	save(data, data)
+
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	invoices, err := GenerateInvoicesByContract(l, &contractAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(util.ToIndentString(invoices))
+	// data records are not invoiced without data service
+	if len(invoices) != 2 {
+		t.Fatalf("invalid invoices %d", len(invoices))
+	}
+	for _, invoice := range invoices {
+		switch invoice.Kind {
+		case CDRKindSms:
+			if invoice.ServiceId != param.Services[0].ServiceId || invoice.SumOfBillableSMSCustomer != 3 ||
+				invoice.SumOfTOTPrice.String() != "6.00 USD" {
+				t.Fatal(util.ToIndentString(invoice))
+			}
+		case CDRKindVoice:
+			if invoice.ServiceId != param.Services[1].ServiceId || invoice.SumOfBillableSMSCustomer != 2 ||
+				invoice.SumOfBillableUnits != 5 || invoice.SumOfTOTPrice.String() != "0.25 USD" {
+				t.Fatal(util.ToIndentString(invoice))
+			}
+		default:
+			t.Fatalf("invalid kind %s", invoice.Kind)
+		}
+	}
+
+	report, err := GetSummaryReport(l, &contractAddr, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := report.Kinds[CDRKindVoice.String()].records["partyB"]; r.Matching.Success != 2 || r.Matching.Units != 6 {
+		t.Fatal(report.String())
+	}
+}
+
 func TestGetStopNames(t *testing.T) {
 	teardownTestCase, l := setupTestCase(t)
 	defer teardownTestCase(t)
@@ -1436,3 +1537,36 @@ func TestGetSummaryReportByCustomer(t *testing.T) {
 		}
 	}
 }
+
+func TestEmitEvent(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+	ca := mock.Address()
+	partyB := mock.Address()
+	if err := EmitEvent(ctx, EventNameContractSigned, []types.Address{ca, partyB}, int64(100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := EmitEvent(ctx, EventNameContractSigned, []types.Address{ca}, int64(100)); err == nil {
+		t.Fatal("indexed count mismatch should fail")
+	}
+
+	logs := vmstore.LogList(ctx).Logs
+	if len(logs) != 1 {
+		t.Fatal("invalid logs", len(logs))
+	}
+	vmLog := logs[0]
+	if vmLog.Address != contractaddress.SettlementAddress || len(vmLog.Topics) != 3 ||
+		vmLog.Topics[0] != SettlementABI.Events[EventNameContractSigned].Id() ||
+		vmLog.Topics[1] != types.Hash(ca) || vmLog.Topics[2] != types.Hash(partyB) {
+		t.Fatal("invalid log", vmLog)
+	}
+	var confirmDate int64
+	if err := SettlementABI.Events[EventNameContractSigned].Inputs.NonIndexed().Unpack(&confirmDate, vmLog.Data); err != nil {
+		t.Fatal(err)
+	}
+	if confirmDate != 100 {
+		t.Fatal("invalid confirm date", confirmDate)
+	}
+}
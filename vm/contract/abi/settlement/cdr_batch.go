@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/log"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// CDRBatchCounter aggregates records of a kind in batch, Billable is in base unit of the kind,
+// message for SMS, second for voice and byte for data
+//
+//go:generate msgp
+type CDRBatchCounter struct {
+	Kind     CDRKind `msg:"k" json:"kind"`
+	Total    uint64  `msg:"t" json:"total"`
+	Success  uint64  `msg:"s" json:"success"`
+	Billable uint64  `msg:"b" json:"billable"`
+}
+
+// Add counts the record into counter
+func (z *CDRBatchCounter) Add(param *CDRParam) {
+	z.Total++
+	if !param.Status() {
+		return
+	}
+	z.Success++
+	switch param.Kind {
+	case CDRKindSms:
+		z.Billable++
+	case CDRKindVoice:
+		z.Billable += param.Voice.Billable()
+	case CDRKindData:
+		z.Billable += param.Data.Billable()
+	}
+}
+
+// CDRBatchParam commits a batch of CDR records which are kept off chain, only the merkle root of the
+// records and the aggregated counters are put on chain, records are proved by merkle branch to the root
+//
+//go:generate msgp
+type CDRBatchParam struct {
+	ContractAddress types.Address      `msg:"ca,extension" json:"contractAddress"`
+	Seq             uint64             `msg:"s" json:"seq"`
+	Root            types.Hash         `msg:"r,extension" json:"root"`
+	StartDate       int64              `msg:"t1" json:"startDate"`
+	EndDate         int64              `msg:"t2" json:"endDate"`
+	Counters        []*CDRBatchCounter `msg:"c" json:"counters"`
+}
+
+func (z *CDRBatchParam) ToABI() ([]byte, error) {
+	id := SettlementABI.Methods[MethodNameCommitCDRBatch].Id()
+	if data, err := z.MarshalMsg(nil); err != nil {
+		return nil, err
+	} else {
+		id = append(id, data...)
+		return id, nil
+	}
+}
+
+func (z *CDRBatchParam) FromABI(data []byte) error {
+	_, err := z.UnmarshalMsg(data[4:])
+	return err
+}
+
+func (z *CDRBatchParam) Verify() error {
+	if z.ContractAddress.IsZero() {
+		return errors.New("invalid contract address")
+	}
+	if z.Seq == 0 {
+		return errors.New("invalid batch seq")
+	}
+	if z.Root.IsZero() {
+		return errors.New("invalid batch root")
+	}
+	if z.StartDate <= 0 || z.EndDate < z.StartDate {
+		return fmt.Errorf("invalid batch date [%d, %d]", z.StartDate, z.EndDate)
+	}
+	if len(z.Counters) == 0 {
+		return errors.New("empty batch counters")
+	}
+	kinds := make(map[CDRKind]struct{})
+	for _, c := range z.Counters {
+		if c == nil {
+			return errors.New("invalid batch counter")
+		}
+		if _, err := ParseCDRKind(c.Kind.String()); err != nil {
+			return fmt.Errorf("invalid CDR kind %d", c.Kind)
+		}
+		if _, ok := kinds[c.Kind]; ok {
+			return fmt.Errorf("duplicate %s counter", c.Kind.String())
+		}
+		kinds[c.Kind] = struct{}{}
+		if c.Total == 0 || c.Success > c.Total {
+			return fmt.Errorf("invalid %s counter, total %d, success %d", c.Kind.String(), c.Total, c.Success)
+		}
+	}
+	return nil
+}
+
+func (z *CDRBatchParam) String() string {
+	return util.ToIndentString(z)
+}
+
+// CDRBatch is the batch committed by a party of settlement contract
+//
+//go:generate msgp
+type CDRBatch struct {
+	CDRBatchParam
+	Party     types.Address `msg:"pa,extension" json:"party"`
+	Block     types.Hash    `msg:"bh,extension" json:"block"`
+	Timestamp int64         `msg:"ts" json:"timestamp"`
+}
+
+func (z *CDRBatch) ToABI() ([]byte, error) {
+	return z.MarshalMsg(nil)
+}
+
+func (z *CDRBatch) FromABI(data []byte) error {
+	_, err := z.UnmarshalMsg(data)
+	return err
+}
+
+func (z *CDRBatch) String() string {
+	return util.ToIndentString(z)
+}
+
+func cdrBatchKey(contract, party types.Address, seq uint64) []byte {
+	key := make([]byte, 0, types.AddressSize*2+8)
+	key = append(key, contract[:]...)
+	key = append(key, party[:]...)
+	return append(key, util.BE_Uint64ToBytes(seq)...)
+}
+
+// SaveCDRBatch saves the committed batch, batch of the party can not be overwritten
+func SaveCDRBatch(ctx *vmstore.VMContext, batch *CDRBatch) error {
+	key := cdrBatchKey(batch.ContractAddress, batch.Party, batch.Seq)
+	if _, err := ctx.GetStorage(cdrBatchPrefix, key); err == nil {
+		return fmt.Errorf("batch %d of %s is already committed", batch.Seq, batch.Party.String())
+	} else if err != vmstore.ErrStorageNotFound {
+		return err
+	}
+	data, err := batch.ToABI()
+	if err != nil {
+		return err
+	}
+	return ctx.SetStorage(cdrBatchPrefix, key, data)
+}
+
+// GetCDRBatch returns the batch of seq committed by party of contract
+func GetCDRBatch(ctx *vmstore.VMContext, contract, party types.Address, seq uint64) (*CDRBatch, error) {
+	if storage, err := ctx.GetStorage(cdrBatchPrefix, cdrBatchKey(contract, party, seq)); err != nil {
+		return nil, err
+	} else {
+		batch := &CDRBatch{}
+		if err := batch.FromABI(storage); err != nil {
+			return nil, err
+		}
+		return batch, nil
+	}
+}
+
+// GetCDRBatches returns batches committed by all parties of contract, ordered by party and seq
+func GetCDRBatches(store ledger.Store, contract *types.Address) ([]*CDRBatch, error) {
+	logger := log.NewLogger("GetCDRBatches")
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	prefix := make([]byte, 0, len(cdrBatchPrefix)+types.AddressSize)
+	prefix = append(prefix, cdrBatchPrefix...)
+	prefix = append(prefix, contract[:]...)
+
+	var result []*CDRBatch
+	iterator := store.NewVMIterator(&contractaddress.SettlementAddress)
+	if err := iterator.Next(prefix, func(key []byte, value []byte) error {
+		batch := &CDRBatch{}
+		if err := batch.FromABI(value); err != nil {
+			logger.Error(err)
+		} else {
+			result = append(result, batch)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Party != result[j].Party {
+			return result[i].Party.String() < result[j].Party.String()
+		}
+		return result[i].Seq < result[j].Seq
+	})
+	return result, nil
+}
@@ -0,0 +1,696 @@
+package settlement
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *CDRBatch) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "CDRBatchParam":
+			err = z.CDRBatchParam.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "CDRBatchParam")
+				return
+			}
+		case "pa":
+			err = dc.ReadExtension(&z.Party)
+			if err != nil {
+				err = msgp.WrapError(err, "Party")
+				return
+			}
+		case "bh":
+			err = dc.ReadExtension(&z.Block)
+			if err != nil {
+				err = msgp.WrapError(err, "Block")
+				return
+			}
+		case "ts":
+			z.Timestamp, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CDRBatch) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "CDRBatchParam"
+	err = en.Append(0x84, 0xad, 0x43, 0x44, 0x52, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d)
+	if err != nil {
+		return
+	}
+	err = z.CDRBatchParam.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "CDRBatchParam")
+		return
+	}
+	// write "pa"
+	err = en.Append(0xa2, 0x70, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Party)
+	if err != nil {
+		err = msgp.WrapError(err, "Party")
+		return
+	}
+	// write "bh"
+	err = en.Append(0xa2, 0x62, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Block)
+	if err != nil {
+		err = msgp.WrapError(err, "Block")
+		return
+	}
+	// write "ts"
+	err = en.Append(0xa2, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Timestamp)
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamp")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CDRBatch) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "CDRBatchParam"
+	o = append(o, 0x84, 0xad, 0x43, 0x44, 0x52, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d)
+	o, err = z.CDRBatchParam.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "CDRBatchParam")
+		return
+	}
+	// string "pa"
+	o = append(o, 0xa2, 0x70, 0x61)
+	o, err = msgp.AppendExtension(o, &z.Party)
+	if err != nil {
+		err = msgp.WrapError(err, "Party")
+		return
+	}
+	// string "bh"
+	o = append(o, 0xa2, 0x62, 0x68)
+	o, err = msgp.AppendExtension(o, &z.Block)
+	if err != nil {
+		err = msgp.WrapError(err, "Block")
+		return
+	}
+	// string "ts"
+	o = append(o, 0xa2, 0x74, 0x73)
+	o = msgp.AppendInt64(o, z.Timestamp)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CDRBatch) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "CDRBatchParam":
+			bts, err = z.CDRBatchParam.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CDRBatchParam")
+				return
+			}
+		case "pa":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Party)
+			if err != nil {
+				err = msgp.WrapError(err, "Party")
+				return
+			}
+		case "bh":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Block)
+			if err != nil {
+				err = msgp.WrapError(err, "Block")
+				return
+			}
+		case "ts":
+			z.Timestamp, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CDRBatch) Msgsize() (s int) {
+	s = 1 + 14 + z.CDRBatchParam.Msgsize() + 3 + msgp.ExtensionPrefixSize + z.Party.Len() + 3 + msgp.ExtensionPrefixSize + z.Block.Len() + 3 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CDRBatchCounter) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "k":
+			err = z.Kind.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Kind")
+				return
+			}
+		case "t":
+			z.Total, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Total")
+				return
+			}
+		case "s":
+			z.Success, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Success")
+				return
+			}
+		case "b":
+			z.Billable, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Billable")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CDRBatchCounter) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "k"
+	err = en.Append(0x84, 0xa1, 0x6b)
+	if err != nil {
+		return
+	}
+	err = z.Kind.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
+	// write "t"
+	err = en.Append(0xa1, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Total)
+	if err != nil {
+		err = msgp.WrapError(err, "Total")
+		return
+	}
+	// write "s"
+	err = en.Append(0xa1, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Success)
+	if err != nil {
+		err = msgp.WrapError(err, "Success")
+		return
+	}
+	// write "b"
+	err = en.Append(0xa1, 0x62)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Billable)
+	if err != nil {
+		err = msgp.WrapError(err, "Billable")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CDRBatchCounter) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "k"
+	o = append(o, 0x84, 0xa1, 0x6b)
+	o, err = z.Kind.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
+	// string "t"
+	o = append(o, 0xa1, 0x74)
+	o = msgp.AppendUint64(o, z.Total)
+	// string "s"
+	o = append(o, 0xa1, 0x73)
+	o = msgp.AppendUint64(o, z.Success)
+	// string "b"
+	o = append(o, 0xa1, 0x62)
+	o = msgp.AppendUint64(o, z.Billable)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CDRBatchCounter) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "k":
+			bts, err = z.Kind.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Kind")
+				return
+			}
+		case "t":
+			z.Total, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Total")
+				return
+			}
+		case "s":
+			z.Success, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Success")
+				return
+			}
+		case "b":
+			z.Billable, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Billable")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CDRBatchCounter) Msgsize() (s int) {
+	s = 1 + 2 + z.Kind.Msgsize() + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CDRBatchParam) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			err = dc.ReadExtension(&z.ContractAddress)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "s":
+			z.Seq, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Seq")
+				return
+			}
+		case "r":
+			err = dc.ReadExtension(&z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "t1":
+			z.StartDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "StartDate")
+				return
+			}
+		case "t2":
+			z.EndDate, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "EndDate")
+				return
+			}
+		case "c":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Counters")
+				return
+			}
+			if cap(z.Counters) >= int(zb0002) {
+				z.Counters = (z.Counters)[:zb0002]
+			} else {
+				z.Counters = make([]*CDRBatchCounter, zb0002)
+			}
+			for za0001 := range z.Counters {
+				if dc.IsNil() {
+					err = dc.ReadNil()
+					if err != nil {
+						err = msgp.WrapError(err, "Counters", za0001)
+						return
+					}
+					z.Counters[za0001] = nil
+				} else {
+					if z.Counters[za0001] == nil {
+						z.Counters[za0001] = new(CDRBatchCounter)
+					}
+					err = z.Counters[za0001].DecodeMsg(dc)
+					if err != nil {
+						err = msgp.WrapError(err, "Counters", za0001)
+						return
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CDRBatchParam) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "ca"
+	err = en.Append(0x86, 0xa2, 0x63, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.ContractAddress)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// write "s"
+	err = en.Append(0xa1, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Seq)
+	if err != nil {
+		err = msgp.WrapError(err, "Seq")
+		return
+	}
+	// write "r"
+	err = en.Append(0xa1, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteExtension(&z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// write "t1"
+	err = en.Append(0xa2, 0x74, 0x31)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.StartDate)
+	if err != nil {
+		err = msgp.WrapError(err, "StartDate")
+		return
+	}
+	// write "t2"
+	err = en.Append(0xa2, 0x74, 0x32)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.EndDate)
+	if err != nil {
+		err = msgp.WrapError(err, "EndDate")
+		return
+	}
+	// write "c"
+	err = en.Append(0xa1, 0x63)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Counters)))
+	if err != nil {
+		err = msgp.WrapError(err, "Counters")
+		return
+	}
+	for za0001 := range z.Counters {
+		if z.Counters[za0001] == nil {
+			err = en.WriteNil()
+			if err != nil {
+				return
+			}
+		} else {
+			err = z.Counters[za0001].EncodeMsg(en)
+			if err != nil {
+				err = msgp.WrapError(err, "Counters", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CDRBatchParam) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "ca"
+	o = append(o, 0x86, 0xa2, 0x63, 0x61)
+	o, err = msgp.AppendExtension(o, &z.ContractAddress)
+	if err != nil {
+		err = msgp.WrapError(err, "ContractAddress")
+		return
+	}
+	// string "s"
+	o = append(o, 0xa1, 0x73)
+	o = msgp.AppendUint64(o, z.Seq)
+	// string "r"
+	o = append(o, 0xa1, 0x72)
+	o, err = msgp.AppendExtension(o, &z.Root)
+	if err != nil {
+		err = msgp.WrapError(err, "Root")
+		return
+	}
+	// string "t1"
+	o = append(o, 0xa2, 0x74, 0x31)
+	o = msgp.AppendInt64(o, z.StartDate)
+	// string "t2"
+	o = append(o, 0xa2, 0x74, 0x32)
+	o = msgp.AppendInt64(o, z.EndDate)
+	// string "c"
+	o = append(o, 0xa1, 0x63)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Counters)))
+	for za0001 := range z.Counters {
+		if z.Counters[za0001] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Counters[za0001].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Counters", za0001)
+				return
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CDRBatchParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ca":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.ContractAddress)
+			if err != nil {
+				err = msgp.WrapError(err, "ContractAddress")
+				return
+			}
+		case "s":
+			z.Seq, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Seq")
+				return
+			}
+		case "r":
+			bts, err = msgp.ReadExtensionBytes(bts, &z.Root)
+			if err != nil {
+				err = msgp.WrapError(err, "Root")
+				return
+			}
+		case "t1":
+			z.StartDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "StartDate")
+				return
+			}
+		case "t2":
+			z.EndDate, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EndDate")
+				return
+			}
+		case "c":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Counters")
+				return
+			}
+			if cap(z.Counters) >= int(zb0002) {
+				z.Counters = (z.Counters)[:zb0002]
+			} else {
+				z.Counters = make([]*CDRBatchCounter, zb0002)
+			}
+			for za0001 := range z.Counters {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Counters[za0001] = nil
+				} else {
+					if z.Counters[za0001] == nil {
+						z.Counters[za0001] = new(CDRBatchCounter)
+					}
+					bts, err = z.Counters[za0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Counters", za0001)
+						return
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CDRBatchParam) Msgsize() (s int) {
+	s = 1 + 3 + msgp.ExtensionPrefixSize + z.ContractAddress.Len() + 2 + msgp.Uint64Size + 2 + msgp.ExtensionPrefixSize + z.Root.Len() + 3 + msgp.Int64Size + 3 + msgp.Int64Size + 2 + msgp.ArrayHeaderSize
+	for za0001 := range z.Counters {
+		if z.Counters[za0001] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Counters[za0001].Msgsize()
+		}
+	}
+	return
+}
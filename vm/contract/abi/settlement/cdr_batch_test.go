@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func buildCDRBatchParam() *CDRBatchParam {
+	return &CDRBatchParam{
+		ContractAddress: mock.Address(),
+		Seq:             1,
+		Root:            mock.Hash(),
+		StartDate:       1000,
+		EndDate:         2000,
+		Counters: []*CDRBatchCounter{
+			{Kind: CDRKindSms, Total: 10, Success: 8, Billable: 8},
+			{Kind: CDRKindVoice, Total: 2, Success: 1, Billable: 120},
+		},
+	}
+}
+
+func TestCDRBatchParam_ToABI(t *testing.T) {
+	param := buildCDRBatchParam()
+	if abi, err := param.ToABI(); err != nil {
+		t.Fatal(err)
+	} else {
+		p2 := &CDRBatchParam{}
+		if err := p2.FromABI(abi); err != nil {
+			t.Fatal(err)
+		} else if !reflect.DeepEqual(param, p2) {
+			t.Fatalf("invalid param, %v, %v", param, p2)
+		} else {
+			t.Log(param.String())
+		}
+	}
+}
+
+func TestCDRBatchParam_Verify(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(p *CDRBatchParam)
+		wantErr bool
+	}{
+		{name: "ok", fn: func(p *CDRBatchParam) {}},
+		{name: "contract", fn: func(p *CDRBatchParam) { p.ContractAddress = types.ZeroAddress }, wantErr: true},
+		{name: "seq", fn: func(p *CDRBatchParam) { p.Seq = 0 }, wantErr: true},
+		{name: "root", fn: func(p *CDRBatchParam) { p.Root = types.ZeroHash }, wantErr: true},
+		{name: "date", fn: func(p *CDRBatchParam) { p.EndDate = p.StartDate - 1 }, wantErr: true},
+		{name: "empty", fn: func(p *CDRBatchParam) { p.Counters = nil }, wantErr: true},
+		{name: "kind", fn: func(p *CDRBatchParam) { p.Counters[0].Kind = 10 }, wantErr: true},
+		{name: "duplicate", fn: func(p *CDRBatchParam) { p.Counters[1].Kind = CDRKindSms }, wantErr: true},
+		{name: "success", fn: func(p *CDRBatchParam) { p.Counters[0].Success = 11 }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := buildCDRBatchParam()
+			tt.fn(p)
+			if err := p.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCDRBatchCounter_Add(t *testing.T) {
+	c := &CDRBatchCounter{Kind: CDRKindVoice}
+	c.Add(&CDRParam{Kind: CDRKindVoice, Voice: &VoiceCDR{Duration: 61, AnswerStatus: AnswerStatusAnswered}})
+	c.Add(&CDRParam{Kind: CDRKindVoice, Voice: &VoiceCDR{Duration: 10, AnswerStatus: AnswerStatusBusy}})
+	if c.Total != 2 || c.Success != 1 || c.Billable != 120 {
+		t.Fatal("invalid counter", c)
+	}
+}
+
+func TestGetCDRBatches(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	param := buildCDRBatchParam()
+	partyA := mock.Address()
+	partyB := mock.Address()
+	for _, b := range []*CDRBatch{
+		{CDRBatchParam: *param, Party: partyA, Block: mock.Hash()},
+		{CDRBatchParam: *param, Party: partyB, Block: mock.Hash()},
+	} {
+		if err := SaveCDRBatch(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b2 := &CDRBatch{CDRBatchParam: *param, Party: partyA, Block: mock.Hash()}
+	b2.Seq = 2
+	if err := SaveCDRBatch(ctx, b2); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveCDRBatch(ctx, b2); err == nil {
+		t.Fatal("batch should not be overwritten")
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	if b, err := GetCDRBatch(ctx, param.ContractAddress, partyA, 2); err != nil || !reflect.DeepEqual(b, b2) {
+		t.Fatal("invalid batch", b, err)
+	}
+	if _, err := GetCDRBatch(ctx, param.ContractAddress, partyB, 2); err != vmstore.ErrStorageNotFound {
+		t.Fatal("invalid error", err)
+	}
+	if batches, err := GetCDRBatches(l, &param.ContractAddress); err != nil || len(batches) != 3 {
+		t.Fatal("invalid batches", len(batches), err)
+	}
+	if batches, err := GetCDRBatches(l, &partyA); err != nil || len(batches) != 0 {
+		t.Fatal("invalid batches", len(batches), err)
+	}
+}
@@ -8,6 +8,10 @@
 package settlement
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
+
 	"gopkg.in/validator.v2"
 
 	"github.com/qlcchain/go-qlc/common/types"
@@ -36,6 +40,77 @@ Empty
 */
 type DLRStatus int
 
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+sms
+voice
+data
+)
+*/
+type CDRKind int
+
+//go:generate go-enum -f=$GOFILE --marshal --names
+/*
+ENUM(
+Answered
+NoAnswer
+Busy
+Failed
+Empty
+)
+*/
+type AnswerStatus int
+
+const (
+	// voice is priced per minute and data per MB by the unit price of contract service
+	secondsPerMinute = 60
+	bytesPerMB       = 1 << 20
+
+	// DefaultVoiceIncrement is used if voice record has no increment, calls are billed by whole minutes
+	DefaultVoiceIncrement = 60
+	// DefaultDataIncrement is used if data record has no increment, sessions are billed by KB
+	DefaultDataIncrement = 1 << 10
+)
+
+// roundUp rounds v up to multiple of increment, def is used if increment is not set
+func roundUp(v, increment, def uint64) uint64 {
+	if increment == 0 {
+		increment = def
+	}
+	if r := v % increment; r > 0 {
+		return v + increment - r
+	}
+	return v
+}
+
+//go:generate msgp
+type VoiceCDR struct {
+	Duration     uint64       `msg:"du" json:"duration"` // call duration in seconds
+	AnswerStatus AnswerStatus `msg:"as" json:"answerStatus"`
+	Increment    uint64       `msg:"in" json:"increment,omitempty"` // rounding increment in seconds
+}
+
+// Billable returns call duration in seconds rounded up to increment
+func (z *VoiceCDR) Billable() uint64 {
+	return roundUp(z.Duration, z.Increment, DefaultVoiceIncrement)
+}
+
+//go:generate msgp
+type DataCDR struct {
+	SessionId string `msg:"sid" json:"sessionId"`
+	Volume    uint64 `msg:"vo" json:"volume"`              // uplink and downlink volume in bytes
+	Increment uint64 `msg:"in" json:"increment,omitempty"` // rounding increment in bytes
+}
+
+// Billable returns session volume in bytes rounded up to increment
+func (z *DataCDR) Billable() uint64 {
+	return roundUp(z.Volume, z.Increment, DefaultDataIncrement)
+}
+
+// CDRParam is a record of SMS, voice call or data session, SmsDt is the time of SMS or the start time of
+// call and session, the details of call and session are in Voice and Data
+//
 //go:generate msgp
 type CDRParam struct {
 	Index         uint64        `msg:"i" json:"index" validate:"min=1"`
@@ -48,13 +123,24 @@ type CDRParam struct {
 	DlrStatus     DLRStatus     `msg:"ds" json:"dlrStatus"`
 	PreStop       string        `msg:"ps" json:"preStop"`
 	NextStop      string        `msg:"ns" json:"nextStop"`
+	Kind          CDRKind       `msg:"k" json:"kind,omitempty"`
+	Voice         *VoiceCDR     `msg:"v" json:"voice,omitempty"`
+	Data          *DataCDR      `msg:"dd" json:"data,omitempty"`
 }
 
 func (z *CDRParam) String() string {
 	return util.ToIndentString(z)
 }
 
+// Status returns whether the record is billable, call should be answered and session should have volume
 func (z *CDRParam) Status() bool {
+	switch z.Kind {
+	case CDRKindVoice:
+		return z.Voice != nil && z.Voice.AnswerStatus == AnswerStatusAnswered && z.Voice.Duration > 0
+	case CDRKindData:
+		return z.Data != nil && z.Data.Volume > 0
+	}
+
 	switch z.DlrStatus {
 	case DLRStatusDelivered:
 		return true
@@ -77,13 +163,50 @@ func (z *CDRParam) Verify() error {
 	if errs := validator.Validate(z); errs != nil {
 		return errs
 	}
+	switch z.Kind {
+	case CDRKindSms:
+	case CDRKindVoice:
+		if z.Voice == nil {
+			return errors.New("voice record without call detail")
+		}
+	case CDRKindData:
+		if z.Data == nil || len(z.Data.SessionId) == 0 {
+			return errors.New("data record without session id")
+		}
+	default:
+		return fmt.Errorf("invalid CDR kind %d", z.Kind)
+	}
 	return nil
 }
 
+// ToHash identifies the record, both parties of contract should upload the same index, sender and destination,
+// and session id for data
 func (z *CDRParam) ToHash() (types.Hash, error) {
+	if z.Kind == CDRKindData && z.Data != nil {
+		return types.HashBytes(util.BE_Uint64ToBytes(z.Index), []byte(z.Sender), []byte(z.Destination),
+			[]byte(z.Data.SessionId))
+	}
 	return types.HashBytes(util.BE_Uint64ToBytes(z.Index), []byte(z.Sender), []byte(z.Destination))
 }
 
+// Units returns the billable quantity of record in unit of contract service, it is a message for SMS,
+// a minute for voice and a MB for data
+func (z *CDRParam) Units() *big.Rat {
+	switch z.Kind {
+	case CDRKindSms:
+		return big.NewRat(1, 1)
+	case CDRKindVoice:
+		if z.Voice != nil {
+			return new(big.Rat).SetFrac64(int64(z.Voice.Billable()), secondsPerMinute)
+		}
+	case CDRKindData:
+		if z.Data != nil {
+			return new(big.Rat).SetFrac64(int64(z.Data.Billable()), bytesPerMB)
+		}
+	}
+	return new(big.Rat)
+}
+
 func (z *CDRParam) GetCustomer() string {
 	if z.Customer == "" {
 		return z.Sender
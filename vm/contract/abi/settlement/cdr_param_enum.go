@@ -8,6 +8,152 @@ import (
 	"strings"
 )
 
+const (
+	// AnswerStatusAnswered is a AnswerStatus of type Answered
+	AnswerStatusAnswered AnswerStatus = iota
+	// AnswerStatusNoAnswer is a AnswerStatus of type NoAnswer
+	AnswerStatusNoAnswer
+	// AnswerStatusBusy is a AnswerStatus of type Busy
+	AnswerStatusBusy
+	// AnswerStatusFailed is a AnswerStatus of type Failed
+	AnswerStatusFailed
+	// AnswerStatusEmpty is a AnswerStatus of type Empty
+	AnswerStatusEmpty
+)
+
+const _AnswerStatusName = "AnsweredNoAnswerBusyFailedEmpty"
+
+var _AnswerStatusNames = []string{
+	_AnswerStatusName[0:8],
+	_AnswerStatusName[8:16],
+	_AnswerStatusName[16:20],
+	_AnswerStatusName[20:26],
+	_AnswerStatusName[26:31],
+}
+
+// AnswerStatusNames returns a list of possible string values of AnswerStatus.
+func AnswerStatusNames() []string {
+	tmp := make([]string, len(_AnswerStatusNames))
+	copy(tmp, _AnswerStatusNames)
+	return tmp
+}
+
+var _AnswerStatusMap = map[AnswerStatus]string{
+	0: _AnswerStatusName[0:8],
+	1: _AnswerStatusName[8:16],
+	2: _AnswerStatusName[16:20],
+	3: _AnswerStatusName[20:26],
+	4: _AnswerStatusName[26:31],
+}
+
+// String implements the Stringer interface.
+func (x AnswerStatus) String() string {
+	if str, ok := _AnswerStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AnswerStatus(%d)", x)
+}
+
+var _AnswerStatusValue = map[string]AnswerStatus{
+	_AnswerStatusName[0:8]:   0,
+	_AnswerStatusName[8:16]:  1,
+	_AnswerStatusName[16:20]: 2,
+	_AnswerStatusName[20:26]: 3,
+	_AnswerStatusName[26:31]: 4,
+}
+
+// ParseAnswerStatus attempts to convert a string to a AnswerStatus
+func ParseAnswerStatus(name string) (AnswerStatus, error) {
+	if x, ok := _AnswerStatusValue[name]; ok {
+		return x, nil
+	}
+	return AnswerStatus(0), fmt.Errorf("%s is not a valid AnswerStatus, try [%s]", name, strings.Join(_AnswerStatusNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x AnswerStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *AnswerStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseAnswerStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// CDRKindSms is a CDRKind of type Sms
+	CDRKindSms CDRKind = iota
+	// CDRKindVoice is a CDRKind of type Voice
+	CDRKindVoice
+	// CDRKindData is a CDRKind of type Data
+	CDRKindData
+)
+
+const _CDRKindName = "smsvoicedata"
+
+var _CDRKindNames = []string{
+	_CDRKindName[0:3],
+	_CDRKindName[3:8],
+	_CDRKindName[8:12],
+}
+
+// CDRKindNames returns a list of possible string values of CDRKind.
+func CDRKindNames() []string {
+	tmp := make([]string, len(_CDRKindNames))
+	copy(tmp, _CDRKindNames)
+	return tmp
+}
+
+var _CDRKindMap = map[CDRKind]string{
+	0: _CDRKindName[0:3],
+	1: _CDRKindName[3:8],
+	2: _CDRKindName[8:12],
+}
+
+// String implements the Stringer interface.
+func (x CDRKind) String() string {
+	if str, ok := _CDRKindMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("CDRKind(%d)", x)
+}
+
+var _CDRKindValue = map[string]CDRKind{
+	_CDRKindName[0:3]:  0,
+	_CDRKindName[3:8]:  1,
+	_CDRKindName[8:12]: 2,
+}
+
+// ParseCDRKind attempts to convert a string to a CDRKind
+func ParseCDRKind(name string) (CDRKind, error) {
+	if x, ok := _CDRKindValue[name]; ok {
+		return x, nil
+	}
+	return CDRKind(0), fmt.Errorf("%s is not a valid CDRKind, try [%s]", name, strings.Join(_CDRKindNames, ", "))
+}
+
+// MarshalText implements the text marshaller method
+func (x CDRKind) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method
+func (x *CDRKind) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseCDRKind(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
 const (
 	// DLRStatusDelivered is a DLRStatus of type Delivered
 	DLRStatusDelivered DLRStatus = iota
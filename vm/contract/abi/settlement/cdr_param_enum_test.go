@@ -12,6 +12,286 @@ import (
 	"testing"
 )
 
+func TestAnswerStatusNames(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{
+			name: "ok",
+			want: []string{"Answered", "NoAnswer", "Busy", "Failed", "Empty"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AnswerStatusNames(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AnswerStatusNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswerStatus_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       AnswerStatus
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "",
+			x:       AnswerStatusAnswered,
+			want:    []byte("Answered"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.x.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswerStatus_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    AnswerStatus
+		want string
+	}{
+		{
+			name: "ok",
+			x:    AnswerStatusAnswered,
+			want: "Answered",
+		}, {
+			name: "ok",
+			x:    5,
+			want: "AnswerStatus(5)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnswerStatus_UnmarshalText(t *testing.T) {
+	type args struct {
+		text []byte
+	}
+	tests := []struct {
+		name    string
+		x       AnswerStatus
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			x:    AnswerStatusAnswered,
+			args: args{
+				text: []byte("Answered"),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.x.UnmarshalText(tt.args.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAnswerStatus(t *testing.T) {
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    AnswerStatus
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			args: args{
+				name: "Answered",
+			},
+			want:    AnswerStatusAnswered,
+			wantErr: false,
+		}, {
+			name: "fail",
+			args: args{
+				name: "Answereddd",
+			},
+			want:    AnswerStatusAnswered,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAnswerStatus(tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAnswerStatus() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseAnswerStatus() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDRKindNames(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{
+			name: "ok",
+			want: []string{"sms", "voice", "data"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CDRKindNames(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CDRKindNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDRKind_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       CDRKind
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "",
+			x:       CDRKindSms,
+			want:    []byte("sms"),
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.x.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalText() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDRKind_String(t *testing.T) {
+	tests := []struct {
+		name string
+		x    CDRKind
+		want string
+	}{
+		{
+			name: "ok",
+			x:    CDRKindSms,
+			want: "sms",
+		}, {
+			name: "ok",
+			x:    3,
+			want: "CDRKind(3)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.x.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCDRKind_UnmarshalText(t *testing.T) {
+	type args struct {
+		text []byte
+	}
+	tests := []struct {
+		name    string
+		x       CDRKind
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			x:    CDRKindSms,
+			args: args{
+				text: []byte("sms"),
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.x.UnmarshalText(tt.args.text); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseCDRKind(t *testing.T) {
+	type args struct {
+		name string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    CDRKind
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			args: args{
+				name: "sms",
+			},
+			want:    CDRKindSms,
+			wantErr: false,
+		}, {
+			name: "fail",
+			args: args{
+				name: "smsdd",
+			},
+			want:    CDRKindSms,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCDRKind(tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCDRKind() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseCDRKind() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDLRStatusNames(t *testing.T) {
 	tests := []struct {
 		name string
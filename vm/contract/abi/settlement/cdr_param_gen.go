@@ -6,6 +6,110 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// DecodeMsg implements msgp.Decodable
+func (z *AnswerStatus) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = AnswerStatus(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z AnswerStatus) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z AnswerStatus) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *AnswerStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = AnswerStatus(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z AnswerStatus) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CDRKind) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 int
+		zb0001, err = dc.ReadInt()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = CDRKind(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z CDRKind) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteInt(int(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z CDRKind) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendInt(o, int(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CDRKind) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 int
+		zb0001, bts, err = msgp.ReadIntBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = CDRKind(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z CDRKind) Msgsize() (s int) {
+	s = msgp.IntSize
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *CDRParam) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -92,6 +196,87 @@ func (z *CDRParam) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "NextStop")
 				return
 			}
+		case "k":
+			{
+				var zb0004 int
+				zb0004, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "Kind")
+					return
+				}
+				z.Kind = CDRKind(zb0004)
+			}
+		case "v":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Voice")
+					return
+				}
+				z.Voice = nil
+			} else {
+				if z.Voice == nil {
+					z.Voice = new(VoiceCDR)
+				}
+				err = z.Voice.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "Voice")
+					return
+				}
+			}
+		case "dd":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "Data")
+					return
+				}
+				z.Data = nil
+			} else {
+				if z.Data == nil {
+					z.Data = new(DataCDR)
+				}
+				var zb0005 uint32
+				zb0005, err = dc.ReadMapHeader()
+				if err != nil {
+					err = msgp.WrapError(err, "Data")
+					return
+				}
+				for zb0005 > 0 {
+					zb0005--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						err = msgp.WrapError(err, "Data")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "sid":
+						z.Data.SessionId, err = dc.ReadString()
+						if err != nil {
+							err = msgp.WrapError(err, "Data", "SessionId")
+							return
+						}
+					case "vo":
+						z.Data.Volume, err = dc.ReadUint64()
+						if err != nil {
+							err = msgp.WrapError(err, "Data", "Volume")
+							return
+						}
+					case "in":
+						z.Data.Increment, err = dc.ReadUint64()
+						if err != nil {
+							err = msgp.WrapError(err, "Data", "Increment")
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							err = msgp.WrapError(err, "Data")
+							return
+						}
+					}
+				}
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -105,9 +290,9 @@ func (z *CDRParam) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *CDRParam) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 10
+	// map header, size 13
 	// write "i"
-	err = en.Append(0x8a, 0xa1, 0x69)
+	err = en.Append(0x8d, 0xa1, 0x69)
 	if err != nil {
 		return
 	}
@@ -206,15 +391,85 @@ func (z *CDRParam) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "NextStop")
 		return
 	}
+	// write "k"
+	err = en.Append(0xa1, 0x6b)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.Kind))
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
+	// write "v"
+	err = en.Append(0xa1, 0x76)
+	if err != nil {
+		return
+	}
+	if z.Voice == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Voice.EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "Voice")
+			return
+		}
+	}
+	// write "dd"
+	err = en.Append(0xa2, 0x64, 0x64)
+	if err != nil {
+		return
+	}
+	if z.Data == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 3
+		// write "sid"
+		err = en.Append(0x83, 0xa3, 0x73, 0x69, 0x64)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Data.SessionId)
+		if err != nil {
+			err = msgp.WrapError(err, "Data", "SessionId")
+			return
+		}
+		// write "vo"
+		err = en.Append(0xa2, 0x76, 0x6f)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.Data.Volume)
+		if err != nil {
+			err = msgp.WrapError(err, "Data", "Volume")
+			return
+		}
+		// write "in"
+		err = en.Append(0xa2, 0x69, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.Data.Increment)
+		if err != nil {
+			err = msgp.WrapError(err, "Data", "Increment")
+			return
+		}
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *CDRParam) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 10
+	// map header, size 13
 	// string "i"
-	o = append(o, 0x8a, 0xa1, 0x69)
+	o = append(o, 0x8d, 0xa1, 0x69)
 	o = msgp.AppendUint64(o, z.Index)
 	// string "dt"
 	o = append(o, 0xa2, 0x64, 0x74)
@@ -243,6 +498,36 @@ func (z *CDRParam) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ns"
 	o = append(o, 0xa2, 0x6e, 0x73)
 	o = msgp.AppendString(o, z.NextStop)
+	// string "k"
+	o = append(o, 0xa1, 0x6b)
+	o = msgp.AppendInt(o, int(z.Kind))
+	// string "v"
+	o = append(o, 0xa1, 0x76)
+	if z.Voice == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Voice.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Voice")
+			return
+		}
+	}
+	// string "dd"
+	o = append(o, 0xa2, 0x64, 0x64)
+	if z.Data == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 3
+		// string "sid"
+		o = append(o, 0x83, 0xa3, 0x73, 0x69, 0x64)
+		o = msgp.AppendString(o, z.Data.SessionId)
+		// string "vo"
+		o = append(o, 0xa2, 0x76, 0x6f)
+		o = msgp.AppendUint64(o, z.Data.Volume)
+		// string "in"
+		o = append(o, 0xa2, 0x69, 0x6e)
+		o = msgp.AppendUint64(o, z.Data.Increment)
+	}
 	return
 }
 
@@ -332,6 +617,85 @@ func (z *CDRParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "NextStop")
 				return
 			}
+		case "k":
+			{
+				var zb0004 int
+				zb0004, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Kind")
+					return
+				}
+				z.Kind = CDRKind(zb0004)
+			}
+		case "v":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Voice = nil
+			} else {
+				if z.Voice == nil {
+					z.Voice = new(VoiceCDR)
+				}
+				bts, err = z.Voice.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Voice")
+					return
+				}
+			}
+		case "dd":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Data = nil
+			} else {
+				if z.Data == nil {
+					z.Data = new(DataCDR)
+				}
+				var zb0005 uint32
+				zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Data")
+					return
+				}
+				for zb0005 > 0 {
+					zb0005--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Data")
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "sid":
+						z.Data.SessionId, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Data", "SessionId")
+							return
+						}
+					case "vo":
+						z.Data.Volume, bts, err = msgp.ReadUint64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Data", "Volume")
+							return
+						}
+					case "in":
+						z.Data.Increment, bts, err = msgp.ReadUint64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Data", "Increment")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Data")
+							return
+						}
+					}
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -346,7 +710,18 @@ func (z *CDRParam) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *CDRParam) Msgsize() (s int) {
-	s = 1 + 2 + msgp.Uint64Size + 3 + msgp.Int64Size + 3 + msgp.StringPrefixSize + len(z.Account) + 3 + msgp.StringPrefixSize + len(z.Sender) + 2 + msgp.StringPrefixSize + len(z.Customer) + 2 + msgp.StringPrefixSize + len(z.Destination) + 2 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.PreStop) + 3 + msgp.StringPrefixSize + len(z.NextStop)
+	s = 1 + 2 + msgp.Uint64Size + 3 + msgp.Int64Size + 3 + msgp.StringPrefixSize + len(z.Account) + 3 + msgp.StringPrefixSize + len(z.Sender) + 2 + msgp.StringPrefixSize + len(z.Customer) + 2 + msgp.StringPrefixSize + len(z.Destination) + 2 + msgp.IntSize + 3 + msgp.IntSize + 3 + msgp.StringPrefixSize + len(z.PreStop) + 3 + msgp.StringPrefixSize + len(z.NextStop) + 2 + msgp.IntSize + 2
+	if z.Voice == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Voice.Msgsize()
+	}
+	s += 3
+	if z.Data == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 4 + msgp.StringPrefixSize + len(z.Data.SessionId) + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size
+	}
 	return
 }
 
@@ -615,6 +990,159 @@ func (z DLRStatus) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *DataCDR) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "sid":
+			z.SessionId, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "SessionId")
+				return
+			}
+		case "vo":
+			z.Volume, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Volume")
+				return
+			}
+		case "in":
+			z.Increment, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Increment")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DataCDR) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "sid"
+	err = en.Append(0x83, 0xa3, 0x73, 0x69, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.SessionId)
+	if err != nil {
+		err = msgp.WrapError(err, "SessionId")
+		return
+	}
+	// write "vo"
+	err = en.Append(0xa2, 0x76, 0x6f)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Volume)
+	if err != nil {
+		err = msgp.WrapError(err, "Volume")
+		return
+	}
+	// write "in"
+	err = en.Append(0xa2, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Increment)
+	if err != nil {
+		err = msgp.WrapError(err, "Increment")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DataCDR) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "sid"
+	o = append(o, 0x83, 0xa3, 0x73, 0x69, 0x64)
+	o = msgp.AppendString(o, z.SessionId)
+	// string "vo"
+	o = append(o, 0xa2, 0x76, 0x6f)
+	o = msgp.AppendUint64(o, z.Volume)
+	// string "in"
+	o = append(o, 0xa2, 0x69, 0x6e)
+	o = msgp.AppendUint64(o, z.Increment)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DataCDR) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "sid":
+			z.SessionId, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SessionId")
+				return
+			}
+		case "vo":
+			z.Volume, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Volume")
+				return
+			}
+		case "in":
+			z.Increment, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Increment")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z DataCDR) Msgsize() (s int) {
+	s = 1 + 4 + msgp.StringPrefixSize + len(z.SessionId) + 3 + msgp.Uint64Size + 3 + msgp.Uint64Size
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *SendingStatus) DecodeMsg(dc *msgp.Reader) (err error) {
 	{
@@ -666,3 +1194,164 @@ func (z SendingStatus) Msgsize() (s int) {
 	s = msgp.IntSize
 	return
 }
+
+// DecodeMsg implements msgp.Decodable
+func (z *VoiceCDR) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "du":
+			z.Duration, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Duration")
+				return
+			}
+		case "as":
+			{
+				var zb0002 int
+				zb0002, err = dc.ReadInt()
+				if err != nil {
+					err = msgp.WrapError(err, "AnswerStatus")
+					return
+				}
+				z.AnswerStatus = AnswerStatus(zb0002)
+			}
+		case "in":
+			z.Increment, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Increment")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z VoiceCDR) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "du"
+	err = en.Append(0x83, 0xa2, 0x64, 0x75)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Duration)
+	if err != nil {
+		err = msgp.WrapError(err, "Duration")
+		return
+	}
+	// write "as"
+	err = en.Append(0xa2, 0x61, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(int(z.AnswerStatus))
+	if err != nil {
+		err = msgp.WrapError(err, "AnswerStatus")
+		return
+	}
+	// write "in"
+	err = en.Append(0xa2, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Increment)
+	if err != nil {
+		err = msgp.WrapError(err, "Increment")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z VoiceCDR) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "du"
+	o = append(o, 0x83, 0xa2, 0x64, 0x75)
+	o = msgp.AppendUint64(o, z.Duration)
+	// string "as"
+	o = append(o, 0xa2, 0x61, 0x73)
+	o = msgp.AppendInt(o, int(z.AnswerStatus))
+	// string "in"
+	o = append(o, 0xa2, 0x69, 0x6e)
+	o = msgp.AppendUint64(o, z.Increment)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *VoiceCDR) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "du":
+			z.Duration, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Duration")
+				return
+			}
+		case "as":
+			{
+				var zb0002 int
+				zb0002, bts, err = msgp.ReadIntBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AnswerStatus")
+					return
+				}
+				z.AnswerStatus = AnswerStatus(zb0002)
+			}
+		case "in":
+			z.Increment, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Increment")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z VoiceCDR) Msgsize() (s int) {
+	s = 1 + 3 + msgp.Uint64Size + 3 + msgp.IntSize + 3 + msgp.Uint64Size
+	return
+}
@@ -341,6 +341,110 @@ func TestCDRParam_ToHash(t *testing.T) {
 	}
 }
 
+func TestCDRParam_Kind(t *testing.T) {
+	voice := cdrParam
+	voice.Kind = CDRKindVoice
+	voice.Voice = &VoiceCDR{Duration: 61, AnswerStatus: AnswerStatusAnswered}
+	data := cdrParam
+	data.Kind = CDRKindData
+	data.Data = &DataCDR{SessionId: "s1", Volume: 1<<20 + 1, Increment: 1 << 19}
+
+	tests := []struct {
+		name    string
+		param   func() CDRParam
+		units   string
+		status  bool
+		wantErr bool
+	}{
+		{
+			name:   "sms",
+			param:  func() CDRParam { return cdrParam },
+			units:  "1/1",
+			status: true,
+		}, {
+			name:   "voice",
+			param:  func() CDRParam { return voice },
+			units:  "2/1",
+			status: true,
+		}, {
+			name: "voice_increment",
+			param: func() CDRParam {
+				p := voice
+				p.Voice = &VoiceCDR{Duration: 61, AnswerStatus: AnswerStatusAnswered, Increment: 6}
+				return p
+			},
+			units:  "11/10",
+			status: true,
+		}, {
+			name: "voice_no_answer",
+			param: func() CDRParam {
+				p := voice
+				p.Voice = &VoiceCDR{AnswerStatus: AnswerStatusNoAnswer}
+				return p
+			},
+			units:  "0/1",
+			status: false,
+		}, {
+			name: "voice_without_detail",
+			param: func() CDRParam {
+				p := voice
+				p.Voice = nil
+				return p
+			},
+			units:   "0/1",
+			wantErr: true,
+		}, {
+			name:   "data",
+			param:  func() CDRParam { return data },
+			units:  "3/2",
+			status: true,
+		}, {
+			name: "data_without_session",
+			param: func() CDRParam {
+				p := data
+				p.Data = &DataCDR{Volume: 10}
+				return p
+			},
+			units:   "1/1024",
+			status:  true,
+			wantErr: true,
+		}, {
+			name: "invalid_kind",
+			param: func() CDRParam {
+				p := cdrParam
+				p.Kind = CDRKind(10)
+				return p
+			},
+			units:   "0/1",
+			status:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.param()
+			if err := p.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := p.Status(); got != tt.status {
+				t.Errorf("Status() = %v, want %v", got, tt.status)
+			}
+			if got := p.Units().String(); got != tt.units {
+				t.Errorf("Units() = %v, want %v", got, tt.units)
+			}
+		})
+	}
+
+	// sessions of the same index are different records
+	d2 := data
+	d2.Data = &DataCDR{SessionId: "s2", Volume: 1}
+	h1, _ := data.ToHash()
+	h2, _ := d2.ToHash()
+	if h1 == h2 {
+		t.Fatal("hash of sessions should be different")
+	}
+}
+
 func TestContractParam_FromABI(t *testing.T) {
 	cp := buildContractParam()
 
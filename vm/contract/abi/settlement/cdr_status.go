@@ -10,6 +10,7 @@ package settlement
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/util"
@@ -100,6 +101,42 @@ func (z *CDRStatus) State(addr *types.Address, fn func(status *CDRStatus) (strin
 	}
 }
 
+// Kind returns the kind of records, all parties should upload records of the same kind
+func (z *CDRStatus) Kind() CDRKind {
+	for _, params := range z.Params {
+		if len(params) > 0 {
+			return params[0].Kind
+		}
+	}
+	return CDRKindSms
+}
+
+// UnitsOf returns the billable units uploaded by addr, it is zero if the record of addr is not billable
+func (z *CDRStatus) UnitsOf(addr *types.Address) float64 {
+	if params, ok := z.Params[addr.String()]; ok && len(params) == 1 && params[0].Status() {
+		f, _ := params[0].Units().Float64()
+		return f
+	}
+	return 0
+}
+
+// Units returns the billable units which are agreed by all parties, it is the least one of the uploaded
+// records, so that the longer call duration or data volume reported by one party is not billed
+func (z *CDRStatus) Units() *big.Rat {
+	var units *big.Rat
+	for _, params := range z.Params {
+		if len(params) > 0 {
+			if u := params[0].Units(); units == nil || u.Cmp(units) < 0 {
+				units = u
+			}
+		}
+	}
+	if units == nil {
+		return new(big.Rat)
+	}
+	return units
+}
+
 func (z *CDRStatus) IsInCycle(start, end int64) bool {
 	if len(z.Params) == 0 {
 		return false
@@ -214,6 +251,7 @@ func (z *CDRStatus) DoSettlement(cdr SettlementCDR) (err error) {
 	case size == 2:
 		z.Status = SettlementStatusSuccess
 		b := true
+		kind := cdr.Kind
 		// combine all status
 		for _, params := range z.Params {
 			//for _, param := range params {
@@ -224,7 +262,8 @@ func (z *CDRStatus) DoSettlement(cdr SettlementCDR) (err error) {
 				z.Status = SettlementStatusDuplicate
 				return
 			case l == 1:
-				b = b && params[0].Status()
+				// records of different kinds can not be settled with each other
+				b = b && params[0].Status() && params[0].Kind == kind
 				break
 			}
 		}
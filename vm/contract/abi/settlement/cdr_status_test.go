@@ -8,6 +8,7 @@
 package settlement
 
 import (
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -283,6 +284,41 @@ func TestCDRStatus_DoSettlement(t *testing.T) {
 	}
 }
 
+func TestCDRStatus_DoSettlementKind(t *testing.T) {
+	a1 := mock.Address()
+	a2 := mock.Address()
+	voice := cdrParam
+	voice.Kind = CDRKindVoice
+	voice.Voice = &VoiceCDR{Duration: 30, AnswerStatus: AnswerStatusAnswered}
+	sms := cdrParam
+
+	s := &CDRStatus{}
+	if err := s.DoSettlement(SettlementCDR{CDRParam: voice, From: a1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DoSettlement(SettlementCDR{CDRParam: sms, From: a2}); err != nil {
+		t.Fatal(err)
+	}
+	if s.Status != SettlementStatusFailure {
+		t.Fatalf("records of different kinds should fail, got %s", s.Status)
+	}
+
+	long := voice
+	long.Voice = &VoiceCDR{Duration: 90, AnswerStatus: AnswerStatusAnswered}
+	s = &CDRStatus{}
+	_ = s.DoSettlement(SettlementCDR{CDRParam: voice, From: a1})
+	_ = s.DoSettlement(SettlementCDR{CDRParam: long, From: a2})
+	if s.Status != SettlementStatusSuccess || s.Kind() != CDRKindVoice {
+		t.Fatal(s.String())
+	}
+	if u := s.Units(); u.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Fatalf("invalid units %s", u)
+	}
+	if u := s.UnitsOf(&a2); u != 2 {
+		t.Fatalf("invalid units of %s: %f", a2, u)
+	}
+}
+
 func TestCDRStatus_FromABI(t *testing.T) {
 	status := CDRStatus{
 		Params: map[string][]CDRParam{
@@ -74,6 +74,16 @@ func (z *ContractParam) IsContractor(addr types.Address) bool {
 	return z.PartyA.Address == addr || z.PartyB.Address == addr
 }
 
+// ServiceByKind returns the first service of contract which settles records of kind
+func (z *ContractParam) ServiceByKind(kind CDRKind) (*ContractService, error) {
+	for i := range z.Services {
+		if z.Services[i].Kind == kind {
+			return &z.Services[i], nil
+		}
+	}
+	return nil, fmt.Errorf("can not find %s service of contract", kind.String())
+}
+
 func (z *ContractParam) ToABI() ([]byte, error) {
 	return z.MarshalMsg(nil)
 }
@@ -78,6 +78,22 @@ func TestContractParam_Equal(t *testing.T) {
 	}
 }
 
+func TestContractParam_ServiceByKind(t *testing.T) {
+	param := buildContractParam()
+	param.Services = []ContractService{param.Services[0], param.Services[1]}
+	param.Services[1].Kind = CDRKindData
+
+	if s, err := param.ServiceByKind(CDRKindSms); err != nil || s.ServiceId != param.Services[0].ServiceId {
+		t.Fatal(s, err)
+	}
+	if s, err := param.ServiceByKind(CDRKindData); err != nil || s.ServiceId != param.Services[1].ServiceId {
+		t.Fatal(s, err)
+	}
+	if _, err := param.ServiceByKind(CDRKindVoice); err == nil {
+		t.Fatal("voice service should not be found")
+	}
+}
+
 func TestContractParam_String(t *testing.T) {
 	param := buildContractParam()
 	s := param.String()
@@ -109,7 +125,7 @@ func TestContractService_ToABI(t *testing.T) {
 		Mcc:         1,
 		Mnc:         2,
 		TotalAmount: 100,
-		UnitPrice:   2,
+		UnitPrice:   types.NewMoney(2, 0, ""),
 		Currency:    "USD",
 	}
 	abi, err := param.ToABI()
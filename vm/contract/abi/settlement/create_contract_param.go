@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/tinylib/msgp/msgp"
 	"gopkg.in/validator.v2"
 
 	"github.com/qlcchain/go-qlc/common/types"
@@ -30,14 +31,18 @@ Rejected
 */
 type ContractStatus int
 
+// ContractServiceDeposit is the QGAS which is paid to the settlement contract for each service of a new contract
+var ContractServiceDeposit = types.Balance{Int: big.NewInt(1e8)}
+
 //go:generate msgp
 type ContractService struct {
-	ServiceId   string  `msg:"id" json:"serviceId" validate:"nonzero"`
-	Mcc         uint64  `msg:"mcc" json:"mcc"`
-	Mnc         uint64  `msg:"mnc" json:"mnc"`
-	TotalAmount uint64  `msg:"t" json:"totalAmount" validate:"min=1"`
-	UnitPrice   float64 `msg:"u" json:"unitPrice" validate:"nonzero"`
-	Currency    string  `msg:"c" json:"currency" validate:"nonzero"`
+	ServiceId   string      `msg:"id" json:"serviceId" validate:"nonzero"`
+	Mcc         uint64      `msg:"mcc" json:"mcc"`
+	Mnc         uint64      `msg:"mnc" json:"mnc"`
+	TotalAmount uint64      `msg:"t" json:"totalAmount" validate:"min=1"`
+	UnitPrice   types.Money `msg:"u" json:"unitPrice"`
+	Currency    string      `msg:"c" json:"currency" validate:"nonzero"`
+	Kind        CDRKind     `msg:"k" json:"kind,omitempty"`
 }
 
 func (z *ContractService) ToABI() ([]byte, error) {
@@ -49,8 +54,65 @@ func (z *ContractService) FromABI(data []byte) error {
 	return err
 }
 
+// Verify checks the service, currency of the unit price should be the same as the service if it is set
+func (z *ContractService) Verify() error {
+	if err := validator.Validate(z); err != nil {
+		return err
+	}
+	if z.UnitPrice.IsZero() {
+		return fmt.Errorf("invalid unit price of service %s", z.ServiceId)
+	}
+	if c := z.UnitPrice.Currency(); c != "" && c != z.Currency {
+		return fmt.Errorf("invalid currency of unit price, exp: %s, act: %s", z.Currency, c)
+	}
+	if _, ok := _CDRKindMap[z.Kind]; !ok {
+		return fmt.Errorf("invalid kind of service %s", z.ServiceId)
+	}
+	return nil
+}
+
+// Price returns the unit price in currency of the service
+func (z *ContractService) Price() types.Money {
+	return z.UnitPrice.In(z.Currency)
+}
+
+// Amount returns the total value of the service, TotalAmount units of UnitPrice rounded to the currency
+func (z *ContractService) Amount() types.Money {
+	return z.Price().Mul(int64(z.TotalAmount)).RoundCurrency()
+}
+
+// Balance returns the QGAS deposit of the service, it is not related to the price
 func (z *ContractService) Balance() (types.Balance, error) {
-	return types.Balance{Int: big.NewInt(1e8)}, nil
+	return ContractServiceDeposit.Copy(), nil
+}
+
+// addressABI encodes the service as it was when unit price was float, contract address is always hashed
+// by it, so addresses of contracts which were created before are kept, kind is only appended for voice
+// and data services
+func (z *ContractService) addressABI() []byte {
+	var o []byte
+	if z.Kind == CDRKindSms {
+		o = msgp.AppendMapHeader(nil, 6)
+	} else {
+		o = msgp.AppendMapHeader(nil, 7)
+	}
+	o = msgp.AppendString(o, "id")
+	o = msgp.AppendString(o, z.ServiceId)
+	o = msgp.AppendString(o, "mcc")
+	o = msgp.AppendUint64(o, z.Mcc)
+	o = msgp.AppendString(o, "mnc")
+	o = msgp.AppendUint64(o, z.Mnc)
+	o = msgp.AppendString(o, "t")
+	o = msgp.AppendUint64(o, z.TotalAmount)
+	o = msgp.AppendString(o, "u")
+	o = msgp.AppendFloat64(o, z.UnitPrice.Float64())
+	o = msgp.AppendString(o, "c")
+	o = msgp.AppendString(o, z.Currency)
+	if z.Kind != CDRKindSms {
+		o = msgp.AppendString(o, "k")
+		o = msgp.AppendInt(o, int(z.Kind))
+	}
+	return o
 }
 
 //go:generate msgp
@@ -83,7 +145,7 @@ func (z *CreateContractParam) Verify() (bool, error) {
 	}
 
 	for _, s := range z.Services {
-		if err := validator.Validate(s); err != nil {
+		if err := s.Verify(); err != nil {
 			return false, err
 		}
 	}
@@ -162,11 +224,7 @@ func (z *CreateContractParam) Address() (types.Address, error) {
 
 	result = append(result, z.Previous[:]...)
 	for _, s := range z.Services {
-		if data, err := s.ToABI(); err != nil {
-			return types.ZeroAddress, err
-		} else {
-			result = append(result, data...)
-		}
+		result = append(result, s.addressABI()...)
 	}
 	result = append(result, util.BE_Int2Bytes(z.SignDate)...)
 
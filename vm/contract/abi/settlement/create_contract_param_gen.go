@@ -49,7 +49,7 @@ func (z *ContractService) DecodeMsg(dc *msgp.Reader) (err error) {
 				return
 			}
 		case "u":
-			z.UnitPrice, err = dc.ReadFloat64()
+			err = z.UnitPrice.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "UnitPrice")
 				return
@@ -60,6 +60,12 @@ func (z *ContractService) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "Currency")
 				return
 			}
+		case "k":
+			err = z.Kind.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Kind")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -73,9 +79,9 @@ func (z *ContractService) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *ContractService) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 6
+	// map header, size 7
 	// write "id"
-	err = en.Append(0x86, 0xa2, 0x69, 0x64)
+	err = en.Append(0x87, 0xa2, 0x69, 0x64)
 	if err != nil {
 		return
 	}
@@ -119,7 +125,7 @@ func (z *ContractService) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
-	err = en.WriteFloat64(z.UnitPrice)
+	err = z.UnitPrice.EncodeMsg(en)
 	if err != nil {
 		err = msgp.WrapError(err, "UnitPrice")
 		return
@@ -134,15 +140,25 @@ func (z *ContractService) EncodeMsg(en *msgp.Writer) (err error) {
 		err = msgp.WrapError(err, "Currency")
 		return
 	}
+	// write "k"
+	err = en.Append(0xa1, 0x6b)
+	if err != nil {
+		return
+	}
+	err = z.Kind.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *ContractService) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 7
 	// string "id"
-	o = append(o, 0x86, 0xa2, 0x69, 0x64)
+	o = append(o, 0x87, 0xa2, 0x69, 0x64)
 	o = msgp.AppendString(o, z.ServiceId)
 	// string "mcc"
 	o = append(o, 0xa3, 0x6d, 0x63, 0x63)
@@ -155,10 +171,21 @@ func (z *ContractService) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.AppendUint64(o, z.TotalAmount)
 	// string "u"
 	o = append(o, 0xa1, 0x75)
-	o = msgp.AppendFloat64(o, z.UnitPrice)
+	o, err = z.UnitPrice.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "UnitPrice")
+		return
+	}
 	// string "c"
 	o = append(o, 0xa1, 0x63)
 	o = msgp.AppendString(o, z.Currency)
+	// string "k"
+	o = append(o, 0xa1, 0x6b)
+	o, err = z.Kind.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Kind")
+		return
+	}
 	return
 }
 
@@ -205,7 +232,7 @@ func (z *ContractService) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		case "u":
-			z.UnitPrice, bts, err = msgp.ReadFloat64Bytes(bts)
+			bts, err = z.UnitPrice.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "UnitPrice")
 				return
@@ -216,6 +243,12 @@ func (z *ContractService) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Currency")
 				return
 			}
+		case "k":
+			bts, err = z.Kind.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Kind")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -230,7 +263,7 @@ func (z *ContractService) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *ContractService) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.ServiceId) + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Float64Size + 2 + msgp.StringPrefixSize + len(z.Currency)
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ServiceId) + 4 + msgp.Uint64Size + 4 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + z.UnitPrice.Msgsize() + 2 + msgp.StringPrefixSize + len(z.Currency) + 2 + z.Kind.Msgsize()
 	return
 }
 
@@ -281,7 +281,7 @@ func TestCreateContractParam_Verify(t *testing.T) {
 						Mcc:         1,
 						Mnc:         2,
 						TotalAmount: 100,
-						UnitPrice:   2,
+						UnitPrice:   types.NewMoney(2, 0, ""),
 						Currency:    "USD",
 					},
 				},
@@ -322,7 +322,7 @@ func TestContractService_Balance(t *testing.T) {
 		Mcc         uint64
 		Mnc         uint64
 		TotalAmount uint64
-		UnitPrice   float64
+		UnitPrice   types.Money
 		Currency    string
 	}
 	tests := []struct {
@@ -338,7 +338,7 @@ func TestContractService_Balance(t *testing.T) {
 				Mcc:         22,
 				Mnc:         1,
 				TotalAmount: 100,
-				UnitPrice:   0.04,
+				UnitPrice:   types.NewMoney(4, 2, ""),
 				Currency:    "USD",
 			},
 			want:    types.Balance{Int: big.NewInt(1e8)},
@@ -367,6 +367,75 @@ func TestContractService_Balance(t *testing.T) {
 	}
 }
 
+func TestContractService_Amount(t *testing.T) {
+	price, _ := types.ParseMoney("0.0045")
+	z := &ContractService{
+		ServiceId:   mock.Hash().String(),
+		TotalAmount: 1001,
+		UnitPrice:   price,
+		Currency:    "USD",
+	}
+	if err := z.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	// 1001 * 0.0045 = 4.5045
+	if a := z.Amount(); a.String() != "4.50 USD" {
+		t.Fatal("invalid amount", a)
+	}
+
+	z.UnitPrice = price.In("EUR")
+	if err := z.Verify(); err == nil {
+		t.Fatal("currency of unit price should be the same as service")
+	}
+	z.UnitPrice = types.Money{}
+	if err := z.Verify(); err == nil {
+		t.Fatal("unit price should not be zero")
+	}
+}
+
+func TestContractService_LegacyPrice(t *testing.T) {
+	cp := createContractParam
+	cp.Services = []ContractService{cp.Services[0]}
+	cp.Services[0].UnitPrice, _ = types.ParseMoney("0.04")
+	address, err := cp.Address()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// service which was encoded when unit price was float
+	legacy := cp.Services[0].addressABI()
+	s := ContractService{}
+	if _, err := s.UnmarshalMsg(legacy); err != nil {
+		t.Fatal(err)
+	}
+	if !s.UnitPrice.Equal(cp.Services[0].UnitPrice) || s.Currency != "USD" {
+		t.Fatal("invalid legacy service", s)
+	}
+	cp.Services[0] = s
+	if a, _ := cp.Address(); a != address {
+		t.Fatal("address should be kept", a, address)
+	}
+}
+
+func TestContractService_Kind(t *testing.T) {
+	cp := createContractParam
+	cp.Services = []ContractService{cp.Services[0]}
+	sms, _ := cp.Address()
+
+	cp.Services[0].Kind = CDRKindVoice
+	if err := cp.Services[0].Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if voice, _ := cp.Address(); voice == sms {
+		t.Fatal("address of voice service should be different")
+	}
+
+	cp.Services[0].Kind = CDRKind(10)
+	if err := cp.Services[0].Verify(); err == nil {
+		t.Fatal("invalid kind should be rejected")
+	}
+}
+
 func TestCreateContractParam_Balance(t *testing.T) {
 	type fields struct {
 		PartyA    Contractor
@@ -400,14 +469,14 @@ func TestCreateContractParam_Balance(t *testing.T) {
 					Mcc:         1,
 					Mnc:         2,
 					TotalAmount: 100,
-					UnitPrice:   2,
+					UnitPrice:   types.NewMoney(2, 0, ""),
 					Currency:    "USD",
 				}, {
 					ServiceId:   mock.Hash().String(),
 					Mcc:         22,
 					Mnc:         1,
 					TotalAmount: 300,
-					UnitPrice:   4,
+					UnitPrice:   types.NewMoney(4, 0, ""),
 					Currency:    "USD",
 				}},
 				SignDate:  time.Now().Unix(),
@@ -21,9 +21,14 @@ type InvoiceRecord struct {
 	MCC                      uint64        `json:"mcc"`
 	MNC                      uint64        `json:"mnc"`
 	Currency                 string        `json:"currency"`
-	UnitPrice                float64       `json:"unitPrice"`
-	SumOfBillableSMSCustomer uint64        `json:"sumOfBillableSMSCustomer"`
-	SumOfTOTPrice            float64       `json:"sumOfTOTPrice"`
+	UnitPrice                types.Money   `json:"unitPrice"`
+	Kind                     CDRKind       `json:"kind"`
+	SumOfBillableSMSCustomer uint64        `json:"sumOfBillableSMSCustomer"` // billable records, messages, calls or sessions
+	SumOfBillableUnits       float64       `json:"sumOfBillableUnits"`       // messages, minutes or MB which are priced`
+	SumOfTOTPrice            types.Money   `json:"sumOfTOTPrice"`
+	SLAResults               []*SLAResult  `json:"slaResults,omitempty"`
+	Compensation             types.Money   `json:"compensation"`
+	SumOfNetPrice            types.Money   `json:"sumOfNetPrice"`
 }
 
 func sortInvoiceFun(r1, r2 *InvoiceRecord) bool {
@@ -10,6 +10,7 @@ package settlement
 import (
 	"time"
 
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/mock"
 )
 
@@ -30,14 +31,14 @@ var (
 				Mcc:         1,
 				Mnc:         2,
 				TotalAmount: 100,
-				UnitPrice:   2,
+				UnitPrice:   types.NewMoney(2, 0, ""),
 				Currency:    "USD",
 			}, {
 				ServiceId:   mock.Hash().String(),
 				Mcc:         22,
 				Mnc:         1,
 				TotalAmount: 300,
-				UnitPrice:   4,
+				UnitPrice:   types.NewMoney(4, 0, ""),
 				Currency:    "USD",
 			},
 		},
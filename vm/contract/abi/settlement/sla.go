@@ -0,0 +1,251 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/ledger"
+)
+
+// SLAResult is the evaluation of one asset SLA over a settlement cycle
+type SLAResult struct {
+	SLAType  SLAType     `json:"type"`
+	Priority uint        `json:"priority"`
+	Target   float64     `json:"target"`
+	Achieved float64     `json:"achieved"`
+	Breached bool        `json:"breached"`
+	Rate     float64     `json:"rate"`
+	Credit   types.Money `json:"credit"`
+}
+
+// slaMetric holds the achieved SLA values calculated from CDR status
+type slaMetric struct {
+	deliveredRate float64
+	// average latency in seconds
+	latency     float64
+	settled     int
+	latencySize int
+}
+
+func newSLAMetric(cdrs []*CDRStatus) *slaMetric {
+	m := &slaMetric{}
+	delivered := 0
+	var total int64
+	for _, cdr := range cdrs {
+		switch cdr.Status {
+		case SettlementStatusSuccess:
+			delivered++
+			m.settled++
+		case SettlementStatusFailure:
+			m.settled++
+		default:
+			continue
+		}
+
+		if len(cdr.Params) < 2 {
+			continue
+		}
+		var min, max int64
+		for _, params := range cdr.Params {
+			if len(params) == 0 {
+				continue
+			}
+			dt := params[0].SmsDt
+			if min == 0 || dt < min {
+				min = dt
+			}
+			if dt > max {
+				max = dt
+			}
+		}
+		total += max - min
+		m.latencySize++
+	}
+
+	if m.settled > 0 {
+		m.deliveredRate = float64(delivered) / float64(m.settled)
+	}
+	if m.latencySize > 0 {
+		m.latency = float64(total) / float64(m.latencySize)
+	}
+	return m
+}
+
+// percentOf returns the rate in percent as the decimal which is shown for the float
+func percentOf(rate float32) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(float64(rate), 'f', -1, 32))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r.Quo(r, big.NewRat(100, 1))
+}
+
+// evaluate checks the SLA against achieved value, for latency the SLA value is a time.Duration
+// and compensation bands are in seconds, for delivered rate both are fractions,
+// credit is rounded to the currency of amount
+func (z *SLA) evaluate(m *slaMetric, amount types.Money) *SLAResult {
+	result := &SLAResult{
+		SLAType:  z.SLAType,
+		Priority: z.Priority,
+	}
+
+	switch z.SLAType {
+	case SLATypeDeliveredRate:
+		if m.settled == 0 {
+			return nil
+		}
+		result.Target = float64(z.Value)
+		result.Achieved = m.deliveredRate
+		result.Breached = result.Achieved < result.Target
+	case SLATypeLatency:
+		if m.latencySize == 0 {
+			return nil
+		}
+		result.Target = time.Duration(z.Value).Seconds()
+		result.Achieved = m.latency
+		result.Breached = result.Achieved > result.Target
+	default:
+		return nil
+	}
+
+	if result.Breached {
+		for _, c := range z.Compensations {
+			if result.Achieved >= float64(c.Low) && result.Achieved < float64(c.High) {
+				result.Rate = float64(c.Rate)
+				result.Credit = amount.MulRat(percentOf(c.Rate), types.CurrencyPrecision(amount.Currency()))
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+// applySLAs evaluates all SLAs by priority and returns the results and the total credit,
+// which is capped at amount
+func applySLAs(slas []*SLA, m *slaMetric, amount types.Money) ([]*SLAResult, types.Money) {
+	var results []*SLAResult
+	for _, sla := range slas {
+		if r := sla.evaluate(m, amount); r != nil {
+			results = append(results, r)
+		}
+	}
+	if len(results) == 0 {
+		return nil, types.ZeroMoney(amount.Currency())
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Priority < results[j].Priority
+	})
+
+	credit := types.ZeroMoney(amount.Currency())
+	for _, r := range results {
+		if left := amount.Sub(credit); r.Credit.Cmp(left) > 0 {
+			r.Credit = left
+		}
+		credit = credit.Add(r.Credit)
+	}
+	return results, credit
+}
+
+// ServiceSLA is the SLA evaluation of a contract service in summary report, credit is relative to the billable
+// units of the service at its unit price, as the invoices of the same period
+type ServiceSLA struct {
+	ServiceId    string       `json:"serviceId"`
+	Kind         CDRKind      `json:"kind"`
+	Amount       types.Money  `json:"amount"`
+	SLAResults   []*SLAResult `json:"slaResults"`
+	Compensation types.Money  `json:"compensation"`
+}
+
+// serviceSLAMetric returns the asset of service and its SLA metric measured by cdrs of the same kind,
+// the metric is nil if the asset has no SLA
+func serviceSLAMetric(store ledger.Store, c *ContractParam, service *ContractService, cdrs []*CDRStatus,
+	start, end int64) (*Asset, *slaMetric) {
+	asset := findAsset(store, &c.PartyB.Address, service, start, end)
+	if asset == nil || len(asset.SLAs) == 0 {
+		return asset, nil
+	}
+	var records []*CDRStatus
+	for _, cdr := range cdrs {
+		if cdr.Kind() == service.Kind {
+			records = append(records, cdr)
+		}
+	}
+	return asset, newSLAMetric(records)
+}
+
+// summarySLAs evaluates SLAs of every service whose asset has SLA, billable units are counted as generateInvoices
+func summarySLAs(store ledger.Store, c *ContractParam, cdrs []*CDRStatus, fn func(*CDRStatus) (string, error),
+	start, end int64) []*ServiceSLA {
+	units := make(map[CDRKind]*big.Rat)
+	for _, cdr := range cdrs {
+		if cdr.Status != SettlementStatusSuccess {
+			continue
+		}
+		if _, err := fn(cdr); err != nil {
+			continue
+		}
+		k := cdr.Kind()
+		if _, ok := units[k]; !ok {
+			units[k] = new(big.Rat)
+		}
+		units[k].Add(units[k], cdr.Units())
+	}
+
+	var result []*ServiceSLA
+	for kind, u := range units {
+		service, err := c.ServiceByKind(kind)
+		if err != nil {
+			continue
+		}
+		asset, metric := serviceSLAMetric(store, c, service, cdrs, start, end)
+		if metric == nil {
+			continue
+		}
+		sla := &ServiceSLA{
+			ServiceId: service.ServiceId,
+			Kind:      kind,
+			Amount:    service.Price().MulRat(u, types.CurrencyPrecision(service.Currency)),
+		}
+		sla.SLAResults, sla.Compensation = applySLAs(asset.SLAs, metric, sla.Amount)
+		result = append(result, sla)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Kind < result[j].Kind
+	})
+	return result
+}
+
+// findAsset returns the first activated asset of owner which matches the service and overlaps [start, end]
+func findAsset(store ledger.Store, owner *types.Address, service *ContractService, start, end int64) *Asset {
+	params, err := GetAssertsByAddress(store, owner)
+	if err != nil {
+		return nil
+	}
+	for _, param := range params {
+		if param.Status != AssetStatusActivated {
+			continue
+		}
+		if start != 0 && end != 0 && (param.StartDate > end || param.EndDate < start) {
+			continue
+		}
+		for _, asset := range param.Assets {
+			if asset.Mcc == service.Mcc && asset.Mnc == service.Mnc {
+				return asset
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package settlement
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func buildSLACDRStatus(status SettlementStatus, latency int64) *CDRStatus {
+	dt := time.Now().Unix()
+	p1 := cdrParam
+	p1.SmsDt = dt
+	p2 := cdrParam
+	p2.SmsDt = dt + latency
+	return &CDRStatus{
+		Params: map[string][]CDRParam{
+			mock.Address().String(): {p1},
+			mock.Address().String(): {p2},
+		},
+		Status: status,
+	}
+}
+
+func TestNewSLAMetric(t *testing.T) {
+	var cdrs []*CDRStatus
+	for i := 0; i < 8; i++ {
+		cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusSuccess, 70))
+	}
+	cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusFailure, 70))
+	cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusFailure, 70))
+	// pending record is ignored
+	cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusStage1, 1000))
+
+	m := newSLAMetric(cdrs)
+	if m.settled != 10 || m.deliveredRate != 0.8 {
+		t.Fatalf("invalid delivered rate, %d, %f", m.settled, m.deliveredRate)
+	}
+	if m.latencySize != 10 || m.latency != 70 {
+		t.Fatalf("invalid latency, %d, %f", m.latencySize, m.latency)
+	}
+}
+
+func TestApplySLAs(t *testing.T) {
+	slas := assetParam.Assets[0].SLAs
+	m := &slaMetric{
+		deliveredRate: 0.85,
+		latency:       70,
+		settled:       10,
+		latencySize:   10,
+	}
+
+	amount := types.NewMoney(10000, 2, "USD")
+	results, credit := applySLAs(slas, m, amount)
+	if len(results) != 2 {
+		t.Fatalf("invalid results, %d", len(results))
+	}
+	if results[0].SLAType != SLATypeLatency || !results[0].Breached || math.Abs(results[0].Target-60) > 1e-3 || results[0].Rate != 20.5 {
+		t.Fatal("invalid latency result", results[0])
+	}
+	if results[1].SLAType != SLATypeDeliveredRate || !results[1].Breached || results[1].Rate != 5 {
+		t.Fatal("invalid delivered rate result", results[1])
+	}
+	if credit.String() != "25.50 USD" || results[0].Credit.String() != "20.50 USD" {
+		t.Fatalf("invalid credit, %s", credit)
+	}
+
+	// no breach
+	m.deliveredRate = 0.99
+	m.latency = 10
+	if results, credit := applySLAs(slas, m, amount); len(results) != 2 || !credit.IsZero() {
+		t.Fatal("invalid credit", credit)
+	}
+
+	// credit is capped at amount
+	capped := []*SLA{NewDeliveredRate(0.95, []*Compensation{{Low: 0, High: 0.9, Rate: 80}}),
+		NewLatency(time.Second, []*Compensation{{Low: 1, High: 100, Rate: 50}})}
+	m.deliveredRate = 0.5
+	m.latency = 10
+	if results, credit := applySLAs(capped, m, amount); !credit.Equal(amount) || results[1].Credit.String() != "50.00 USD" {
+		t.Fatal("invalid capped credit", credit)
+	}
+
+	if results, credit := applySLAs(slas, &slaMetric{}, amount); results != nil || !credit.IsZero() {
+		t.Fatal("invalid empty metric")
+	}
+}
+
+func TestFindAsset(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	template := assetParam
+	template.Owner.Address = mock.Address()
+	a := &template
+	if abi, err := a.ToABI(); err != nil {
+		t.Fatal(err)
+	} else if err = SaveAssetParam(ctx, abi); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	service := &ContractService{Mcc: 42, Mnc: 5}
+	if asset := findAsset(l, &a.Owner.Address, service, a.StartDate, a.EndDate); asset == nil || len(asset.SLAs) != 2 {
+		t.Fatal("can not find asset")
+	}
+	if asset := findAsset(l, &a.Owner.Address, service, a.EndDate+1, a.EndDate+100); asset != nil {
+		t.Fatal("asset should be out of date")
+	}
+	if asset := findAsset(l, &a.Owner.Address, &ContractService{Mcc: 1, Mnc: 1}, 0, 0); asset != nil {
+		t.Fatal("asset should not match")
+	}
+}
+
+func TestSummarySLAs(t *testing.T) {
+	teardownTestCase, l := setupTestCase(t)
+	defer teardownTestCase(t)
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+
+	c := buildContractParam()
+	c.Services = []ContractService{
+		{ServiceId: "sms", Mcc: 42, Mnc: 5, Kind: CDRKindSms, UnitPrice: types.NewMoney(2, 0, ""), Currency: "USD"},
+		{ServiceId: "voice", Mcc: 1, Mnc: 1, Kind: CDRKindVoice, UnitPrice: types.NewMoney(1, 0, ""), Currency: "USD"},
+	}
+
+	template := assetParam
+	template.Owner.Address = c.PartyB.Address
+	if abi, err := template.ToABI(); err != nil {
+		t.Fatal(err)
+	} else if err = SaveAssetParam(ctx, abi); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	var cdrs []*CDRStatus
+	for i := 0; i < 8; i++ {
+		cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusSuccess, 70))
+	}
+	cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusFailure, 70))
+	cdrs = append(cdrs, buildSLACDRStatus(SettlementStatusFailure, 70))
+
+	// only the sms service has asset with SLA, 8 messages at 2 USD are billed as the invoice
+	slas := summarySLAs(l, c, cdrs, customerFn, 0, 0)
+	if len(slas) != 1 || slas[0].ServiceId != "sms" || slas[0].Amount.String() != "16.00 USD" {
+		t.Fatal("invalid summary SLAs", slas)
+	}
+	results, credit := applySLAs(template.Assets[0].SLAs, newSLAMetric(cdrs), slas[0].Amount)
+	if len(slas[0].SLAResults) != len(results) || !slas[0].Compensation.Equal(credit) || credit.IsZero() {
+		t.Fatal("invalid compensation", slas[0].Compensation)
+	}
+
+	// records which are not billed to the sender are not counted
+	if slas := summarySLAs(l, c, cdrs, func(status *CDRStatus) (string, error) {
+		return "", errors.New("not billed")
+	}, 0, 0); len(slas) != 0 {
+		t.Fatal("invalid summary SLAs", slas)
+	}
+}
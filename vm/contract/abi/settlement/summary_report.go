@@ -18,6 +18,7 @@ type SummaryRecord struct {
 	Success uint64  `json:"success"`
 	Fail    uint64  `json:"fail"`
 	Result  float64 `json:"result"`
+	Units   float64 `json:"units"` // billable units of successful records, minutes for voice and MB for data
 }
 
 func (z *SummaryRecord) DoCalculate() *SummaryRecord {
@@ -51,7 +52,7 @@ func (z *CompareRecord) MarshalJSON() ([]byte, error) {
 	return json.Marshal(z.records)
 }
 
-func (z *CompareRecord) UpdateCounter(party string, isMatching, state bool) {
+func (z *CompareRecord) UpdateCounter(party string, isMatching, state bool, units float64) {
 	if _, ok := z.records[party]; !ok {
 		z.records[party] = &MatchingRecord{}
 	}
@@ -60,12 +61,14 @@ func (z *CompareRecord) UpdateCounter(party string, isMatching, state bool) {
 	if isMatching {
 		if state {
 			v.Matching.Success++
+			v.Matching.Units += units
 		} else {
 			v.Matching.Fail++
 		}
 	} else {
 		if state {
 			v.Orphan.Success++
+			v.Orphan.Units += units
 		} else {
 			v.Orphan.Fail++
 		}
@@ -79,33 +82,49 @@ func (z *CompareRecord) DoCalculate() {
 	}
 }
 
+// updateKind counts the record by its kind
+func updateKind(kinds map[string]*CompareRecord, kind CDRKind, party string, isMatching, state bool, units float64) {
+	k := kind.String()
+	if _, ok := kinds[k]; !ok {
+		kinds[k] = newCompareRecord()
+	}
+	kinds[k].UpdateCounter(party, isMatching, state, units)
+}
+
 type SummaryResult struct {
 	Contract *ContractParam            `json:"contract"`
 	Records  map[string]*CompareRecord `json:"records"`
+	Kinds    map[string]*CompareRecord `json:"kinds"`
 	Total    *CompareRecord            `json:"total"`
+	SLAs     []*ServiceSLA             `json:"slas,omitempty"`
 }
 
 func newSummaryResult() *SummaryResult {
 	return &SummaryResult{
 		Records: make(map[string]*CompareRecord),
+		Kinds:   make(map[string]*CompareRecord),
 		Total:   newCompareRecord(),
 	}
 }
 
-func (z *SummaryResult) UpdateState(sender, party string, isMatching, state bool) {
+func (z *SummaryResult) UpdateState(sender, party string, kind CDRKind, isMatching, state bool, units float64) {
 	if sender != "" {
 		if _, ok := z.Records[sender]; !ok {
 			z.Records[sender] = newCompareRecord()
 		}
-		z.Records[sender].UpdateCounter(party, isMatching, state)
+		z.Records[sender].UpdateCounter(party, isMatching, state, units)
 	}
-	z.Total.UpdateCounter(party, isMatching, state)
+	updateKind(z.Kinds, kind, party, isMatching, state, units)
+	z.Total.UpdateCounter(party, isMatching, state, units)
 }
 
 func (z *SummaryResult) DoCalculate() {
 	for k := range z.Records {
 		z.Records[k].DoCalculate()
 	}
+	for k := range z.Kinds {
+		z.Kinds[k].DoCalculate()
+	}
 	z.Total.DoCalculate()
 }
 
@@ -116,12 +135,14 @@ func (z *SummaryResult) String() string {
 type MultiPartySummaryResult struct {
 	Contracts []*ContractParam          `json:"contracts"`
 	Records   map[string]*CompareRecord `json:"records"`
+	Kinds     map[string]*CompareRecord `json:"kinds"`
 	Total     *CompareRecord            `json:"total"`
 }
 
 func newMultiPartySummaryResult() *MultiPartySummaryResult {
 	return &MultiPartySummaryResult{
 		Records: make(map[string]*CompareRecord),
+		Kinds:   make(map[string]*CompareRecord),
 		Total:   newCompareRecord(),
 	}
 }
@@ -130,17 +151,21 @@ func (z *MultiPartySummaryResult) DoCalculate() {
 	for k := range z.Records {
 		z.Records[k].DoCalculate()
 	}
+	for k := range z.Kinds {
+		z.Kinds[k].DoCalculate()
+	}
 	z.Total.DoCalculate()
 }
 
-func (z *MultiPartySummaryResult) UpdateState(sender, party string, isMatching, state bool) {
+func (z *MultiPartySummaryResult) UpdateState(sender, party string, kind CDRKind, isMatching, state bool, units float64) {
 	if sender != "" {
 		if _, ok := z.Records[sender]; !ok {
 			z.Records[sender] = newCompareRecord()
 		}
-		z.Records[sender].UpdateCounter(party, isMatching, state)
+		z.Records[sender].UpdateCounter(party, isMatching, state, units)
 	}
-	z.Total.UpdateCounter(party, isMatching, state)
+	updateKind(z.Kinds, kind, party, isMatching, state, units)
+	z.Total.UpdateCounter(party, isMatching, state, units)
 }
 
 func (z *MultiPartySummaryResult) String() string {
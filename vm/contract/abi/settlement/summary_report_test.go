@@ -21,25 +21,37 @@ func TestNewSummaryResult(t *testing.T) {
 	r := newSummaryResult()
 
 	for i := 0; i < 20; i++ {
-		r.UpdateState("WeChat", "partyA", i%3 == 0, i%2 == 0)
-		r.UpdateState("WeChat", "partyB", i%2 == 0, i%3 == 0)
-		r.UpdateState("Slack", "partyA", i%2 == 0, i%3 == 0)
-		r.UpdateState("Slack", "partyB", i%3 == 0, i%2 == 0)
+		r.UpdateState("WeChat", "partyA", CDRKindSms, i%3 == 0, i%2 == 0, 1)
+		r.UpdateState("WeChat", "partyB", CDRKindSms, i%2 == 0, i%3 == 0, 1)
+		r.UpdateState("Slack", "partyA", CDRKindVoice, i%2 == 0, i%3 == 0, 1.5)
+		r.UpdateState("Slack", "partyB", CDRKindVoice, i%3 == 0, i%2 == 0, 1.5)
 	}
 
 	r.DoCalculate()
 	t.Log(r.String())
+
+	if len(r.Kinds) != 2 {
+		t.Fatalf("invalid kinds %d", len(r.Kinds))
+	}
+	// voice calls of partyA succeed for i%3 == 0, 4 of them are matching (i%6 == 0) and 3 are orphan
+	voice := r.Kinds[CDRKindVoice.String()].records["partyA"]
+	if voice.Matching.Success != 4 || voice.Matching.Units != 6 || voice.Orphan.Success != 3 || voice.Orphan.Units != 4.5 {
+		t.Fatal(voice.Matching.String(), voice.Orphan.String())
+	}
+	if total := r.Total.records["partyA"]; total.Matching.Units+total.Orphan.Units != 10+10.5 {
+		t.Fatal(total.Matching.String(), total.Orphan.String())
+	}
 }
 
 func TestMultiPartySummaryResult_UpdateState(t *testing.T) {
 	r := newMultiPartySummaryResult()
 
 	for i := 0; i < 20; i++ {
-		r.UpdateState("WeChat", "partyB", i%2 == 0, i%3 == 0)
-		r.UpdateState("WeChat", "partyC", i%2 == 0, i%3 == 0)
-		r.UpdateState("Slack", "partyA", i%2 == 0, i%3 == 0)
-		r.UpdateState("Slack", "partyB", i%3 == 0, i%2 == 0)
-		r.UpdateState("Slack", "partyC", i%3 == 0, i%2 == 0)
+		r.UpdateState("WeChat", "partyB", CDRKindSms, i%2 == 0, i%3 == 0, 1)
+		r.UpdateState("WeChat", "partyC", CDRKindSms, i%2 == 0, i%3 == 0, 1)
+		r.UpdateState("Slack", "partyA", CDRKindData, i%2 == 0, i%3 == 0, 0.5)
+		r.UpdateState("Slack", "partyB", CDRKindData, i%3 == 0, i%2 == 0, 0.5)
+		r.UpdateState("Slack", "partyC", CDRKindData, i%3 == 0, i%2 == 0, 0.5)
 	}
 
 	r.DoCalculate()
@@ -42,6 +42,7 @@ var (
 	ErrInvalidAdmin     = errors.New("invalid admin")
 	ErrInvalidLen       = errors.New("invalid len")
 	ErrInvalidOperator  = errors.New("invalid operator")
+	ErrPovHeight        = errors.New("invalid pov height")
 )
 
 type BaseContract struct {
@@ -235,4 +236,6 @@ func init() {
 	RegisterContracts(contractaddress.PermissionAddress, PermissionContract)
 	RegisterContracts(contractaddress.PrivacyDemoKVAddress, PdkvContract)
 	RegisterContracts(contractaddress.PtmKeyKVAddress, PtmkeyContract)
+	RegisterContracts(contractaddress.MultiSigAddress, MultiSigContract)
+	RegisterContracts(contractaddress.TimeLockAddress, TimeLockContract)
 }
@@ -42,6 +42,7 @@ var (
 	ErrInvalidAdmin     = errors.New("invalid admin")
 	ErrInvalidLen       = errors.New("invalid len")
 	ErrInvalidOperator  = errors.New("invalid operator")
+	ErrPovHeight        = errors.New("invalid pov height")
 )
 
 type BaseContract struct {
@@ -238,4 +239,6 @@ func init() {
 	RegisterContracts(contractaddress.PtmKeyKVAddress, PtmkeyContract)
 	RegisterContracts(contractaddress.DoDSettlementAddress, DoDSettlementContract)
 	RegisterContracts(contractaddress.KYCAddress, KYCContract)
+	RegisterContracts(contractaddress.MultiSigAddress, MultiSigContract)
+	RegisterContracts(contractaddress.TimeLockAddress, TimeLockContract)
 }
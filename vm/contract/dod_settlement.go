@@ -1,12 +1,14 @@
 package contract
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/qlcchain/go-qlc/common/util"
 
 	"github.com/qlcchain/go-qlc/common"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
 	cfg "github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/vm/contract/abi"
 	"github.com/qlcchain/go-qlc/vm/vmstore"
@@ -64,6 +66,35 @@ var DoDSettlementContract = NewChainContract(
 				},
 			},
 		},
+		abi.MethodNameDoDSettleIssueInvoice: &DoDSettleIssueInvoice{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+				},
+			},
+		},
+		abi.MethodNameDoDSettlePayInvoice: &DoDSettlePayInvoice{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+					pending:   true,
+				},
+			},
+		},
+		abi.MethodNameDoDSettleRefundInvoice: &DoDSettleRefundInvoice{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+					pending:   true,
+				},
+			},
+		},
 	},
 	abi.DoDSettlementABI,
 	abi.JsonDoDSettlement,
@@ -1447,3 +1478,297 @@ func (up *DoDSettleUpdateProductInfo) DoGap(ctx *vmstore.VMContext, block *types
 
 	return common.ContractNoGap, nil, nil
 }
+
+// DoDSettleIssueInvoice records the invoice of order in billing period on chain, so the buyer can pay it by stable coin
+type DoDSettleIssueInvoice struct {
+	BaseContract
+}
+
+func (ii *DoDSettleIssueInvoice) DoGap(ctx *vmstore.VMContext, block *types.StateBlock) (common.ContractGapType, interface{}, error) {
+	return contractPovGap(ctx, block)
+}
+
+func (ii *DoDSettleIssueInvoice) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	if block.GetToken() != cfg.GasToken() {
+		return nil, nil, ErrToken
+	}
+
+	if amount, err := ctx.CalculateAmount(block); err != nil || !amount.IsZero() {
+		return nil, nil, errors.New("invalid issue amount")
+	}
+
+	param := new(abi.DoDSettleIssueInvoiceParam)
+	err := param.FromABI(block.GetPayload())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	param.Seller = block.Address
+	err = param.Verify(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := abi.DoDSettleGetInvoice(ctx, param.InvoiceId()); err == nil {
+		return nil, nil, fmt.Errorf("invoice %s already issued", param.InvoiceId())
+	}
+
+	now, err := dodSettlePovTime(ctx, block, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice, err := abi.DoDSettleCalcInvoice(ctx, param, now)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = abi.DoDSettleSetInvoice(ctx, invoice)
+	if err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	return nil, nil, nil
+}
+
+// DoDSettlePayInvoice pays invoice by its token, the applied amount is released to seller and
+// the amount exceeding outstanding can be refunded to payer
+type DoDSettlePayInvoice struct {
+	BaseContract
+}
+
+func (pi *DoDSettlePayInvoice) parse(ctx *vmstore.VMContext, block *types.StateBlock) (*abi.DoDSettleInvoiceInfo, error) {
+	param := new(abi.DoDSettlePayInvoiceParam)
+	if err := param.FromABI(block.GetPayload()); err != nil {
+		return nil, err
+	}
+
+	invoice, err := abi.DoDSettleGetInvoice(ctx, param.InvoiceId)
+	if err != nil {
+		return nil, fmt.Errorf("invoice %s not found", param.InvoiceId)
+	}
+
+	return invoice, nil
+}
+
+func (pi *DoDSettlePayInvoice) DoGap(ctx *vmstore.VMContext, block *types.StateBlock) (common.ContractGapType, interface{}, error) {
+	return contractPovGap(ctx, block)
+}
+
+func (pi *DoDSettlePayInvoice) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	invoice, err := pi.parse(ctx, block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if block.Address != invoice.Buyer.Address {
+		return nil, nil, ErrInvalidOperator
+	}
+
+	if block.Token != invoice.Token {
+		return nil, nil, ErrToken
+	}
+
+	amount, err := ctx.CalculateAmount(block)
+	if err != nil {
+		return nil, nil, ErrCalcAmount
+	}
+	if amount.Compare(types.ZeroBalance) != types.BalanceCompBigger {
+		return nil, nil, fmt.Errorf("invalid pay amount %s", amount)
+	}
+
+	// the block is processed again when its receive block is rolled back
+	if payment, err := abi.DoDSettleGetInvoicePayment(ctx, invoice.InvoiceId, block.Previous); err == nil {
+		key, info := pi.pending(block, invoice, payment)
+		return key, info, nil
+	}
+
+	paid, err := abi.DoDSettleGetInvoicePaid(ctx, invoice.InvoiceId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outstanding := invoice.TokenAmount.Sub(paid)
+	if outstanding.Int.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("invoice %s is already paid", invoice.InvoiceId)
+	}
+
+	applied := amount
+	if applied.Compare(outstanding) == types.BalanceCompBigger {
+		applied = outstanding
+	}
+
+	now, err := dodSettlePovTime(ctx, block, invoice.IssuedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payment := &abi.DoDSettleInvoicePayment{
+		PaymentId: block.Previous,
+		Payer:     block.Address,
+		Amount:    amount,
+		Applied:   applied,
+		Refund:    amount.Sub(applied),
+		PaidAt:    now,
+	}
+
+	err = abi.DoDSettleAddInvoicePayment(ctx, invoice.InvoiceId, payment)
+	if err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	key, info := pi.pending(block, invoice, payment)
+	return key, info, nil
+}
+
+func (pi *DoDSettlePayInvoice) pending(block *types.StateBlock, invoice *abi.DoDSettleInvoiceInfo,
+	payment *abi.DoDSettleInvoicePayment) (*types.PendingKey, *types.PendingInfo) {
+	return &types.PendingKey{
+			Address: invoice.Seller.Address,
+			Hash:    block.GetHash(),
+		}, &types.PendingInfo{
+			Source: contractaddress.DoDSettlementAddress,
+			Amount: payment.Applied,
+			Type:   invoice.Token,
+		}
+}
+
+func (pi *DoDSettlePayInvoice) GetTargetReceiver(ctx *vmstore.VMContext, block *types.StateBlock) (types.Address, error) {
+	invoice, err := pi.parse(ctx, block)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	return invoice.Seller.Address, nil
+}
+
+func (pi *DoDSettlePayInvoice) DoReceive(ctx *vmstore.VMContext, block, input *types.StateBlock) ([]*ContractBlock, error) {
+	invoice, err := pi.parse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := abi.DoDSettleGetInvoicePayment(ctx, invoice.InvoiceId, input.Previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractRewardBlock(ctx, block, input, invoice.Seller.Address, invoice.Token, payment.Applied), nil
+}
+
+// DoDSettleRefundInvoice returns the overpaid part of a payment to its payer
+type DoDSettleRefundInvoice struct {
+	BaseContract
+}
+
+func (ri *DoDSettleRefundInvoice) parse(ctx *vmstore.VMContext, block *types.StateBlock) (*abi.DoDSettleInvoiceInfo, *abi.DoDSettleInvoicePayment, error) {
+	param := new(abi.DoDSettleRefundInvoiceParam)
+	if err := param.FromABI(block.GetPayload()); err != nil {
+		return nil, nil, err
+	}
+
+	invoice, err := abi.DoDSettleGetInvoice(ctx, param.InvoiceId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invoice %s not found", param.InvoiceId)
+	}
+
+	payment, err := abi.DoDSettleGetInvoicePayment(ctx, param.InvoiceId, param.PaymentId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invoice, payment, nil
+}
+
+func (ri *DoDSettleRefundInvoice) DoGap(ctx *vmstore.VMContext, block *types.StateBlock) (common.ContractGapType, interface{}, error) {
+	return contractPovGap(ctx, block)
+}
+
+func (ri *DoDSettleRefundInvoice) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	if amount, err := ctx.CalculateAmount(block); err != nil || !amount.IsZero() {
+		return nil, nil, errors.New("invalid refund amount")
+	}
+
+	invoice, payment, err := ri.parse(ctx, block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if block.Address != payment.Payer {
+		return nil, nil, ErrInvalidOperator
+	}
+
+	if payment.Refund.IsZero() {
+		return nil, nil, fmt.Errorf("nothing to refund for payment %s", payment.PaymentId)
+	}
+
+	// the block is processed again when its receive block is rolled back
+	if refund, err := abi.DoDSettleGetInvoiceRefund(ctx, invoice.InvoiceId, payment.PaymentId); err == nil {
+		if refund.Previous == block.Previous {
+			key, info := ri.pending(block, invoice, payment)
+			return key, info, nil
+		}
+		return nil, nil, fmt.Errorf("payment %s is already refunded", payment.PaymentId)
+	}
+
+	now, err := dodSettlePovTime(ctx, block, invoice.IssuedAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refund := &abi.DoDSettleInvoiceRefund{Previous: block.Previous, RefundAt: now}
+	err = abi.DoDSettleSetInvoiceRefund(ctx, invoice.InvoiceId, payment.PaymentId, refund)
+	if err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	key, info := ri.pending(block, invoice, payment)
+	return key, info, nil
+}
+
+func (ri *DoDSettleRefundInvoice) pending(block *types.StateBlock, invoice *abi.DoDSettleInvoiceInfo,
+	payment *abi.DoDSettleInvoicePayment) (*types.PendingKey, *types.PendingInfo) {
+	return &types.PendingKey{
+			Address: payment.Payer,
+			Hash:    block.GetHash(),
+		}, &types.PendingInfo{
+			Source: contractaddress.DoDSettlementAddress,
+			Amount: payment.Refund,
+			Type:   invoice.Token,
+		}
+}
+
+func (ri *DoDSettleRefundInvoice) GetTargetReceiver(ctx *vmstore.VMContext, block *types.StateBlock) (types.Address, error) {
+	_, payment, err := ri.parse(ctx, block)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	return payment.Payer, nil
+}
+
+func (ri *DoDSettleRefundInvoice) DoReceive(ctx *vmstore.VMContext, block, input *types.StateBlock) ([]*ContractBlock, error) {
+	invoice, payment, err := ri.parse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := abi.DoDSettleGetInvoiceRefund(ctx, invoice.InvoiceId, payment.PaymentId)
+	if err != nil || refund.Previous != input.Previous {
+		return nil, fmt.Errorf("payment %s is not refunded by %s", payment.PaymentId, input.Previous)
+	}
+
+	return contractRewardBlock(ctx, block, input, payment.Payer, invoice.Token, payment.Refund), nil
+}
+
+// dodSettlePovTime returns the time of pov header cited by block, which should not be before notBefore,
+// blocks citing an unknown pov height wait for it by DoGap
+func dodSettlePovTime(ctx *vmstore.VMContext, block *types.StateBlock, notBefore int64) (int64, error) {
+	header, err := contractPovHeader(ctx, block, 0)
+	if err != nil {
+		return 0, err
+	}
+	now := int64(header.GetTimestamp())
+	if now < notBefore {
+		return 0, ErrPovHeight
+	}
+	return now, nil
+}
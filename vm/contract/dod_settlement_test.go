@@ -1,11 +1,14 @@
 package contract
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
 	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
 	cfg "github.com/qlcchain/go-qlc/config"
 	"github.com/qlcchain/go-qlc/mock"
 	"github.com/qlcchain/go-qlc/vm/contract/abi"
@@ -67,7 +70,7 @@ func TestDoDSettleCreateOrder_ProcessSend(t *testing.T) {
 					QuoteItemId:    "quoteItem1",
 					Bandwidth:      "200 Mbps",
 					BillingUnit:    abi.DoDSettleBillingUnitSecond,
-					Price:          1,
+					Price:          types.NewMoney(1, 0, ""),
 					ServiceClass:   abi.DoDSettleServiceClassSilver,
 					PaymentType:    abi.DoDSettlePaymentTypeStableCoin,
 					BillingType:    abi.DoDSettleBillingTypePAYG,
@@ -94,7 +97,7 @@ func TestDoDSettleCreateOrder_ProcessSend(t *testing.T) {
 					QuoteId:        "quote1",
 					QuoteItemId:    "quoteItem2",
 					Bandwidth:      "200 Mbps",
-					Price:          1,
+					Price:          types.NewMoney(1, 0, ""),
 					ServiceClass:   abi.DoDSettleServiceClassSilver,
 					PaymentType:    abi.DoDSettlePaymentTypeStableCoin,
 					BillingType:    abi.DoDSettleBillingTypeDOD,
@@ -695,7 +698,7 @@ func TestDoDSettleChangeOrder_ProcessSend(t *testing.T) {
 					ItemId:      "i1",
 					QuoteItemId: "qi1",
 					Bandwidth:   "100 Mbps",
-					Price:       10,
+					Price:       types.NewMoney(10, 0, ""),
 					StartTime:   time.Now().Unix(),
 				},
 			},
@@ -1422,3 +1425,264 @@ func TestDoDSettleUpdateProductInfo_DoGap(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestDoDSettleInvoice(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	pb, td := mock.GeneratePovBlockByFakePow(nil, 0)
+	if err := addLatestPovBlock(pb, td, l); err != nil {
+		t.Fatal(err)
+	}
+
+	buyer := account1.Address()
+	seller := account2.Address()
+	token := cfg.ChainToken()
+
+	// token info of chain token
+	mctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	data, err := mintage.MintageABI.PackVariable(mintage.VariableNameGenesisToken, token, "QLC", "QLC",
+		big.NewInt(1e16), uint8(8), buyer, big.NewInt(0), int64(0), buyer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mctx.SetStorage(contractaddress.MintageAddress[:], token[:], data); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(mctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	// confirmed order paid by stable coin
+	ctx := vmstore.NewVMContext(l, &contractaddress.DoDSettlementAddress)
+	internalId := mock.Hash()
+	order := abi.NewOrderInfo()
+	order.Buyer = &abi.DoDSettleUser{Address: buyer, Name: "B1"}
+	order.Seller = &abi.DoDSettleUser{Address: seller, Name: "S1"}
+	order.OrderId = "order1"
+	order.OrderType = abi.DoDSettleOrderTypeCreate
+	order.OrderState = abi.DoDSettleOrderStateSuccess
+	order.ContractState = abi.DoDSettleContractStateConfirmed
+	dynamic := abi.DoDSettleConnectionDynamicParam{
+		OrderId:     "order1",
+		OrderItemId: "oi1",
+		BillingType: abi.DoDSettleBillingTypeDOD,
+		PaymentType: abi.DoDSettlePaymentTypeStableCoin,
+		Currency:    "USD",
+		Price:       types.NewMoney(10, 0, ""),
+		Addition:    types.NewMoney(10, 0, ""),
+		StartTime:   1000,
+		EndTime:     9000,
+	}
+	order.Connections = append(order.Connections, &abi.DoDSettleConnectionParam{DoDSettleConnectionDynamicParam: dynamic})
+	if err := abi.DoDSettleUpdateOrder(ctx, order, internalId); err != nil {
+		t.Fatal(err)
+	}
+	orderKey := &abi.DoDSettleOrder{Seller: seller, OrderId: order.OrderId}
+	if err := ctx.SetStorage(nil, append([]byte{abi.DoDSettleDBTableOrderIdMap}, orderKey.Hash().Bytes()...),
+		internalId.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	otp := &abi.DoDSettleOrderToProduct{Seller: seller, OrderId: order.OrderId, OrderItemId: "oi1"}
+	if err := abi.DoDSettleUpdateConnection(ctx, &abi.DoDSettleConnectionInfo{Active: &dynamic}, otp.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	povHeight := pb.GetHeight()
+	send := func(address types.Address, token types.Hash, amount int64, data []byte) *types.StateBlock {
+		tm, err := l.GetTokenMeta(address, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &types.StateBlock{
+			Type:      types.ContractSend,
+			Token:     token,
+			Address:   address,
+			Balance:   tm.Balance.Sub(types.Balance{Int: big.NewInt(amount)}),
+			Previous:  tm.Header,
+			Link:      types.Hash(contractaddress.DoDSettlementAddress),
+			Vote:      types.ZeroBalance,
+			Network:   types.ZeroBalance,
+			Storage:   types.ZeroBalance,
+			Oracle:    types.ZeroBalance,
+			Data:      data,
+			PoVHeight: povHeight,
+		}
+	}
+
+	// issue at the next pov block
+	latest, td := mock.GeneratePovBlockByFakePow(pb, 0)
+	if err := addLatestPovBlock(latest, td, l); err != nil {
+		t.Fatal(err)
+	}
+	povHeight = latest.GetHeight()
+	ip := &abi.DoDSettleIssueInvoiceParam{OrderId: order.OrderId, Token: token, StartTime: 1000, EndTime: 9000,
+		DueTime: 10000}
+	data, err = ip.ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	issue := DoDSettlementContract.m[abi.MethodNameDoDSettleIssueInvoice]
+	pay := DoDSettlementContract.m[abi.MethodNameDoDSettlePayInvoice]
+	if _, _, err := issue.ProcessSend(ctx, send(seller, token, 0, data)); err != ErrToken {
+		t.Fatal(err)
+	}
+	if _, _, err := issue.ProcessSend(ctx, send(buyer, cfg.GasToken(), 0, data)); err == nil {
+		t.Fatal("only seller of order can issue invoice")
+	}
+	if key, info, err := issue.ProcessSend(ctx, send(seller, cfg.GasToken(), 0, data)); err != nil {
+		t.Fatal(err)
+	} else if key != nil || info != nil {
+		t.Fatal("issue should not have pending")
+	}
+	if _, _, err := issue.ProcessSend(ctx, send(seller, cfg.GasToken(), 0, data)); err == nil {
+		t.Fatal("invoice should not be issued twice")
+	}
+	ip.Seller = seller
+	invoice, err := abi.DoDSettleGetInvoice(ctx, ip.InvoiceId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.TokenAmount.Compare(types.Balance{Int: big.NewInt(10e8)}) != types.BalanceCompEqual ||
+		invoice.IssuedAt != int64(latest.GetTimestamp()) {
+		t.Fatal("invalid invoice", invoice)
+	}
+
+	// payment can not be backdated before the invoice is issued
+	data, err = (&abi.DoDSettlePayInvoiceParam{InvoiceId: invoice.InvoiceId}).ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	povHeight = pb.GetHeight()
+	if _, _, err := pay.ProcessSend(ctx, send(buyer, token, 4e8, data)); err != ErrPovHeight {
+		t.Fatal(err)
+	}
+
+	// payment by a pov height which is not known yet waits for the pov block
+	povHeight = latest.GetHeight() + 1
+	if gap, height, err := pay.DoGap(ctx, send(buyer, token, 4e8, data)); err != nil ||
+		gap != common.ContractRewardGapPov || height.(uint64) != povHeight {
+		t.Fatal(gap, height, err)
+	}
+	povHeight = latest.GetHeight()
+
+	// partial payment
+	data, err = (&abi.DoDSettlePayInvoiceParam{InvoiceId: invoice.InvoiceId}).ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := pay.ProcessSend(ctx, send(buyer, cfg.GasToken(), 4e8, data)); err != ErrToken {
+		t.Fatal(err)
+	}
+	if _, _, err := pay.ProcessSend(ctx, send(seller, token, 0, data)); err != ErrInvalidOperator {
+		t.Fatal(err)
+	}
+	payBlk := send(buyer, token, 4e8, data)
+	key, info, err := pay.ProcessSend(ctx, payBlk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Address != seller || info.Amount.Compare(types.Balance{Int: big.NewInt(4e8)}) != types.BalanceCompEqual ||
+		info.Type != token {
+		t.Fatal("invalid pending", key, info)
+	}
+	if key2, info2, err := pay.ProcessSend(ctx, payBlk); err != nil || key2.Hash != key.Hash ||
+		info2.Amount.Compare(info.Amount) != types.BalanceCompEqual {
+		t.Fatal("payment should be processed again", err)
+	}
+	if addr, err := pay.GetTargetReceiver(ctx, payBlk); err != nil || addr != seller {
+		t.Fatal(addr, err)
+	}
+	blocks, err := pay.DoReceive(ctx, &types.StateBlock{}, payBlk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 1 || blocks[0].ToAddress != seller || blocks[0].Block.Type != types.ContractReward ||
+		blocks[0].Amount.Compare(types.Balance{Int: big.NewInt(4e8)}) != types.BalanceCompEqual {
+		t.Fatal("invalid reward")
+	}
+
+	ps, err := abi.DoDSettleGetInvoicePaymentStatus(ctx, invoice.InvoiceId, invoice.DueTime+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.Status != abi.DoDSettleInvoiceStatusPartial || ps.Dunning != abi.DoDSettleDunningStateOverdue {
+		t.Fatal("invalid status", ps)
+	}
+
+	// overpay, the exceeding amount is refundable
+	if err := updateBlock(l, payBlk); err != nil {
+		t.Fatal(err)
+	}
+	payBlk2 := send(buyer, token, 8e8, data)
+	if key, info, err = pay.ProcessSend(ctx, payBlk2); err != nil {
+		t.Fatal(err)
+	} else if info.Amount.Compare(types.Balance{Int: big.NewInt(6e8)}) != types.BalanceCompEqual {
+		t.Fatal("invalid applied amount", info.Amount)
+	}
+	if err := updateBlock(l, payBlk2); err != nil {
+		t.Fatal(err)
+	}
+	payBlk3 := send(buyer, token, 1e8, data)
+	if _, _, err := pay.ProcessSend(ctx, payBlk3); err == nil {
+		t.Fatal("invoice is paid")
+	}
+
+	ps, err = abi.DoDSettleGetInvoicePaymentStatus(ctx, invoice.InvoiceId, invoice.DueTime+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ps.Status != abi.DoDSettleInvoiceStatusPaid || ps.Dunning != abi.DoDSettleDunningStateSettled ||
+		ps.Refundable.Compare(types.Balance{Int: big.NewInt(2e8)}) != types.BalanceCompEqual {
+		t.Fatal("invalid status", ps)
+	}
+
+	// refund
+	refund := DoDSettlementContract.m[abi.MethodNameDoDSettleRefundInvoice]
+	data, err = (&abi.DoDSettleRefundInvoiceParam{InvoiceId: invoice.InvoiceId, PaymentId: payBlk.Previous}).ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := refund.ProcessSend(ctx, send(buyer, token, 0, data)); err == nil {
+		t.Fatal("nothing to refund for the first payment")
+	}
+	data, err = (&abi.DoDSettleRefundInvoiceParam{InvoiceId: invoice.InvoiceId, PaymentId: payBlk2.Previous}).ToABI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := refund.ProcessSend(ctx, send(seller, cfg.GasToken(), 0, data)); err != ErrInvalidOperator {
+		t.Fatal(err)
+	}
+	refundBlk := send(buyer, token, 0, data)
+	key, info, err = refund.ProcessSend(ctx, refundBlk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Address != buyer || info.Amount.Compare(types.Balance{Int: big.NewInt(2e8)}) != types.BalanceCompEqual {
+		t.Fatal("invalid pending", key, info)
+	}
+	if _, _, err := refund.ProcessSend(ctx, refundBlk); err != nil {
+		t.Fatal("refund should be processed again", err)
+	}
+	if err := updateBlock(l, refundBlk); err != nil {
+		t.Fatal(err)
+	}
+	refundBlk2 := send(buyer, token, 0, data)
+	if _, _, err := refund.ProcessSend(ctx, refundBlk2); err == nil {
+		t.Fatal("payment should not be refunded twice")
+	}
+	if addr, err := refund.GetTargetReceiver(ctx, refundBlk); err != nil || addr != buyer {
+		t.Fatal(addr, err)
+	}
+	if blocks, err := refund.DoReceive(ctx, &types.StateBlock{}, refundBlk); err != nil {
+		t.Fatal(err)
+	} else if len(blocks) != 1 || blocks[0].ToAddress != buyer || blocks[0].Token != token {
+		t.Fatal("invalid reward")
+	}
+	if _, err := refund.DoReceive(ctx, &types.StateBlock{}, refundBlk2); err == nil {
+		t.Fatal("refund is not made by the block")
+	}
+}
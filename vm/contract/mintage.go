@@ -48,20 +48,70 @@ var MintageContract = NewChainContract(
 				},
 			},
 		},
+		mintage2.MethodNameMintageWithAuthority: &Mintage{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer1,
+					signature: true,
+					work:      true,
+				},
+			},
+		},
+		mintage2.MethodNameMintageIssue: &IssueMintage{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer1,
+					signature: true,
+					pending:   true,
+					work:      true,
+				},
+			},
+		},
+		mintage2.MethodNameMintageBurn: &BurnMintage{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+				},
+			},
+		},
+		mintage2.MethodNameMintageFreeze: &FreezeMintage{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+				},
+			},
+			frozen: true,
+		},
+		mintage2.MethodNameMintageUnfreeze: &FreezeMintage{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+				},
+			},
+			frozen: false,
+		},
 	},
 	mintage2.MintageABI,
 	mintage2.JsonMintage,
 )
 
 func (m *Mintage) DoSend(ctx *vmstore.VMContext, block *types.StateBlock) error {
-	param := new(mintage.ParamMintage)
-	err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintage, block.Data)
+	param, err := mintage.ParseMintageParam(block.Data)
 	if err != nil {
 		return err
 	}
 	if err = verifyToken(*param); err != nil {
 		return err
 	}
+	if err = verifyAuthority(*param); err != nil {
+		return err
+	}
 
 	tokenId := mintage.NewTokenHash(block.Address, block.Previous, param.TokenName)
 	if _, err = ctx.GetTokenById(tokenId); err == nil {
@@ -76,20 +126,26 @@ func (m *Mintage) DoSend(ctx *vmstore.VMContext, block *types.StateBlock) error
 		}
 	}
 
-	if block.Data, err = mintage.MintageABI.PackMethod(
-		mintage.MethodNameMintage,
-		tokenId,
-		param.TokenName,
-		param.TokenSymbol,
-		param.TotalSupply,
-		param.Decimals,
-		param.Beneficial,
-		param.NEP5TxId); err != nil {
+	param.TokenId = tokenId
+	if block.Data, err = mintage.PackMintageParam(param); err != nil {
 		return err
 	}
 	return nil
 }
 
+func verifyAuthority(param mintage.ParamMintage) error {
+	if !param.HasAuthority() {
+		return nil
+	}
+	if param.Flags&^mintage.AuthorityAll != 0 {
+		return fmt.Errorf("invalid authority flags %d", param.Flags)
+	}
+	if param.Issuer.IsZero() {
+		return errors.New("invalid token issuer")
+	}
+	return nil
+}
+
 func verifyToken(param mintage.ParamMintage) error {
 	if param.TotalSupply.Cmp(util.Tt256m1) > 0 ||
 		//param.TotalSupply.Cmp(new(big.Int).Exp(util.Big10, new(big.Int).SetUint64(uint64(param.Decimals)), nil)) < 0 ||
@@ -107,8 +163,7 @@ func verifyToken(param mintage.ParamMintage) error {
 }
 
 func (m *Mintage) DoPending(block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
-	param := new(mintage.ParamMintage)
-	err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintage, block.Data)
+	param, err := mintage.ParseMintageParam(block.Data)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -124,14 +179,15 @@ func (m *Mintage) DoPending(block *types.StateBlock) (*types.PendingKey, *types.
 }
 
 func (m *Mintage) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
-	param := new(mintage.ParamMintage)
-	_ = mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintage, input.Data)
+	param, err := mintage.ParseMintageParam(input.Data)
+	if err != nil {
+		return nil, err
+	}
 	var tokenInfo []byte
 	amount, _ := ctx.CalculateAmount(input)
 	if amount.Sign() > 0 &&
 		amount.Compare(types.Balance{Int: MinPledgeAmount}) != types.BalanceCompSmaller &&
 		input.Token == cfg.ChainToken() {
-		var err error
 		tokenInfo, err = mintage.MintageABI.PackVariable(
 			mintage.VariableNameToken,
 			param.TokenId,
@@ -183,6 +239,17 @@ func (m *Mintage) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, inp
 		}
 	}
 
+	if param.HasAuthority() {
+		authority, err := mintage.MintageABI.PackVariable(mintage.VariableNameTokenAuthority, param.TokenId,
+			param.Issuer, param.Flags, big.NewInt(0), big.NewInt(0))
+		if err != nil {
+			return nil, err
+		}
+		if err := ctx.SetStorage(contractaddress.MintageAddress[:], mintage.GetTokenAuthorityKey(param.TokenId), authority); err != nil {
+			return nil, err
+		}
+	}
+
 	return []*ContractBlock{
 		{
 			VMContext: ctx,
@@ -201,7 +268,7 @@ func (m *Mintage) GetTargetReceiver(ctx *vmstore.VMContext, block *types.StateBl
 	tr := types.ZeroAddress
 
 	if method, err := mintage.MintageABI.MethodById(data[0:4]); err == nil {
-		if method.Name == mintage.MethodNameMintage {
+		if mintage.IsMintageMethod(method.Name) {
 			param := new(mintage.ParamMintage)
 			if err = method.Inputs.Unpack(param, data[4:]); err == nil {
 				tr = param.Beneficial
@@ -0,0 +1,321 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/util"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+var (
+	ErrTokenAuthority = errors.New("token issuer has no such authority")
+	ErrTokenIssuer    = errors.New("invalid token issuer")
+	ErrTokenFrozen    = errors.New("account is frozen for token")
+	ErrKYCNotApproved = errors.New("kyc of account is not approved")
+)
+
+// GetTokenAuthority returns the issuer authority of token, ctx should be of mintage contract
+func GetTokenAuthority(ctx *vmstore.VMContext, tokenId types.Hash) (*mintage.TokenAuthority, error) {
+	data, err := ctx.GetStorage(contractaddress.MintageAddress[:], mintage.GetTokenAuthorityKey(tokenId))
+	if err != nil {
+		return nil, err
+	}
+	return mintage.ParseTokenAuthority(data)
+}
+
+// IsTokenFrozen returns true if the issuer froze the account for token
+func IsTokenFrozen(ctx *vmstore.VMContext, tokenId types.Hash, account types.Address) bool {
+	data, err := ctx.GetStorage(contractaddress.MintageAddress[:], mintage.GetTokenFreezeKey(tokenId, account))
+	if err != nil {
+		return false
+	}
+	freeze, err := mintage.ParseTokenFreeze(data)
+	if err != nil {
+		return false
+	}
+	return freeze.Frozen
+}
+
+// ListFrozenAccounts returns all accounts frozen for token
+func ListFrozenAccounts(ctx *vmstore.VMContext, tokenId types.Hash) ([]types.Address, error) {
+	accounts := make([]types.Address, 0)
+	prefix := append(contractaddress.MintageAddress[:], mintage.GetTokenFreezePrefix(tokenId)...)
+	err := ctx.Iterator(prefix, func(key []byte, value []byte) error {
+		freeze, err := mintage.ParseTokenFreeze(value)
+		if err != nil {
+			return err
+		}
+		if freeze.Frozen {
+			account, err := types.BytesToAddress(key[len(prefix):])
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, account)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// CheckTokenSend checks both sender and receiver are allowed to transfer the token by its issuer authority
+func CheckTokenSend(ctx *vmstore.VMContext, tokenId types.Hash, from, to types.Address) error {
+	authority, err := GetTokenAuthority(ctx, tokenId)
+	if err != nil {
+		if err == vmstore.ErrStorageNotFound {
+			return nil
+		}
+		return err
+	}
+	if err := checkTokenAccount(ctx, authority, from); err != nil {
+		return err
+	}
+	return checkTokenAccount(ctx, authority, to)
+}
+
+// CheckTokenReceive checks the receiver is allowed to receive the token by its issuer authority
+func CheckTokenReceive(ctx *vmstore.VMContext, tokenId types.Hash, account types.Address) error {
+	authority, err := GetTokenAuthority(ctx, tokenId)
+	if err != nil {
+		if err == vmstore.ErrStorageNotFound {
+			return nil
+		}
+		return err
+	}
+	return checkTokenAccount(ctx, authority, account)
+}
+
+func checkTokenAccount(ctx *vmstore.VMContext, authority *mintage.TokenAuthority, account types.Address) error {
+	// contracts hold tokens by their own rules
+	if contractaddress.IsContractAddress(account) {
+		return nil
+	}
+	if authority.Has(mintage.AuthorityFreeze) && IsTokenFrozen(ctx, authority.TokenId, account) {
+		return fmt.Errorf("%s: %s", ErrTokenFrozen, account)
+	}
+	if authority.Has(mintage.AuthorityKYC) && account != authority.Issuer && !abi.KYCIsApproved(ctx, account) {
+		return fmt.Errorf("%s: %s", ErrKYCNotApproved, account)
+	}
+	return nil
+}
+
+func verifyIssuer(ctx *vmstore.VMContext, tokenId types.Hash, issuer types.Address, flag uint8) (*mintage.TokenAuthority, error) {
+	authority, err := GetTokenAuthority(ctx, tokenId)
+	if err != nil {
+		return nil, fmt.Errorf("get authority of token %s: %s", tokenId, err)
+	}
+	if authority.Issuer != issuer {
+		return nil, ErrTokenIssuer
+	}
+	if !authority.Has(flag) {
+		return nil, ErrTokenAuthority
+	}
+	return authority, nil
+}
+
+func saveTokenAuthority(ctx *vmstore.VMContext, authority *mintage.TokenAuthority) error {
+	data, err := mintage.MintageABI.PackVariable(mintage.VariableNameTokenAuthority, authority.TokenId,
+		authority.Issuer, authority.Flags, authority.Issued, authority.Burned)
+	if err != nil {
+		return err
+	}
+	return ctx.SetStorage(contractaddress.MintageAddress[:], mintage.GetTokenAuthorityKey(authority.TokenId), data)
+}
+
+// IssueMintage issues more token to beneficial by issuer
+type IssueMintage struct {
+	BaseContract
+}
+
+func (m *IssueMintage) verify(ctx *vmstore.VMContext, issuer types.Address, param *mintage.ParamIssue) (*mintage.TokenAuthority, error) {
+	if param.Amount == nil || param.Amount.Sign() <= 0 {
+		return nil, ErrCheckParam
+	}
+	authority, err := verifyIssuer(ctx, param.TokenId, issuer, mintage.AuthorityIssue)
+	if err != nil {
+		return nil, err
+	}
+	if new(big.Int).Add(authority.Issued, param.Amount).Cmp(util.Tt256m1) > 0 {
+		return nil, errors.New("issued amount overflow")
+	}
+	if err := CheckTokenReceive(ctx, param.TokenId, param.Beneficial); err != nil {
+		return nil, err
+	}
+	return authority, nil
+}
+
+func (m *IssueMintage) DoSend(ctx *vmstore.VMContext, block *types.StateBlock) error {
+	if amount, err := ctx.CalculateAmount(block); block.Type != types.ContractSend || err != nil ||
+		amount.Compare(types.ZeroBalance) != types.BalanceCompEqual {
+		return errors.New("invalid block")
+	}
+
+	param := new(mintage.ParamIssue)
+	if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintageIssue, block.Data); err != nil {
+		return ErrUnpackMethod
+	}
+	if _, err := m.verify(ctx, block.Address, param); err != nil {
+		return err
+	}
+
+	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintageIssue, param.TokenId, param.Beneficial, param.Amount)
+	if err != nil {
+		return ErrPackMethod
+	}
+	block.Data = data
+	return nil
+}
+
+func (m *IssueMintage) DoPending(block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	param := new(mintage.ParamIssue)
+	if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintageIssue, block.Data); err != nil {
+		return nil, nil, err
+	}
+
+	return &types.PendingKey{
+			Address: param.Beneficial,
+			Hash:    block.GetHash(),
+		}, &types.PendingInfo{
+			Source: types.Address(block.Link),
+			Amount: types.Balance{Int: param.Amount},
+			Type:   param.TokenId,
+		}, nil
+}
+
+func (m *IssueMintage) GetTargetReceiver(ctx *vmstore.VMContext, block *types.StateBlock) (types.Address, error) {
+	param := new(mintage.ParamIssue)
+	if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintageIssue, block.Data); err != nil {
+		return types.ZeroAddress, err
+	}
+	return param.Beneficial, nil
+}
+
+func (m *IssueMintage) DoReceive(ctx *vmstore.VMContext, block, input *types.StateBlock) ([]*ContractBlock, error) {
+	param := new(mintage.ParamIssue)
+	if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintageIssue, input.Data); err != nil {
+		return nil, err
+	}
+	authority, err := m.verify(ctx, input.Address, param)
+	if err != nil {
+		return nil, err
+	}
+
+	authority.Issued = new(big.Int).Add(authority.Issued, param.Amount)
+	if err := saveTokenAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	return contractRewardBlock(ctx, block, input, param.Beneficial, param.TokenId, types.Balance{Int: param.Amount}), nil
+}
+
+// BurnMintage destroys token sent by issuer
+type BurnMintage struct {
+	BaseContract
+}
+
+func (m *BurnMintage) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	param := new(mintage.ParamBurn)
+	if err := mintage.MintageABI.UnpackMethod(param, mintage.MethodNameMintageBurn, block.Data); err != nil {
+		return nil, nil, ErrUnpackMethod
+	}
+	if block.GetToken() != param.TokenId {
+		return nil, nil, ErrToken
+	}
+	if param.Amount == nil || param.Amount.Sign() <= 0 {
+		return nil, nil, ErrCheckParam
+	}
+
+	authority, err := verifyIssuer(ctx, param.TokenId, block.Address, mintage.AuthorityBurn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	amount, err := ctx.CalculateAmount(block)
+	if err != nil {
+		return nil, nil, ErrCalcAmount
+	}
+	if amount.Compare(types.Balance{Int: param.Amount}) != types.BalanceCompEqual {
+		return nil, nil, fmt.Errorf("amount mismatch, exp: %s, act: %s", param.Amount, amount)
+	}
+
+	authority.Burned = new(big.Int).Add(authority.Burned, param.Amount)
+	if err := saveTokenAuthority(ctx, authority); err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintageBurn, param.TokenId, param.Amount)
+	if err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	block.Data = data
+	return nil, nil, nil
+}
+
+// FreezeMintage freezes or unfreezes an account of token by issuer
+type FreezeMintage struct {
+	BaseContract
+	frozen bool
+}
+
+func (m *FreezeMintage) methodName() string {
+	if m.frozen {
+		return mintage.MethodNameMintageFreeze
+	}
+	return mintage.MethodNameMintageUnfreeze
+}
+
+func (m *FreezeMintage) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	if block.GetToken() != cfg.ChainToken() {
+		return nil, nil, ErrToken
+	}
+
+	param := new(mintage.ParamFreeze)
+	if err := mintage.MintageABI.UnpackMethod(param, m.methodName(), block.Data); err != nil {
+		return nil, nil, ErrUnpackMethod
+	}
+	if param.Account.IsZero() {
+		return nil, nil, ErrCheckParam
+	}
+
+	authority, err := verifyIssuer(ctx, param.TokenId, block.Address, mintage.AuthorityFreeze)
+	if err != nil {
+		return nil, nil, err
+	}
+	if param.Account == authority.Issuer {
+		return nil, nil, errors.New("issuer can not be frozen")
+	}
+	if IsTokenFrozen(ctx, param.TokenId, param.Account) == m.frozen {
+		return nil, nil, fmt.Errorf("frozen status of %s is already %t", param.Account, m.frozen)
+	}
+
+	freeze, err := mintage.MintageABI.PackVariable(mintage.VariableNameTokenFreeze, m.frozen)
+	if err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	if err := ctx.SetStorage(contractaddress.MintageAddress[:], mintage.GetTokenFreezeKey(param.TokenId, param.Account), freeze); err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	data, err := mintage.MintageABI.PackMethod(m.methodName(), param.TokenId, param.Account)
+	if err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	block.Data = data
+	return nil, nil, nil
+}
@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/crypto/random"
+	"github.com/qlcchain/go-qlc/ledger"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func mintageWithAuthority(t *testing.T, l *ledger.Ledger, issuer types.Address, flags uint8) (*types.TokenMeta, types.Hash) {
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	tm, err := l.GetTokenMeta(issuer, cfg.ChainToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenName := "Auth"
+	tokenId := mintage.NewTokenHash(issuer, tm.Header, tokenName)
+	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintageWithAuthority, tokenId, tokenName,
+		"A", big.NewInt(1000), uint8(8), issuer, random.RandomHexString(32), issuer, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Mintage{}
+	blk := &types.StateBlock{
+		Type:           types.ContractSend,
+		Token:          tm.Type,
+		Address:        issuer,
+		Balance:        tm.Balance.Sub(types.Balance{Int: MinPledgeAmount}),
+		Previous:       tm.Header,
+		Link:           types.Hash(contractaddress.MintageAddress),
+		Representative: tm.Representative,
+		Data:           data,
+		Timestamp:      common.TimeNow().Unix(),
+	}
+	if err := m.DoSend(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.DoReceive(ctx, &types.StateBlock{}, blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	return tm, tokenId
+}
+
+func TestMintage_Authority(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	issuer := account1.Address()
+	tm, tokenId := mintageWithAuthority(t, l, issuer, mintage.AuthorityIssue|mintage.AuthorityBurn|mintage.AuthorityFreeze)
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+
+	if a, err := GetTokenAuthority(ctx, tokenId); err != nil {
+		t.Fatal(err)
+	} else if a.Issuer != issuer || a.Issued.Sign() != 0 || a.Burned.Sign() != 0 || a.Has(mintage.AuthorityKYC) {
+		t.Fatal(a)
+	}
+	// authority data should not be listed as token
+	if tokens, err := l.ListTokens(); err != nil {
+		t.Fatal(err)
+	} else {
+		found := false
+		for _, token := range tokens {
+			if token.TokenId == tokenId {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatal("can not find token", tokenId)
+		}
+	}
+
+	// issue
+	data, err := mintage.MintageABI.PackMethod(mintage.MethodNameMintageIssue, tokenId, issuer, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := &types.StateBlock{
+		Type:           types.ContractSend,
+		Token:          tm.Type,
+		Address:        issuer,
+		Balance:        tm.Balance,
+		Vote:           types.ZeroBalance,
+		Network:        types.ZeroBalance,
+		Storage:        types.ZeroBalance,
+		Oracle:         types.ZeroBalance,
+		Previous:       tm.Header,
+		Link:           types.Hash(contractaddress.MintageAddress),
+		Representative: tm.Representative,
+		Data:           data,
+		Timestamp:      common.TimeNow().Unix(),
+	}
+	im := &IssueMintage{}
+	if err := im.DoSend(ctx, send); err != nil {
+		t.Fatal(err)
+	}
+	if key, info, err := im.DoPending(send); err != nil {
+		t.Fatal(err)
+	} else if key.Address != issuer || info.Type != tokenId || info.Amount.Int64() != 100 {
+		t.Fatal(key, info)
+	}
+	if receiver, err := im.GetTargetReceiver(ctx, send); err != nil || receiver != issuer {
+		t.Fatal(receiver, err)
+	}
+
+	other := *send
+	other.Address = mock.Address()
+	if err := im.DoSend(ctx, &other); err == nil {
+		t.Fatal("only issuer can issue token")
+	}
+
+	r, err := im.DoReceive(ctx, &types.StateBlock{}, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reward := r[0].Block
+	if reward.Type != types.ContractReward || reward.Token != tokenId || reward.Balance.Int64() != 100 || !reward.Previous.IsZero() {
+		t.Fatal(reward)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	if err := updateBlock(l, reward); err != nil {
+		t.Fatal(err)
+	}
+
+	// burn
+	ctx = vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	data, err = mintage.MintageABI.PackMethod(mintage.MethodNameMintageBurn, tokenId, big.NewInt(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	burn := &types.StateBlock{
+		Type:           types.ContractSend,
+		Token:          tokenId,
+		Address:        issuer,
+		Balance:        reward.Balance.Sub(types.Balance{Int: big.NewInt(30)}),
+		Vote:           types.ZeroBalance,
+		Network:        types.ZeroBalance,
+		Storage:        types.ZeroBalance,
+		Oracle:         types.ZeroBalance,
+		Previous:       reward.GetHash(),
+		Link:           types.Hash(contractaddress.MintageAddress),
+		Representative: reward.Representative,
+		Data:           data,
+		Timestamp:      common.TimeNow().Unix(),
+	}
+	bm := &BurnMintage{}
+	invalid := *burn
+	invalid.Balance = reward.Balance.Sub(types.Balance{Int: big.NewInt(20)})
+	if _, _, err := bm.ProcessSend(ctx, &invalid); err == nil {
+		t.Fatal("burn amount should be checked")
+	}
+	if _, _, err := bm.ProcessSend(ctx, burn); err != nil {
+		t.Fatal(err)
+	}
+	if a, err := GetTokenAuthority(ctx, tokenId); err != nil {
+		t.Fatal(err)
+	} else if a.Issued.Int64() != 100 || a.Burned.Int64() != 30 {
+		t.Fatal(a)
+	}
+
+	// freeze and unfreeze
+	account := mock.Address()
+	freezeBlock := func(frozen bool, addr types.Address) *types.StateBlock {
+		method := mintage.MethodNameMintageUnfreeze
+		if frozen {
+			method = mintage.MethodNameMintageFreeze
+		}
+		data, err := mintage.MintageABI.PackMethod(method, tokenId, addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blk := *send
+		blk.Data = data
+		return &blk
+	}
+	if err := CheckTokenSend(ctx, tokenId, issuer, account); err != nil {
+		t.Fatal(err)
+	}
+
+	fm := &FreezeMintage{frozen: true}
+	if _, _, err := fm.ProcessSend(ctx, freezeBlock(true, issuer)); err == nil {
+		t.Fatal("issuer should not be frozen")
+	}
+	if _, _, err := fm.ProcessSend(ctx, freezeBlock(true, account)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := fm.ProcessSend(ctx, freezeBlock(true, account)); err == nil {
+		t.Fatal("account is already frozen")
+	}
+	if !IsTokenFrozen(ctx, tokenId, account) {
+		t.Fatal("account should be frozen")
+	}
+	if err := CheckTokenSend(ctx, tokenId, issuer, account); err == nil {
+		t.Fatal("frozen account should not receive token")
+	}
+	if err := CheckTokenReceive(ctx, tokenId, account); err == nil {
+		t.Fatal("frozen account should not receive token")
+	}
+	if err := CheckTokenSend(ctx, cfg.ChainToken(), issuer, account); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	ctx = vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	if accounts, err := ListFrozenAccounts(ctx, tokenId); err != nil {
+		t.Fatal(err)
+	} else if len(accounts) != 1 || accounts[0] != account {
+		t.Fatal(accounts)
+	}
+
+	um := &FreezeMintage{frozen: false}
+	if _, _, err := um.ProcessSend(ctx, freezeBlock(false, account)); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckTokenSend(ctx, tokenId, account, issuer); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMintage_AuthorityKYC(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	issuer := account1.Address()
+	_, tokenId := mintageWithAuthority(t, l, issuer, mintage.AuthorityKYC)
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+
+	if err := CheckTokenReceive(ctx, tokenId, issuer); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckTokenSend(ctx, tokenId, issuer, mock.Address()); err == nil {
+		t.Fatal("account without kyc should not receive token")
+	}
+	if err := CheckTokenReceive(ctx, tokenId, contractaddress.MintageAddress); err != nil {
+		t.Fatal(err)
+	}
+
+	// token without authority is not restricted
+	if err := CheckTokenSend(ctx, mock.Hash(), mock.Address(), mock.Address()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"errors"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+var MultiSigContract = NewChainContract(
+	map[string]Contract{
+		abi.MethodNameMultiSigCreate: &MultiSigCreate{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+				},
+			},
+		},
+	},
+	abi.MultiSigABI,
+	abi.JsonMultiSig,
+)
+
+var ErrMultiSigExist = errors.New("multisig account already exists")
+
+type MultiSigCreate struct {
+	BaseContract
+}
+
+func (m *MultiSigCreate) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	if block.GetToken() != cfg.ChainToken() {
+		return nil, nil, ErrToken
+	}
+
+	param := new(abi.MultiSigParam)
+	err := abi.MultiSigABI.UnpackMethod(param, abi.MethodNameMultiSigCreate, block.GetData())
+	if err != nil {
+		return nil, nil, ErrUnpackMethod
+	}
+
+	account, err := param.Account()
+	if err != nil {
+		return nil, nil, ErrCheckParam
+	}
+
+	if abi.IsMultiSigAccount(ctx, account) {
+		return nil, nil, ErrMultiSigExist
+	}
+
+	data, err := abi.MultiSigABI.PackVariable(abi.VariableNameMultiSigInfo, param.SortedKeys(), param.Threshold, block.Address)
+	if err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	if err := ctx.SetStorage(contractaddress.MultiSigAddress[:], account[:], data); err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	return nil, nil, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestMultiSigCreate_ProcessSend(t *testing.T) {
+	clear, l := getTestLedger()
+	if l == nil {
+		t.Fatal()
+	}
+	defer clear()
+
+	ctx := vmstore.NewVMContext(l, &contractaddress.MultiSigAddress)
+	m := new(MultiSigCreate)
+	keys := []types.Address{mock.Address(), mock.Address(), mock.Address()}
+
+	blk := mock.StateBlockWithoutWork()
+	blk.Type = types.ContractSend
+	blk.Link = types.Hash(contractaddress.MultiSigAddress)
+	blk.Data, _ = abi.MultiSigABI.PackMethod(abi.MethodNameMultiSigCreate, keys, uint8(2))
+	blk.Token = mock.Hash()
+
+	if _, _, err := m.ProcessSend(ctx, blk); err != ErrToken {
+		t.Fatal(err)
+	}
+
+	blk.Token = cfg.ChainToken()
+	if _, _, err := m.ProcessSend(ctx, blk); err != nil {
+		t.Fatal(err)
+	}
+
+	account, _ := (&abi.MultiSigParam{Keys: keys, Threshold: 2}).Account()
+	info, err := abi.GetMultiSigInfo(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Threshold != 2 || len(info.Keys) != 3 || info.Creator != blk.Address {
+		t.Fatal(info)
+	}
+	for _, k := range keys {
+		if !info.HasKey(k) {
+			t.Fatalf("key %s not found", k)
+		}
+	}
+
+	if _, _, err := m.ProcessSend(ctx, blk); err != ErrMultiSigExist {
+		t.Fatal(err)
+	}
+
+	blk.Data, _ = abi.MultiSigABI.PackMethod(abi.MethodNameMultiSigCreate, keys, uint8(4))
+	if _, _, err := m.ProcessSend(ctx, blk); err != ErrCheckParam {
+		t.Fatal(err)
+	}
+
+	blk.Data = []byte{1, 2, 3}
+	if _, _, err := m.ProcessSend(ctx, blk); err != ErrUnpackMethod {
+		t.Fatal(err)
+	}
+}
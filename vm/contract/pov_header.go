@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// contractPovHeader returns the pov header at the height cited by block, whose time decides the conditions of contract.
+// The height is chosen by the sender, so it should not be below minHeight, which is taken from the chain,
+// e.g. the pov height of the contract record the block settles
+func contractPovHeader(ctx *vmstore.VMContext, block *types.StateBlock, minHeight uint64) (*types.PovHeader, error) {
+	if block.PoVHeight < minHeight {
+		return nil, ErrPovHeight
+	}
+	header, err := ctx.GetPovHeaderByHeight(block.PoVHeight)
+	if err != nil {
+		return nil, fmt.Errorf("get pov header %d: %s", block.PoVHeight, err)
+	}
+	return header, nil
+}
+
+// contractPovGap makes the block wait until the pov header it cites is known by this node,
+// so that the block is judged by the same header on all nodes
+func contractPovGap(ctx *vmstore.VMContext, block *types.StateBlock) (common.ContractGapType, interface{}, error) {
+	latest, err := ctx.GetLatestPovHeader()
+	if err != nil || latest.GetHeight() < block.PoVHeight {
+		return common.ContractRewardGapPov, block.PoVHeight, nil
+	}
+	return common.ContractNoGap, nil, nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"github.com/qlcchain/go-qlc/common/types"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+// contractRewardBlock fills the reward block which releases amount of token held by contract to receiver,
+// the receiver keeps its representative, or takes the one of chain token when it opens the token
+func contractRewardBlock(ctx *vmstore.VMContext, block, input *types.StateBlock, receiver types.Address,
+	token types.Hash, amount types.Balance) []*ContractBlock {
+	block.Type = types.ContractReward
+	block.Address = receiver
+	block.Token = token
+	block.Link = input.GetHash()
+	block.Data = []byte{}
+	block.Vote = types.ZeroBalance
+	block.Network = types.ZeroBalance
+	block.Oracle = types.ZeroBalance
+	block.Storage = types.ZeroBalance
+
+	var tm *types.TokenMeta
+	am, _ := ctx.GetAccountMeta(receiver)
+	if am != nil {
+		tm = am.Token(token)
+		if token == cfg.ChainToken() {
+			block.Vote = am.CoinVote
+			block.Network = am.CoinNetwork
+			block.Oracle = am.CoinOracle
+			block.Storage = am.CoinStorage
+		}
+	}
+	if tm != nil {
+		block.Previous = tm.Header
+		block.Balance = tm.Balance.Add(amount)
+		block.Representative = tm.Representative
+	} else {
+		block.Previous = types.ZeroHash
+		block.Balance = amount
+		block.Representative = receiver
+		if am != nil {
+			if ctm := am.Token(cfg.ChainToken()); ctm != nil {
+				block.Representative = ctm.Representative
+			}
+		}
+	}
+
+	return []*ContractBlock{
+		{
+			VMContext: ctx,
+			Block:     block,
+			ToAddress: receiver,
+			BlockType: types.ContractReward,
+			Amount:    amount,
+			Token:     token,
+			Data:      []byte{},
+		},
+	}
+}
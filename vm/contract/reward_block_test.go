@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common/types"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestContractRewardBlock(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	a := account1.Address()
+	am, err := l.GetAccountMeta(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm := am.Token(cfg.ChainToken())
+	input := mock.StateBlockWithoutWork()
+	amount := types.Balance{Int: big.NewInt(100)}
+	ctx := vmstore.NewVMContext(l, &a)
+
+	// receiver has the token
+	block := new(types.StateBlock)
+	cbs := contractRewardBlock(ctx, block, input, a, cfg.ChainToken(), amount)
+	if len(cbs) != 1 || cbs[0].Block != block || cbs[0].ToAddress != a || !cbs[0].Amount.Equal(amount) {
+		t.Fatal(cbs)
+	}
+	if block.Type != types.ContractReward || block.Link != input.GetHash() || block.Previous != tm.Header ||
+		!block.Balance.Equal(tm.Balance.Add(amount)) || block.Representative != tm.Representative ||
+		!block.Vote.Equal(am.CoinVote) {
+		t.Fatal(block)
+	}
+
+	// receiver opens the token with representative of chain token
+	token := mock.Hash()
+	block = new(types.StateBlock)
+	contractRewardBlock(ctx, block, input, a, token, amount)
+	if block.Token != token || !block.Previous.IsZero() || !block.Balance.Equal(amount) ||
+		block.Representative != tm.Representative || !block.Vote.IsZero() {
+		t.Fatal(block)
+	}
+
+	// new account represents itself
+	receiver := mock.Address()
+	block = new(types.StateBlock)
+	contractRewardBlock(ctx, block, input, receiver, token, amount)
+	if block.Address != receiver || !block.Previous.IsZero() || block.Representative != receiver {
+		t.Fatal(block)
+	}
+}
@@ -43,6 +43,7 @@ var (
 			cabi.MethodNameRemoveNextStop:    &RemoveNextStop{},
 			cabi.MethodNameTerminateContract: &TerminateContract{},
 			cabi.MethodNameRegisterAsset:     &RegisterAsset{},
+			cabi.MethodNameCommitCDRBatch:    &CommitCDRBatch{},
 		},
 		cabi.SettlementABI,
 		cabi.JsonSettlement,
@@ -182,6 +183,11 @@ func (c *CreateContract) ProcessSend(ctx *vmstore.VMContext, block *types.StateB
 			}
 		}
 
+		if err := cabi.EmitEvent(ctx, cabi.EventNameContractCreated,
+			[]types.Address{address, param.PartyA.Address, param.PartyB.Address}); err != nil {
+			return nil, nil, err
+		}
+
 		return &types.PendingKey{
 				Address: param.PartyA.Address,
 				Hash:    block.GetHash(),
@@ -246,6 +252,10 @@ func (s *SignContract) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlo
 				if data, err := cp.ToABI(); err == nil {
 					// save confirm data
 					if err := cabi.SaveContractParam(ctx, &param.ContractAddress, data); err == nil {
+						if err := cabi.EmitEvent(ctx, cabi.EventNameContractSigned,
+							[]types.Address{param.ContractAddress, block.Address}, param.ConfirmDate); err != nil {
+							return nil, nil, err
+						}
 						return &types.PendingKey{
 								Address: block.Address,
 								Hash:    block.GetHash(),
@@ -369,10 +379,14 @@ func (p *ProcessCDR) save(ctx *vmstore.VMContext, block *types.StateBlock, ca *t
 	}()
 
 	if param.SmsDt <= 0 || param.SmsDt < contract.StartDate || param.SmsDt > contract.EndDate {
-		return fmt.Errorf("invalid SMS date, should be in [%s, %s], got %s",
+		return fmt.Errorf("invalid %s date, should be in [%s, %s], got %s", param.Kind.String(),
 			timeString(contract.StartDate), timeString(contract.EndDate), timeString(param.SmsDt))
 	}
 
+	if _, err := contract.ServiceByKind(param.Kind); err != nil {
+		return err
+	}
+
 	if !(contract.PartyA.Address == addr || contract.PartyB.Address == addr) {
 		return fmt.Errorf("%s can not upload CDR data to contract %s", addr.String(), ca.String())
 	}
@@ -829,6 +843,10 @@ func (t *TerminateContract) ProcessSend(ctx *vmstore.VMContext, block *types.Sta
 				if data, err := cp.ToABI(); err == nil {
 					// save confirm data
 					if err := cabi.SaveContractParam(ctx, &param.ContractAddress, data); err == nil {
+						if err := cabi.EmitEvent(ctx, cabi.EventNameContractTerminated,
+							[]types.Address{param.ContractAddress, block.Address}, param.Request); err != nil {
+							return nil, nil, err
+						}
 						return &types.PendingKey{
 								Address: block.Address,
 								Hash:    block.GetHash(),
@@ -886,6 +904,87 @@ func (r *RegisterAsset) ProcessSend(ctx *vmstore.VMContext, block *types.StateBl
 		}, nil
 }
 
+// CommitCDRBatch puts the merkle root and counters of a batch of off chain CDR records on chain,
+// batches of a party are committed in the order of seq
+type CommitCDRBatch struct {
+	internalContract
+}
+
+func (c *CommitCDRBatch) DoReceive(ctx *vmstore.VMContext, block *types.StateBlock, input *types.StateBlock) ([]*ContractBlock, error) {
+	return handleReceive(ctx, block, input, func(data []byte) error {
+		param := new(cabi.CDRBatchParam)
+		if err := param.FromABI(data); err != nil {
+			return err
+		}
+		if _, err := cabi.GetCDRBatch(ctx, param.ContractAddress, input.Address, param.Seq); err != nil {
+			return fmt.Errorf("invalid send block[%s] data", input.GetHash().String())
+		}
+		return nil
+	})
+}
+
+func (c *CommitCDRBatch) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	param := new(cabi.CDRBatchParam)
+	if err := param.FromABI(block.Data); err != nil {
+		return nil, nil, err
+	}
+	if err := param.Verify(); err != nil {
+		return nil, nil, err
+	}
+
+	contractAddress := param.ContractAddress
+	contract, err := cabi.GetSettlementContract(ctx, &contractAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !contract.IsContractor(block.Address) {
+		return nil, nil, fmt.Errorf("%s can not commit CDR batch to contract %s", block.Address.String(),
+			contractAddress.String())
+	}
+	if block.Timestamp <= 0 || block.Timestamp < contract.StartDate || block.Timestamp > contract.EndDate {
+		return nil, nil, fmt.Errorf("invalid uploading date, should be in [%s, %s], got %s",
+			timeString(contract.StartDate), timeString(contract.EndDate), timeString(block.Timestamp))
+	}
+	if param.StartDate < contract.StartDate || param.EndDate > contract.EndDate {
+		return nil, nil, fmt.Errorf("invalid batch date, should be in [%s, %s], got [%s, %s]",
+			timeString(contract.StartDate), timeString(contract.EndDate), timeString(param.StartDate),
+			timeString(param.EndDate))
+	}
+	for _, counter := range param.Counters {
+		if _, err := contract.ServiceByKind(counter.Kind); err != nil {
+			return nil, nil, err
+		}
+	}
+	if param.Seq > 1 {
+		if _, err := cabi.GetCDRBatch(ctx, contractAddress, block.Address, param.Seq-1); err != nil {
+			return nil, nil, fmt.Errorf("batch %d of %s is not committed", param.Seq-1, block.Address.String())
+		}
+	}
+
+	batch := &cabi.CDRBatch{
+		CDRBatchParam: *param,
+		Party:         block.Address,
+		Block:         block.GetHash(),
+		Timestamp:     block.Timestamp,
+	}
+	if err := cabi.SaveCDRBatch(ctx, batch); err != nil {
+		return nil, nil, err
+	}
+	if err := cabi.EmitEvent(ctx, cabi.EventNameCDRBatchCommitted,
+		[]types.Address{contractAddress, block.Address}, param.Seq, param.Root); err != nil {
+		return nil, nil, err
+	}
+
+	return &types.PendingKey{
+			Address: block.Address,
+			Hash:    block.GetHash(),
+		}, &types.PendingInfo{
+			Source: types.Address(block.Link),
+			Amount: types.ZeroBalance,
+			Type:   block.Token,
+		}, nil
+}
+
 func timeString(t int64) string {
 	return time.Unix(t, 0).Format(time.RFC3339)
 }
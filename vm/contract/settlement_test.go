@@ -49,14 +49,14 @@ var (
 				Mcc:         1,
 				Mnc:         2,
 				TotalAmount: 10,
-				UnitPrice:   2,
+				UnitPrice:   types.NewMoney(2, 0, ""),
 				Currency:    "USD",
 			}, {
 				ServiceId:   mock.Hash().String(),
 				Mcc:         22,
 				Mnc:         1,
 				TotalAmount: 30,
-				UnitPrice:   4,
+				UnitPrice:   types.NewMoney(4, 0, ""),
 				Currency:    "USD",
 			},
 		},
@@ -1834,3 +1834,121 @@ func TestProcessCDR_save(t *testing.T) {
 		}
 	}
 }
+
+func TestProcessCDR_saveKind(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	ca, a1, _, err := buildContract(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+	param, err := cabi.GetSettlementContract(ctx, &ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cdr := &cabi.CDRParam{
+		Index:       20000,
+		SmsDt:       time.Now().Unix(),
+		Sender:      "PCCWG",
+		Destination: "85257***343",
+		Kind:        cabi.CDRKindVoice,
+		Voice:       &cabi.VoiceCDR{Duration: 65, AnswerStatus: cabi.AnswerStatusAnswered},
+	}
+	sb := &types.StateBlock{
+		Address:   a1,
+		Timestamp: time.Now().Unix(),
+	}
+
+	// contract only has SMS services
+	p := &ProcessCDR{}
+	if err := p.save(ctx, sb, &ca, param, cdr); err == nil {
+		t.Fatal("voice record should be rejected without voice service")
+	}
+
+	param.Services[1].Kind = cabi.CDRKindVoice
+	if err := p.save(ctx, sb, &ca, param, cdr); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCommitCDRBatch_ProcessSend(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	ca, a1, _, err := buildContract(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := vmstore.NewVMContext(l, &contractaddress.SettlementAddress)
+	contract, err := cabi.GetSettlementContract(ctx, &ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().Unix()
+	param := &cabi.CDRBatchParam{
+		ContractAddress: ca,
+		Seq:             2,
+		Root:            mock.Hash(),
+		StartDate:       contract.StartDate,
+		EndDate:         now,
+		Counters:        []*cabi.CDRBatchCounter{{Kind: cabi.CDRKindSms, Total: 10, Success: 9, Billable: 9}},
+	}
+	send := func(p *cabi.CDRBatchParam, addr types.Address) (*types.StateBlock, error) {
+		data, err := p.ToABI()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sb := &types.StateBlock{
+			Type:      types.ContractSend,
+			Token:     cfg.GasToken(),
+			Address:   addr,
+			Link:      types.Hash(contractaddress.SettlementAddress),
+			Data:      data,
+			Timestamp: now,
+		}
+		_, _, err = (&CommitCDRBatch{}).ProcessSend(ctx, sb)
+		return sb, err
+	}
+
+	if _, err := send(param, a1); err == nil {
+		t.Fatal("batch 1 is not committed")
+	}
+	param.Seq = 1
+	if _, err := send(param, mock.Address()); err == nil {
+		t.Fatal("only contractor can commit batch")
+	}
+	param.Counters[0].Kind = cabi.CDRKindData
+	if _, err := send(param, a1); err == nil {
+		t.Fatal("contract has no data service")
+	}
+	param.Counters[0].Kind = cabi.CDRKindSms
+	sb, err := send(param, a1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := send(param, a1); err == nil {
+		t.Fatal("batch should not be committed twice")
+	}
+	param.Seq = 2
+	if _, err := send(param, a1); err != nil {
+		t.Fatal(err)
+	}
+
+	if batch, err := cabi.GetCDRBatch(ctx, ca, a1, 1); err != nil {
+		t.Fatal(err)
+	} else if batch.Block != sb.GetHash() || batch.Root != param.Root {
+		t.Fatal("invalid batch", batch)
+	}
+	if logs := vmstore.LogList(ctx); len(logs.Logs) != 2 {
+		t.Fatal("invalid batch event", logs)
+	}
+
+	rev := &types.StateBlock{Timestamp: now}
+	if _, err := (&CommitCDRBatch{}).DoReceive(ctx, rev, sb); err != nil {
+		t.Fatal(err)
+	}
+}
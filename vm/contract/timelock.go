@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+var TimeLockContract = NewChainContract(
+	map[string]Contract{
+		abi.MethodNameTimeLock: &TimeLock{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+				},
+			},
+		},
+		abi.MethodNameTimeLockRelease: &TimeLockSettle{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+					pending:   true,
+				},
+			},
+			status: abi.TimeLockStatusReleased,
+		},
+		abi.MethodNameTimeLockRefund: &TimeLockSettle{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+					pending:   true,
+				},
+			},
+			status: abi.TimeLockStatusRefunded,
+		},
+		abi.MethodNameTimeLockCancel: &TimeLockSettle{
+			BaseContract: BaseContract{
+				Describe: Describe{
+					specVer:   SpecVer2,
+					signature: true,
+					work:      true,
+					pending:   true,
+				},
+			},
+			status: abi.TimeLockStatusCanceled,
+		},
+	},
+	abi.TimeLockABI,
+	abi.JsonTimeLock,
+)
+
+var (
+	ErrTimeLockNotFound  = errors.New("time lock not found")
+	ErrTimeLockSettled   = errors.New("time lock is already settled")
+	ErrTimeLockCondition = errors.New("time lock condition is not met")
+)
+
+// TimeLock holds the funds sent to contract until they are released to receiver or returned to sender
+type TimeLock struct {
+	BaseContract
+}
+
+func (t *TimeLock) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	param := new(abi.TimeLockParam)
+	if err := abi.TimeLockABI.UnpackMethod(param, abi.MethodNameTimeLock, block.GetData()); err != nil {
+		return nil, nil, ErrUnpackMethod
+	}
+	if err := param.Verify(); err != nil {
+		return nil, nil, err
+	}
+
+	amount, err := ctx.CalculateAmount(block)
+	if err != nil {
+		return nil, nil, ErrCalcAmount
+	}
+	if amount.Compare(types.ZeroBalance) != types.BalanceCompBigger {
+		return nil, nil, fmt.Errorf("invalid lock amount %s", amount)
+	}
+
+	if _, err := contractPovHeader(ctx, block, 0); err != nil {
+		return nil, nil, err
+	}
+	if err := CheckTokenSend(ctx.WithContract(&contractaddress.MintageAddress), block.Token, block.Address, param.Receiver); err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := ctx.GetStorage(contractaddress.TimeLockAddress[:], abi.GetTimeLockKey(block.Previous)); err == nil {
+		return nil, nil, fmt.Errorf("time lock %s already exists", block.Previous)
+	}
+
+	data, err := abi.TimeLockABI.PackVariable(abi.VariableNameTimeLockInfo, block.Address, param.Receiver, block.Token,
+		amount.Int, param.ReleaseHeight, param.ReleaseTime, param.RefundTime, param.CancelTime, block.PoVHeight)
+	if err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	if err := ctx.SetStorage(contractaddress.TimeLockAddress[:], abi.GetTimeLockKey(block.Previous), data); err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	if block.Data, err = abi.TimeLockABI.PackMethod(abi.MethodNameTimeLock, param.Receiver, param.ReleaseHeight,
+		param.ReleaseTime, param.RefundTime, param.CancelTime); err != nil {
+		return nil, nil, ErrPackMethod
+	}
+
+	// funds are held by contract, nothing to receive
+	return nil, nil, nil
+}
+
+func (t *TimeLock) DoGap(ctx *vmstore.VMContext, block *types.StateBlock) (common.ContractGapType, interface{}, error) {
+	return contractPovGap(ctx, block)
+}
+
+// TimeLockSettle releases the lock to receiver, or refunds or cancels it to sender
+type TimeLockSettle struct {
+	BaseContract
+	status abi.TimeLockStatus
+}
+
+func (t *TimeLockSettle) methodName() string {
+	switch t.status {
+	case abi.TimeLockStatusReleased:
+		return abi.MethodNameTimeLockRelease
+	case abi.TimeLockStatusRefunded:
+		return abi.MethodNameTimeLockRefund
+	default:
+		return abi.MethodNameTimeLockCancel
+	}
+}
+
+// target returns the account which settles the lock and receives the funds
+func (t *TimeLockSettle) target(lock *abi.TimeLockInfo) types.Address {
+	if t.status == abi.TimeLockStatusReleased {
+		return lock.Receiver
+	}
+	return lock.Sender
+}
+
+func (t *TimeLockSettle) parse(ctx *vmstore.VMContext, block *types.StateBlock) (*abi.TimeLockInfo, error) {
+	param := new(abi.TimeLockSettleParam)
+	if err := abi.TimeLockABI.UnpackMethod(param, t.methodName(), block.GetData()); err != nil {
+		return nil, ErrUnpackMethod
+	}
+	lock, err := abi.GetTimeLockInfo(ctx, param.LockId)
+	if err != nil {
+		return nil, ErrTimeLockNotFound
+	}
+	return lock, nil
+}
+
+func (t *TimeLockSettle) DoGap(ctx *vmstore.VMContext, block *types.StateBlock) (common.ContractGapType, interface{}, error) {
+	return contractPovGap(ctx, block)
+}
+
+func (t *TimeLockSettle) ProcessSend(ctx *vmstore.VMContext, block *types.StateBlock) (*types.PendingKey, *types.PendingInfo, error) {
+	if amount, err := ctx.CalculateAmount(block); err != nil || !amount.IsZero() {
+		return nil, nil, errors.New("invalid settle amount")
+	}
+
+	lock, err := t.parse(ctx, block)
+	if err != nil {
+		return nil, nil, err
+	}
+	if block.Address != t.target(lock) {
+		return nil, nil, ErrInvalidOperator
+	}
+
+	// the block is processed again when its receive block is rolled back
+	if settle, err := abi.GetTimeLockSettle(ctx, lock.LockId); err == nil {
+		if abi.TimeLockStatus(settle.Status) == t.status && settle.Previous == block.Previous {
+			key, info := t.pending(block, lock)
+			return key, info, nil
+		}
+		return nil, nil, ErrTimeLockSettled
+	}
+
+	// conditions are judged no earlier than the lock
+	header, err := contractPovHeader(ctx, block, lock.PovHeight)
+	if err != nil {
+		return nil, nil, err
+	}
+	povTime := int64(header.GetTimestamp())
+
+	var ok bool
+	switch t.status {
+	case abi.TimeLockStatusReleased:
+		ok = lock.Releasable(block.PoVHeight, povTime)
+	case abi.TimeLockStatusRefunded:
+		ok = lock.Refundable(povTime)
+	default:
+		ok = lock.Cancelable(povTime)
+	}
+	if !ok {
+		return nil, nil, ErrTimeLockCondition
+	}
+
+	data, err := abi.TimeLockABI.PackVariable(abi.VariableNameTimeLockSettle, uint8(t.status), block.Previous)
+	if err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	if err := ctx.SetStorage(contractaddress.TimeLockAddress[:], abi.GetTimeLockSettleKey(lock.LockId), data); err != nil {
+		return nil, nil, ErrSetStorage
+	}
+
+	if block.Data, err = abi.TimeLockABI.PackMethod(t.methodName(), lock.LockId); err != nil {
+		return nil, nil, ErrPackMethod
+	}
+	key, info := t.pending(block, lock)
+	return key, info, nil
+}
+
+func (t *TimeLockSettle) pending(block *types.StateBlock, lock *abi.TimeLockInfo) (*types.PendingKey, *types.PendingInfo) {
+	return &types.PendingKey{
+			Address: block.Address,
+			Hash:    block.GetHash(),
+		}, &types.PendingInfo{
+			Source: contractaddress.TimeLockAddress,
+			Amount: types.Balance{Int: lock.Amount},
+			Type:   lock.Token,
+		}
+}
+
+func (t *TimeLockSettle) GetTargetReceiver(ctx *vmstore.VMContext, block *types.StateBlock) (types.Address, error) {
+	lock, err := t.parse(ctx, block)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+	return t.target(lock), nil
+}
+
+func (t *TimeLockSettle) DoReceive(ctx *vmstore.VMContext, block, input *types.StateBlock) ([]*ContractBlock, error) {
+	lock, err := t.parse(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	settle, err := abi.GetTimeLockSettle(ctx, lock.LockId)
+	if err != nil || abi.TimeLockStatus(settle.Status) != t.status || settle.Previous != input.Previous {
+		return nil, fmt.Errorf("time lock %s is not %s by %s", lock.LockId, t.status, input.Previous)
+	}
+
+	if err := CheckTokenReceive(ctx.WithContract(&contractaddress.MintageAddress), lock.Token, t.target(lock)); err != nil {
+		return nil, err
+	}
+
+	return contractRewardBlock(ctx, block, input, t.target(lock), lock.Token, types.Balance{Int: lock.Amount}), nil
+}
@@ -0,0 +1,272 @@
+/*
+ * Copyright (c) 2020 QLC Chain Team
+ *
+ * This software is released under the MIT License.
+ * https://opensource.org/licenses/MIT
+ */
+
+package contract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/qlcchain/go-qlc/common"
+	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/common/vmcontract/contractaddress"
+	"github.com/qlcchain/go-qlc/common/vmcontract/mintage"
+	cfg "github.com/qlcchain/go-qlc/config"
+	"github.com/qlcchain/go-qlc/mock"
+	"github.com/qlcchain/go-qlc/vm/contract/abi"
+	"github.com/qlcchain/go-qlc/vm/vmstore"
+)
+
+func TestTimeLock(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	pb, td := mock.GeneratePovBlockByFakePow(nil, 0)
+	if err := addLatestPovBlock(pb, td, l); err != nil {
+		t.Fatal(err)
+	}
+	povTime := int64(pb.GetTimestamp())
+
+	a := account1.Address()
+	receiver := mock.Address()
+	tm, err := l.GetTokenMeta(a, cfg.ChainToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// lock
+	data, err := abi.TimeLockABI.PackMethod(abi.MethodNameTimeLock, receiver, pb.GetHeight(), povTime+100, int64(0), povTime+50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockBlk := &types.StateBlock{
+		Type:      types.ContractSend,
+		Token:     tm.Type,
+		Address:   a,
+		Balance:   tm.Balance.Sub(types.Balance{Int: big.NewInt(1000)}),
+		Previous:  tm.Header,
+		Link:      types.Hash(contractaddress.TimeLockAddress),
+		Vote:      types.ZeroBalance,
+		Network:   types.ZeroBalance,
+		Storage:   types.ZeroBalance,
+		Oracle:    types.ZeroBalance,
+		Data:      data,
+		PoVHeight: pb.GetHeight(),
+	}
+	ctx := vmstore.NewVMContextWithBlock(l, lockBlk)
+	lock := TimeLockContract.m[abi.MethodNameTimeLock]
+	if key, info, err := lock.ProcessSend(ctx, lockBlk); err != nil {
+		t.Fatal(err)
+	} else if key != nil || info != nil {
+		t.Fatal("lock should not have pending")
+	}
+	if _, _, err := lock.ProcessSend(ctx, lockBlk); err == nil {
+		t.Fatal("lock should not be saved twice")
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+	id := lockBlk.Previous
+
+	povHeight := pb.GetHeight()
+	settle := func(method string, address types.Address) *types.StateBlock {
+		data, err := abi.TimeLockABI.PackMethod(method, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &types.StateBlock{
+			Type:      types.ContractSend,
+			Token:     tm.Type,
+			Address:   address,
+			Balance:   tm.Balance,
+			Previous:  tm.Header,
+			Link:      types.Hash(contractaddress.TimeLockAddress),
+			Data:      data,
+			PoVHeight: povHeight,
+			Vote:      types.ZeroBalance,
+			Network:   types.ZeroBalance,
+			Storage:   types.ZeroBalance,
+			Oracle:    types.ZeroBalance,
+		}
+	}
+
+	// release before release time and by sender
+	release := TimeLockContract.m[abi.MethodNameTimeLockRelease]
+	blk := settle(abi.MethodNameTimeLockRelease, receiver)
+	ctx = vmstore.NewVMContextWithBlock(l, blk)
+	if _, _, err := release.ProcessSend(ctx, blk); err != ErrTimeLockCondition {
+		t.Fatal(err)
+	}
+	blk = settle(abi.MethodNameTimeLockRelease, a)
+	if _, _, err := release.ProcessSend(ctx, blk); err != ErrInvalidOperator {
+		t.Fatal(err)
+	}
+	if addr, err := release.GetTargetReceiver(ctx, blk); err != nil || addr != receiver {
+		t.Fatal(addr, err)
+	}
+
+	// cancel by a pov height before the lock
+	cancel := TimeLockContract.m[abi.MethodNameTimeLockCancel]
+	povHeight = pb.GetHeight() - 1
+	blk = settle(abi.MethodNameTimeLockCancel, a)
+	if _, _, err := cancel.ProcessSend(ctx, blk); err != ErrPovHeight {
+		t.Fatal(err)
+	}
+
+	// cancel by a pov height which is not known yet waits for the pov block
+	povHeight = pb.GetHeight() + 1
+	blk = settle(abi.MethodNameTimeLockCancel, a)
+	if gap, height, err := cancel.DoGap(ctx, blk); err != nil || gap != common.ContractRewardGapPov ||
+		height.(uint64) != povHeight {
+		t.Fatal(gap, height, err)
+	}
+	latest, td := mock.GeneratePovBlockByFakePow(pb, 0)
+	if err := addLatestPovBlock(latest, td, l); err != nil {
+		t.Fatal(err)
+	}
+	if gap, _, err := cancel.DoGap(ctx, blk); err != nil || gap != common.ContractNoGap {
+		t.Fatal(gap, err)
+	}
+
+	// cancel
+	povHeight = latest.GetHeight()
+	blk = settle(abi.MethodNameTimeLockCancel, a)
+	ctx = vmstore.NewVMContextWithBlock(l, blk)
+	key, info, err := cancel.ProcessSend(ctx, blk)
+	if err != nil || key.Address != a || info.Amount.Int64() != 1000 || info.Type != tm.Type {
+		t.Fatal(key, info, err)
+	}
+	if key2, _, err := cancel.ProcessSend(ctx, blk); err != nil || *key2 != *key {
+		t.Fatal("settle should be processed again", err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = vmstore.NewVMContext(l, &contractaddress.TimeLockAddress)
+	refund := TimeLockContract.m[abi.MethodNameTimeLockRefund]
+	if _, _, err := refund.ProcessSend(ctx, settle(abi.MethodNameTimeLockRefund, a)); err != ErrTimeLockSettled {
+		t.Fatal(err)
+	}
+
+	reward := &types.StateBlock{}
+	blocks, err := cancel.DoReceive(ctx, reward, blk)
+	if err != nil || len(blocks) != 1 {
+		t.Fatal(blocks, err)
+	}
+	if reward.Address != a || reward.Previous != tm.Header || reward.Balance.Compare(tm.Balance.Add(info.Amount)) != types.BalanceCompEqual {
+		t.Fatal(reward)
+	}
+	if _, err := release.DoReceive(ctx, &types.StateBlock{}, blk); err == nil {
+		t.Fatal("receive should match the settle status")
+	}
+}
+
+func TestTimeLock_TokenAuthority(t *testing.T) {
+	teardownTestCase, l := setupLedgerForTestCase(t)
+	defer teardownTestCase(t)
+
+	pb, td := mock.GeneratePovBlockByFakePow(nil, 0)
+	if err := addLatestPovBlock(pb, td, l); err != nil {
+		t.Fatal(err)
+	}
+
+	a := account1.Address()
+	receiver := mock.Address()
+	tm, err := l.GetTokenMeta(a, cfg.ChainToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the locked token can be frozen by its issuer
+	ctx := vmstore.NewVMContext(l, &contractaddress.MintageAddress)
+	if err := saveTokenAuthority(ctx, &mintage.TokenAuthority{TokenId: tm.Type, Issuer: a, Flags: mintage.AuthorityFreeze,
+		Issued: big.NewInt(0), Burned: big.NewInt(0)}); err != nil {
+		t.Fatal(err)
+	}
+	freeze := func(frozen bool) {
+		data, err := mintage.MintageABI.PackVariable(mintage.VariableNameTokenFreeze, frozen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ctx.SetStorage(contractaddress.MintageAddress[:], mintage.GetTokenFreezeKey(tm.Type, receiver), data); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.SaveStorage(vmstore.ToCache(ctx)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	freeze(true)
+
+	data, err := abi.TimeLockABI.PackMethod(abi.MethodNameTimeLock, receiver, pb.GetHeight(), int64(0), int64(0), int64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lockBlk := &types.StateBlock{
+		Type:      types.ContractSend,
+		Token:     tm.Type,
+		Address:   a,
+		Balance:   tm.Balance.Sub(types.Balance{Int: big.NewInt(1000)}),
+		Previous:  tm.Header,
+		Link:      types.Hash(contractaddress.TimeLockAddress),
+		Vote:      types.ZeroBalance,
+		Network:   types.ZeroBalance,
+		Storage:   types.ZeroBalance,
+		Oracle:    types.ZeroBalance,
+		Data:      data,
+		PoVHeight: pb.GetHeight(),
+	}
+	lock := TimeLockContract.m[abi.MethodNameTimeLock]
+	lctx := vmstore.NewVMContextWithBlock(l, lockBlk)
+	if _, _, err := lock.ProcessSend(lctx, lockBlk); err == nil {
+		t.Fatal("token should not be locked for frozen receiver")
+	}
+
+	// receiver is frozen after the lock, the release can not be received
+	freeze(false)
+	lctx = vmstore.NewVMContextWithBlock(l, lockBlk)
+	if _, _, err := lock.ProcessSend(lctx, lockBlk); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(lctx)); err != nil {
+		t.Fatal(err)
+	}
+	if data, err = abi.TimeLockABI.PackMethod(abi.MethodNameTimeLockRelease, lockBlk.Previous); err != nil {
+		t.Fatal(err)
+	}
+	releaseBlk := &types.StateBlock{
+		Type:      types.ContractSend,
+		Token:     tm.Type,
+		Address:   receiver,
+		Balance:   tm.Balance,
+		Previous:  tm.Header,
+		Link:      types.Hash(contractaddress.TimeLockAddress),
+		Vote:      types.ZeroBalance,
+		Network:   types.ZeroBalance,
+		Storage:   types.ZeroBalance,
+		Oracle:    types.ZeroBalance,
+		Data:      data,
+		PoVHeight: pb.GetHeight(),
+	}
+	release := TimeLockContract.m[abi.MethodNameTimeLockRelease]
+	rctx := vmstore.NewVMContextWithBlock(l, releaseBlk)
+	if _, _, err := release.ProcessSend(rctx, releaseBlk); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SaveStorage(vmstore.ToCache(rctx)); err != nil {
+		t.Fatal(err)
+	}
+	freeze(true)
+	rctx = vmstore.NewVMContext(l, &contractaddress.TimeLockAddress)
+	if _, err := release.DoReceive(rctx, &types.StateBlock{}, releaseBlk); err == nil {
+		t.Fatal("frozen receiver should not receive released token")
+	}
+	freeze(false)
+	if blocks, err := release.DoReceive(rctx, &types.StateBlock{}, releaseBlk); err != nil || len(blocks) != 1 {
+		t.Fatal(blocks, err)
+	}
+}
@@ -30,6 +30,7 @@ type ContractStore interface {
 
 	SetObjectStorage(prefix, key []byte, value interface{}) error
 	GetStorageByRaw([]byte) ([]byte, error)
+	AppendLog(log *types.VmLog)
 
 	Iterator(prefix []byte, fn func(key []byte, value []byte) error) error
 
@@ -83,6 +84,12 @@ func Trie(ctx *VMContext) *trie.Trie {
 	})
 }
 
+// LogList returns the logs emitted by contract in the context
+func LogList(ctx *VMContext) *types.VmLogs {
+	logs := ctx.cache.LogList()
+	return &logs
+}
+
 func ToCache(ctx *VMContext) map[string]interface{} {
 	result := make(map[string]interface{})
 	for k, val := range ctx.cache.storage {
@@ -124,6 +131,19 @@ func (v *VMContext) WithCache(c storage.Cache) *VMContext {
 	return v
 }
 
+// WithContract returns a context on the same ledger and pov height to read storage of another contract
+func (v *VMContext) WithContract(contractAddr *types.Address) *VMContext {
+	return &VMContext{
+		l:            v.l,
+		logger:       v.logger,
+		cache:        NewVMCache(),
+		trie:         trie.NewTrie(v.l.DBStore(), nil, trie.NewSimpleTrieNodePool()),
+		contractAddr: contractAddr,
+		storageCache: v.storageCache,
+		poVHeight:    v.poVHeight,
+	}
+}
+
 // WithBlock Load storage trie from the specified user address and block hash
 func NewVMContextWithBlock(l ledger.Store, block *types.StateBlock) *VMContext {
 	vmlog := log.NewLogger("vm_context")
@@ -224,6 +244,14 @@ func (v *VMContext) SetObjectStorage(prefix, key []byte, value interface{}) erro
 	return nil
 }
 
+// AppendLog appends a log emitted by the contract, the address of log is set to the contract address if empty
+func (v *VMContext) AppendLog(log *types.VmLog) {
+	if log.Address.IsZero() && v.contractAddr != nil {
+		log.Address = *v.contractAddr
+	}
+	v.cache.AppendLog(log)
+}
+
 func (v *VMContext) GetStorageByRaw(i []byte) ([]byte, error) {
 	_, val, err := v.l.GetObject(i)
 	if err != nil {
@@ -239,6 +267,10 @@ func (v *VMContext) GetLatestPovHeader() (*types.PovHeader, error) {
 	return v.l.GetLatestPovHeader()
 }
 
+func (v *VMContext) GetPovHeaderByHeight(height uint64) (*types.PovHeader, error) {
+	return v.l.GetPovHeaderByHeight(height)
+}
+
 func (v *VMContext) IsUserAccount(address types.Address) (bool, error) {
 	if b, err := v.l.HasAccountMetaConfirmed(address); b {
 		return true, nil
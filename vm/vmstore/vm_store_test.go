@@ -209,6 +209,28 @@ func TestVMCache_AppendLog(t *testing.T) {
 	}
 }
 
+func TestVMContext_AppendLog(t *testing.T) {
+	teardownTestCase, _, l := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	addr := mock.Address()
+	ctx := NewVMContext(l, &addr)
+	ctx.AppendLog(&types.VmLog{
+		Topics: []types.Hash{mock.Hash()},
+		Data:   []byte{10, 20, 30, 40},
+	})
+	other := mock.Address()
+	ctx.AppendLog(&types.VmLog{
+		Address: other,
+		Data:    []byte{10, 20, 30, 50},
+	})
+
+	logs := LogList(ctx)
+	if len(logs.Logs) != 2 || logs.Logs[0].Address != addr || logs.Logs[1].Address != other {
+		t.Fatal("invalid logs", logs)
+	}
+}
+
 func TestVMContext_Block(t *testing.T) {
 	teardownTestCase, ctx, l := setupTestCase(t)
 	defer teardownTestCase(t)
@@ -359,6 +381,11 @@ func TestVMContext_POV(t *testing.T) {
 	} else {
 		t.Log(r)
 	}
+	if r, err := ctx.GetPovHeaderByHeight(0); err != nil {
+		t.Fatal()
+	} else {
+		t.Log(r)
+	}
 	if _, err := ctx.GetPovMinerStat(0); err == nil {
 		t.Fatal()
 	}
@@ -9,6 +9,7 @@ package wallet
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -33,11 +34,16 @@ const (
 	idPrefixIndex
 	idPrefixRepresentation
 	idPrefixWork
+	idPrefixMnemonic
+	idPrefixHDAccounts
 )
 
 const (
 	Version            = 1
+	HDVersion          = 2
 	searchAccountCount = 100
+	// DefaultGapLimit is the count of consecutive unused accounts to stop discovery
+	DefaultGapLimit = 20
 )
 
 type WalletStore struct {
@@ -57,8 +63,16 @@ type Session struct {
 	password        *crypto.SecureString
 }
 
+// HDAccount is a named account of HD wallet
+type HDAccount struct {
+	Name    string        `json:"name"`
+	Path    string        `json:"path"`
+	Address types.Address `json:"address"`
+}
+
 var (
-	ErrEmptyId = errors.New("empty wallet id")
+	ErrEmptyId     = errors.New("empty wallet id")
+	ErrNotHDWallet = errors.New("not a HD wallet")
 )
 
 func (ws *WalletStore) NewSession(walletId types.Address) *Session {
@@ -72,7 +86,7 @@ func (ws *WalletStore) NewSession(walletId types.Address) *Session {
 }
 
 func (s *Session) removeWallet(batch storage.Batch) error {
-	for _, val := range []byte{idPrefixId, idPrefixVersion, idPrefixSeed, idPrefixRepresentation} {
+	for _, val := range []byte{idPrefixId, idPrefixVersion, idPrefixSeed, idPrefixRepresentation, idPrefixMnemonic, idPrefixHDAccounts} {
 		key := []byte{val}
 		key = append(key, s.walletId...)
 		err := batch.Delete(key)
@@ -273,12 +287,37 @@ func (s *Session) ChangePassword(password string) error {
 	if err != nil {
 		return nil
 	}
+	mnemonic, err := s.getMnemonic()
+	if err != nil {
+		return err
+	}
 	//set new password
 	s.setPassword(password)
-	return s.setSeed(seed)
+	return s.BatchWrite(true, func(batch storage.Batch) error {
+		if err := s.setSeedByTxn(batch, seed); err != nil {
+			return err
+		}
+		if len(mnemonic) > 0 {
+			return s.setMnemonicByTxn(batch, mnemonic)
+		}
+		return nil
+	})
 }
 
 func (s *Session) GetRawKey(account types.Address) (*types.Account, error) {
+	if s.IsHD() {
+		accounts, err := s.GetHDAccounts()
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range accounts {
+			if a.Address == account {
+				return s.hdRawKey(a.Path)
+			}
+		}
+		return nil, fmt.Errorf("can not fetch account[%s]'s raw key", account.String())
+	}
+
 	index, err := s.GetDeterministicIndex()
 	if err != nil {
 		index = 0
@@ -306,6 +345,219 @@ func (s *Session) GetRawKey(account types.Address) (*types.Account, error) {
 	return nil, fmt.Errorf("can not fetch account[%s]'s raw key", account.String())
 }
 
+// IsHD checks the wallet is created from mnemonic
+func (s *Session) IsHD() bool {
+	version, err := s.GetVersion()
+	return err == nil && version == HDVersion
+}
+
+// GetMnemonic returns the mnemonic of HD wallet, passphrase is never stored
+func (s *Session) GetMnemonic() (string, error) {
+	if !s.IsHD() {
+		return "", ErrNotHDWallet
+	}
+	mnemonic, err := s.getMnemonic()
+	if err != nil {
+		return "", err
+	}
+	if len(mnemonic) == 0 {
+		return "", errors.New("password is invalid")
+	}
+	return string(mnemonic), nil
+}
+
+func (s *Session) getMnemonic() ([]byte, error) {
+	key := s.getKey(idPrefixMnemonic)
+	val, err := s.Get(key)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return util.DecryptBytes(val, s.getPassword())
+}
+
+func (s *Session) setMnemonicByTxn(batch storage.Batch, mnemonic []byte) error {
+	encrypted, err := util.EncryptBytes(mnemonic, s.getPassword())
+	if err != nil {
+		return err
+	}
+	key := s.getKey(idPrefixMnemonic)
+	return batch.Put(key, encrypted)
+}
+
+// GetHDAccounts returns named accounts of HD wallet
+func (s *Session) GetHDAccounts() ([]*HDAccount, error) {
+	var accounts []*HDAccount
+	key := s.getKey(idPrefixHDAccounts)
+	val, err := s.Get(key)
+	if err != nil {
+		if err == storage.KeyNotFound {
+			return accounts, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(val, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func (s *Session) setHDAccountsByTxn(batch storage.Batch, accounts []*HDAccount) error {
+	bytes, err := json.Marshal(&accounts)
+	if err != nil {
+		return err
+	}
+	key := s.getKey(idPrefixHDAccounts)
+	return batch.Put(key, bytes)
+}
+
+// NewHDAccount derives a named account, path is the next default path if empty
+func (s *Session) NewHDAccount(name, path string) (*HDAccount, error) {
+	if !s.IsHD() {
+		return nil, ErrNotHDWallet
+	}
+	accounts, err := s.GetHDAccounts()
+	if err != nil {
+		return nil, err
+	}
+	index, err := s.GetDeterministicIndex()
+	if err != nil {
+		return nil, err
+	}
+	next := index
+	if path == "" {
+		path = types.HDPath(uint32(index))
+		next = index + 1
+		if name == "" {
+			name = fmt.Sprintf("account%d", index)
+		}
+	}
+	if name == "" {
+		return nil, errors.New("account name is required for custom path")
+	}
+	for _, a := range accounts {
+		if a.Name == name {
+			return nil, fmt.Errorf("account name %s already exists", name)
+		}
+		if a.Path == path {
+			return nil, fmt.Errorf("account of path %s already exists", path)
+		}
+	}
+
+	account, err := s.hdRawKey(path)
+	if err != nil {
+		return nil, err
+	}
+	hd := &HDAccount{Name: name, Path: path, Address: account.Address()}
+	accounts = append(accounts, hd)
+	err = s.BatchWrite(true, func(batch storage.Batch) error {
+		if err := s.setHDAccountsByTxn(batch, accounts); err != nil {
+			return err
+		}
+		return s.setDeterministicIndex(batch, next)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hd, nil
+}
+
+// HDRawKeys returns raw keys of all named accounts of HD wallet
+func (s *Session) HDRawKeys() ([]*types.Account, error) {
+	accounts, err := s.GetHDAccounts()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*types.Account, 0, len(accounts))
+	for _, a := range accounts {
+		key, err := s.hdRawKey(a.Path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Discover scans default paths until gapLimit consecutive accounts are unused, and saves used accounts as named accounts
+func (s *Session) Discover(gapLimit int, isUsed func(address types.Address) (bool, error)) ([]*HDAccount, error) {
+	if !s.IsHD() {
+		return nil, ErrNotHDWallet
+	}
+	if gapLimit <= 0 {
+		gapLimit = DefaultGapLimit
+	}
+	accounts, err := s.GetHDAccounts()
+	if err != nil {
+		return nil, err
+	}
+	index, err := s.GetDeterministicIndex()
+	if err != nil {
+		return nil, err
+	}
+	paths := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, a := range accounts {
+		paths[a.Path] = true
+		names[a.Name] = true
+	}
+
+	var found []*HDAccount
+	for i, gap := uint32(0), 0; gap < gapLimit; i++ {
+		path := types.HDPath(i)
+		account, err := s.hdRawKey(path)
+		if err != nil {
+			return nil, err
+		}
+		used, err := isUsed(account.Address())
+		if err != nil {
+			return nil, err
+		}
+		if !used {
+			gap++
+			continue
+		}
+		gap = 0
+		if int64(i) >= index {
+			index = int64(i) + 1
+		}
+		if paths[path] {
+			continue
+		}
+		name := fmt.Sprintf("account%d", i)
+		if names[name] {
+			name = path
+		}
+		hd := &HDAccount{Name: name, Path: path, Address: account.Address()}
+		accounts = append(accounts, hd)
+		found = append(found, hd)
+	}
+
+	err = s.BatchWrite(true, func(batch storage.Batch) error {
+		if err := s.setHDAccountsByTxn(batch, accounts); err != nil {
+			return err
+		}
+		return s.setDeterministicIndex(batch, index)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+func (s *Session) hdRawKey(path string) (*types.Account, error) {
+	seed, err := s.GetSeed()
+	if err != nil {
+		return nil, err
+	}
+	if len(seed) == 0 {
+		return nil, errors.New("password is invalid")
+	}
+	return types.NewAccountByPath(seed, path)
+}
+
 func (s *Session) getKey(t byte) []byte {
 	var key []byte
 	key = append(key, t)
@@ -641,3 +641,125 @@ func TestSession_GetRepresentative(t *testing.T) {
 		}
 	}
 }
+
+func newTestHDSession(t *testing.T, store *WalletStore) *Session {
+	id, err := store.NewWalletByMnemonic("letter advice cage absurd amount doctor acoustic avoid letter advice cage above", "", "1111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session := store.NewSession(id)
+	if b, err := session.VerifyPassword("1111"); err != nil || !b {
+		t.Fatal("invalid password", err)
+	}
+	return session
+}
+
+func TestSession_NewHDAccount(t *testing.T) {
+	teardownTestCase, store := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	session := newTestHDSession(t, store)
+	accounts, err := session.GetHDAccounts()
+	if err != nil || len(accounts) != 1 {
+		t.Fatal(accounts, err)
+	}
+	if id, _ := session.GetWalletId(); !bytes.Equal(id, accounts[0].Address.Bytes()) {
+		t.Fatal("wallet id should be the first account")
+	}
+
+	a1, err := session.NewHDAccount("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a1.Name != "account1" || a1.Path != types.HDPath(1) {
+		t.Fatal(a1)
+	}
+	a2, err := session.NewHDAccount("savings", "m/44'/105'/7'/1'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.NewHDAccount("savings", ""); err == nil {
+		t.Fatal("duplicate name should be rejected")
+	}
+	if _, err := session.NewHDAccount("other", "m/44'/105'/7'/1'"); err == nil {
+		t.Fatal("duplicate path should be rejected")
+	}
+	if _, err := session.NewHDAccount("bad", "m/44'/105'/1"); err == nil {
+		t.Fatal("non-hardened path should be rejected")
+	}
+
+	hash := mock.Hash()
+	for _, a := range []*HDAccount{a1, a2} {
+		key, err := session.GetRawKey(a.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sign := key.Sign(hash)
+		if !a.Address.Verify(hash[:], sign[:]) {
+			t.Fatal("verify failed")
+		}
+	}
+	if _, err := session.GetRawKey(mock.Address()); err == nil {
+		t.Fatal("get invalid raw key failed")
+	}
+	keys, err := session.HDRawKeys()
+	if err != nil || len(keys) != 3 {
+		t.Fatal(keys, err)
+	}
+
+	// mnemonic is re-encrypted with new password
+	if err := session.ChangePassword("2222"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := session.GetMnemonic(); err != nil {
+		t.Fatal(err)
+	}
+
+	legacy, err := store.NewWallet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.NewSession(legacy).NewHDAccount("", ""); err != ErrNotHDWallet {
+		t.Fatal(err)
+	}
+}
+
+func TestSession_Discover(t *testing.T) {
+	teardownTestCase, store := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	session := newTestHDSession(t, store)
+	seed, err := session.GetSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	used := make(map[types.Address]bool)
+	for _, i := range []uint32{0, 3, 9} {
+		a, _ := types.NewAccountByPath(seed, types.HDPath(i))
+		used[a.Address()] = true
+	}
+	// index 30 is out of gap limit
+	a30, _ := types.NewAccountByPath(seed, types.HDPath(30))
+	used[a30.Address()] = true
+
+	found, err := session.Discover(6, func(address types.Address) (bool, error) {
+		return used[address], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 || found[0].Path != types.HDPath(3) || found[1].Path != types.HDPath(9) {
+		t.Fatal(found)
+	}
+	accounts, _ := session.GetHDAccounts()
+	if len(accounts) != 3 {
+		t.Fatal(accounts)
+	}
+	if index, _ := session.GetDeterministicIndex(); index != 10 {
+		t.Fatal(index)
+	}
+	a, err := session.NewHDAccount("", "")
+	if err != nil || a.Path != types.HDPath(10) {
+		t.Fatal(a, err)
+	}
+}
@@ -12,6 +12,7 @@ import "github.com/qlcchain/go-qlc/common/types"
 type walletManager interface {
 	WalletIds() ([]types.Address, error)
 	NewWalletBySeed(seed string) (types.Address, error)
+	NewWalletByMnemonic(mnemonic, passphrase, password string) (types.Address, error)
 	NewWallet() (types.Address, error)
 	CurrentId() (types.Address, error)
 	RemoveWallet(id types.Address) error
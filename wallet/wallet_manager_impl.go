@@ -12,12 +12,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/qlcchain/go-qlc/chain/context"
 	"github.com/qlcchain/go-qlc/common/storage"
 	"github.com/qlcchain/go-qlc/common/storage/db"
 	"github.com/qlcchain/go-qlc/common/types"
+	"github.com/qlcchain/go-qlc/crypto/bip39"
 	"github.com/qlcchain/go-qlc/log"
 )
 
@@ -136,6 +138,78 @@ func (ws *WalletStore) NewWalletBySeed(seed, password string) (types.Address, er
 	return walletId, err
 }
 
+// NewWalletByMnemonic create HD wallet from BIP39 mnemonic and optional passphrase,
+// the wallet id is the account of the first default path
+func (ws *WalletStore) NewWalletByMnemonic(mnemonic, passphrase, password string) (types.Address, error) {
+	var walletId types.Address
+	seed, err := bip39.NewSeed(mnemonic, passphrase)
+	if err != nil {
+		return walletId, err
+	}
+	path := types.HDPath(0)
+	account, err := types.NewAccountByPath(seed, path)
+	if err != nil {
+		return walletId, err
+	}
+	walletId = account.Address()
+	if b, err := ws.IsWalletExist(walletId); b && err == nil {
+		return walletId, fmt.Errorf("mnemonic of wallet[%s] already exist", walletId)
+	}
+
+	session := ws.NewSession(walletId)
+	ids, err := ws.WalletIds()
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	ids = append(ids, walletId)
+	err = ws.BatchWrite(true, func(batch storage.Batch) error {
+		key := []byte{idPrefixIds}
+		bytes, err := json.Marshal(&ids)
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(key, bytes); err != nil {
+			return err
+		}
+		if err := ws.setCurrentId(batch, walletId.Bytes()); err != nil {
+			return err
+		}
+		if err := session.setDeterministicIndex(batch, 1); err != nil {
+			return err
+		}
+		_ = session.setVersion(batch, HDVersion)
+
+		if err := session.EnterPassword(password); err != nil {
+			return err
+		}
+		if err := session.setSeedByTxn(batch, seed); err != nil {
+			return err
+		}
+		normalized := strings.Join(strings.Fields(strings.ToLower(mnemonic)), " ")
+		if err := session.setMnemonicByTxn(batch, []byte(normalized)); err != nil {
+			return err
+		}
+		accounts := []*HDAccount{{Name: "account0", Path: path, Address: walletId}}
+		return session.setHDAccountsByTxn(batch, accounts)
+	})
+
+	return walletId, err
+}
+
+// NewHDWallet create HD wallet with a new 24 words mnemonic
+func (ws *WalletStore) NewHDWallet(passphrase, password string) (types.Address, string, error) {
+	mnemonic, err := bip39.NewRandomMnemonic(bip39.DefaultEntropySize)
+	if err != nil {
+		return types.ZeroAddress, "", err
+	}
+	addr, err := ws.NewWalletByMnemonic(mnemonic, passphrase, password)
+	if err != nil {
+		return types.ZeroAddress, "", err
+	}
+	return addr, mnemonic, nil
+}
+
 // IsWalletExist check is the wallet exist by master address
 func (ws *WalletStore) IsWalletExist(address types.Address) (bool, error) {
 	addresses, err := ws.WalletIds()
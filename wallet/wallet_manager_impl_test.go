@@ -158,3 +158,53 @@ func TestWalletStore_NewWalletBySeed(t *testing.T) {
 		t.Fatal("invalid password")
 	}
 }
+
+func TestWalletStore_NewWalletByMnemonic(t *testing.T) {
+	teardownTestCase, store := setupTestCase(t)
+	defer teardownTestCase(t)
+
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+	id, err := store.NewWalletByMnemonic(mnemonic, "TREZOR", "1111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.NewWalletByMnemonic(mnemonic, "TREZOR", "1111"); err == nil {
+		t.Fatal("duplicate wallet should be rejected")
+	}
+	if _, err := store.NewWalletByMnemonic("legal winner thank", "", "1111"); err == nil {
+		t.Fatal("invalid mnemonic should be rejected")
+	}
+
+	// another passphrase generates another wallet
+	id2, err := store.NewWalletByMnemonic(mnemonic, "", "1111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id2 == id {
+		t.Fatal("passphrase should change wallet id")
+	}
+
+	s := store.NewSession(id)
+	if b, err := s.VerifyPassword("1111"); err != nil || !b {
+		t.Fatal("invalid password", err)
+	}
+	if !s.IsHD() {
+		t.Fatal("should be HD wallet")
+	}
+	m, err := s.GetMnemonic()
+	if err != nil || m != mnemonic {
+		t.Fatal(m, err)
+	}
+
+	id3, m3, err := store.NewHDWallet("", "2222")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s3 := store.NewSession(id3)
+	if b, err := s3.VerifyPassword("2222"); err != nil || !b {
+		t.Fatal("invalid password", err)
+	}
+	if m, err := s3.GetMnemonic(); err != nil || m != m3 {
+		t.Fatal(m, err)
+	}
+}